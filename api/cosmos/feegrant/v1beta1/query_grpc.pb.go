@@ -24,6 +24,7 @@ const (
 	Query_Allowance_FullMethodName           = "/cosmos.feegrant.v1beta1.Query/Allowance"
 	Query_Allowances_FullMethodName          = "/cosmos.feegrant.v1beta1.Query/Allowances"
 	Query_AllowancesByGranter_FullMethodName = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter"
+	Query_GasAllowanceBudget_FullMethodName  = "/cosmos.feegrant.v1beta1.Query/GasAllowanceBudget"
 )
 
 // QueryClient is the client API for Query service.
@@ -36,6 +37,9 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// GasAllowanceBudget returns the gas budgets left in the current period of
+	// the gas allowance granted to the grantee by the granter.
+	GasAllowanceBudget(ctx context.Context, in *QueryGasAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryGasAllowanceBudgetResponse, error)
 }
 
 type queryClient struct {
@@ -73,6 +77,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) GasAllowanceBudget(ctx context.Context, in *QueryGasAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryGasAllowanceBudgetResponse, error) {
+	out := new(QueryGasAllowanceBudgetResponse)
+	err := c.cc.Invoke(ctx, Query_GasAllowanceBudget_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -83,6 +96,9 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// GasAllowanceBudget returns the gas budgets left in the current period of
+	// the gas allowance granted to the grantee by the granter.
+	GasAllowanceBudget(context.Context, *QueryGasAllowanceBudgetRequest) (*QueryGasAllowanceBudgetResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -99,6 +115,9 @@ func (UnimplementedQueryServer) Allowances(context.Context, *QueryAllowancesRequ
 func (UnimplementedQueryServer) AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (UnimplementedQueryServer) GasAllowanceBudget(context.Context, *QueryGasAllowanceBudgetRequest) (*QueryGasAllowanceBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasAllowanceBudget not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -166,6 +185,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasAllowanceBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasAllowanceBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasAllowanceBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GasAllowanceBudget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasAllowanceBudget(ctx, req.(*QueryGasAllowanceBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -185,6 +222,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "GasAllowanceBudget",
+			Handler:    _Query_GasAllowanceBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -14,12 +14,14 @@ import (
 )
 
 var (
-	md_Module                      protoreflect.MessageDescriptor
-	fd_Module_max_metadata_len     protoreflect.FieldDescriptor
-	fd_Module_authority            protoreflect.FieldDescriptor
-	fd_Module_max_title_len        protoreflect.FieldDescriptor
-	fd_Module_max_summary_len      protoreflect.FieldDescriptor
-	fd_Module_max_vote_options_len protoreflect.FieldDescriptor
+	md_Module                             protoreflect.MessageDescriptor
+	fd_Module_max_metadata_len            protoreflect.FieldDescriptor
+	fd_Module_authority                   protoreflect.FieldDescriptor
+	fd_Module_max_title_len               protoreflect.FieldDescriptor
+	fd_Module_max_summary_len             protoreflect.FieldDescriptor
+	fd_Module_max_vote_options_len        protoreflect.FieldDescriptor
+	fd_Module_max_simulate_proposal_msgs  protoreflect.FieldDescriptor
+	fd_Module_simulate_proposal_gas_limit protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -30,6 +32,8 @@ func init() {
 	fd_Module_max_title_len = md_Module.Fields().ByName("max_title_len")
 	fd_Module_max_summary_len = md_Module.Fields().ByName("max_summary_len")
 	fd_Module_max_vote_options_len = md_Module.Fields().ByName("max_vote_options_len")
+	fd_Module_max_simulate_proposal_msgs = md_Module.Fields().ByName("max_simulate_proposal_msgs")
+	fd_Module_simulate_proposal_gas_limit = md_Module.Fields().ByName("simulate_proposal_gas_limit")
 }
 
 var _ protoreflect.Message = (*fastReflection_Module)(nil)
@@ -127,6 +131,18 @@ func (x *fastReflection_Module) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.MaxSimulateProposalMsgs != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxSimulateProposalMsgs)
+		if !f(fd_Module_max_simulate_proposal_msgs, value) {
+			return
+		}
+	}
+	if x.SimulateProposalGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SimulateProposalGasLimit)
+		if !f(fd_Module_simulate_proposal_gas_limit, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -152,6 +168,10 @@ func (x *fastReflection_Module) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MaxSummaryLen != uint64(0)
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		return x.MaxVoteOptionsLen != uint64(0)
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_msgs":
+		return x.MaxSimulateProposalMsgs != uint64(0)
+	case "cosmos.gov.module.v1.Module.simulate_proposal_gas_limit":
+		return x.SimulateProposalGasLimit != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -178,6 +198,10 @@ func (x *fastReflection_Module) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxSummaryLen = uint64(0)
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		x.MaxVoteOptionsLen = uint64(0)
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_msgs":
+		x.MaxSimulateProposalMsgs = uint64(0)
+	case "cosmos.gov.module.v1.Module.simulate_proposal_gas_limit":
+		x.SimulateProposalGasLimit = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -209,6 +233,12 @@ func (x *fastReflection_Module) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		value := x.MaxVoteOptionsLen
 		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_msgs":
+		value := x.MaxSimulateProposalMsgs
+		return protoreflect.ValueOfUint64(value)
+	case "cosmos.gov.module.v1.Module.simulate_proposal_gas_limit":
+		value := x.SimulateProposalGasLimit
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -239,6 +269,10 @@ func (x *fastReflection_Module) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MaxSummaryLen = value.Uint()
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		x.MaxVoteOptionsLen = value.Uint()
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_msgs":
+		x.MaxSimulateProposalMsgs = value.Uint()
+	case "cosmos.gov.module.v1.Module.simulate_proposal_gas_limit":
+		x.SimulateProposalGasLimit = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -269,6 +303,10 @@ func (x *fastReflection_Module) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field max_summary_len of message cosmos.gov.module.v1.Module is not mutable"))
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		panic(fmt.Errorf("field max_vote_options_len of message cosmos.gov.module.v1.Module is not mutable"))
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_msgs":
+		panic(fmt.Errorf("field max_simulate_proposal_msgs of message cosmos.gov.module.v1.Module is not mutable"))
+	case "cosmos.gov.module.v1.Module.simulate_proposal_gas_limit":
+		panic(fmt.Errorf("field simulate_proposal_gas_limit of message cosmos.gov.module.v1.Module is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -292,6 +330,10 @@ func (x *fastReflection_Module) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "cosmos.gov.module.v1.Module.max_vote_options_len":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.module.v1.Module.max_simulate_proposal_msgs":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "cosmos.gov.module.v1.Module.simulate_proposal_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: cosmos.gov.module.v1.Module"))
@@ -377,6 +419,12 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 		if x.MaxVoteOptionsLen != 0 {
 			n += 1 + runtime.Sov(uint64(x.MaxVoteOptionsLen))
 		}
+		if x.MaxSimulateProposalMsgs != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxSimulateProposalMsgs))
+		}
+		if x.SimulateProposalGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.SimulateProposalGasLimit))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -406,6 +454,16 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.SimulateProposalGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SimulateProposalGasLimit))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxSimulateProposalMsgs != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxSimulateProposalMsgs))
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.MaxVoteOptionsLen != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxVoteOptionsLen))
 			i--
@@ -590,6 +648,44 @@ func (x *fastReflection_Module) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxSimulateProposalMsgs", wireType)
+				}
+				x.MaxSimulateProposalMsgs = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxSimulateProposalMsgs |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SimulateProposalGasLimit", wireType)
+				}
+				x.SimulateProposalGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SimulateProposalGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -658,6 +754,12 @@ type Module struct {
 	// max_vote_options_len defines the maximum number of vote options a proposal can have.
 	// Defaults to 0 if not explicitly set.
 	MaxVoteOptionsLen uint64 `protobuf:"varint,5,opt,name=max_vote_options_len,json=maxVoteOptionsLen,proto3" json:"max_vote_options_len,omitempty"`
+	// max_simulate_proposal_msgs defines the maximum number of messages a proposal simulation can execute.
+	// Defaults to 100 if not explicitly set.
+	MaxSimulateProposalMsgs uint64 `protobuf:"varint,6,opt,name=max_simulate_proposal_msgs,json=maxSimulateProposalMsgs,proto3" json:"max_simulate_proposal_msgs,omitempty"`
+	// simulate_proposal_gas_limit defines the gas limit of the execution of the messages of a proposal simulation.
+	// Defaults to 10000000 if not explicitly set.
+	SimulateProposalGasLimit uint64 `protobuf:"varint,7,opt,name=simulate_proposal_gas_limit,json=simulateProposalGasLimit,proto3" json:"simulate_proposal_gas_limit,omitempty"`
 }
 
 func (x *Module) Reset() {
@@ -715,6 +817,20 @@ func (x *Module) GetMaxVoteOptionsLen() uint64 {
 	return 0
 }
 
+func (x *Module) GetMaxSimulateProposalMsgs() uint64 {
+	if x != nil {
+		return x.MaxSimulateProposalMsgs
+	}
+	return 0
+}
+
+func (x *Module) GetSimulateProposalGasLimit() uint64 {
+	if x != nil {
+		return x.SimulateProposalGasLimit
+	}
+	return 0
+}
+
 var File_cosmos_gov_module_v1_module_proto protoreflect.FileDescriptor
 
 var file_cosmos_gov_module_v1_module_proto_rawDesc = []byte{
@@ -723,7 +839,7 @@ var file_cosmos_gov_module_v1_module_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x12, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e,
 	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x31, 0x2f, 0x6d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x01, 0x0a, 0x06,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe5, 0x02, 0x0a, 0x06,
 	0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65,
 	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
 	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x65, 0x6e,
@@ -736,22 +852,30 @@ var file_cosmos_gov_module_v1_module_proto_rawDesc = []byte{
 	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c, 0x65, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61,
 	0x78, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6c,
 	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x6e, 0x3a, 0x1a, 0xba, 0xc0, 0x96,
-	0xda, 0x01, 0x14, 0x0a, 0x12, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x78, 0x2f, 0x67, 0x6f, 0x76, 0x42, 0xca, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76,
-	0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
-	0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x47, 0x6f, 0x76, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x4d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
-	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x65, 0x6e, 0x12, 0x3b, 0x0a, 0x1a, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x17, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x3d, 0x0a, 0x1b, 0x73, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x5f, 0x67, 0x61,
+	0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x73,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x47,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x1a, 0xba, 0xc0, 0x96, 0xda, 0x01, 0x14, 0x0a,
+	0x12, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x78, 0x2f,
+	0x67, 0x6f, 0x76, 0x42, 0xca, 0x01, 0x0a, 0x18, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x67, 0x6f, 0x76, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x76, 0x31,
+	0x42, 0x0b, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x2f, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x47, 0x4d, 0xaa, 0x02, 0x14, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x47,
+	0x6f, 0x76, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x14, 0x43,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76, 0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x20, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5c, 0x47, 0x6f, 0x76,
+	0x5c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x17, 0x43, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x3a,
+	0x3a, 0x47, 0x6f, 0x76, 0x3a, 0x3a, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x3a, 0x3a, 0x56, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
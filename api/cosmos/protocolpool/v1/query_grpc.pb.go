@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_CommunityPool_FullMethodName   = "/cosmos.protocolpool.v1.Query/CommunityPool"
-	Query_UnclaimedBudget_FullMethodName = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_CommunityPool_FullMethodName     = "/cosmos.protocolpool.v1.Query/CommunityPool"
+	Query_UnclaimedBudget_FullMethodName   = "/cosmos.protocolpool.v1.Query/UnclaimedBudget"
+	Query_PendingMilestones_FullMethodName = "/cosmos.protocolpool.v1.Query/PendingMilestones"
 )
 
 // QueryClient is the client API for Query service.
@@ -31,6 +32,9 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// PendingMilestones queries the milestones of milestone-gated budgets that are
+	// still waiting for approval.
+	PendingMilestones(ctx context.Context, in *QueryPendingMilestonesRequest, opts ...grpc.CallOption) (*QueryPendingMilestonesResponse, error)
 }
 
 type queryClient struct {
@@ -59,6 +63,15 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) PendingMilestones(ctx context.Context, in *QueryPendingMilestonesRequest, opts ...grpc.CallOption) (*QueryPendingMilestonesResponse, error) {
+	out := new(QueryPendingMilestonesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingMilestones_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -67,6 +80,9 @@ type QueryServer interface {
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// PendingMilestones queries the milestones of milestone-gated budgets that are
+	// still waiting for approval.
+	PendingMilestones(context.Context, *QueryPendingMilestonesRequest) (*QueryPendingMilestonesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -80,6 +96,9 @@ func (UnimplementedQueryServer) CommunityPool(context.Context, *QueryCommunityPo
 func (UnimplementedQueryServer) UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (UnimplementedQueryServer) PendingMilestones(context.Context, *QueryPendingMilestonesRequest) (*QueryPendingMilestonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMilestones not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -129,6 +148,24 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingMilestones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMilestonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMilestones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingMilestones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMilestones(ctx, req.(*QueryPendingMilestonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -144,6 +181,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "PendingMilestones",
+			Handler:    _Query_PendingMilestones_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -23,6 +23,7 @@ const (
 	Msg_CommunityPoolSpend_FullMethodName     = "/cosmos.protocolpool.v1.Msg/CommunityPoolSpend"
 	Msg_SubmitBudgetProposal_FullMethodName   = "/cosmos.protocolpool.v1.Msg/SubmitBudgetProposal"
 	Msg_ClaimBudget_FullMethodName            = "/cosmos.protocolpool.v1.Msg/ClaimBudget"
+	Msg_ApproveMilestone_FullMethodName       = "/cosmos.protocolpool.v1.Msg/ApproveMilestone"
 	Msg_CreateContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CreateContinuousFund"
 	Msg_WithdrawContinuousFund_FullMethodName = "/cosmos.protocolpool.v1.Msg/WithdrawContinuousFund"
 	Msg_CancelContinuousFund_FullMethodName   = "/cosmos.protocolpool.v1.Msg/CancelContinuousFund"
@@ -44,6 +45,9 @@ type MsgClient interface {
 	SubmitBudgetProposal(ctx context.Context, in *MsgSubmitBudgetProposal, opts ...grpc.CallOption) (*MsgSubmitBudgetProposalResponse, error)
 	// ClaimBudget defines a method to claim the distributed budget.
 	ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ...grpc.CallOption) (*MsgClaimBudgetResponse, error)
+	// ApproveMilestone defines a method for the milestone approver of a budget
+	// to approve its next pending milestone.
+	ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error)
 	// CreateContinuousFund defines a method to add funds continuously.
 	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
 	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
@@ -96,6 +100,15 @@ func (c *msgClient) ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ..
 	return out, nil
 }
 
+func (c *msgClient) ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error) {
+	out := new(MsgApproveMilestoneResponse)
+	err := c.cc.Invoke(ctx, Msg_ApproveMilestone_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error) {
 	out := new(MsgCreateContinuousFundResponse)
 	err := c.cc.Invoke(ctx, Msg_CreateContinuousFund_FullMethodName, in, out, opts...)
@@ -139,6 +152,9 @@ type MsgServer interface {
 	SubmitBudgetProposal(context.Context, *MsgSubmitBudgetProposal) (*MsgSubmitBudgetProposalResponse, error)
 	// ClaimBudget defines a method to claim the distributed budget.
 	ClaimBudget(context.Context, *MsgClaimBudget) (*MsgClaimBudgetResponse, error)
+	// ApproveMilestone defines a method for the milestone approver of a budget
+	// to approve its next pending milestone.
+	ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error)
 	// CreateContinuousFund defines a method to add funds continuously.
 	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
 	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
@@ -164,6 +180,9 @@ func (UnimplementedMsgServer) SubmitBudgetProposal(context.Context, *MsgSubmitBu
 func (UnimplementedMsgServer) ClaimBudget(context.Context, *MsgClaimBudget) (*MsgClaimBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimBudget not implemented")
 }
+func (UnimplementedMsgServer) ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMilestone not implemented")
+}
 func (UnimplementedMsgServer) CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateContinuousFund not implemented")
 }
@@ -258,6 +277,24 @@ func _Msg_ClaimBudget_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ApproveMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ApproveMilestone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMilestone(ctx, req.(*MsgApproveMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateContinuousFund)
 	if err := dec(in); err != nil {
@@ -335,6 +372,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimBudget",
 			Handler:    _Msg_ClaimBudget_Handler,
 		},
+		{
+			MethodName: "ApproveMilestone",
+			Handler:    _Msg_ApproveMilestone_Handler,
+		},
 		{
 			MethodName: "CreateContinuousFund",
 			Handler:    _Msg_CreateContinuousFund_Handler,
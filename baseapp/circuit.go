@@ -6,3 +6,11 @@ import "context"
 type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
+
+// RateLimitedCircuitBreaker is a CircuitBreaker which enforces throughput limits
+// on the messages of a type URL. Every message routed by the MsgServiceRouter,
+// including the nested ones, is accounted against its rate limit.
+type RateLimitedCircuitBreaker interface {
+	CircuitBreaker
+	RecordMsg(ctx context.Context, typeURL string) error
+}
@@ -55,6 +55,17 @@ func (msr *MsgServiceRouter) SetCircuit(cb CircuitBreaker) {
 	msr.circuitBreaker = cb
 }
 
+// recordMsg accounts the message against its rate limit, when the circuit breaker
+// enforces rate limits.
+func (msr *MsgServiceRouter) recordMsg(ctx context.Context, msgURL string) error {
+	rateLimiter, ok := msr.circuitBreaker.(RateLimitedCircuitBreaker)
+	if !ok {
+		return nil
+	}
+
+	return rateLimiter.RecordMsg(ctx, msgURL)
+}
+
 // MsgServiceHandler defines a function type which handles Msg service message.
 type MsgServiceHandler = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error)
 
@@ -129,6 +140,9 @@ func (msr *MsgServiceRouter) registerHybridHandler(sd *grpc.ServiceDesc, method
 		if !allowed {
 			return fmt.Errorf("circuit breaker disallows execution of message %s", messageName)
 		}
+		if err := msr.recordMsg(ctx, messageName); err != nil {
+			return err
+		}
 		return hybridHandler(ctx, req, resp)
 	}
 	msr.hybridHandlers[string(inputName)] = circuitBreakerHybridHandler
@@ -214,6 +228,10 @@ func (msr *MsgServiceRouter) registerMsgServiceHandler(sd *grpc.ServiceDesc, met
 			if !isAllowed {
 				return nil, fmt.Errorf("circuit breaker disables execution of this message: %s", msgURL)
 			}
+
+			if err := msr.recordMsg(ctx, msgURL); err != nil {
+				return nil, err
+			}
 		}
 
 		// Call the method handler from the service description with the handler object.
@@ -2,6 +2,7 @@ package baseapp_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -121,6 +122,63 @@ func TestHybridHandlerByMsgName(t *testing.T) {
 	require.Equal(t, resp.Name, "Spot")
 }
 
+type rateLimitedCircuitBreaker struct {
+	recorded []string
+}
+
+func (*rateLimitedCircuitBreaker) IsAllowed(context.Context, string) (bool, error) {
+	return true, nil
+}
+
+func (cb *rateLimitedCircuitBreaker) RecordMsg(_ context.Context, typeURL string) error {
+	if len(cb.recorded) > 0 {
+		return errors.New("rate limit exceeded")
+	}
+	cb.recorded = append(cb.recorded, typeURL)
+	return nil
+}
+
+func TestMsgServiceRouterRateLimit(t *testing.T) {
+	// Setup baseapp and router.
+	var (
+		appBuilder *runtime.AppBuilder
+		registry   codectypes.InterfaceRegistry
+	)
+	err := depinject.Inject(
+		depinject.Configs(
+			makeMinimalConfig(),
+			depinject.Supply(log.NewTestLogger(t)),
+		), &appBuilder, &registry)
+	require.NoError(t, err)
+	app := appBuilder.Build(dbm.NewMemDB(), nil)
+	testdata.RegisterInterfaces(registry)
+
+	circuitBreaker := &rateLimitedCircuitBreaker{}
+	app.SetCircuitBreaker(circuitBreaker)
+	testdata.RegisterMsgServer(
+		app.MsgServiceRouter(),
+		testdata.MsgServerImpl{},
+	)
+	require.NoError(t, app.Init())
+	ctx := app.NewContext(true)
+
+	// every routed msg, as the nested ones, is accounted against the rate limit
+	msg := &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "Spot"}, Owner: "me"}
+	handler := app.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+	_, err = handler(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/testpb.MsgCreateDog"}, circuitBreaker.recorded)
+
+	_, err = handler(ctx, msg)
+	require.ErrorContains(t, err, "rate limit exceeded")
+
+	hybridHandler := app.MsgServiceRouter().HybridHandlerByMsgName("testpb.MsgCreateDog")
+	require.NotNil(t, hybridHandler)
+	err = hybridHandler(ctx, msg, new(testdata.MsgCreateDogResponse))
+	require.ErrorContains(t, err, "rate limit exceeded")
+}
+
 func TestMsgService(t *testing.T) {
 	priv, _, _ := testdata.KeyTestPubAddr()
 
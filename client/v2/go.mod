@@ -176,6 +176,7 @@ replace github.com/cosmos/cosmos-sdk => ./../../
 
 replace (
 	cosmossdk.io/api => ./../../api
+	cosmossdk.io/collections => ../../collections
 	cosmossdk.io/core => ./../../core
 	cosmossdk.io/depinject => ./../../depinject
 	cosmossdk.io/log => ./../../log
@@ -0,0 +1,117 @@
+package indexes
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/codec"
+)
+
+// Iterator defines an iterator over the entries of an index, exposing both the
+// index key, which is the key under which the entry is stored in the index, and
+// the primary key it references.
+type Iterator[IndexKey, PrimaryKey any] interface {
+	// IndexKey returns the iterator current index key.
+	IndexKey() (IndexKey, error)
+	// PrimaryKey returns the iterator current primary key.
+	PrimaryKey() (PrimaryKey, error)
+	// Next advances the iterator by one element.
+	Next()
+	// Valid asserts if the Iterator is valid.
+	Valid() bool
+	// Close closes the iterator.
+	Close() error
+}
+
+// Iterable defines an index whose entries can be iterated using raw index keys.
+// It is implemented by Multi, Unique and ReversePair, and it is the minimum API
+// required to paginate an index.
+type Iterable[IndexKey, PrimaryKey any] interface {
+	// KeyCodec returns the codec used to encode the index keys.
+	KeyCodec() codec.KeyCodec[IndexKey]
+	// IterateIndexRaw iterates over the index entries using raw bytes index keys.
+	// It follows the same semantics as collections.Map.IterateRaw.
+	IterateIndexRaw(ctx context.Context, start, end []byte, order collections.Order) (Iterator[IndexKey, PrimaryKey], error)
+}
+
+// JoinIterator joins an index iterator with its collections.IndexedMap.
+// The value referenced by the current primary key is fetched lazily,
+// only when Value or KeyValue are called.
+type JoinIterator[K, V any, I iterator[K], Idx collections.Indexes[K, V]] struct {
+	ctx        context.Context
+	indexedMap *collections.IndexedMap[K, V, Idx]
+	iter       I
+}
+
+// NewJoinIterator returns a JoinIterator which resolves the primary keys
+// yielded by the provided index iterator using the IndexedMap.
+// Closing the JoinIterator closes the index iterator.
+func NewJoinIterator[K, V any, I iterator[K], Idx collections.Indexes[K, V]](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[K, V, Idx],
+	iter I,
+) JoinIterator[K, V, I, Idx] {
+	return JoinIterator[K, V, I, Idx]{
+		ctx:        ctx,
+		indexedMap: indexedMap,
+		iter:       iter,
+	}
+}
+
+// IndexIterator returns the underlying index iterator.
+func (j JoinIterator[K, V, I, Idx]) IndexIterator() I {
+	return j.iter
+}
+
+// PrimaryKey returns the iterator current primary key.
+func (j JoinIterator[K, V, I, Idx]) PrimaryKey() (K, error) {
+	return j.iter.PrimaryKey()
+}
+
+// Value fetches the value referenced by the iterator current primary key.
+func (j JoinIterator[K, V, I, Idx]) Value() (value V, err error) {
+	pk, err := j.iter.PrimaryKey()
+	if err != nil {
+		return value, err
+	}
+	return j.indexedMap.Get(j.ctx, pk)
+}
+
+// KeyValue returns the iterator current primary key and the value it references.
+func (j JoinIterator[K, V, I, Idx]) KeyValue() (kv collections.KeyValue[K, V], err error) {
+	pk, err := j.iter.PrimaryKey()
+	if err != nil {
+		return kv, err
+	}
+	value, err := j.indexedMap.Get(j.ctx, pk)
+	if err != nil {
+		return kv, err
+	}
+	return collections.KeyValue[K, V]{Key: pk, Value: value}, nil
+}
+
+// Values fully consumes the iterator and returns all the referenced values.
+func (j JoinIterator[K, V, I, Idx]) Values() ([]V, error) {
+	return CollectValues(j.ctx, j.indexedMap, j.iter)
+}
+
+// KeyValues fully consumes the iterator and returns all the primary keys
+// and the values they reference.
+func (j JoinIterator[K, V, I, Idx]) KeyValues() ([]collections.KeyValue[K, V], error) {
+	return CollectKeyValues(j.ctx, j.indexedMap, j.iter)
+}
+
+// Next advances the iterator.
+func (j JoinIterator[K, V, I, Idx]) Next() { j.iter.Next() }
+
+// Valid asserts if the iterator is still valid or not.
+func (j JoinIterator[K, V, I, Idx]) Valid() bool { return j.iter.Valid() }
+
+// Close closes the iterator.
+func (j JoinIterator[K, V, I, Idx]) Close() error { return j.iter.Close() }
+
+var (
+	_ Iterable[collections.Pair[string, uint64], uint64]                           = (*Multi[string, uint64, any])(nil)
+	_ Iterable[string, uint64]                                                     = (*Unique[string, uint64, any])(nil)
+	_ Iterable[collections.Pair[uint64, string], collections.Pair[string, uint64]] = (*ReversePair[string, uint64, any])(nil)
+)
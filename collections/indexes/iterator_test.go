@@ -0,0 +1,121 @@
+package indexes
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+)
+
+type ownerIndex struct {
+	Owner *Multi[string, uint64, string]
+}
+
+func (o ownerIndex) IndexesList() []collections.Index[uint64, string] {
+	return []collections.Index[uint64, string]{o.Owner}
+}
+
+func TestJoinIterator(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+
+	// objects are stored as ID => owner
+	indexedMap := collections.NewIndexedMap(
+		sb, collections.NewPrefix("objects"), "objects",
+		collections.Uint64Key, collections.StringValue,
+		ownerIndex{
+			Owner: NewMulti(sb, collections.NewPrefix("owner_index"), "owner_index", collections.StringKey, collections.Uint64Key, func(_ uint64, owner string) (string, error) {
+				return owner, nil
+			}),
+		},
+	)
+
+	require.NoError(t, indexedMap.Set(ctx, 1, "alice"))
+	require.NoError(t, indexedMap.Set(ctx, 2, "bob"))
+	require.NoError(t, indexedMap.Set(ctx, 3, "alice"))
+
+	iter, err := indexedMap.Indexes.Owner.MatchExact(ctx, "alice")
+	require.NoError(t, err)
+	joined := NewJoinIterator(ctx, indexedMap, iter)
+
+	pk, err := joined.PrimaryKey()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pk)
+
+	indexKey, err := joined.IndexIterator().IndexKey()
+	require.NoError(t, err)
+	require.Equal(t, collections.Join("alice", uint64(1)), indexKey)
+
+	value, err := joined.Value()
+	require.NoError(t, err)
+	require.Equal(t, "alice", value)
+
+	joined.Next()
+	kv, err := joined.KeyValue()
+	require.NoError(t, err)
+	require.Equal(t, collections.KeyValue[uint64, string]{Key: 3, Value: "alice"}, kv)
+
+	joined.Next()
+	require.False(t, joined.Valid())
+	require.NoError(t, joined.Close())
+
+	// values are resolved from the indexed map
+	iter, err = indexedMap.Indexes.Owner.Iterate(ctx, nil)
+	require.NoError(t, err)
+	kvs, err := NewJoinIterator(ctx, indexedMap, iter).KeyValues()
+	require.NoError(t, err)
+	require.Equal(t, []collections.KeyValue[uint64, string]{
+		{Key: 1, Value: "alice"},
+		{Key: 3, Value: "alice"},
+		{Key: 2, Value: "bob"},
+	}, kvs)
+}
+
+func TestIterateIndexRaw(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+
+	multi := NewMulti(sb, collections.NewPrefix("multi"), "multi", collections.StringKey, collections.Uint64Key, func(_ uint64, v string) (string, error) {
+		return v, nil
+	})
+	unique := NewUnique(sb, collections.NewPrefix("unique"), "unique", collections.StringKey, collections.Uint64Key, func(_ uint64, v string) (string, error) {
+		return v, nil
+	})
+	pairCodec := collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)
+	reversePair := NewReversePair[string](sb, collections.NewPrefix("reverse"), "reverse", pairCodec)
+
+	noValue := func() (string, error) { return "", collections.ErrNotFound }
+	require.NoError(t, multi.Reference(ctx, 1, "a", noValue))
+	require.NoError(t, multi.Reference(ctx, 2, "b", noValue))
+	require.NoError(t, unique.Reference(ctx, 1, "a", noValue))
+	require.NoError(t, unique.Reference(ctx, 2, "b", noValue))
+	require.NoError(t, reversePair.Reference(ctx, collections.Join("a", uint64(2)), "", noValue))
+	require.NoError(t, reversePair.Reference(ctx, collections.Join("b", uint64(1)), "", noValue))
+
+	require.Equal(t,
+		[]collections.Pair[string, uint64]{collections.Join("b", uint64(2)), collections.Join("a", uint64(1))},
+		collectIndexKeys(t, ctx, multi, collections.OrderDescending),
+	)
+	require.Equal(t, []string{"a", "b"}, collectIndexKeys(t, ctx, unique, collections.OrderAscending))
+	require.Equal(t,
+		[]collections.Pair[uint64, string]{collections.Join(uint64(1), "b"), collections.Join(uint64(2), "a")},
+		collectIndexKeys(t, ctx, reversePair, collections.OrderAscending),
+	)
+}
+
+func collectIndexKeys[IK, PK any](t *testing.T, ctx context.Context, index Iterable[IK, PK], order collections.Order) []IK {
+	t.Helper()
+	iter, err := index.IterateIndexRaw(ctx, nil, nil, order)
+	require.NoError(t, err)
+	defer iter.Close()
+
+	var keys []IK
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.IndexKey()
+		require.NoError(t, err)
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -106,6 +106,15 @@ func (m *Multi[ReferenceKey, PrimaryKey, Value]) Iterate(ctx context.Context, ra
 	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), err
 }
 
+// IterateIndexRaw iterates over the index using raw bytes keys, it implements Iterable.
+func (m *Multi[ReferenceKey, PrimaryKey, Value]) IterateIndexRaw(ctx context.Context, start, end []byte, order collections.Order) (Iterator[collections.Pair[ReferenceKey, PrimaryKey], PrimaryKey], error) {
+	iter, err := m.refKeys.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		return nil, err
+	}
+	return (MultiIterator[ReferenceKey, PrimaryKey])(iter), nil
+}
+
 func (m *Multi[ReferenceKey, PrimaryKey, Value]) Walk(
 	ctx context.Context,
 	ranger collections.Ranger[collections.Pair[ReferenceKey, PrimaryKey]],
@@ -157,6 +166,11 @@ func (i MultiIterator[ReferenceKey, PrimaryKey]) FullKeys() ([]collections.Pair[
 	return (collections.KeySetIterator[collections.Pair[ReferenceKey, PrimaryKey]])(i).Keys()
 }
 
+// IndexKey returns the current index key, which is the full reference key, it implements Iterator.
+func (i MultiIterator[ReferenceKey, PrimaryKey]) IndexKey() (collections.Pair[ReferenceKey, PrimaryKey], error) {
+	return i.FullKey()
+}
+
 // Next advances the iterator.
 func (i MultiIterator[ReferenceKey, PrimaryKey]) Next() {
 	(collections.KeySetIterator[collections.Pair[ReferenceKey, PrimaryKey]])(i).Next()
@@ -108,6 +108,17 @@ func (i *ReversePair[K1, K2, Value]) IterateRaw(
 	return i.refKeys.IterateRaw(ctx, start, end, order)
 }
 
+// IterateIndexRaw iterates over the index using raw bytes keys, it implements Iterable.
+func (i *ReversePair[K1, K2, Value]) IterateIndexRaw(
+	ctx context.Context, start, end []byte, order collections.Order,
+) (Iterator[collections.Pair[K2, K1], collections.Pair[K1, K2]], error) {
+	iter, err := i.refKeys.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		return nil, err
+	}
+	return (ReversePairIterator[K2, K1])(iter), nil
+}
+
 func (i *ReversePair[K1, K2, Value]) KeyCodec() codec.KeyCodec[collections.Pair[K2, K1]] {
 	return i.refKeys.KeyCodec()
 }
@@ -144,6 +155,11 @@ func (m ReversePairIterator[K2, K1]) FullKey() (p collections.Pair[K2, K1], err
 	return (collections.KeySetIterator[collections.Pair[K2, K1]])(m).Key()
 }
 
+// IndexKey returns the current index key, which is the reversed pair key, it implements Iterator.
+func (m ReversePairIterator[K2, K1]) IndexKey() (collections.Pair[K2, K1], error) {
+	return m.FullKey()
+}
+
 func (m ReversePairIterator[K2, K1]) Next() {
 	(collections.KeySetIterator[collections.Pair[K2, K1]])(m).Next()
 }
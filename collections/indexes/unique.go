@@ -103,6 +103,20 @@ func (i *Unique[ReferenceKey, PrimaryKey, Value]) IterateRaw(ctx context.Context
 	return (UniqueIterator[ReferenceKey, PrimaryKey])(iter), nil
 }
 
+// IterateIndexRaw iterates over the index using raw bytes keys, it implements Iterable.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) IterateIndexRaw(ctx context.Context, start, end []byte, order collections.Order) (Iterator[ReferenceKey, PrimaryKey], error) {
+	iter, err := i.IterateRaw(ctx, start, end, order)
+	if err != nil {
+		return nil, err
+	}
+	return iter, nil
+}
+
+// KeyCodec returns the codec of the reference keys.
+func (i *Unique[ReferenceKey, PrimaryKey, Value]) KeyCodec() codec.KeyCodec[ReferenceKey] {
+	return i.refKeys.KeyCodec()
+}
+
 // UniqueIterator is an Iterator wrapper, that exposes only the functionality needed to work with Unique keys.
 type UniqueIterator[ReferenceKey, PrimaryKey any] collections.Iterator[ReferenceKey, PrimaryKey]
 
@@ -135,6 +149,11 @@ func (i UniqueIterator[ReferenceKey, PrimaryKey]) FullKeys() ([]collections.Pair
 	return pairKeys, nil
 }
 
+// IndexKey returns the iterator's current reference key, it implements Iterator.
+func (i UniqueIterator[ReferenceKey, PrimaryKey]) IndexKey() (ReferenceKey, error) {
+	return (collections.Iterator[ReferenceKey, PrimaryKey])(i).Key()
+}
+
 func (i UniqueIterator[ReferenceKey, PrimaryKey]) Next() {
 	(collections.Iterator[ReferenceKey, PrimaryKey])(i).Next()
 }
@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/go-bip39"
 	"gitlab.com/yawning/secp256k1-voi/secec"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 )
@@ -21,10 +22,17 @@ const (
 	Ed25519Type = PubKeyType("ed25519")
 	// Sr25519Type represents the Sr25519Type signature system.
 	Sr25519Type = PubKeyType("sr25519")
+	// Bls12_381Type represents the BLS signature system on the BLS12-381 curve.
+	Bls12_381Type = PubKeyType("bls12_381")
 )
 
-// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
-var Secp256k1 = secp256k1Algo{}
+var (
+	// Secp256k1 uses the Bitcoin secp256k1 ECDSA parameters.
+	Secp256k1 = secp256k1Algo{}
+	// Bls12_381 uses BLS signatures on the BLS12-381 curve. It requires the
+	// bls12381 build tag, see bls12_381.Enabled.
+	Bls12_381 = bls12_381Algo{}
+)
 
 type (
 	DeriveFn   func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error)
@@ -74,3 +82,42 @@ func (s secp256k1Algo) Generate() GenerateFn {
 		return &secp256k1.PrivKey{Key: privKeyObj.Bytes()}
 	}
 }
+
+type bls12_381Algo struct{}
+
+func (s bls12_381Algo) Name() PubKeyType {
+	return Bls12_381Type
+}
+
+// Derive derives and returns the BLS12-381 private key for the given seed and
+// HD path, following EIP-2333. See DeriveBLSKeyForPath for the HD path format.
+func (s bls12_381Algo) Derive() DeriveFn {
+	return func(mnemonic, bip39Passphrase, hdPath string) ([]byte, error) {
+		seed, err := bip39.NewSeedWithErrorChecking(mnemonic, bip39Passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		masterKey, err := DeriveBLSMasterKey(seed)
+		if err != nil {
+			return nil, err
+		}
+		if len(hdPath) == 0 {
+			return masterKey, nil
+		}
+
+		return DeriveBLSKeyForPath(masterKey, hdPath)
+	}
+}
+
+// Generate generates a BLS12-381 private key from the given secret scalar bytes.
+func (s bls12_381Algo) Generate() GenerateFn {
+	return func(bz []byte) types.PrivKey {
+		privKey, err := bls12_381.NewPrivKeyFromBytes(bz)
+		if err != nil {
+			panic(err)
+		}
+
+		return privKey
+	}
+}
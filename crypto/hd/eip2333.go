@@ -0,0 +1,125 @@
+package hd
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+// This file implements the BLS12-381 key derivation of EIP-2333:
+// https://eips.ethereum.org/EIPS/eip-2333
+
+const (
+	// eip2333LamportChunks is the number of 32 bytes chunks of a Lamport secret key.
+	eip2333LamportChunks = 255
+	// eip2333OKMLength is the length of the output keying material of HKDF_mod_r,
+	// ceil((3 * ceil(log2(r))) / 16).
+	eip2333OKMLength = 48
+)
+
+// bls12381Order is the order r of the BLS12-381 G1 and G2 subgroups.
+var bls12381Order, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// DeriveBLSMasterKey derives the EIP-2333 master secret key from a seed of at
+// least 32 bytes. The key is returned as a 32 bytes big-endian scalar.
+func DeriveBLSMasterKey(seed []byte) ([]byte, error) {
+	if len(seed) < 32 {
+		return nil, errors.New("seed must be at least 32 bytes")
+	}
+
+	return hkdfModR(seed), nil
+}
+
+// DeriveBLSChildKey derives the EIP-2333 child secret key of the given index
+// from a parent secret key, both being 32 bytes big-endian scalars.
+func DeriveBLSChildKey(parentKey []byte, index uint32) ([]byte, error) {
+	if len(parentKey) != 32 {
+		return nil, fmt.Errorf("parent key must be 32 bytes, got %d", len(parentKey))
+	}
+
+	return hkdfModR(parentKeyToLamportPK(parentKey, index)), nil
+}
+
+// DeriveBLSKeyForPath derives the EIP-2333 secret key of the given path from a
+// master secret key. The path is a BIP44 like path, e.g. m/12381/118/0/0 as
+// specified by EIP-2334, where every index is derived the same way: the
+// hardened marker "'" is allowed but ignored, as EIP-2333 only defines
+// hardened derivations.
+func DeriveBLSKeyForPath(masterKey []byte, path string) ([]byte, error) {
+	parts := strings.Split(path, "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("path must start with m: %s", path)
+	}
+
+	key := masterKey
+	for _, part := range parts[1:] {
+		index, err := strconv.ParseUint(strings.TrimSuffix(part, "'"), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path index %q: %w", part, err)
+		}
+
+		if key, err = DeriveBLSChildKey(key, uint32(index)); err != nil {
+			return nil, err
+		}
+	}
+
+	return key, nil
+}
+
+// hkdfModR derives a non zero secret key, smaller than r, from the input keying material.
+func hkdfModR(ikm []byte) []byte {
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	// IKM || I2OSP(0, 1)
+	ikm = append(append([]byte{}, ikm...), 0)
+	// key_info || I2OSP(L, 2), with an empty key_info
+	info := []byte{0, eip2333OKMLength}
+
+	sk := new(big.Int)
+	for sk.Sign() == 0 {
+		saltHash := sha256.Sum256(salt)
+		salt = saltHash[:]
+
+		okm := make([]byte, eip2333OKMLength)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, info), okm); err != nil {
+			// the output length is far below the HKDF limit
+			panic(err)
+		}
+		sk.SetBytes(okm).Mod(sk, bls12381Order)
+	}
+
+	return sk.FillBytes(make([]byte, 32))
+}
+
+// parentKeyToLamportPK returns the compressed Lamport public key used to derive
+// the child secret key of the given index.
+func parentKeyToLamportPK(parentKey []byte, index uint32) []byte {
+	salt := binary.BigEndian.AppendUint32(nil, index)
+
+	notIKM := make([]byte, len(parentKey))
+	for i, b := range parentKey {
+		notIKM[i] = ^b
+	}
+
+	lamportPK := sha256.New()
+	for _, ikm := range [][]byte{parentKey, notIKM} {
+		lamportSK := hkdf.New(sha256.New, ikm, salt, nil)
+		chunk := make([]byte, 32)
+		for i := 0; i < eip2333LamportChunks; i++ {
+			if _, err := io.ReadFull(lamportSK, chunk); err != nil {
+				// 255 chunks of 32 bytes is exactly the HKDF limit
+				panic(err)
+			}
+			chunkHash := sha256.Sum256(chunk)
+			lamportPK.Write(chunkHash[:])
+		}
+	}
+
+	return lamportPK.Sum(nil)
+}
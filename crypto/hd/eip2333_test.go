@@ -0,0 +1,100 @@
+package hd_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/cosmos/go-bip39"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+)
+
+// test vectors of https://eips.ethereum.org/EIPS/eip-2333#test-cases
+func TestDeriveBLSKeys(t *testing.T) {
+	testCases := []struct {
+		seed       string
+		masterKey  string
+		childIndex uint32
+		childKey   string
+	}{
+		{
+			seed:       "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+			masterKey:  "6083874454709270928345386274498605044986640685124978867557563392430687146096",
+			childIndex: 0,
+			childKey:   "20397789859736650942317412262472558107875392172444076792671091975210932703118",
+		},
+		{
+			seed:       "3141592653589793238462643383279502884197169399375105820974944592",
+			masterKey:  "29757020647961307431480504535336562678282505419141012933316116377660817309383",
+			childIndex: 3141592653,
+			childKey:   "25457201688850691947727629385191704516744796114925897962676248250929345014287",
+		},
+		{
+			seed:       "0099ff991111002299dd7744ee3355bbdd8844115566cc55663355668888cc00",
+			masterKey:  "27580842291869792442942448775674722299803720648445448686099262467207037398656",
+			childIndex: 4294967295,
+			childKey:   "29358610794459428860402234341874281240803786294062035874021252734817515685787",
+		},
+		{
+			seed:       "d4e56740f876aef8c010b86a40d5f56745a118d0906a34e69aec8c0db1cb8fa3",
+			masterKey:  "19022158461524446591288038168518313374041767046816487870552872741050760015818",
+			childIndex: 42,
+			childKey:   "31372231650479070279774297061823572166496564838472787488249775572789064611981",
+		},
+	}
+
+	toInt := func(bz []byte) string { return new(big.Int).SetBytes(bz).String() }
+
+	for _, tc := range testCases {
+		seed, err := hex.DecodeString(tc.seed)
+		require.NoError(t, err)
+
+		masterKey, err := hd.DeriveBLSMasterKey(seed)
+		require.NoError(t, err)
+		require.Len(t, masterKey, 32)
+		require.Equal(t, tc.masterKey, toInt(masterKey))
+
+		childKey, err := hd.DeriveBLSChildKey(masterKey, tc.childIndex)
+		require.NoError(t, err)
+		require.Equal(t, tc.childKey, toInt(childKey))
+	}
+}
+
+func TestDeriveBLSKeyForPath(t *testing.T) {
+	mnemonic := "equip will roof matter pink blind book anxiety banner elbow sun young"
+	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, "")
+	require.NoError(t, err)
+	masterKey, err := hd.DeriveBLSMasterKey(seed)
+	require.NoError(t, err)
+
+	expected := masterKey
+	for _, index := range []uint32{12381, 118, 0, 0} {
+		expected, err = hd.DeriveBLSChildKey(expected, index)
+		require.NoError(t, err)
+	}
+
+	// all the EIP-2333 derivations are hardened, the marker is ignored
+	for _, path := range []string{"m/12381/118/0/0", "m/12381'/118'/0'/0"} {
+		key, err := hd.DeriveBLSKeyForPath(masterKey, path)
+		require.NoError(t, err)
+		require.Equal(t, expected, key)
+
+		key, err = hd.Bls12_381.Derive()(mnemonic, "", path)
+		require.NoError(t, err)
+		require.Equal(t, expected, key)
+	}
+
+	key, err := hd.Bls12_381.Derive()(mnemonic, "", "")
+	require.NoError(t, err)
+	require.Equal(t, masterKey, key)
+
+	for _, path := range []string{"12381/118", "m/12381/x", "m/4294967296", "m//0"} {
+		_, err := hd.DeriveBLSKeyForPath(masterKey, path)
+		require.Error(t, err, path)
+	}
+
+	_, err = hd.DeriveBLSMasterKey(seed[:31])
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/ledger"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -222,8 +223,12 @@ type keystore struct {
 func newKeystore(kr keyring.Keyring, cdc codec.Codec, backend string, opts ...Option) keystore {
 	// Default options for keybase, these can be overwritten using the
 	// Option function
+	supportedAlgos := SigningAlgoList{hd.Secp256k1}
+	if bls12_381.Enabled {
+		supportedAlgos = append(supportedAlgos, hd.Bls12_381)
+	}
 	options := Options{
-		SupportedAlgos:       SigningAlgoList{hd.Secp256k1},
+		SupportedAlgos:       supportedAlgos,
 		SupportedAlgosLedger: SigningAlgoList{hd.Secp256k1},
 	}
 
@@ -0,0 +1,42 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package keyring
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestInMemoryBls12381(t *testing.T) {
+	cdc := getCodec()
+	cstore := NewInMemory(cdc)
+
+	supported, _ := cstore.SupportedAlgorithms()
+	require.True(t, supported.Contains(hd.Bls12_381))
+
+	k, mnemonic, err := cstore.NewMnemonic("bls", English, sdk.FullFundraiserPath, DefaultBIP39Passphrase, hd.Bls12_381)
+	require.NoError(t, err)
+	pubKey, err := k.GetPubKey()
+	require.NoError(t, err)
+	require.IsType(t, &bls12_381.PubKey{}, pubKey)
+
+	msg := []byte("message to sign")
+	sig, signer, err := cstore.Sign("bls", msg, signing.SignMode_SIGN_MODE_DIRECT)
+	require.NoError(t, err)
+	require.True(t, signer.Equals(pubKey))
+	require.True(t, pubKey.VerifySignature(msg, sig))
+
+	// the key is recovered from the mnemonic
+	require.NoError(t, cstore.Delete("bls"))
+	k1, err := cstore.NewAccount("bls-imported", mnemonic, DefaultBIP39Passphrase, sdk.FullFundraiserPath, hd.Bls12_381)
+	require.NoError(t, err)
+	pubKey1, err := k1.GetPubKey()
+	require.NoError(t, err)
+	require.True(t, pubKey1.Equals(pubKey))
+}
@@ -0,0 +1,42 @@
+//go:build !(((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381)
+
+package bls12_381
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// AggregateSignatures returns ErrDisabled.
+func AggregateSignatures([][]byte) ([]byte, error) {
+	return nil, ErrDisabled
+}
+
+// VerifyAggregateSignature always returns false.
+func VerifyAggregateSignature([]*PubKey, [][]byte, []byte) bool {
+	return false
+}
+
+var _ cryptotypes.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier never accepts any signature when BLS12-381 is disabled.
+type BatchVerifier struct{}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add returns ErrDisabled.
+func (b *BatchVerifier) Add(cryptotypes.PubKey, []byte, []byte) error {
+	return ErrDisabled
+}
+
+// Len always returns 0.
+func (b *BatchVerifier) Len() int {
+	return 0
+}
+
+// Verify always returns false.
+func (b *BatchVerifier) Verify() bool {
+	return false
+}
@@ -0,0 +1,154 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	blst "github.com/supranational/blst/bindings/go"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+// AggregateSignatures aggregates the given signatures into a single signature,
+// which can be verified with VerifyAggregateSignature.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures to aggregate")
+	}
+	for i, sig := range sigs {
+		if len(sig) != SignatureSize {
+			return nil, fmt.Errorf("invalid signature size at index %d", i)
+		}
+	}
+
+	agg := new(blst.P2Aggregate)
+	if !agg.AggregateCompressed(sigs, true) {
+		return nil, errors.New("invalid signature")
+	}
+
+	return agg.ToAffine().Compress(), nil
+}
+
+// VerifyAggregateSignature verifies that aggSig is the aggregate of the
+// signatures of msgs[i] by pubKeys[i], for every i, with a single
+// multi-pairing check.
+//
+// The signed messages must be distinct, which protects against rogue key
+// attacks without requiring a proof of possession of the keys.
+func VerifyAggregateSignature(pubKeys []*PubKey, msgs [][]byte, aggSig []byte) bool {
+	if len(pubKeys) == 0 || len(pubKeys) != len(msgs) || len(aggSig) != SignatureSize {
+		return false
+	}
+
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	signedMsgs := make([]blst.Message, len(msgs))
+	seen := make(map[string]struct{}, len(msgs))
+	for i, pubKey := range pubKeys {
+		pks[i] = new(blst.P1Affine).Uncompress(pubKey.Key)
+		if pks[i] == nil {
+			return false
+		}
+
+		signedMsgs[i] = signedMessage(msgs[i])
+		if _, ok := seen[string(signedMsgs[i])]; ok {
+			return false
+		}
+		seen[string(signedMsgs[i])] = struct{}{}
+	}
+
+	sig := new(blst.P2Affine).Uncompress(aggSig)
+	if sig == nil {
+		return false
+	}
+
+	return sig.AggregateVerify(true, pks, true, signedMsgs, dst)
+}
+
+var _ cryptotypes.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier collects BLS12-381 signatures so that they are verified at
+// once, with a single multi-pairing check.
+//
+// Unlike VerifyAggregateSignature, each signature is bound to its own key and
+// message: every signature and key is multiplied by a random 64 bits scalar
+// before being aggregated. A plain aggregate would still verify if signatures
+// were swapped between the keys, whereas such a batch only verifies with a
+// probability of 2^-64. A batch of valid signatures always verifies.
+type BatchVerifier struct {
+	pubKeys []*PubKey
+	msgs    [][]byte
+	sigs    [][]byte
+	seen    map[string]struct{}
+}
+
+// NewBatchVerifier returns an empty BatchVerifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{seen: make(map[string]struct{})}
+}
+
+// Add implements cryptotypes.BatchVerifier. It only accepts BLS12-381 keys,
+// and rejects a message which has already been added to the batch, in which
+// case the signature must be verified on its own.
+func (b *BatchVerifier) Add(key cryptotypes.PubKey, msg, sig []byte) error {
+	pubKey, ok := key.(*PubKey)
+	if !ok {
+		return fmt.Errorf("unsupported key type %T", key)
+	}
+	if len(pubKey.Key) != PubKeySize {
+		return errors.New("invalid pubkey size")
+	}
+	if len(sig) != SignatureSize {
+		return errors.New("invalid signature size")
+	}
+	signedMsg := string(signedMessage(msg))
+	if _, ok := b.seen[signedMsg]; ok {
+		return errors.New("duplicate message")
+	}
+
+	b.seen[signedMsg] = struct{}{}
+	b.pubKeys = append(b.pubKeys, pubKey)
+	b.msgs = append(b.msgs, msg)
+	b.sigs = append(b.sigs, sig)
+	return nil
+}
+
+// Len returns the number of signatures in the batch.
+func (b *BatchVerifier) Len() int {
+	return len(b.sigs)
+}
+
+// Verify implements cryptotypes.BatchVerifier. An empty batch is not valid.
+func (b *BatchVerifier) Verify() bool {
+	if len(b.sigs) == 0 {
+		return false
+	}
+
+	pks := make([]*blst.P1Affine, len(b.pubKeys))
+	sigs := make([]*blst.P2Affine, len(b.sigs))
+	msgs := make([]blst.Message, len(b.msgs))
+	for i := range b.sigs {
+		pks[i] = new(blst.P1Affine).Uncompress(b.pubKeys[i].Key)
+		sigs[i] = new(blst.P2Affine).Uncompress(b.sigs[i])
+		if pks[i] == nil || sigs[i] == nil {
+			return false
+		}
+		msgs[i] = signedMessage(b.msgs[i])
+	}
+
+	// randScalar is called concurrently by blst
+	randScalar := func(s *blst.Scalar) {
+		var bz [blst.BLST_SCALAR_BYTES]byte
+		if _, err := rand.Read(bz[blst.BLST_SCALAR_BYTES-batchRandBits/8:]); err != nil {
+			panic(err)
+		}
+		s.FromBEndian(bz[:])
+	}
+
+	return new(blst.P2Affine).MultipleAggregateVerify(sigs, true, pks, true, msgs, dst, randScalar, batchRandBits)
+}
+
+// batchRandBits is the number of bits of the random scalars of a BatchVerifier.
+const batchRandBits = 64
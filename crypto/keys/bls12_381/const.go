@@ -0,0 +1,27 @@
+package bls12_381
+
+import (
+	"errors"
+
+	"github.com/cometbft/cometbft/crypto/bls12381"
+)
+
+const (
+	PrivKeyName = bls12381.PrivKeyName
+	PubKeyName  = bls12381.PubKeyName
+	// PubKeySize is the size, in bytes, of a compressed public key.
+	PubKeySize = bls12381.PubKeySize
+	// PrivKeySize is the size, in bytes, of a private key.
+	PrivKeySize = bls12381.PrivKeySize
+	// SignatureSize is the size, in bytes, of a compressed signature.
+	SignatureSize = bls12381.SignatureLength
+	// MaxMsgLen is the maximum length of a message signed as is, longer
+	// messages are hashed with SHA-256 before being signed.
+	MaxMsgLen = bls12381.MaxMsgLen
+
+	keyType = bls12381.KeyType
+)
+
+// ErrDisabled is returned by the key operations when the package is built
+// without the `bls12381` build tag or on an unsupported platform.
+var ErrDisabled = errors.New("bls12_381 is disabled")
@@ -0,0 +1,13 @@
+// Package bls12_381 implements Cosmos-SDK compatible BLS12-381 public and
+// private keys, using the min-pubkey-size variant of the signature scheme: public
+// keys live on G1 and signatures on G2. The keys can be protobuf serialized and
+// packed in Any.
+//
+// Signatures of several keys can be aggregated and verified with a single
+// pairing check, see AggregateSignatures, VerifyAggregateSignature and
+// BatchVerifier.
+//
+// The implementation is backed by the blst library and requires cgo and the
+// `bls12381` build tag on a supported platform. Otherwise Enabled is false and
+// the keys can only be decoded, every cryptographic operation failing.
+package bls12_381
@@ -0,0 +1,48 @@
+//go:build !(((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381)
+
+package bls12_381
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Enabled indicates if BLS12-381 keys are supported by this build.
+const Enabled = false
+
+// Sign returns ErrDisabled.
+func (privKey *PrivKey) Sign([]byte) ([]byte, error) {
+	return nil, ErrDisabled
+}
+
+// PubKey always panics.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	panic(ErrDisabled)
+}
+
+// GenPrivKey returns ErrDisabled.
+func GenPrivKey() (*PrivKey, error) {
+	return nil, ErrDisabled
+}
+
+// GenPrivKeyFromSecret returns ErrDisabled.
+func GenPrivKeyFromSecret([]byte) (*PrivKey, error) {
+	return nil, ErrDisabled
+}
+
+// NewPrivKeyFromBytes returns ErrDisabled.
+func NewPrivKeyFromBytes([]byte) (*PrivKey, error) {
+	return nil, ErrDisabled
+}
+
+// VerifySignature always returns false.
+func (pubKey *PubKey) VerifySignature([]byte, []byte) bool {
+	return false
+}
+
+// Validate returns ErrDisabled.
+func (pubKey *PubKey) Validate() error {
+	return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, ErrDisabled.Error())
+}
@@ -0,0 +1,135 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+
+	"github.com/cometbft/cometbft/crypto"
+	blst "github.com/supranational/blst/bindings/go"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Enabled indicates if BLS12-381 keys are supported by this build.
+const Enabled = true
+
+// dst is the domain separation tag of the proof of possession ciphersuite,
+// the one used by CometBFT.
+var dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+// Sign produces a signature on the provided message. Messages longer than
+// MaxMsgLen are hashed with SHA-256 before being signed.
+func (privKey *PrivKey) Sign(msg []byte) ([]byte, error) {
+	sk := new(blst.SecretKey).Deserialize(privKey.Key)
+	if sk == nil {
+		return nil, errors.New("invalid bls12_381 private key")
+	}
+	defer sk.Zeroize()
+
+	return new(blst.P2Affine).Sign(sk, signedMessage(msg), dst).Compress(), nil
+}
+
+// PubKey gets the corresponding public key from the private key.
+//
+// Panics if the private key is not valid.
+func (privKey *PrivKey) PubKey() cryptotypes.PubKey {
+	sk := new(blst.SecretKey).Deserialize(privKey.Key)
+	if sk == nil {
+		panic("invalid bls12_381 private key")
+	}
+	defer sk.Zeroize()
+
+	return &PubKey{Key: new(blst.P1Affine).From(sk).Compress()}
+}
+
+// GenPrivKey generates a new BLS12-381 private key using OS randomness.
+func GenPrivKey() (*PrivKey, error) {
+	return genPrivKey(crypto.CReader())
+}
+
+// genPrivKey generates a new BLS12-381 private key using the provided reader.
+func genPrivKey(rand io.Reader) (*PrivKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		return nil, err
+	}
+
+	return GenPrivKeyFromSecret(ikm)
+}
+
+// GenPrivKeyFromSecret hashes the secret with SHA2, and uses that 32 byte
+// output as the input keying material of the BLS key generation.
+// NOTE: secret should be the output of a KDF like bcrypt,
+// if it's derived from user input.
+func GenPrivKeyFromSecret(secret []byte) (*PrivKey, error) {
+	ikm := sha256.Sum256(secret)
+	sk := blst.KeyGen(ikm[:])
+	if sk == nil {
+		return nil, errors.New("bls12_381 key generation failed")
+	}
+	defer sk.Zeroize()
+
+	return &PrivKey{Key: sk.Serialize()}, nil
+}
+
+// NewPrivKeyFromBytes returns the private key of the given 32 bytes big-endian
+// secret scalar, e.g. derived with EIP-2333.
+func NewPrivKeyFromBytes(bz []byte) (*PrivKey, error) {
+	if len(bz) != PrivKeySize {
+		return nil, errors.New("invalid bls12_381 private key size")
+	}
+	sk := new(blst.SecretKey).Deserialize(bz)
+	if sk == nil {
+		return nil, errors.New("invalid bls12_381 private key")
+	}
+	defer sk.Zeroize()
+
+	return &PrivKey{Key: sk.Serialize()}, nil
+}
+
+// VerifySignature verifies the signature of msg, see Sign.
+func (pubKey *PubKey) VerifySignature(msg, sig []byte) bool {
+	if len(sig) != SignatureSize {
+		return false
+	}
+	pk := new(blst.P1Affine).Uncompress(pubKey.Key)
+	if pk == nil {
+		return false
+	}
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil {
+		return false
+	}
+
+	return signature.Verify(true, pk, true, signedMessage(msg), dst)
+}
+
+// Validate returns an error if the public key is not a valid point of the G1
+// subgroup, or is the identity.
+func (pubKey *PubKey) Validate() error {
+	if len(pubKey.Key) != PubKeySize {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid bls12_381 pubkey size")
+	}
+	pk := new(blst.P1Affine).Uncompress(pubKey.Key)
+	if pk == nil || !pk.KeyValidate() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "invalid bls12_381 pubkey")
+	}
+
+	return nil
+}
+
+// signedMessage returns the message actually signed for msg: messages longer
+// than MaxMsgLen are hashed with SHA-256, as done by CometBFT.
+func signedMessage(msg []byte) []byte {
+	if len(msg) > MaxMsgLen {
+		hash := sha256.Sum256(msg)
+		return hash[:]
+	}
+	return msg
+}
@@ -0,0 +1,31 @@
+//go:build !(((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381)
+
+package bls12_381_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+)
+
+func TestDisabled(t *testing.T) {
+	require.False(t, bls12_381.Enabled)
+
+	_, err := bls12_381.GenPrivKey()
+	require.ErrorIs(t, err, bls12_381.ErrDisabled)
+	_, err = bls12_381.GenPrivKeyFromSecret([]byte("secret"))
+	require.ErrorIs(t, err, bls12_381.ErrDisabled)
+	_, err = (&bls12_381.PrivKey{Key: make([]byte, bls12_381.PrivKeySize)}).Sign([]byte("msg"))
+	require.ErrorIs(t, err, bls12_381.ErrDisabled)
+
+	pubKey := &bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)}
+	require.False(t, pubKey.VerifySignature([]byte("msg"), make([]byte, bls12_381.SignatureSize)))
+	require.Error(t, pubKey.Validate())
+
+	batch := bls12_381.NewBatchVerifier()
+	require.ErrorIs(t, batch.Add(pubKey, []byte("msg"), make([]byte, bls12_381.SignatureSize)), bls12_381.ErrDisabled)
+	require.Zero(t, batch.Len())
+	require.False(t, batch.Verify())
+}
@@ -0,0 +1,255 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package bls12_381_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto"
+	cmtbls12381 "github.com/cometbft/cometbft/crypto/bls12381"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+)
+
+func genPrivKey(t *testing.T) *bls12_381.PrivKey {
+	t.Helper()
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+	return privKey
+}
+
+func TestSignAndValidateBLS12381(t *testing.T) {
+	require.True(t, bls12_381.Enabled)
+
+	privKey := genPrivKey(t)
+	pubKey := privKey.PubKey()
+	require.Len(t, pubKey.Bytes(), bls12_381.PubKeySize)
+	require.NoError(t, pubKey.(*bls12_381.PubKey).Validate())
+
+	for _, size := range []int{0, 16, bls12_381.MaxMsgLen, 1000} {
+		msg := crypto.CRandBytes(size)
+		sig, err := privKey.Sign(msg)
+		require.NoError(t, err)
+		require.Len(t, sig, bls12_381.SignatureSize)
+		require.True(t, pubKey.VerifySignature(msg, sig))
+
+		// mutate the message and the signature
+		require.False(t, pubKey.VerifySignature(append(msg, 0x01), sig))
+		sig[7] ^= byte(0x01)
+		require.False(t, pubKey.VerifySignature(msg, sig))
+	}
+
+	require.False(t, genPrivKey(t).PubKey().VerifySignature([]byte("msg"), make([]byte, bls12_381.SignatureSize)))
+}
+
+func TestCometBFTCompatibility(t *testing.T) {
+	privKey := genPrivKey(t)
+	cmtPrivKey, err := cmtbls12381.NewPrivateKeyFromBytes(privKey.Key)
+	require.NoError(t, err)
+	require.Equal(t, cmtPrivKey.PubKey().Bytes(), privKey.PubKey().Bytes())
+
+	msg := crypto.CRandBytes(1000)
+	sig, err := cmtPrivKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, privKey.PubKey().VerifySignature(msg, sig))
+
+	sig, err = privKey.Sign(msg)
+	require.NoError(t, err)
+	require.True(t, cmtPrivKey.PubKey().VerifySignature(msg, sig))
+	require.Equal(t, cmtPrivKey.PubKey().Address(), privKey.PubKey().Address())
+
+	pk, err := cryptocodec.FromCmtPubKeyInterface(cmtPrivKey.PubKey())
+	require.NoError(t, err)
+	require.True(t, pk.Equals(privKey.PubKey()))
+}
+
+func TestGenPrivKeyFromSecret(t *testing.T) {
+	privKey1, err := bls12_381.GenPrivKeyFromSecret([]byte("secret"))
+	require.NoError(t, err)
+	privKey2, err := bls12_381.GenPrivKeyFromSecret([]byte("secret"))
+	require.NoError(t, err)
+	privKey3, err := bls12_381.GenPrivKeyFromSecret([]byte("other secret"))
+	require.NoError(t, err)
+
+	require.Len(t, privKey1.Key, bls12_381.PrivKeySize)
+	require.True(t, privKey1.Equals(privKey2))
+	require.False(t, privKey1.Equals(privKey3))
+	require.False(t, privKey1.Equals(secp256k1.GenPrivKey()))
+}
+
+func TestPubKeyValidate(t *testing.T) {
+	require.Error(t, (&bls12_381.PubKey{Key: []byte{0x01}}).Validate())
+	require.Error(t, (&bls12_381.PubKey{Key: make([]byte, bls12_381.PubKeySize)}).Validate())
+
+	// the compressed point at infinity
+	infinity := make([]byte, bls12_381.PubKeySize)
+	infinity[0] = 0xc0
+	require.Error(t, (&bls12_381.PubKey{Key: infinity}).Validate())
+}
+
+func TestAggregateSignatures(t *testing.T) {
+	const n = 5
+	var (
+		pubKeys = make([]*bls12_381.PubKey, n)
+		msgs    = make([][]byte, n)
+		sigs    = make([][]byte, n)
+	)
+	for i := 0; i < n; i++ {
+		privKey := genPrivKey(t)
+		pubKeys[i] = privKey.PubKey().(*bls12_381.PubKey)
+		msgs[i] = crypto.CRandBytes(100)
+		sig, err := privKey.Sign(msgs[i])
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+
+	aggSig, err := bls12_381.AggregateSignatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, aggSig, bls12_381.SignatureSize)
+	require.True(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggSig))
+
+	// a single signature is its own aggregate
+	aggSig1, err := bls12_381.AggregateSignatures(sigs[:1])
+	require.NoError(t, err)
+	require.Equal(t, sigs[0], aggSig1)
+
+	// missing signature
+	aggSig2, err := bls12_381.AggregateSignatures(sigs[1:])
+	require.NoError(t, err)
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, msgs, aggSig2))
+
+	// swapped messages
+	swapped := append([][]byte{msgs[1], msgs[0]}, msgs[2:]...)
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, swapped, aggSig))
+
+	// duplicated messages are rejected
+	duplicated := append([][]byte{msgs[0], msgs[0]}, msgs[2:]...)
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys, duplicated, aggSig))
+
+	require.False(t, bls12_381.VerifyAggregateSignature(pubKeys[1:], msgs, aggSig))
+	require.False(t, bls12_381.VerifyAggregateSignature(nil, nil, aggSig))
+
+	_, err = bls12_381.AggregateSignatures(nil)
+	require.Error(t, err)
+	_, err = bls12_381.AggregateSignatures([][]byte{sigs[0], {0x01}})
+	require.Error(t, err)
+}
+
+func TestBatchVerifier(t *testing.T) {
+	newBatch := func(n int) (*bls12_381.BatchVerifier, []cryptotypes.PubKey, [][]byte, [][]byte) {
+		batch := bls12_381.NewBatchVerifier()
+		var (
+			pubKeys = make([]cryptotypes.PubKey, n)
+			msgs    = make([][]byte, n)
+			sigs    = make([][]byte, n)
+		)
+		for i := 0; i < n; i++ {
+			privKey := genPrivKey(t)
+			pubKeys[i] = privKey.PubKey()
+			msgs[i] = crypto.CRandBytes(100)
+			sig, err := privKey.Sign(msgs[i])
+			require.NoError(t, err)
+			sigs[i] = sig
+			require.NoError(t, batch.Add(pubKeys[i], msgs[i], sigs[i]))
+		}
+		return batch, pubKeys, msgs, sigs
+	}
+
+	batch, pubKeys, msgs, sigs := newBatch(3)
+	require.Equal(t, 3, batch.Len())
+	require.True(t, batch.Verify())
+
+	// unsupported key type and duplicate messages can't be batched
+	require.Error(t, batch.Add(secp256k1.GenPrivKey().PubKey(), []byte("msg"), sigs[0]))
+	require.Error(t, batch.Add(pubKeys[0], msgs[0], sigs[0]))
+	require.Error(t, batch.Add(pubKeys[0], []byte("msg"), []byte{0x01}))
+	require.Equal(t, 3, batch.Len())
+
+	// signatures swapped between the keys invalidate the batch, even though their aggregate is unchanged
+	batch, pubKeys, msgs, sigs = newBatch(3)
+	require.True(t, batch.Verify())
+	swapped := bls12_381.NewBatchVerifier()
+	for i, j := range []int{1, 0, 2} {
+		require.NoError(t, swapped.Add(pubKeys[i], msgs[i], sigs[j]))
+	}
+	require.False(t, swapped.Verify())
+
+	// a single invalid signature invalidates the batch
+	batch, pubKeys, _, sigs = newBatch(3)
+	require.NoError(t, batch.Add(pubKeys[0], []byte("other msg"), sigs[0]))
+	require.False(t, batch.Verify())
+
+	require.False(t, bls12_381.NewBatchVerifier().Verify())
+}
+
+func TestMarshalAmino(t *testing.T) {
+	aminoCdc := codec.NewLegacyAmino()
+	privKey := genPrivKey(t)
+	pubKey := privKey.PubKey().(*bls12_381.PubKey)
+
+	testCases := []struct {
+		desc      string
+		msg       codec.AminoMarshaler
+		typ       interface{}
+		expBinary []byte
+		expJSON   string
+	}{
+		{
+			"bls12_381 private key",
+			privKey,
+			&bls12_381.PrivKey{},
+			append([]byte{bls12_381.PrivKeySize}, privKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(privKey.Bytes()) + "\"",
+		},
+		{
+			"bls12_381 public key",
+			pubKey,
+			&bls12_381.PubKey{},
+			append([]byte{bls12_381.PubKeySize}, pubKey.Bytes()...), // Length-prefixed.
+			"\"" + base64.StdEncoding.EncodeToString(pubKey.Bytes()) + "\"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Do a round trip of encoding/decoding binary.
+			bz, err := aminoCdc.Marshal(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expBinary, bz)
+
+			err = aminoCdc.Unmarshal(bz, tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg, tc.typ)
+
+			// Do a round trip of encoding/decoding JSON.
+			bz, err = aminoCdc.MarshalJSON(tc.msg)
+			require.NoError(t, err)
+			require.Equal(t, tc.expJSON, string(bz))
+
+			err = aminoCdc.UnmarshalJSON(bz, tc.typ)
+			require.NoError(t, err)
+			require.Equal(t, tc.msg, tc.typ)
+		})
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	registry := types.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	pk := genPrivKey(t).PubKey()
+	bz, err := cdc.MarshalInterfaceJSON(pk)
+	require.NoError(t, err)
+
+	var pk2 cryptotypes.PubKey
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &pk2))
+	require.True(t, pk2.Equals(pk))
+}
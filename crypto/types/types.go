@@ -49,6 +49,17 @@ type PrivKey interface {
 	LedgerPrivKey
 }
 
+// BatchVerifier verifies several signatures at once, which is cheaper than
+// verifying them one by one for some key types.
+type BatchVerifier interface {
+	// Add adds the signature of msg by key to the batch. It returns an error if
+	// the signature cannot be batched, e.g. because the key type is not
+	// supported, in which case it must be verified on its own.
+	Add(key PubKey, msg, sig []byte) error
+	// Verify reports whether all the signatures of the batch are valid.
+	Verify() bool
+}
+
 type (
 	Address = cmtcrypto.Address
 )
@@ -4,6 +4,7 @@ go 1.22.2
 
 replace (
 	cosmossdk.io/api => ../../../api
+	cosmossdk.io/collections => ../../../collections
 	cosmossdk.io/core => ../../../core
 	cosmossdk.io/depinject => ../../../depinject
 	cosmossdk.io/log => ../../../log
@@ -1,6 +1,7 @@
 package branch
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -103,6 +104,42 @@ func TestBranch(t *testing.T) {
 	// test reverse iter
 }
 
+func TestReadTracker(t *testing.T) {
+	parent := newMemState()
+	require.NoError(t, parent.Set([]byte("a"), []byte("1")))
+
+	tracker := NewReadTracker(singleActorState{parent}, &sync.RWMutex{})
+	reader, err := tracker.GetReader([]byte("actor"))
+	require.NoError(t, err)
+
+	v, err := reader.Get([]byte("a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), v)
+	iter, err := reader.Iterator([]byte("m"), []byte("p"))
+	require.NoError(t, err)
+	require.NoError(t, iter.Close())
+
+	conflicts := func(actor, key string) bool {
+		writes := WriteSet{}
+		writes.Add([]store.StateChanges{{
+			Actor:        []byte(actor),
+			StateChanges: []store.KVPair{{Key: []byte(key), Value: []byte("x")}},
+		}})
+		return tracker.Conflicts(writes)
+	}
+	require.True(t, conflicts("actor", "a"))  // key read
+	require.True(t, conflicts("actor", "n"))  // within iterated range
+	require.False(t, conflicts("actor", "p")) // end of range is exclusive
+	require.False(t, conflicts("actor", "b")) // never read
+	require.False(t, conflicts("other", "a")) // different actor
+}
+
+type singleActorState struct {
+	store.Reader
+}
+
+func (s singleActorState) GetReader([]byte) (store.Reader, error) { return s.Reader, nil }
+
 func newMemState() memStore {
 	return memStore{btree.NewBTreeGOptions(byKeys, btree.Options{Degree: bTreeDegree, NoLocks: true})}
 }
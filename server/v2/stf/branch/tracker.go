@@ -0,0 +1,221 @@
+package branch
+
+import (
+	"bytes"
+	"sync"
+
+	"cosmossdk.io/core/store"
+)
+
+var _ store.ReaderMap = (*ReadTracker)(nil)
+
+// keyRange is a [start, end) range of keys read through an iterator,
+// nil bounds are unbounded.
+type keyRange struct {
+	start, end []byte
+}
+
+// contains reports if the key falls within the range.
+func (r keyRange) contains(key []byte) bool {
+	if r.start != nil && bytes.Compare(key, r.start) < 0 {
+		return false
+	}
+	if r.end != nil && bytes.Compare(key, r.end) >= 0 {
+		return false
+	}
+	return true
+}
+
+// ReadTracker wraps a store.ReaderMap and records every key and iterator
+// range read through it, so that a speculative execution can later be
+// validated against the writes of the transactions that preceded it.
+// Reads of the parent state hold the read lock of the provided mutex, so that
+// many trackers read the same parent concurrently, while getting a reader holds
+// the write lock, as it may memoize the reader in the parent.
+// A single ReadTracker must not be used from multiple goroutines.
+type ReadTracker struct {
+	mu     *sync.RWMutex
+	parent store.ReaderMap
+
+	keys   map[string]map[string]struct{}
+	ranges map[string][]keyRange
+}
+
+// NewReadTracker creates a new ReadTracker over the parent state. The mutex
+// guards all the accesses to parent.
+func NewReadTracker(parent store.ReaderMap, mu *sync.RWMutex) *ReadTracker {
+	return &ReadTracker{
+		mu:     mu,
+		parent: parent,
+		keys:   make(map[string]map[string]struct{}),
+		ranges: make(map[string][]keyRange),
+	}
+}
+
+// GetReader implements store.ReaderMap.
+func (t *ReadTracker) GetReader(actor []byte) (store.Reader, error) {
+	t.mu.Lock()
+	reader, err := t.parent.GetReader(actor)
+	t.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return trackedReader{actor: string(actor), tracker: t, parent: reader}, nil
+}
+
+// Conflicts reports if any of the tracked reads observed a key which is part of
+// the provided WriteSet.
+func (t *ReadTracker) Conflicts(writes WriteSet) bool {
+	for actor, keys := range t.keys {
+		written, ok := writes[actor]
+		if !ok {
+			continue
+		}
+		for key := range keys {
+			if _, ok := written[key]; ok {
+				return true
+			}
+		}
+	}
+	for actor, ranges := range t.ranges {
+		written, ok := writes[actor]
+		if !ok {
+			continue
+		}
+		for key := range written {
+			for _, r := range ranges {
+				if r.contains([]byte(key)) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (t *ReadTracker) trackKey(actor string, key []byte) {
+	keys, ok := t.keys[actor]
+	if !ok {
+		keys = make(map[string]struct{})
+		t.keys[actor] = keys
+	}
+	keys[string(key)] = struct{}{}
+}
+
+func (t *ReadTracker) trackRange(actor string, start, end []byte) {
+	t.ranges[actor] = append(t.ranges[actor], keyRange{
+		start: bytes.Clone(start),
+		end:   bytes.Clone(end),
+	})
+}
+
+// WriteSet is the set of keys written, grouped by actor.
+type WriteSet map[string]map[string]struct{}
+
+// Add adds the keys modified by the provided state changes to the WriteSet.
+func (w WriteSet) Add(changes []store.StateChanges) {
+	for _, sc := range changes {
+		if len(sc.StateChanges) == 0 {
+			continue
+		}
+		keys, ok := w[string(sc.Actor)]
+		if !ok {
+			keys = make(map[string]struct{}, len(sc.StateChanges))
+			w[string(sc.Actor)] = keys
+		}
+		for _, kv := range sc.StateChanges {
+			keys[string(kv.Key)] = struct{}{}
+		}
+	}
+}
+
+// trackedReader is a store.Reader which records its reads in the ReadTracker.
+type trackedReader struct {
+	actor   string
+	tracker *ReadTracker
+	parent  store.Reader
+}
+
+func (r trackedReader) Has(key []byte) (bool, error) {
+	r.tracker.trackKey(r.actor, key)
+	r.tracker.mu.RLock()
+	defer r.tracker.mu.RUnlock()
+	return r.parent.Has(key)
+}
+
+func (r trackedReader) Get(key []byte) ([]byte, error) {
+	r.tracker.trackKey(r.actor, key)
+	r.tracker.mu.RLock()
+	defer r.tracker.mu.RUnlock()
+	return r.parent.Get(key)
+}
+
+func (r trackedReader) Iterator(start, end []byte) (store.Iterator, error) {
+	r.tracker.trackRange(r.actor, start, end)
+	r.tracker.mu.RLock()
+	defer r.tracker.mu.RUnlock()
+	iter, err := r.parent.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return lockedIterator{mu: r.tracker.mu, parent: iter}, nil
+}
+
+func (r trackedReader) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	r.tracker.trackRange(r.actor, start, end)
+	r.tracker.mu.RLock()
+	defer r.tracker.mu.RUnlock()
+	iter, err := r.parent.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return lockedIterator{mu: r.tracker.mu, parent: iter}, nil
+}
+
+// lockedIterator reads the parent iterator under the read lock.
+type lockedIterator struct {
+	mu     *sync.RWMutex
+	parent store.Iterator
+}
+
+func (i lockedIterator) Domain() (start, end []byte) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.parent.Domain()
+}
+
+func (i lockedIterator) Valid() bool {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.parent.Valid()
+}
+
+func (i lockedIterator) Next() {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	i.parent.Next()
+}
+
+func (i lockedIterator) Key() (key []byte) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.parent.Key()
+}
+
+func (i lockedIterator) Value() (value []byte) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.parent.Value()
+}
+
+func (i lockedIterator) Error() error {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.parent.Error()
+}
+
+func (i lockedIterator) Close() error {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.parent.Close()
+}
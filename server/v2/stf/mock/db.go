@@ -27,7 +27,7 @@ func (m memState) Has(key []byte) (bool, error) {
 }
 
 func (m memState) Get(bytes []byte) ([]byte, error) {
-	key := append(m.address, bytes...)
+	key := append(append([]byte{}, m.address...), bytes...)
 	return m.kv[string(key)], nil
 }
 
@@ -34,6 +34,8 @@ type STF[T transaction.Tx] struct {
 	branchFn            branchFn // branchFn is a function that given a readonly state it returns a writable version of it.
 	makeGasMeter        makeGasMeterFn
 	makeGasMeteredState makeGasMeteredStateFn
+
+	txWorkers int // txWorkers is the number of workers used to execute txs in parallel, sequential if <= 1.
 }
 
 // NewSTF returns a new STF instance.
@@ -122,12 +124,19 @@ func (s STF[T]) DeliverBlock(
 	// execute txs
 	txResults := make([]appmanager.TxResult, len(block.Txs))
 	// TODO: skip first tx if vote extensions are enabled (marko)
-	for i, txBytes := range block.Txs {
-		// check if we need to return early or continue delivering txs
-		if err = isCtxCancelled(ctx); err != nil {
+	if s.txWorkers > 1 {
+		txResults, err = s.deliverTxsParallel(ctx, newState, block.Txs, hi)
+		if err != nil {
 			return nil, nil, err
 		}
-		txResults[i] = s.deliverTx(ctx, newState, txBytes, transaction.ExecModeFinalize, hi)
+	} else {
+		for i, txBytes := range block.Txs {
+			// check if we need to return early or continue delivering txs
+			if err = isCtxCancelled(ctx); err != nil {
+				return nil, nil, err
+			}
+			txResults[i] = s.deliverTx(ctx, newState, txBytes, transaction.ExecModeFinalize, hi)
+		}
 	}
 	// reset events
 	exCtx.events = make([]event.Event, 0)
@@ -521,6 +530,7 @@ func (s STF[T]) clone() STF[T] {
 		branchFn:            s.branchFn,
 		makeGasMeter:        s.makeGasMeter,
 		makeGasMeteredState: s.makeGasMeteredState,
+		txWorkers:           s.txWorkers,
 	}
 }
 
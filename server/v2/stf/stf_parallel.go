@@ -0,0 +1,115 @@
+package stf
+
+import (
+	"context"
+	"sync"
+
+	appmanager "cosmossdk.io/core/app"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/core/transaction"
+	"cosmossdk.io/server/v2/stf/branch"
+)
+
+// WithParallelExecution enables the optimistic parallel execution of the block
+// transactions in DeliverBlock using the provided number of workers.
+// A value lower or equal to 1 keeps the sequential execution.
+func (s *STF[T]) WithParallelExecution(workers int) *STF[T] {
+	s.txWorkers = workers
+	return s
+}
+
+// speculativeTx holds the outcome of a tx executed against the pre-tx block state.
+type speculativeTx struct {
+	result  appmanager.TxResult
+	reads   *branch.ReadTracker
+	changes []store.StateChanges
+	err     error
+}
+
+// deliverTxsParallel executes the txs optimistically in parallel, Block-STM style.
+// Every tx is first executed speculatively on top of the state as it is before any
+// tx of the block is applied, while its reads are tracked. Then, in block order,
+// each speculative execution is validated against the keys written by the txs
+// which precede it: if none of the keys it read was modified, its state changes
+// are applied as is, otherwise the tx is re-executed on top of the up-to-date state.
+// This yields the same state changes, results and events as the sequential execution.
+func (s STF[T]) deliverTxsParallel(
+	ctx context.Context,
+	state store.WriterMap,
+	txs []T,
+	hi header.Info,
+) ([]appmanager.TxResult, error) {
+	speculative := make([]speculativeTx, len(txs))
+
+	// state is shared across all workers, it is only read until they are done,
+	// but getting a reader may memoize it, so the trackers lock their accesses to it.
+	mu := &sync.RWMutex{}
+	indexes := make(chan int)
+	wg := sync.WaitGroup{}
+	for w := 0; w < min(s.txWorkers, len(txs)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				speculative[i] = s.speculateTx(ctx, state, mu, txs[i], hi)
+			}
+		}()
+	}
+	for i := range txs {
+		if isCtxCancelled(ctx) != nil {
+			break
+		}
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	txResults := make([]appmanager.TxResult, len(txs))
+	written := branch.WriteSet{}
+	for i, tx := range txs {
+		// check if we need to return early or continue delivering txs
+		if err := isCtxCancelled(ctx); err != nil {
+			return nil, err
+		}
+
+		spec := speculative[i]
+		if spec.reads == nil || spec.err != nil || spec.reads.Conflicts(written) {
+			// the speculative execution is stale, re-execute the tx on the current state.
+			txState := s.branchFn(state)
+			spec.result = s.deliverTx(ctx, txState, tx, transaction.ExecModeFinalize, hi)
+			spec.changes, spec.err = txState.GetStateChanges()
+			if spec.err != nil {
+				return nil, spec.err
+			}
+		}
+
+		if err := state.ApplyStateChanges(spec.changes); err != nil {
+			return nil, err
+		}
+		written.Add(spec.changes)
+		txResults[i] = spec.result
+	}
+
+	return txResults, nil
+}
+
+// speculateTx executes the tx on a branch of the provided state, tracking all the reads.
+func (s STF[T]) speculateTx(
+	ctx context.Context,
+	state store.WriterMap,
+	mu *sync.RWMutex,
+	tx T,
+	hi header.Info,
+) speculativeTx {
+	reads := branch.NewReadTracker(state, mu)
+	txState := s.branchFn(reads)
+	result := s.deliverTx(ctx, txState, tx, transaction.ExecModeFinalize, hi)
+	changes, err := txState.GetStateChanges()
+	return speculativeTx{
+		result:  result,
+		reads:   reads,
+		changes: changes,
+		err:     err,
+	}
+}
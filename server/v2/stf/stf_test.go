@@ -233,3 +233,137 @@ func stateNotHas(t *testing.T, accountState store.ReaderMap, key string) {
 	require.NoError(t, err)
 	require.Falsef(t, has, "state was not supposed to have key: %s", key)
 }
+
+func TestSTFParallelExecution(t *testing.T) {
+	sum := sha256.Sum256([]byte("test-hash"))
+
+	newSTF := func() *STF[mock.Tx] {
+		return &STF[mock.Tx]{
+			handleMsg: func(ctx context.Context, msg transaction.Msg) (msgResp transaction.Msg, err error) {
+				state, err := ctx.(*executionContext).state.GetWriter(actorName)
+				require.NoError(t, err)
+				// shared txs increment a global counter, hence they conflict with each other,
+				// the others only write a key owned by the sender.
+				key := append([]byte("sender/"), ctx.(*executionContext).sender...)
+				if msg.(*wrapperspb.BoolValue).Value {
+					key = []byte("counter")
+				}
+				v, err := state.Get(key)
+				require.NoError(t, err)
+				v = append(v, 0x1)
+				return nil, state.Set(key, v)
+			},
+			doPreBlock:        func(ctx context.Context, txs []mock.Tx) error { return nil },
+			doBeginBlock:      func(ctx context.Context) error { return nil },
+			doEndBlock:        func(ctx context.Context) error { return nil },
+			doValidatorUpdate: func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+			doTxValidation: func(ctx context.Context, tx mock.Tx) error {
+				kvSet(t, ctx, "validate")
+				return nil
+			},
+			postTxExec:          func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+			branchFn:            branch.DefaultNewWriterMap,
+			makeGasMeter:        gas.DefaultGasMeter,
+			makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+		}
+	}
+
+	txs := make([]mock.Tx, 50)
+	for i := range txs {
+		txs[i] = mock.Tx{
+			Sender:   []byte(fmt.Sprintf("sender-%d", i%10)),
+			Msg:      wrapperspb.Bool(i%3 == 0),
+			GasLimit: 100_000,
+		}
+	}
+	block := &appmanager.BlockRequest[mock.Tx]{
+		Height:  uint64(1),
+		Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     txs,
+	}
+
+	seqResult, seqState, err := newSTF().DeliverBlock(context.Background(), block, mock.DB())
+	require.NoError(t, err)
+	parResult, parState, err := newSTF().WithParallelExecution(4).DeliverBlock(context.Background(), block, mock.DB())
+	require.NoError(t, err)
+
+	require.Equal(t, seqResult.TxResults, parResult.TxResults)
+	for _, key := range []string{"counter", "validate", "sender/sender-0", "sender/sender-9"} {
+		require.Equal(t, stateGet(t, seqState, key), stateGet(t, parState, key), key)
+	}
+	require.Len(t, stateGet(t, parState, "counter"), 17)
+}
+
+func stateGet(t *testing.T, accountState store.ReaderMap, key string) []byte {
+	t.Helper()
+	state, err := accountState.GetReader(actorName)
+	require.NoError(t, err)
+	v, err := state.Get([]byte(key))
+	require.NoError(t, err)
+	return v
+}
+
+// BenchmarkDeliverBlock compares the sequential and the parallel execution of a
+// block whose txs are computation heavy and do not conflict with each other.
+func BenchmarkDeliverBlock(b *testing.B) {
+	sum := sha256.Sum256([]byte("test-hash"))
+
+	newSTF := func() *STF[mock.Tx] {
+		return &STF[mock.Tx]{
+			handleMsg: func(ctx context.Context, msg transaction.Msg) (msgResp transaction.Msg, err error) {
+				state, err := ctx.(*executionContext).state.GetWriter(actorName)
+				if err != nil {
+					return nil, err
+				}
+				key := append([]byte("sender/"), ctx.(*executionContext).sender...)
+				v, err := state.Get(key)
+				if err != nil {
+					return nil, err
+				}
+				hash := sha256.Sum256(v)
+				for i := 0; i < 2_000; i++ {
+					hash = sha256.Sum256(hash[:])
+				}
+				return nil, state.Set(key, hash[:])
+			},
+			doPreBlock:          func(ctx context.Context, txs []mock.Tx) error { return nil },
+			doBeginBlock:        func(ctx context.Context) error { return nil },
+			doEndBlock:          func(ctx context.Context) error { return nil },
+			doValidatorUpdate:   func(ctx context.Context) ([]appmodulev2.ValidatorUpdate, error) { return nil, nil },
+			doTxValidation:      func(ctx context.Context, tx mock.Tx) error { return nil },
+			postTxExec:          func(ctx context.Context, tx mock.Tx, success bool) error { return nil },
+			branchFn:            branch.DefaultNewWriterMap,
+			makeGasMeter:        gas.DefaultGasMeter,
+			makeGasMeteredState: gas.DefaultWrapWithGasMeter,
+		}
+	}
+
+	txs := make([]mock.Tx, 200)
+	for i := range txs {
+		txs[i] = mock.Tx{
+			Sender:   []byte(fmt.Sprintf("sender-%d", i)),
+			Msg:      wrapperspb.Bool(false),
+			GasLimit: 1_000_000,
+		}
+	}
+	block := &appmanager.BlockRequest[mock.Tx]{
+		Height:  uint64(1),
+		Time:    time.Date(2024, 2, 3, 18, 23, 0, 0, time.UTC),
+		AppHash: sum[:],
+		Hash:    sum[:],
+		Txs:     txs,
+	}
+
+	for _, workers := range []int{1, 4} {
+		s := newSTF().WithParallelExecution(workers)
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, _, err := s.DeliverBlock(context.Background(), block, mock.DB()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
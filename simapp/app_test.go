@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtproto "github.com/cometbft/cometbft/api/cometbft/types/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -21,8 +23,10 @@ import (
 	"cosmossdk.io/x/auth/vesting"
 	authzmodule "cosmossdk.io/x/authz/module"
 	"cosmossdk.io/x/bank"
+	banktestutil "cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/distribution"
+	distrtypes "cosmossdk.io/x/distribution/types"
 	"cosmossdk.io/x/epochs"
 	"cosmossdk.io/x/evidence"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
@@ -287,6 +291,49 @@ func TestUpgradeStateOnGenesis(t *testing.T) {
 
 // TestMergedRegistry tests that fetching the gogo/protov2 merged registry
 // doesn't fail after loading all file descriptors.
+func TestAutoCompoundOnEpochEnd(t *testing.T) {
+	app := Setup(t, false)
+	_, err := app.Commit()
+	require.NoError(t, err)
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+	delegations, err := app.StakingKeeper.GetAllSDKDelegations(ctx)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	delAddr, err := app.AuthKeeper.AddressCodec().StringToBytes(delegations[0].DelegatorAddress)
+	require.NoError(t, err)
+	valAddr, err := app.StakingKeeper.ValidatorAddressCodec().StringToBytes(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	validator, err := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+
+	// the validator is allocated rewards, which the delegator compounds
+	require.NoError(t, app.DistrKeeper.SetAutoCompound(ctx, delAddr, true))
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000))
+	require.NoError(t, banktestutil.FundModuleAccount(ctx, app.BankKeeper, distrtypes.ModuleName, rewards))
+	require.NoError(t, app.DistrKeeper.AllocateTokensToValidator(ctx, validator, sdk.NewDecCoinsFromCoins(rewards...)))
+
+	finalizeBlock := func(blockTime time.Time) {
+		t.Helper()
+		_, err := app.FinalizeBlock(&abci.FinalizeBlockRequest{Height: app.LastBlockHeight() + 1, Time: blockTime})
+		require.NoError(t, err)
+		_, err = app.Commit()
+		require.NoError(t, err)
+	}
+
+	// the first block starts the epochs, the next day ends the first one, which
+	// starts an auto-compound round, compounded in the following block
+	genesisTime := time.Now().UTC()
+	finalizeBlock(genesisTime)
+	finalizeBlock(genesisTime.Add(25 * time.Hour))
+	finalizeBlock(genesisTime.Add(25*time.Hour + time.Minute))
+
+	ctx = app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+	delegation, err := app.StakingKeeper.Delegations.Get(ctx, collections.Join(sdk.AccAddress(delAddr), sdk.ValAddress(valAddr)))
+	require.NoError(t, err)
+	require.True(t, delegation.Shares.GT(delegations[0].Shares), "delegation shares %s were not compounded", delegation.Shares)
+}
+
 func TestMergedRegistry(t *testing.T) {
 	r, err := proto.MergedRegistry()
 	require.NoError(t, err)
@@ -151,7 +151,6 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
@@ -264,6 +263,9 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 // SnapshotStoreItem contains metadata about a snapshotted store.
 type SnapshotStoreItem struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// hash is the root hash of the store at the snapshot height. It is used to
+	// verify the restored store, and is empty for snapshots which do not set it.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
 func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
@@ -306,6 +308,13 @@ func (m *SnapshotStoreItem) GetName() string {
 	return ""
 }
 
+func (m *SnapshotStoreItem) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // SnapshotIAVLItem is an exported IAVL node.
 type SnapshotIAVLItem struct {
 	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -490,41 +499,41 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xd3, 0x3c,
-	0x18, 0x8e, 0xd7, 0xb4, 0x5f, 0xf7, 0x26, 0x9f, 0xe8, 0xcc, 0x40, 0x61, 0x87, 0x2c, 0x84, 0x4b,
-	0x24, 0x68, 0xba, 0x75, 0x88, 0x03, 0xda, 0x85, 0x8a, 0x49, 0xad, 0x00, 0x69, 0xf2, 0x24, 0x84,
-	0xb8, 0x54, 0xde, 0x6a, 0x9a, 0xaa, 0x4d, 0x5d, 0xd5, 0x5e, 0x45, 0x8f, 0xfc, 0x03, 0xfe, 0x08,
-	0x37, 0x7e, 0xc4, 0x8e, 0x13, 0x27, 0x4e, 0x13, 0x6a, 0xff, 0x02, 0x3f, 0x00, 0xd9, 0x4e, 0x0a,
-	0xda, 0x52, 0x34, 0x6e, 0xef, 0xf3, 0xfa, 0x79, 0x1e, 0xfb, 0x7d, 0xec, 0x04, 0xa2, 0x33, 0x2e,
-	0x52, 0x2e, 0x1a, 0x42, 0xf2, 0x29, 0x6b, 0x88, 0x31, 0x9d, 0x88, 0x84, 0x4b, 0xd1, 0x98, 0xed,
-	0xaf, 0x40, 0x3c, 0x99, 0x72, 0xc9, 0xf1, 0x03, 0xc3, 0x8c, 0x35, 0x33, 0x5e, 0x31, 0xe3, 0xd9,
-	0xfe, 0xce, 0x76, 0x9f, 0xf7, 0xb9, 0x66, 0x35, 0x54, 0x65, 0x04, 0x3b, 0x99, 0xa0, 0x6b, 0x16,
-	0x32, 0xb5, 0x06, 0xe1, 0x17, 0x04, 0xd5, 0x93, 0xcc, 0x01, 0xdf, 0x87, 0x4a, 0xc2, 0x06, 0xfd,
-	0x44, 0x7a, 0x28, 0x40, 0x91, 0x4d, 0x32, 0xa4, 0xfa, 0x1f, 0xf8, 0x34, 0xa5, 0xd2, 0xdb, 0x08,
-	0x50, 0xf4, 0x3f, 0xc9, 0x90, 0xea, 0x9f, 0x25, 0xe7, 0xe3, 0xa1, 0xf0, 0x4a, 0xa6, 0x6f, 0x10,
-	0xc6, 0x60, 0x27, 0x54, 0x24, 0x9e, 0x1d, 0xa0, 0xc8, 0x25, 0xba, 0xc6, 0x47, 0x50, 0x4d, 0x99,
-	0xa4, 0x3d, 0x2a, 0xa9, 0x57, 0x0e, 0x50, 0xe4, 0x34, 0x1f, 0xc5, 0x6b, 0xe7, 0x88, 0xdf, 0x64,
-	0xd4, 0x96, 0x7d, 0x71, 0xb5, 0x6b, 0x91, 0x95, 0x34, 0xac, 0x43, 0x35, 0x5f, 0xc3, 0x0f, 0xc1,
-	0xd5, 0x1b, 0x76, 0xd5, 0x06, 0x4c, 0x78, 0x28, 0x28, 0x45, 0x2e, 0x71, 0x74, 0xaf, 0xad, 0x5b,
-	0xe1, 0xcf, 0x0d, 0x70, 0xf3, 0xf1, 0x3a, 0x92, 0xa5, 0xf8, 0x25, 0x94, 0xf5, 0x76, 0x7a, 0x42,
-	0xa7, 0xf9, 0xe4, 0x2f, 0x67, 0xc8, 0x75, 0x27, 0x6a, 0x49, 0x89, 0xdb, 0x16, 0x31, 0x62, 0xfc,
-	0x0a, 0xec, 0x01, 0x9d, 0x8d, 0x74, 0x1c, 0x4e, 0xf3, 0xf1, 0x2d, 0x4c, 0x3a, 0x2f, 0xde, 0xbe,
-	0x56, 0x1e, 0xad, 0xea, 0xe2, 0x6a, 0xd7, 0x56, 0xa8, 0x6d, 0x11, 0x6d, 0x82, 0x8f, 0x61, 0x93,
-	0x7d, 0x94, 0x6c, 0x2c, 0x06, 0x7c, 0xac, 0x83, 0x74, 0x9a, 0x7b, 0xb7, 0x70, 0x3c, 0xca, 0x35,
-	0x2a, 0x8f, 0xb6, 0x45, 0x7e, 0x9b, 0xe0, 0x53, 0xd8, 0x5a, 0x81, 0xee, 0x84, 0xce, 0x47, 0x9c,
-	0xf6, 0xf4, 0x65, 0x38, 0xcd, 0x83, 0x7f, 0x71, 0x3e, 0x36, 0xd2, 0xb6, 0x45, 0x6a, 0xec, 0x5a,
-	0xef, 0xf9, 0xdd, 0x6f, 0x5f, 0xeb, 0x77, 0x8c, 0x57, 0x5d, 0xf4, 0x86, 0xc1, 0x5e, 0xfc, 0xf4,
-	0x59, 0xab, 0x02, 0xf6, 0x40, 0xb2, 0x34, 0x3c, 0x84, 0xad, 0x1b, 0xe9, 0xa9, 0x57, 0x31, 0xa6,
-	0xa9, 0x49, 0x7e, 0x93, 0xe8, 0xba, 0xd0, 0x25, 0xfc, 0x84, 0xa0, 0x76, 0x3d, 0x37, 0x5c, 0x83,
-	0xd2, 0x90, 0xcd, 0xb5, 0xd8, 0x25, 0xaa, 0xc4, 0xdb, 0x50, 0x9e, 0xd1, 0xd1, 0x39, 0xd3, 0xb7,
-	0xe0, 0x12, 0x03, 0xb0, 0x07, 0xff, 0xcd, 0xd8, 0x74, 0x95, 0x65, 0x89, 0xe4, 0xf0, 0x8f, 0xd7,
-	0xad, 0xa2, 0x28, 0xe7, 0xaf, 0xbb, 0xf8, 0x0c, 0xef, 0xe0, 0x5e, 0x61, 0xd0, 0x45, 0x53, 0xac,
-	0xfb, 0x3e, 0x8a, 0x9d, 0x3b, 0xe0, 0xad, 0x0b, 0x5a, 0x1d, 0x3e, 0xbf, 0x2e, 0x33, 0x68, 0x0e,
-	0x8b, 0xe3, 0x3e, 0xbc, 0x58, 0xf8, 0xe8, 0x72, 0xe1, 0xa3, 0x1f, 0x0b, 0x1f, 0x7d, 0x5e, 0xfa,
-	0xd6, 0xe5, 0xd2, 0xb7, 0xbe, 0x2f, 0x7d, 0xeb, 0x7d, 0x68, 0xa8, 0xa2, 0x37, 0x8c, 0x07, 0xfc,
-	0xc6, 0x2f, 0x45, 0xce, 0x27, 0x4c, 0x9c, 0x56, 0xf4, 0x1f, 0xe0, 0xe0, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x57, 0xe2, 0xd5, 0x36, 0x79, 0x04, 0x00, 0x00,
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xf6, 0x26, 0x4e, 0xfe, 0x74, 0x9d, 0x5f, 0xa4, 0x4b, 0x41, 0xa6, 0x07, 0xd7, 0x98, 0x8b,
+	0x25, 0x88, 0xd3, 0xa6, 0x88, 0x03, 0xe2, 0x42, 0x44, 0xa5, 0x44, 0x80, 0x14, 0x6d, 0x25, 0x84,
+	0xb8, 0x44, 0xdb, 0x66, 0x89, 0xad, 0xc4, 0xde, 0x28, 0xbb, 0xb5, 0xc8, 0x91, 0x37, 0xe0, 0x45,
+	0xb8, 0xf1, 0x10, 0x3d, 0x56, 0x9c, 0x38, 0x55, 0x28, 0x79, 0x05, 0x1e, 0x00, 0xed, 0xae, 0xed,
+	0xa2, 0xd6, 0x41, 0xe5, 0x36, 0xdf, 0xec, 0x7c, 0xdf, 0xee, 0x7c, 0x33, 0x36, 0xf4, 0x4f, 0x19,
+	0x8f, 0x19, 0xef, 0x70, 0xc1, 0x16, 0xb4, 0xc3, 0x13, 0x32, 0xe7, 0x21, 0x13, 0xbc, 0x93, 0x1e,
+	0x14, 0x20, 0x98, 0x2f, 0x98, 0x60, 0xe8, 0x81, 0xae, 0x0c, 0x54, 0x65, 0x50, 0x54, 0x06, 0xe9,
+	0xc1, 0xee, 0xce, 0x84, 0x4d, 0x98, 0xaa, 0xea, 0xc8, 0x48, 0x13, 0x76, 0x33, 0xc2, 0x48, 0x1f,
+	0x64, 0x6c, 0x05, 0xbc, 0xaf, 0x00, 0x36, 0x8e, 0x33, 0x05, 0x74, 0x1f, 0xd6, 0x43, 0x1a, 0x4d,
+	0x42, 0x61, 0x03, 0x17, 0xf8, 0x26, 0xce, 0x90, 0xcc, 0x7f, 0x64, 0x8b, 0x98, 0x08, 0xbb, 0xe2,
+	0x02, 0xff, 0x7f, 0x9c, 0x21, 0x99, 0x3f, 0x0d, 0xcf, 0x92, 0x29, 0xb7, 0xab, 0x3a, 0xaf, 0x11,
+	0x42, 0xd0, 0x0c, 0x09, 0x0f, 0x6d, 0xd3, 0x05, 0x7e, 0x13, 0xab, 0x18, 0x1d, 0xc1, 0x46, 0x4c,
+	0x05, 0x19, 0x13, 0x41, 0xec, 0x9a, 0x0b, 0x7c, 0xab, 0xfb, 0x28, 0xd8, 0xd8, 0x47, 0xf0, 0x36,
+	0x2b, 0xed, 0x99, 0xe7, 0x97, 0x7b, 0x06, 0x2e, 0xa8, 0x5e, 0x1b, 0x36, 0xf2, 0x33, 0xf4, 0x10,
+	0x36, 0xd5, 0x85, 0x23, 0x79, 0x01, 0xe5, 0x36, 0x70, 0xab, 0x7e, 0x13, 0x5b, 0x2a, 0xd7, 0x57,
+	0x29, 0xef, 0x57, 0x05, 0x36, 0xf3, 0xf6, 0x06, 0x82, 0xc6, 0xe8, 0x15, 0xac, 0xa9, 0xeb, 0x54,
+	0x87, 0x56, 0xf7, 0xc9, 0x5f, 0xde, 0x90, 0xf3, 0x8e, 0xe5, 0x91, 0x24, 0xf7, 0x0d, 0xac, 0xc9,
+	0xe8, 0x35, 0x34, 0x23, 0x92, 0xce, 0x94, 0x1d, 0x56, 0xf7, 0xf1, 0x2d, 0x44, 0x06, 0x2f, 0xdf,
+	0xbd, 0x91, 0x1a, 0xbd, 0xc6, 0xea, 0x72, 0xcf, 0x94, 0xa8, 0x6f, 0x60, 0x25, 0x82, 0x86, 0x70,
+	0x8b, 0x7e, 0x12, 0x34, 0xe1, 0x11, 0x4b, 0x94, 0x91, 0x56, 0x77, 0xff, 0x16, 0x8a, 0x47, 0x39,
+	0x47, 0xfa, 0xd1, 0x37, 0xf0, 0x95, 0x08, 0x3a, 0x81, 0xdb, 0x05, 0x18, 0xcd, 0xc9, 0x72, 0xc6,
+	0xc8, 0x58, 0x0d, 0xc3, 0xea, 0x1e, 0xfe, 0x8b, 0xf2, 0x50, 0x53, 0xfb, 0x06, 0x6e, 0xd1, 0x6b,
+	0xb9, 0xe7, 0x77, 0xbf, 0x7f, 0x6b, 0xdf, 0xd1, 0x5a, 0x6d, 0x3e, 0x9e, 0xba, 0xfb, 0xc1, 0xd3,
+	0x67, 0xbd, 0x3a, 0x34, 0x23, 0x41, 0x63, 0x6f, 0x08, 0xb7, 0x6f, 0xb8, 0x27, 0xb7, 0x22, 0x21,
+	0xb1, 0x76, 0x7e, 0x0b, 0xab, 0xb8, 0xd8, 0x94, 0xca, 0xd5, 0xa6, 0x94, 0x2a, 0x7b, 0x9f, 0x01,
+	0x6c, 0x5d, 0xf7, 0x12, 0xb5, 0x60, 0x75, 0x4a, 0x97, 0x4a, 0xb0, 0x89, 0x65, 0x88, 0x76, 0x60,
+	0x2d, 0x25, 0xb3, 0x33, 0x9a, 0x09, 0x6a, 0x80, 0x6c, 0xf8, 0x5f, 0x4a, 0x17, 0x85, 0xbf, 0x55,
+	0x9c, 0xc3, 0x3f, 0x36, 0x5e, 0xda, 0x53, 0xcb, 0x37, 0xbe, 0xfc, 0x0d, 0xef, 0xe1, 0xbd, 0x52,
+	0xf3, 0x4b, 0x3b, 0xdb, 0xf0, 0xcd, 0x94, 0x2b, 0x0f, 0xa0, 0xbd, 0xc9, 0x7c, 0xf9, 0xf8, 0x7c,
+	0x84, 0xba, 0xd1, 0x1c, 0x96, 0x8f, 0xe0, 0xc5, 0xf9, 0xca, 0x01, 0x17, 0x2b, 0x07, 0xfc, 0x5c,
+	0x39, 0xe0, 0xcb, 0xda, 0x31, 0x2e, 0xd6, 0x8e, 0xf1, 0x63, 0xed, 0x18, 0x1f, 0x3c, 0x5d, 0xca,
+	0xc7, 0xd3, 0x20, 0x62, 0x37, 0x7e, 0x33, 0x62, 0x39, 0xa7, 0xfc, 0xa4, 0xae, 0xfe, 0x0a, 0x87,
+	0xbf, 0x07, 0x00, 0x9d, 0xb5, 0xe3, 0xc7, 0x8d, 0x04, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -750,6 +759,13 @@ func (m *SnapshotStoreItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -992,6 +1008,10 @@ func (m *SnapshotStoreItem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSnapshot(uint64(l))
 	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -1560,6 +1580,40 @@ func (m *SnapshotStoreItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
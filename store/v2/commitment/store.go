@@ -26,9 +26,10 @@ const (
 )
 
 var (
-	_ store.Committer             = (*CommitStore)(nil)
-	_ snapshots.CommitSnapshotter = (*CommitStore)(nil)
-	_ store.PausablePruner        = (*CommitStore)(nil)
+	_ store.Committer                  = (*CommitStore)(nil)
+	_ snapshots.CommitSnapshotter      = (*CommitStore)(nil)
+	_ snapshots.StoreCommitSnapshotter = (*CommitStore)(nil)
+	_ store.PausablePruner             = (*CommitStore)(nil)
 )
 
 // CommitStore is a wrapper around multiple Tree objects mapped by a unique store
@@ -332,6 +333,19 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 		return fmt.Errorf("the snapshot version %d is greater than the latest version %d", version, latestVersion)
 	}
 
+	// the store hashes are recorded in the snapshot, so that each restored store
+	// can be verified independently
+	storeHashes := make(map[string][]byte, len(c.multiTrees))
+	cInfo, err := c.GetCommitInfo(version)
+	if err != nil {
+		return err
+	}
+	if cInfo != nil {
+		for _, storeInfo := range cInfo.StoreInfos {
+			storeHashes[string(storeInfo.Name)] = storeInfo.GetHash()
+		}
+	}
+
 	for storeKey, tree := range c.multiTrees {
 		// TODO: check the parallelism of this loop
 		if err := func() error {
@@ -345,6 +359,7 @@ func (c *CommitStore) Snapshot(version uint64, protoWriter protoio.Writer) error
 				Item: &snapshotstypes.SnapshotItem_Store{
 					Store: &snapshotstypes.SnapshotStoreItem{
 						Name: storeKey,
+						Hash: storeHashes[storeKey],
 					},
 				},
 			})
@@ -389,6 +404,7 @@ func (c *CommitStore) Restore(
 		importer     Importer
 		snapshotItem snapshotstypes.SnapshotItem
 		storeKey     []byte
+		storeHashes  = make(map[string][]byte)
 	)
 
 loop:
@@ -413,6 +429,7 @@ loop:
 			}
 
 			storeKey = []byte(item.Store.Name)
+			storeHashes[item.Store.Name] = item.Store.Hash
 			tree := c.multiTrees[item.Store.Name]
 			if tree == nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("store %s not found", item.Store.Name)
@@ -427,35 +444,8 @@ loop:
 			if importer == nil {
 				return snapshotstypes.SnapshotItem{}, fmt.Errorf("received IAVL node item before store item")
 			}
-			node := item.IAVL
-			if node.Height > int32(math.MaxInt8) {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("node height %v cannot exceed %v",
-					item.IAVL.Height, math.MaxInt8)
-			}
-			// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
-			// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
-			if node.Key == nil {
-				node.Key = []byte{}
-			}
-			if node.Height == 0 {
-				if node.Value == nil {
-					node.Value = []byte{}
-				}
-
-				// If the node is a leaf node, it will be written to the storage.
-				chStorage <- &corestore.StateChanges{
-					Actor: storeKey,
-					StateChanges: []corestore.KVPair{
-						{
-							Key:   node.Key,
-							Value: node.Value,
-						},
-					},
-				}
-			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshotstypes.SnapshotItem{}, fmt.Errorf("failed to add node to importer: %w", err)
+			if err := importNode(importer, storeKey, item.IAVL, chStorage); err != nil {
+				return snapshotstypes.SnapshotItem{}, err
 			}
 		default:
 			break loop
@@ -468,7 +458,131 @@ loop:
 		}
 	}
 
-	return snapshotItem, c.LoadVersion(version)
+	if err := c.LoadVersion(version); err != nil {
+		return snapshotstypes.SnapshotItem{}, err
+	}
+
+	for storeKey, hash := range storeHashes {
+		if err := verifyStoreHash(storeKey, c.multiTrees[storeKey], hash); err != nil {
+			return snapshotstypes.SnapshotItem{}, err
+		}
+	}
+
+	return snapshotItem, nil
+}
+
+// RestoreStore implements snapshots.StoreCommitSnapshotter.
+func (c *CommitStore) RestoreStore(
+	version uint64,
+	format uint32,
+	item snapshotstypes.SnapshotStoreItem,
+	nodeReader snapshots.StoreNodeReader,
+	chStorage chan<- *corestore.StateChanges,
+) error {
+	tree := c.multiTrees[item.Name]
+	if tree == nil {
+		return fmt.Errorf("store %s not found", item.Name)
+	}
+	storeKey := []byte(item.Name)
+
+	// The tree may already hold the version if a previous restore was interrupted
+	// after importing it, in which case only the leaves are written to the storage.
+	if len(item.Hash) > 0 && tree.GetLatestVersion() == version && bytes.Equal(tree.Hash(), item.Hash) {
+		for {
+			node, err := nodeReader()
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if node.Height == 0 {
+				chStorage <- leafStateChanges(storeKey, node)
+			}
+		}
+	}
+
+	importer, err := tree.Import(version)
+	if err != nil {
+		return fmt.Errorf("failed to import tree for version %d: %w", version, err)
+	}
+	defer importer.Close()
+
+	for {
+		node, err := nodeReader()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+		if err := importNode(importer, storeKey, node, chStorage); err != nil {
+			return err
+		}
+	}
+
+	if err := importer.Commit(); err != nil {
+		return fmt.Errorf("failed to commit importer: %w", err)
+	}
+	if err := tree.LoadVersion(version); err != nil {
+		return fmt.Errorf("failed to load tree for version %d: %w", version, err)
+	}
+
+	return verifyStoreHash(item.Name, tree, item.Hash)
+}
+
+// FinalizeRestore implements snapshots.StoreCommitSnapshotter.
+func (c *CommitStore) FinalizeRestore(version uint64) error {
+	return c.LoadVersion(version)
+}
+
+// importNode adds the node to the importer. Leaf nodes are also written to the
+// storage through chStorage.
+func importNode(importer Importer, storeKey []byte, node *snapshotstypes.SnapshotIAVLItem, chStorage chan<- *corestore.StateChanges) error {
+	if node.Height > int32(math.MaxInt8) {
+		return fmt.Errorf("node height %v cannot exceed %v", node.Height, math.MaxInt8)
+	}
+	// Protobuf does not differentiate between []byte{} and nil, but fortunately IAVL does
+	// not allow nil keys nor nil values for leaf nodes, so we can always set them to empty.
+	if node.Key == nil {
+		node.Key = []byte{}
+	}
+	if node.Height == 0 {
+		// If the node is a leaf node, it will be written to the storage.
+		chStorage <- leafStateChanges(storeKey, node)
+	}
+	if err := importer.Add(node); err != nil {
+		return fmt.Errorf("failed to add node to importer: %w", err)
+	}
+
+	return nil
+}
+
+// leafStateChanges returns the storage changes of a leaf node.
+func leafStateChanges(storeKey []byte, node *snapshotstypes.SnapshotIAVLItem) *corestore.StateChanges {
+	if node.Value == nil {
+		node.Value = []byte{}
+	}
+	return &corestore.StateChanges{
+		Actor: storeKey,
+		StateChanges: []corestore.KVPair{
+			{
+				Key:   node.Key,
+				Value: node.Value,
+			},
+		},
+	}
+}
+
+// verifyStoreHash verifies the root hash of a restored tree against the hash
+// recorded in the snapshot. Snapshots without store hashes are not verified.
+func verifyStoreHash(storeKey string, tree Tree, expected []byte) error {
+	if len(expected) == 0 {
+		return nil
+	}
+	if hash := tree.Hash(); !bytes.Equal(hash, expected) {
+		return fmt.Errorf("%w: store %s: expected %X, got %X", snapshotstypes.ErrStoreHashMismatch, storeKey, expected, hash)
+	}
+
+	return nil
 }
 
 func (c *CommitStore) Close() (ferr error) {
@@ -2,6 +2,7 @@ package commitment
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -19,6 +20,7 @@ import (
 const (
 	storeKey1 = "store1"
 	storeKey2 = "store2"
+	storeKey3 = "store3"
 )
 
 // CommitStoreTestSuite is a test suite to be used for all tree backends.
@@ -123,6 +125,72 @@ func (s *CommitStoreTestSuite) TestStore_Snapshotter() {
 	}
 }
 
+func (s *CommitStoreTestSuite) TestStore_SnapshotterRestoreStores() {
+	storeKeys := []string{storeKey1, storeKey2, storeKey3}
+	commitStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+
+	latestVersion := uint64(10)
+	kvCount := 10
+	for i := uint64(1); i <= latestVersion; i++ {
+		kvPairs := make(map[string]corestore.KVPairs)
+		for _, storeKey := range storeKeys {
+			for j := 0; j < kvCount; j++ {
+				key := []byte(fmt.Sprintf("key-%d-%d", i, j))
+				value := []byte(fmt.Sprintf("value-%d-%d", i, j))
+				kvPairs[storeKey] = append(kvPairs[storeKey], corestore.KVPair{Key: key, Value: value})
+			}
+		}
+		s.Require().NoError(commitStore.WriteChangeset(corestore.NewChangesetWithPairs(kvPairs)))
+
+		_, err = commitStore.Commit(i)
+		s.Require().NoError(err)
+	}
+
+	snapshotStore, err := snapshots.NewStore(s.T().TempDir())
+	s.Require().NoError(err)
+	opts := snapshots.SnapshotOptions{RestoreConcurrency: 2}
+	manager := snapshots.NewManager(snapshotStore, opts, commitStore, nil, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(latestVersion)
+	s.Require().NoError(err)
+
+	// restore the stores concurrently
+	targetStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	storage := &leavesCounter{}
+	targetManager := snapshots.NewManager(snapshotStore, opts, targetStore, storage, nil, log.NewNopLogger())
+	s.Require().NoError(targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format))
+
+	s.Require().Equal(len(storeKeys)*kvCount*int(latestVersion), storage.count)
+
+	cInfo, err := commitStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+	targetCInfo, err := targetStore.GetCommitInfo(latestVersion)
+	s.Require().NoError(err)
+	s.Require().Equal(cInfo.Hash(), targetCInfo.Hash())
+
+	// a store whose root hash does not match the snapshot is rejected
+	exporter, err := commitStore.multiTrees[storeKey1].Export(latestVersion)
+	s.Require().NoError(err)
+	defer exporter.Close()
+	nodeReader := func() (*snapshotstypes.SnapshotIAVLItem, error) {
+		node, err := exporter.Next()
+		if errors.Is(err, ErrorExportDone) {
+			return nil, io.EOF
+		}
+		return node, err
+	}
+
+	mismatchStore, err := s.NewStore(dbm.NewMemDB(), storeKeys, log.NewNopLogger())
+	s.Require().NoError(err)
+	chStorage := make(chan *corestore.StateChanges, len(storeKeys)*kvCount*int(latestVersion))
+	err = mismatchStore.RestoreStore(latestVersion, snapshotstypes.CurrentFormat, snapshotstypes.SnapshotStoreItem{
+		Name: storeKey1,
+		Hash: []byte("invalid hash"),
+	}, nodeReader, chStorage)
+	s.Require().ErrorIs(err, snapshotstypes.ErrStoreHashMismatch)
+}
+
 func (s *CommitStoreTestSuite) TestStore_Pruning() {
 	storeKeys := []string{storeKey1, storeKey2}
 	pruneOpts := &store.PruneOptions{
@@ -166,3 +234,15 @@ func (s *CommitStoreTestSuite) TestStore_Pruning() {
 		}
 	}
 }
+
+// leavesCounter is a snapshots.StorageSnapshotter counting the restored leaves.
+type leavesCounter struct {
+	count int
+}
+
+func (c *leavesCounter) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	for changes := range chStorage {
+		c.count += len(changes.StateChanges)
+	}
+	return nil
+}
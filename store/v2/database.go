@@ -25,6 +25,29 @@ type VersionedDatabase interface {
 	io.Closer
 }
 
+// VersionIterator defines an iterator over the values of a single key across a
+// range of versions, in ascending order.
+type VersionIterator interface {
+	// Version returns the current version of the iterator.
+	Version() uint64
+
+	// Value returns the value of the key at the current version. It returns nil
+	// if the key does not exist at that version.
+	Value() []byte
+
+	// Next moves the iterator to the next version.
+	Next()
+
+	// Valid returns whether the iterator is positioned at a valid version.
+	Valid() bool
+
+	// Error returns the last error encountered by the iterator, if any.
+	Error() error
+
+	// Close releases associated resources.
+	Close() error
+}
+
 // Committer defines an API for committing state.
 type Committer interface {
 	// WriteChangeset writes the changeset to the commitment state.
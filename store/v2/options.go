@@ -38,6 +38,39 @@ func (opts *PruneOptions) ShouldPrune(version uint64) (bool, uint64) {
 	return false, 0
 }
 
+// HistoricalOptions defines the retention policy of historical queries served
+// purely from the state storage (SS) backend. It is independent of the pruning
+// of the state commitment (SC) backend, which allows a node to keep a small SC
+// footprint while still serving reads at old heights from SS.
+//
+// NOTE: The retention window can never exceed what the SS backend still holds,
+// i.e. the SS PruneOptions must keep at least as many versions.
+type HistoricalOptions struct {
+	// KeepRecent sets the number of recent versions, besides the latest one,
+	// that can be queried. If set to 0, every version available in SS can be
+	// queried.
+	KeepRecent uint64
+}
+
+// DefaultHistoricalOptions returns the default historical query options.
+// KeepRecent is set to 0, which means every version available in SS can be
+// queried.
+func DefaultHistoricalOptions() *HistoricalOptions {
+	return &HistoricalOptions{
+		KeepRecent: 0,
+	}
+}
+
+// EarliestVersion returns the earliest version that can be queried given the
+// latest version.
+func (opts *HistoricalOptions) EarliestVersion(latestVersion uint64) uint64 {
+	if opts.KeepRecent == 0 || latestVersion <= opts.KeepRecent {
+		return 0
+	}
+
+	return latestVersion - opts.KeepRecent
+}
+
 // DBOptions defines the interface of a database options.
 type DBOptions interface {
 	Get(string) interface{}
@@ -41,6 +41,10 @@ type FactoryOptions struct {
 	IavlConfig     *iavl.Config
 	StoreKeys      []string
 	SCRawDB        corestore.KVStoreWithBatch
+
+	// HistoricalOptions enables historical queries served purely from SS, with a
+	// retention policy independent of SCPruneOptions. It is optional.
+	HistoricalOptions *store.HistoricalOptions
 }
 
 // CreateRootStore is a convenience function to create a root store based on the
@@ -103,5 +107,11 @@ func CreateRootStore(opts *FactoryOptions) (store.RootStore, error) {
 
 	pm := pruning.NewManager(sc, ss, opts.SCPruneOptions, opts.SSPruneOptions)
 
-	return New(opts.Logger, ss, sc, pm, nil, nil)
+	rs, err := New(opts.Logger, ss, sc, pm, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	rs.(*Store).SetHistoricalOptions(opts.HistoricalOptions)
+
+	return rs, nil
 }
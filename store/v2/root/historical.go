@@ -0,0 +1,118 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/storage"
+)
+
+var _ store.HistoricalRootStore = (*Store)(nil)
+
+// ErrHistoricalQueriesDisabled is returned when the historical query path is
+// used on a Store without HistoricalOptions.
+var ErrHistoricalQueriesDisabled = errors.New("historical queries are disabled")
+
+// SetHistoricalOptions enables the historical query path, which serves reads
+// purely from the SS backend, and sets its retention policy. The retention is
+// independent of the SC pruning options, so StateAt keeps working for versions
+// that have been pruned from the SC backend but are still retained by SS.
+// Passing nil disables the historical query path.
+func (s *Store) SetHistoricalOptions(opts *store.HistoricalOptions) {
+	s.historicalOptions = opts
+}
+
+// EarliestHistoricalVersion returns the earliest version that can be queried
+// through the historical query path, according to the retention policy. Note,
+// the SS backend may have pruned further, in which case reads return
+// ErrVersionPruned.
+func (s *Store) EarliestHistoricalVersion() (uint64, error) {
+	if s.historicalOptions == nil {
+		return 0, ErrHistoricalQueriesDisabled
+	}
+
+	latestVersion, err := s.stateStorage.GetLatestVersion()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest SS version: %w", err)
+	}
+
+	return s.historicalOptions.EarliestVersion(latestVersion), nil
+}
+
+// HistoricalStateAt returns a read-only view of the SS backend at the provided
+// version without consulting the SC backend.
+func (s *Store) HistoricalStateAt(v uint64) (corestore.ReaderMap, error) {
+	if err := s.validateHistoricalVersion(v); err != nil {
+		return nil, err
+	}
+
+	return NewReaderMap(v, s), nil
+}
+
+// HistoricalQuery queries the SS backend for the given store key, version and
+// key tuple. Unlike Query, it never falls back to the SC backend and cannot
+// return proofs.
+func (s *Store) HistoricalQuery(storeKey []byte, version uint64, key []byte) (store.QueryResult, error) {
+	if s.telemetry != nil {
+		now := time.Now()
+		defer s.telemetry.MeasureSince(now, "root_store", "historical_query")
+	}
+
+	if err := s.validateHistoricalVersion(version); err != nil {
+		return store.QueryResult{}, err
+	}
+
+	val, err := s.stateStorage.Get(storeKey, version, key)
+	if err != nil {
+		return store.QueryResult{}, fmt.Errorf("failed to query SS store: %w", err)
+	}
+
+	return store.QueryResult{
+		Key:     key,
+		Value:   val,
+		Version: version,
+	}, nil
+}
+
+// VersionIterator returns an iterator over the values of the given key at every
+// version in the inclusive range [start, end], read from the SS backend. Both
+// bounds must be within the historical retention window.
+func (s *Store) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	if start > end {
+		return nil, fmt.Errorf("start version %d is after end version %d", start, end)
+	}
+	if err := s.validateHistoricalVersion(start); err != nil {
+		return nil, err
+	}
+	if err := s.validateHistoricalVersion(end); err != nil {
+		return nil, err
+	}
+
+	return storage.NewVersionIterator(s.stateStorage, storeKey, key, start, end)
+}
+
+// validateHistoricalVersion returns an error if the historical query path is
+// disabled or the version is outside of the historical retention window.
+func (s *Store) validateHistoricalVersion(v uint64) error {
+	if s.historicalOptions == nil {
+		return ErrHistoricalQueriesDisabled
+	}
+
+	latestVersion, err := s.stateStorage.GetLatestVersion()
+	if err != nil {
+		return fmt.Errorf("failed to get latest SS version: %w", err)
+	}
+	if v > latestVersion {
+		return fmt.Errorf("version %d is greater than the latest SS version %d", v, latestVersion)
+	}
+
+	if earliest := s.historicalOptions.EarliestVersion(latestVersion); v < earliest {
+		return storeerrors.ErrVersionPruned{RequestedVersion: v, EarliestVersion: earliest}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package root
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/core/log"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/store/v2"
+	"cosmossdk.io/store/v2/commitment"
+	"cosmossdk.io/store/v2/commitment/iavl"
+	dbm "cosmossdk.io/store/v2/db"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/pruning"
+	"cosmossdk.io/store/v2/storage"
+	"cosmossdk.io/store/v2/storage/sqlite"
+)
+
+type HistoricalTestSuite struct {
+	suite.Suite
+
+	rootStore *Store
+}
+
+func TestHistoricalTestSuite(t *testing.T) {
+	suite.Run(t, &HistoricalTestSuite{})
+}
+
+func (s *HistoricalTestSuite) SetupTest() {
+	noopLog := log.NewNopLogger()
+
+	sqliteDB, err := sqlite.New(s.T().TempDir())
+	s.Require().NoError(err)
+	ss := storage.NewStorageStore(sqliteDB, noopLog)
+
+	tree := iavl.NewIavlTree(dbm.NewMemDB(), noopLog, iavl.DefaultConfig())
+	sc, err := commitment.NewCommitStore(map[string]commitment.Tree{testStoreKey: tree}, dbm.NewMemDB(), noopLog)
+	s.Require().NoError(err)
+
+	// prune the SC aggressively while keeping every version in SS
+	pm := pruning.NewManager(sc, ss, &store.PruneOptions{KeepRecent: 1, Interval: 1}, nil)
+	rs, err := New(noopLog, ss, sc, pm, nil, nil)
+	s.Require().NoError(err)
+
+	s.rootStore = rs.(*Store)
+
+	// write the key at versions 1 through 10, removing it at version 8
+	for v := uint64(1); v <= 10; v++ {
+		cs := corestore.NewChangeset()
+		if v == 8 {
+			cs.Add(testStoreKeyBytes, []byte("key"), nil, true)
+		} else {
+			cs.Add(testStoreKeyBytes, []byte("key"), []byte(fmt.Sprintf("val%03d", v)), false)
+		}
+
+		_, err := s.rootStore.Commit(cs)
+		s.Require().NoError(err)
+	}
+
+	// wait for the pruning to finish in the commitment store
+	checkSCPrune := func() bool {
+		_, err := sc.GetProof(testStoreKeyBytes, 7, []byte("key"))
+		return err != nil
+	}
+	s.Require().Eventually(checkSCPrune, 10*time.Second, 100*time.Millisecond)
+}
+
+func (s *HistoricalTestSuite) TearDownTest() {
+	s.Require().NoError(s.rootStore.Close())
+}
+
+func (s *HistoricalTestSuite) TestDisabled() {
+	// the SC backend has pruned version 5, so StateAt fails without historical queries
+	_, err := s.rootStore.StateAt(5)
+	s.Require().Error(err)
+
+	_, err = s.rootStore.HistoricalStateAt(5)
+	s.Require().ErrorIs(err, ErrHistoricalQueriesDisabled)
+
+	_, err = s.rootStore.HistoricalQuery(testStoreKeyBytes, 5, []byte("key"))
+	s.Require().ErrorIs(err, ErrHistoricalQueriesDisabled)
+
+	_, err = s.rootStore.VersionIterator(testStoreKeyBytes, []byte("key"), 5, 6)
+	s.Require().ErrorIs(err, ErrHistoricalQueriesDisabled)
+
+	_, err = s.rootStore.EarliestHistoricalVersion()
+	s.Require().ErrorIs(err, ErrHistoricalQueriesDisabled)
+}
+
+func (s *HistoricalTestSuite) TestStateAt() {
+	s.rootStore.SetHistoricalOptions(&store.HistoricalOptions{KeepRecent: 5})
+
+	earliest, err := s.rootStore.EarliestHistoricalVersion()
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), earliest)
+
+	// versions pruned from SC are served from SS within the retention window
+	for v := uint64(5); v <= 10; v++ {
+		ro, err := s.rootStore.StateAt(v)
+		s.Require().NoError(err)
+
+		reader, err := ro.GetReader(testStoreKeyBytes)
+		s.Require().NoError(err)
+		bz, err := reader.Get([]byte("key"))
+		s.Require().NoError(err)
+		if v == 8 {
+			s.Require().Nil(bz)
+		} else {
+			s.Require().Equal([]byte(fmt.Sprintf("val%03d", v)), bz)
+		}
+	}
+
+	// versions outside of the retention window are rejected even though SS has them
+	_, err = s.rootStore.StateAt(4)
+	s.Require().ErrorIs(err, storeerrors.ErrVersionPruned{RequestedVersion: 4, EarliestVersion: 5})
+
+	_, err = s.rootStore.StateAt(11)
+	s.Require().Error(err)
+}
+
+func (s *HistoricalTestSuite) TestHistoricalQuery() {
+	s.rootStore.SetHistoricalOptions(store.DefaultHistoricalOptions())
+
+	earliest, err := s.rootStore.EarliestHistoricalVersion()
+	s.Require().NoError(err)
+	s.Require().Zero(earliest)
+
+	for v := uint64(1); v <= 10; v++ {
+		res, err := s.rootStore.HistoricalQuery(testStoreKeyBytes, v, []byte("key"))
+		s.Require().NoError(err)
+		s.Require().Equal(v, res.Version)
+		s.Require().Nil(res.ProofOps)
+		if v == 8 {
+			s.Require().Nil(res.Value)
+		} else {
+			s.Require().Equal([]byte(fmt.Sprintf("val%03d", v)), res.Value)
+		}
+	}
+
+	_, err = s.rootStore.HistoricalQuery(testStoreKeyBytes, 11, []byte("key"))
+	s.Require().Error(err)
+}
+
+func (s *HistoricalTestSuite) TestVersionIterator() {
+	s.rootStore.SetHistoricalOptions(&store.HistoricalOptions{KeepRecent: 5})
+
+	itr, err := s.rootStore.VersionIterator(testStoreKeyBytes, []byte("key"), 6, 10)
+	s.Require().NoError(err)
+	defer itr.Close()
+
+	var (
+		versions []uint64
+		values   [][]byte
+	)
+	for ; itr.Valid(); itr.Next() {
+		versions = append(versions, itr.Version())
+		values = append(values, itr.Value())
+	}
+	s.Require().NoError(itr.Error())
+	s.Require().Equal([]uint64{6, 7, 8, 9, 10}, versions)
+	s.Require().Equal([][]byte{[]byte("val006"), []byte("val007"), nil, []byte("val009"), []byte("val010")}, values)
+
+	// ranges outside of the retention window or the latest version are rejected
+	_, err = s.rootStore.VersionIterator(testStoreKeyBytes, []byte("key"), 4, 10)
+	s.Require().Error(err)
+	_, err = s.rootStore.VersionIterator(testStoreKeyBytes, []byte("key"), 6, 11)
+	s.Require().Error(err)
+	_, err = s.rootStore.VersionIterator(testStoreKeyBytes, []byte("key"), 8, 6)
+	s.Require().Error(err)
+}
@@ -47,6 +47,10 @@ type Store struct {
 	// pruningManager reflects the pruning manager used to prune state of the SS and SC backends
 	pruningManager *pruning.Manager
 
+	// historicalOptions reflects the retention policy of historical queries served
+	// purely from the SS backend (if enabled)
+	historicalOptions *store.HistoricalOptions
+
 	// Migration related fields
 	// migrationManager reflects the migration manager used to migrate state from v1 to v2
 	migrationManager *migration.Manager
@@ -121,6 +125,12 @@ func (s *Store) StateAt(v uint64) (corestore.ReaderMap, error) {
 	//
 	// Ref: https://github.com/cosmos/cosmos-sdk/issues/19091
 	if cInfo, err := s.stateCommitment.GetCommitInfo(v); err != nil || cInfo == nil {
+		// The SC backend may have pruned the version while the SS backend still
+		// retains it, so serve it through the historical query path if enabled.
+		if s.historicalOptions != nil {
+			return s.HistoricalStateAt(v)
+		}
+
 		return nil, fmt.Errorf("failed to get commit info for version %d: %w", v, err)
 	}
 
@@ -7,6 +7,8 @@ import (
 	"crypto/sha256"
 	"errors"
 	"io"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -287,3 +289,103 @@ func (s *extSnapshotter) RestoreExtension(height uint64, format uint32, payloadR
 	// finalize restoration
 	return nil
 }
+
+// mockStoreCommitSnapshotter snapshots and restores a fixed set of stores, one
+// store at a time.
+type mockStoreCommitSnapshotter struct {
+	mtx       sync.Mutex
+	stores    map[string][]*snapshotstypes.SnapshotIAVLItem
+	calls     map[string]int
+	failStore string
+	finalized bool
+}
+
+var _ snapshots.StoreCommitSnapshotter = (*mockStoreCommitSnapshotter)(nil)
+
+func newMockStoreCommitSnapshotter(stores map[string][]*snapshotstypes.SnapshotIAVLItem) *mockStoreCommitSnapshotter {
+	return &mockStoreCommitSnapshotter{
+		stores: stores,
+		calls:  make(map[string]int),
+	}
+}
+
+func (m *mockStoreCommitSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	names := make([]string, 0, len(m.stores))
+	for name := range m.stores {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+			Item: &snapshotstypes.SnapshotItem_Store{Store: &snapshotstypes.SnapshotStoreItem{Name: name}},
+		}); err != nil {
+			return err
+		}
+		for _, node := range m.stores[name] {
+			if err := protoWriter.WriteMsg(&snapshotstypes.SnapshotItem{
+				Item: &snapshotstypes.SnapshotItem_IAVL{IAVL: node},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *mockStoreCommitSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges,
+) (snapshotstypes.SnapshotItem, error) {
+	return snapshotstypes.SnapshotItem{}, errors.New("stores must be restored one at a time")
+}
+
+func (m *mockStoreCommitSnapshotter) RestoreStore(
+	height uint64, format uint32, item snapshotstypes.SnapshotStoreItem, nodeReader snapshots.StoreNodeReader, chStorage chan<- *corestore.StateChanges,
+) error {
+	m.mtx.Lock()
+	m.calls[item.Name]++
+	m.mtx.Unlock()
+
+	var nodes []*snapshotstypes.SnapshotIAVLItem
+	for {
+		node, err := nodeReader()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+		if node.Height == 0 {
+			chStorage <- &corestore.StateChanges{
+				Actor:        []byte(item.Name),
+				StateChanges: []corestore.KVPair{{Key: node.Key, Value: node.Value}},
+			}
+		}
+		nodes = append(nodes, node)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if item.Name == m.failStore {
+		return errors.New("mock restore store error")
+	}
+	m.stores[item.Name] = nodes
+	return nil
+}
+
+func (m *mockStoreCommitSnapshotter) FinalizeRestore(version uint64) error {
+	m.finalized = true
+	return nil
+}
+
+// recordingStorageSnapshotter records the restored leaves by store.
+type recordingStorageSnapshotter struct {
+	leaves map[string]int
+}
+
+func (m *recordingStorageSnapshotter) Restore(version uint64, chStorage <-chan *corestore.StateChanges) error {
+	m.leaves = make(map[string]int)
+	for changes := range chStorage {
+		m.leaves[string(changes.Actor)] += len(changes.StateChanges)
+	}
+	return nil
+}
@@ -49,6 +49,9 @@ type Manager struct {
 	chRestoreDone     <-chan restoreDone
 	restoreSnapshot   *types.Snapshot
 	restoreChunkIndex uint32
+	// restoreAppliedChunks is the number of chunks applied by a previous restore of
+	// the snapshot, which are replayed from disk instead of being applied again.
+	restoreAppliedChunks uint32
 }
 
 // operation represents a Manager operation. Only one operation can be in progress at a time.
@@ -144,6 +147,7 @@ func (m *Manager) endLocked() {
 	m.chRestoreDone = nil
 	m.restoreSnapshot = nil
 	m.restoreChunkIndex = 0
+	m.restoreAppliedChunks = 0
 }
 
 // GetInterval returns snapshot interval represented in heights.
@@ -335,7 +339,17 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 		return errorsmod.Wrapf(err, "failed to create snapshot directory %q", dir)
 	}
 
-	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, chChunkIDs)
+	// resume an interrupted restore of the same snapshot from the last applied chunk
+	appliedChunks, err := m.store.appliedChunks(snapshot)
+	if err != nil {
+		m.endLocked()
+		return err
+	}
+	if appliedChunks > 0 {
+		m.logger.Info("resuming snapshot restore", "height", snapshot.Height, "format", snapshot.Format, "applied_chunks", appliedChunks)
+	}
+
+	chChunks := m.loadChunkStream(snapshot.Height, snapshot.Format, appliedChunks, chChunkIDs)
 
 	go func() {
 		err := m.doRestoreSnapshot(snapshot, chChunks)
@@ -350,14 +364,26 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.chRestoreDone = chDone
 	m.restoreSnapshot = &snapshot
 	m.restoreChunkIndex = 0
+	m.restoreAppliedChunks = appliedChunks
 	return nil
 }
 
-func (m *Manager) loadChunkStream(height uint64, format uint32, chunkIDs <-chan uint32) <-chan io.ReadCloser {
+// loadChunkStream loads the chunks already applied, then the chunks whose IDs are
+// received, from disk.
+func (m *Manager) loadChunkStream(height uint64, format, appliedChunks uint32, chunkIDs <-chan uint32) <-chan io.ReadCloser {
 	chunks := make(chan io.ReadCloser, chunkBufferSize)
 	go func() {
 		defer close(chunks)
 
+		for chunkID := uint32(0); chunkID < appliedChunks; chunkID++ {
+			chunk, err := m.store.loadChunkFile(height, format, chunkID)
+			if err != nil {
+				m.logger.Error("load chunk file failed", "height", height, "format", format, "chunk", chunkID, "err", err)
+				return
+			}
+			chunks <- chunk
+		}
+
 		for chunkID := range chunkIDs {
 			chunk, err := m.store.loadChunkFile(height, format, chunkID)
 			if err != nil {
@@ -400,7 +426,6 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 
 	// chStorage is the channel to pass the KV pairs to the storage snapshotter.
 	chStorage := make(chan *corestore.StateChanges, defaultStorageChannelBufferSize)
-	defer close(chStorage)
 
 	storageErrs := make(chan error, 1)
 	go func() {
@@ -408,11 +433,22 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		err := m.storageSnapshotter.Restore(snapshot.Height, chStorage)
 		if err != nil {
 			storageErrs <- err
+			// drain the channel so the commitment restore is not blocked
+			for range chStorage {
+			}
 		}
 	}()
 
-	nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	if storeSnapshotter, ok := m.commitSnapshotter.(StoreCommitSnapshotter); ok {
+		nextItem, err = m.restoreStores(snapshot, storeSnapshotter, streamReader, chStorage)
+	} else {
+		nextItem, err = m.commitSnapshotter.Restore(snapshot.Height, snapshot.Format, streamReader, chStorage)
+	}
+	// the commitment restore is the only producer of the storage changes
+	close(chStorage)
 	if err != nil {
+		// wait for the storage snapshotter to stop, so a new restore can start
+		<-storageErrs
 		return errorsmod.Wrap(err, "multistore restore")
 	}
 
@@ -447,7 +483,7 @@ func (m *Manager) doRestoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.
 		return errorsmod.Wrap(err, "storage snapshotter")
 	}
 
-	return nil
+	return m.store.deleteRestoreProgress(snapshot)
 }
 
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
@@ -482,14 +518,25 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 			"expected %x, got %x", hash, expected)
 	}
 
-	if err := m.store.saveChunkContent(chunk, m.restoreChunkIndex, m.restoreSnapshot); err != nil {
-		return false, errorsmod.Wrapf(err, "save chunk content %d", m.restoreChunkIndex)
-	}
+	// The chunks applied by a previous restore are already being replayed from disk.
+	if m.restoreChunkIndex >= m.restoreAppliedChunks {
+		if err := m.store.saveChunkContent(chunk, m.restoreChunkIndex, m.restoreSnapshot); err != nil {
+			return false, errorsmod.Wrapf(err, "save chunk content %d", m.restoreChunkIndex)
+		}
 
-	// Pass the chunk to the restore, and wait for completion if it was the final one.
-	m.chRestore <- m.restoreChunkIndex
+		appliedChunks := m.restoreChunkIndex + 1
+		if err := m.store.updateRestoreProgress(*m.restoreSnapshot, func(progress *restoreProgress) {
+			progress.Chunks = appliedChunks
+		}); err != nil {
+			return false, errorsmod.Wrapf(err, "save restore progress of chunk %d", m.restoreChunkIndex)
+		}
+
+		// Pass the chunk to the restore.
+		m.chRestore <- m.restoreChunkIndex
+	}
 	m.restoreChunkIndex++
 
+	// Wait for completion if it was the final chunk.
 	if int(m.restoreChunkIndex) >= len(m.restoreSnapshot.Metadata.ChunkHashes) {
 		close(m.chRestore)
 		m.chRestore = nil
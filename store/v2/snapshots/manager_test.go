@@ -1,7 +1,10 @@
 package snapshots_test
 
 import (
+	"bytes"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -254,3 +257,118 @@ func TestManager_TakeError(t *testing.T) {
 	_, err = manager.Create(1)
 	require.Error(t, err)
 }
+
+func TestManager_RestoreStores(t *testing.T) {
+	// each store has a root and two leaves
+	newNodes := func(name string) []*types.SnapshotIAVLItem {
+		return []*types.SnapshotIAVLItem{
+			{Key: []byte(name + "1"), Version: 1, Height: 1},
+			{Key: []byte(name + "0"), Value: []byte{0}, Version: 1, Height: 0},
+			{Key: []byte(name + "1"), Value: []byte{1}, Version: 1, Height: 0},
+		}
+	}
+	source := newMockStoreCommitSnapshotter(map[string][]*types.SnapshotIAVLItem{
+		"a": newNodes("a"),
+		"b": newNodes("b"),
+		"c": newNodes("c"),
+	})
+
+	store, err := snapshots.NewStore(t.TempDir())
+	require.NoError(t, err)
+	manager := snapshots.NewManager(store, opts, source, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	snapshot, err := manager.Create(5)
+	require.NoError(t, err)
+
+	// the first restore fails on store b, after store a has been restored
+	restoreOpts := opts
+	restoreOpts.RestoreConcurrency = 1
+	target := newMockStoreCommitSnapshotter(map[string][]*types.SnapshotIAVLItem{})
+	target.failStore = "b"
+	storage := &recordingStorageSnapshotter{}
+	targetManager := snapshots.NewManager(store, restoreOpts, target, storage, nil, log.NewNopLogger())
+	err = targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.ErrorContains(t, err, "mock restore store error")
+	require.False(t, target.finalized)
+	require.Equal(t, source.stores["a"], target.stores["a"])
+
+	// resuming the restore skips the import of store a, but still restores its leaves
+	restoreOpts.RestoreConcurrency = 2
+	target.failStore = ""
+	targetManager = snapshots.NewManager(store, restoreOpts, target, storage, nil, log.NewNopLogger())
+	err = targetManager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	require.True(t, target.finalized)
+	require.Equal(t, source.stores, target.stores)
+	require.Equal(t, map[string]int{"a": 1, "b": 2, "c": 1}, target.calls)
+	require.Equal(t, map[string]int{"a": 2, "b": 2, "c": 2}, storage.leaves)
+
+	// the restore progress is removed once the restore completes
+	_, err = os.Stat(filepath.Join(filepath.Dir(store.PathChunk(snapshot.Height, snapshot.Format, 0)), "restore_progress"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestManager_RestoreResumeChunks(t *testing.T) {
+	store := setupStore(t)
+	expectItems := [][]byte{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+
+	// split the snapshot stream in 3 chunks
+	stream := bytes.Join(snapshotItems(expectItems, newExtSnapshotter(10)), nil)
+	size := len(stream)/3 + 1
+	var chunks [][]byte
+	for len(stream) > 0 {
+		n := min(size, len(stream))
+		chunks = append(chunks, stream[:n])
+		stream = stream[n:]
+	}
+	require.Len(t, chunks, 3)
+
+	snapshot := types.Snapshot{
+		Height:   3,
+		Format:   types.CurrentFormat,
+		Hash:     []byte{1, 2, 3},
+		Chunks:   uint32(len(chunks)),
+		Metadata: types.Metadata{ChunkHashes: checksums(chunks)},
+	}
+
+	// the first restore is interrupted after 2 chunks
+	manager := snapshots.NewManager(store, opts, &mockCommitSnapshotter{}, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(newExtSnapshotter(0)))
+	require.NoError(t, manager.Restore(snapshot))
+	for _, chunk := range chunks[:2] {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.False(t, done)
+	}
+
+	progressPath := filepath.Join(filepath.Dir(store.PathChunk(snapshot.Height, snapshot.Format, 0)), "restore_progress")
+	bz, err := os.ReadFile(progressPath)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"chunks":2`)
+
+	// the restore resumes from the last applied chunk: the applied chunks are
+	// replayed from disk, and are only verified when they are received again.
+	target := &mockCommitSnapshotter{}
+	extSnapshotter := newExtSnapshotter(0)
+	manager = snapshots.NewManager(store, opts, target, &mockStorageSnapshotter{}, nil, log.NewNopLogger())
+	require.NoError(t, manager.RegisterExtensions(extSnapshotter))
+	require.NoError(t, manager.Restore(snapshot))
+
+	_, err = manager.RestoreChunk([]byte{9, 9, 9})
+	require.ErrorIs(t, err, types.ErrChunkHashMismatch)
+
+	for i, chunk := range chunks {
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == len(chunks)-1, done)
+	}
+	require.Equal(t, expectItems, target.items)
+	require.Equal(t, 10, len(extSnapshotter.state))
+
+	// the restore progress is removed once the restore completes
+	_, err = os.Stat(progressPath)
+	require.True(t, os.IsNotExist(err))
+}
@@ -8,6 +8,11 @@ type SnapshotOptions struct {
 
 	// KeepRecent defines how many snapshots to keep in heights.
 	KeepRecent uint32
+
+	// RestoreConcurrency defines how many stores are restored concurrently when
+	// the CommitSnapshotter supports restoring stores independently. If set to
+	// 0, the number of CPUs is used.
+	RestoreConcurrency uint32
 }
 
 func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
@@ -0,0 +1,198 @@
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"io"
+	"runtime"
+
+	protoio "github.com/cosmos/gogoproto/io"
+	"golang.org/x/sync/errgroup"
+
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	storeerrors "cosmossdk.io/store/v2/errors"
+	"cosmossdk.io/store/v2/snapshots/types"
+)
+
+// nodeBufferSize is the number of IAVL nodes buffered for each store being
+// restored, which lets the stream reader move on while the store imports them.
+const nodeBufferSize = 4096
+
+// errRestoreAborted is returned by a StoreNodeReader when the restore has been
+// aborted before all the nodes of the store have been read.
+var errRestoreAborted = errors.New("snapshot restore aborted")
+
+// storeStream streams the IAVL nodes of a store to the worker restoring it.
+type storeStream struct {
+	ch      chan *types.SnapshotIAVLItem
+	aborted bool
+}
+
+func newStoreStream() *storeStream {
+	return &storeStream{ch: make(chan *types.SnapshotIAVLItem, nodeBufferSize)}
+}
+
+// next implements StoreNodeReader.
+func (s *storeStream) next() (*types.SnapshotIAVLItem, error) {
+	node, ok := <-s.ch
+	if !ok {
+		if s.aborted {
+			return nil, errRestoreAborted
+		}
+		return nil, io.EOF
+	}
+	return node, nil
+}
+
+// close ends the stream, aborted reports whether all nodes have been sent.
+func (s *storeStream) close(aborted bool) {
+	s.aborted = aborted
+	close(s.ch)
+}
+
+// restoreConcurrency returns the number of stores restored concurrently.
+func (m *Manager) restoreConcurrency() int {
+	if m.opts.RestoreConcurrency == 0 {
+		return runtime.NumCPU()
+	}
+	return int(m.opts.RestoreConcurrency)
+}
+
+// restoreStores restores the commitment state from the snapshot stream through
+// a StoreCommitSnapshotter. The stream is read sequentially, but the nodes of
+// each store are dispatched to a dedicated worker, so that up to
+// RestoreConcurrency stores are imported concurrently, each one verifying its
+// own root hash.
+//
+// Every store restored successfully is recorded in the restore progress of the
+// snapshot. If the restore is interrupted, e.g. by a crash, the next restore of
+// the same snapshot skips the import of the recorded stores and only forwards
+// their leaves to the storage snapshotter, since the SS backend may not have
+// flushed them yet.
+//
+// It returns the first snapshot item which does not belong to a store, e.g. an
+// extension item, or an empty item at the end of the stream.
+func (m *Manager) restoreStores(
+	snapshot types.Snapshot,
+	snapshotter StoreCommitSnapshotter,
+	protoReader protoio.Reader,
+	chStorage chan<- *corestore.StateChanges,
+) (types.SnapshotItem, error) {
+	progress, err := m.store.loadRestoreProgress(snapshot)
+	if err != nil {
+		return types.SnapshotItem{}, err
+	}
+	restored := make(map[string]bool, len(progress.Stores))
+	for _, name := range progress.Stores {
+		restored[name] = true
+	}
+	if len(restored) > 0 {
+		m.logger.Info("resuming snapshot restore", "height", snapshot.Height, "format", snapshot.Format, "restored_stores", len(restored))
+	}
+
+	markRestored := func(name string) error {
+		return m.store.updateRestoreProgress(snapshot, func(progress *restoreProgress) {
+			progress.Stores = append(progress.Stores, name)
+		})
+	}
+
+	g, ctx := errgroup.WithContext(context.Background())
+	g.SetLimit(m.restoreConcurrency())
+
+	var (
+		item types.SnapshotItem
+		// stream is the stream of the store being restored, if any
+		stream *storeStream
+		// skipped is the name of the store being skipped, if any
+		skipped string
+	)
+	endStore := func(aborted bool) {
+		if stream != nil {
+			stream.close(aborted)
+			stream = nil
+		}
+		skipped = ""
+	}
+
+	readErr := func() error {
+		for {
+			item = types.SnapshotItem{}
+			err := protoReader.ReadMsg(&item)
+			if errors.Is(err, io.EOF) {
+				return nil
+			} else if err != nil {
+				return errorsmod.Wrap(err, "invalid protobuf message")
+			}
+
+			switch it := item.Item.(type) {
+			case *types.SnapshotItem_Store:
+				endStore(false)
+
+				storeItem := *it.Store
+				if restored[storeItem.Name] {
+					m.logger.Debug("skipping restored store", "store", storeItem.Name)
+					skipped = storeItem.Name
+					continue
+				}
+
+				stream = newStoreStream()
+				nodeReader := stream.next
+				g.Go(func() error {
+					// do not start restoring a store once another one has failed
+					if err := ctx.Err(); err != nil {
+						return err
+					}
+					if err := snapshotter.RestoreStore(snapshot.Height, snapshot.Format, storeItem, nodeReader, chStorage); err != nil {
+						return errorsmod.Wrapf(err, "restore store %s", storeItem.Name)
+					}
+					return markRestored(storeItem.Name)
+				})
+
+			case *types.SnapshotItem_IAVL:
+				switch {
+				case skipped != "":
+					if it.IAVL.Height == 0 {
+						value := it.IAVL.Value
+						if value == nil {
+							value = []byte{}
+						}
+						chStorage <- &corestore.StateChanges{
+							Actor:        []byte(skipped),
+							StateChanges: []corestore.KVPair{{Key: it.IAVL.Key, Value: value}},
+						}
+					}
+
+				case stream != nil:
+					select {
+					case stream.ch <- it.IAVL:
+					case <-ctx.Done():
+						// a store failed to restore, its error is returned by g.Wait()
+						return ctx.Err()
+					}
+
+				default:
+					return errorsmod.Wrap(storeerrors.ErrLogic, "received IAVL node item before store item")
+				}
+
+			default:
+				return nil
+			}
+		}
+	}()
+	// on error, the store being restored has not been fully received
+	endStore(readErr != nil)
+
+	if err := g.Wait(); err != nil {
+		return types.SnapshotItem{}, err
+	}
+	if readErr != nil {
+		return types.SnapshotItem{}, readErr
+	}
+
+	if err := snapshotter.FinalizeRestore(snapshot.Height); err != nil {
+		return types.SnapshotItem{}, errorsmod.Wrap(err, "finalize restore")
+	}
+
+	return item, nil
+}
@@ -17,12 +17,34 @@ type CommitSnapshotter interface {
 	Restore(version uint64, format uint32, protoReader protoio.Reader, chStorage chan<- *corestore.StateChanges) (types.SnapshotItem, error)
 }
 
+// StoreCommitSnapshotter extends CommitSnapshotter to restore the commitment
+// state one store at a time. It allows the Manager to restore different stores
+// concurrently and to resume an interrupted restore without importing again the
+// stores which were already restored.
+type StoreCommitSnapshotter interface {
+	CommitSnapshotter
+
+	// RestoreStore restores a single store from the IAVL nodes returned by the
+	// node reader. The restored root hash must be verified against the hash of
+	// the store item, if set. It must be safe to call concurrently for different
+	// stores.
+	RestoreStore(version uint64, format uint32, item types.SnapshotStoreItem, nodeReader StoreNodeReader, chStorage chan<- *corestore.StateChanges) error
+
+	// FinalizeRestore is called once all the stores have been restored.
+	FinalizeRestore(version uint64) error
+}
+
 // StorageSnapshotter defines an API for restoring snapshots of the storage state.
 type StorageSnapshotter interface {
 	// Restore restores the storage state from the given channel.
 	Restore(version uint64, chStorage <-chan *corestore.StateChanges) error
 }
 
+// StoreNodeReader reads the IAVL nodes of a store, it returns io.EOF once all
+// the nodes of the store have been read. Any other error means the restore has
+// been aborted.
+type StoreNodeReader = func() (*types.SnapshotIAVLItem, error)
+
 // ExtensionPayloadReader read extension payloads,
 // it returns io.EOF when reached either end of stream or the extension boundaries.
 type ExtensionPayloadReader = func() ([]byte, error)
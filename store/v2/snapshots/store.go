@@ -1,8 +1,10 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"hash"
 	"io"
@@ -343,6 +345,101 @@ func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	return nil
 }
 
+// restoreProgress records the chunks which have been received and saved, and the
+// stores which have been fully restored and verified by an in-progress restore,
+// so that it can be resumed after a crash.
+type restoreProgress struct {
+	// Hash is the hash of the snapshot being restored.
+	Hash []byte `json:"hash"`
+	// Chunks is the number of chunks applied, i.e. verified and saved to disk.
+	Chunks uint32 `json:"chunks"`
+	// Stores are the names of the restored stores.
+	Stores []string `json:"stores"`
+}
+
+// loadRestoreProgress loads the restore progress of the given snapshot. An empty
+// progress is returned if there is none, or if it belongs to another snapshot
+// with the same height and format.
+func (s *Store) loadRestoreProgress(snapshot types.Snapshot) (*restoreProgress, error) {
+	progress := &restoreProgress{Hash: snapshot.Hash}
+	bz, err := os.ReadFile(s.pathRestoreProgress(snapshot.Height, snapshot.Format))
+	if os.IsNotExist(err) {
+		return progress, nil
+	} else if err != nil {
+		return nil, errors.Wrap(err, "failed to read restore progress")
+	}
+
+	var saved restoreProgress
+	if err := json.Unmarshal(bz, &saved); err != nil {
+		return nil, errors.Wrap(err, "failed to decode restore progress")
+	}
+	if bytes.Equal(saved.Hash, snapshot.Hash) {
+		progress.Chunks = saved.Chunks
+		progress.Stores = saved.Stores
+	}
+
+	return progress, nil
+}
+
+// updateRestoreProgress applies the update to the restore progress of the given
+// snapshot and saves it. It is safe to call concurrently.
+func (s *Store) updateRestoreProgress(snapshot types.Snapshot, update func(progress *restoreProgress)) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	progress, err := s.loadRestoreProgress(snapshot)
+	if err != nil {
+		return err
+	}
+	update(progress)
+
+	return s.saveRestoreProgress(snapshot, progress)
+}
+
+// appliedChunks returns the number of chunks of the given snapshot applied by a
+// previous restore, which are still on disk with the expected hashes.
+func (s *Store) appliedChunks(snapshot types.Snapshot) (uint32, error) {
+	progress, err := s.loadRestoreProgress(snapshot)
+	if err != nil {
+		return 0, err
+	}
+
+	for index := uint32(0); index < progress.Chunks && int(index) < len(snapshot.Metadata.ChunkHashes); index++ {
+		chunk, err := os.ReadFile(s.PathChunk(snapshot.Height, snapshot.Format, index))
+		if err != nil {
+			return index, nil
+		}
+		if hash := sha256.Sum256(chunk); !bytes.Equal(hash[:], snapshot.Metadata.ChunkHashes[index]) {
+			return index, nil
+		}
+	}
+
+	return min(progress.Chunks, uint32(len(snapshot.Metadata.ChunkHashes))), nil
+}
+
+// saveRestoreProgress saves the restore progress of the given snapshot to disk.
+func (s *Store) saveRestoreProgress(snapshot types.Snapshot, progress *restoreProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode restore progress")
+	}
+	// write to a temporary file first, so a crash never leaves a partial progress
+	path := s.pathRestoreProgress(snapshot.Height, snapshot.Format)
+	if err := os.WriteFile(path+".tmp", bz, 0o600); err != nil {
+		return errors.Wrap(err, "failed to write restore progress")
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// deleteRestoreProgress deletes the restore progress of the given snapshot.
+func (s *Store) deleteRestoreProgress(snapshot types.Snapshot) error {
+	err := os.Remove(s.pathRestoreProgress(snapshot.Height, snapshot.Format))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to delete restore progress")
+	}
+	return nil
+}
+
 // pathHeight generates the path to a height, containing multiple snapshot formats.
 func (s *Store) pathHeight(height uint64) string {
 	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
@@ -362,6 +459,11 @@ func (s *Store) pathMetadata(height uint64, format uint32) string {
 	return filepath.Join(s.pathMetadataDir(), fmt.Sprintf("%020d-%08d", height, format))
 }
 
+// pathRestoreProgress generates the path to the restore progress of a snapshot.
+func (s *Store) pathRestoreProgress(height uint64, format uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), "restore_progress")
+}
+
 // PathChunk generates a snapshot chunk path.
 func (s *Store) PathChunk(height uint64, format, chunk uint32) string {
 	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
@@ -11,6 +11,10 @@ var (
 	// ErrChunkHashMismatch is returned when chunk hash verification failed.
 	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
 
+	// ErrStoreHashMismatch is returned when the root hash of a restored store
+	// does not match the hash recorded in the snapshot.
+	ErrStoreHashMismatch = errors.New("store hash verification failed")
+
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
 
@@ -152,7 +152,6 @@ type SnapshotItem struct {
 	// item is the specific type of snapshot item.
 	//
 	// Types that are valid to be assigned to Item:
-	//
 	//	*SnapshotItem_Store
 	//	*SnapshotItem_IAVL
 	//	*SnapshotItem_Extension
@@ -267,6 +266,9 @@ func (*SnapshotItem) XXX_OneofWrappers() []interface{} {
 // Since: cosmos-sdk 0.46
 type SnapshotStoreItem struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// hash is the root hash of the store at the snapshot height. It is used to
+	// verify the restored store, and is empty for snapshots which do not set it.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
 func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
@@ -309,6 +311,13 @@ func (m *SnapshotStoreItem) GetName() string {
 	return ""
 }
 
+func (m *SnapshotStoreItem) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // SnapshotIAVLItem is an exported IAVL node.
 //
 // Since: cosmos-sdk 0.46
@@ -499,38 +508,39 @@ func init() {
 }
 
 var fileDescriptor_3d5cca1aa5b69183 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
+	// 503 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0xd7, 0xb4, 0x74, 0x2f, 0x41, 0xea, 0xac, 0x81, 0x02, 0x87, 0x2c, 0x84, 0x03, 0x91,
-	0x80, 0x94, 0x65, 0x1c, 0xb9, 0x50, 0x98, 0x94, 0x09, 0x90, 0x26, 0x4f, 0xe2, 0xc0, 0x65, 0xf2,
-	0x56, 0xd3, 0x44, 0x6d, 0xe2, 0xaa, 0xf6, 0x22, 0xfa, 0x2f, 0xf8, 0x23, 0xfc, 0x8f, 0x1d, 0x77,
-	0xe4, 0x34, 0x50, 0xfb, 0x47, 0x90, 0xed, 0x26, 0xa0, 0x6d, 0x45, 0xdb, 0xed, 0x7d, 0x2f, 0xdf,
-	0xf7, 0xf9, 0xf9, 0xcb, 0x33, 0x44, 0xa7, 0x5c, 0x14, 0x5c, 0xf4, 0x85, 0xe4, 0x33, 0xd6, 0x17,
-	0x25, 0x9d, 0x8a, 0x8c, 0x4b, 0xd1, 0xaf, 0x76, 0x1b, 0x10, 0x4f, 0x67, 0x5c, 0x72, 0xfc, 0xc8,
-	0x30, 0x63, 0xcd, 0x8c, 0x1b, 0x66, 0x5c, 0xed, 0x3e, 0xde, 0x1e, 0xf1, 0x11, 0xd7, 0xac, 0xbe,
-	0xaa, 0x8c, 0x20, 0xfc, 0x81, 0xa0, 0x7b, 0xb4, 0xa2, 0xe1, 0x87, 0xd0, 0xc9, 0x58, 0x3e, 0xca,
-	0xa4, 0x87, 0x02, 0x14, 0xd9, 0x64, 0x85, 0x54, 0xff, 0x2b, 0x9f, 0x15, 0x54, 0x7a, 0x1b, 0x01,
-	0x8a, 0xee, 0x93, 0x15, 0x52, 0xfd, 0xd3, 0xec, 0xac, 0x1c, 0x0b, 0xaf, 0x65, 0xfa, 0x06, 0x61,
-	0x0c, 0x76, 0x46, 0x45, 0xe6, 0xd9, 0x01, 0x8a, 0x5c, 0xa2, 0x6b, 0xbc, 0x0f, 0xdd, 0x82, 0x49,
-	0x3a, 0xa4, 0x92, 0x7a, 0xed, 0x00, 0x45, 0x4e, 0xf2, 0x34, 0x5e, 0x3b, 0x6c, 0xfc, 0x69, 0x45,
-	0x1d, 0xd8, 0xe7, 0x97, 0x3b, 0x16, 0x69, 0xa4, 0xe1, 0x4b, 0xe8, 0xd6, 0xdf, 0xf0, 0x13, 0x70,
-	0xf5, 0x81, 0xc7, 0xea, 0x00, 0x26, 0x3c, 0x14, 0xb4, 0x22, 0x97, 0x38, 0xba, 0x97, 0xea, 0x56,
-	0xf8, 0x6b, 0x03, 0xdc, 0xfa, 0x7a, 0x07, 0x92, 0x15, 0xf8, 0x3d, 0xb4, 0xf5, 0x71, 0xfa, 0x86,
-	0x4e, 0xf2, 0xe2, 0x3f, 0x33, 0xd4, 0xba, 0x23, 0xf5, 0x49, 0x89, 0x53, 0x8b, 0x18, 0x31, 0xfe,
-	0x00, 0x76, 0x4e, 0xab, 0x89, 0x8e, 0xc3, 0x49, 0x9e, 0xdf, 0xc2, 0xe4, 0xe0, 0xed, 0xe7, 0x8f,
-	0xca, 0x63, 0xd0, 0x5d, 0x5c, 0xee, 0xd8, 0x0a, 0xa5, 0x16, 0xd1, 0x26, 0xf8, 0x10, 0x36, 0xd9,
-	0x37, 0xc9, 0x4a, 0x91, 0xf3, 0x52, 0x07, 0xe9, 0x24, 0xaf, 0x6e, 0xe1, 0xb8, 0x5f, 0x6b, 0x54,
-	0x1e, 0xa9, 0x45, 0xfe, 0x9a, 0xe0, 0x13, 0xd8, 0x6a, 0xc0, 0xf1, 0x94, 0xce, 0x27, 0x9c, 0x0e,
-	0xf5, 0xcf, 0x70, 0x92, 0xbd, 0xbb, 0x38, 0x1f, 0x1a, 0x69, 0x6a, 0x91, 0x1e, 0xbb, 0xd2, 0x1b,
-	0x74, 0xc0, 0xce, 0x25, 0x2b, 0xc2, 0x67, 0xb0, 0x75, 0x2d, 0x28, 0xb5, 0x00, 0x25, 0x2d, 0x4c,
-	0xc8, 0x9b, 0x44, 0xd7, 0xe1, 0x04, 0x7a, 0x57, 0xc3, 0xc0, 0x3d, 0x68, 0x8d, 0xd9, 0x5c, 0xd3,
-	0x5c, 0xa2, 0x4a, 0xbc, 0x0d, 0xed, 0x8a, 0x4e, 0xce, 0x98, 0x8e, 0xd6, 0x25, 0x06, 0x60, 0x0f,
-	0xee, 0x55, 0x6c, 0xd6, 0x04, 0xd4, 0x22, 0x35, 0xfc, 0x67, 0x65, 0xd5, 0xfd, 0xda, 0xf5, 0xca,
-	0x86, 0xef, 0xe0, 0xc1, 0x8d, 0x41, 0xdd, 0x34, 0xda, 0xba, 0xfd, 0x0e, 0x5f, 0x83, 0xb7, 0x2e,
-	0x13, 0x35, 0x52, 0x9d, 0xac, 0x19, 0xbf, 0x86, 0x83, 0x37, 0xe7, 0x0b, 0x1f, 0x5d, 0x2c, 0x7c,
-	0xf4, 0x7b, 0xe1, 0xa3, 0xef, 0x4b, 0xdf, 0xba, 0x58, 0xfa, 0xd6, 0xcf, 0xa5, 0x6f, 0x7d, 0x09,
-	0x4d, 0xf6, 0x62, 0x38, 0x8e, 0x73, 0x7e, 0xed, 0x35, 0xcb, 0xf9, 0x94, 0x89, 0x93, 0x8e, 0x7e,
-	0x97, 0x7b, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xef, 0xe9, 0x8e, 0x10, 0xf4, 0x03, 0x00, 0x00,
+	0x14, 0x8e, 0xdb, 0xb4, 0x74, 0x2f, 0x45, 0xea, 0xac, 0x81, 0x02, 0x87, 0xac, 0x04, 0x21, 0x45,
+	0x02, 0x52, 0x96, 0x71, 0xe3, 0x80, 0x28, 0x4c, 0xca, 0x04, 0x48, 0x93, 0x27, 0x71, 0xe0, 0x32,
+	0x79, 0xab, 0x69, 0xa2, 0x36, 0x71, 0x55, 0x7b, 0x11, 0xfd, 0x17, 0xfc, 0x11, 0xfe, 0xc7, 0x8e,
+	0x3b, 0x72, 0x1a, 0xa8, 0xfd, 0x23, 0xc8, 0x76, 0x92, 0x4d, 0x63, 0x45, 0xdb, 0xed, 0x7d, 0xcf,
+	0xef, 0x7b, 0x7e, 0xfe, 0xfc, 0x3d, 0x08, 0x4e, 0xb8, 0xc8, 0xb8, 0x18, 0x08, 0xc9, 0xe7, 0x6c,
+	0x20, 0x72, 0x3a, 0x13, 0x09, 0x97, 0x62, 0x50, 0xec, 0xd4, 0x20, 0x9c, 0xcd, 0xb9, 0xe4, 0xf8,
+	0x91, 0xa9, 0x0c, 0x75, 0x65, 0x58, 0x57, 0x86, 0xc5, 0xce, 0xe3, 0xad, 0x31, 0x1f, 0x73, 0x5d,
+	0x35, 0x50, 0x91, 0x21, 0xf8, 0x3f, 0x11, 0x74, 0x0e, 0xcb, 0x32, 0xfc, 0x10, 0xda, 0x09, 0x4b,
+	0xc7, 0x89, 0x74, 0x51, 0x1f, 0x05, 0x36, 0x29, 0x91, 0xca, 0x7f, 0xe3, 0xf3, 0x8c, 0x4a, 0xb7,
+	0xd1, 0x47, 0xc1, 0x7d, 0x52, 0x22, 0x95, 0x3f, 0x49, 0x4e, 0xf3, 0x89, 0x70, 0x9b, 0x26, 0x6f,
+	0x10, 0xc6, 0x60, 0x27, 0x54, 0x24, 0xae, 0xdd, 0x47, 0x41, 0x97, 0xe8, 0x18, 0xef, 0x41, 0x27,
+	0x63, 0x92, 0x8e, 0xa8, 0xa4, 0x6e, 0xab, 0x8f, 0x02, 0x27, 0x7a, 0x1a, 0xae, 0x1d, 0x36, 0xfc,
+	0x5c, 0x96, 0x0e, 0xed, 0xb3, 0x8b, 0x6d, 0x8b, 0xd4, 0x54, 0xff, 0x25, 0x74, 0xaa, 0x33, 0xfc,
+	0x04, 0xba, 0xfa, 0xc2, 0x23, 0x75, 0x01, 0x13, 0x2e, 0xea, 0x37, 0x83, 0x2e, 0x71, 0x74, 0x2e,
+	0xd6, 0x29, 0xff, 0x77, 0x03, 0xba, 0xd5, 0xf3, 0xf6, 0x25, 0xcb, 0xf0, 0x07, 0x68, 0xe9, 0xeb,
+	0xf4, 0x0b, 0x9d, 0xe8, 0xc5, 0x7f, 0x66, 0xa8, 0x78, 0x87, 0xea, 0x48, 0x91, 0x63, 0x8b, 0x18,
+	0x32, 0xfe, 0x08, 0x76, 0x4a, 0x8b, 0xa9, 0x96, 0xc3, 0x89, 0x9e, 0xdf, 0xa2, 0xc9, 0xfe, 0xbb,
+	0x2f, 0x9f, 0x54, 0x8f, 0x61, 0x67, 0x79, 0xb1, 0x6d, 0x2b, 0x14, 0x5b, 0x44, 0x37, 0xc1, 0x07,
+	0xb0, 0xc1, 0xbe, 0x4b, 0x96, 0x8b, 0x94, 0xe7, 0x5a, 0x48, 0x27, 0x7a, 0x75, 0x8b, 0x8e, 0x7b,
+	0x15, 0x47, 0xe9, 0x11, 0x5b, 0xe4, 0xb2, 0x09, 0x3e, 0x86, 0xcd, 0x1a, 0x1c, 0xcd, 0xe8, 0x62,
+	0xca, 0xe9, 0x48, 0x7f, 0x86, 0x13, 0xed, 0xde, 0xa5, 0xf3, 0x81, 0xa1, 0xc6, 0x16, 0xe9, 0xb1,
+	0x6b, 0xb9, 0x61, 0x1b, 0xec, 0x54, 0xb2, 0xcc, 0x7f, 0x03, 0x9b, 0xff, 0x08, 0xa5, 0x0c, 0x90,
+	0xd3, 0xcc, 0x88, 0xbc, 0x41, 0x74, 0x5c, 0x9b, 0xa2, 0x71, 0x69, 0x0a, 0x7f, 0x0a, 0xbd, 0xeb,
+	0x02, 0xe1, 0x1e, 0x34, 0x27, 0x6c, 0xa1, 0xa9, 0x5d, 0xa2, 0x42, 0xbc, 0x05, 0xad, 0x82, 0x4e,
+	0x4f, 0x59, 0x49, 0x35, 0x00, 0xbb, 0x70, 0xaf, 0x60, 0xf3, 0x5a, 0xb4, 0x26, 0xa9, 0xe0, 0x15,
+	0x1b, 0xab, 0x37, 0xb7, 0x2a, 0x1b, 0xfb, 0xef, 0xe1, 0xc1, 0x8d, 0xe2, 0xdd, 0x38, 0xee, 0x1a,
+	0xcf, 0xfb, 0xaf, 0xc1, 0x5d, 0xa7, 0x93, 0x1a, 0xa9, 0x52, 0xdb, 0x8c, 0x5f, 0xc1, 0xe1, 0xdb,
+	0xb3, 0xa5, 0x87, 0xce, 0x97, 0x1e, 0xfa, 0xb3, 0xf4, 0xd0, 0x8f, 0x95, 0x67, 0x9d, 0xaf, 0x3c,
+	0xeb, 0xd7, 0xca, 0xb3, 0xbe, 0x3e, 0x33, 0xff, 0x21, 0x46, 0x93, 0x30, 0xe5, 0xe5, 0x86, 0x17,
+	0xd1, 0x95, 0x25, 0x97, 0x8b, 0x19, 0x13, 0xc7, 0x6d, 0xbd, 0xae, 0xbb, 0x7f, 0x07, 0x00, 0x73,
+	0x9c, 0x2c, 0xc9, 0x0b, 0x04, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -756,6 +766,13 @@ func (m *SnapshotStoreItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -998,6 +1015,10 @@ func (m *SnapshotStoreItem) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSnapshot(uint64(l))
 	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -1566,6 +1587,40 @@ func (m *SnapshotStoreItem) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
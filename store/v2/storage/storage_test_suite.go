@@ -640,6 +640,98 @@ func (s *StorageTestSuite) TestDatabase_Prune_KeepRecent() {
 	s.Require().Equal([]byte("val200"), bz)
 }
 
+func (s *StorageTestSuite) TestDatabase_VersionIterator() {
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	key := []byte("key")
+
+	// write the key at every version, but remove it at version 5
+	for v := uint64(1); v <= 10; v++ {
+		kvPair := corestore.KVPair{Key: key, Value: []byte(fmt.Sprintf("val%03d", v))}
+		if v == 5 {
+			kvPair = corestore.KVPair{Key: key, Remove: true}
+		}
+		s.Require().NoError(db.ApplyChangeset(v, corestore.NewChangesetWithPairs(
+			map[string]corestore.KVPairs{storeKey1: {kvPair}},
+		)))
+	}
+
+	itr, err := db.VersionIterator(storeKey1Bytes, key, 3, 8)
+	s.Require().NoError(err)
+	defer itr.Close()
+
+	expectedVersion := uint64(3)
+	for ; itr.Valid(); itr.Next() {
+		s.Require().Equal(expectedVersion, itr.Version())
+		if expectedVersion == 5 {
+			s.Require().Nil(itr.Value())
+		} else {
+			s.Require().Equal([]byte(fmt.Sprintf("val%03d", expectedVersion)), itr.Value())
+		}
+		expectedVersion++
+	}
+	s.Require().NoError(itr.Error())
+	s.Require().Equal(uint64(9), expectedVersion)
+
+	// a single version range
+	itr2, err := db.VersionIterator(storeKey1Bytes, key, 10, 10)
+	s.Require().NoError(err)
+	defer itr2.Close()
+
+	s.Require().True(itr2.Valid())
+	s.Require().Equal([]byte("val010"), itr2.Value())
+	itr2.Next()
+	s.Require().False(itr2.Valid())
+
+	// invalid ranges
+	_, err = db.VersionIterator(storeKey1Bytes, key, 8, 3)
+	s.Require().Error(err)
+	_, err = db.VersionIterator(storeKey1Bytes, nil, 3, 8)
+	s.Require().Error(err)
+}
+
+func (s *StorageTestSuite) TestDatabase_VersionIterator_Pruned() {
+	if slices.Contains(s.SkipTests, s.T().Name()) {
+		s.T().SkipNow()
+	}
+
+	db, err := s.NewDB(s.T().TempDir())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	key := []byte("key")
+	for v := uint64(1); v <= 10; v++ {
+		s.Require().NoError(db.ApplyChangeset(v, corestore.NewChangesetWithPairs(
+			map[string]corestore.KVPairs{storeKey1: {{Key: key, Value: []byte(fmt.Sprintf("val%03d", v))}}},
+		)))
+	}
+
+	s.Require().NoError(db.Prune(5))
+
+	// starting at a pruned version invalidates the iterator with an error
+	itr, err := db.VersionIterator(storeKey1Bytes, key, 3, 8)
+	s.Require().NoError(err)
+	defer itr.Close()
+
+	s.Require().False(itr.Valid())
+	s.Require().Error(itr.Error())
+
+	// starting after the pruned version works as expected
+	itr2, err := db.VersionIterator(storeKey1Bytes, key, 6, 8)
+	s.Require().NoError(err)
+	defer itr2.Close()
+
+	count := 0
+	for ; itr2.Valid(); itr2.Next() {
+		s.Require().Equal([]byte(fmt.Sprintf("val%03d", itr2.Version())), itr2.Value())
+		count++
+	}
+	s.Require().NoError(itr2.Error())
+	s.Require().Equal(3, count)
+}
+
 func DBApplyChangeset(
 	t *testing.T,
 	db store.VersionedDatabase,
@@ -92,6 +92,12 @@ func (ss *StorageStore) ReverseIterator(storeKey []byte, version uint64, start,
 	return ss.db.ReverseIterator(storeKey, version, start, end)
 }
 
+// VersionIterator returns an iterator over the values of the given key at every
+// version in the inclusive range [start, end].
+func (ss *StorageStore) VersionIterator(storeKey, key []byte, start, end uint64) (store.VersionIterator, error) {
+	return NewVersionIterator(ss, storeKey, key, start, end)
+}
+
 // Prune prunes the store up to the given version.
 func (ss *StorageStore) Prune(version uint64) error {
 	return ss.db.Prune(version)
@@ -103,8 +109,10 @@ func (ss *StorageStore) Restore(version uint64, chStorage <-chan *corestore.Stat
 	if err != nil {
 		return fmt.Errorf("failed to get latest version: %w", err)
 	}
-	if version <= latestVersion {
-		return fmt.Errorf("the snapshot version %d is not greater than latest version %d", version, latestVersion)
+	// the snapshot version may be equal to the latest version when resuming an
+	// interrupted restore, writing the same pairs again is harmless
+	if version < latestVersion {
+		return fmt.Errorf("the snapshot version %d is lower than latest version %d", version, latestVersion)
 	}
 
 	b, err := ss.db.NewBatch(version)
@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/v2"
+	storeerrors "cosmossdk.io/store/v2/errors"
+)
+
+var _ store.VersionIterator = (*VersionIterator)(nil)
+
+// VersionIterator iterates over the values of a single key at every version in
+// an inclusive range [start, end]. It works on top of any VersionedDatabase by
+// issuing a versioned Get per version, so values are only loaded as the iterator
+// advances.
+type VersionIterator struct {
+	db       store.VersionedDatabase
+	storeKey []byte
+	key      []byte
+
+	version uint64
+	end     uint64
+	value   []byte
+	valid   bool
+	err     error
+}
+
+// NewVersionIterator returns a new VersionIterator positioned at the start
+// version. If the value at the start version cannot be loaded, e.g. because the
+// version has been pruned, the iterator is invalid and Error returns the cause.
+func NewVersionIterator(db store.VersionedDatabase, storeKey, key []byte, start, end uint64) (*VersionIterator, error) {
+	if len(key) == 0 {
+		return nil, storeerrors.ErrKeyEmpty
+	}
+	if start > end {
+		return nil, fmt.Errorf("start version %d is after end version %d", start, end)
+	}
+
+	itr := &VersionIterator{
+		db:       db,
+		storeKey: storeKey,
+		key:      key,
+		version:  start,
+		end:      end,
+		valid:    true,
+	}
+	itr.load()
+
+	return itr, nil
+}
+
+// load reads the value of the key at the current version.
+func (itr *VersionIterator) load() {
+	val, err := itr.db.Get(itr.storeKey, itr.version, itr.key)
+	if err != nil {
+		itr.err = err
+		itr.valid = false
+		itr.value = nil
+		return
+	}
+
+	itr.value = val
+}
+
+func (itr *VersionIterator) Version() uint64 {
+	return itr.version
+}
+
+func (itr *VersionIterator) Value() []byte {
+	return itr.value
+}
+
+func (itr *VersionIterator) Next() {
+	if !itr.valid {
+		return
+	}
+
+	if itr.version >= itr.end {
+		itr.valid = false
+		itr.value = nil
+		return
+	}
+
+	itr.version++
+	itr.load()
+}
+
+func (itr *VersionIterator) Valid() bool {
+	return itr.valid
+}
+
+func (itr *VersionIterator) Error() error {
+	return itr.err
+}
+
+func (itr *VersionIterator) Close() error {
+	itr.valid = false
+	itr.value = nil
+	return nil
+}
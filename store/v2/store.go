@@ -65,6 +65,32 @@ type RootStore interface {
 	io.Closer
 }
 
+// HistoricalRootStore extends the RootStore interface to support historical
+// reads served purely from the SS backend. Such reads do not depend on the SC
+// backend, so they remain available after the SC backend has been pruned, and
+// are bounded by their own retention policy instead.
+type HistoricalRootStore interface {
+	RootStore
+
+	// HistoricalStateAt returns a read-only view of the SS backend at the provided
+	// version. An error must be returned if the version is outside of the
+	// historical retention window or has been pruned from SS.
+	HistoricalStateAt(version uint64) (corestore.ReaderMap, error)
+
+	// HistoricalQuery performs a query for a given store key, version and key
+	// tuple against the SS backend only. No proof can be returned since the SC
+	// backend is not involved.
+	HistoricalQuery(storeKey []byte, version uint64, key []byte) (QueryResult, error)
+
+	// VersionIterator returns an iterator over the values of a single key at
+	// every version in the inclusive range [start, end].
+	VersionIterator(storeKey, key []byte, start, end uint64) (VersionIterator, error)
+
+	// EarliestHistoricalVersion returns the earliest version that can be queried
+	// through the historical query path.
+	EarliestHistoricalVersion() (uint64, error)
+}
+
 // UpgradeableRootStore extends the RootStore interface to support loading versions
 // with upgrades.
 type UpgradeableRootStore interface {
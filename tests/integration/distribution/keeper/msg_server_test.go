@@ -130,7 +130,7 @@ func initFixture(t *testing.T) *fixture {
 	poolKeeper := poolkeeper.NewKeeper(cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[pooltypes.StoreKey]), log.NewNopLogger()), accountKeeper, bankKeeper, stakingKeeper, authority.String())
 
 	distrKeeper := distrkeeper.NewKeeper(
-		cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger), accountKeeper, bankKeeper, stakingKeeper, poolKeeper, distrtypes.ModuleName, authority.String(),
+		cdc, runtime.NewEnvironment(runtime.NewKVStoreService(keys[distrtypes.StoreKey]), logger, runtime.EnvWithMsgRouterService(msgRouter)), accountKeeper, bankKeeper, stakingKeeper, poolKeeper, distrtypes.ModuleName, authority.String(),
 	)
 
 	authModule := auth.NewAppModule(cdc, accountKeeper, acctsModKeeper, authsims.RandomGenesisAccounts)
@@ -177,6 +177,7 @@ func initFixture(t *testing.T) *fixture {
 	// Register MsgServer and QueryServer
 	distrtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), distrkeeper.NewMsgServerImpl(distrKeeper))
 	distrtypes.RegisterQueryServer(integrationApp.QueryHelper(), distrkeeper.NewQuerier(distrKeeper))
+	stakingtypes.RegisterMsgServer(integrationApp.MsgServiceRouter(), stakingkeeper.NewMsgServerImpl(stakingKeeper))
 
 	qr := integrationApp.QueryHelper()
 	distrQueryClient := distrtypes.NewQueryClient(qr)
@@ -791,10 +792,12 @@ func TestMsgUpdateParams(t *testing.T) {
 			msg: &distrtypes.MsgUpdateParams{
 				Authority: f.distrKeeper.GetAuthority(),
 				Params: distrtypes.Params{
-					CommunityTax:        communityTax,
-					BaseProposerReward:  math.LegacyZeroDec(),
-					BonusProposerReward: math.LegacyZeroDec(),
-					WithdrawAddrEnabled: withdrawAddrEnabled,
+					CommunityTax:                communityTax,
+					BaseProposerReward:          math.LegacyZeroDec(),
+					BonusProposerReward:         math.LegacyZeroDec(),
+					WithdrawAddrEnabled:         withdrawAddrEnabled,
+					AutoCompoundEpochIdentifier: distrtypes.DefaultAutoCompoundEpochIdentifier,
+					MaxAutoCompoundPerBlock:     distrtypes.DefaultMaxAutoCompoundPerBlock,
 				},
 			},
 			expErr: false,
@@ -1019,3 +1022,119 @@ func TestMsgDepositValidatorRewardsPool(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetAutoCompound(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	require.NoError(t, f.distrKeeper.FeePool.Set(f.sdkCtx, distrtypes.InitialFeePool()))
+	require.NoError(t, f.distrKeeper.Params.Set(f.sdkCtx, distrtypes.DefaultParams()))
+
+	delAddr := sdk.AccAddress(PKS[1].Address())
+	f.accountKeeper.SetAccount(f.sdkCtx, f.accountKeeper.NewAccountWithAddress(f.sdkCtx, delAddr))
+
+	// setup staking validator
+	validator, err := stakingtypes.NewValidator(f.valAddr.String(), PKS[0], stakingtypes.Description{})
+	assert.NilError(t, err)
+	commission := stakingtypes.NewCommission(math.LegacyZeroDec(), math.LegacyOneDec(), math.LegacyOneDec())
+	validator, err = validator.SetInitialCommission(commission)
+	assert.NilError(t, err)
+	validator.DelegatorShares = math.LegacyNewDec(100)
+	validator.Tokens = math.NewInt(1000000)
+	assert.NilError(t, f.stakingKeeper.SetValidator(f.sdkCtx, validator))
+
+	// setup delegation
+	delTokens := sdk.TokensFromConsensusPower(2, sdk.DefaultPowerReduction)
+	validator, issuedShares := validator.AddTokensFromDel(delTokens)
+	assert.NilError(t, f.stakingKeeper.SetValidator(f.sdkCtx, validator))
+	delegation := stakingtypes.NewDelegation(delAddr.String(), validator.GetOperator(), issuedShares)
+	require.NoError(t, f.stakingKeeper.SetDelegation(f.sdkCtx, delegation))
+	require.NoError(t, f.distrKeeper.DelegatorStartingInfo.Set(f.sdkCtx, collections.Join(f.valAddr, delAddr), distrtypes.NewDelegatorStartingInfo(2, math.LegacyNewDecFromInt(delTokens), 20)))
+
+	// setup validator rewards
+	decCoins := sdk.DecCoins{sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, math.LegacyOneDec())}
+	historicalRewards := distrtypes.NewValidatorHistoricalRewards(decCoins, 2)
+	require.NoError(t, f.distrKeeper.ValidatorHistoricalRewards.Set(f.sdkCtx, collections.Join(f.valAddr, uint64(2)), historicalRewards))
+	rewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, f.stakingKeeper.TokensFromConsensusPower(f.sdkCtx, 1)))
+	currentRewards := distrtypes.NewValidatorCurrentRewards(sdk.NewDecCoinsFromCoins(rewards...), 3)
+	require.NoError(t, f.distrKeeper.ValidatorCurrentRewards.Set(f.sdkCtx, f.valAddr, currentRewards))
+	require.NoError(t, f.distrKeeper.ValidatorOutstandingRewards.Set(f.sdkCtx, f.valAddr, distrtypes.ValidatorOutstandingRewards{Rewards: sdk.NewDecCoinsFromCoins(rewards...)}))
+	require.NoError(t, f.bankKeeper.MintCoins(f.sdkCtx, distrtypes.ModuleName, rewards))
+
+	testCases := []struct {
+		name      string
+		msg       *distrtypes.MsgSetAutoCompound
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "empty delegator address",
+			msg: &distrtypes.MsgSetAutoCompound{
+				DelegatorAddress: emptyDelAddr.String(),
+				Enabled:          true,
+			},
+			expErr:    true,
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name: "valid msg",
+			msg: &distrtypes.MsgSetAutoCompound{
+				DelegatorAddress: delAddr.String(),
+				Enabled:          true,
+			},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := f.app.RunMsg(
+				tc.msg,
+				integration.WithAutomaticFinalizeBlock(),
+				integration.WithAutomaticCommit(),
+			)
+			if tc.expErr {
+				assert.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				assert.NilError(t, err)
+				assert.Assert(t, res != nil)
+
+				// check the result
+				result := distrtypes.MsgSetAutoCompoundResponse{}
+				err := f.cdc.Unmarshal(res.Value, &result)
+				assert.NilError(t, err)
+
+				queryRes, err := f.queryClient.AutoCompound(f.sdkCtx, &distrtypes.QueryAutoCompoundRequest{DelegatorAddress: delAddr.String()})
+				assert.NilError(t, err)
+				assert.Assert(t, queryRes.Enabled)
+			}
+		})
+	}
+
+	// the rewards are re-delegated once the auto-compound round runs
+	params, err := f.distrKeeper.Params.Get(f.sdkCtx)
+	require.NoError(t, err)
+	require.NoError(t, f.distrKeeper.EpochHooks().AfterEpochEnd(f.sdkCtx, params.AutoCompoundEpochIdentifier, 1))
+	cursor, err := f.distrKeeper.AutoCompoundCursor.Get(f.sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, delAddr, cursor.K1())
+
+	// the round in progress is resumed from the exported cursor
+	require.NoError(t, f.distrKeeper.AutoCompoundCursor.Set(f.sdkCtx, collections.Join(delAddr, f.valAddr)))
+	genState, err := f.distrKeeper.ExportGenesis(f.sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, &distrtypes.AutoCompoundCursor{DelegatorAddress: delAddr.String(), ValidatorAddress: f.valAddr.String()}, genState.AutoCompoundCursor)
+	require.NoError(t, f.distrKeeper.AutoCompoundCursor.Remove(f.sdkCtx))
+	require.NoError(t, f.distrKeeper.InitGenesis(f.sdkCtx, *genState))
+	cursor, err = f.distrKeeper.AutoCompoundCursor.Get(f.sdkCtx)
+	require.NoError(t, err)
+	require.Equal(t, collections.Join(delAddr, f.valAddr), cursor)
+
+	require.NoError(t, f.distrKeeper.BeginBlocker(f.sdkCtx.WithBlockHeight(1)))
+
+	compounded, err := f.stakingKeeper.Delegations.Get(f.sdkCtx, collections.Join(delAddr, f.valAddr))
+	require.NoError(t, err)
+	assert.Assert(t, compounded.Shares.GT(delegation.Shares))
+	assert.Assert(t, f.bankKeeper.GetBalance(f.sdkCtx, delAddr, sdk.DefaultBondDenom).IsZero())
+}
@@ -66,10 +66,12 @@ func WithDecisionPolicy(opts rapidproto.GeneratorOptions) rapidproto.GeneratorOp
 	return opts.
 		WithAnyTypes(
 			&groupapi.ThresholdDecisionPolicy{},
-			&groupapi.PercentageDecisionPolicy{}).
+			&groupapi.PercentageDecisionPolicy{},
+			&groupapi.ConvictionDecisionPolicy{}).
 		WithDisallowNil().
 		WithInterfaceHint("cosmos.group.v1.DecisionPolicy", &groupapi.ThresholdDecisionPolicy{}).
-		WithInterfaceHint("cosmos.group.v1.DecisionPolicy", &groupapi.PercentageDecisionPolicy{})
+		WithInterfaceHint("cosmos.group.v1.DecisionPolicy", &groupapi.PercentageDecisionPolicy{}).
+		WithInterfaceHint("cosmos.group.v1.DecisionPolicy", &groupapi.ConvictionDecisionPolicy{})
 }
 
 func GeneratorFieldMapper(t *rapid.T, field protoreflect.FieldDescriptor, name string) (protoreflect.Value, bool) {
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTokenizeAndRedeemShares(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delegator, owner, holder := addrs[0], addrs[2], addrs[3]
+	valAddr := valAddrs[0]
+
+	ownerStr, err := f.accountKeeper.AddressCodec().BytesToString(owner)
+	assert.NilError(t, err)
+
+	delegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delegator, valAddr))
+	assert.NilError(t, err)
+
+	amount := f.stakingKeeper.TokensFromConsensusPower(ctx, 4)
+	record, shareToken, err := f.stakingKeeper.TokenizeShares(ctx, delegator, valAddr, amount, ownerStr)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), record.Id)
+	assert.Equal(t, ownerStr, record.Owner)
+	assert.Equal(t, record.GetShareTokenDenom(), shareToken.Denom)
+	assert.Assert(math.IntEq(t, amount, shareToken.Amount))
+	assert.Assert(math.IntEq(t, amount, f.bankKeeper.GetBalance(ctx, delegator, shareToken.Denom).Amount))
+
+	// the tokenized shares are moved to the delegation of the record
+	remaining, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delegator, valAddr))
+	assert.NilError(t, err)
+	assert.Assert(t, remaining.Shares.Equal(delegation.Shares.Sub(math.LegacyNewDecFromInt(amount))))
+
+	recordDelegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(types.TokenizeShareRecordAddress(record.Id), valAddr))
+	assert.NilError(t, err)
+	assert.Assert(t, recordDelegation.Shares.Equal(math.LegacyNewDecFromInt(amount)))
+
+	liquidShares, err := f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, liquidShares.Equal(math.LegacyNewDecFromInt(amount)))
+
+	totalLiquid, err := f.stakingKeeper.TotalLiquidStakedTokens(ctx)
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, amount, totalLiquid))
+
+	records, err := f.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, owner)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []types.TokenizeShareRecord{record}, records)
+
+	// share tokens are transferable and can be redeemed by any holder
+	half := sdk.NewCoin(shareToken.Denom, amount.QuoRaw(2))
+	assert.NilError(t, f.bankKeeper.SendCoins(ctx, delegator, holder, sdk.NewCoins(half)))
+
+	_, redeemed, err := f.stakingKeeper.RedeemTokensForShares(ctx, holder, half)
+	assert.NilError(t, err)
+	assert.Assert(math.IntEq(t, half.Amount, redeemed.Amount))
+
+	holderDelegation, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(holder, valAddr))
+	assert.NilError(t, err)
+	assert.Assert(t, holderDelegation.Shares.Equal(math.LegacyNewDecFromInt(half.Amount)))
+
+	_, err = f.stakingKeeper.TokenizeShareRecords.Get(ctx, record.Id)
+	assert.NilError(t, err)
+
+	// redeeming the last share tokens deletes the record
+	_, _, err = f.stakingKeeper.RedeemTokensForShares(ctx, delegator, half)
+	assert.NilError(t, err)
+
+	_, err = f.stakingKeeper.TokenizeShareRecords.Get(ctx, record.Id)
+	assert.ErrorIs(t, err, collections.ErrNotFound)
+
+	_, err = f.stakingKeeper.Delegations.Get(ctx, collections.Join(types.TokenizeShareRecordAddress(record.Id), valAddr))
+	assert.ErrorIs(t, err, collections.ErrNotFound)
+
+	restored, err := f.stakingKeeper.Delegations.Get(ctx, collections.Join(delegator, valAddr))
+	assert.NilError(t, err)
+	assert.Assert(t, restored.Shares.Equal(remaining.Shares.Add(math.LegacyNewDecFromInt(half.Amount))))
+
+	liquidShares, err = f.stakingKeeper.GetValidatorLiquidShares(ctx, valAddr)
+	assert.NilError(t, err)
+	assert.Assert(t, liquidShares.IsZero())
+	assert.Assert(t, f.bankKeeper.GetSupply(ctx, shareToken.Denom).IsZero())
+}
+
+func TestTokenizeSharesLiquidStakingCaps(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := f.sdkCtx
+
+	addrs, valAddrs, _ := createValidators(t, f, []int64{9, 8, 7})
+	delegator := addrs[0]
+
+	delegatorStr, err := f.accountKeeper.AddressCodec().BytesToString(delegator)
+	assert.NilError(t, err)
+
+	params, err := f.stakingKeeper.Params.Get(ctx)
+	assert.NilError(t, err)
+
+	// val1 only has the 9 power delegation, so 10% of its shares is below 1 power
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(1, 1)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delegator, valAddrs[0], f.stakingKeeper.TokensFromConsensusPower(ctx, 1), delegatorStr)
+	assert.ErrorIs(t, err, types.ErrValidatorLiquidStakingCapExceeded)
+
+	// the global cap only allows to tokenize 1 power
+	totalBonded, err := f.stakingKeeper.TotalBondedTokens(ctx)
+	assert.NilError(t, err)
+	params.ValidatorLiquidStakingCap = math.LegacyOneDec()
+	params.GlobalLiquidStakingCap = math.LegacyNewDecFromInt(f.stakingKeeper.TokensFromConsensusPower(ctx, 1)).QuoInt(totalBonded)
+	assert.NilError(t, f.stakingKeeper.Params.Set(ctx, params))
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delegator, valAddrs[0], f.stakingKeeper.TokensFromConsensusPower(ctx, 1), delegatorStr)
+	assert.NilError(t, err)
+
+	_, _, err = f.stakingKeeper.TokenizeShares(ctx, delegator, valAddrs[1], f.stakingKeeper.TokensFromConsensusPower(ctx, 1), delegatorStr)
+	assert.ErrorIs(t, err, types.ErrGlobalLiquidStakingCapExceeded)
+}
@@ -1,6 +1,8 @@
 package cosmovisor
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -26,6 +28,8 @@ const (
 	EnvName                     = "DAEMON_NAME"
 	EnvDownloadBin              = "DAEMON_ALLOW_DOWNLOAD_BINARIES"
 	EnvDownloadMustHaveChecksum = "DAEMON_DOWNLOAD_MUST_HAVE_CHECKSUM"
+	EnvBinaryVerificationKey    = "DAEMON_BINARY_VERIFICATION_KEY"
+	EnvChainID                  = "DAEMON_CHAIN_ID"
 	EnvRestartUpgrade           = "DAEMON_RESTART_AFTER_UPGRADE"
 	EnvRestartDelay             = "DAEMON_RESTART_DELAY"
 	EnvShutdownGrace            = "DAEMON_SHUTDOWN_GRACE"
@@ -46,6 +50,9 @@ const (
 	upgradesDir = "upgrades"
 	currentLink = "current"
 
+	upgradeInfoBatchSuffix  = ".batch"
+	upgradeRollbackFilename = "upgrade-rollback.json"
+
 	cfgFileName  = "config"
 	cfgExtension = "toml"
 )
@@ -56,6 +63,8 @@ type Config struct {
 	Name                     string        `toml:"daemon_name" mapstructure:"daemon_name"`
 	AllowDownloadBinaries    bool          `toml:"daemon_allow_download_binaries" mapstructure:"daemon_allow_download_binaries" default:"false"`
 	DownloadMustHaveChecksum bool          `toml:"daemon_download_must_have_checksum" mapstructure:"daemon_download_must_have_checksum" default:"false"`
+	BinaryVerificationKey    string        `toml:"daemon_binary_verification_key" mapstructure:"daemon_binary_verification_key" default:""`
+	ChainID                  string        `toml:"daemon_chain_id" mapstructure:"daemon_chain_id" default:""`
 	RestartAfterUpgrade      bool          `toml:"daemon_restart_after_upgrade" mapstructure:"daemon_restart_after_upgrade" default:"true"`
 	RestartDelay             time.Duration `toml:"daemon_restart_delay" mapstructure:"daemon_restart_delay"`
 	ShutdownGrace            time.Duration `toml:"daemon_shutdown_grace" mapstructure:"daemon_shutdown_grace"`
@@ -104,11 +113,40 @@ func (cfg *Config) BaseUpgradeDir() string {
 	return filepath.Join(cfg.Root(), upgradesDir)
 }
 
+// VerificationKey returns the ed25519 public key upgrade binaries must be signed with,
+// or nil if no verification key is configured.
+func (cfg *Config) VerificationKey() (ed25519.PublicKey, error) {
+	if cfg.BinaryVerificationKey == "" {
+		return nil, nil
+	}
+
+	key, err := hex.DecodeString(cfg.BinaryVerificationKey)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be hex encoded: %w", EnvBinaryVerificationKey, err)
+	}
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("%s must be a %d bytes long ed25519 public key, got %d bytes", EnvBinaryVerificationKey, ed25519.PublicKeySize, len(key))
+	}
+
+	return key, nil
+}
+
 // UpgradeInfoFilePath is the expected upgrade-info filename created by `x/upgrade/keeper`.
 func (cfg *Config) UpgradeInfoFilePath() string {
 	return filepath.Join(cfg.Home, "data", upgradetypes.UpgradeInfoFilename)
 }
 
+// UpgradeInfoBatchFilePath is the file listing the pre-staged upgrades, created by `cosmovisor add-batch-upgrade`.
+func (cfg *Config) UpgradeInfoBatchFilePath() string {
+	return cfg.UpgradeInfoFilePath() + upgradeInfoBatchSuffix
+}
+
+// UpgradeRollbackFilePath is the file holding the information needed to roll back the last upgrade,
+// until its binary commits a block.
+func (cfg *Config) UpgradeRollbackFilePath() string {
+	return filepath.Join(cfg.Root(), upgradeRollbackFilename)
+}
+
 // SymLinkToGenesis creates a symbolic link from "./current" to the genesis directory.
 func (cfg *Config) SymLinkToGenesis() (string, error) {
 	genesis := filepath.Join(cfg.Root(), genesisDir)
@@ -206,10 +244,12 @@ func GetConfigFromFile(filePath string) (*Config, error) {
 func GetConfigFromEnv() (*Config, error) {
 	var errs []error
 	cfg := &Config{
-		Home:             os.Getenv(EnvHome),
-		Name:             os.Getenv(EnvName),
-		DataBackupPath:   os.Getenv(EnvDataBackupPath),
-		CustomPreUpgrade: os.Getenv(EnvCustomPreupgrade),
+		Home:                  os.Getenv(EnvHome),
+		Name:                  os.Getenv(EnvName),
+		DataBackupPath:        os.Getenv(EnvDataBackupPath),
+		CustomPreUpgrade:      os.Getenv(EnvCustomPreupgrade),
+		BinaryVerificationKey: os.Getenv(EnvBinaryVerificationKey),
+		ChainID:               os.Getenv(EnvChainID),
 	}
 
 	if cfg.DataBackupPath == "" {
@@ -342,6 +382,16 @@ func (cfg *Config) validate() []error {
 		}
 	}
 
+	// validate EnvBinaryVerificationKey
+	if _, err := cfg.VerificationKey(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// validate EnvChainID, binary signatures are bound to the chain-id
+	if cfg.BinaryVerificationKey != "" && cfg.ChainID == "" {
+		errs = append(errs, fmt.Errorf("%s must be set when %s is set", EnvChainID, EnvBinaryVerificationKey))
+	}
+
 	// check the DataBackupPath
 	if cfg.UnsafeSkipBackup {
 		return errs
@@ -375,20 +425,10 @@ func (cfg *Config) SetCurrentUpgrade(u upgradetypes.Plan) (rerr error) {
 	}
 
 	// set a symbolic link
-	link := filepath.Join(cfg.Root(), currentLink)
 	safeName := url.PathEscape(u.Name)
 	upgrade := filepath.Join(cfg.Root(), upgradesDir, safeName)
-
-	// remove link if it exists
-	if _, err := os.Stat(link); err == nil {
-		if err := os.Remove(link); err != nil {
-			return fmt.Errorf("failed to remove existing link: %w", err)
-		}
-	}
-
-	// point to the new directory
-	if err := os.Symlink(upgrade, link); err != nil {
-		return fmt.Errorf("creating current symlink: %w", err)
+	if err := cfg.setCurrentLink(upgrade); err != nil {
+		return err
 	}
 
 	cfg.currentUpgrade = u
@@ -411,6 +451,46 @@ func (cfg *Config) SetCurrentUpgrade(u upgradetypes.Plan) (rerr error) {
 	return err
 }
 
+// setCurrentLink points the current link to the given binary directory.
+func (cfg *Config) setCurrentLink(dir string) error {
+	link := filepath.Join(cfg.Root(), currentLink)
+
+	// remove link if it exists
+	if _, err := os.Stat(link); err == nil {
+		if err := os.Remove(link); err != nil {
+			return fmt.Errorf("failed to remove existing link: %w", err)
+		}
+	}
+
+	// point to the new directory
+	if err := os.Symlink(dir, link); err != nil {
+		return fmt.Errorf("creating current symlink: %w", err)
+	}
+
+	return nil
+}
+
+// currentDir returns the binary directory the current link points to.
+func (cfg *Config) currentDir() (string, error) {
+	// ensure the current link exists
+	if _, err := cfg.CurrentBin(); err != nil {
+		return "", err
+	}
+
+	return os.Readlink(filepath.Join(cfg.Root(), currentLink))
+}
+
+// rollbackCurrentUpgrade points the current link back to the given binary directory,
+// and resets the currently running upgrade.
+func (cfg *Config) rollbackCurrentUpgrade(dir string) error {
+	if err := cfg.setCurrentLink(dir); err != nil {
+		return err
+	}
+
+	cfg.currentUpgrade = upgradetypes.Plan{}
+	return nil
+}
+
 // UpgradeInfo returns the current upgrade info
 func (cfg *Config) UpgradeInfo() (upgradetypes.Plan, error) {
 	if cfg.currentUpgrade.Name != "" {
@@ -533,6 +613,8 @@ func (cfg Config) DetailString() string {
 		{EnvName, cfg.Name},
 		{EnvDownloadBin, fmt.Sprintf("%t", cfg.AllowDownloadBinaries)},
 		{EnvDownloadMustHaveChecksum, fmt.Sprintf("%t", cfg.DownloadMustHaveChecksum)},
+		{EnvBinaryVerificationKey, cfg.BinaryVerificationKey},
+		{EnvChainID, cfg.ChainID},
 		{EnvRestartUpgrade, fmt.Sprintf("%t", cfg.RestartAfterUpgrade)},
 		{EnvRestartDelay, cfg.RestartDelay.String()},
 		{EnvShutdownGrace, cfg.ShutdownGrace.String()},
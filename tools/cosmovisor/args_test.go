@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -229,6 +230,22 @@ func (s *argsTestSuite) TestValidate() {
 			cfg:   Config{Home: absPath, Name: "bind", UnsafeSkipBackup: true, DataBackupPath: relPath},
 			valid: true,
 		},
+		"happy with binary verification key": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, BinaryVerificationKey: strings.Repeat("ab", 32), ChainID: "test-chain"},
+			valid: true,
+		},
+		"binary verification key without chain-id": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, BinaryVerificationKey: strings.Repeat("ab", 32)},
+			valid: false,
+		},
+		"binary verification key not hex": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, BinaryVerificationKey: "not hex", ChainID: "test-chain"},
+			valid: false,
+		},
+		"binary verification key of wrong size": {
+			cfg:   Config{Home: absPath, Name: "bind", DataBackupPath: absPath, BinaryVerificationKey: "abcd", ChainID: "test-chain"},
+			valid: false,
+		},
 		"missing home": {
 			cfg:   Config{Name: "bind"},
 			valid: false,
@@ -404,6 +421,8 @@ func (s *argsTestSuite) TestDetailString() {
 	unsafeSkipBackup := false
 	dataBackupPath := "/home"
 	preupgradeMaxRetries := 8
+	binaryVerificationKey := strings.Repeat("ab", 32)
+	chainID := "test-chain"
 	cfg := &Config{
 		Home:                     home,
 		Name:                     name,
@@ -414,6 +433,8 @@ func (s *argsTestSuite) TestDetailString() {
 		UnsafeSkipBackup:         unsafeSkipBackup,
 		DataBackupPath:           dataBackupPath,
 		PreUpgradeMaxRetries:     preupgradeMaxRetries,
+		BinaryVerificationKey:    binaryVerificationKey,
+		ChainID:                  chainID,
 	}
 
 	expectedPieces := []string{
@@ -422,6 +443,8 @@ func (s *argsTestSuite) TestDetailString() {
 		fmt.Sprintf("%s: %s", EnvName, name),
 		fmt.Sprintf("%s: %t", EnvDownloadBin, allowDownloadBinaries),
 		fmt.Sprintf("%s: %t", EnvDownloadMustHaveChecksum, downloadMustHaveChecksum),
+		fmt.Sprintf("%s: %s", EnvBinaryVerificationKey, binaryVerificationKey),
+		fmt.Sprintf("%s: %s", EnvChainID, chainID),
 		fmt.Sprintf("%s: %t", EnvRestartUpgrade, restartAfterUpgrade),
 		fmt.Sprintf("%s: %s", EnvInterval, pollInterval),
 		fmt.Sprintf("%s: %t", EnvSkipBackup, unsafeSkipBackup),
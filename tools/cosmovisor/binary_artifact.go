@@ -0,0 +1,152 @@
+package cosmovisor
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// AnyOSArch is the os/arch of a binary artifact running on any os/arch.
+const AnyOSArch = "any"
+
+// BinaryArtifact is a binary of an upgrade plan for a given os/arch.
+// It mirrors the x/upgrade BinaryArtifact, as written in the binaries of the plan
+// to upgrade-info.json, so that cosmovisor supports every x/upgrade version.
+type BinaryArtifact struct {
+	OsArch    string `json:"os_arch,omitempty"`
+	URL       string `json:"url,omitempty"`
+	Sha256    string `json:"sha256,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+}
+
+// PlanBinaries are the binary artifacts of an upgrade plan.
+type PlanBinaries struct {
+	// Name is the name of the plan, as written by the app (it is not normalized).
+	Name     string           `json:"name"`
+	Binaries []BinaryArtifact `json:"binaries,omitempty"`
+}
+
+// ParsePlanBinaries reads the binary artifacts of the given upgrade from the upgrade info file.
+// No binary artifact is returned if the upgrade info file doesn't exist, or is about another upgrade.
+func ParsePlanBinaries(filename, upgradeName string) (PlanBinaries, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return PlanBinaries{Name: upgradeName}, nil
+		}
+		return PlanBinaries{}, err
+	}
+
+	var planBinaries PlanBinaries
+	if err := json.Unmarshal(bz, &planBinaries); err != nil {
+		return PlanBinaries{}, fmt.Errorf("invalid upgrade-info.json content: %w", err)
+	}
+
+	if !strings.EqualFold(planBinaries.Name, upgradeName) {
+		return PlanBinaries{Name: upgradeName}, nil
+	}
+
+	return planBinaries, nil
+}
+
+// BinaryFor returns the binary artifact for the given os/arch,
+// falling back to the binary artifact for any os/arch.
+func (p PlanBinaries) BinaryFor(osArch string) (BinaryArtifact, bool) {
+	var anyBinary *BinaryArtifact
+	for i, binary := range p.Binaries {
+		switch binary.OsArch {
+		case osArch:
+			return binary, true
+		case AnyOSArch:
+			anyBinary = &p.Binaries[i]
+		}
+	}
+
+	if anyBinary == nil {
+		return BinaryArtifact{}, false
+	}
+
+	return *anyBinary, true
+}
+
+// Checksum returns the decoded SHA-256 checksum of the binary.
+func (a BinaryArtifact) Checksum() ([]byte, error) {
+	checksum, err := hex.DecodeString(a.Sha256)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sha256 checksum: %w", err)
+	}
+	if len(checksum) != sha256.Size {
+		return nil, fmt.Errorf("sha256 checksum must be %d bytes long, got %d", sha256.Size, len(checksum))
+	}
+
+	return checksum, nil
+}
+
+// SignBytes returns the bytes signed by the signature of the binary: the SHA-256 of the
+// chain-id, followed by the SHA-256 of the plan name and the raw SHA-256 checksum of the binary.
+// It must match the x/upgrade BinaryArtifact SignBytes, both are tested against the golden
+// vectors of x/upgrade/types/testdata/binary_signatures.json.
+func (a BinaryArtifact) SignBytes(chainID, planName string) ([]byte, error) {
+	checksum, err := a.Checksum()
+	if err != nil {
+		return nil, err
+	}
+
+	chainIDHash := sha256.Sum256([]byte(chainID))
+	planNameHash := sha256.Sum256([]byte(planName))
+
+	signBytes := make([]byte, 0, 3*sha256.Size)
+	signBytes = append(signBytes, chainIDHash[:]...)
+	signBytes = append(signBytes, planNameHash[:]...)
+	return append(signBytes, checksum...), nil
+}
+
+// VerifySignature checks the signature of the binary of the given chain and plan against
+// the given public key.
+func (a BinaryArtifact) VerifySignature(pubKey ed25519.PublicKey, chainID, planName string) error {
+	if len(a.Signature) == 0 {
+		return fmt.Errorf("binary for %q is not signed", a.OsArch)
+	}
+
+	signBytes, err := a.SignBytes(chainID, planName)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(pubKey, signBytes, a.Signature) {
+		return fmt.Errorf("invalid signature of binary for %q", a.OsArch)
+	}
+
+	return nil
+}
+
+// VerifyBinary checks that the binary file at the given path matches the SHA-256 checksum of the artifact.
+func (a BinaryArtifact) VerifyBinary(path string) error {
+	checksum, err := a.Checksum()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, f); err != nil {
+		return fmt.Errorf("could not read binary: %w", err)
+	}
+
+	if !bytes.Equal(hasher.Sum(nil), checksum) {
+		return fmt.Errorf("binary checksum mismatch: expected %s, got %x", a.Sha256, hasher.Sum(nil))
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package cosmovisor
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlanBinaries(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "upgrade-info.json")
+
+	// no upgrade info file
+	planBinaries, err := ParsePlanBinaries(filename, "v2")
+	require.NoError(t, err)
+	require.Equal(t, PlanBinaries{Name: "v2"}, planBinaries)
+
+	contents := `{"name":"V2","height":123,"binaries":[{"os_arch":"any","url":"https://example.com/any","sha256":"abab","signature":"c2ln"}]}`
+	require.NoError(t, os.WriteFile(filename, []byte(contents), 0o600))
+
+	// the upgrade name is case insensitive, the name of the plan is kept
+	planBinaries, err = ParsePlanBinaries(filename, "v2")
+	require.NoError(t, err)
+	require.Equal(t, PlanBinaries{
+		Name:     "V2",
+		Binaries: []BinaryArtifact{{OsArch: AnyOSArch, URL: "https://example.com/any", Sha256: "abab", Signature: []byte("sig")}},
+	}, planBinaries)
+
+	// the upgrade info file is about another upgrade
+	planBinaries, err = ParsePlanBinaries(filename, "v3")
+	require.NoError(t, err)
+	require.Equal(t, PlanBinaries{Name: "v3"}, planBinaries)
+
+	require.NoError(t, os.WriteFile(filename, []byte("{"), 0o600))
+	_, err = ParsePlanBinaries(filename, "v2")
+	require.ErrorContains(t, err, "invalid upgrade-info.json content")
+}
+
+func TestPlanBinariesBinaryFor(t *testing.T) {
+	linux := BinaryArtifact{OsArch: "linux/amd64", URL: "https://example.com/linux"}
+	anyBinary := BinaryArtifact{OsArch: AnyOSArch, URL: "https://example.com/any"}
+
+	p := PlanBinaries{Binaries: []BinaryArtifact{anyBinary, linux}}
+	binary, ok := p.BinaryFor("linux/amd64")
+	require.True(t, ok)
+	require.Equal(t, linux, binary)
+
+	binary, ok = p.BinaryFor("darwin/arm64")
+	require.True(t, ok)
+	require.Equal(t, anyBinary, binary)
+
+	p = PlanBinaries{Binaries: []BinaryArtifact{linux}}
+	_, ok = p.BinaryFor("darwin/arm64")
+	require.False(t, ok)
+}
+
+// binarySignatureVector is a golden vector of the signature of a binary. The vectors are
+// shared with x/upgrade, so that cosmovisor verifies the signatures the x/upgrade way.
+type binarySignatureVector struct {
+	Description string `json:"description"`
+	ChainID     string `json:"chain_id"`
+	PlanName    string `json:"plan_name"`
+	Sha256      string `json:"sha256"`
+	SignBytes   string `json:"sign_bytes"`
+	PubKey      string `json:"pub_key"`
+	Signature   string `json:"signature"`
+}
+
+func TestBinaryArtifactSignatureGoldenVectors(t *testing.T) {
+	bz, err := os.ReadFile(filepath.Join("..", "..", "x", "upgrade", "types", "testdata", "binary_signatures.json"))
+	require.NoError(t, err)
+	var vectors []binarySignatureVector
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		t.Run(v.Description, func(t *testing.T) {
+			signature, err := hex.DecodeString(v.Signature)
+			require.NoError(t, err)
+			pubKey, err := hex.DecodeString(v.PubKey)
+			require.NoError(t, err)
+
+			binary := BinaryArtifact{Sha256: v.Sha256, Signature: signature}
+			signBytes, err := binary.SignBytes(v.ChainID, v.PlanName)
+			require.NoError(t, err)
+			require.Equal(t, v.SignBytes, hex.EncodeToString(signBytes))
+			require.NoError(t, binary.VerifySignature(pubKey, v.ChainID, v.PlanName))
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/tools/cosmovisor"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+)
+
+func NewAddBatchUpgradeCmd() *cobra.Command {
+	addBatchUpgrade := &cobra.Command{
+		Use:   "add-batch-upgrade [flags]",
+		Short: "Add multiple upgrade binaries at specified heights to cosmovisor",
+		Long: `Add multiple upgrade binaries at specified heights to cosmovisor, without governance proposals.
+Each upgrade is given as <upgrade-name>:<path-to-executable>:<upgrade-height>, either
+one per line in the --upgrade-file, or comma separated in the --upgrade-list.
+The upgrades are staged in upgrade-info.json one after the other, as the chain reaches their heights.`,
+		Example: `cosmovisor add-batch-upgrade --upgrade-list v2:/path/to/v2/simd:100,v3:/path/to/v3/simd:200
+cosmovisor add-batch-upgrade --upgrade-file /path/to/upgrades.txt`,
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE:         AddBatchUpgrade,
+	}
+
+	addBatchUpgrade.Flags().String(cosmovisor.FlagUpgradeFile, "", "path to a file listing the upgrades, one <upgrade-name>:<path-to-executable>:<upgrade-height> per line")
+	addBatchUpgrade.Flags().StringSlice(cosmovisor.FlagUpgradeList, nil, "comma separated list of upgrades, each as <upgrade-name>:<path-to-executable>:<upgrade-height>")
+	addBatchUpgrade.Flags().Bool(cosmovisor.FlagForce, false, "overwrite existing upgrade binaries / upgrade-info.json.batch file")
+	addBatchUpgrade.MarkFlagsMutuallyExclusive(cosmovisor.FlagUpgradeFile, cosmovisor.FlagUpgradeList)
+	addBatchUpgrade.MarkFlagsOneRequired(cosmovisor.FlagUpgradeFile, cosmovisor.FlagUpgradeList)
+
+	return addBatchUpgrade
+}
+
+// batchUpgrade is an upgrade of a batch, staged at the given height.
+type batchUpgrade struct {
+	Name           string
+	ExecutablePath string
+	Height         int64
+}
+
+// AddBatchUpgrade adds the upgrade binaries and writes the batch upgrades file
+func AddBatchUpgrade(cmd *cobra.Command, _ []string) error {
+	configPath, err := cmd.Flags().GetString(cosmovisor.FlagCosmovisorConfig)
+	if err != nil {
+		return fmt.Errorf("failed to get config flag: %w", err)
+	}
+
+	cfg, err := cosmovisor.GetConfigFromFile(configPath)
+	if err != nil {
+		return err
+	}
+
+	logger := cfg.Logger(os.Stdout)
+
+	entries, err := cmd.Flags().GetStringSlice(cosmovisor.FlagUpgradeList)
+	if err != nil {
+		return fmt.Errorf("failed to get upgrade-list flag: %w", err)
+	}
+
+	upgradeFile, err := cmd.Flags().GetString(cosmovisor.FlagUpgradeFile)
+	if err != nil {
+		return fmt.Errorf("failed to get upgrade-file flag: %w", err)
+	}
+
+	if upgradeFile != "" {
+		bz, err := os.ReadFile(upgradeFile)
+		if err != nil {
+			return fmt.Errorf("failed to read upgrade file: %w", err)
+		}
+
+		entries = strings.Split(string(bz), "\n")
+	}
+
+	upgrades, err := parseBatchUpgrades(entries, cfg.DisableRecase)
+	if err != nil {
+		return err
+	}
+
+	force, err := cmd.Flags().GetBool(cosmovisor.FlagForce)
+	if err != nil {
+		return fmt.Errorf("failed to get force flag: %w", err)
+	}
+
+	batch := make([]upgradetypes.Plan, 0, len(upgrades))
+	for _, upgrade := range upgrades {
+		if _, err := addUpgradeBinary(logger, cfg, upgrade.Name, upgrade.ExecutablePath, force); err != nil {
+			return err
+		}
+
+		batch = append(batch, upgradetypes.Plan{Name: upgrade.Name, Height: upgrade.Height})
+	}
+
+	// create upgrade-info.json.batch file
+	batchData, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("failed to marshal batch upgrades: %w", err)
+	}
+
+	if err := saveOrAbort(cfg.UpgradeInfoBatchFilePath(), batchData, force); err != nil {
+		return err
+	}
+
+	logger.Info(fmt.Sprintf("%s created, %d upgrade binaries will switch at heights %s", cfg.UpgradeInfoBatchFilePath(), len(batch), batchHeights(batch)))
+
+	return nil
+}
+
+// parseBatchUpgrades parses the <upgrade-name>:<path-to-executable>:<upgrade-height> entries
+// of a batch, ignoring blank lines, and returns the upgrades sorted by height.
+func parseBatchUpgrades(entries []string, disableRecase bool) ([]batchUpgrade, error) {
+	var (
+		upgrades []batchUpgrade
+		names    = map[string]bool{}
+		heights  = map[int64]bool{}
+	)
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid upgrade %q: must be <upgrade-name>:<path-to-executable>:<upgrade-height>", entry)
+		}
+
+		height, err := strconv.ParseInt(parts[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upgrade height of %q: %w", entry, err)
+		}
+
+		upgrade := batchUpgrade{Name: parts[0], ExecutablePath: parts[1], Height: height}
+		if !disableRecase {
+			upgrade.Name = strings.ToLower(upgrade.Name)
+		}
+
+		plan := upgradetypes.Plan{Name: upgrade.Name, Height: upgrade.Height}
+		if err := plan.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid upgrade %q: %w", entry, err)
+		}
+
+		if names[upgrade.Name] {
+			return nil, fmt.Errorf("duplicate upgrade name %q", upgrade.Name)
+		}
+		if heights[upgrade.Height] {
+			return nil, fmt.Errorf("duplicate upgrade height %d", upgrade.Height)
+		}
+		names[upgrade.Name], heights[upgrade.Height] = true, true
+
+		upgrades = append(upgrades, upgrade)
+	}
+
+	if len(upgrades) == 0 {
+		return nil, errors.New("no upgrades provided")
+	}
+
+	sort.Slice(upgrades, func(i, j int) bool {
+		return upgrades[i].Height < upgrades[j].Height
+	})
+
+	return upgrades, nil
+}
+
+// batchHeights returns the heights of the batch upgrades, for display.
+func batchHeights(batch []upgradetypes.Plan) string {
+	heights := make([]string, len(batch))
+	for i, upgrade := range batch {
+		heights[i] = strconv.FormatInt(upgrade.Height, 10)
+	}
+
+	return strings.Join(heights, ", ")
+}
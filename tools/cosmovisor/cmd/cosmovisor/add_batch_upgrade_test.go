@@ -0,0 +1,66 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBatchUpgrades(t *testing.T) {
+	cases := map[string]struct {
+		entries       []string
+		disableRecase bool
+		expected      []batchUpgrade
+		expectErr     string
+	}{
+		"sorted by height": {
+			entries: []string{"V3:/bin/v3:200", "", "v2:/bin/v2:100"},
+			expected: []batchUpgrade{
+				{Name: "v2", ExecutablePath: "/bin/v2", Height: 100},
+				{Name: "v3", ExecutablePath: "/bin/v3", Height: 200},
+			},
+		},
+		"disable recase": {
+			entries:       []string{" V2:/bin/v2:100 "},
+			disableRecase: true,
+			expected:      []batchUpgrade{{Name: "V2", ExecutablePath: "/bin/v2", Height: 100}},
+		},
+		"no upgrades": {
+			entries:   []string{"", " "},
+			expectErr: "no upgrades provided",
+		},
+		"invalid format": {
+			entries:   []string{"v2:/bin/v2"},
+			expectErr: "must be <upgrade-name>:<path-to-executable>:<upgrade-height>",
+		},
+		"invalid height": {
+			entries:   []string{"v2:/bin/v2:abc"},
+			expectErr: "invalid upgrade height",
+		},
+		"zero height": {
+			entries:   []string{"v2:/bin/v2:0"},
+			expectErr: "height must be greater than 0",
+		},
+		"duplicate name": {
+			entries:   []string{"v2:/bin/v2:100", "V2:/bin/v2:200"},
+			expectErr: "duplicate upgrade name",
+		},
+		"duplicate height": {
+			entries:   []string{"v2:/bin/v2:100", "v3:/bin/v3:100"},
+			expectErr: "duplicate upgrade height",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			upgrades, err := parseBatchUpgrades(tc.entries, tc.disableRecase)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, upgrades)
+		})
+	}
+}
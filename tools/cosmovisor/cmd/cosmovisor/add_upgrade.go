@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"cosmossdk.io/log"
 	"cosmossdk.io/tools/cosmovisor"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 )
@@ -42,44 +43,16 @@ func AddUpgrade(cmd *cobra.Command, args []string) error {
 
 	logger := cfg.Logger(os.Stdout)
 
-	upgradeName := args[0]
-	if !cfg.DisableRecase {
-		upgradeName = strings.ToLower(args[0])
-	}
-
-	executablePath := args[1]
-	if _, err := os.Stat(executablePath); err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("invalid executable path: %w", err)
-		}
-
-		return fmt.Errorf("failed to load executable path: %w", err)
-	}
-
-	// create upgrade dir
-	upgradeLocation := cfg.UpgradeDir(upgradeName)
-	if err := os.MkdirAll(path.Join(upgradeLocation, "bin"), 0o755); err != nil {
-		return fmt.Errorf("failed to create upgrade directory: %w", err)
-	}
-
-	// copy binary to upgrade dir
-	executableData, err := os.ReadFile(executablePath)
-	if err != nil {
-		return fmt.Errorf("failed to read binary: %w", err)
-	}
-
 	force, err := cmd.Flags().GetBool(cosmovisor.FlagForce)
 	if err != nil {
 		return fmt.Errorf("failed to get force flag: %w", err)
 	}
 
-	if err := saveOrAbort(cfg.UpgradeBin(upgradeName), executableData, force); err != nil {
+	upgradeName, err := addUpgradeBinary(logger, cfg, args[0], args[1], force)
+	if err != nil {
 		return err
 	}
 
-	logger.Info(fmt.Sprintf("Using %s for %s upgrade", executablePath, upgradeName))
-	logger.Info(fmt.Sprintf("Upgrade binary located at %s", cfg.UpgradeBin(upgradeName)))
-
 	if upgradeHeight, err := cmd.Flags().GetInt64(cosmovisor.FlagUpgradeHeight); err != nil {
 		return fmt.Errorf("failed to get upgrade-height flag: %w", err)
 	} else if upgradeHeight > 0 {
@@ -104,6 +77,42 @@ func AddUpgrade(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// addUpgradeBinary copies the executable to the upgrade directory and returns the normalized upgrade name.
+func addUpgradeBinary(logger log.Logger, cfg *cosmovisor.Config, upgradeName, executablePath string, force bool) (string, error) {
+	if !cfg.DisableRecase {
+		upgradeName = strings.ToLower(upgradeName)
+	}
+
+	if _, err := os.Stat(executablePath); err != nil {
+		if os.IsNotExist(err) {
+			return "", fmt.Errorf("invalid executable path: %w", err)
+		}
+
+		return "", fmt.Errorf("failed to load executable path: %w", err)
+	}
+
+	// create upgrade dir
+	upgradeLocation := cfg.UpgradeDir(upgradeName)
+	if err := os.MkdirAll(path.Join(upgradeLocation, "bin"), 0o755); err != nil {
+		return "", fmt.Errorf("failed to create upgrade directory: %w", err)
+	}
+
+	// copy binary to upgrade dir
+	executableData, err := os.ReadFile(executablePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read binary: %w", err)
+	}
+
+	if err := saveOrAbort(cfg.UpgradeBin(upgradeName), executableData, force); err != nil {
+		return "", err
+	}
+
+	logger.Info(fmt.Sprintf("Using %s for %s upgrade", executablePath, upgradeName))
+	logger.Info(fmt.Sprintf("Upgrade binary located at %s", cfg.UpgradeBin(upgradeName)))
+
+	return upgradeName, nil
+}
+
 // saveOrAbort saves data to path or aborts if file exists and force is false
 func saveOrAbort(path string, data []byte, force bool) error {
 	if _, err := os.Stat(path); err == nil {
@@ -6,5 +6,7 @@ const (
 	FlagCosmovisorOnly    = "cosmovisor-only"
 	FlagForce             = "force"
 	FlagUpgradeHeight     = "upgrade-height"
+	FlagUpgradeFile       = "upgrade-file"
+	FlagUpgradeList       = "upgrade-list"
 	FlagCosmovisorConfig  = "cosmovisor-config"
 )
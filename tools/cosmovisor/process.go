@@ -49,6 +49,12 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 		return false, fmt.Errorf("current binary is invalid: %w", err)
 	}
 
+	rollback, err := l.loadRollback()
+	if err != nil {
+		return false, err
+	}
+
+	l.fw.currentBin = bin
 	l.logger.Info("running app", "path", bin, "args", args)
 	cmd := exec.Command(bin, args...)
 	cmd.Stdout = stdout
@@ -58,23 +64,46 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 	}
 
 	sigs := make(chan os.Signal, 1)
+	signaled := make(chan struct{})
 	signal.Notify(sigs, syscall.SIGQUIT, syscall.SIGTERM)
 	go func() {
 		sig := <-sigs
+		close(signaled)
 		if err := cmd.Process.Signal(sig); err != nil {
 			l.logger.Error("terminated", "error", err, "bin", bin)
 			os.Exit(1)
 		}
 	}()
 
-	if needsUpdate, err := l.WaitForUpgradeOrExit(cmd); err != nil || !needsUpdate {
+	// the upgrade binary can be rolled back until it commits its first block
+	var committed <-chan struct{}
+	if rollback != nil {
+		stop := make(chan struct{})
+		defer close(stop)
+		committed = l.watchFirstBlock(stop)
+	}
+
+	needsUpdate, err := l.WaitForUpgradeOrExit(cmd)
+	if rollback != nil {
+		if err := l.checkRollback(*rollback, needsUpdate, err, committed, signaled); err != nil {
+			return false, err
+		}
+	}
+
+	if err != nil || !needsUpdate {
 		return false, err
 	}
 
 	if !IsSkipUpgradeHeight(args, l.fw.currentInfo) {
 		l.cfg.WaitRestartDelay()
 
-		if err := l.doBackup(); err != nil {
+		previousDir, err := l.cfg.currentDir()
+		if err != nil {
+			return false, err
+		}
+
+		backupDir, err := l.doBackup()
+		if err != nil {
 			return false, err
 		}
 
@@ -90,6 +119,14 @@ func (l Launcher) Run(args []string, stdout, stderr io.Writer) (bool, error) {
 			return false, err
 		}
 
+		if err := l.saveRollback(upgradeRollback{
+			Upgrade:       l.fw.currentInfo,
+			PreviousDir:   previousDir,
+			DataBackupDir: backupDir,
+		}); err != nil {
+			return false, err
+		}
+
 		return true, nil
 	}
 
@@ -161,22 +198,24 @@ func (l Launcher) WaitForUpgradeOrExit(cmd *exec.Cmd) (bool, error) {
 	return true, nil
 }
 
-func (l Launcher) doBackup() error {
+// doBackup takes a backup of the data directory, unless `UNSAFE_SKIP_BACKUP` is set,
+// and returns the backup directory.
+func (l Launcher) doBackup() (string, error) {
 	// take backup if `UNSAFE_SKIP_BACKUP` is not set.
 	if !l.cfg.UnsafeSkipBackup {
 		// check if upgrade-info.json is not empty.
 		var uInfo upgradetypes.Plan
 		upgradeInfoFile, err := os.ReadFile(l.cfg.UpgradeInfoFilePath())
 		if err != nil {
-			return fmt.Errorf("error while reading upgrade-info.json: %w", err)
+			return "", fmt.Errorf("error while reading upgrade-info.json: %w", err)
 		}
 
 		if err = json.Unmarshal(upgradeInfoFile, &uInfo); err != nil {
-			return err
+			return "", err
 		}
 
 		if uInfo.Name == "" {
-			return fmt.Errorf("upgrade-info.json is empty")
+			return "", fmt.Errorf("upgrade-info.json is empty")
 		}
 
 		// a destination directory, Format YYYY-MM-DD
@@ -188,12 +227,202 @@ func (l Launcher) doBackup() error {
 
 		// copy the $DAEMON_HOME/data to a backup dir
 		if err = copy.Copy(filepath.Join(l.cfg.Home, "data"), dst); err != nil {
-			return fmt.Errorf("error while taking data backup: %w", err)
+			return "", fmt.Errorf("error while taking data backup: %w", err)
 		}
 
 		// backup is done, lets check endtime to calculate total time taken for backup process
 		et := time.Now()
 		l.logger.Info("backup completed", "backup saved at", dst, "backup completion time", et, "time taken to complete backup", et.Sub(st))
+
+		return dst, nil
+	}
+
+	return "", nil
+}
+
+// upgradeRollback is the information needed to roll back an upgrade, kept until the
+// upgrade binary commits its first block.
+type upgradeRollback struct {
+	Upgrade       upgradetypes.Plan `json:"upgrade"`
+	PreviousDir   string            `json:"previous_dir"`
+	DataBackupDir string            `json:"data_backup_dir,omitempty"`
+}
+
+// saveRollback saves the rollback information of the upgrade that just happened.
+func (l Launcher) saveRollback(rollback upgradeRollback) error {
+	bz, err := json.Marshal(rollback)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(l.cfg.UpgradeRollbackFilePath(), bz, 0o600); err != nil {
+		return fmt.Errorf("error while writing %s: %w", upgradeRollbackFilename, err)
+	}
+
+	return nil
+}
+
+// loadRollback returns the rollback information of the last upgrade,
+// or nil if its binary has already committed a block.
+func (l Launcher) loadRollback() (*upgradeRollback, error) {
+	bz, err := os.ReadFile(l.cfg.UpgradeRollbackFilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error while reading %s: %w", upgradeRollbackFilename, err)
+	}
+
+	var rollback upgradeRollback
+	if err := json.Unmarshal(bz, &rollback); err != nil {
+		return nil, fmt.Errorf("invalid %s content: %w", upgradeRollbackFilename, err)
+	}
+
+	return &rollback, nil
+}
+
+// clearRollback removes the rollback information of the last upgrade.
+func (l Launcher) clearRollback() error {
+	if err := os.Remove(l.cfg.UpgradeRollbackFilePath()); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error while removing %s: %w", upgradeRollbackFilename, err)
+	}
+
+	return nil
+}
+
+// watchFirstBlock polls the app until it commits a block, in which case the rollback
+// information is cleared and the returned channel is closed.
+func (l Launcher) watchFirstBlock(stop <-chan struct{}) <-chan struct{} {
+	committed := make(chan struct{})
+	ticker := time.NewTicker(l.cfg.PollInterval)
+
+	go func() {
+		defer ticker.Stop()
+
+		var startHeight int64
+		for {
+			select {
+			case <-ticker.C:
+				height, err := l.fw.checkHeight()
+				if err != nil || height == 0 {
+					continue
+				}
+
+				// the first known height can be the last block of the previous binary
+				if startHeight == 0 {
+					startHeight = height
+					continue
+				}
+
+				if height > startHeight {
+					if err := l.clearRollback(); err != nil {
+						l.logger.Error("failed to clear upgrade rollback", "error", err)
+					}
+					close(committed)
+					return
+				}
+
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	return committed
+}
+
+// checkRollback rolls the last upgrade back if its binary failed before committing a block.
+// The returned error is the reason of the rollback, or the rollback failure.
+func (l Launcher) checkRollback(rollback upgradeRollback, needsUpdate bool, runErr error, committed, signaled <-chan struct{}) error {
+	select {
+	case <-committed:
+		return nil
+	default:
+	}
+
+	switch {
+	case needsUpdate:
+		// the upgrade binary ran until the next upgrade
+		return l.clearRollback()
+
+	case runErr == nil:
+		// the app exited normally (eg. short command), the upgrade binary didn't run yet
+		return nil
+	}
+
+	select {
+	case <-signaled:
+		// the app has been stopped by the operator
+		return nil
+	default:
+	}
+
+	l.logger.Error("upgrade binary failed before committing a block, rolling back", "upgrade", rollback.Upgrade.Name, "error", runErr)
+	if err := l.doRollback(rollback); err != nil {
+		return fmt.Errorf("failed to roll back upgrade %q: %w", rollback.Upgrade.Name, errors.Join(err, runErr))
+	}
+
+	return fmt.Errorf("upgrade %q binary failed before committing a block and has been rolled back: %w", rollback.Upgrade.Name, runErr)
+}
+
+// doRollback switches back to the binary used before the upgrade, and restores the data backup
+// taken before the upgrade if any.
+func (l Launcher) doRollback(rollback upgradeRollback) error {
+	if err := l.cfg.rollbackCurrentUpgrade(rollback.PreviousDir); err != nil {
+		return err
+	}
+	l.logger.Info("switched back to the previous binary", "path", rollback.PreviousDir)
+
+	if rollback.DataBackupDir != "" {
+		if err := l.restoreDataBackup(rollback.DataBackupDir); err != nil {
+			return err
+		}
+		l.logger.Info("restored data backup", "backup", rollback.DataBackupDir)
+	}
+
+	return l.clearRollback()
+}
+
+// restoreDataBackup replaces the data directory with the given data backup. The backup is
+// first copied to a temporary directory, so that the data directory is left untouched if
+// the copy fails, and then renamed into place.
+func (l Launcher) restoreDataBackup(backupDir string) (rerr error) {
+	dataDir := filepath.Join(l.cfg.Home, "data")
+
+	// the temporary directory is on the same filesystem as the data directory to rename it
+	tmpDir, err := os.MkdirTemp(l.cfg.Home, "data-restore-")
+	if err != nil {
+		return fmt.Errorf("error while creating data restore directory: %w", err)
+	}
+
+	// the data of the failed upgrade is moved aside until the backup is in place
+	failedDir := filepath.Join(tmpDir, "failed-data")
+	keepTmpDir := false
+	defer func() {
+		if keepTmpDir {
+			return
+		}
+		if err := os.RemoveAll(tmpDir); err != nil && rerr == nil {
+			l.logger.Error("failed to remove data restore directory", "path", tmpDir, "error", err)
+		}
+	}()
+
+	restoredDir := filepath.Join(tmpDir, "data")
+	if err := copy.Copy(backupDir, restoredDir); err != nil {
+		return fmt.Errorf("error while restoring data backup: %w", err)
+	}
+
+	if err := os.Rename(dataDir, failedDir); err != nil {
+		return fmt.Errorf("error while moving data directory: %w", err)
+	}
+
+	if err := os.Rename(restoredDir, dataDir); err != nil {
+		if rerr := os.Rename(failedDir, dataDir); rerr != nil {
+			// the data directory must not be lost with the temporary directory
+			keepTmpDir = true
+			err = errors.Join(err, fmt.Errorf("data directory left at %s: %w", failedDir, rerr))
+		}
+		return fmt.Errorf("error while restoring data backup: %w", err)
 	}
 
 	return nil
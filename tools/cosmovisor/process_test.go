@@ -7,7 +7,9 @@ import (
 	"bytes"
 	"fmt"
 	"io/fs"
+	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -194,6 +196,125 @@ func (s *processTestSuite) TestPlanShutdownGrace() {
 	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
 }
 
+// TestLaunchProcessWithBatchUpgrades will stage the batch upgrades one after the other
+func (s *processTestSuite) TestLaunchProcessWithBatchUpgrades() {
+	// binaries from testdata/batch directory
+	require := s.Require()
+	home := copyTestData(s.T(), "batch")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, UnsafeSkipBackup: true}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	stdout, stderr := newBuffer(), newBuffer()
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	// the first batch upgrade is staged while running the genesis binary
+	doUpgrade, err := launcher.Run([]string{"foo"}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+
+	// then the next batch upgrade
+	doUpgrade, err = launcher.Run([]string{"bar"}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain3"), currentBin)
+
+	stdout.Reset()
+	doUpgrade, err = launcher.Run([]string{"baz"}, stdout, stderr)
+	require.NoError(err)
+	require.False(doUpgrade)
+	require.Equal("", stderr.String())
+	require.Equal("Chain 3 finally!\nArgs: baz\nFinished successfully\n", stdout.String())
+
+	// all batch upgrades are done
+	_, err = os.Stat(cfg.UpgradeInfoBatchFilePath())
+	require.ErrorIs(err, fs.ErrNotExist)
+}
+
+// TestLaunchProcessWithRollback will roll back an upgrade whose binary fails before committing a block
+func (s *processTestSuite) TestLaunchProcessWithRollback() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: s.T().TempDir()}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	stdout, stderr := newBuffer(), newBuffer()
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	upgradeFile := cfg.UpgradeInfoFilePath()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", upgradeFile}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+	require.FileExists(cfg.UpgradeRollbackFilePath())
+
+	currentBin, err := cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.UpgradeBin("chain2"), currentBin)
+
+	// the upgrade binary corrupts the data and fails
+	appData := filepath.Join(home, "data", "app.db")
+	doUpgrade, err = launcher.Run([]string{appData}, stdout, stderr)
+	require.ErrorContains(err, "has been rolled back")
+	require.False(doUpgrade)
+
+	// the previous binary and data are restored
+	currentBin, err = cfg.CurrentBin()
+	require.NoError(err)
+	require.Equal(cfg.GenesisBin(), currentBin)
+	require.NoFileExists(appData)
+	require.FileExists(upgradeFile)
+
+	_, err = os.Stat(cfg.UpgradeRollbackFilePath())
+	require.ErrorIs(err, fs.ErrNotExist)
+}
+
+// TestLaunchProcessWithRollbackRestoreFailure will keep the data directory when the data backup cannot be restored
+func (s *processTestSuite) TestLaunchProcessWithRollbackRestoreFailure() {
+	// binaries from testdata/rollback directory
+	require := s.Require()
+	home := copyTestData(s.T(), "rollback")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", PollInterval: 20, DataBackupPath: s.T().TempDir()}
+	logger := log.NewTestLogger(s.T()).With(log.ModuleKey, "cosmosvisor")
+
+	stdout, stderr := newBuffer(), newBuffer()
+	launcher, err := cosmovisor.NewLauncher(logger, cfg)
+	require.NoError(err)
+
+	upgradeFile := cfg.UpgradeInfoFilePath()
+	doUpgrade, err := launcher.Run([]string{"foo", "bar", "1234", upgradeFile}, stdout, stderr)
+	require.NoError(err)
+	require.True(doUpgrade)
+
+	// the data backup is lost
+	require.NoError(os.RemoveAll(cfg.DataBackupPath))
+
+	appData := filepath.Join(home, "data", "app.db")
+	doUpgrade, err = launcher.Run([]string{appData}, stdout, stderr)
+	require.ErrorContains(err, "failed to roll back upgrade")
+	require.ErrorContains(err, "error while restoring data backup")
+	require.False(doUpgrade)
+
+	// the data directory is left untouched, and the rollback can be retried
+	require.FileExists(appData)
+	require.FileExists(upgradeFile)
+	require.FileExists(cfg.UpgradeRollbackFilePath())
+
+	entries, err := os.ReadDir(home)
+	require.NoError(err)
+	for _, entry := range entries {
+		require.False(strings.HasPrefix(entry.Name(), "data-restore-"), "temporary directory %s not removed", entry.Name())
+	}
+}
+
 // TestLaunchProcess will try running the script a few times and watch upgrades work properly
 // and args are passed through
 func (s *processTestSuite) TestLaunchProcessWithDownloads() {
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -16,8 +17,11 @@ import (
 )
 
 type fileWatcher struct {
-	filename string // full path to a watched file
-	interval time.Duration
+	logger        log.Logger
+	filename      string // full path to a watched file
+	batchFilename string // full path to the batch upgrades file
+	batchErr      string // last error staging a batch upgrade, logged once
+	interval      time.Duration
 
 	currentBin  string
 	currentInfo upgradetypes.Plan
@@ -41,6 +45,11 @@ func newUpgradeFileWatcher(cfg *Config, logger log.Logger) (*fileWatcher, error)
 		return nil, fmt.Errorf("invalid path: %s must be a valid file path: %w", filename, err)
 	}
 
+	batchFilenameAbs, err := filepath.Abs(cfg.UpgradeInfoBatchFilePath())
+	if err != nil {
+		return nil, fmt.Errorf("invalid path: %s must be a valid file path: %w", cfg.UpgradeInfoBatchFilePath(), err)
+	}
+
 	dirname := filepath.Dir(filename)
 	if info, err := os.Stat(dirname); err != nil || !info.IsDir() {
 		return nil, fmt.Errorf("invalid path: %s must be an existing directory: %w", dirname, err)
@@ -52,8 +61,10 @@ func newUpgradeFileWatcher(cfg *Config, logger log.Logger) (*fileWatcher, error)
 	}
 
 	return &fileWatcher{
+		logger:        logger,
 		currentBin:    bin,
 		filename:      filenameAbs,
+		batchFilename: batchFilenameAbs,
 		interval:      cfg.PollInterval,
 		currentInfo:   upgradetypes.Plan{},
 		lastModTime:   time.Time{},
@@ -105,6 +116,16 @@ func (fw *fileWatcher) CheckUpdate(currentUpgrade upgradetypes.Plan) bool {
 		return true
 	}
 
+	batchUpgrade, err := fw.stageBatchUpgrade(currentUpgrade)
+	switch {
+	case err == nil:
+		fw.batchErr = ""
+	case err.Error() != fw.batchErr:
+		// a malformed batch upgrades file must not stop the node, its upgrades are skipped until it is fixed
+		fw.batchErr = err.Error()
+		fw.logger.Error("failed to stage batch upgrade, skipping batch upgrades", "file", fw.batchFilename, "error", err)
+	}
+
 	stat, err := os.Stat(fw.filename)
 	if err != nil {
 		// file doesn't exists
@@ -121,7 +142,11 @@ func (fw *fileWatcher) CheckUpdate(currentUpgrade upgradetypes.Plan) bool {
 	}
 
 	// file exist but too early in height
-	currentHeight, _ := fw.checkHeight()
+	currentHeight, err := fw.checkHeight()
+	if err != nil && batchUpgrade != nil && strings.EqualFold(batchUpgrade.Name, info.Name) {
+		// a batch upgrade is not triggered by the app, so it needs the current height
+		return false
+	}
 	if currentHeight != 0 && currentHeight < info.Height {
 		return false
 	}
@@ -151,6 +176,54 @@ func (fw *fileWatcher) CheckUpdate(currentUpgrade upgradetypes.Plan) bool {
 	return false
 }
 
+// stageBatchUpgrade writes the next batch upgrade to the upgrade info file, once the upgrade
+// info file has no pending upgrade. Batch upgrades up to the current upgrade are pruned from
+// the batch file. It returns the next batch upgrade, or nil if there is none.
+func (fw *fileWatcher) stageBatchUpgrade(currentUpgrade upgradetypes.Plan) (*upgradetypes.Plan, error) {
+	batch, err := parseUpgradeInfoBatchFile(fw.batchFilename, fw.disableRecase)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	// prune the batch upgrades which are done
+	pending := batch[:0]
+	for _, upgrade := range batch {
+		if upgrade.Height > currentUpgrade.Height && !strings.EqualFold(upgrade.Name, currentUpgrade.Name) {
+			pending = append(pending, upgrade)
+		}
+	}
+	if len(pending) != len(batch) {
+		if err := writeUpgradeInfoBatchFile(fw.batchFilename, pending); err != nil {
+			return nil, err
+		}
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	next := pending[0]
+
+	// an upgrade which is not running yet is pending, it can be a governance upgrade written by the app
+	info, err := parseUpgradeInfoFile(fw.filename, fw.disableRecase)
+	if err == nil && !strings.EqualFold(info.Name, currentUpgrade.Name) {
+		return &next, nil
+	}
+
+	bz, err := json.Marshal(next)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(fw.filename, bz, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write upgrade info file: %w", err)
+	}
+
+	return &next, nil
+}
+
 // checkHeight checks if the current block height
 func (fw *fileWatcher) checkHeight() (int64, error) {
 	// TODO(@julienrbrt) use `if !testing.Testing()` from Go 1.22
@@ -210,3 +283,55 @@ func parseUpgradeInfoFile(filename string, disableRecase bool) (upgradetypes.Pla
 
 	return upgradePlan, nil
 }
+
+// parseUpgradeInfoBatchFile parses the batch upgrades file, a JSON list of upgrade plans,
+// and returns the plans sorted by height.
+func parseUpgradeInfoBatchFile(filename string, disableRecase bool) ([]upgradetypes.Plan, error) {
+	f, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch []upgradetypes.Plan
+	if err := json.Unmarshal(f, &batch); err != nil {
+		return nil, err
+	}
+
+	for i, upgradePlan := range batch {
+		if err := upgradePlan.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid upgrade-info.json.batch content: %w, got: %v", err, upgradePlan)
+		}
+
+		// normalize name to prevent operator error in upgrade name case sensitivity errors.
+		if !disableRecase {
+			batch[i].Name = strings.ToLower(upgradePlan.Name)
+		}
+	}
+
+	sort.SliceStable(batch, func(i, j int) bool {
+		return batch[i].Height < batch[j].Height
+	})
+
+	return batch, nil
+}
+
+// writeUpgradeInfoBatchFile writes the batch upgrades file, or removes it when there are no upgrades left.
+func writeUpgradeInfoBatchFile(filename string, batch []upgradetypes.Plan) error {
+	if len(batch) == 0 {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove upgrade info batch file: %w", err)
+		}
+		return nil
+	}
+
+	bz, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(filename, bz, 0o600); err != nil {
+		return fmt.Errorf("failed to write upgrade info batch file: %w", err)
+	}
+
+	return nil
+}
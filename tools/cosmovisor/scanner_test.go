@@ -1,11 +1,14 @@
 package cosmovisor
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/log"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 )
 
@@ -90,3 +93,23 @@ func TestParseUpgradeInfoFile(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckUpdateMalformedBatchFile(t *testing.T) {
+	dir := t.TempDir()
+	fw := &fileWatcher{
+		logger:        log.NewTestLogger(t),
+		filename:      filepath.Join(dir, upgradetypes.UpgradeInfoFilename),
+		batchFilename: filepath.Join(dir, upgradetypes.UpgradeInfoFilename+".batch"),
+		interval:      time.Millisecond,
+	}
+
+	// the malformed batch file is skipped
+	require.NoError(t, os.WriteFile(fw.batchFilename, []byte(`[{"name":"v2","height":"nan"}]`), 0o600))
+	require.False(t, fw.CheckUpdate(upgradetypes.Plan{}))
+	require.NotEmpty(t, fw.batchErr)
+
+	// and does not prevent the upgrades written by the app
+	require.NoError(t, os.WriteFile(fw.filename, []byte(`{"name":"v2","height":123}`), 0o600))
+	require.True(t, fw.CheckUpdate(upgradetypes.Plan{}))
+	require.Equal(t, "v2", fw.currentInfo.Name)
+}
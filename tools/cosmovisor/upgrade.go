@@ -15,10 +15,18 @@ import (
 // We can now make any changes to the underlying directory without interference and leave it
 // in a state, so we can make a proper restart
 func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
+	planBinaries, err := ParsePlanBinaries(cfg.UpgradeInfoFilePath(), p.Name)
+	if err != nil {
+		return fmt.Errorf("cannot parse plan binaries: %w", err)
+	}
+
 	// simplest case is to switch the link
-	err := plan.EnsureBinary(cfg.UpgradeBin(p.Name))
+	err = plan.EnsureBinary(cfg.UpgradeBin(p.Name))
 	if err == nil {
-		// we have the binary - do it
+		// we have the binary - verify it and do it
+		if err := verifyUpgradeBinary(cfg, p, planBinaries, false); err != nil {
+			return err
+		}
 		return cfg.SetCurrentUpgrade(p)
 	}
 
@@ -39,16 +47,7 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 		return fmt.Errorf("unhandled error: %w", err)
 	}
 
-	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
-	if err != nil {
-		return fmt.Errorf("cannot parse upgrade info: %w", err)
-	}
-
-	if err := upgradeInfo.ValidateFull(cfg.Name); err != nil {
-		return fmt.Errorf("invalid binaries: %w", err)
-	}
-
-	url, err := GetBinaryURL(upgradeInfo.Binaries)
+	url, err := getPlanBinaryURL(cfg, p, planBinaries)
 	if err != nil {
 		return err
 	}
@@ -65,9 +64,68 @@ func UpgradeBinary(logger log.Logger, cfg *Config, p upgradetypes.Plan) error {
 		return fmt.Errorf("downloaded binary doesn't check out: %w", err)
 	}
 
+	if err := verifyUpgradeBinary(cfg, p, planBinaries, true); err != nil {
+		return err
+	}
+
 	return cfg.SetCurrentUpgrade(p)
 }
 
+// getPlanBinaryURL returns the download url of the upgrade binary for the current os/arch.
+// The binary artifacts of the plan take precedence over the urls of the plan info.
+func getPlanBinaryURL(cfg *Config, p upgradetypes.Plan, planBinaries PlanBinaries) (string, error) {
+	if len(planBinaries.Binaries) > 0 {
+		artifact, ok := planBinaries.BinaryFor(OSArch())
+		if !ok {
+			return "", fmt.Errorf("cannot find binary for os/arch: neither %s, nor %s", OSArch(), AnyOSArch)
+		}
+
+		return artifact.URL, nil
+	}
+
+	upgradeInfo, err := plan.ParseInfo(p.Info, plan.ParseOptionEnforceChecksum(cfg.DownloadMustHaveChecksum))
+	if err != nil {
+		return "", fmt.Errorf("cannot parse upgrade info: %w", err)
+	}
+
+	if err := upgradeInfo.ValidateFull(cfg.Name); err != nil {
+		return "", fmt.Errorf("invalid binaries: %w", err)
+	}
+
+	return GetBinaryURL(upgradeInfo.Binaries)
+}
+
+// verifyUpgradeBinary checks the upgrade binary against the binary artifact of the plan.
+// When a verification key is configured, the binary must have an artifact matching it and
+// signed for the chain and the plan. Otherwise, only the checksum of a binary downloaded
+// from the plan artifacts is verified.
+func verifyUpgradeBinary(cfg *Config, p upgradetypes.Plan, planBinaries PlanBinaries, downloaded bool) error {
+	pubKey, err := cfg.VerificationKey()
+	if err != nil {
+		return err
+	}
+
+	artifact, ok := planBinaries.BinaryFor(OSArch())
+	switch {
+	case pubKey != nil && !ok:
+		return fmt.Errorf("cannot verify binary of upgrade %q: no binary artifact for os/arch %s", p.Name, OSArch())
+	case pubKey == nil && (!ok || !downloaded):
+		return nil
+	}
+
+	if pubKey != nil {
+		if err := artifact.VerifySignature(pubKey, cfg.ChainID, planBinaries.Name); err != nil {
+			return fmt.Errorf("refusing to switch to binary of upgrade %q: %w", p.Name, err)
+		}
+	}
+
+	if err := artifact.VerifyBinary(cfg.UpgradeBin(p.Name)); err != nil {
+		return fmt.Errorf("refusing to switch to binary of upgrade %q: %w", p.Name, err)
+	}
+
+	return nil
+}
+
 func GetBinaryURL(binaries plan.BinaryDownloadURLMap) (string, error) {
 	url, ok := binaries[OSArch()]
 	if !ok {
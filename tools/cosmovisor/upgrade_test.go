@@ -4,6 +4,9 @@
 package cosmovisor_test
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -197,6 +200,129 @@ func (s *upgradeTestSuite) TestUpgradeBinary() {
 	}
 }
 
+func (s *upgradeTestSuite) TestUpgradeBinaryWithArtifacts() {
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor")
+
+	url, err := filepath.Abs("./testdata/repo/raw_binary/autod")
+	s.Require().NoError(err)
+
+	// sha256sum ./testdata/repo/raw_binary/autod
+	checksum := "e6bc7851600a2a9917f7bf88eb7bdee1ec162c671101485690b4deb089077b0d"
+	invalidChecksum := strings.Repeat("ab", 32)
+
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+	_, otherPrivKey, err := ed25519.GenerateKey(nil)
+	s.Require().NoError(err)
+
+	// the upgrade name is normalized by cosmovisor, the signature is over the name of the plan
+	const chainID, planName = "test-chain", "Amazonas"
+	sign := func(privKey ed25519.PrivateKey, chainID, planName, checksum string) []byte {
+		signBytes, err := cosmovisor.BinaryArtifact{Sha256: checksum}.SignBytes(chainID, planName)
+		s.Require().NoError(err)
+		return ed25519.Sign(privKey, signBytes)
+	}
+
+	cases := map[string]struct {
+		artifact        cosmovisor.BinaryArtifact
+		verificationKey string
+		canUpgrade      bool
+	}{
+		"valid checksum without verification key": {
+			artifact:   cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: checksum},
+			canUpgrade: true,
+		},
+		"invalid checksum without verification key": {
+			artifact:   cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: invalidChecksum},
+			canUpgrade: false,
+		},
+		"any os/arch without verification key": {
+			artifact:   cosmovisor.BinaryArtifact{OsArch: cosmovisor.AnyOSArch, URL: url, Sha256: checksum},
+			canUpgrade: true,
+		},
+		"no binary for os/arch": {
+			artifact:   cosmovisor.BinaryArtifact{OsArch: "darwin/arm64", URL: url, Sha256: checksum},
+			canUpgrade: false,
+		},
+		"signed binary": {
+			artifact:        cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: checksum, Signature: sign(privKey, chainID, planName, checksum)},
+			verificationKey: hex.EncodeToString(pubKey),
+			canUpgrade:      true,
+		},
+		"unsigned binary": {
+			artifact:        cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: checksum},
+			verificationKey: hex.EncodeToString(pubKey),
+			canUpgrade:      false,
+		},
+		"binary signed by another key": {
+			artifact:        cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: checksum, Signature: sign(otherPrivKey, chainID, planName, checksum)},
+			verificationKey: hex.EncodeToString(pubKey),
+			canUpgrade:      false,
+		},
+		"binary signed for another chain": {
+			artifact:        cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: checksum, Signature: sign(privKey, "other-chain", planName, checksum)},
+			verificationKey: hex.EncodeToString(pubKey),
+			canUpgrade:      false,
+		},
+		"binary signed for another plan": {
+			artifact:        cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: checksum, Signature: sign(privKey, chainID, "Andes", checksum)},
+			verificationKey: hex.EncodeToString(pubKey),
+			canUpgrade:      false,
+		},
+		"signed binary with invalid checksum": {
+			artifact:        cosmovisor.BinaryArtifact{OsArch: cosmovisor.OSArch(), URL: url, Sha256: invalidChecksum, Signature: sign(privKey, chainID, planName, invalidChecksum)},
+			verificationKey: hex.EncodeToString(pubKey),
+			canUpgrade:      false,
+		},
+	}
+
+	for label, tc := range cases {
+		s.Run(label, func() {
+			home := copyTestData(s.T(), "download")
+
+			cfg := &cosmovisor.Config{
+				Home:                  home,
+				Name:                  "autod",
+				AllowDownloadBinaries: true,
+				BinaryVerificationKey: tc.verificationKey,
+				ChainID:               chainID,
+			}
+
+			// the binary artifacts are read from the upgrade info file written by the app
+			bz, err := json.Marshal(cosmovisor.PlanBinaries{Name: planName, Binaries: []cosmovisor.BinaryArtifact{tc.artifact}})
+			s.Require().NoError(err)
+			s.Require().NoError(os.MkdirAll(filepath.Dir(cfg.UpgradeInfoFilePath()), 0o755))
+			s.Require().NoError(os.WriteFile(cfg.UpgradeInfoFilePath(), bz, 0o600))
+
+			plan := upgradetypes.Plan{Name: strings.ToLower(planName)}
+			err = cosmovisor.UpgradeBinary(logger, cfg, plan)
+			currentBin, cerr := cfg.CurrentBin()
+			s.Require().NoError(cerr)
+			if !tc.canUpgrade {
+				s.Require().Error(err)
+				s.Require().Equal(cfg.GenesisBin(), currentBin)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(cfg.UpgradeBin(plan.Name), currentBin)
+			}
+		})
+	}
+}
+
+func (s *upgradeTestSuite) TestUpgradeBinaryRequiresArtifactWithVerificationKey() {
+	home := copyTestData(s.T(), "validate")
+	cfg := &cosmovisor.Config{Home: home, Name: "dummyd", BinaryVerificationKey: strings.Repeat("ab", 32), ChainID: "test-chain"}
+	logger := log.NewLogger(os.Stdout).With(log.ModuleKey, "cosmovisor")
+
+	// the binary is present, but cannot be verified
+	err := cosmovisor.UpgradeBinary(logger, cfg, upgradetypes.Plan{Name: "chain2"})
+	s.Require().ErrorContains(err, "no binary artifact")
+
+	currentBin, err := cfg.CurrentBin()
+	s.Require().NoError(err)
+	s.Require().Equal(cfg.GenesisBin(), currentBin)
+}
+
 func (s *upgradeTestSuite) TestOsArch() {
 	// all download tests will fail if we are not on linux...
 	s.Require().Equal("linux/amd64", cosmovisor.OSArch())
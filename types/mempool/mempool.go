@@ -40,4 +40,7 @@ type Iterator interface {
 var (
 	ErrTxNotFound           = errors.New("tx not found in mempool")
 	ErrMempoolTxMaxCapacity = errors.New("pool reached max tx capacity")
+
+	ErrMempoolSenderTxMaxCapacity = errors.New("sender reached max tx capacity")
+	ErrMempoolTxPriorityTooLow    = errors.New("tx priority is below the mempool priority floor")
 )
@@ -6,8 +6,10 @@ import (
 	"math"
 	"sync"
 
+	"github.com/hashicorp/go-metrics"
 	"github.com/huandu/skiplist"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -40,6 +42,22 @@ type (
 		// - if MaxTx < 0, `Insert` is a no-op.
 		MaxTx int
 
+		// MaxSenderTx sets the maximum number of transactions a single sender can
+		// have in the mempool. If MaxSenderTx <= 0, there is no per sender cap.
+		// Replacing an existing sender-nonce transaction is not subject to the cap.
+		MaxSenderTx int
+
+		// PriorityFloor, when set, returns the minimum priority a new transaction
+		// must have to be inserted given the current number of transactions in the
+		// mempool and MaxTx. It allows the floor to rise as the mempool fills up.
+		PriorityFloor func(txCount, maxTx int) C
+
+		// EnableEviction, when true and the mempool reached MaxTx, makes the
+		// insertion of a transaction evict the tail (highest nonce) transaction of
+		// the sender owning the lowest priority transaction in the mempool, as long
+		// as the inserted transaction has a strictly higher priority than both.
+		EnableEviction bool
+
 		// SignerExtractor is an implementation which retrieves signer data from a sdk.Tx
 		SignerExtractor SignerExtractionAdapter
 	}
@@ -157,6 +175,21 @@ func skiplistComparable[C comparable](txPriority TxPriority[C]) skiplist.Compara
 	})
 }
 
+// NewLinearPriorityFloor returns a PriorityFloor function which rises linearly
+// from minPriority, when the mempool is empty, to maxPriority, when the mempool
+// is full. If the mempool has no MaxTx, minPriority is always returned.
+func NewLinearPriorityFloor(minPriority, maxPriority int64) func(txCount, maxTx int) int64 {
+	return func(txCount, maxTx int) int64 {
+		if maxTx <= 0 || txCount <= 0 {
+			return minPriority
+		}
+		if txCount >= maxTx {
+			return maxPriority
+		}
+		return minPriority + (maxPriority-minPriority)*int64(txCount)/int64(maxTx)
+	}
+}
+
 // NewPriorityMempool returns the SDK's default mempool implementation which
 // returns txs in a partial order by 2 dimensions; priority, and sender-nonce.
 func NewPriorityMempool[C comparable](cfg PriorityNonceMempoolConfig[C]) *PriorityNonceMempool[C] {
@@ -204,9 +237,7 @@ func (mp *PriorityNonceMempool[C]) NextSenderTx(sender string) sdk.Tx {
 func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error {
 	mp.mtx.Lock()
 	defer mp.mtx.Unlock()
-	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
-		return ErrMempoolTxMaxCapacity
-	} else if mp.cfg.MaxTx < 0 {
+	if mp.cfg.MaxTx < 0 {
 		return nil
 	}
 
@@ -224,6 +255,29 @@ func (mp *PriorityNonceMempool[C]) Insert(ctx context.Context, tx sdk.Tx) error
 	nonce := sig.Sequence
 	key := txMeta[C]{nonce: nonce, priority: priority, sender: sender}
 
+	_, txExists := mp.scores[txMeta[C]{nonce: nonce, sender: sender}]
+	if !txExists {
+		if mp.cfg.MaxSenderTx > 0 {
+			if senderIndex, ok := mp.senderIndices[sender]; ok && senderIndex.Len() >= mp.cfg.MaxSenderTx {
+				return ErrMempoolSenderTxMaxCapacity
+			}
+		}
+
+		if mp.cfg.PriorityFloor != nil {
+			floor := mp.cfg.PriorityFloor(mp.priorityIndex.Len(), mp.cfg.MaxTx)
+			if mp.cfg.TxPriority.Compare(priority, floor) < 0 {
+				return fmt.Errorf("%w: priority %v, minimum priority %v", ErrMempoolTxPriorityTooLow, priority, floor)
+			}
+		}
+	}
+
+	// eviction happens last, once the tx passed all the other admission checks
+	if mp.cfg.MaxTx > 0 && mp.priorityIndex.Len() >= mp.cfg.MaxTx {
+		if txExists || !mp.cfg.EnableEviction || !mp.evictLowestPriority(sender, priority) {
+			return ErrMempoolTxMaxCapacity
+		}
+	}
+
 	senderIndex, ok := mp.senderIndices[sender]
 	if !ok {
 		senderIndex = skiplist.New(skiplist.LessThanFunc(func(a, b any) int {
@@ -440,9 +494,11 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	}
 
 	sig := sigs[0]
-	sender := sig.Signer.String()
-	nonce := sig.Sequence
+	return mp.remove(sig.Signer.String(), sig.Sequence)
+}
 
+// remove removes the transaction identified by sender and nonce from the mempool.
+func (mp *PriorityNonceMempool[C]) remove(sender string, nonce uint64) error {
 	scoreKey := txMeta[C]{nonce: nonce, sender: sender}
 	score, ok := mp.scores[scoreKey]
 	if !ok {
@@ -463,6 +519,46 @@ func (mp *PriorityNonceMempool[C]) Remove(tx sdk.Tx) error {
 	return nil
 }
 
+// evictLowestPriority evicts the tail (highest nonce) transaction of the sender
+// owning the lowest priority transaction in the mempool, so that a transaction
+// from sender with the given priority can be inserted. A transaction is only
+// evicted if the given priority is strictly higher than both the lowest priority
+// and the evicted transaction priority, and if it belongs to another sender, as
+// evicting the sender's own tail could leave a nonce gap. It returns true if a
+// transaction was evicted.
+func (mp *PriorityNonceMempool[C]) evictLowestPriority(sender string, priority C) bool {
+	lowest := mp.priorityIndex.Back()
+	if lowest == nil {
+		return false
+	}
+	lowestKey := lowest.Key().(txMeta[C])
+	if lowestKey.sender == sender || mp.cfg.TxPriority.Compare(priority, lowestKey.priority) <= 0 {
+		return false
+	}
+
+	tail := mp.senderIndices[lowestKey.sender].Back()
+	if tail == nil {
+		return false
+	}
+	tailKey := tail.Key().(txMeta[C])
+	tailScore := mp.scores[txMeta[C]{nonce: tailKey.nonce, sender: tailKey.sender}]
+	if mp.cfg.TxPriority.Compare(priority, tailScore.priority) <= 0 {
+		return false
+	}
+
+	if err := mp.remove(tailKey.sender, tailKey.nonce); err != nil {
+		return false
+	}
+
+	telemetry.IncrCounterWithLabels(
+		[]string{"mempool", "evicted_txs"},
+		1,
+		[]metrics.Label{telemetry.NewLabel("reason", "lower_priority")},
+	)
+
+	return true
+}
+
 func IsEmpty[C comparable](mempool Mempool) error {
 	mp := mempool.(*PriorityNonceMempool[C])
 	if mp.priorityIndex.Len() != 0 {
@@ -890,3 +890,158 @@ func TestNextSenderTx_TxReplacement(t *testing.T) {
 	iter := mp.Select(ctx, nil)
 	require.Equal(t, txs[3], iter.Tx())
 }
+
+func TestNextSenderTx_SenderTxLimit(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 2)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxSenderTx:     2,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 1, address: sa}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 2, address: sa}))
+	err := mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 3, address: sa})
+	require.ErrorIs(t, err, mempool.ErrMempoolSenderTxMaxCapacity)
+
+	// replacing an existing nonce is still allowed
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{priority: 20, nonce: 2, address: sa}))
+	// other senders are not affected
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), testTx{priority: 10, nonce: 1, address: sb}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityFloor(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	floor := mempool.NewLinearPriorityFloor(0, 100)
+	require.Equal(t, int64(0), floor(0, 4))
+	require.Equal(t, int64(50), floor(2, 4))
+	require.Equal(t, int64(100), floor(4, 4))
+	require.Equal(t, int64(0), floor(10, 0))
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxTx:           4,
+			PriorityFloor:   floor,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(1), testTx{priority: 1, nonce: 1, address: accounts[0].Address}))
+	require.NoError(t, mp.Insert(ctx.WithPriority(25), testTx{priority: 25, nonce: 1, address: accounts[1].Address}))
+	// floor is now 50
+	err := mp.Insert(ctx.WithPriority(49), testTx{priority: 49, nonce: 1, address: accounts[2].Address})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxPriorityTooLow)
+	require.NoError(t, mp.Insert(ctx.WithPriority(50), testTx{priority: 50, nonce: 1, address: accounts[2].Address}))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestPriorityEviction(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 3)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+	sa := accounts[0].Address
+	sb := accounts[1].Address
+	sc := accounts[2].Address
+
+	mp := mempool.NewPriorityMempool(
+		mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			MaxTx:           3,
+			EnableEviction:  true,
+			SignerExtractor: mempool.NewDefaultSignerExtractionAdapter(),
+		},
+	)
+
+	spam := []testTx{
+		{priority: 5, nonce: 1, address: sa},
+		{priority: 5, nonce: 2, address: sa},
+	}
+	for _, tx := range spam {
+		require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+	}
+	require.NoError(t, mp.Insert(ctx.WithPriority(50), testTx{priority: 50, nonce: 1, address: sb}))
+
+	// a tx with a priority not higher than the lowest is rejected
+	err := mp.Insert(ctx.WithPriority(5), testTx{priority: 5, nonce: 1, address: sc})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+	// the lowest priority sender cannot evict its own txs
+	err = mp.Insert(ctx.WithPriority(80), testTx{priority: 80, nonce: 3, address: sa})
+	require.ErrorIs(t, err, mempool.ErrMempoolTxMaxCapacity)
+
+	// a higher priority tx evicts the tail of the lowest priority sender
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), testTx{priority: 20, nonce: 1, address: sc}))
+	require.Equal(t, 3, mp.CountTx())
+
+	var selected []testTx
+	for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+		selected = append(selected, iter.Tx().(testTx))
+	}
+	require.Equal(t, []testTx{
+		{priority: 50, nonce: 1, address: sb},
+		{priority: 20, nonce: 1, address: sc},
+		{priority: 5, nonce: 1, address: sa},
+	}, selected)
+}
+
+func TestPriorityEvictionRejectedInsert(t *testing.T) {
+	accounts := simtypes.RandomAccounts(rand.New(rand.NewSource(0)), 4)
+	ctx := sdk.NewContext(nil, false, log.NewNopLogger())
+
+	testCases := map[string]struct {
+		cfg         mempool.PriorityNonceMempoolConfig[int64]
+		tx          testTx
+		expectedErr error
+	}{
+		"rejected by max sender txs": {
+			cfg: mempool.PriorityNonceMempoolConfig[int64]{MaxSenderTx: 1},
+			// the sender already has a tx in the pool
+			tx:          testTx{priority: 90, nonce: 2, address: accounts[1].Address},
+			expectedErr: mempool.ErrMempoolSenderTxMaxCapacity,
+		},
+		"rejected by priority floor": {
+			// the floor of the full pool is 100
+			cfg:         mempool.PriorityNonceMempoolConfig[int64]{PriorityFloor: mempool.NewLinearPriorityFloor(0, 100)},
+			tx:          testTx{priority: 80, nonce: 1, address: accounts[3].Address},
+			expectedErr: mempool.ErrMempoolTxPriorityTooLow,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			cfg := tc.cfg
+			cfg.TxPriority = mempool.NewDefaultTxPriority()
+			cfg.MaxTx = 3
+			cfg.EnableEviction = true
+			mp := mempool.NewPriorityMempool(cfg)
+
+			txs := []testTx{
+				{priority: 5, nonce: 1, address: accounts[0].Address},
+				{priority: 50, nonce: 1, address: accounts[1].Address},
+				{priority: 70, nonce: 1, address: accounts[2].Address},
+			}
+			for _, tx := range txs {
+				require.NoError(t, mp.Insert(ctx.WithPriority(tx.priority), tx))
+			}
+
+			// the tx would evict the lowest priority tx, but it fails the other admission checks first
+			err := mp.Insert(ctx.WithPriority(tc.tx.priority), tc.tx)
+			require.ErrorIs(t, err, tc.expectedErr)
+			require.Equal(t, 3, mp.CountTx())
+
+			var priorities []int64
+			for iter := mp.Select(ctx, nil); iter != nil; iter = iter.Next() {
+				priorities = append(priorities, iter.Tx().(testTx).priority)
+			}
+			require.Equal(t, []int64{70, 50, 5}, priorities)
+		})
+	}
+}
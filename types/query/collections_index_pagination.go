@@ -0,0 +1,148 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+)
+
+// CollectionIndexPaginate follows the same logic as CollectionPaginate but iterates
+// over an index of a collections.IndexedMap. The pagination keys are index keys,
+// and the values referenced by the index are lazily fetched from the IndexedMap,
+// only for the results which are in range of the pagination.
+// transformFunc is used to transform the result to a different type.
+func CollectionIndexPaginate[IK, PK, V any, Idx collections.Indexes[PK, V], T any](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[PK, V, Idx],
+	index indexes.Iterable[IK, PK],
+	pageReq *PageRequest,
+	transformFunc func(indexKey IK, primaryKey PK, value V) (T, error),
+	opts ...func(opt *CollectionsPaginateOptions[IK]),
+) ([]T, *PageResponse, error) {
+	return CollectionIndexFilteredPaginate(
+		ctx,
+		indexedMap,
+		index,
+		pageReq,
+		nil,
+		transformFunc,
+		opts...,
+	)
+}
+
+// CollectionIndexFilteredPaginate works in the same way as CollectionIndexPaginate but allows to filter
+// results using a predicateFunc.
+// A nil predicateFunc means no filtering is applied and results are collected as is.
+// NOTE: do not collect results using the values/keys passed to predicateFunc as they are not
+// guaranteed to be in the pagination range requested.
+func CollectionIndexFilteredPaginate[IK, PK, V any, Idx collections.Indexes[PK, V], T any](
+	ctx context.Context,
+	indexedMap *collections.IndexedMap[PK, V, Idx],
+	index indexes.Iterable[IK, PK],
+	pageReq *PageRequest,
+	predicateFunc func(indexKey IK, primaryKey PK, value V) (include bool, err error),
+	transformFunc func(indexKey IK, primaryKey PK, value V) (T, error),
+	opts ...func(opt *CollectionsPaginateOptions[IK]),
+) (results []T, pageRes *PageResponse, err error) {
+	pageReq = initPageRequestDefaults(pageReq)
+
+	offset := pageReq.Offset
+	key := pageReq.Key
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	reverse := pageReq.Reverse
+
+	if offset > 0 && key != nil {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	opt := new(CollectionsPaginateOptions[IK])
+	for _, o := range opts {
+		o(opt)
+	}
+
+	var prefix []byte
+	if opt.Prefix != nil {
+		prefix, err = encodeKeyWithCodec(index.KeyCodec(), *opt.Prefix)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	start, end, order := rawIterRange(prefix, key, reverse)
+	indexIter, err := index.IterateIndexRaw(ctx, start, end, order)
+	if err != nil {
+		// invalid iter error is ignored to retain Paginate behavior
+		if errors.Is(err, collections.ErrInvalidIterator) {
+			return nil, new(PageResponse), nil
+		}
+		return nil, nil, err
+	}
+	defer indexIter.Close()
+
+	iterator := joinedIndexIterator[IK, PK, V, Idx]{ctx: ctx, indexedMap: indexedMap, Iterator: indexIter}
+	encodeKey := func(key IK) ([]byte, error) { return encodeKeyWithCodec(index.KeyCodec(), key) }
+
+	var predicate func(IK, collections.KeyValue[PK, V]) (bool, error)
+	if predicateFunc != nil {
+		predicate = func(indexKey IK, kv collections.KeyValue[PK, V]) (bool, error) {
+			return predicateFunc(indexKey, kv.Key, kv.Value)
+		}
+	}
+	transform := func(indexKey IK, kv collections.KeyValue[PK, V]) (T, error) {
+		return transformFunc(indexKey, kv.Key, kv.Value)
+	}
+
+	if len(key) != 0 {
+		results, pageRes, err = collFilteredPaginateByKey[IK, collections.KeyValue[PK, V], T](iterator, encodeKey, limit, predicate, transform)
+	} else {
+		results, pageRes, err = collFilteredPaginateNoKey[IK, collections.KeyValue[PK, V], T](iterator, encodeKey, offset, limit, countTotal, predicate, transform)
+	}
+	// invalid iter error is ignored to retain Paginate behavior
+	if errors.Is(err, collections.ErrInvalidIterator) {
+		return results, new(PageResponse), nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	// strip the prefix from next key
+	if len(pageRes.NextKey) != 0 && prefix != nil {
+		pageRes.NextKey = pageRes.NextKey[len(prefix):]
+	}
+	return results, pageRes, nil
+}
+
+// joinedIndexIterator adapts an index iterator to the iterator used by pagination,
+// the keys are the index keys and the values are the primary keys joined with
+// the values referenced in the IndexedMap, which are fetched lazily.
+type joinedIndexIterator[IK, PK, V any, Idx collections.Indexes[PK, V]] struct {
+	indexes.Iterator[IK, PK]
+	ctx        context.Context
+	indexedMap *collections.IndexedMap[PK, V, Idx]
+}
+
+func (i joinedIndexIterator[IK, PK, V, Idx]) Key() (IK, error) {
+	return i.IndexKey()
+}
+
+func (i joinedIndexIterator[IK, PK, V, Idx]) KeyValue() (kv collections.KeyValue[IK, collections.KeyValue[PK, V]], err error) {
+	indexKey, err := i.IndexKey()
+	if err != nil {
+		return kv, err
+	}
+	pk, err := i.PrimaryKey()
+	if err != nil {
+		return kv, err
+	}
+	value, err := i.indexedMap.Get(i.ctx, pk)
+	if err != nil {
+		return kv, err
+	}
+	return collections.KeyValue[IK, collections.KeyValue[PK, V]]{
+		Key:   indexKey,
+		Value: collections.KeyValue[PK, V]{Key: pk, Value: value},
+	}, nil
+}
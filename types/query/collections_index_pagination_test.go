@@ -0,0 +1,114 @@
+package query
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+)
+
+type testOwnerIndex struct {
+	Owner *indexes.Multi[string, uint64, string]
+}
+
+func (i testOwnerIndex) IndexesList() []collections.Index[uint64, string] {
+	return []collections.Index[uint64, string]{i.Owner}
+}
+
+func TestCollectionIndexPagination(t *testing.T) {
+	sk, ctx := deps()
+	sb := collections.NewSchemaBuilder(sk)
+	// objects are stored as ID => owner and indexed by owner
+	m := collections.NewIndexedMap(sb, collections.NewPrefix(0), "objects", collections.Uint64Key, collections.StringValue,
+		testOwnerIndex{
+			Owner: indexes.NewMulti(sb, collections.NewPrefix(1), "owner_index", collections.StringKey, collections.Uint64Key, func(_ uint64, owner string) (string, error) {
+				return owner, nil
+			}),
+		},
+	)
+
+	// ids 0..99 are owned by alice, ids 100..149 by bob
+	for i := uint64(0); i < 150; i++ {
+		owner := "alice"
+		if i >= 100 {
+			owner = "bob"
+		}
+		require.NoError(t, m.Set(ctx, i, owner))
+	}
+
+	index := m.Indexes.Owner
+	transform := func(_ collections.Pair[string, uint64], pk uint64, owner string) (string, error) {
+		return fmt.Sprintf("%s/%d", owner, pk), nil
+	}
+	encodeKey := func(owner string, pk uint64) []byte {
+		b, err := encodeKeyWithCodec(index.KeyCodec(), collections.Join(owner, pk))
+		require.NoError(t, err)
+		return b
+	}
+	createResults := func(owner string, from, to uint64) []string {
+		var res []string
+		for i := from; i <= to; i++ {
+			res = append(res, fmt.Sprintf("%s/%d", owner, i))
+		}
+		return res
+	}
+
+	// offset and count total
+	results, resp, err := CollectionIndexPaginate(ctx, m, index, &PageRequest{Offset: 95, Limit: 10, CountTotal: true}, transform)
+	require.NoError(t, err)
+	require.Equal(t, append(createResults("alice", 95, 99), createResults("bob", 100, 104)...), results)
+	require.Equal(t, &PageResponse{NextKey: encodeKey("bob", 105), Total: 150}, resp)
+
+	// next key
+	results, resp, err = CollectionIndexPaginate(ctx, m, index, &PageRequest{Key: resp.NextKey, Limit: 50}, transform)
+	require.NoError(t, err)
+	require.Equal(t, createResults("bob", 105, 149), results)
+	require.Nil(t, resp.NextKey)
+
+	// prefixed by the reference key
+	results, resp, err = CollectionIndexPaginate(ctx, m, index, &PageRequest{Limit: 20, CountTotal: true}, transform,
+		WithCollectionPaginationPairPrefix[string, uint64]("bob"))
+	require.NoError(t, err)
+	require.Equal(t, createResults("bob", 100, 119), results)
+	require.Equal(t, uint64(50), resp.Total)
+	// the next key is stripped of the prefix
+	nextKey, err := encodeKeyWithCodec(collections.Uint64Key, 120)
+	require.NoError(t, err)
+	require.Equal(t, nextKey, resp.NextKey)
+
+	results, _, err = CollectionIndexPaginate(ctx, m, index, &PageRequest{Key: resp.NextKey, Limit: 2}, transform,
+		WithCollectionPaginationPairPrefix[string, uint64]("bob"))
+	require.NoError(t, err)
+	require.Equal(t, createResults("bob", 120, 121), results)
+
+	// reverse
+	results, _, err = CollectionIndexPaginate(ctx, m, index, &PageRequest{Limit: 2, Reverse: true}, transform,
+		WithCollectionPaginationPairPrefix[string, uint64]("alice"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"alice/99", "alice/98"}, results)
+
+	// filtered
+	results, resp, err = CollectionIndexFilteredPaginate(ctx, m, index, &PageRequest{Limit: 3, CountTotal: true},
+		func(_ collections.Pair[string, uint64], pk uint64, _ string) (bool, error) {
+			return pk%10 == 0, nil
+		},
+		transform,
+		WithCollectionPaginationPairPrefix[string, uint64]("bob"),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob/100", "bob/110", "bob/120"}, results)
+	require.Equal(t, uint64(5), resp.Total)
+
+	// offset and key
+	_, _, err = CollectionIndexPaginate(ctx, m, index, &PageRequest{Offset: 1, Key: []byte("a")}, transform)
+	require.ErrorContains(t, err, "either offset or key is expected")
+
+	// offset out of range
+	results, resp, err = CollectionIndexPaginate(ctx, m, index, &PageRequest{Offset: 500}, transform)
+	require.NoError(t, err)
+	require.Empty(t, results)
+	require.Equal(t, new(PageResponse), resp)
+}
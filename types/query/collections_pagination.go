@@ -103,10 +103,21 @@ func CollectionFilteredPaginate[K, V any, C Collection[K, V], T any](
 		}
 	}
 
+	iterator, err := getCollIter[K, V](ctx, coll, prefix, key, reverse)
+	if err != nil {
+		// invalid iter error is ignored to retain Paginate behavior
+		if errors.Is(err, collections.ErrInvalidIterator) {
+			return nil, new(PageResponse), nil
+		}
+		return nil, nil, err
+	}
+	defer iterator.Close()
+
+	encodeKey := func(key K) ([]byte, error) { return encodeCollKey[K, V](coll, key) }
 	if len(key) != 0 {
-		results, pageRes, err = collFilteredPaginateByKey(ctx, coll, prefix, key, reverse, limit, predicateFunc, transformFunc)
+		results, pageRes, err = collFilteredPaginateByKey(iterator, encodeKey, limit, predicateFunc, transformFunc)
 	} else {
-		results, pageRes, err = collFilteredPaginateNoKey(ctx, coll, prefix, reverse, offset, limit, countTotal, predicateFunc, transformFunc)
+		results, pageRes, err = collFilteredPaginateNoKey(iterator, encodeKey, offset, limit, countTotal, predicateFunc, transformFunc)
 	}
 	// invalid iter error is ignored to retain Paginate behavior
 	if errors.Is(err, collections.ErrInvalidIterator) {
@@ -119,24 +130,26 @@ func CollectionFilteredPaginate[K, V any, C Collection[K, V], T any](
 	return results, pageRes, err
 }
 
-// collFilteredPaginateNoKey applies the provided pagination on the collection when the starting key is not set.
+// collIterator defines the minimum set of methods of an iterator
+// required to apply pagination.
+type collIterator[K, V any] interface {
+	Key() (K, error)
+	KeyValue() (collections.KeyValue[K, V], error)
+	Next()
+	Valid() bool
+}
+
+// collFilteredPaginateNoKey applies the provided pagination on the collection iterator when the starting key is not set.
 // If predicateFunc is nil no filtering is applied.
-func collFilteredPaginateNoKey[K, V any, C Collection[K, V], T any](
-	ctx context.Context,
-	coll C,
-	prefix []byte,
-	reverse bool,
+func collFilteredPaginateNoKey[K, V, T any](
+	iterator collIterator[K, V],
+	encodeKey func(K) ([]byte, error),
 	offset uint64,
 	limit uint64,
 	countTotal bool,
 	predicateFunc func(K, V) (bool, error),
 	transformFunc func(K, V) (T, error),
 ) ([]T, *PageResponse, error) {
-	iterator, err := getCollIter[K, V](ctx, coll, prefix, nil, reverse)
-	if err != nil {
-		return nil, nil, err
-	}
-	defer iterator.Close()
 	// we advance the iter equal to the provided offset
 	if !advanceIter(iterator, offset) {
 		return nil, nil, collections.ErrInvalidIterator
@@ -186,7 +199,7 @@ func collFilteredPaginateNoKey[K, V any, C Collection[K, V], T any](
 			if err != nil {
 				return nil, nil, err
 			}
-			nextKey, err = encodeCollKey[K, V](coll, key)
+			nextKey, err = encodeKey(key)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -247,24 +260,15 @@ func advanceIter[I interface {
 	return true
 }
 
-// collFilteredPaginateByKey paginates a collection when a starting key
+// collFilteredPaginateByKey paginates a collection iterator when a starting key
 // is provided in the PageRequest. Predicate is applied only if not nil.
-func collFilteredPaginateByKey[K, V any, C Collection[K, V], T any](
-	ctx context.Context,
-	coll C,
-	prefix []byte,
-	key []byte,
-	reverse bool,
+func collFilteredPaginateByKey[K, V, T any](
+	iterator collIterator[K, V],
+	encodeKey func(K) ([]byte, error),
 	limit uint64,
 	predicateFunc func(key K, value V) (bool, error),
 	transformFunc func(key K, value V) (transformed T, err error),
 ) (results []T, pageRes *PageResponse, err error) {
-	iterator, err := getCollIter[K, V](ctx, coll, prefix, key, reverse)
-	if err != nil {
-		return nil, nil, err
-	}
-	defer iterator.Close()
-
 	var (
 		count   uint64
 		nextKey []byte
@@ -279,7 +283,7 @@ func collFilteredPaginateByKey[K, V any, C Collection[K, V], T any](
 				return nil, nil, err
 			}
 
-			nextKey, err = encodeCollKey[K, V](coll, concreteKey)
+			nextKey, err = encodeKey(concreteKey)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -322,12 +326,22 @@ func collFilteredPaginateByKey[K, V any, C Collection[K, V], T any](
 
 // todo maybe move to collections?
 func encodeCollKey[K, V any, C Collection[K, V]](coll C, key K) ([]byte, error) {
-	buffer := make([]byte, coll.KeyCodec().Size(key))
-	_, err := coll.KeyCodec().Encode(buffer, key)
+	return encodeKeyWithCodec(coll.KeyCodec(), key)
+}
+
+func encodeKeyWithCodec[K any](keyCodec collcodec.KeyCodec[K], key K) ([]byte, error) {
+	buffer := make([]byte, keyCodec.Size(key))
+	_, err := keyCodec.Encode(buffer, key)
 	return buffer, err
 }
 
 func getCollIter[K, V any, C Collection[K, V]](ctx context.Context, coll C, prefix, start []byte, reverse bool) (collections.Iterator[K, V], error) {
+	start, end, order := rawIterRange(prefix, start, reverse)
+	return coll.IterateRaw(ctx, start, end, order)
+}
+
+// rawIterRange returns the raw iteration range given the optional prefix and starting key.
+func rawIterRange(prefix, start []byte, reverse bool) ([]byte, []byte, collections.Order) {
 	// TODO: maybe can be simplified
 	if reverse {
 		var end []byte
@@ -335,12 +349,12 @@ func getCollIter[K, V any, C Collection[K, V]](ctx context.Context, coll C, pref
 			start = storetypes.PrefixEndBytes(append(prefix, start...))
 			end = prefix
 		}
-		return coll.IterateRaw(ctx, end, start, collections.OrderDescending)
+		return end, start, collections.OrderDescending
 	}
 	var end []byte
 	if prefix != nil {
 		start = append(prefix, start...)
 		end = storetypes.PrefixEndBytes(prefix)
 	}
-	return coll.IterateRaw(ctx, start, end, collections.OrderAscending)
+	return start, end, collections.OrderAscending
 }
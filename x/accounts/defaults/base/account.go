@@ -27,8 +27,9 @@ import (
 )
 
 var (
-	PubKeyPrefix   = collections.NewPrefix(0)
-	SequencePrefix = collections.NewPrefix(1)
+	PubKeyPrefix      = collections.NewPrefix(0)
+	SequencePrefix    = collections.NewPrefix(1)
+	SessionKeysPrefix = collections.NewPrefix(2)
 )
 
 func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
@@ -36,6 +37,7 @@ func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountC
 		return name, Account{
 			PubKey:          collections.NewItem(deps.SchemaBuilder, PubKeyPrefix, "pub_key", codec.CollValue[secp256k1.PubKey](deps.LegacyStateCodec)),
 			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			SessionKeys:     collections.NewMap(deps.SchemaBuilder, SessionKeysPrefix, "session_keys", collections.BytesKey, codec.CollValue[v1.SessionKey](deps.LegacyStateCodec)),
 			addrCodec:       deps.AddressCodec,
 			signingHandlers: handlerMap,
 			hs:              deps.Environment.HeaderService,
@@ -47,6 +49,8 @@ func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountC
 type Account struct {
 	PubKey   collections.Item[secp256k1.PubKey]
 	Sequence collections.Sequence
+	// SessionKeys are the additional pubkeys allowed to sign txs, keyed by pubkey.
+	SessionKeys collections.Map[[]byte, v1.SessionKey]
 
 	addrCodec address.Codec
 	hs        header.Service
@@ -75,6 +79,8 @@ func (a Account) verifyAndSetPubKey(ctx context.Context, key []byte) error {
 }
 
 // Authenticate implements the authentication flow of an abstracted base account.
+// The tx can be signed by the account pubkey, or by one of its session keys
+// identified by the pubkey of the signer info.
 func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
 	if !accountstd.SenderIsAccountsModule(ctx) {
 		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
@@ -90,12 +96,25 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, err
 	}
 
-	gotSeq := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].Sequence
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+	gotSeq := signerInfo.Sequence
 	if gotSeq != signerData.Sequence {
 		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
 	}
 
-	signMode, err := parseSignMode(msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex].ModeInfo)
+	sessionKey, isSessionKey, err := a.getSessionKey(ctx, signerInfo.PublicKey, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if isSessionKey {
+		pubKey = secp256k1.PubKey{Key: sessionKey.PubKey}
+		signerData.PubKey = &anypb.Any{
+			TypeUrl: signerInfo.PublicKey.TypeUrl,
+			Value:   signerInfo.PublicKey.Value,
+		}
+	}
+
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
 	}
@@ -111,6 +130,12 @@ func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthe
 		return nil, errors.New("signature verification failed")
 	}
 
+	if isSessionKey {
+		if err := a.useSessionKey(ctx, sessionKey, msg); err != nil {
+			return nil, err
+		}
+	}
+
 	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
 }
 
@@ -209,9 +234,13 @@ func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 
 func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.SwapPubKey)
+	accountstd.RegisterExecuteHandler(builder, a.AddSessionKey)
+	accountstd.RegisterExecuteHandler(builder, a.RevokeSessionKey)
 	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
 }
 
 func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKey)
+	accountstd.RegisterQueryHandler(builder, a.QuerySessionKeys)
 }
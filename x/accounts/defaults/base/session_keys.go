@@ -0,0 +1,260 @@
+package base
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	dcrd_secp256k1 "github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	msgSendTypeURL      = "/" + string((&bankv1beta1.MsgSend{}).ProtoReflect().Descriptor().FullName())
+	msgMultiSendTypeURL = "/" + string((&bankv1beta1.MsgMultiSend{}).ProtoReflect().Descriptor().FullName())
+	msgExecuteTypeURL   = "/" + gogoproto.MessageName(&accountsv1.MsgExecute{})
+
+	// spendTrackedMsgs are the msgs whose spending is counted against the
+	// spend limit of a session key. A session key with a spend limit can only
+	// sign those msgs, as any other msg could move funds out of the account.
+	// MsgExecute is not one of them: the executed account msg could make another
+	// account controlled by the account, e.g. a lockup account, send funds.
+	spendTrackedMsgs = []string{msgSendTypeURL, msgMultiSendTypeURL}
+)
+
+// AddSessionKey adds a session key to the account, or replaces the session key
+// with the same pubkey.
+func (a Account) AddSessionKey(ctx context.Context, msg *v1.MsgAddSessionKey) (*v1.MsgAddSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	sessionKey := msg.SessionKey
+	if _, err := dcrd_secp256k1.ParsePubKey(sessionKey.PubKey); err != nil {
+		return nil, err
+	}
+
+	pk, err := a.PubKey.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(pk.Key, sessionKey.PubKey) {
+		return nil, errors.New("session key cannot be the account pubkey")
+	}
+
+	if !sessionKey.Expiration.After(a.hs.HeaderInfo(ctx).Time) {
+		return nil, errors.New("session key expiration must be in the future")
+	}
+
+	for i, msgType := range sessionKey.AllowedMsgs {
+		if msgType == "" {
+			return nil, errors.New("allowed msg type cannot be empty")
+		}
+		if slices.Contains(sessionKey.AllowedMsgs[:i], msgType) {
+			return nil, fmt.Errorf("duplicate allowed msg type %s", msgType)
+		}
+	}
+
+	if len(sessionKey.SpendLimit) > 0 {
+		if err := sessionKey.SpendLimit.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid spend limit: %w", err)
+		}
+		for _, msgType := range sessionKey.AllowedMsgs {
+			if !slices.Contains(spendTrackedMsgs, msgType) {
+				return nil, fmt.Errorf("session key with a spend limit cannot be allowed to sign %s", msgType)
+			}
+		}
+	}
+
+	return &v1.MsgAddSessionKeyResponse{}, a.SessionKeys.Set(ctx, sessionKey.PubKey, sessionKey)
+}
+
+// RevokeSessionKey removes a session key from the account.
+func (a Account) RevokeSessionKey(ctx context.Context, msg *v1.MsgRevokeSessionKey) (*v1.MsgRevokeSessionKeyResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.SessionKeys.Has(ctx, msg.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errors.New("session key not found")
+	}
+
+	return &v1.MsgRevokeSessionKeyResponse{}, a.SessionKeys.Remove(ctx, msg.PubKey)
+}
+
+// getSessionKey returns the session key of the tx signer, if it signs with a
+// session key instead of the account pubkey.
+func (a Account) getSessionKey(ctx context.Context, signerPubKey *codectypes.Any, pk secp256k1.PubKey) (v1.SessionKey, bool, error) {
+	if signerPubKey == nil {
+		return v1.SessionKey{}, false, nil
+	}
+
+	var signerPk secp256k1.PubKey
+	if signerPubKey.TypeUrl != "/"+gogoproto.MessageName(&signerPk) {
+		return v1.SessionKey{}, false, fmt.Errorf("unsupported signer pubkey type %s", signerPubKey.TypeUrl)
+	}
+	if err := gogoproto.Unmarshal(signerPubKey.Value, &signerPk); err != nil {
+		return v1.SessionKey{}, false, err
+	}
+	if bytes.Equal(signerPk.Key, pk.Key) {
+		return v1.SessionKey{}, false, nil
+	}
+
+	sessionKey, err := a.SessionKeys.Get(ctx, signerPk.Key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return v1.SessionKey{}, false, errors.New("signer pubkey is neither the account pubkey nor a session key")
+	}
+	return sessionKey, err == nil, err
+}
+
+// useSessionKey checks the tx can be signed by the session key, and updates
+// the spend limit of the session key. The session key is revoked once its
+// spend limit is exhausted.
+func (a Account) useSessionKey(ctx context.Context, sessionKey v1.SessionKey, msg *aa_interface_v1.MsgAuthenticate) error {
+	if !a.hs.HeaderInfo(ctx).Time.Before(sessionKey.Expiration) {
+		return errors.New("session key expired")
+	}
+
+	self, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return err
+	}
+
+	spent := sdk.NewCoins()
+	if fee := msg.Tx.AuthInfo.Fee; fee != nil && fee.Granter == "" && (fee.Payer == self || fee.Payer == "" && msg.SignerIndex == 0) {
+		spent = spent.Add(fee.Amount...)
+	}
+
+	for _, anyMsg := range msg.Tx.Body.Messages {
+		if len(sessionKey.AllowedMsgs) > 0 && !slices.Contains(sessionKey.AllowedMsgs, anyMsg.TypeUrl) {
+			return fmt.Errorf("session key is not allowed to sign %s", anyMsg.TypeUrl)
+		}
+		if len(sessionKey.SpendLimit) > 0 && !slices.Contains(spendTrackedMsgs, anyMsg.TypeUrl) {
+			return fmt.Errorf("session key with a spend limit cannot sign %s", anyMsg.TypeUrl)
+		}
+
+		coins, err := msgSpending(anyMsg, self)
+		if err != nil {
+			return err
+		}
+		spent = spent.Add(coins...)
+	}
+
+	if len(sessionKey.SpendLimit) == 0 {
+		return nil
+	}
+
+	remaining, isNegative := sessionKey.SpendLimit.SafeSub(spent...)
+	if isNegative {
+		return fmt.Errorf("session key spend limit exceeded: spending %s, limit %s", spent, sessionKey.SpendLimit)
+	}
+	if remaining.IsZero() {
+		return a.SessionKeys.Remove(ctx, sessionKey.PubKey)
+	}
+
+	sessionKey.SpendLimit = remaining
+	return a.SessionKeys.Set(ctx, sessionKey.PubKey, sessionKey)
+}
+
+// msgSpending returns the coins sent by the account in the msg, for the
+// spendTrackedMsgs. The execution of msgs on the account itself is rejected,
+// so session keys cannot manage the keys of the account.
+func msgSpending(anyMsg *codectypes.Any, self string) (sdk.Coins, error) {
+	switch anyMsg.TypeUrl {
+	case msgSendTypeURL:
+		msg := new(bankv1beta1.MsgSend)
+		if err := proto.Unmarshal(anyMsg.Value, msg); err != nil {
+			return nil, err
+		}
+		if msg.FromAddress != self {
+			return nil, nil
+		}
+		return toCoins(msg.Amount)
+
+	case msgMultiSendTypeURL:
+		msg := new(bankv1beta1.MsgMultiSend)
+		if err := proto.Unmarshal(anyMsg.Value, msg); err != nil {
+			return nil, err
+		}
+		spent := sdk.NewCoins()
+		for _, input := range msg.Inputs {
+			if input.Address != self {
+				continue
+			}
+			coins, err := toCoins(input.Coins)
+			if err != nil {
+				return nil, err
+			}
+			spent = spent.Add(coins...)
+		}
+		return spent, nil
+
+	case msgExecuteTypeURL:
+		msg := new(accountsv1.MsgExecute)
+		if err := gogoproto.Unmarshal(anyMsg.Value, msg); err != nil {
+			return nil, err
+		}
+		if msg.Target == self {
+			return nil, errors.New("session keys cannot execute msgs on the account")
+		}
+		// only signed by session keys without spend limit
+		return nil, nil
+
+	default:
+		return nil, nil
+	}
+}
+
+func toCoins(coins []*basev1beta1.Coin) (sdk.Coins, error) {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		amount, ok := math.NewIntFromString(coin.Amount)
+		if !ok {
+			return nil, fmt.Errorf("invalid coin amount %s", coin.Amount)
+		}
+		res = res.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return res, nil
+}
+
+// QuerySessionKey returns a session key of the account.
+func (a Account) QuerySessionKey(ctx context.Context, req *v1.QuerySessionKey) (*v1.QuerySessionKeyResponse, error) {
+	sessionKey, err := a.SessionKeys.Get(ctx, req.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeyResponse{SessionKey: sessionKey}, nil
+}
+
+// QuerySessionKeys returns all the session keys of the account.
+func (a Account) QuerySessionKeys(ctx context.Context, _ *v1.QuerySessionKeys) (*v1.QuerySessionKeysResponse, error) {
+	sessionKeys, err := a.SessionKeys.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	values, err := sessionKeys.Values()
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySessionKeysResponse{SessionKeys: values}, nil
+}
@@ -0,0 +1,263 @@
+package base
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	basev1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/base/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const self = "base_account"
+
+const msgDelegateTypeURL = "/cosmos.staking.v1beta1.MsgDelegate"
+
+var now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type stateCodec struct {
+	codec.Codec
+}
+
+func (stateCodec) Marshal(m gogoproto.Message) ([]byte, error) { return gogoproto.Marshal(m) }
+func (stateCodec) Unmarshal(bz []byte, m gogoproto.Message) error {
+	return gogoproto.Unmarshal(bz, m)
+}
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{}
+
+func (headerService) HeaderInfo(context.Context) header.Info { return header.Info{Time: now} }
+
+func setupAccount(t *testing.T) (context.Context, Account) {
+	t.Helper()
+	ctx, ss := accountstd.NewMockContext(0, []byte(self), []byte(self), nil, nil, nil, nil)
+	_, acc, err := NewAccount("base", nil)(accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: stateCodec{},
+		Environment:      appmodule.Environment{HeaderService: headerService{}},
+	})
+	require.NoError(t, err)
+
+	account := acc.(Account)
+	_, err = account.Init(ctx, &v1.MsgInit{PubKey: secp256k1.GenPrivKey().PubKey().Bytes()})
+	require.NoError(t, err)
+	return ctx, account
+}
+
+func TestAddAndRevokeSessionKey(t *testing.T) {
+	ctx, acc := setupAccount(t)
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	sessionPubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+
+	testCases := []struct {
+		name       string
+		sender     string
+		sessionKey v1.SessionKey
+		expErr     string
+	}{
+		{"unauthorized", "other", v1.SessionKey{PubKey: sessionPubKey, Expiration: now.Add(time.Hour)}, "unauthorized"},
+		{"invalid pubkey", self, v1.SessionKey{PubKey: []byte("invalid"), Expiration: now.Add(time.Hour)}, "malformed public key"},
+		{"account pubkey", self, v1.SessionKey{PubKey: pk.Key, Expiration: now.Add(time.Hour)}, "cannot be the account pubkey"},
+		{"expired", self, v1.SessionKey{PubKey: sessionPubKey, Expiration: now}, "must be in the future"},
+		{"duplicate allowed msg", self, v1.SessionKey{PubKey: sessionPubKey, Expiration: now.Add(time.Hour), AllowedMsgs: []string{msgSendTypeURL, msgSendTypeURL}}, "duplicate allowed msg type"},
+		{"invalid spend limit", self, v1.SessionKey{PubKey: sessionPubKey, Expiration: now.Add(time.Hour), SpendLimit: sdk.Coins{sdk.NewInt64Coin("stake", 0)}}, "invalid spend limit"},
+		{"spend limit with untracked allowed msg", self, v1.SessionKey{PubKey: sessionPubKey, Expiration: now.Add(time.Hour), AllowedMsgs: []string{msgSendTypeURL, msgDelegateTypeURL}, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))}, "cannot be allowed to sign"},
+		{"success", self, v1.SessionKey{PubKey: sessionPubKey, Expiration: now.Add(time.Hour), AllowedMsgs: []string{msgSendTypeURL}}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := acc.AddSessionKey(accountstd.SetSender(ctx, []byte(tc.sender)), &v1.MsgAddSessionKey{SessionKey: tc.sessionKey})
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	res, err := acc.QuerySessionKeys(ctx, &v1.QuerySessionKeys{})
+	require.NoError(t, err)
+	require.Len(t, res.SessionKeys, 1)
+	require.Equal(t, []string{msgSendTypeURL}, res.SessionKeys[0].AllowedMsgs)
+
+	_, err = acc.RevokeSessionKey(accountstd.SetSender(ctx, []byte("other")), &v1.MsgRevokeSessionKey{PubKey: sessionPubKey})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionPubKey})
+	require.NoError(t, err)
+	_, err = acc.RevokeSessionKey(ctx, &v1.MsgRevokeSessionKey{PubKey: sessionPubKey})
+	require.ErrorContains(t, err, "session key not found")
+	_, err = acc.QuerySessionKey(ctx, &v1.QuerySessionKey{PubKey: sessionPubKey})
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestGetSessionKey(t *testing.T) {
+	ctx, acc := setupAccount(t)
+	pk, err := acc.PubKey.Get(ctx)
+	require.NoError(t, err)
+	sessionPubKey := secp256k1.GenPrivKey().PubKey().(*secp256k1.PubKey)
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: v1.SessionKey{PubKey: sessionPubKey.Key, Expiration: now.Add(time.Hour)}})
+	require.NoError(t, err)
+
+	_, isSessionKey, err := acc.getSessionKey(ctx, nil, pk)
+	require.NoError(t, err)
+	require.False(t, isSessionKey)
+
+	pkAny, err := codectypes.NewAnyWithValue(&pk)
+	require.NoError(t, err)
+	_, isSessionKey, err = acc.getSessionKey(ctx, pkAny, pk)
+	require.NoError(t, err)
+	require.False(t, isSessionKey)
+
+	sessionPkAny, err := codectypes.NewAnyWithValue(sessionPubKey)
+	require.NoError(t, err)
+	sessionKey, isSessionKey, err := acc.getSessionKey(ctx, sessionPkAny, pk)
+	require.NoError(t, err)
+	require.True(t, isSessionKey)
+	require.Equal(t, sessionPubKey.Key, sessionKey.PubKey)
+
+	unknownPkAny, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, _, err = acc.getSessionKey(ctx, unknownPkAny, pk)
+	require.ErrorContains(t, err, "neither the account pubkey nor a session key")
+}
+
+func TestUseSessionKey(t *testing.T) {
+	ctx, acc := setupAccount(t)
+	sessionPubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	sessionKey := v1.SessionKey{
+		PubKey:      sessionPubKey,
+		Expiration:  now.Add(time.Hour),
+		AllowedMsgs: []string{msgSendTypeURL},
+		SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+	}
+	_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.NoError(t, err)
+
+	send := func(from string, amount int64) *codectypes.Any {
+		bz, err := proto.Marshal(&bankv1beta1.MsgSend{
+			FromAddress: from,
+			ToAddress:   "recipient",
+			Amount:      []*basev1beta1.Coin{{Denom: "stake", Amount: math.NewInt(amount).String()}},
+		})
+		require.NoError(t, err)
+		return &codectypes.Any{TypeUrl: msgSendTypeURL, Value: bz}
+	}
+	execute := func(target string) *codectypes.Any {
+		bz, err := gogoproto.Marshal(&accountsv1.MsgExecute{Sender: self, Target: target})
+		require.NoError(t, err)
+		return &codectypes.Any{TypeUrl: msgExecuteTypeURL, Value: bz}
+	}
+	authenticate := func(fee int64, msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+		return &aa_interface_v1.MsgAuthenticate{Tx: &tx.Tx{
+			Body:     &tx.TxBody{Messages: msgs},
+			AuthInfo: &tx.AuthInfo{Fee: &tx.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", fee))}},
+		}}
+	}
+
+	testCases := []struct {
+		name         string
+		msg          *aa_interface_v1.MsgAuthenticate
+		expErr       string
+		expRemaining sdk.Coins
+	}{
+		{"msg not allowed", authenticate(0, &codectypes.Any{TypeUrl: msgMultiSendTypeURL}), "not allowed to sign", nil},
+		{"execute", authenticate(0, execute("other")), "not allowed to sign", nil},
+		{"spend limit exceeded", authenticate(10, send(self, 91)), "spend limit exceeded", nil},
+		{"fee and send", authenticate(10, send(self, 50)), "", sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+		{"send from another account", authenticate(0, send("other", 1000)), "", sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sessionKey, err := acc.SessionKeys.Get(ctx, sessionPubKey)
+			require.NoError(t, err)
+			err = acc.useSessionKey(ctx, sessionKey, tc.msg)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			sessionKey, err = acc.SessionKeys.Get(ctx, sessionPubKey)
+			require.NoError(t, err)
+			require.Equal(t, tc.expRemaining, sessionKey.SpendLimit)
+		})
+	}
+
+	t.Log("verify the session key is revoked once its spend limit is exhausted")
+	sessionKey, err = acc.SessionKeys.Get(ctx, sessionPubKey)
+	require.NoError(t, err)
+	require.NoError(t, acc.useSessionKey(ctx, sessionKey, authenticate(0, send(self, 40))))
+	has, err := acc.SessionKeys.Has(ctx, sessionPubKey)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	t.Log("verify expired session keys are rejected")
+	sessionKey.Expiration = now
+	require.ErrorContains(t, acc.useSessionKey(ctx, sessionKey, authenticate(0)), "session key expired")
+}
+
+func TestUseSessionKeySpendLimitUntrackedMsg(t *testing.T) {
+	ctx, acc := setupAccount(t)
+	sessionPubKey := secp256k1.GenPrivKey().PubKey().Bytes()
+	authenticate := func(msgs ...*codectypes.Any) *aa_interface_v1.MsgAuthenticate {
+		return &aa_interface_v1.MsgAuthenticate{Tx: &tx.Tx{
+			Body:     &tx.TxBody{Messages: msgs},
+			AuthInfo: &tx.AuthInfo{Fee: &tx.Fee{}},
+		}}
+	}
+	delegate := &codectypes.Any{TypeUrl: msgDelegateTypeURL}
+	execute := func(target string) *codectypes.Any {
+		bz, err := gogoproto.Marshal(&accountsv1.MsgExecute{Sender: self, Target: target})
+		require.NoError(t, err)
+		return &codectypes.Any{TypeUrl: msgExecuteTypeURL, Value: bz}
+	}
+
+	t.Log("verify a session key without spend limit can sign any msg, but the execution of msgs on the account")
+	sessionKey := v1.SessionKey{PubKey: sessionPubKey, Expiration: now.Add(time.Hour)}
+	_, err := acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.NoError(t, err)
+	require.NoError(t, acc.useSessionKey(ctx, sessionKey, authenticate(delegate, execute("other"))))
+	require.ErrorContains(t, acc.useSessionKey(ctx, sessionKey, authenticate(execute(self))), "cannot execute msgs on the account")
+
+	t.Log("verify a session key with a spend limit and no allowed msgs cannot sign untracked msgs")
+	sessionKey.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.NoError(t, err)
+	require.ErrorContains(t, acc.useSessionKey(ctx, sessionKey, authenticate(delegate)), "session key with a spend limit cannot sign")
+
+	t.Log("verify a session key with a spend limit cannot execute msgs on another account, which could make it send funds")
+	require.ErrorContains(t, acc.useSessionKey(ctx, sessionKey, authenticate(execute("other"))), "session key with a spend limit cannot sign")
+	sessionKey.AllowedMsgs = []string{msgExecuteTypeURL}
+	_, err = acc.AddSessionKey(ctx, &v1.MsgAddSessionKey{SessionKey: sessionKey})
+	require.ErrorContains(t, err, "session key with a spend limit cannot be allowed to sign")
+
+	sessionKey, err = acc.SessionKeys.Get(ctx, sessionPubKey)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sessionKey.SpendLimit)
+}
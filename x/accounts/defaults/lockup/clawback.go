@@ -0,0 +1,308 @@
+package lockup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Clawback allows the admin to claw back the locked coins of the lockup account to an account of choice.
+// The lockup of the account is then over: the coins which are not clawed back are unlocked.
+// The locked coins which are delegated are undelegated, together with the locked coins which are already
+// unbonding they are pending clawback and sent when the admin performs MsgClawback again.
+func (bva *BaseLockup) Clawback(
+	ctx context.Context, msg *lockuptypes.MsgClawback, getLockedCoinsFunc getLockedCoinsFunc,
+) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	err := bva.checkAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	_, err = bva.addressCodec.StringToBytes(msg.ToAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid 'to' address: %s", err)
+	}
+	whoami := accountstd.Whoami(ctx)
+	fromAddress, err := bva.addressCodec.BytesToString(whoami)
+	if err != nil {
+		return nil, err
+	}
+
+	clawedBack, err := bva.ClawedBack.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !clawedBack {
+		err = bva.endLockup(ctx, fromAddress, getLockedCoinsFunc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	amount, pending, err := bva.collectClawbackPending(ctx, fromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if amount.IsZero() && pending.IsZero() {
+		return nil, fmt.Errorf("no tokens available for clawback")
+	}
+
+	if !amount.IsZero() {
+		msgSend := &banktypes.MsgSend{
+			FromAddress: fromAddress,
+			ToAddress:   msg.ToAddress,
+			Amount:      amount,
+		}
+		_, err = sendMessage(ctx, msgSend)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &lockuptypes.MsgClawbackResponse{
+		AmountClawedBack: amount,
+		Pending:          pending,
+	}, nil
+}
+
+// endLockup ends the lockup of the account: the coins locked at the current block time are set pending
+// clawback and the original locking is reduced to the unlocked coins. The delegated locking coins are
+// undelegated, the remaining delegations are tracked as delegated free.
+func (bva *BaseLockup) endLockup(ctx context.Context, delegatorAddress string, getLockedCoinsFunc getLockedCoinsFunc) error {
+	hs := bva.headerService.HeaderInfo(ctx)
+
+	denoms := []string{}
+	err := bva.IterateCoinEntries(ctx, bva.OriginalLocking, func(key string, _ math.Int) (stop bool, err error) {
+		denoms = append(denoms, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, denoms...)
+	if err != nil {
+		return err
+	}
+
+	for _, coin := range lockedCoins {
+		if coin.Amount.IsZero() {
+			continue
+		}
+
+		originalLockingAmt, err := bva.OriginalLocking.Get(ctx, coin.Denom)
+		if err != nil {
+			return err
+		}
+		err = bva.OriginalLocking.Set(ctx, coin.Denom, originalLockingAmt.Sub(coin.Amount))
+		if err != nil {
+			return err
+		}
+
+		err = bva.ClawbackPending.Set(ctx, coin.Denom, coin.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	bondDenom, err := getStakingDenom(ctx)
+	if err != nil {
+		return err
+	}
+	delLockingAmt, err := bva.DelegatedLocking.Get(ctx, bondDenom)
+	if err != nil {
+		return err
+	}
+	delFreeAmt, err := bva.DelegatedFree.Get(ctx, bondDenom)
+	if err != nil {
+		return err
+	}
+
+	undelegateAmt := math.MinInt(lockedCoins.AmountOf(bondDenom), delLockingAmt)
+	if undelegateAmt.IsPositive() {
+		undelegatedAmt, err := bva.undelegate(ctx, delegatorAddress, sdk.NewCoin(bondDenom, undelegateAmt))
+		if err != nil {
+			return err
+		}
+
+		// the delegations were slashed, the slashed locked coins can't be clawed back
+		if slashedAmt := undelegateAmt.Sub(undelegatedAmt); slashedAmt.IsPositive() {
+			pendingAmt, err := bva.ClawbackPending.Get(ctx, bondDenom)
+			if err != nil {
+				return err
+			}
+			err = bva.ClawbackPending.Set(ctx, bondDenom, pendingAmt.Sub(slashedAmt))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = bva.DelegatedFree.Set(ctx, bondDenom, delFreeAmt.Add(delLockingAmt).Sub(undelegateAmt))
+	if err != nil {
+		return err
+	}
+	err = bva.DelegatedLocking.Set(ctx, bondDenom, math.ZeroInt())
+	if err != nil {
+		return err
+	}
+
+	return bva.ClawedBack.Set(ctx, true)
+}
+
+// undelegate undelegates up to amount from the delegations of the account, and returns the undelegated amount.
+func (bva *BaseLockup) undelegate(ctx context.Context, delegatorAddress string, amount sdk.Coin) (math.Int, error) {
+	delegationsQueryReq := &stakingtypes.QueryDelegatorDelegationsRequest{DelegatorAddr: delegatorAddress}
+	resp, err := accountstd.QueryModule[stakingtypes.QueryDelegatorDelegationsResponse](ctx, delegationsQueryReq)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	undelegatedAmt := math.ZeroInt()
+	for _, delegation := range resp.DelegationResponses {
+		remainingAmt := amount.Amount.Sub(undelegatedAmt)
+		if !remainingAmt.IsPositive() {
+			break
+		}
+
+		undelegateAmt := math.MinInt(remainingAmt, delegation.Balance.Amount)
+		if !undelegateAmt.IsPositive() {
+			continue
+		}
+
+		msgUndelegate := &stakingtypes.MsgUndelegate{
+			DelegatorAddress: delegatorAddress,
+			ValidatorAddress: delegation.Delegation.ValidatorAddress,
+			Amount:           sdk.NewCoin(amount.Denom, undelegateAmt),
+		}
+		_, err = sendMessage(ctx, msgUndelegate)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		undelegatedAmt = undelegatedAmt.Add(undelegateAmt)
+	}
+
+	return undelegatedAmt, nil
+}
+
+// collectClawbackPending returns the coins pending clawback which are available in the account balance,
+// and the coins which are still pending clawback afterwards.
+func (bva *BaseLockup) collectClawbackPending(ctx context.Context, address string) (amount, pending sdk.Coins, err error) {
+	clawbackPending := sdk.Coins{}
+	err = bva.IterateCoinEntries(ctx, bva.ClawbackPending, func(key string, value math.Int) (stop bool, err error) {
+		clawbackPending = append(clawbackPending, sdk.NewCoin(key, value))
+		return false, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	amount = sdk.Coins{}
+	pending = sdk.Coins{}
+	for _, coin := range clawbackPending {
+		balance, err := bva.getBalance(ctx, address, coin.Denom)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		collectAmt := math.MinInt(balance.Amount, coin.Amount)
+		if collectAmt.IsPositive() {
+			amount = append(amount, sdk.NewCoin(coin.Denom, collectAmt))
+		}
+
+		pendingAmt := coin.Amount.Sub(collectAmt)
+		if pendingAmt.IsPositive() {
+			pending = append(pending, sdk.NewCoin(coin.Denom, pendingAmt))
+			err = bva.ClawbackPending.Set(ctx, coin.Denom, pendingAmt)
+		} else {
+			err = bva.ClawbackPending.Remove(ctx, coin.Denom)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return amount, pending, nil
+}
+
+// getLockedCoins returns the locked coins of the account for the given denoms. Once the account is
+// clawed back, its lockup is over and only the coins pending clawback are locked.
+func (bva *BaseLockup) getLockedCoins(
+	ctx context.Context, blockTime time.Time, getLockedCoinsFunc getLockedCoinsFunc, denoms ...string,
+) (sdk.Coins, error) {
+	clawedBack, err := bva.ClawedBack.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !clawedBack {
+		return getLockedCoinsFunc(ctx, blockTime, denoms...)
+	}
+
+	lockedCoins := sdk.Coins{}
+	for _, denom := range denoms {
+		pendingAmt, err := bva.ClawbackPending.Get(ctx, denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			pendingAmt = math.ZeroInt()
+		} else if err != nil {
+			return nil, err
+		}
+		lockedCoins = append(lockedCoins, sdk.NewCoin(denom, pendingAmt))
+	}
+
+	return lockedCoins, nil
+}
+
+func (bva *BaseLockup) setAdmin(ctx context.Context, admin string) error {
+	if admin == "" {
+		return nil
+	}
+
+	adminBytes, err := bva.addressCodec.StringToBytes(admin)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid 'admin' address: %s", err)
+	}
+
+	return bva.Admin.Set(ctx, adminBytes)
+}
+
+func (bva *BaseLockup) checkAdmin(ctx context.Context, sender string) error {
+	admin, err := bva.Admin.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return fmt.Errorf("lockup account has no admin, clawback is disabled")
+	}
+	if err != nil {
+		return err
+	}
+	senderBytes, err := bva.addressCodec.StringToBytes(sender)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err.Error())
+	}
+	if !bytes.Equal(admin, senderBytes) {
+		return fmt.Errorf("sender is not the admin of this lockup account")
+	}
+
+	return nil
+}
+
+// withClawbackLockInfo sets the locked and unlocked coins of a clawed back lockup account info, as its lockup is over.
+func withClawbackLockInfo(resp *lockuptypes.QueryLockupAccountInfoResponse) *lockuptypes.QueryLockupAccountInfoResponse {
+	if resp.ClawedBack {
+		resp.UnlockedCoins = resp.OriginalLocking
+		resp.LockedCoins = resp.ClawbackPending
+	}
+	return resp
+}
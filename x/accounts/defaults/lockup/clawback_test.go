@@ -0,0 +1,372 @@
+package lockup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/accounts/accountstd"
+	lockuptypes "cosmossdk.io/x/accounts/defaults/lockup/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// collStateCodec is a mockStateCodec which can be used by codec.CollValue.
+type collStateCodec struct {
+	codec.Codec
+}
+
+func (collStateCodec) Marshal(m gogoproto.Message) ([]byte, error) {
+	return mockStateCodec{}.Marshal(m)
+}
+func (collStateCodec) Unmarshal(bz []byte, m gogoproto.Message) error {
+	return mockStateCodec{}.Unmarshal(bz, m)
+}
+
+// mockChain keeps track of the balance, delegations and unbondings of a lockup account.
+type mockChain struct {
+	balance     math.Int
+	delegations map[string]math.Int
+	unbonding   math.Int
+	sent        map[string]math.Int
+	now         time.Time
+}
+
+func newMockChain(t *testing.T, funds int64, now time.Time) (*mockChain, context.Context, store.KVStoreService) {
+	t.Helper()
+	chain := &mockChain{
+		balance:     math.NewInt(funds),
+		delegations: map[string]math.Int{},
+		unbonding:   math.ZeroInt(),
+		sent:        map[string]math.Int{},
+		now:         now,
+	}
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("lockup_account"), []byte("sender"), sdk.NewCoins(sdk.NewInt64Coin("test", funds)), func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+			return nil
+		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+			switch msg := msg.(type) {
+			case *banktypes.MsgSend:
+				amt := msg.Amount.AmountOf("test")
+				require.True(t, chain.balance.GTE(amt), "insufficient funds")
+				chain.balance = chain.balance.Sub(amt)
+				if sent, ok := chain.sent[msg.ToAddress]; ok {
+					amt = amt.Add(sent)
+				}
+				chain.sent[msg.ToAddress] = amt
+				return &banktypes.MsgSendResponse{}, nil
+			case *stakingtypes.MsgDelegate:
+				require.True(t, chain.balance.GTE(msg.Amount.Amount), "insufficient funds")
+				chain.balance = chain.balance.Sub(msg.Amount.Amount)
+				chain.delegations[msg.ValidatorAddress] = chain.delegationOf(msg.ValidatorAddress).Add(msg.Amount.Amount)
+				return &stakingtypes.MsgDelegateResponse{}, nil
+			case *stakingtypes.MsgUndelegate:
+				require.True(t, chain.delegationOf(msg.ValidatorAddress).GTE(msg.Amount.Amount), "insufficient delegation")
+				chain.delegations[msg.ValidatorAddress] = chain.delegationOf(msg.ValidatorAddress).Sub(msg.Amount.Amount)
+				chain.unbonding = chain.unbonding.Add(msg.Amount.Amount)
+				return &stakingtypes.MsgUndelegateResponse{}, nil
+			}
+			return nil, nil
+		}, func(ctx context.Context, req, resp ProtoMsg) error {
+			switch req.(type) {
+			case *stakingtypes.QueryParamsRequest:
+				*resp.(*stakingtypes.QueryParamsResponse) = stakingtypes.QueryParamsResponse{
+					Params: stakingtypes.Params{BondDenom: "test"},
+				}
+			case *banktypes.QueryBalanceRequest:
+				*resp.(*banktypes.QueryBalanceResponse) = banktypes.QueryBalanceResponse{
+					Balance: &sdk.Coin{Denom: "test", Amount: chain.balance},
+				}
+			case *stakingtypes.QueryDelegatorDelegationsRequest:
+				delegations := resp.(*stakingtypes.QueryDelegatorDelegationsResponse)
+				for _, val := range []string{"val1", "val2"} {
+					if amt, ok := chain.delegations[val]; ok {
+						delegations.DelegationResponses = append(delegations.DelegationResponses, stakingtypes.DelegationResponse{
+							Delegation: stakingtypes.Delegation{ValidatorAddress: val},
+							Balance:    sdk.NewCoin("test", amt),
+						})
+					}
+				}
+			default:
+				t.Fatalf("unexpected query %T", req)
+			}
+			return nil
+		},
+	)
+	return chain, ctx, ss
+}
+
+func (c *mockChain) delegationOf(val string) math.Int {
+	if amt, ok := c.delegations[val]; ok {
+		return amt
+	}
+	return math.ZeroInt()
+}
+
+// completeUnbonding credits the balance with the unbonding coins.
+func (c *mockChain) completeUnbonding() {
+	c.balance = c.balance.Add(c.unbonding)
+	c.unbonding = math.ZeroInt()
+}
+
+func (c *mockChain) dependencies(ss store.KVStoreService) accountstd.Dependencies {
+	return accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: collStateCodec{},
+		Environment:      appmodule.Environment{HeaderService: c},
+	}
+}
+
+func (c *mockChain) HeaderInfo(context.Context) header.Info {
+	return header.Info{Time: c.now}
+}
+
+func TestClawbackDisabled(t *testing.T) {
+	startTime := time.Now()
+	chain, ctx, ss := newMockChain(t, 1000, startTime)
+	acc, err := NewContinuousLockingAccount(chain.dependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		StartTime: startTime,
+		EndTime:   startTime.Add(100 * time.Second),
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.ErrorContains(t, err, "clawback is disabled")
+}
+
+func TestClawbackContinuousLockingAccount(t *testing.T) {
+	startTime := time.Now()
+	chain, ctx, ss := newMockChain(t, 1000, startTime)
+	acc, err := NewContinuousLockingAccount(chain.dependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitLockupAccount{
+		Owner:     "owner",
+		Admin:     "funder",
+		StartTime: startTime,
+		EndTime:   startTime.Add(100 * time.Second),
+	})
+	require.NoError(t, err)
+
+	// the owner delegates locked coins to two validators, then partially unbonds one delegation
+	_, err = acc.Delegate(ctx, &lockuptypes.MsgDelegate{Sender: "owner", ValidatorAddress: "val1", Amount: sdk.NewInt64Coin("test", 400)})
+	require.NoError(t, err)
+	_, err = acc.Delegate(ctx, &lockuptypes.MsgDelegate{Sender: "owner", ValidatorAddress: "val2", Amount: sdk.NewInt64Coin("test", 400)})
+	require.NoError(t, err)
+	_, err = acc.Undelegate(ctx, &lockuptypes.MsgUndelegate{Sender: "owner", ValidatorAddress: "val1", Amount: sdk.NewInt64Coin("test", 200)})
+	require.NoError(t, err)
+
+	delLocking, err := acc.DelegatedLocking.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(600), delLocking)
+
+	// half of the coins are unlocked
+	chain.now = startTime.Add(50 * time.Second)
+
+	_, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "owner", ToAddress: "owner"})
+	require.ErrorContains(t, err, "sender is not the admin of this lockup account")
+
+	// the 500 locked coins are delegated and undelegated, 200 are clawed back from the liquid balance
+	// and 300 once the unbonding completes.
+	resp, err := acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 200)), resp.AmountClawedBack)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 300)), resp.Pending)
+	require.True(t, chain.delegationOf("val1").IsZero())
+	require.Equal(t, math.NewInt(100), chain.delegationOf("val2"))
+	require.Equal(t, math.NewInt(700), chain.unbonding)
+
+	// the remaining delegations are free
+	delLocking, err = acc.DelegatedLocking.Get(ctx, "test")
+	require.NoError(t, err)
+	require.True(t, delLocking.IsZero())
+	delFree, err := acc.DelegatedFree.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(100), delFree)
+
+	info, err := acc.QueryLockupAccountInfo(ctx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "funder", info.Admin)
+	require.True(t, info.ClawedBack)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 300)), info.ClawbackPending)
+	require.Equal(t, info.ClawbackPending, info.LockedCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 500)), info.UnlockedCoins)
+
+	// the coins pending clawback can't be spent once the unbonding completes
+	chain.completeUnbonding()
+	_, err = acc.SendCoins(ctx, &lockuptypes.MsgSend{Sender: "owner", ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 600))})
+	require.ErrorContains(t, err, "spendable balance 400test is smaller than 600test")
+	_, err = acc.Delegate(ctx, &lockuptypes.MsgDelegate{Sender: "owner", ValidatorAddress: "val2", Amount: sdk.NewInt64Coin("test", 600)})
+	require.ErrorContains(t, err, "spendable balance 400test is smaller than 600test")
+
+	resp, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 300)), resp.AmountClawedBack)
+	require.True(t, resp.Pending.IsZero())
+	require.Equal(t, math.NewInt(500), chain.sent["funder"])
+
+	_, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.ErrorContains(t, err, "no tokens available for clawback")
+
+	// the lockup is over, the owner can spend the remaining coins
+	_, err = acc.SendCoins(ctx, &lockuptypes.MsgSend{Sender: "owner", ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 400))})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(400), chain.sent["receiver"])
+}
+
+func TestClawbackPermanentLockingAccount(t *testing.T) {
+	chain, ctx, ss := newMockChain(t, 1000, time.Now())
+	acc, err := NewPermanentLockingAccount(chain.dependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitLockupAccount{Owner: "owner", Admin: "funder"})
+	require.NoError(t, err)
+
+	// the delegation is slashed before the clawback
+	_, err = acc.Delegate(ctx, &lockuptypes.MsgDelegate{Sender: "owner", ValidatorAddress: "val1", Amount: sdk.NewInt64Coin("test", 300)})
+	require.NoError(t, err)
+	chain.delegations["val1"] = math.NewInt(250)
+
+	resp, err := acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 700)), resp.AmountClawedBack)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 250)), resp.Pending)
+
+	chain.completeUnbonding()
+	resp, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 250)), resp.AmountClawedBack)
+	require.Equal(t, math.NewInt(950), chain.sent["funder"])
+
+	info, err := acc.QueryLockupAccountInfo(ctx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.True(t, info.LockedCoins.IsZero())
+}
+
+func TestClawbackDelayedLockingAccount(t *testing.T) {
+	startTime := time.Now()
+	chain, ctx, ss := newMockChain(t, 1000, startTime)
+	acc, err := NewDelayedLockingAccount(chain.dependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitLockupAccount{
+		Owner:   "owner",
+		Admin:   "funder",
+		EndTime: startTime.Add(100 * time.Second),
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(ctx, &lockuptypes.MsgDelegate{Sender: "owner", ValidatorAddress: "val1", Amount: sdk.NewInt64Coin("test", 300)})
+	require.NoError(t, err)
+
+	// all the coins are locked until the end time, the delegated coins are pending clawback
+	chain.now = startTime.Add(99 * time.Second)
+	resp, err := acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 700)), resp.AmountClawedBack)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 300)), resp.Pending)
+	require.True(t, chain.delegationOf("val1").IsZero())
+
+	info, err := acc.QueryVestingAccountInfo(ctx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.True(t, info.ClawedBack)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 300)), info.LockedCoins)
+	require.True(t, info.UnlockedCoins.IsZero())
+
+	chain.completeUnbonding()
+	_, err = acc.SendCoins(ctx, &lockuptypes.MsgSend{Sender: "owner", ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1))})
+	require.ErrorContains(t, err, "spendable balance 0test is smaller than 1test")
+
+	resp, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 300)), resp.AmountClawedBack)
+	require.True(t, resp.Pending.IsZero())
+	require.Equal(t, math.NewInt(1000), chain.sent["funder"])
+	require.True(t, chain.balance.IsZero())
+
+	_, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.ErrorContains(t, err, "no tokens available for clawback")
+}
+
+func TestClawbackDelayedLockingAccountAfterEndTime(t *testing.T) {
+	startTime := time.Now()
+	chain, ctx, ss := newMockChain(t, 1000, startTime)
+	acc, err := NewDelayedLockingAccount(chain.dependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitLockupAccount{
+		Owner:   "owner",
+		Admin:   "funder",
+		EndTime: startTime.Add(100 * time.Second),
+	})
+	require.NoError(t, err)
+
+	// nothing is locked anymore, the owner keeps the coins
+	chain.now = startTime.Add(101 * time.Second)
+	_, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.ErrorContains(t, err, "no tokens available for clawback")
+
+	_, err = acc.SendCoins(ctx, &lockuptypes.MsgSend{Sender: "owner", ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1000))})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), chain.sent["receiver"])
+}
+
+func TestClawbackPeriodicLockingAccount(t *testing.T) {
+	startTime := time.Now()
+	chain, ctx, ss := newMockChain(t, 1000, startTime)
+	acc, err := NewPeriodicLockingAccount(chain.dependencies(ss))
+	require.NoError(t, err)
+	_, err = acc.Init(ctx, &lockuptypes.MsgInitPeriodicLockingAccount{
+		Owner:     "owner",
+		Admin:     "funder",
+		StartTime: startTime,
+		LockingPeriods: []lockuptypes.Period{
+			{Length: 100 * time.Second, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))},
+			{Length: 100 * time.Second, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 300))},
+			{Length: 100 * time.Second, Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 500))},
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = acc.Delegate(ctx, &lockuptypes.MsgDelegate{Sender: "owner", ValidatorAddress: "val1", Amount: sdk.NewInt64Coin("test", 300)})
+	require.NoError(t, err)
+
+	// the first period is unlocked, the coins of the two last periods are clawed back
+	chain.now = startTime.Add(150 * time.Second)
+	resp, err := acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 700)), resp.AmountClawedBack)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), resp.Pending)
+	require.True(t, chain.delegationOf("val1").IsZero())
+
+	info, err := acc.QueryLockupAccountInfo(ctx, &lockuptypes.QueryLockupAccountInfoRequest{})
+	require.NoError(t, err)
+	require.True(t, info.ClawedBack)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), info.LockedCoins)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 200)), info.UnlockedCoins)
+
+	// the coins of the later periods stay clawed back once their period ends
+	chain.now = startTime.Add(300 * time.Second)
+	chain.completeUnbonding()
+	resp, err = acc.Clawback(ctx, &lockuptypes.MsgClawback{Admin: "funder", ToAddress: "funder"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("test", 100)), resp.AmountClawedBack)
+	require.True(t, resp.Pending.IsZero())
+	require.Equal(t, math.NewInt(800), chain.sent["funder"])
+
+	_, err = acc.SendCoins(ctx, &lockuptypes.MsgSend{Sender: "owner", ToAddress: "receiver", Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 200))})
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(200), chain.sent["receiver"])
+	require.True(t, chain.balance.IsZero())
+}
@@ -72,6 +72,12 @@ func (cva *ContinuousLockingAccount) SendCoins(ctx context.Context, msg *lockupt
 	return cva.BaseLockup.SendCoins(ctx, msg, cva.GetLockedCoinsWithDenoms)
 }
 
+func (cva *ContinuousLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return cva.BaseLockup.Clawback(ctx, msg, cva.GetLockedCoinsWithDenoms)
+}
+
 func (cva *ContinuousLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (
 	*lockuptypes.MsgWithdrawResponse, error,
 ) {
@@ -183,7 +189,7 @@ func (cva ContinuousLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 	resp.StartTime = &startTime
 	resp.LockedCoins = lockedCoins
 	resp.UnlockedCoins = unlockedCoins
-	return resp, nil
+	return withClawbackLockInfo(resp), nil
 }
 
 // Implement smart account interface
@@ -194,6 +200,7 @@ func (cva ContinuousLockingAccount) RegisterInitHandler(builder *accountstd.Init
 func (cva ContinuousLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, cva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, cva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, cva.Clawback)
 	accountstd.RegisterExecuteHandler(builder, cva.WithdrawUnlockedCoins)
 	cva.BaseLockup.RegisterExecuteHandlers(builder)
 }
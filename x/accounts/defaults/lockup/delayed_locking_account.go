@@ -49,6 +49,12 @@ func (dva *DelayedLockingAccount) SendCoins(ctx context.Context, msg *lockuptype
 	return dva.BaseLockup.SendCoins(ctx, msg, dva.GetLockedCoinsWithDenoms)
 }
 
+func (dva *DelayedLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return dva.BaseLockup.Clawback(ctx, msg, dva.GetLockedCoinsWithDenoms)
+}
+
 func (dva *DelayedLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (
 	*lockuptypes.MsgWithdrawResponse, error,
 ) {
@@ -97,12 +103,13 @@ func (dva DelayedLockingAccount) GetLockCoinInfoWithDenom(ctx context.Context, b
 		return nil, nil, err
 	}
 	originalLockingCoin := sdk.NewCoin(denom, originalLockingAmt)
+	zeroCoin := sdk.NewCoin(denom, math.ZeroInt())
 
 	if blockTime.After(endTime) {
-		return &originalLockingCoin, &sdk.Coin{}, nil
+		return &originalLockingCoin, &zeroCoin, nil
 	}
 
-	return &sdk.Coin{}, &originalLockingCoin, nil
+	return &zeroCoin, &originalLockingCoin, nil
 }
 
 // GetLockedCoinsWithDenoms returns the number of locked coin for a specific denom.
@@ -132,7 +139,7 @@ func (dva DelayedLockingAccount) QueryVestingAccountInfo(ctx context.Context, re
 	}
 	resp.LockedCoins = lockedCoins
 	resp.UnlockedCoins = unlockedCoins
-	return resp, nil
+	return withClawbackLockInfo(resp), nil
 }
 
 // Implement smart account interface
@@ -143,6 +150,7 @@ func (dva DelayedLockingAccount) RegisterInitHandler(builder *accountstd.InitBui
 func (dva DelayedLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, dva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, dva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, dva.Clawback)
 	accountstd.RegisterExecuteHandler(builder, dva.WithdrawUnlockedCoins)
 	dva.BaseLockup.RegisterExecuteHandlers(builder)
 }
@@ -3,6 +3,7 @@ package lockup
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -34,6 +35,9 @@ var (
 	LockingPeriodsPrefix   = collections.NewPrefix(5)
 	OwnerPrefix            = collections.NewPrefix(6)
 	WithdrawedCoinsPrefix  = collections.NewPrefix(7)
+	AdminPrefix            = collections.NewPrefix(8)
+	ClawedBackPrefix       = collections.NewPrefix(9)
+	ClawbackPendingPrefix  = collections.NewPrefix(10)
 )
 
 var (
@@ -53,6 +57,9 @@ func newBaseLockup(d accountstd.Dependencies) *BaseLockup {
 		DelegatedFree:    collections.NewMap(d.SchemaBuilder, DelegatedFreePrefix, "delegated_free", collections.StringKey, sdk.IntValue),
 		DelegatedLocking: collections.NewMap(d.SchemaBuilder, DelegatedLockingPrefix, "delegated_locking", collections.StringKey, sdk.IntValue),
 		WithdrawedCoins:  collections.NewMap(d.SchemaBuilder, WithdrawedCoinsPrefix, "withdrawed_coins", collections.StringKey, sdk.IntValue),
+		Admin:            collections.NewItem(d.SchemaBuilder, AdminPrefix, "admin", collections.BytesValue),
+		ClawedBack:       collections.NewItem(d.SchemaBuilder, ClawedBackPrefix, "clawed_back", collections.BoolValue),
+		ClawbackPending:  collections.NewMap(d.SchemaBuilder, ClawbackPendingPrefix, "clawback_pending", collections.StringKey, sdk.IntValue),
 		addressCodec:     d.AddressCodec,
 		headerService:    d.Environment.HeaderService,
 		EndTime:          collections.NewItem(d.SchemaBuilder, EndTimePrefix, "end_time", collcodec.KeyToValueCodec[time.Time](sdk.TimeKey)),
@@ -68,8 +75,14 @@ type BaseLockup struct {
 	DelegatedFree    collections.Map[string, math.Int]
 	DelegatedLocking collections.Map[string, math.Int]
 	WithdrawedCoins  collections.Map[string, math.Int]
-	addressCodec     address.Codec
-	headerService    header.Service
+	// Admin is the optional address allowed to claw back the locked coins.
+	Admin collections.Item[[]byte]
+	// ClawedBack is set once the account is clawed back, its lockup is then over.
+	ClawedBack collections.Item[bool]
+	// ClawbackPending are the clawed back coins which are still to be sent to the admin.
+	ClawbackPending collections.Map[string, math.Int]
+	addressCodec    address.Codec
+	headerService   header.Service
 	// lockup end time.
 	EndTime collections.Item[time.Time]
 }
@@ -86,6 +99,11 @@ func (bva *BaseLockup) Init(ctx context.Context, msg *lockuptypes.MsgInitLockupA
 		return nil, err
 	}
 
+	err = bva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+
 	funds := accountstd.Funds(ctx)
 
 	sortedAmt := funds.Sort()
@@ -148,10 +166,23 @@ func (bva *BaseLockup) Delegate(
 	if err != nil {
 		return nil, err
 	}
-	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denom)
+	lockedCoins, err := bva.getLockedCoins(ctx, hs.Time, getLockedCoinsFunc, msg.Amount.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// once the account is clawed back, the coins pending clawback cannot be delegated
+	clawedBack, err := bva.ClawedBack.Has(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if clawedBack {
+		err = bva.checkTokensSendable(ctx, delegatorAddress, sdk.Coins{msg.Amount}, lockedCoins)
+		if err != nil {
+			return nil, err
+		}
+		lockedCoins = sdk.Coins{}
+	}
 
 	err = bva.TrackDelegation(
 		ctx,
@@ -253,7 +284,7 @@ func (bva *BaseLockup) SendCoins(
 
 	hs := bva.headerService.HeaderInfo(ctx)
 
-	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Amount.Denoms()...)
+	lockedCoins, err := bva.getLockedCoins(ctx, hs.Time, getLockedCoinsFunc, msg.Amount.Denoms()...)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +325,7 @@ func (bva *BaseLockup) WithdrawUnlockedCoins(
 	}
 
 	hs := bva.headerService.HeaderInfo(ctx)
-	lockedCoins, err := getLockedCoinsFunc(ctx, hs.Time, msg.Denoms...)
+	lockedCoins, err := bva.getLockedCoins(ctx, hs.Time, getLockedCoinsFunc, msg.Denoms...)
 	if err != nil {
 		return nil, err
 	}
@@ -658,12 +689,40 @@ func (bva BaseLockup) QueryLockupAccountBaseInfo(ctx context.Context, _ *lockupt
 	}
 	delegatedFree := sdk.NewCoins(sdk.NewCoin(bondDenom, delegatedFreeAmt))
 
+	adminAddress := ""
+	admin, err := bva.Admin.Get(ctx)
+	if err == nil {
+		adminAddress, err = bva.addressCodec.BytesToString(admin)
+		if err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	clawedBack, err := bva.ClawedBack.Has(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clawbackPending := sdk.Coins{}
+	err = bva.IterateCoinEntries(ctx, bva.ClawbackPending, func(key string, value math.Int) (stop bool, err error) {
+		clawbackPending = append(clawbackPending, sdk.NewCoin(key, value))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &lockuptypes.QueryLockupAccountInfoResponse{
 		Owner:            ownerAddress,
 		OriginalLocking:  originalLocking,
 		DelegatedLocking: delegatedLocking,
 		DelegatedFree:    delegatedFree,
 		EndTime:          &endTime,
+		Admin:            adminAddress,
+		ClawedBack:       clawedBack,
+		ClawbackPending:  clawbackPending,
 	}, nil
 }
 
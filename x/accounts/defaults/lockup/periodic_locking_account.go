@@ -118,6 +118,10 @@ func (pva PeriodicLockingAccount) Init(ctx context.Context, msg *lockuptypes.Msg
 	if err != nil {
 		return nil, err
 	}
+	err = pva.setAdmin(ctx, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
 
 	return &lockuptypes.MsgInitPeriodicLockingAccountResponse{}, nil
 }
@@ -134,6 +138,12 @@ func (pva *PeriodicLockingAccount) SendCoins(ctx context.Context, msg *lockuptyp
 	return pva.BaseLockup.SendCoins(ctx, msg, pva.GetLockedCoinsWithDenoms)
 }
 
+func (pva *PeriodicLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return pva.BaseLockup.Clawback(ctx, msg, pva.GetLockedCoinsWithDenoms)
+}
+
 func (pva *PeriodicLockingAccount) WithdrawUnlockedCoins(ctx context.Context, msg *lockuptypes.MsgWithdraw) (
 	*lockuptypes.MsgWithdrawResponse, error,
 ) {
@@ -309,7 +319,7 @@ func (pva PeriodicLockingAccount) QueryLockupAccountInfo(ctx context.Context, re
 	resp.StartTime = &startTime
 	resp.LockedCoins = lockedCoins
 	resp.UnlockedCoins = unlockedCoins
-	return resp, nil
+	return withClawbackLockInfo(resp), nil
 }
 
 func (pva PeriodicLockingAccount) QueryLockingPeriods(ctx context.Context, msg *lockuptypes.QueryLockingPeriodsRequest) (
@@ -336,6 +346,7 @@ func (pva PeriodicLockingAccount) RegisterInitHandler(builder *accountstd.InitBu
 func (pva PeriodicLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, pva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, pva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, pva.Clawback)
 	accountstd.RegisterExecuteHandler(builder, pva.WithdrawUnlockedCoins)
 	pva.BaseLockup.RegisterExecuteHandlers(builder)
 }
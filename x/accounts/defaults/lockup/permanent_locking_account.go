@@ -72,6 +72,12 @@ func (plva *PermanentLockingAccount) SendCoins(ctx context.Context, msg *lockupt
 	return plva.BaseLockup.SendCoins(ctx, msg, plva.GetlockedCoinsWithDenoms)
 }
 
+func (plva *PermanentLockingAccount) Clawback(ctx context.Context, msg *lockuptypes.MsgClawback) (
+	*lockuptypes.MsgClawbackResponse, error,
+) {
+	return plva.BaseLockup.Clawback(ctx, msg, plva.GetlockedCoinsWithDenoms)
+}
+
 func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context, req *lockuptypes.QueryLockupAccountInfoRequest) (
 	*lockuptypes.QueryLockupAccountInfoResponse, error,
 ) {
@@ -90,7 +96,7 @@ func (plva PermanentLockingAccount) QueryLockupAccountInfo(ctx context.Context,
 
 	resp.LockedCoins = originalLocking
 	resp.UnlockedCoins = sdk.Coins{}
-	return resp, nil
+	return withClawbackLockInfo(resp), nil
 }
 
 // Implement smart account interface
@@ -101,6 +107,7 @@ func (plva PermanentLockingAccount) RegisterInitHandler(builder *accountstd.Init
 func (plva PermanentLockingAccount) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, plva.Delegate)
 	accountstd.RegisterExecuteHandler(builder, plva.SendCoins)
+	accountstd.RegisterExecuteHandler(builder, plva.Clawback)
 	plva.BaseLockup.RegisterExecuteHandlers(builder)
 }
 
@@ -84,6 +84,12 @@ type QueryLockupAccountInfoResponse struct {
 	UnlockedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=unlocked_coins,json=unlockedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unlocked_coins"`
 	// owner defines the value of the owner of the lockup account.
 	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
+	// admin defines the address allowed to claw back the locked coins of the lockup account.
+	Admin string `protobuf:"bytes,9,opt,name=admin,proto3" json:"admin,omitempty"`
+	// clawed_back defines if the lockup account was clawed back, its lockup is then over.
+	ClawedBack bool `protobuf:"varint,10,opt,name=clawed_back,json=clawedBack,proto3" json:"clawed_back,omitempty"`
+	// clawback_pending defines the coins which are still to be clawed back once their unbonding completes.
+	ClawbackPending github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,11,rep,name=clawback_pending,json=clawbackPending,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"clawback_pending"`
 }
 
 func (m *QueryLockupAccountInfoResponse) Reset()         { *m = QueryLockupAccountInfoResponse{} }
@@ -175,6 +181,27 @@ func (m *QueryLockupAccountInfoResponse) GetOwner() string {
 	return ""
 }
 
+func (m *QueryLockupAccountInfoResponse) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *QueryLockupAccountInfoResponse) GetClawedBack() bool {
+	if m != nil {
+		return m.ClawedBack
+	}
+	return false
+}
+
+func (m *QueryLockupAccountInfoResponse) GetClawbackPending() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClawbackPending
+	}
+	return nil
+}
+
 // QueryLockingPeriodsRequest is used to query the periodic lockup account locking periods.
 type QueryLockingPeriodsRequest struct {
 }
@@ -270,39 +297,42 @@ func init() {
 }
 
 var fileDescriptor_f06fad50e16c8e9b = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0x63, 0xda, 0xf4, 0x63, 0x03, 0x6d, 0x89, 0x7a, 0x30, 0x01, 0xec, 0x28, 0x17, 0x22,
-	0x41, 0x77, 0x69, 0x39, 0x72, 0x40, 0x04, 0x09, 0x84, 0xd4, 0x43, 0xb1, 0x38, 0x71, 0xb1, 0xfc,
-	0x31, 0x31, 0xab, 0x38, 0x3b, 0xae, 0x77, 0x5d, 0xda, 0xb7, 0xe8, 0x73, 0xf0, 0x24, 0x3d, 0xf6,
-	0xc8, 0x89, 0xa2, 0xe4, 0x3d, 0x10, 0xb2, 0x77, 0x37, 0xa8, 0x12, 0x55, 0x39, 0xa4, 0xa7, 0xfd,
-	0x9a, 0x99, 0xdf, 0xcc, 0xfe, 0x77, 0x96, 0x3c, 0x4f, 0x50, 0x4e, 0x51, 0xb2, 0x28, 0x49, 0xb0,
-	0x12, 0x4a, 0xb2, 0x14, 0xc6, 0x51, 0x95, 0x2b, 0xc9, 0x72, 0x4c, 0x26, 0x55, 0xc1, 0x8e, 0x2b,
-	0x28, 0xcf, 0x68, 0x51, 0xa2, 0xc2, 0xae, 0xaf, 0x8d, 0xa9, 0x35, 0xa6, 0xd6, 0x98, 0x6a, 0xe3,
-	0xde, 0x8b, 0xdb, 0xa2, 0xe9, 0x41, 0x87, 0xeb, 0x79, 0xc6, 0x3a, 0x8e, 0x24, 0xb0, 0x93, 0xfd,
-	0x18, 0x54, 0xb4, 0xcf, 0x12, 0xe4, 0xc2, 0x9c, 0xef, 0x66, 0x98, 0x61, 0x33, 0x65, 0xf5, 0xcc,
-	0xec, 0xfa, 0x19, 0x62, 0x96, 0x03, 0x6b, 0x56, 0x71, 0x35, 0x66, 0x8a, 0x4f, 0x41, 0xaa, 0x68,
-	0x6a, 0xc2, 0x0e, 0x7c, 0xf2, 0xf4, 0x53, 0x9d, 0xf4, 0x61, 0xc3, 0x7a, 0xab, 0x53, 0xf9, 0x28,
-	0xc6, 0x18, 0xc0, 0x71, 0x05, 0x52, 0x0d, 0x7e, 0xb7, 0x89, 0x77, 0x93, 0x85, 0x2c, 0x50, 0x48,
-	0xe8, 0x9e, 0x90, 0x1d, 0x2c, 0x79, 0xc6, 0x45, 0x94, 0x87, 0x75, 0xce, 0x5c, 0x64, 0xae, 0xd3,
-	0x5f, 0x19, 0x76, 0x0e, 0x1e, 0x51, 0x73, 0x09, 0x75, 0xd6, 0xd4, 0x64, 0x4d, 0xdf, 0x21, 0x17,
-	0xa3, 0x97, 0x17, 0x3f, 0xfd, 0xd6, 0xf7, 0x2b, 0x7f, 0x98, 0x71, 0xf5, 0xb5, 0x8a, 0x69, 0x82,
-	0x53, 0x66, 0x4a, 0xd4, 0xc3, 0x9e, 0x4c, 0x27, 0x4c, 0x9d, 0x15, 0x20, 0x1b, 0x07, 0x19, 0x6c,
-	0x5b, 0xc8, 0xa1, 0x66, 0x74, 0x4b, 0xb2, 0x95, 0x42, 0x0e, 0x59, 0xa4, 0x20, 0x0d, 0xc7, 0x25,
-	0x80, 0x7b, 0x6f, 0xf9, 0xd4, 0x07, 0x0b, 0xc4, 0xfb, 0x12, 0xa0, 0x7b, 0x4a, 0x1e, 0xfe, 0x65,
-	0xda, 0x62, 0x57, 0x96, 0x8f, 0xdd, 0x59, 0x50, 0x6c, 0xb5, 0x6f, 0x08, 0x91, 0x2a, 0x2a, 0x55,
-	0x58, 0x4b, 0xe8, 0xae, 0xf6, 0x9d, 0x61, 0xe7, 0xa0, 0x47, 0xb5, 0xbe, 0xd4, 0xea, 0x4b, 0x3f,
-	0x5b, 0x7d, 0x47, 0xab, 0xe7, 0x57, 0xbe, 0x13, 0x6c, 0x36, 0x3e, 0xf5, 0x6e, 0xf7, 0x35, 0xd9,
-	0x00, 0x91, 0x6a, 0xf7, 0xf6, 0x7f, 0xba, 0xaf, 0x83, 0x48, 0x1b, 0x67, 0x41, 0xee, 0xd7, 0xd5,
-	0x42, 0x1a, 0xd6, 0x6f, 0x4e, 0xba, 0x6b, 0xcb, 0x2f, 0xb9, 0xa3, 0x01, 0xcd, 0xa2, 0xd6, 0xb6,
-	0x12, 0xd7, 0x88, 0xeb, 0x77, 0xa0, 0xad, 0x45, 0x68, 0xe6, 0x2e, 0x69, 0xe3, 0x37, 0x01, 0xa5,
-	0xbb, 0xd1, 0x77, 0x86, 0x9b, 0x81, 0x5e, 0x0c, 0x9e, 0x90, 0xde, 0xe2, 0xfd, 0x73, 0x91, 0x1d,
-	0x41, 0xc9, 0x31, 0x95, 0xb6, 0x3d, 0x90, 0x3c, 0xfe, 0xe7, 0xa9, 0x69, 0x8d, 0x23, 0xb2, 0x6d,
-	0x1e, 0x49, 0x58, 0xe8, 0x23, 0xd3, 0x19, 0xcf, 0xe8, 0x2d, 0xdf, 0x03, 0xd5, 0xa1, 0x82, 0xad,
-	0xfc, 0x5a, 0xe4, 0xd1, 0x87, 0x8b, 0x99, 0xe7, 0x5c, 0xce, 0x3c, 0xe7, 0xd7, 0xcc, 0x73, 0xce,
-	0xe7, 0x5e, 0xeb, 0x72, 0xee, 0xb5, 0x7e, 0xcc, 0xbd, 0xd6, 0x97, 0x3d, 0x1d, 0x51, 0xa6, 0x13,
-	0xca, 0x91, 0x9d, 0xde, 0xfc, 0xaf, 0x34, 0x57, 0x10, 0xaf, 0x35, 0xa2, 0xbf, 0xfa, 0x13, 0x00,
-	0x00, 0xff, 0xff, 0x8d, 0xa3, 0x8b, 0xf7, 0xd5, 0x04, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc0, 0xe3, 0x7f, 0xbf, 0x92, 0xcd, 0x9f, 0xb6, 0x58, 0x3d, 0x98, 0x00, 0x76, 0x94, 0x0b,
+	0x91, 0xa0, 0xbb, 0xb4, 0x1c, 0x39, 0x20, 0x82, 0x04, 0x42, 0xea, 0x21, 0x58, 0x9c, 0xb8, 0x58,
+	0x6b, 0xef, 0xc4, 0xac, 0xe2, 0xec, 0xba, 0xde, 0x75, 0x3f, 0xde, 0xa2, 0xcf, 0x81, 0xc4, 0x7b,
+	0xf4, 0xd8, 0x23, 0x27, 0x8a, 0x92, 0x17, 0x41, 0xeb, 0xb5, 0x83, 0x2a, 0x51, 0x95, 0x43, 0x7a,
+	0xb2, 0xe7, 0xf3, 0x37, 0xe3, 0x99, 0x31, 0x7a, 0x9e, 0x48, 0x35, 0x93, 0x8a, 0xd0, 0x24, 0x91,
+	0xa5, 0xd0, 0x8a, 0x30, 0x98, 0xd0, 0x32, 0xd3, 0x8a, 0x64, 0x32, 0x99, 0x96, 0x39, 0x39, 0x2e,
+	0xa1, 0x38, 0xc7, 0x79, 0x21, 0xb5, 0x74, 0x03, 0xeb, 0x8c, 0x1b, 0x67, 0xdc, 0x38, 0x63, 0xeb,
+	0xdc, 0x7b, 0x71, 0x57, 0x36, 0xfb, 0xb0, 0xe9, 0x7a, 0x7e, 0xed, 0x1d, 0x53, 0x05, 0xe4, 0xe4,
+	0x20, 0x06, 0x4d, 0x0f, 0x48, 0x22, 0xb9, 0xa8, 0xed, 0x7b, 0xa9, 0x4c, 0x65, 0xf5, 0x4a, 0xcc,
+	0x5b, 0xad, 0x0d, 0x52, 0x29, 0xd3, 0x0c, 0x48, 0x25, 0xc5, 0xe5, 0x84, 0x68, 0x3e, 0x03, 0xa5,
+	0xe9, 0xac, 0x4e, 0x3b, 0x08, 0xd0, 0xd3, 0x4f, 0xa6, 0xe8, 0xa3, 0x8a, 0xf5, 0xd6, 0x96, 0xf2,
+	0x51, 0x4c, 0x64, 0x08, 0xc7, 0x25, 0x28, 0x3d, 0xf8, 0xbe, 0x85, 0xfc, 0xdb, 0x3c, 0x54, 0x2e,
+	0x85, 0x02, 0xf7, 0x04, 0xed, 0xca, 0x82, 0xa7, 0x5c, 0xd0, 0x2c, 0x32, 0x35, 0x73, 0x91, 0x7a,
+	0x4e, 0x7f, 0x6d, 0xd8, 0x3d, 0x7c, 0x84, 0xeb, 0x8f, 0x60, 0xaa, 0xc6, 0x75, 0xd5, 0xf8, 0x9d,
+	0xe4, 0x62, 0xf4, 0xf2, 0xf2, 0x67, 0xd0, 0xfa, 0x76, 0x1d, 0x0c, 0x53, 0xae, 0xbf, 0x96, 0x31,
+	0x4e, 0xe4, 0x8c, 0xd4, 0x2d, 0xda, 0xc7, 0xbe, 0x62, 0x53, 0xa2, 0xcf, 0x73, 0x50, 0x55, 0x80,
+	0x0a, 0x77, 0x1a, 0xc8, 0x91, 0x65, 0xb8, 0x05, 0xda, 0x66, 0x90, 0x41, 0x4a, 0x35, 0xb0, 0x68,
+	0x52, 0x00, 0x78, 0xff, 0xad, 0x9e, 0xfa, 0x60, 0x89, 0x78, 0x5f, 0x00, 0xb8, 0x67, 0xe8, 0xe1,
+	0x1f, 0x66, 0xd3, 0xec, 0xda, 0xea, 0xb1, 0xbb, 0x4b, 0x4a, 0xd3, 0xed, 0x1b, 0x84, 0x94, 0xa6,
+	0x85, 0x8e, 0xcc, 0x08, 0xbd, 0xf5, 0xbe, 0x33, 0xec, 0x1e, 0xf6, 0xb0, 0x9d, 0x2f, 0x6e, 0xe6,
+	0x8b, 0x3f, 0x37, 0xf3, 0x1d, 0xad, 0x5f, 0x5c, 0x07, 0x4e, 0xd8, 0xa9, 0x62, 0x8c, 0xd6, 0x7d,
+	0x8d, 0xda, 0x20, 0x98, 0x0d, 0xdf, 0xf8, 0xc7, 0xf0, 0x2d, 0x10, 0xac, 0x0a, 0x16, 0xe8, 0x7f,
+	0xd3, 0x2d, 0xb0, 0xc8, 0xec, 0x9c, 0xf2, 0x36, 0x57, 0xdf, 0x72, 0xd7, 0x02, 0x2a, 0xc1, 0xcc,
+	0xb6, 0x14, 0x37, 0x88, 0x5b, 0xf7, 0x30, 0xdb, 0x06, 0x61, 0x99, 0x7b, 0x68, 0x43, 0x9e, 0x0a,
+	0x28, 0xbc, 0x76, 0xdf, 0x19, 0x76, 0x42, 0x2b, 0x18, 0x2d, 0x65, 0x33, 0x2e, 0xbc, 0x8e, 0xd5,
+	0x56, 0x82, 0x1b, 0xa0, 0x6e, 0x92, 0xd1, 0x53, 0x60, 0x51, 0x4c, 0x93, 0xa9, 0x87, 0xfa, 0xce,
+	0xb0, 0x1d, 0x22, 0xab, 0x1a, 0xd1, 0x64, 0x6a, 0x8e, 0xc2, 0x48, 0xc6, 0x1a, 0xe5, 0x20, 0x98,
+	0xd9, 0x93, 0xee, 0x3d, 0x1c, 0x45, 0x03, 0x19, 0x5b, 0xc6, 0xe0, 0x09, 0xea, 0x2d, 0xcf, 0x95,
+	0x8b, 0x74, 0x0c, 0x05, 0x97, 0x4c, 0x35, 0xd7, 0x2c, 0xd1, 0xe3, 0xbf, 0x5a, 0xeb, 0x4b, 0x1e,
+	0xa3, 0x9d, 0x7a, 0xa7, 0xa3, 0xdc, 0x9a, 0xea, 0x43, 0x7e, 0x86, 0xef, 0xf8, 0x9b, 0x61, 0x9b,
+	0x2a, 0xdc, 0xce, 0x6e, 0x64, 0x1e, 0x7d, 0xb8, 0x9c, 0xfb, 0xce, 0xd5, 0xdc, 0x77, 0x7e, 0xcd,
+	0x7d, 0xe7, 0x62, 0xe1, 0xb7, 0xae, 0x16, 0x7e, 0xeb, 0xc7, 0xc2, 0x6f, 0x7d, 0xd9, 0xb7, 0x19,
+	0x15, 0x9b, 0x62, 0x2e, 0xc9, 0xd9, 0xed, 0xbf, 0xc1, 0xaa, 0xdd, 0x78, 0xb3, 0xda, 0xd1, 0x57,
+	0xbf, 0x07, 0x00, 0x9c, 0x80, 0x1d, 0x11, 0x84, 0x05, 0x00, 0x00,
 }
 
 func (m *QueryLockupAccountInfoRequest) Marshal() (dAtA []byte, err error) {
@@ -348,6 +378,37 @@ func (m *QueryLockupAccountInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClawbackPending) > 0 {
+		for iNdEx := len(m.ClawbackPending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClawbackPending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.ClawedBack {
+		i--
+		if m.ClawedBack {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -576,6 +637,19 @@ func (m *QueryLockupAccountInfoResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ClawedBack {
+		n += 2
+	}
+	if len(m.ClawbackPending) > 0 {
+		for _, e := range m.ClawbackPending {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -962,6 +1036,92 @@ func (m *QueryLockupAccountInfoResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawedBack", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClawedBack = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClawbackPending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClawbackPending = append(m.ClawbackPending, types.Coin{})
+			if err := m.ClawbackPending[len(m.ClawbackPending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
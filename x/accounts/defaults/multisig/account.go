@@ -24,6 +24,7 @@ var (
 	ConfigPrefix    = collections.NewPrefix(2)
 	ProposalsPrefix = collections.NewPrefix(3)
 	VotesPrefix     = collections.NewPrefix(4)
+	ExpiryPrefix    = collections.NewPrefix(5)
 )
 
 // Compile-time type assertions
@@ -42,6 +43,7 @@ type Account struct {
 
 	Proposals collections.Map[uint64, v1.Proposal]
 	Votes     collections.Map[collections.Pair[uint64, []byte], int32] // key: proposalID + voter address
+	Expiries  collections.KeySet[collections.Pair[int64, uint64]]      // key: expiry + proposalID
 }
 
 // NewAccount returns a new multisig account creator function.
@@ -52,6 +54,7 @@ func NewAccount(deps accountstd.Dependencies) (*Account, error) {
 		Config:        collections.NewItem(deps.SchemaBuilder, ConfigPrefix, "config", codec.CollValue[v1.Config](deps.LegacyStateCodec)),
 		Proposals:     collections.NewMap(deps.SchemaBuilder, ProposalsPrefix, "proposals", collections.Uint64Key, codec.CollValue[v1.Proposal](deps.LegacyStateCodec)),
 		Votes:         collections.NewMap(deps.SchemaBuilder, VotesPrefix, "votes", collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey), collections.Int32Value),
+		Expiries:      collections.NewKeySet(deps.SchemaBuilder, ExpiryPrefix, "expiries", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
 		addrCodec:     deps.AddressCodec,
 		headerService: deps.Environment.HeaderService,
 		eventService:  deps.Environment.EventService,
@@ -97,7 +100,7 @@ func (a *Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitRespons
 		}
 	}
 
-	if err := validateConfig(*msg.Config, totalWeight); err != nil {
+	if err := validateConfig(*msg.Config, totalWeight, a.addrCodec); err != nil {
 		return nil, err
 	}
 
@@ -172,6 +175,10 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		return nil, err
 	}
 
+	if err := a.pruneExpiredProposals(ctx); err != nil {
+		return nil, err
+	}
+
 	seq, err := a.Sequence.Next(ctx)
 	if err != nil {
 		return nil, err
@@ -194,6 +201,7 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		Summary:  msg.Proposal.Summary,
 		Messages: msg.Proposal.Messages,
 		Status:   v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD,
+		Id:       seq,
 	}
 
 	// set the voting period, if not specified, use the default
@@ -203,6 +211,13 @@ func (a Account) CreateProposal(ctx context.Context, msg *v1.MsgCreateProposal)
 		proposal.VotingPeriodEnd = a.headerService.HeaderInfo(ctx).Time.Add(time.Second * time.Duration(config.VotingPeriod)).Unix()
 	}
 
+	// the proposal must be executed within the proposal expiry after the voting period ends
+	if config.ProposalExpiry > 0 {
+		if err = a.setProposalExpiry(ctx, &proposal, proposal.VotingPeriodEnd+config.ProposalExpiry); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = a.Proposals.Set(ctx, seq, proposal); err != nil {
 		return nil, err
 	}
@@ -236,19 +251,34 @@ func (a Account) deleteProposalAndVotes(ctx context.Context, proposalID uint64)
 
 // ExecuteProposal tallies the votes for a proposal and executes it if it passes. If early execution is enabled, it will
 // ignore the voting period and tally the votes without deleting them if the proposal has not passed.
+// If a timelock is configured, a passing proposal is not executed but timelocked, it can be executed by calling
+// ExecuteProposal again once the timelock has elapsed.
 func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal) (*v1.MsgExecuteProposalResponse, error) {
+	if err := a.pruneExpiredProposals(ctx); err != nil {
+		return nil, err
+	}
+
 	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
 	if err != nil {
 		return nil, err
 	}
 
+	switch prop.Status {
+	case v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD:
+	case v1.ProposalStatus_PROPOSAL_STATUS_TIMELOCKED:
+		return a.executeTimelockedProposal(ctx, msg.ProposalId, prop)
+	default:
+		return nil, fmt.Errorf("proposal cannot be executed, status: %s", prop.Status)
+	}
+
 	config, err := a.Config.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// check if voting period is still active and early execution is disabled
-	votingPeriodEnded := a.headerService.HeaderInfo(ctx).Time.Unix() > prop.VotingPeriodEnd
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	votingPeriodEnded := now > prop.VotingPeriodEnd
 	if !votingPeriodEnded && !config.EarlyExecution {
 		return nil, errors.New("voting period has not ended yet, and early execution is not enabled")
 	}
@@ -296,6 +326,10 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	} else if yesVotes < uint64(config.Threshold) {
 		rejectErr = errors.New("threshold not reached")
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_REJECTED
+	} else if config.Timelock > 0 {
+		// we have quorum and threshold, but the proposal must wait for the timelock
+		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_TIMELOCKED
+		prop.ExecutableAfter = now + config.Timelock
 	} else {
 		// we have quorum and threshold, execute the proposal
 		prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
@@ -303,7 +337,7 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 	}
 
 	// if early execution is enabled, we return early if the proposal has NOT passed
-	if config.EarlyExecution && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_PASSED {
+	if config.EarlyExecution && prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_REJECTED {
 		return nil, errors.New("early execution attempted and proposal has not passed")
 	}
 
@@ -311,6 +345,16 @@ func (a Account) ExecuteProposal(ctx context.Context, msg *v1.MsgExecuteProposal
 		return nil, err
 	}
 
+	// a timelocked proposal must be executed within the proposal expiry after the timelock,
+	// other proposals are settled and do not expire anymore.
+	expiry := int64(0)
+	if prop.Status == v1.ProposalStatus_PROPOSAL_STATUS_TIMELOCKED && config.ProposalExpiry > 0 {
+		expiry = prop.ExecutableAfter + config.ProposalExpiry
+	}
+	if err = a.setProposalExpiry(ctx, &prop, expiry); err != nil {
+		return nil, err
+	}
+
 	if err = a.eventService.EventManager(ctx).EmitKV("proposal_tally",
 		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
 		event.NewAttribute("yes_votes", fmt.Sprint(yesVotes)),
@@ -349,6 +393,19 @@ func (a Account) QueryProposal(ctx context.Context, q *v1.QueryProposal) (*v1.Qu
 	return &v1.QueryProposalResponse{Proposal: &proposal}, nil
 }
 
+// QueryProposals returns all the proposals of the account which haven't been pruned yet.
+func (a Account) QueryProposals(ctx context.Context, _ *v1.QueryProposals) (*v1.QueryProposalsResponse, error) {
+	proposals := []*v1.Proposal{}
+	err := a.Proposals.Walk(ctx, nil, func(_ uint64, proposal v1.Proposal) (stop bool, err error) {
+		proposals = append(proposals, &proposal)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryProposalsResponse{Proposals: proposals}, nil
+}
+
 // QueryConfig returns the current multisig configuration.
 func (a Account) QueryConfig(ctx context.Context, _ *v1.QueryConfig) (*v1.QueryConfigResponse, error) {
 	cfg, err := a.Config.Get(ctx)
@@ -378,6 +435,7 @@ func (a *Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
 	accountstd.RegisterExecuteHandler(builder, a.CreateProposal)
 	accountstd.RegisterExecuteHandler(builder, a.ExecuteProposal)
 	accountstd.RegisterExecuteHandler(builder, a.UpdateConfig)
+	accountstd.RegisterExecuteHandler(builder, a.VetoProposal)
 }
 
 // RegisterInitHandler implements implementation.Account.
@@ -389,6 +447,7 @@ func (a *Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
 func (a *Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
 	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
 	accountstd.RegisterQueryHandler(builder, a.QueryProposal)
+	accountstd.RegisterQueryHandler(builder, a.QueryProposals)
 	accountstd.RegisterQueryHandler(builder, a.QueryConfig)
 }
 
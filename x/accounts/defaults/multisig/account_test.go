@@ -9,6 +9,7 @@ import (
 	types "github.com/cosmos/gogoproto/types/any"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
@@ -712,3 +713,148 @@ func TestWeightOverflow(t *testing.T) {
 	_, err = acc.Init(ctx, startAcc)
 	require.ErrorContains(t, err, "overflow")
 }
+
+func TestProposalTimelock(t *testing.T) {
+	executed := 0
+	ctx, ss := accountstd.NewMockContext(
+		0, []byte("multisig_acc"), []byte("addr1"), TestFunds, func(ctx context.Context, sender []byte, msg, msgResp ProtoMsg) error {
+			return nil
+		}, func(ctx context.Context, sender []byte, msg ProtoMsg) (ProtoMsg, error) {
+			executed++
+			return &accountsv1.MsgExecuteResponse{}, nil
+		}, func(ctx context.Context, req, resp ProtoMsg) error {
+			return nil
+		},
+	)
+
+	currentTime := time.Unix(1000, 0)
+	acc := setup(t, ctx, ss, func() time.Time {
+		return currentTime
+	})
+
+	_, err := acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:    2000,
+			Quorum:       2000,
+			VotingPeriod: 60,
+			Timelock:     -1,
+		},
+		Members: []*v1.Member{{Address: "addr1", Weight: 1000}, {Address: "addr2", Weight: 1000}},
+	})
+	require.ErrorContains(t, err, "timelock and proposal expiry cannot be negative")
+
+	_, err = acc.Init(ctx, &v1.MsgInit{
+		Config: &v1.Config{
+			Threshold:      2000,
+			Quorum:         2000,
+			VotingPeriod:   60,
+			Timelock:       100,
+			Vetoer:         "vetoer",
+			ProposalExpiry: 50,
+		},
+		Members: []*v1.Member{{Address: "addr1", Weight: 1000}, {Address: "addr2", Weight: 1000}},
+	})
+	require.NoError(t, err)
+
+	execMsgAny, err := accountstd.PackAny(&accountsv1.MsgExecute{Sender: "multisig_acc", Target: "other"})
+	require.NoError(t, err)
+
+	// createPassingProposal creates a proposal and votes yes with all the members
+	createPassingProposal := func() uint64 {
+		createRes, err := acc.CreateProposal(accountstd.SetSender(ctx, []byte("addr1")), &v1.MsgCreateProposal{
+			Proposal: &v1.Proposal{Title: "test", Summary: "test", Messages: []*types.Any{execMsgAny}},
+		})
+		require.NoError(t, err)
+		for _, voter := range []string{"addr1", "addr2"} {
+			_, err = acc.Vote(accountstd.SetSender(ctx, []byte(voter)), &v1.MsgVote{
+				ProposalId: createRes.ProposalId,
+				Vote:       v1.VoteOption_VOTE_OPTION_YES,
+			})
+			require.NoError(t, err)
+		}
+		return createRes.ProposalId
+	}
+	getProposal := func(id uint64) *v1.Proposal {
+		res, err := acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: id})
+		require.NoError(t, err)
+		return res.Proposal
+	}
+
+	t.Log("a passing proposal is timelocked")
+	propId := createPassingProposal()
+	require.Equal(t, currentTime.Unix()+60+50, getProposal(propId).Expiry)
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, 0, executed)
+	prop := getProposal(propId)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_TIMELOCKED, prop.Status)
+	require.Equal(t, currentTime.Unix()+100, prop.ExecutableAfter)
+	require.Equal(t, currentTime.Unix()+100+50, prop.Expiry)
+
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "timelock has not elapsed yet")
+	_, err = acc.Vote(ctx, &v1.MsgVote{ProposalId: propId, Vote: v1.VoteOption_VOTE_OPTION_NO})
+	require.ErrorContains(t, err, "voting period has ended")
+
+	t.Log("a timelocked proposal is executed once the timelock elapsed")
+	currentTime = currentTime.Add(100 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, 1, executed)
+	prop = getProposal(propId)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_PASSED, prop.Status)
+	require.Zero(t, prop.Expiry)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal cannot be executed")
+
+	t.Log("the vetoer can cancel a proposal during its voting period")
+	propId = createPassingProposal()
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("addr1")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "only the vetoer can veto proposals")
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("vetoer")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.NoError(t, err)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VETOED, getProposal(propId).Status)
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("vetoer")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal cannot be vetoed")
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal cannot be executed")
+
+	t.Log("the vetoer can cancel a proposal during its timelock")
+	propId = createPassingProposal()
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.NoError(t, err)
+	_, err = acc.VetoProposal(accountstd.SetSender(ctx, []byte("vetoer")), &v1.MsgVetoProposal{ProposalId: propId})
+	require.NoError(t, err)
+	prop = getProposal(propId)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VETOED, prop.Status)
+	require.Zero(t, prop.Expiry)
+	currentTime = currentTime.Add(100 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: propId})
+	require.ErrorContains(t, err, "proposal cannot be executed")
+	require.Equal(t, 1, executed)
+
+	t.Log("proposals which are not executed before their expiry are pruned")
+	expiredId := createPassingProposal()
+	currentTime = currentTime.Add(61 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: expiredId})
+	require.NoError(t, err)
+	expiredTimelockId := createPassingProposal()
+	currentTime = currentTime.Add(151 * time.Second)
+	_, err = acc.ExecuteProposal(ctx, &v1.MsgExecuteProposal{ProposalId: expiredId})
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	propId = createPassingProposal()
+	for _, id := range []uint64{expiredId, expiredTimelockId} {
+		_, err = acc.QueryProposal(ctx, &v1.QueryProposal{ProposalId: id})
+		require.ErrorIs(t, err, collections.ErrNotFound)
+	}
+
+	proposals, err := acc.QueryProposals(ctx, &v1.QueryProposals{})
+	require.NoError(t, err)
+	require.Len(t, proposals.Proposals, 4)
+	require.Equal(t, propId, proposals.Proposals[3].Id)
+	require.Equal(t, v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD, proposals.Proposals[3].Status)
+}
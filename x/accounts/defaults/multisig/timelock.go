@@ -0,0 +1,143 @@
+package multisig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
+)
+
+// VetoProposal cancels a proposal during its voting period or its timelock. The sender must be the vetoer of the multisig.
+func (a Account) VetoProposal(ctx context.Context, msg *v1.MsgVetoProposal) (*v1.MsgVetoProposalResponse, error) {
+	config, err := a.Config.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Vetoer == "" {
+		return nil, errors.New("the multisig has no vetoer")
+	}
+
+	vetoer, err := a.addrCodec.StringToBytes(config.Vetoer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(vetoer, accountstd.Sender(ctx)) {
+		return nil, errors.New("only the vetoer can veto proposals")
+	}
+
+	if err := a.pruneExpiredProposals(ctx); err != nil {
+		return nil, err
+	}
+
+	prop, err := a.Proposals.Get(ctx, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	if prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_VOTING_PERIOD && prop.Status != v1.ProposalStatus_PROPOSAL_STATUS_TIMELOCKED {
+		return nil, fmt.Errorf("proposal cannot be vetoed, status: %s", prop.Status)
+	}
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_VETOED
+	if err = a.deleteProposalAndVotes(ctx, msg.ProposalId); err != nil {
+		return nil, err
+	}
+
+	if err = a.setProposalExpiry(ctx, &prop, 0); err != nil {
+		return nil, err
+	}
+
+	if err = a.eventService.EventManager(ctx).EmitKV("proposal_vetoed",
+		event.NewAttribute("proposal_id", fmt.Sprint(msg.ProposalId)),
+		event.NewAttribute("vetoer", config.Vetoer),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v1.MsgVetoProposalResponse{}, a.Proposals.Set(ctx, msg.ProposalId, prop)
+}
+
+// executeTimelockedProposal executes a proposal which passed, once its timelock has elapsed.
+func (a Account) executeTimelockedProposal(ctx context.Context, proposalID uint64, prop v1.Proposal) (*v1.MsgExecuteProposalResponse, error) {
+	if a.headerService.HeaderInfo(ctx).Time.Unix() < prop.ExecutableAfter {
+		return nil, fmt.Errorf("timelock has not elapsed yet, proposal can be executed after %d", prop.ExecutableAfter)
+	}
+
+	var (
+		execErr error
+		resp    = &v1.MsgExecuteProposalResponse{}
+	)
+
+	prop.Status = v1.ProposalStatus_PROPOSAL_STATUS_PASSED
+	resp.Responses, execErr = accountstd.ExecModuleAnys(ctx, prop.Messages) // do not return this error, just emit the event
+
+	if err := a.setProposalExpiry(ctx, &prop, 0); err != nil {
+		return nil, err
+	}
+
+	if err := a.eventService.EventManager(ctx).EmitKV("proposal_execute",
+		event.NewAttribute("proposal_id", fmt.Sprint(proposalID)),
+		event.NewAttribute("status", prop.Status.String()),
+		event.NewAttribute("exec_err", fmt.Sprint(execErr)),
+	); err != nil {
+		return nil, err
+	}
+
+	return resp, a.Proposals.Set(ctx, proposalID, prop)
+}
+
+// setProposalExpiry replaces the expiry of a proposal, an expiry of zero means the proposal does not expire.
+// The proposal itself is not saved.
+func (a Account) setProposalExpiry(ctx context.Context, prop *v1.Proposal, expiry int64) error {
+	if prop.Expiry != 0 {
+		if err := a.Expiries.Remove(ctx, collections.Join(prop.Expiry, prop.Id)); err != nil {
+			return err
+		}
+	}
+
+	prop.Expiry = expiry
+	if expiry == 0 {
+		return nil
+	}
+
+	return a.Expiries.Set(ctx, collections.Join(expiry, prop.Id))
+}
+
+// pruneExpiredProposals deletes the proposals, and their votes, which were not executed before their expiry.
+func (a Account) pruneExpiredProposals(ctx context.Context) error {
+	now := a.headerService.HeaderInfo(ctx).Time.Unix()
+	rng := new(collections.Range[collections.Pair[int64, uint64]]).EndExclusive(collections.Join(now, uint64(0)))
+	expired, err := a.Expiries.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := expired.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := a.deleteProposalAndVotes(ctx, key.K2()); err != nil {
+			return err
+		}
+
+		if err := a.Expiries.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		if err := a.eventService.EventManager(ctx).EmitKV("proposal_expired",
+			event.NewAttribute("proposal_id", fmt.Sprint(key.K2())),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,7 +3,9 @@ package multisig
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/x/accounts/accountstd"
 	v1 "cosmossdk.io/x/accounts/defaults/multisig/v1"
 )
@@ -61,19 +63,29 @@ func (a Account) UpdateConfig(ctx context.Context, msg *v1.MsgUpdateConfig) (*v1
 		return nil, err
 	}
 
-	if err := validateConfig(config, totalWeight); err != nil {
+	if err := validateConfig(config, totalWeight, a.addrCodec); err != nil {
 		return nil, err
 	}
 
 	return &v1.MsgUpdateConfigResponse{}, nil
 }
 
-func validateConfig(cfg v1.Config, totalWeight uint64) error {
+func validateConfig(cfg v1.Config, totalWeight uint64, addrCodec address.Codec) error {
 	// check for zero values
 	if cfg.Threshold <= 0 || cfg.Quorum <= 0 || cfg.VotingPeriod <= 0 {
 		return errors.New("threshold, quorum and voting period must be greater than zero")
 	}
 
+	if cfg.Timelock < 0 || cfg.ProposalExpiry < 0 {
+		return errors.New("timelock and proposal expiry cannot be negative")
+	}
+
+	if cfg.Vetoer != "" {
+		if _, err := addrCodec.StringToBytes(cfg.Vetoer); err != nil {
+			return fmt.Errorf("invalid vetoer address: %w", err)
+		}
+	}
+
 	// threshold must be less than or equal to the total weight
 	if totalWeight < uint64(cfg.Threshold) {
 		return errors.New("threshold must be less than or equal to the total weight")
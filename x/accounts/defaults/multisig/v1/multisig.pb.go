@@ -37,6 +37,11 @@ const (
 	ProposalStatus_PROPOSAL_STATUS_PASSED ProposalStatus = 2
 	// PROPOSAL_STATUS_REJECTED defines the proposal status when the proposal was rejected.
 	ProposalStatus_PROPOSAL_STATUS_REJECTED ProposalStatus = 3
+	// PROPOSAL_STATUS_TIMELOCKED defines the proposal status when the proposal passed and waits
+	// for its timelock to elapse before being executed.
+	ProposalStatus_PROPOSAL_STATUS_TIMELOCKED ProposalStatus = 4
+	// PROPOSAL_STATUS_VETOED defines the proposal status when the proposal was vetoed.
+	ProposalStatus_PROPOSAL_STATUS_VETOED ProposalStatus = 5
 )
 
 var ProposalStatus_name = map[int32]string{
@@ -44,6 +49,8 @@ var ProposalStatus_name = map[int32]string{
 	1: "PROPOSAL_STATUS_VOTING_PERIOD",
 	2: "PROPOSAL_STATUS_PASSED",
 	3: "PROPOSAL_STATUS_REJECTED",
+	4: "PROPOSAL_STATUS_TIMELOCKED",
+	5: "PROPOSAL_STATUS_VETOED",
 }
 
 var ProposalStatus_value = map[string]int32{
@@ -51,6 +58,8 @@ var ProposalStatus_value = map[string]int32{
 	"PROPOSAL_STATUS_VOTING_PERIOD": 1,
 	"PROPOSAL_STATUS_PASSED":        2,
 	"PROPOSAL_STATUS_REJECTED":      3,
+	"PROPOSAL_STATUS_TIMELOCKED":    4,
+	"PROPOSAL_STATUS_VETOED":        5,
 }
 
 func (x ProposalStatus) String() string {
@@ -451,6 +460,88 @@ func (m *MsgExecuteProposalResponse) GetResponses() []*any.Any {
 	return nil
 }
 
+// MsgVetoProposal is used by the vetoer of the account to cancel a proposal
+// during its voting period or its timelock.
+type MsgVetoProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgVetoProposal) Reset()         { *m = MsgVetoProposal{} }
+func (m *MsgVetoProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposal) ProtoMessage()    {}
+func (*MsgVetoProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{8}
+}
+func (m *MsgVetoProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposal.Merge(m, src)
+}
+func (m *MsgVetoProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposal proto.InternalMessageInfo
+
+func (m *MsgVetoProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+type MsgVetoProposalResponse struct {
+}
+
+func (m *MsgVetoProposalResponse) Reset()         { *m = MsgVetoProposalResponse{} }
+func (m *MsgVetoProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVetoProposalResponse) ProtoMessage()    {}
+func (*MsgVetoProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{9}
+}
+func (m *MsgVetoProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVetoProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVetoProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVetoProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVetoProposalResponse.Merge(m, src)
+}
+func (m *MsgVetoProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVetoProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVetoProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVetoProposalResponse proto.InternalMessageInfo
+
 // MsgUpdateConfig is used to change the config or members.
 type MsgUpdateConfig struct {
 	// only the members that are changing are required, if their weight is 0, they are removed.
@@ -463,7 +554,7 @@ func (m *MsgUpdateConfig) Reset()         { *m = MsgUpdateConfig{} }
 func (m *MsgUpdateConfig) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfig) ProtoMessage()    {}
 func (*MsgUpdateConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{8}
+	return fileDescriptor_e6da8796717704d7, []int{10}
 }
 func (m *MsgUpdateConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +604,7 @@ func (m *MsgUpdateConfigResponse) Reset()         { *m = MsgUpdateConfigResponse
 func (m *MsgUpdateConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateConfigResponse) ProtoMessage()    {}
 func (*MsgUpdateConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{9}
+	return fileDescriptor_e6da8796717704d7, []int{11}
 }
 func (m *MsgUpdateConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +642,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{10}
+	return fileDescriptor_e6da8796717704d7, []int{12}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,13 +694,22 @@ type Config struct {
 	Revote bool `protobuf:"varint,4,opt,name=revote,proto3" json:"revote,omitempty"`
 	// early_execution defines if the multisig can be executed before the voting period ends.
 	EarlyExecution bool `protobuf:"varint,5,opt,name=early_execution,json=earlyExecution,proto3" json:"early_execution,omitempty"`
+	// timelock is the duration in seconds a passed proposal must wait before it can be executed.
+	Timelock int64 `protobuf:"varint,6,opt,name=timelock,proto3" json:"timelock,omitempty"`
+	// vetoer is the address allowed to veto proposals until they are executed. If empty,
+	// proposals cannot be vetoed.
+	Vetoer string `protobuf:"bytes,7,opt,name=vetoer,proto3" json:"vetoer,omitempty"`
+	// proposal_expiry is the duration in seconds after the voting period end, or after the
+	// timelock if one applies, after which a proposal which was not executed expires and is pruned.
+	// If zero, proposals never expire.
+	ProposalExpiry int64 `protobuf:"varint,8,opt,name=proposal_expiry,json=proposalExpiry,proto3" json:"proposal_expiry,omitempty"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
 func (m *Config) String() string { return proto.CompactTextString(m) }
 func (*Config) ProtoMessage()    {}
 func (*Config) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{11}
+	return fileDescriptor_e6da8796717704d7, []int{13}
 }
 func (m *Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,6 +773,27 @@ func (m *Config) GetEarlyExecution() bool {
 	return false
 }
 
+func (m *Config) GetTimelock() int64 {
+	if m != nil {
+		return m.Timelock
+	}
+	return 0
+}
+
+func (m *Config) GetVetoer() string {
+	if m != nil {
+		return m.Vetoer
+	}
+	return ""
+}
+
+func (m *Config) GetProposalExpiry() int64 {
+	if m != nil {
+		return m.ProposalExpiry
+	}
+	return 0
+}
+
 type Proposal struct {
 	Title    string     `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Summary  string     `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
@@ -680,13 +801,21 @@ type Proposal struct {
 	// voting_period_end will be set by the account when the proposal is created.
 	VotingPeriodEnd int64          `protobuf:"varint,4,opt,name=voting_period_end,json=votingPeriodEnd,proto3" json:"voting_period_end,omitempty"`
 	Status          ProposalStatus `protobuf:"varint,5,opt,name=status,proto3,enum=cosmos.accounts.defaults.multisig.v1.ProposalStatus" json:"status,omitempty"`
+	// executable_after will be set by the account when the proposal passes and a timelock
+	// applies, it is the unix time from which the proposal can be executed.
+	ExecutableAfter int64 `protobuf:"varint,6,opt,name=executable_after,json=executableAfter,proto3" json:"executable_after,omitempty"`
+	// expiry will be set by the account when a proposal expiry is configured, it is the unix
+	// time after which the proposal cannot be executed anymore.
+	Expiry int64 `protobuf:"varint,7,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// id will be set by the account when the proposal is created.
+	Id uint64 `protobuf:"varint,8,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{12}
+	return fileDescriptor_e6da8796717704d7, []int{14}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -750,6 +879,27 @@ func (m *Proposal) GetStatus() ProposalStatus {
 	return ProposalStatus_PROPOSAL_STATUS_UNSPECIFIED
 }
 
+func (m *Proposal) GetExecutableAfter() int64 {
+	if m != nil {
+		return m.ExecutableAfter
+	}
+	return 0
+}
+
+func (m *Proposal) GetExpiry() int64 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *Proposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 // QuerySequence is the request for the account sequence.
 type QuerySequence struct {
 }
@@ -758,7 +908,7 @@ func (m *QuerySequence) Reset()         { *m = QuerySequence{} }
 func (m *QuerySequence) String() string { return proto.CompactTextString(m) }
 func (*QuerySequence) ProtoMessage()    {}
 func (*QuerySequence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{13}
+	return fileDescriptor_e6da8796717704d7, []int{15}
 }
 func (m *QuerySequence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +947,7 @@ func (m *QuerySequenceResponse) Reset()         { *m = QuerySequenceResponse{} }
 func (m *QuerySequenceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySequenceResponse) ProtoMessage()    {}
 func (*QuerySequenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{14}
+	return fileDescriptor_e6da8796717704d7, []int{16}
 }
 func (m *QuerySequenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +990,7 @@ func (m *QueryConfig) Reset()         { *m = QueryConfig{} }
 func (m *QueryConfig) String() string { return proto.CompactTextString(m) }
 func (*QueryConfig) ProtoMessage()    {}
 func (*QueryConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{15}
+	return fileDescriptor_e6da8796717704d7, []int{17}
 }
 func (m *QueryConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1029,7 @@ func (m *QueryConfigResponse) Reset()         { *m = QueryConfigResponse{} }
 func (m *QueryConfigResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryConfigResponse) ProtoMessage()    {}
 func (*QueryConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{16}
+	return fileDescriptor_e6da8796717704d7, []int{18}
 }
 func (m *QueryConfigResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1080,7 @@ func (m *QueryProposal) Reset()         { *m = QueryProposal{} }
 func (m *QueryProposal) String() string { return proto.CompactTextString(m) }
 func (*QueryProposal) ProtoMessage()    {}
 func (*QueryProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{17}
+	return fileDescriptor_e6da8796717704d7, []int{19}
 }
 func (m *QueryProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -974,7 +1124,7 @@ func (m *QueryProposalResponse) Reset()         { *m = QueryProposalResponse{} }
 func (m *QueryProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryProposalResponse) ProtoMessage()    {}
 func (*QueryProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e6da8796717704d7, []int{18}
+	return fileDescriptor_e6da8796717704d7, []int{20}
 }
 func (m *QueryProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,6 +1160,88 @@ func (m *QueryProposalResponse) GetProposal() *Proposal {
 	return nil
 }
 
+// QueryProposals is the request for all the proposals of the account which haven't been pruned yet.
+type QueryProposals struct {
+}
+
+func (m *QueryProposals) Reset()         { *m = QueryProposals{} }
+func (m *QueryProposals) String() string { return proto.CompactTextString(m) }
+func (*QueryProposals) ProtoMessage()    {}
+func (*QueryProposals) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{21}
+}
+func (m *QueryProposals) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposals) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposals.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposals) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposals.Merge(m, src)
+}
+func (m *QueryProposals) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposals) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposals.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposals proto.InternalMessageInfo
+
+// QueryProposalsResponse returns the proposals of the account.
+type QueryProposalsResponse struct {
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+}
+
+func (m *QueryProposalsResponse) Reset()         { *m = QueryProposalsResponse{} }
+func (m *QueryProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProposalsResponse) ProtoMessage()    {}
+func (*QueryProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6da8796717704d7, []int{22}
+}
+func (m *QueryProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProposalsResponse.Merge(m, src)
+}
+func (m *QueryProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryProposalsResponse) GetProposals() []*Proposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.ProposalStatus", ProposalStatus_name, ProposalStatus_value)
 	proto.RegisterEnum("cosmos.accounts.defaults.multisig.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1021,6 +1253,8 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVoteResponse")
 	proto.RegisterType((*MsgExecuteProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposal")
 	proto.RegisterType((*MsgExecuteProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgExecuteProposalResponse")
+	proto.RegisterType((*MsgVetoProposal)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposal")
+	proto.RegisterType((*MsgVetoProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgVetoProposalResponse")
 	proto.RegisterType((*MsgUpdateConfig)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfig")
 	proto.RegisterType((*MsgUpdateConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.MsgUpdateConfigResponse")
 	proto.RegisterType((*Member)(nil), "cosmos.accounts.defaults.multisig.v1.Member")
@@ -1032,6 +1266,8 @@ func init() {
 	proto.RegisterType((*QueryConfigResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryConfigResponse")
 	proto.RegisterType((*QueryProposal)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposal")
 	proto.RegisterType((*QueryProposalResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalResponse")
+	proto.RegisterType((*QueryProposals)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposals")
+	proto.RegisterType((*QueryProposalsResponse)(nil), "cosmos.accounts.defaults.multisig.v1.QueryProposalsResponse")
 }
 
 func init() {
@@ -1039,62 +1275,71 @@ func init() {
 }
 
 var fileDescriptor_e6da8796717704d7 = []byte{
-	// 865 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0xaf, 0x9b, 0x6e, 0x9a, 0xbe, 0xd2, 0x24, 0x9d, 0x96, 0xad, 0x9b, 0x5d, 0xb2, 0xc5, 0x20,
-	0xb1, 0xaa, 0x16, 0xa7, 0x9b, 0xc2, 0x8d, 0x4b, 0xda, 0xb8, 0x28, 0xab, 0x4d, 0x6c, 0xc6, 0x69,
-	0x25, 0xb8, 0x58, 0x6e, 0x3c, 0x75, 0xad, 0x8d, 0x3d, 0x5e, 0xcf, 0xb8, 0x6c, 0xbe, 0x04, 0xe2,
-	0xc6, 0x07, 0x80, 0x23, 0x47, 0x3e, 0x04, 0xc7, 0x15, 0x27, 0x8e, 0xa8, 0x95, 0xf8, 0x1c, 0xa8,
-	0xe3, 0xb1, 0x9b, 0xa6, 0xd2, 0x6e, 0x10, 0x3d, 0x70, 0xf3, 0xfb, 0xf3, 0xfb, 0xbd, 0xdf, 0xbc,
-	0x79, 0xf3, 0x64, 0xd8, 0x1f, 0x51, 0x16, 0x52, 0xd6, 0x72, 0x47, 0x23, 0x9a, 0x46, 0x9c, 0xb5,
-	0x3c, 0x72, 0xe6, 0xa6, 0x63, 0xce, 0x5a, 0x61, 0x3a, 0xe6, 0x01, 0x0b, 0xfc, 0xd6, 0xc5, 0xf3,
-	0xe2, 0x5b, 0x8f, 0x13, 0xca, 0x29, 0xfa, 0x34, 0x03, 0xe9, 0x39, 0x48, 0xcf, 0x41, 0x7a, 0x91,
-	0x78, 0xf1, 0xbc, 0xb1, 0xed, 0x53, 0xea, 0x8f, 0x49, 0x4b, 0x60, 0x4e, 0xd3, 0xb3, 0x96, 0x1b,
-	0x4d, 0x32, 0x82, 0xc6, 0x96, 0xac, 0x1a, 0xb2, 0x8c, 0x9e, 0x49, 0xe6, 0xc6, 0x76, 0x16, 0x70,
-	0x84, 0xd5, 0x92, 0x65, 0x84, 0xa1, 0xfd, 0xa4, 0xc0, 0x72, 0x9f, 0xf9, 0xbd, 0x28, 0xe0, 0xe8,
-	0x08, 0x96, 0x43, 0x12, 0x9e, 0x92, 0x84, 0xa9, 0xca, 0x4e, 0xe9, 0xe9, 0x6a, 0xfb, 0x99, 0x3e,
-	0x8f, 0x24, 0xbd, 0x2f, 0x40, 0x38, 0x07, 0xa3, 0x2e, 0x94, 0x0f, 0x69, 0x74, 0x16, 0xf8, 0xea,
-	0xe2, 0x8e, 0x32, 0x3f, 0x4d, 0x86, 0xc1, 0x12, 0xab, 0xad, 0x43, 0x4d, 0x0a, 0xc3, 0x84, 0xc5,
-	0x34, 0x62, 0x44, 0x73, 0x60, 0xbd, 0xcf, 0xfc, 0xc3, 0x84, 0xb8, 0x9c, 0x58, 0x09, 0x8d, 0x29,
-	0x73, 0xc7, 0xe8, 0x05, 0x54, 0x62, 0xf9, 0xad, 0x2a, 0xa2, 0x9e, 0x3e, 0x5f, 0xbd, 0x9c, 0x01,
-	0x17, 0x78, 0xed, 0x2b, 0xd8, 0xbe, 0x53, 0x20, 0xaf, 0x8e, 0x9e, 0xc0, 0x6a, 0x9e, 0xe8, 0x04,
-	0x9e, 0xa8, 0xb5, 0x84, 0x21, 0x77, 0xf5, 0x3c, 0x2d, 0x16, 0xad, 0x3c, 0xa1, 0xfc, 0xfd, 0xb9,
-	0xa8, 0x0b, 0x4b, 0x17, 0x94, 0x13, 0xd1, 0xa1, 0x6a, 0x7b, 0x6f, 0x3e, 0xc5, 0xd7, 0xd4, 0x66,
-	0xcc, 0x03, 0x1a, 0x61, 0x81, 0x96, 0x3d, 0xba, 0x76, 0x17, 0x3d, 0xfa, 0x12, 0x50, 0x9f, 0xf9,
-	0xc6, 0x1b, 0x32, 0x4a, 0xa7, 0x9a, 0xf4, 0x5e, 0xed, 0x16, 0x34, 0xee, 0xc2, 0x8a, 0xa3, 0xb7,
-	0x61, 0x25, 0x91, 0xdf, 0xf9, 0x6c, 0x6c, 0xea, 0xd9, 0x20, 0xea, 0xf9, 0x20, 0xea, 0x9d, 0x68,
-	0x82, 0x6f, 0xd2, 0xb4, 0x5f, 0x15, 0x21, 0xee, 0x38, 0xf6, 0x5c, 0x4e, 0xb2, 0x3b, 0x45, 0x36,
-	0x54, 0x53, 0x61, 0x3b, 0xff, 0x65, 0xd0, 0xd6, 0x32, 0x8e, 0xfe, 0xbd, 0x8e, 0xdb, 0x36, 0x6c,
-	0xcd, 0xa8, 0x2d, 0x5a, 0x3a, 0x84, 0x72, 0x56, 0x0b, 0xb5, 0x61, 0xd9, 0xf5, 0xbc, 0x84, 0x30,
-	0x26, 0x5a, 0xb8, 0x72, 0xa0, 0xfe, 0xf1, 0xdb, 0xe7, 0x9b, 0xb2, 0x5c, 0x27, 0x8b, 0xd8, 0x3c,
-	0x09, 0x22, 0x1f, 0xe7, 0x89, 0xe8, 0x21, 0x94, 0xbf, 0x27, 0x81, 0x7f, 0xce, 0x85, 0xbc, 0x25,
-	0x2c, 0x2d, 0xed, 0x17, 0x25, 0xd7, 0x8d, 0x1e, 0xc3, 0x0a, 0x3f, 0x4f, 0x08, 0x3b, 0xa7, 0xe3,
-	0xec, 0x6e, 0x4a, 0xf8, 0xc6, 0x71, 0x4d, 0xf0, 0x3a, 0xa5, 0x49, 0x1a, 0x0a, 0x82, 0x12, 0x96,
-	0x16, 0xfa, 0x04, 0xd6, 0x2e, 0x28, 0x0f, 0x22, 0xdf, 0x89, 0x49, 0x12, 0x50, 0x4f, 0x2d, 0x89,
-	0xf0, 0x07, 0x99, 0xd3, 0x12, 0xbe, 0x6b, 0x70, 0x42, 0xc4, 0xa4, 0x2d, 0xed, 0x28, 0x4f, 0x2b,
-	0x58, 0x5a, 0xe8, 0x33, 0xa8, 0x11, 0x37, 0x19, 0x4f, 0x1c, 0x22, 0xae, 0x3c, 0xa0, 0x91, 0xfa,
-	0x40, 0x24, 0x54, 0x85, 0xdb, 0xc8, 0xbd, 0xda, 0xdf, 0x0a, 0x54, 0x8a, 0x31, 0xda, 0x84, 0x07,
-	0x3c, 0xe0, 0x63, 0x92, 0x9d, 0x1e, 0x67, 0x06, 0x52, 0x61, 0x99, 0xa5, 0x61, 0xe8, 0x26, 0x13,
-	0xa1, 0x70, 0x05, 0xe7, 0x26, 0xda, 0x83, 0x4a, 0x48, 0x18, 0x73, 0x7d, 0xc2, 0xd4, 0xd2, 0x3b,
-	0xc6, 0xa6, 0xc8, 0x42, 0xbb, 0xb0, 0x7e, 0xeb, 0x50, 0x0e, 0x89, 0x3c, 0x21, 0xbd, 0x84, 0x6b,
-	0xd3, 0x07, 0x33, 0x22, 0x0f, 0xbd, 0x84, 0x32, 0xe3, 0x2e, 0x4f, 0x99, 0x90, 0x5e, 0x6d, 0x7f,
-	0xf1, 0xef, 0xde, 0xbd, 0x2d, 0xb0, 0x58, 0x72, 0x68, 0x35, 0x58, 0xfb, 0x26, 0x25, 0xc9, 0xc4,
-	0x26, 0xaf, 0x53, 0x12, 0x8d, 0x88, 0xb6, 0x0f, 0x1f, 0xde, 0x72, 0x14, 0xaf, 0xa1, 0x01, 0x15,
-	0x26, 0x7d, 0xf2, 0x25, 0x15, 0xb6, 0xb6, 0x06, 0xab, 0x02, 0x24, 0xa7, 0xea, 0x67, 0x05, 0x36,
-	0xa6, 0xec, 0x82, 0xe2, 0xff, 0xb5, 0x6a, 0xf7, 0xe4, 0xd1, 0xe7, 0x5f, 0x17, 0x23, 0xd9, 0x9b,
-	0x3b, 0x9b, 0xe2, 0x1e, 0xb7, 0xf1, 0xee, 0x0f, 0x0a, 0x54, 0x6f, 0x5f, 0x16, 0x7a, 0x02, 0x8f,
-	0x2c, 0x6c, 0x5a, 0xa6, 0xdd, 0x79, 0xe9, 0xd8, 0xc3, 0xce, 0xf0, 0xd8, 0x76, 0x8e, 0x07, 0xb6,
-	0x65, 0x1c, 0xf6, 0x8e, 0x7a, 0x46, 0xb7, 0xbe, 0x80, 0x3e, 0x86, 0x8f, 0x66, 0x13, 0x4e, 0xcc,
-	0x61, 0x6f, 0xf0, 0xb5, 0x63, 0x19, 0xb8, 0x67, 0x76, 0xeb, 0x0a, 0x6a, 0xc0, 0xc3, 0xd9, 0x14,
-	0xab, 0x63, 0xdb, 0x46, 0xb7, 0xbe, 0x88, 0x1e, 0x83, 0x3a, 0x1b, 0xc3, 0xc6, 0x0b, 0xe3, 0x70,
-	0x68, 0x74, 0xeb, 0xa5, 0xdd, 0x57, 0x00, 0x37, 0x2b, 0x18, 0x3d, 0x82, 0xad, 0x13, 0x73, 0x68,
-	0x38, 0xa6, 0x35, 0xec, 0x99, 0x83, 0x19, 0x1d, 0x1b, 0x50, 0x9b, 0x0e, 0x7e, 0x6b, 0xd8, 0x75,
-	0x05, 0x6d, 0xc1, 0xc6, 0xb4, 0xb3, 0x73, 0x60, 0x0f, 0x3b, 0xbd, 0x41, 0x7d, 0x11, 0x21, 0xa8,
-	0x4e, 0x07, 0x06, 0x66, 0xbd, 0x74, 0x70, 0xf4, 0xfb, 0x65, 0x53, 0x79, 0x7b, 0xd9, 0x54, 0xfe,
-	0xba, 0x6c, 0x2a, 0x3f, 0x5e, 0x35, 0x17, 0xde, 0x5e, 0x35, 0x17, 0xfe, 0xbc, 0x6a, 0x2e, 0x7c,
-	0xf7, 0x2c, 0x6b, 0x28, 0xf3, 0x5e, 0xe9, 0x01, 0x6d, 0xbd, 0x79, 0xf7, 0x5f, 0xc6, 0x69, 0x59,
-	0xbc, 0xb4, 0xfd, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2c, 0xe6, 0x8e, 0x70, 0x94, 0x08, 0x00,
-	0x00,
+	// 1011 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0x4f, 0x73, 0xe3, 0xc4,
+	0x13, 0x8d, 0x6c, 0xc7, 0x76, 0x3a, 0xbf, 0xd8, 0xca, 0x24, 0x9b, 0x28, 0xde, 0xfd, 0x79, 0x83,
+	0xa0, 0x8a, 0x25, 0xb5, 0xc8, 0x59, 0x07, 0x6e, 0x5c, 0x9c, 0x58, 0xa1, 0xbc, 0xc4, 0x91, 0x91,
+	0x9c, 0x54, 0xc1, 0x45, 0xa5, 0x58, 0x13, 0x45, 0x15, 0x5b, 0xe3, 0xd5, 0x8c, 0x42, 0xfc, 0x2d,
+	0xb8, 0x71, 0xe0, 0xc8, 0x95, 0x23, 0x17, 0xbe, 0x01, 0xc5, 0x69, 0x8b, 0x13, 0x47, 0x2a, 0xf9,
+	0x22, 0x94, 0x47, 0x23, 0xf9, 0xcf, 0x52, 0x1b, 0x6f, 0xb1, 0x07, 0x6e, 0xea, 0x37, 0xfd, 0x5e,
+	0x77, 0x3f, 0xb5, 0x47, 0x86, 0x83, 0x1e, 0xa1, 0x03, 0x42, 0x6b, 0x4e, 0xaf, 0x47, 0xa2, 0x80,
+	0xd1, 0x9a, 0x8b, 0x2f, 0x9d, 0xa8, 0xcf, 0x68, 0x6d, 0x10, 0xf5, 0x99, 0x4f, 0x7d, 0xaf, 0x76,
+	0xf3, 0x22, 0x7d, 0xd6, 0x86, 0x21, 0x61, 0x04, 0x7d, 0x14, 0x93, 0xb4, 0x84, 0xa4, 0x25, 0x24,
+	0x2d, 0x4d, 0xbc, 0x79, 0x51, 0xd9, 0xf1, 0x08, 0xf1, 0xfa, 0xb8, 0xc6, 0x39, 0x17, 0xd1, 0x65,
+	0xcd, 0x09, 0x46, 0xb1, 0x40, 0x65, 0x5b, 0x54, 0x1d, 0xd0, 0x58, 0x9e, 0x0a, 0xe5, 0xca, 0x4e,
+	0x7c, 0x60, 0xf3, 0xa8, 0x26, 0xca, 0xf0, 0x40, 0xfd, 0x41, 0x82, 0x42, 0x9b, 0x7a, 0xad, 0xc0,
+	0x67, 0xe8, 0x18, 0x0a, 0x03, 0x3c, 0xb8, 0xc0, 0x21, 0x55, 0xa4, 0xdd, 0xec, 0xb3, 0xd5, 0xfa,
+	0x73, 0x6d, 0x91, 0x96, 0xb4, 0x36, 0x27, 0x99, 0x09, 0x19, 0x35, 0x21, 0x7f, 0x44, 0x82, 0x4b,
+	0xdf, 0x53, 0x32, 0xbb, 0xd2, 0xe2, 0x32, 0x31, 0xc7, 0x14, 0x5c, 0x75, 0x1d, 0xca, 0xa2, 0x31,
+	0x13, 0xd3, 0x21, 0x09, 0x28, 0x56, 0x6d, 0x58, 0x6f, 0x53, 0xef, 0x28, 0xc4, 0x0e, 0xc3, 0x9d,
+	0x90, 0x0c, 0x09, 0x75, 0xfa, 0xe8, 0x25, 0x14, 0x87, 0xe2, 0x59, 0x91, 0x78, 0x3d, 0x6d, 0xb1,
+	0x7a, 0x89, 0x82, 0x99, 0xf2, 0xd5, 0x2f, 0x60, 0xe7, 0x8d, 0x02, 0x49, 0x75, 0xf4, 0x14, 0x56,
+	0x93, 0x44, 0xdb, 0x77, 0x79, 0xad, 0x9c, 0x09, 0x09, 0xd4, 0x72, 0xd5, 0x21, 0xb7, 0xf2, 0x9c,
+	0xb0, 0x87, 0x73, 0x51, 0x13, 0x72, 0x37, 0x84, 0x61, 0xee, 0x50, 0xa9, 0xbe, 0xbf, 0x58, 0xc7,
+	0x63, 0x69, 0x63, 0xc8, 0x7c, 0x12, 0x98, 0x9c, 0x2d, 0x3c, 0x1a, 0xc3, 0xa9, 0x47, 0x9f, 0x03,
+	0x6a, 0x53, 0x4f, 0xbf, 0xc5, 0xbd, 0x68, 0xca, 0xa4, 0x07, 0x7b, 0xef, 0x40, 0xe5, 0x4d, 0x5a,
+	0x3a, 0x7a, 0x1d, 0x56, 0x42, 0xf1, 0x9c, 0xec, 0xc6, 0xa6, 0x16, 0x2f, 0xa2, 0x96, 0x2c, 0xa2,
+	0xd6, 0x08, 0x46, 0xe6, 0x24, 0x4d, 0xad, 0xc7, 0xbd, 0x61, 0x46, 0x16, 0xef, 0x62, 0x07, 0xb6,
+	0xe7, 0x38, 0xe9, 0x5c, 0x3f, 0x4b, 0x5c, 0xef, 0x6c, 0xe8, 0x3a, 0x0c, 0xc7, 0x2b, 0x82, 0x2c,
+	0x28, 0x45, 0x3c, 0xb6, 0xff, 0xcd, 0xde, 0xae, 0xc5, 0x1a, 0xed, 0xf7, 0xba, 0xbd, 0xf1, 0x24,
+	0xd3, 0xdd, 0xa6, 0x93, 0x74, 0x21, 0x1f, 0xd7, 0x42, 0x75, 0x28, 0x38, 0xae, 0x1b, 0x62, 0x4a,
+	0xb9, 0x17, 0x2b, 0x87, 0xca, 0x1f, 0xbf, 0x7c, 0xba, 0x29, 0xca, 0x35, 0xe2, 0x13, 0x8b, 0x85,
+	0x7e, 0xe0, 0x99, 0x49, 0x22, 0xda, 0x82, 0xfc, 0x77, 0xd8, 0xf7, 0xae, 0x18, 0x6f, 0x2f, 0x67,
+	0x8a, 0x48, 0xfd, 0x31, 0x93, 0xf4, 0x8d, 0x9e, 0xc0, 0x0a, 0xbb, 0x0a, 0x31, 0xbd, 0x22, 0xfd,
+	0xd8, 0xe4, 0xac, 0x39, 0x01, 0xc6, 0x02, 0xaf, 0x22, 0x12, 0x46, 0x03, 0x2e, 0x90, 0x35, 0x45,
+	0x84, 0x3e, 0x84, 0xb5, 0x1b, 0xc2, 0xfc, 0xc0, 0xb3, 0x87, 0x38, 0xf4, 0x89, 0xab, 0x64, 0xf9,
+	0xf1, 0xff, 0x62, 0xb0, 0xc3, 0xb1, 0x31, 0x39, 0xc4, 0x7c, 0x71, 0x73, 0xbb, 0xd2, 0xb3, 0xa2,
+	0x29, 0x22, 0xf4, 0x31, 0x94, 0xb1, 0x13, 0xf6, 0x47, 0x36, 0xe6, 0x1b, 0xe4, 0x93, 0x40, 0x59,
+	0xe6, 0x09, 0x25, 0x0e, 0xeb, 0x09, 0x8a, 0x2a, 0x50, 0x64, 0xfe, 0x00, 0xf7, 0x49, 0xef, 0x5a,
+	0xc9, 0xf3, 0x02, 0x69, 0x8c, 0xf6, 0x21, 0x7f, 0x83, 0x19, 0xc1, 0xa1, 0x52, 0x78, 0xc0, 0x0d,
+	0x91, 0x37, 0x2e, 0x9b, 0x2e, 0x14, 0xbe, 0x1d, 0xfa, 0xe1, 0x48, 0x29, 0x72, 0xd1, 0x52, 0x02,
+	0xeb, 0x1c, 0x55, 0x7f, 0xcd, 0x40, 0x31, 0x5d, 0xc3, 0x4d, 0x58, 0x66, 0x3e, 0xeb, 0xe3, 0xd8,
+	0x74, 0x33, 0x0e, 0x90, 0x02, 0x05, 0x1a, 0x0d, 0x06, 0x4e, 0x38, 0xe2, 0xc6, 0xac, 0x98, 0x49,
+	0x88, 0xf6, 0xa1, 0x38, 0xc0, 0x94, 0x3a, 0x1e, 0xa6, 0x4a, 0xf6, 0x2d, 0xcb, 0x9f, 0x66, 0xa1,
+	0x3d, 0x58, 0x9f, 0xf1, 0xd2, 0xc6, 0x81, 0xcb, 0x1d, 0xcb, 0x9a, 0xe5, 0x69, 0x3f, 0xf5, 0xc0,
+	0x45, 0x27, 0x90, 0xa7, 0xcc, 0x61, 0x11, 0xe5, 0x8e, 0x95, 0xea, 0x9f, 0xbd, 0xdb, 0xed, 0x65,
+	0x71, 0xae, 0x29, 0x34, 0xd0, 0x27, 0x20, 0xc7, 0xaf, 0xc0, 0xb9, 0xe8, 0x63, 0xdb, 0xb9, 0x64,
+	0x38, 0x14, 0x3e, 0x97, 0x27, 0x78, 0x63, 0x0c, 0x8f, 0xdf, 0xa5, 0xf0, 0xac, 0x10, 0x2f, 0x42,
+	0x1c, 0xa1, 0x12, 0x64, 0x7c, 0x97, 0xfb, 0x98, 0x33, 0x33, 0xbe, 0xab, 0x96, 0x61, 0xed, 0xeb,
+	0x08, 0x87, 0x23, 0x0b, 0xbf, 0x8a, 0x70, 0xd0, 0xc3, 0xea, 0x01, 0x3c, 0x9a, 0x01, 0xd2, 0x6b,
+	0xa2, 0x02, 0x45, 0x2a, 0x30, 0xf1, 0xe3, 0x4e, 0x63, 0x75, 0x0d, 0x56, 0x39, 0x49, 0xfc, 0x3e,
+	0x7e, 0x92, 0x60, 0x63, 0x2a, 0x4e, 0x25, 0xfe, 0x5b, 0xdf, 0xa0, 0x7d, 0x31, 0xfa, 0xe2, 0x37,
+	0x58, 0x0f, 0x1e, 0xcd, 0x30, 0xd2, 0xc1, 0xde, 0xe7, 0x67, 0x4a, 0x86, 0xd2, 0x4c, 0x11, 0xaa,
+	0x5e, 0xc2, 0xd6, 0x2c, 0x92, 0xd6, 0x3d, 0x81, 0x95, 0x84, 0x97, 0x58, 0xfa, 0xae, 0x85, 0x27,
+	0x02, 0x7b, 0xbf, 0x4b, 0x50, 0x9a, 0xdd, 0x3c, 0xf4, 0x14, 0x1e, 0x77, 0x4c, 0xa3, 0x63, 0x58,
+	0x8d, 0x13, 0xdb, 0xea, 0x36, 0xba, 0x67, 0x96, 0x7d, 0x76, 0x6a, 0x75, 0xf4, 0xa3, 0xd6, 0x71,
+	0x4b, 0x6f, 0xca, 0x4b, 0xe8, 0x03, 0xf8, 0xff, 0x7c, 0xc2, 0xb9, 0xd1, 0x6d, 0x9d, 0x7e, 0x69,
+	0x77, 0x74, 0xb3, 0x65, 0x34, 0x65, 0x09, 0x55, 0x60, 0x6b, 0x3e, 0xa5, 0xd3, 0xb0, 0x2c, 0xbd,
+	0x29, 0x67, 0xd0, 0x13, 0x50, 0xe6, 0xcf, 0x4c, 0xfd, 0xa5, 0x7e, 0xd4, 0xd5, 0x9b, 0x72, 0x16,
+	0x55, 0xa1, 0x32, 0x7f, 0xda, 0x6d, 0xb5, 0xf5, 0x13, 0xe3, 0xe8, 0x2b, 0xbd, 0x29, 0xe7, 0xfe,
+	0x49, 0xf9, 0x5c, 0xef, 0x1a, 0x7a, 0x53, 0x5e, 0xde, 0xbb, 0x06, 0x98, 0x7c, 0x51, 0xd1, 0x63,
+	0xd8, 0x3e, 0x37, 0xba, 0xba, 0x6d, 0x74, 0xba, 0x2d, 0xe3, 0x74, 0x6e, 0x86, 0x0d, 0x28, 0x4f,
+	0x1f, 0x7e, 0xa3, 0x5b, 0xb2, 0x84, 0xb6, 0x61, 0x63, 0x1a, 0x6c, 0x1c, 0x5a, 0xdd, 0x46, 0xeb,
+	0x54, 0xce, 0x20, 0x04, 0xa5, 0xe9, 0x83, 0x53, 0x43, 0xce, 0x1e, 0x1e, 0xff, 0x76, 0x57, 0x95,
+	0x5e, 0xdf, 0x55, 0xa5, 0xbf, 0xee, 0xaa, 0xd2, 0xf7, 0xf7, 0xd5, 0xa5, 0xd7, 0xf7, 0xd5, 0xa5,
+	0x3f, 0xef, 0xab, 0x4b, 0xdf, 0x3e, 0x8f, 0xdf, 0x06, 0x75, 0xaf, 0x35, 0x9f, 0xd4, 0x6e, 0xdf,
+	0xfe, 0xa7, 0xf1, 0x22, 0xcf, 0xaf, 0x9c, 0x83, 0xbf, 0x07, 0x00, 0x6b, 0x6d, 0x4e, 0x5f, 0x63,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *MsgInit) Marshal() (dAtA []byte, err error) {
@@ -1353,6 +1598,57 @@ func (m *MsgExecuteProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgVetoProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVetoProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVetoProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVetoProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgUpdateConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1480,6 +1776,23 @@ func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ProposalExpiry != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ProposalExpiry))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Vetoer) > 0 {
+		i -= len(m.Vetoer)
+		copy(dAtA[i:], m.Vetoer)
+		i = encodeVarintMultisig(dAtA, i, uint64(len(m.Vetoer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Timelock != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Timelock))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.EarlyExecution {
 		i--
 		if m.EarlyExecution {
@@ -1538,6 +1851,21 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Id != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Expiry != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.Expiry))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExecutableAfter != 0 {
+		i = encodeVarintMultisig(dAtA, i, uint64(m.ExecutableAfter))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Status != 0 {
 		i = encodeVarintMultisig(dAtA, i, uint64(m.Status))
 		i--
@@ -1765,6 +2093,66 @@ func (m *QueryProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultisig(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMultisig(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMultisig(v)
 	base := offset
@@ -1880,6 +2268,27 @@ func (m *MsgExecuteProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgVetoProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgVetoProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgUpdateConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1945,6 +2354,16 @@ func (m *Config) Size() (n int) {
 	if m.EarlyExecution {
 		n += 2
 	}
+	if m.Timelock != 0 {
+		n += 1 + sovMultisig(uint64(m.Timelock))
+	}
+	l = len(m.Vetoer)
+	if l > 0 {
+		n += 1 + l + sovMultisig(uint64(l))
+	}
+	if m.ProposalExpiry != 0 {
+		n += 1 + sovMultisig(uint64(m.ProposalExpiry))
+	}
 	return n
 }
 
@@ -1974,6 +2393,15 @@ func (m *Proposal) Size() (n int) {
 	if m.Status != 0 {
 		n += 1 + sovMultisig(uint64(m.Status))
 	}
+	if m.ExecutableAfter != 0 {
+		n += 1 + sovMultisig(uint64(m.ExecutableAfter))
+	}
+	if m.Expiry != 0 {
+		n += 1 + sovMultisig(uint64(m.Expiry))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMultisig(uint64(m.Id))
+	}
 	return n
 }
 
@@ -2051,6 +2479,30 @@ func (m *QueryProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovMultisig(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovMultisig(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2673,6 +3125,125 @@ func (m *MsgExecuteProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgVetoProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVetoProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVetoProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgUpdateConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3070,6 +3641,76 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EarlyExecution = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timelock", wireType)
+			}
+			m.Timelock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timelock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vetoer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vetoer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalExpiry", wireType)
+			}
+			m.ProposalExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3256,6 +3897,63 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutableAfter", wireType)
+			}
+			m.ExecutableAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutableAfter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMultisig(dAtA[iNdEx:])
@@ -3721,6 +4419,140 @@ func (m *QueryProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultisig
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultisig
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, &Proposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultisig(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultisig
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMultisig(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,293 @@
+package webauthn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	txv1beta1 "cosmossdk.io/api/cosmos/tx/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	CredentialsPrefix = collections.NewPrefix(0)
+	SequencePrefix    = collections.NewPrefix(1)
+)
+
+func NewAccount(name string, handlerMap *signing.HandlerMap) accountstd.AccountCreatorFunc {
+	return func(deps accountstd.Dependencies) (string, accountstd.Interface, error) {
+		return name, Account{
+			Credentials:     collections.NewMap(deps.SchemaBuilder, CredentialsPrefix, "credentials", collections.BytesKey, codec.CollValue[v1.Credential](deps.LegacyStateCodec)),
+			Sequence:        collections.NewSequence(deps.SchemaBuilder, SequencePrefix, "sequence"),
+			addrCodec:       deps.AddressCodec,
+			signingHandlers: handlerMap,
+			hs:              deps.Environment.HeaderService,
+		}, nil
+	}
+}
+
+// Account implements an account authenticated by WebAuthn credentials, such as
+// browser passkeys.
+type Account struct {
+	// Credentials are the credentials of the account, keyed by credential ID.
+	Credentials collections.Map[[]byte, v1.Credential]
+	Sequence    collections.Sequence
+
+	addrCodec address.Codec
+	hs        header.Service
+
+	signingHandlers *signing.HandlerMap
+}
+
+func (a Account) Init(ctx context.Context, msg *v1.MsgInit) (*v1.MsgInitResponse, error) {
+	if len(msg.Credentials) == 0 {
+		return nil, errors.New("at least one credential must be provided")
+	}
+
+	for _, cred := range msg.Credentials {
+		if err := a.addCredential(ctx, cred); err != nil {
+			return nil, err
+		}
+	}
+
+	return &v1.MsgInitResponse{}, nil
+}
+
+func (a Account) AddCredential(ctx context.Context, msg *v1.MsgAddCredential) (*v1.MsgAddCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	return &v1.MsgAddCredentialResponse{}, a.addCredential(ctx, msg.Credential)
+}
+
+func (a Account) addCredential(ctx context.Context, cred v1.Credential) error {
+	if err := validateCredential(cred); err != nil {
+		return err
+	}
+
+	has, err := a.Credentials.Has(ctx, cred.Id)
+	if err != nil {
+		return err
+	}
+	if has {
+		return fmt.Errorf("credential %X already exists", cred.Id)
+	}
+
+	return a.Credentials.Set(ctx, cred.Id, cred)
+}
+
+func (a Account) RemoveCredential(ctx context.Context, msg *v1.MsgRemoveCredential) (*v1.MsgRemoveCredentialResponse, error) {
+	if !accountstd.SenderIsSelf(ctx) {
+		return nil, errors.New("unauthorized")
+	}
+
+	has, err := a.Credentials.Has(ctx, msg.CredentialId)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf("credential %X not found", msg.CredentialId)
+	}
+
+	// the last credential cannot be removed, otherwise the account could not
+	// sign txs anymore.
+	iter, err := a.Credentials.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 1 {
+		return nil, errors.New("cannot remove the last credential of the account")
+	}
+
+	return &v1.MsgRemoveCredentialResponse{}, a.Credentials.Remove(ctx, msg.CredentialId)
+}
+
+// Authenticate implements the authentication flow of an abstracted WebAuthn
+// account. The tx signature is a WebAuthnSignature, whose assertion challenge
+// is the hash of the tx sign bytes.
+func (a Account) Authenticate(ctx context.Context, msg *aa_interface_v1.MsgAuthenticate) (*aa_interface_v1.MsgAuthenticateResponse, error) {
+	if !accountstd.SenderIsAccountsModule(ctx) {
+		return nil, errors.New("unauthorized: only accounts module is allowed to call this")
+	}
+
+	signerInfo := msg.Tx.AuthInfo.SignerInfos[msg.SignerIndex]
+	signerData, err := a.computeSignerData(ctx, signerInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	txData, err := a.getTxData(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	gotSeq := signerInfo.Sequence
+	if gotSeq != signerData.Sequence {
+		return nil, fmt.Errorf("unexpected sequence number, wanted: %d, got: %d", signerData.Sequence, gotSeq)
+	}
+
+	signMode, err := parseSignMode(signerInfo.ModeInfo)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse sign mode: %w", err)
+	}
+
+	sig := new(v1.WebAuthnSignature)
+	if err := gogoproto.Unmarshal(msg.Tx.Signatures[msg.SignerIndex], sig); err != nil {
+		return nil, fmt.Errorf("invalid webauthn signature: %w", err)
+	}
+
+	cred, err := a.Credentials.Get(ctx, sig.CredentialId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, fmt.Errorf("unknown credential %X", sig.CredentialId)
+		}
+		return nil, err
+	}
+
+	signBytes, err := a.signingHandlers.GetSignBytes(ctx, signMode, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	signCount, err := verifyAssertion(cred, sig, signBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if signCount != cred.SignCount {
+		cred.SignCount = signCount
+		if err := a.Credentials.Set(ctx, cred.Id, cred); err != nil {
+			return nil, err
+		}
+	}
+
+	return &aa_interface_v1.MsgAuthenticateResponse{}, nil
+}
+
+func parseSignMode(info *tx.ModeInfo) (signingv1beta1.SignMode, error) {
+	single, ok := info.Sum.(*tx.ModeInfo_Single_)
+	if !ok {
+		return 0, fmt.Errorf("only sign mode single accepted got: %v", info.Sum)
+	}
+	return signingv1beta1.SignMode(single.Single.Mode), nil
+}
+
+// computeSignerData will populate signer data and also increase the sequence.
+func (a Account) computeSignerData(ctx context.Context, signerInfo *tx.SignerInfo) (signing.SignerData, error) {
+	addrStr, err := a.addrCodec.BytesToString(accountstd.Whoami(ctx))
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+	chainID := a.hs.HeaderInfo(ctx).ChainID
+
+	wantSequence, err := a.Sequence.Next(ctx)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	accNum, err := a.getNumber(ctx, addrStr)
+	if err != nil {
+		return signing.SignerData{}, err
+	}
+
+	signerData := signing.SignerData{
+		Address:       addrStr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      wantSequence,
+	}
+	if signerInfo.PublicKey != nil {
+		signerData.PubKey = &anypb.Any{
+			TypeUrl: signerInfo.PublicKey.TypeUrl,
+			Value:   signerInfo.PublicKey.Value,
+		}
+	}
+
+	return signerData, nil
+}
+
+func (a Account) getNumber(ctx context.Context, addrStr string) (uint64, error) {
+	accNum, err := accountstd.QueryModule[accountsv1.AccountNumberResponse](ctx, &accountsv1.AccountNumberRequest{Address: addrStr})
+	if err != nil {
+		return 0, err
+	}
+
+	return accNum.Number, nil
+}
+
+func (a Account) getTxData(msg *aa_interface_v1.MsgAuthenticate) (signing.TxData, error) {
+	txBody := new(txv1beta1.TxBody)
+	err := proto.Unmarshal(msg.RawTx.BodyBytes, txBody)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	authInfo := new(txv1beta1.AuthInfo)
+	err = proto.Unmarshal(msg.RawTx.AuthInfoBytes, authInfo)
+	if err != nil {
+		return signing.TxData{}, err
+	}
+
+	return signing.TxData{
+		Body:                       txBody,
+		AuthInfo:                   authInfo,
+		BodyBytes:                  msg.RawTx.BodyBytes,
+		AuthInfoBytes:              msg.RawTx.AuthInfoBytes,
+		BodyHasUnknownNonCriticals: false, // NOTE: amino signing must be disabled.
+	}, nil
+}
+
+func (a Account) QuerySequence(ctx context.Context, _ *v1.QuerySequence) (*v1.QuerySequenceResponse, error) {
+	seq, err := a.Sequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QuerySequenceResponse{Sequence: seq}, nil
+}
+
+func (a Account) QueryCredentials(ctx context.Context, _ *v1.QueryCredentials) (*v1.QueryCredentialsResponse, error) {
+	iter, err := a.Credentials.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := iter.Values()
+	if err != nil {
+		return nil, err
+	}
+	return &v1.QueryCredentialsResponse{Credentials: credentials}, nil
+}
+
+func (a Account) RegisterInitHandler(builder *accountstd.InitBuilder) {
+	accountstd.RegisterInitHandler(builder, a.Init)
+}
+
+func (a Account) RegisterExecuteHandlers(builder *accountstd.ExecuteBuilder) {
+	accountstd.RegisterExecuteHandler(builder, a.AddCredential)
+	accountstd.RegisterExecuteHandler(builder, a.RemoveCredential)
+	accountstd.RegisterExecuteHandler(builder, a.Authenticate) // account abstraction
+}
+
+func (a Account) RegisterQueryHandlers(builder *accountstd.QueryBuilder) {
+	accountstd.RegisterQueryHandler(builder, a.QuerySequence)
+	accountstd.RegisterQueryHandler(builder, a.QueryCredentials)
+}
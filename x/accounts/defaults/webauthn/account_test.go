@@ -0,0 +1,191 @@
+package webauthn
+
+import (
+	"context"
+	"testing"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule/v2"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/accounts/accountstd"
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+	aa_interface_v1 "cosmossdk.io/x/accounts/interfaces/account_abstraction/v1"
+	accountsv1 "cosmossdk.io/x/accounts/v1"
+	"cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const self = "webauthn_account"
+
+var testSignBytes = []byte("tx sign bytes")
+
+type stateCodec struct {
+	codec.Codec
+}
+
+func (stateCodec) Marshal(m gogoproto.Message) ([]byte, error) { return gogoproto.Marshal(m) }
+func (stateCodec) Unmarshal(bz []byte, m gogoproto.Message) error {
+	return gogoproto.Unmarshal(bz, m)
+}
+
+type addressCodec struct{}
+
+func (addressCodec) StringToBytes(text string) ([]byte, error) { return []byte(text), nil }
+func (addressCodec) BytesToString(bz []byte) (string, error)   { return string(bz), nil }
+
+type headerService struct{}
+
+func (headerService) HeaderInfo(context.Context) header.Info { return header.Info{ChainID: "test"} }
+
+// signModeHandler returns fixed sign bytes for any tx.
+type signModeHandler struct{}
+
+func (signModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode_SIGN_MODE_DIRECT
+}
+
+func (signModeHandler) GetSignBytes(context.Context, signing.SignerData, signing.TxData) ([]byte, error) {
+	return testSignBytes, nil
+}
+
+func moduleQuery(_ context.Context, _, resp protoiface.MessageV1) error {
+	resp.(*accountsv1.AccountNumberResponse).Number = 1
+	return nil
+}
+
+func setupAccount(t *testing.T, creds ...v1.Credential) (context.Context, Account) {
+	t.Helper()
+	ctx, ss := accountstd.NewMockContext(0, []byte(self), []byte(self), nil, nil, nil, moduleQuery)
+	_, acc, err := NewAccount("webauthn", signing.NewHandlerMap(signModeHandler{}))(accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: stateCodec{},
+		Environment:      appmodule.Environment{HeaderService: headerService{}},
+	})
+	require.NoError(t, err)
+
+	account := acc.(Account)
+	_, err = account.Init(ctx, &v1.MsgInit{Credentials: creds})
+	require.NoError(t, err)
+	return ctx, account
+}
+
+func TestInit(t *testing.T) {
+	ctx, ss := accountstd.NewMockContext(0, []byte(self), []byte(self), nil, nil, nil, nil)
+	_, acc, err := NewAccount("webauthn", nil)(accountstd.Dependencies{
+		SchemaBuilder:    collections.NewSchemaBuilder(ss),
+		AddressCodec:     addressCodec{},
+		LegacyStateCodec: stateCodec{},
+	})
+	require.NoError(t, err)
+	account := acc.(Account)
+	cred := newAuthenticator(t, "passkey").credential()
+
+	testCases := []struct {
+		name   string
+		creds  []v1.Credential
+		expErr string
+	}{
+		{"no credentials", nil, "at least one credential"},
+		{"empty id", []v1.Credential{{PublicKey: cred.PublicKey, RpId: testRPID}}, "credential id cannot be empty"},
+		{"empty rp id", []v1.Credential{{Id: cred.Id, PublicKey: cred.PublicKey}}, "credential rp id cannot be empty"},
+		{"invalid pubkey", []v1.Credential{{Id: cred.Id, PublicKey: []byte("invalid"), RpId: testRPID}}, "invalid P-256 compressed public key"},
+		{"duplicate credential", []v1.Credential{cred, cred}, "already exists"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := account.Init(ctx, &v1.MsgInit{Credentials: tc.creds})
+			require.ErrorContains(t, err, tc.expErr)
+		})
+	}
+}
+
+func TestAddAndRemoveCredential(t *testing.T) {
+	first := newAuthenticator(t, "first").credential()
+	second := newAuthenticator(t, "second").credential()
+	ctx, acc := setupAccount(t, first)
+
+	_, err := acc.AddCredential(accountstd.SetSender(ctx, []byte("other")), &v1.MsgAddCredential{Credential: second})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.AddCredential(ctx, &v1.MsgAddCredential{Credential: first})
+	require.ErrorContains(t, err, "already exists")
+	_, err = acc.AddCredential(ctx, &v1.MsgAddCredential{Credential: second})
+	require.NoError(t, err)
+
+	res, err := acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Len(t, res.Credentials, 2)
+
+	_, err = acc.RemoveCredential(accountstd.SetSender(ctx, []byte("other")), &v1.MsgRemoveCredential{CredentialId: first.Id})
+	require.ErrorContains(t, err, "unauthorized")
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: []byte("unknown")})
+	require.ErrorContains(t, err, "not found")
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: first.Id})
+	require.NoError(t, err)
+	_, err = acc.RemoveCredential(ctx, &v1.MsgRemoveCredential{CredentialId: second.Id})
+	require.ErrorContains(t, err, "cannot remove the last credential")
+
+	res, err = acc.QueryCredentials(ctx, &v1.QueryCredentials{})
+	require.NoError(t, err)
+	require.Equal(t, []v1.Credential{second}, res.Credentials)
+}
+
+func TestAuthenticate(t *testing.T) {
+	first := newAuthenticator(t, "first")
+	second := newAuthenticator(t, "second")
+	ctx, acc := setupAccount(t, first.credential(), second.credential())
+	ctx = accountstd.SetSender(ctx, address.Module("accounts"))
+
+	authenticate := func(seq uint64, sig *v1.WebAuthnSignature) *aa_interface_v1.MsgAuthenticate {
+		bz, err := gogoproto.Marshal(sig)
+		require.NoError(t, err)
+		return &aa_interface_v1.MsgAuthenticate{
+			RawTx: &tx.TxRaw{},
+			Tx: &tx.Tx{
+				AuthInfo: &tx.AuthInfo{SignerInfos: []*tx.SignerInfo{{
+					ModeInfo: &tx.ModeInfo{Sum: &tx.ModeInfo_Single_{Single: &tx.ModeInfo_Single{Mode: txsigning.SignMode_SIGN_MODE_DIRECT}}},
+					Sequence: seq,
+				}}},
+				Signatures: [][]byte{bz},
+			},
+		}
+	}
+
+	_, err := acc.Authenticate(accountstd.SetSender(ctx, []byte(self)), authenticate(0, first.sign(t, testRPID, testOrigin, testSignBytes)))
+	require.ErrorContains(t, err, "unauthorized")
+
+	_, err = acc.Authenticate(ctx, authenticate(0, first.sign(t, testRPID, testOrigin, testSignBytes)))
+	require.NoError(t, err)
+
+	t.Log("verify the sign count of the credential is updated")
+	cred, err := acc.Credentials.Get(ctx, first.id)
+	require.NoError(t, err)
+	require.Equal(t, first.signCount, cred.SignCount)
+
+	t.Log("verify any credential of the account can sign")
+	_, err = acc.Authenticate(ctx, authenticate(1, second.sign(t, testRPID, testOrigin, testSignBytes)))
+	require.NoError(t, err)
+
+	_, err = acc.Authenticate(ctx, authenticate(1, first.sign(t, testRPID, testOrigin, testSignBytes)))
+	require.ErrorContains(t, err, "unexpected sequence number")
+
+	_, err = acc.Authenticate(ctx, authenticate(3, newAuthenticator(t, "unknown").sign(t, testRPID, testOrigin, testSignBytes)))
+	require.ErrorContains(t, err, "unknown credential")
+
+	_, err = acc.Authenticate(ctx, authenticate(4, first.sign(t, testRPID, testOrigin, []byte("other sign bytes"))))
+	require.ErrorContains(t, err, "challenge does not match")
+
+	seq, err := acc.QuerySequence(ctx, &v1.QuerySequence{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), seq.Sequence)
+}
@@ -0,0 +1,117 @@
+package webauthn
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+)
+
+const (
+	// assertionType is the type of the client data of WebAuthn assertions.
+	assertionType = "webauthn.get"
+
+	// flagUserPresent is the user present flag of the authenticator data.
+	flagUserPresent = 0x01
+
+	// minAuthenticatorDataLength is the length of the rp ID hash, the flags and
+	// the signature counter of the authenticator data.
+	minAuthenticatorDataLength = 37
+)
+
+// clientData is the subset of the client data JSON of an assertion checked by
+// the account.
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// Challenge returns the WebAuthn challenge for the sign bytes of a tx, encoded
+// as in the client data JSON.
+func Challenge(signBytes []byte) string {
+	hash := sha256.Sum256(signBytes)
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+// parsePublicKey parses a compressed SEC1 encoded P-256 pubkey.
+func parsePublicKey(bz []byte) (*ecdsa.PublicKey, error) {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), bz)
+	if x == nil {
+		return nil, errors.New("invalid P-256 compressed public key")
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+}
+
+// validateCredential does a basic validation of a credential.
+func validateCredential(cred v1.Credential) error {
+	if len(cred.Id) == 0 {
+		return errors.New("credential id cannot be empty")
+	}
+	if cred.RpId == "" {
+		return errors.New("credential rp id cannot be empty")
+	}
+	if _, err := parsePublicKey(cred.PublicKey); err != nil {
+		return err
+	}
+	return nil
+}
+
+// verifyAssertion verifies that the WebAuthn assertion of the credential signs
+// the sign bytes, and returns the new signature counter of the credential.
+func verifyAssertion(cred v1.Credential, sig *v1.WebAuthnSignature, signBytes []byte) (uint32, error) {
+	var cd clientData
+	if err := json.Unmarshal(sig.ClientDataJson, &cd); err != nil {
+		return 0, fmt.Errorf("invalid client data: %w", err)
+	}
+	if cd.Type != assertionType {
+		return 0, fmt.Errorf("unexpected client data type %q", cd.Type)
+	}
+	if cd.Challenge != Challenge(signBytes) {
+		return 0, errors.New("client data challenge does not match the tx sign bytes")
+	}
+	if cred.Origin != "" && cd.Origin != cred.Origin {
+		return 0, fmt.Errorf("unexpected client data origin %q", cd.Origin)
+	}
+
+	authData := sig.AuthenticatorData
+	if len(authData) < minAuthenticatorDataLength {
+		return 0, errors.New("authenticator data is too short")
+	}
+	rpIDHash := sha256.Sum256([]byte(cred.RpId))
+	if !bytes.Equal(authData[:32], rpIDHash[:]) {
+		return 0, errors.New("authenticator data rp id hash does not match the credential")
+	}
+	if authData[32]&flagUserPresent == 0 {
+		return 0, errors.New("user presence is required")
+	}
+	// a counter which does not increase may signal a cloned authenticator,
+	// authenticators without counter always return zero.
+	signCount := binary.BigEndian.Uint32(authData[33:37])
+	if (signCount != 0 || cred.SignCount != 0) && signCount <= cred.SignCount {
+		return 0, fmt.Errorf("signature counter %d must be greater than %d", signCount, cred.SignCount)
+	}
+
+	pk, err := parsePublicKey(cred.PublicKey)
+	if err != nil {
+		return 0, err
+	}
+
+	clientDataHash := sha256.Sum256(sig.ClientDataJson)
+	signed := make([]byte, 0, len(authData)+len(clientDataHash))
+	signed = append(signed, authData...)
+	signed = append(signed, clientDataHash[:]...)
+	digest := sha256.Sum256(signed)
+	if !ecdsa.VerifyASN1(pk, digest[:], sig.Signature) {
+		return 0, errors.New("signature verification failed")
+	}
+
+	return signCount, nil
+}
@@ -0,0 +1,178 @@
+package webauthn
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	v1 "cosmossdk.io/x/accounts/defaults/webauthn/v1"
+)
+
+const (
+	testRPID   = "example.com"
+	testOrigin = "https://example.com"
+)
+
+// authenticator mimics a WebAuthn authenticator holding a P-256 credential.
+type authenticator struct {
+	id        []byte
+	key       *ecdsa.PrivateKey
+	signCount uint32
+}
+
+func newAuthenticator(t *testing.T, id string) *authenticator {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return &authenticator{id: []byte(id), key: key}
+}
+
+func (a *authenticator) credential() v1.Credential {
+	return v1.Credential{
+		Id:        a.id,
+		PublicKey: elliptic.MarshalCompressed(elliptic.P256(), a.key.X, a.key.Y),
+		RpId:      testRPID,
+		Origin:    testOrigin,
+	}
+}
+
+func (a *authenticator) sign(t *testing.T, rpID, origin string, signBytes []byte) *v1.WebAuthnSignature {
+	t.Helper()
+	a.signCount++
+
+	clientDataJSON, err := json.Marshal(clientData{Type: assertionType, Challenge: Challenge(signBytes), Origin: origin})
+	require.NoError(t, err)
+
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	authData := append(rpIDHash[:], flagUserPresent, 0, 0, 0, 0)
+	binary.BigEndian.PutUint32(authData[33:], a.signCount)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	require.NoError(t, err)
+
+	return &v1.WebAuthnSignature{
+		CredentialId:      a.id,
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         sig,
+	}
+}
+
+func TestVerifyAssertion(t *testing.T) {
+	signBytes := []byte("sign bytes")
+	auth := newAuthenticator(t, "passkey")
+	cred := auth.credential()
+
+	testCases := []struct {
+		name     string
+		malleate func() *v1.WebAuthnSignature
+		expErr   string
+	}{
+		{
+			"valid assertion",
+			func() *v1.WebAuthnSignature { return auth.sign(t, testRPID, testOrigin, signBytes) },
+			"",
+		},
+		{
+			"wrong challenge",
+			func() *v1.WebAuthnSignature { return auth.sign(t, testRPID, testOrigin, []byte("other")) },
+			"challenge does not match",
+		},
+		{
+			"wrong origin",
+			func() *v1.WebAuthnSignature { return auth.sign(t, testRPID, "https://evil.com", signBytes) },
+			"unexpected client data origin",
+		},
+		{
+			"wrong rp id",
+			func() *v1.WebAuthnSignature { return auth.sign(t, "evil.com", testOrigin, signBytes) },
+			"rp id hash does not match",
+		},
+		{
+			"wrong client data type",
+			func() *v1.WebAuthnSignature {
+				sig := auth.sign(t, testRPID, testOrigin, signBytes)
+				sig.ClientDataJson, _ = json.Marshal(clientData{Type: "webauthn.create", Challenge: Challenge(signBytes), Origin: testOrigin})
+				return sig
+			},
+			"unexpected client data type",
+		},
+		{
+			"user not present",
+			func() *v1.WebAuthnSignature {
+				sig := auth.sign(t, testRPID, testOrigin, signBytes)
+				sig.AuthenticatorData[32] = 0
+				return sig
+			},
+			"user presence is required",
+		},
+		{
+			"short authenticator data",
+			func() *v1.WebAuthnSignature {
+				sig := auth.sign(t, testRPID, testOrigin, signBytes)
+				sig.AuthenticatorData = sig.AuthenticatorData[:36]
+				return sig
+			},
+			"authenticator data is too short",
+		},
+		{
+			"signed by another key",
+			func() *v1.WebAuthnSignature {
+				return newAuthenticator(t, "passkey").sign(t, testRPID, testOrigin, signBytes)
+			},
+			"signature verification failed",
+		},
+		{
+			"tampered client data",
+			func() *v1.WebAuthnSignature {
+				sig := auth.sign(t, testRPID, testOrigin, signBytes)
+				sig.ClientDataJson = append(sig.ClientDataJson, ' ')
+				return sig
+			},
+			"signature verification failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			signCount, err := verifyAssertion(cred, tc.malleate(), signBytes)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, auth.signCount, signCount)
+		})
+	}
+}
+
+func TestVerifyAssertionSignCount(t *testing.T) {
+	signBytes := []byte("sign bytes")
+	auth := newAuthenticator(t, "passkey")
+	cred := auth.credential()
+
+	sig := auth.sign(t, testRPID, testOrigin, signBytes)
+	signCount, err := verifyAssertion(cred, sig, signBytes)
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), signCount)
+	cred.SignCount = signCount
+
+	// replaying the same counter is rejected
+	_, err = verifyAssertion(cred, sig, signBytes)
+	require.ErrorContains(t, err, "signature counter 1 must be greater than 1")
+
+	// authenticators without counter always report zero
+	auth.signCount = ^uint32(0)
+	cred.SignCount = 0
+	signCount, err = verifyAssertion(cred, auth.sign(t, testRPID, testOrigin, signBytes), signBytes)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), signCount)
+}
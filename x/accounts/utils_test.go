@@ -69,6 +69,11 @@ func newKeeper(t *testing.T, accounts ...implementation.AccountCreatorFunc) (Kee
 		&bankv1beta1.MsgSetSendEnabled{},
 		&bankv1beta1.MsgMultiSend{},
 		&bankv1beta1.MsgUpdateParams{},
+		&bankv1beta1.MsgCreateDenom{},
+		&bankv1beta1.MsgMint{},
+		&bankv1beta1.MsgForceTransfer{},
+		&bankv1beta1.MsgChangeAdmin{},
+		&bankv1beta1.MsgSetDenomSendHook{},
 	)
 	queryRouter.RegisterService(&bankv1beta1.Query_ServiceDesc, &bankQueryServer{})
 	msgRouter.RegisterService(&bankv1beta1.Msg_ServiceDesc, &bankMsgServer{})
@@ -19,6 +19,7 @@ import (
 	txsigning "cosmossdk.io/x/tx/signing"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -130,6 +131,11 @@ func verifyIsOnCurve(pubKey cryptotypes.PubKey) (err error) {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "secp256r1 key is not on curve")
 		}
 
+	case *bls12_381.PubKey:
+		if err := typedPubKey.Validate(); err != nil {
+			return err
+		}
+
 	case multisig.PubKey:
 		pubKeysObjects := typedPubKey.GetPubKeys()
 		ok := true
@@ -186,12 +192,19 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 		return ctx, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid number of pubkeys; expected %d, got %d", len(signers), len(pubKeys))
 	}
 
+	// The BLS12-381 signatures of the tx are not verified one by one, but all
+	// at once with a single batch verification. The batch binds each signature
+	// to its signer, so that they can't be swapped between the signers of the tx.
+	blsBatch := bls12_381.NewBatchVerifier()
 	for i := range signers {
-		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i)
+		err = svd.authenticate(ctx, sigTx, signers[i], signatures[i], pubKeys[i], i, blsBatch)
 		if err != nil {
 			return ctx, err
 		}
 	}
+	if blsBatch.Len() > 0 && !blsBatch.Verify() {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed; bls12_381 batch signature is invalid")
+	}
 
 	var events sdk.Events
 	for i, sig := range signatures {
@@ -220,7 +233,9 @@ func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, _ boo
 }
 
 // authenticate the authentication of the TX for a specific tx signer.
-func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int) error {
+// Signatures which can be batched are added to the batch verifier instead of
+// being verified.
+func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning.Tx, signer []byte, sig signing.SignatureV2, txPubKey cryptotypes.PubKey, signerIndex int, batch cryptotypes.BatchVerifier) error {
 	// first we check if it's an AA
 	if svd.aaKeeper != nil {
 		isAa, err := svd.aaKeeper.IsAbstractedAccount(ctx, signer)
@@ -261,7 +276,7 @@ func (svd SigVerificationDecorator) authenticate(ctx sdk.Context, tx authsigning
 		return err
 	}
 
-	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated)
+	err = svd.verifySig(ctx, tx, acc, sig, newlyCreated, batch)
 	if err != nil {
 		return err
 	}
@@ -299,8 +314,9 @@ func (svd SigVerificationDecorator) consumeSignatureGas(
 	return nil
 }
 
-// verifySig will verify the signature of the provided signer account.
-func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool) error {
+// verifySig will verify the signature of the provided signer account, or add it
+// to the batch verifier if it supports it.
+func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sdk.AccountI, sig signing.SignatureV2, newlyCreated bool, batch cryptotypes.BatchVerifier) error {
 	if sig.Sequence != acc.GetSequence() {
 		return errorsmod.Wrapf(
 			sdkerrors.ErrWrongSequence,
@@ -355,7 +371,7 @@ func (svd SigVerificationDecorator) verifySig(ctx sdk.Context, tx sdk.Tx, acc sd
 		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
 	}
 	txData := adaptableTx.GetSigningTxData()
-	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+	err := authsigning.VerifySignatureWithBatch(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData, batch)
 	if err != nil {
 		var errMsg string
 		if OnlyLegacyAminoSigners(sig.Data) {
@@ -502,6 +518,10 @@ func DefaultSigVerificationGasConsumer(meter storetypes.GasMeter, sig signing.Si
 		meter.ConsumeGas(params.SigVerifyCostSecp256r1(), "ante verify: secp256r1")
 		return nil
 
+	case *bls12_381.PubKey:
+		meter.ConsumeGas(params.SigVerifyCostBls12381(), "ante verify: bls12_381")
+		return nil
+
 	case multisig.PubKey:
 		multisignature, ok := sig.Data.(*signing.MultiSignatureData)
 		if !ok {
@@ -0,0 +1,126 @@
+//go:build ((linux && amd64) || (linux && arm64) || (darwin && amd64) || (darwin && arm64) || (windows && amd64)) && bls12381
+
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/auth/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/bls12_381"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+func TestConsumeSignatureVerificationGasBls12381(t *testing.T) {
+	params := types.DefaultParams()
+	privKey, err := bls12_381.GenPrivKey()
+	require.NoError(t, err)
+
+	meter := storetypes.NewInfiniteGasMeter()
+	sigV2 := signing.SignatureV2{PubKey: privKey.PubKey()}
+	require.NoError(t, ante.DefaultSigVerificationGasConsumer(meter, sigV2, params))
+	require.Equal(t, params.SigVerifyCostBls12381(), meter.GasConsumed())
+}
+
+func TestSigVerificationBls12381(t *testing.T) {
+	suite := SetupTestSuite(t, true)
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+
+	// three BLS signers and a secp256k1 one
+	privs := make([]cryptotypes.PrivKey, 4)
+	for i := 0; i < 3; i++ {
+		priv, err := bls12_381.GenPrivKey()
+		require.NoError(t, err)
+		privs[i] = priv
+	}
+	privs[3], _, _ = testdata.KeyTestPubAddr()
+
+	msgs := make([]sdk.Msg, len(privs))
+	accNums := make([]uint64, len(privs))
+	accSeqs := make([]uint64, len(privs))
+	for i, priv := range privs {
+		addr := sdk.AccAddress(priv.PubKey().Address())
+		acc := suite.accountKeeper.NewAccountWithAddress(suite.ctx, addr)
+		require.NoError(t, acc.SetAccountNumber(uint64(i)+1000))
+		suite.accountKeeper.SetAccount(suite.ctx, acc)
+		msgs[i] = testdata.NewTestMsg(addr)
+		accNums[i] = acc.GetAccountNumber()
+	}
+
+	svd := ante.NewSigVerificationDecorator(suite.accountKeeper, suite.clientCtx.TxConfig.SignModeHandler(), ante.DefaultSigVerificationGasConsumer, nil)
+	antehandler := sdk.ChainAnteDecorators(svd)
+
+	testCases := []struct {
+		name      string
+		unordered bool
+		malleate  func(sigs []signing.SignatureV2)
+		expErr    string
+	}{
+		{"valid tx", false, func([]signing.SignatureV2) {}, ""},
+		{"valid unordered tx", true, func([]signing.SignatureV2) {}, ""},
+		{
+			"invalid bls12_381 signature",
+			false,
+			func(sigs []signing.SignatureV2) {
+				badSig, err := privs[1].Sign([]byte("unrelated message"))
+				require.NoError(t, err)
+				sigs[1].Data.(*signing.SingleSignatureData).Signature = badSig
+			},
+			"bls12_381 batch signature is invalid",
+		},
+		{
+			"swapped bls12_381 signatures",
+			false,
+			swapSignatures,
+			"bls12_381 batch signature is invalid",
+		},
+		{
+			"swapped bls12_381 signatures of unordered tx",
+			true,
+			swapSignatures,
+			"bls12_381 batch signature is invalid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := suite.ctx.CacheContext()
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			require.NoError(t, suite.txBuilder.SetMsgs(msgs...))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetUnordered(tc.unordered)
+
+			tx, err := suite.CreateTestTx(ctx, privs, accNums, accSeqs, ctx.ChainID(), signing.SignMode_SIGN_MODE_DIRECT)
+			require.NoError(t, err)
+			sigs, err := tx.GetSignaturesV2()
+			require.NoError(t, err)
+			tc.malleate(sigs)
+			require.NoError(t, suite.txBuilder.SetSignatures(sigs...))
+			tx = suite.txBuilder.GetTx()
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			for _, priv := range privs {
+				acc := suite.accountKeeper.GetAccount(ctx, sdk.AccAddress(priv.PubKey().Address()))
+				require.True(t, priv.PubKey().Equals(acc.GetPubKey()))
+			}
+		})
+	}
+}
+
+func swapSignatures(sigs []signing.SignatureV2) {
+	sig0 := sigs[0].Data.(*signing.SingleSignatureData)
+	sig2 := sigs[2].Data.(*signing.SingleSignatureData)
+	sig0.Signature, sig2.Signature = sig2.Signature, sig0.Signature
+}
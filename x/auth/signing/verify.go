@@ -66,6 +66,23 @@ func VerifySignature(
 	signatureData signing.SignatureData,
 	handler *txsigning.HandlerMap,
 	txData txsigning.TxData,
+) error {
+	return VerifySignatureWithBatch(ctx, pubKey, signerData, signatureData, handler, txData, nil)
+}
+
+// VerifySignatureWithBatch is like VerifySignature, except that a single signature
+// which can be added to the given batch verifier is not verified right away. In
+// that case, the signature is only valid once batch.Verify() returns true, which
+// the caller must check after adding all the signatures of the tx to the batch.
+// A nil batch verifies all the signatures right away.
+func VerifySignatureWithBatch(
+	ctx context.Context,
+	pubKey cryptotypes.PubKey,
+	signerData txsigning.SignerData,
+	signatureData signing.SignatureData,
+	handler *txsigning.HandlerMap,
+	txData txsigning.TxData,
+	batch cryptotypes.BatchVerifier,
 ) error {
 	switch data := signatureData.(type) {
 	case *signing.SingleSignatureData:
@@ -77,6 +94,9 @@ func VerifySignature(
 		if err != nil {
 			return err
 		}
+		if batch != nil && batch.Add(pubKey, signBytes, data.Signature) == nil {
+			return nil
+		}
 		if !pubKey.VerifySignature(signBytes, data.Signature) {
 			return fmt.Errorf("unable to verify single signer signature")
 		}
@@ -70,6 +70,62 @@ func (m *GenericAuthorization) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenericAuthorization proto.InternalMessageInfo
 
+// LimitedAuthorization wraps an authorization to limit the number of times the
+// grantee can execute it, the number of executions per window of blocks, and
+// optionally the recipients of the executed msgs.
+type LimitedAuthorization struct {
+	// authorization is the wrapped authorization, which must accept the msg too.
+	Authorization *any.Any `protobuf:"bytes,1,opt,name=authorization,proto3" json:"authorization,omitempty"`
+	// remaining_executions is the number of executions left before the grant is
+	// deleted. Zero means unlimited.
+	RemainingExecutions uint64 `protobuf:"varint,2,opt,name=remaining_executions,json=remainingExecutions,proto3" json:"remaining_executions,omitempty"`
+	// window_blocks is the length, in blocks, of the rate limiting window. Zero
+	// disables rate limiting.
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// max_executions_per_window is the number of executions allowed per window.
+	MaxExecutionsPerWindow uint64 `protobuf:"varint,4,opt,name=max_executions_per_window,json=maxExecutionsPerWindow,proto3" json:"max_executions_per_window,omitempty"`
+	// window_start_height is the height of the first block of the current window.
+	WindowStartHeight int64 `protobuf:"varint,5,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// window_executions is the number of executions in the current window.
+	WindowExecutions uint64 `protobuf:"varint,6,opt,name=window_executions,json=windowExecutions,proto3" json:"window_executions,omitempty"`
+	// allowed_recipients, if not empty, restricts the recipients of the executed
+	// msgs: the receivers of bank sends, or the validators of staking msgs.
+	AllowedRecipients []string `protobuf:"bytes,7,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *LimitedAuthorization) Reset()         { *m = LimitedAuthorization{} }
+func (m *LimitedAuthorization) String() string { return proto.CompactTextString(m) }
+func (*LimitedAuthorization) ProtoMessage()    {}
+func (*LimitedAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{1}
+}
+func (m *LimitedAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitedAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitedAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitedAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitedAuthorization.Merge(m, src)
+}
+func (m *LimitedAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitedAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitedAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitedAuthorization proto.InternalMessageInfo
+
 // Grant gives permissions to execute
 // the provide method with expiration time.
 type Grant struct {
@@ -84,7 +140,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{1}
+	return fileDescriptor_544dc2e84b61c637, []int{2}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -126,7 +182,7 @@ func (m *GrantAuthorization) Reset()         { *m = GrantAuthorization{} }
 func (m *GrantAuthorization) String() string { return proto.CompactTextString(m) }
 func (*GrantAuthorization) ProtoMessage()    {}
 func (*GrantAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{2}
+	return fileDescriptor_544dc2e84b61c637, []int{3}
 }
 func (m *GrantAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +221,7 @@ func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
 func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
 func (*GrantQueueItem) ProtoMessage()    {}
 func (*GrantQueueItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_544dc2e84b61c637, []int{3}
+	return fileDescriptor_544dc2e84b61c637, []int{4}
 }
 func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,6 +252,7 @@ var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
+	proto.RegisterType((*LimitedAuthorization)(nil), "cosmos.authz.v1beta1.LimitedAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
 	proto.RegisterType((*GrantAuthorization)(nil), "cosmos.authz.v1beta1.GrantAuthorization")
 	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
@@ -204,35 +261,46 @@ func init() {
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 439 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xe3, 0x75, 0xfc, 0x99, 0xa7, 0x21, 0x88, 0x72, 0x28, 0x3d, 0x24, 0x55, 0x0e, 0x68,
-	0x42, 0x6a, 0xac, 0x15, 0x4e, 0x9c, 0x68, 0x84, 0x34, 0xc1, 0x8d, 0x30, 0x2e, 0x5c, 0x2a, 0x67,
-	0x31, 0x9e, 0xb5, 0x3a, 0x8e, 0x6c, 0x07, 0x2d, 0xfb, 0x08, 0x9c, 0xf6, 0x19, 0xf8, 0x04, 0x20,
-	0xed, 0x43, 0x54, 0x9c, 0x2a, 0x4e, 0x9c, 0xf8, 0xd3, 0x1e, 0xf8, 0x1a, 0xa8, 0x76, 0x22, 0x1a,
-	0x5a, 0x89, 0x1e, 0xb8, 0x44, 0x7e, 0xfd, 0x3e, 0xcf, 0xfb, 0x3e, 0xf9, 0x45, 0x81, 0xfd, 0x53,
-	0xa1, 0xb8, 0x50, 0x08, 0x97, 0xfa, 0xec, 0x12, 0xbd, 0x3b, 0x4a, 0x89, 0xc6, 0x47, 0xb6, 0x8a,
-	0x0a, 0x29, 0xb4, 0x70, 0x3d, 0xab, 0x88, 0xec, 0x5d, 0xad, 0xe8, 0xdd, 0xc3, 0x9c, 0xe5, 0x02,
-	0x99, 0xa7, 0x15, 0xf6, 0xee, 0x5b, 0xe1, 0xd8, 0x54, 0xa8, 0x76, 0xd9, 0x56, 0x40, 0x85, 0xa0,
-	0x13, 0x82, 0x4c, 0x95, 0x96, 0x6f, 0x91, 0x66, 0x9c, 0x28, 0x8d, 0x79, 0x51, 0x0b, 0x3c, 0x2a,
-	0xa8, 0xb0, 0xc6, 0xe5, 0xa9, 0x99, 0xf8, 0xb7, 0x0d, 0xe7, 0x95, 0x6d, 0x85, 0x1a, 0x7a, 0xc7,
-	0x24, 0x27, 0x92, 0x9d, 0x8e, 0x4a, 0x7d, 0x26, 0x24, 0xbb, 0xc4, 0x9a, 0x89, 0xdc, 0xbd, 0x0b,
-	0x3b, 0x5c, 0xd1, 0x2e, 0xe8, 0x83, 0xc3, 0xbd, 0x64, 0x79, 0x7c, 0xf2, 0xe2, 0xf3, 0xf5, 0x20,
-	0xdc, 0xf4, 0x0e, 0x51, 0xcb, 0xf9, 0xfe, 0xd7, 0xc7, 0x87, 0x81, 0x95, 0x0d, 0x54, 0x76, 0x8e,
-	0x36, 0x4d, 0x0f, 0x3f, 0x01, 0x78, 0xe3, 0x58, 0xe2, 0x5c, 0xbb, 0x29, 0x3c, 0xc0, 0xab, 0x2d,
-	0xb3, 0x71, 0x7f, 0xe8, 0x45, 0x36, 0x72, 0xd4, 0x44, 0x8e, 0x46, 0x79, 0x15, 0x3f, 0xd8, 0x2e,
-	0x42, 0xd2, 0x1e, 0xe9, 0x3e, 0x83, 0x90, 0x5c, 0x14, 0x4c, 0xda, 0x05, 0x3b, 0x66, 0x41, 0x6f,
-	0x6d, 0xc1, 0x49, 0x83, 0x32, 0xbe, 0x3d, 0xfd, 0x16, 0x80, 0xab, 0xef, 0x01, 0x48, 0x56, 0x7c,
-	0xe1, 0x87, 0x1d, 0xe8, 0x9a, 0xcc, 0x6d, 0x50, 0x43, 0x78, 0x8b, 0x2e, 0x6f, 0x89, 0xb4, 0xb0,
-	0xe2, 0xee, 0x97, 0xeb, 0x41, 0xf3, 0xad, 0x47, 0x59, 0x26, 0x89, 0x52, 0xaf, 0xb4, 0x64, 0x39,
-	0x4d, 0x1a, 0xe1, 0x1f, 0x0f, 0x31, 0x69, 0xb6, 0xf0, 0x90, 0x75, 0x50, 0x9d, 0xff, 0x0f, 0xea,
-	0x69, 0x0b, 0xd4, 0xee, 0x3f, 0x41, 0xed, 0xae, 0x41, 0x7a, 0x0c, 0xef, 0x18, 0x46, 0x2f, 0x4b,
-	0x52, 0x92, 0xe7, 0x9a, 0x70, 0x37, 0x84, 0x07, 0x5c, 0xd1, 0xb1, 0xae, 0x0a, 0x32, 0x2e, 0xe5,
-	0x44, 0x75, 0x41, 0xbf, 0x73, 0xb8, 0x97, 0xec, 0x73, 0x45, 0x4f, 0xaa, 0x82, 0xbc, 0x96, 0x13,
-	0x15, 0x0f, 0xa7, 0x3f, 0x7d, 0x67, 0x3a, 0xf7, 0xc1, 0x6c, 0xee, 0x83, 0x1f, 0x73, 0x1f, 0x5c,
-	0x2d, 0x7c, 0x67, 0xb6, 0xf0, 0x9d, 0xaf, 0x0b, 0xdf, 0x79, 0x53, 0x83, 0x51, 0xd9, 0x79, 0xc4,
-	0x04, 0xba, 0xb0, 0x3f, 0x55, 0x7a, 0xd3, 0xe4, 0x79, 0xf4, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x60,
-	0x57, 0xde, 0x7c, 0x79, 0x03, 0x00, 0x00,
+	// 621 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0xb6, 0x05, 0xfe, 0x0c, 0x7f, 0x08, 0x2c, 0x8d, 0x59, 0x38, 0x6c, 0x9b, 0x35, 0x31,
+	0x8d, 0xa6, 0xbb, 0x16, 0xbd, 0xc8, 0x49, 0x1a, 0x0d, 0x6a, 0x3c, 0xe8, 0x82, 0x31, 0x31, 0x26,
+	0x9b, 0x69, 0xf7, 0x71, 0x3b, 0x61, 0x67, 0x67, 0x33, 0x33, 0x0b, 0x2d, 0x1f, 0xc1, 0x13, 0x9f,
+	0xc1, 0x4f, 0xa0, 0x09, 0x1f, 0x82, 0x78, 0x22, 0x9c, 0x3c, 0xf9, 0x02, 0x07, 0x3f, 0x82, 0x57,
+	0xd3, 0x99, 0x2d, 0xb4, 0x40, 0x62, 0x0f, 0xc6, 0xcb, 0x66, 0xe7, 0xf7, 0xf2, 0x3c, 0xcf, 0xfc,
+	0x66, 0x06, 0xd5, 0x3a, 0x4c, 0x50, 0x26, 0x3c, 0x9c, 0xc9, 0xee, 0xbe, 0xb7, 0xdb, 0x6c, 0x83,
+	0xc4, 0x4d, 0xbd, 0x72, 0x53, 0xce, 0x24, 0x33, 0x2b, 0x5a, 0xe1, 0x6a, 0x2c, 0x57, 0xac, 0x2e,
+	0x61, 0x4a, 0x12, 0xe6, 0xa9, 0xaf, 0x16, 0xae, 0xae, 0x68, 0x61, 0xa0, 0x56, 0x5e, 0xee, 0xd2,
+	0x54, 0x35, 0x62, 0x2c, 0x8a, 0xc1, 0x53, 0xab, 0x76, 0xf6, 0xce, 0x93, 0x84, 0x82, 0x90, 0x98,
+	0xa6, 0xb9, 0xa0, 0x12, 0xb1, 0x88, 0x69, 0xe3, 0xe0, 0x6f, 0x58, 0xf1, 0xb2, 0x0d, 0x27, 0x7d,
+	0x4d, 0x39, 0x12, 0x55, 0x36, 0x21, 0x01, 0x4e, 0x3a, 0x1b, 0x99, 0xec, 0x32, 0x4e, 0xf6, 0xb1,
+	0x24, 0x2c, 0x31, 0x17, 0x51, 0x89, 0x8a, 0xc8, 0x32, 0x6a, 0x46, 0x7d, 0xd6, 0x1f, 0xfc, 0xae,
+	0x3f, 0xfb, 0x7c, 0xd8, 0x70, 0xae, 0xdb, 0x83, 0x3b, 0xe6, 0x7c, 0xff, 0xf3, 0xe3, 0xed, 0xaa,
+	0x96, 0x35, 0x44, 0xb8, 0xe3, 0x5d, 0x57, 0xdd, 0xf9, 0x55, 0x42, 0x95, 0xe7, 0x84, 0x12, 0x09,
+	0xe1, 0x78, 0xdb, 0x36, 0x9a, 0xc7, 0xa3, 0x80, 0x1a, 0x60, 0x6e, 0xad, 0xe2, 0xea, 0x1d, 0xb8,
+	0xc3, 0x1d, 0xb8, 0x1b, 0x49, 0xbf, 0x75, 0x6b, 0xb2, 0x89, 0xfc, 0xf1, 0x92, 0x66, 0x13, 0x55,
+	0x38, 0x50, 0x4c, 0x12, 0x92, 0x44, 0x01, 0xf4, 0xa0, 0x93, 0x0d, 0x60, 0x61, 0x15, 0x6b, 0x46,
+	0xbd, 0xec, 0x2f, 0x9f, 0x73, 0x8f, 0xcf, 0x29, 0xf3, 0x26, 0x9a, 0xdf, 0x23, 0x49, 0xc8, 0xf6,
+	0x82, 0x76, 0xcc, 0x3a, 0x3b, 0xc2, 0x2a, 0x29, 0xed, 0xff, 0x1a, 0x6c, 0x29, 0xcc, 0x7c, 0x80,
+	0x56, 0x28, 0xee, 0x8d, 0x54, 0x0c, 0x52, 0xe0, 0x81, 0x96, 0x58, 0x65, 0x65, 0xb8, 0x41, 0x71,
+	0xef, 0xa2, 0xec, 0x0b, 0xe0, 0xaf, 0x15, 0x6b, 0xba, 0x68, 0x39, 0xaf, 0x2f, 0x24, 0xe6, 0x32,
+	0xe8, 0x02, 0x89, 0xba, 0xd2, 0x9a, 0xaa, 0x19, 0xf5, 0x92, 0xbf, 0xa4, 0xa9, 0xad, 0x01, 0xf3,
+	0x44, 0x11, 0xe6, 0x1d, 0x94, 0x83, 0xa3, 0xf3, 0x4f, 0xab, 0x16, 0x8b, 0x9a, 0x18, 0x19, 0xbe,
+	0x81, 0x4c, 0x1c, 0xc7, 0x6c, 0x0f, 0xc2, 0x80, 0x43, 0x87, 0xa4, 0x04, 0x12, 0x29, 0xac, 0x99,
+	0x5a, 0xa9, 0x3e, 0xeb, 0x2f, 0xe5, 0x8c, 0x7f, 0x4e, 0xac, 0xbf, 0x9d, 0x2c, 0xd5, 0x93, 0xc3,
+	0xc6, 0x42, 0x4f, 0x5f, 0xf0, 0xda, 0x6e, 0xd3, 0x6d, 0xba, 0x77, 0x2f, 0x9f, 0xfc, 0x75, 0x07,
+	0xec, 0x7c, 0x32, 0xd0, 0xd4, 0x26, 0xc7, 0x89, 0xfc, 0x27, 0x47, 0xfd, 0x08, 0x21, 0xe8, 0xa5,
+	0x84, 0xeb, 0x06, 0x45, 0xd5, 0x60, 0xf5, 0x4a, 0x83, 0xed, 0xe1, 0x23, 0x6a, 0xfd, 0x77, 0xf4,
+	0xb5, 0x6a, 0x1c, 0x7c, 0xab, 0x1a, 0xfe, 0x88, 0xcf, 0xf9, 0x50, 0x44, 0xa6, 0x9a, 0x79, 0xfc,
+	0xae, 0xae, 0xa1, 0x99, 0x68, 0x80, 0x02, 0xd7, 0xcf, 0xa4, 0x65, 0x9d, 0x1c, 0x36, 0x86, 0xaf,
+	0x7c, 0x23, 0x0c, 0x39, 0x08, 0xb1, 0x25, 0x39, 0x49, 0x22, 0x7f, 0x28, 0xbc, 0xf0, 0x80, 0x55,
+	0x9c, 0xcc, 0x03, 0x57, 0x83, 0x2a, 0xfd, 0xfd, 0xa0, 0x1e, 0x8e, 0x05, 0x55, 0xfe, 0x63, 0x50,
+	0xe5, 0x2b, 0x21, 0xdd, 0x47, 0x0b, 0x2a, 0xa3, 0x97, 0x19, 0x64, 0xf0, 0x54, 0x02, 0x35, 0x1d,
+	0x34, 0x4f, 0x45, 0x14, 0xc8, 0x7e, 0x0a, 0x41, 0xc6, 0x63, 0x61, 0x19, 0xea, 0xca, 0xcd, 0x51,
+	0x11, 0x6d, 0xf7, 0x53, 0x78, 0xc5, 0x63, 0xd1, 0x5a, 0x3b, 0xfa, 0x61, 0x17, 0x8e, 0x4e, 0x6d,
+	0xe3, 0xf8, 0xd4, 0x36, 0xbe, 0x9f, 0xda, 0xc6, 0xc1, 0x99, 0x5d, 0x38, 0x3e, 0xb3, 0x0b, 0x5f,
+	0xce, 0xec, 0xc2, 0x9b, 0x3c, 0x18, 0x11, 0xee, 0xb8, 0x84, 0x79, 0xf9, 0x6d, 0x6b, 0x4f, 0xab,
+	0x79, 0xee, 0xfd, 0x1e, 0x00, 0x21, 0x33, 0xdc, 0x6e, 0x73, 0x05, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -265,6 +333,75 @@ func (m *GenericAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LimitedAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitedAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitedAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.WindowExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowExecutions))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxExecutionsPerWindow != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxExecutionsPerWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RemainingExecutions != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingExecutions))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Authorization != nil {
+		{
+			size, err := m.Authorization.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -286,12 +423,12 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -331,12 +468,12 @@ func (m *GrantAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiration != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
-		if err3 != nil {
-			return 0, err3
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n3
-		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i -= n4
+		i = encodeVarintAuthz(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -425,6 +562,40 @@ func (m *GenericAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *LimitedAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Authorization != nil {
+		l = m.Authorization.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.RemainingExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingExecutions))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowBlocks))
+	}
+	if m.MaxExecutionsPerWindow != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxExecutionsPerWindow))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowStartHeight))
+	}
+	if m.WindowExecutions != 0 {
+		n += 1 + sovAuthz(uint64(m.WindowExecutions))
+	}
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *Grant) Size() (n int) {
 	if m == nil {
 		return 0
@@ -570,6 +741,219 @@ func (m *GenericAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitedAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitedAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitedAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authorization", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Authorization == nil {
+				m.Authorization = &any.Any{}
+			}
+			if err := m.Authorization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingExecutions", wireType)
+			}
+			m.RemainingExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionsPerWindow", wireType)
+			}
+			m.MaxExecutionsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowExecutions", wireType)
+			}
+			m.WindowExecutions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowExecutions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
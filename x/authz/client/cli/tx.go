@@ -28,9 +28,15 @@ const (
 	FlagAllowedValidators = "allowed-validators"
 	FlagDenyValidators    = "deny-validators"
 	FlagAllowList         = "allow-list"
-	delegate              = "delegate"
-	redelegate            = "redelegate"
-	unbond                = "unbond"
+	// FlagMaxExecutions, FlagWindowBlocks, FlagMaxExecutionsPerWindow and
+	// FlagAllowedRecipients wrap the authorization in a LimitedAuthorization.
+	FlagMaxExecutions          = "max-executions"
+	FlagWindowBlocks           = "window-blocks"
+	FlagMaxExecutionsPerWindow = "max-executions-per-window"
+	FlagAllowedRecipients      = "allowed-recipients"
+	delegate                   = "delegate"
+	redelegate                 = "redelegate"
+	unbond                     = "unbond"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -101,6 +107,7 @@ func NewCmdGrantAuthorization() *cobra.Command {
 Examples:
  $ %[1]s tx authz grant cosmos1skjw.. send --spend-limit=1000stake --from=cosmos1skl..
  $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.gov.v1.MsgVote --from=cosmos1sk..
+ $ %[1]s tx authz grant cosmos1skjw.. generic --msg-type=/cosmos.bank.v1beta1.MsgSend --max-executions=10 --window-blocks=100 --max-executions-per-window=2 --allowed-recipients=cosmos1skl.. --from=cosmos1sk..
 	`, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -233,6 +240,11 @@ Examples:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
 
+			authorization, err = limitAuthorization(cmd, authorization)
+			if err != nil {
+				return err
+			}
+
 			expire, err := getExpireTime(cmd)
 			if err != nil {
 				return err
@@ -253,9 +265,48 @@ Examples:
 	cmd.Flags().StringSlice(FlagDenyValidators, []string{}, "Deny validators addresses separated by ,")
 	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to send funds separated by ,")
 	cmd.Flags().Int64(FlagExpiration, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry. Default is 0.")
+	cmd.Flags().Uint64(FlagMaxExecutions, 0, "Maximum number of executions of the grant. Set zero (0) for no limit.")
+	cmd.Flags().Uint64(FlagWindowBlocks, 0, "Length in blocks of the rate limiting window, used with --max-executions-per-window")
+	cmd.Flags().Uint64(FlagMaxExecutionsPerWindow, 0, "Maximum number of executions of the grant per window of blocks")
+	cmd.Flags().StringSlice(FlagAllowedRecipients, []string{}, "Allowed recipients of the bank sends or validators of the staking msgs separated by ,")
 	return cmd
 }
 
+// limitAuthorization wraps the authorization in a LimitedAuthorization if any
+// of its limits is set.
+func limitAuthorization(cmd *cobra.Command, authorization authz.Authorization) (authz.Authorization, error) {
+	maxExecutions, err := cmd.Flags().GetUint64(FlagMaxExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	windowBlocks, err := cmd.Flags().GetUint64(FlagWindowBlocks)
+	if err != nil {
+		return nil, err
+	}
+
+	maxExecutionsPerWindow, err := cmd.Flags().GetUint64(FlagMaxExecutionsPerWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedRecipients, err := cmd.Flags().GetStringSlice(FlagAllowedRecipients)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxExecutions == 0 && windowBlocks == 0 && maxExecutionsPerWindow == 0 && len(allowedRecipients) == 0 {
+		return authorization, nil
+	}
+
+	limited, err := authz.NewLimitedAuthorization(authorization, maxExecutions, windowBlocks, maxExecutionsPerWindow, allowedRecipients)
+	if err != nil {
+		return nil, err
+	}
+
+	return limited, limited.ValidateBasic()
+}
+
 func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	exp, err := cmd.Flags().GetInt64(FlagExpiration)
 	if err != nil {
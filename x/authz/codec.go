@@ -20,6 +20,7 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 	cdc.RegisterConcrete(&GenericAuthorization{}, "cosmos-sdk/GenericAuthorization")
+	cdc.RegisterConcrete(&LimitedAuthorization{}, "cosmos-sdk/LimitedAuthorization")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -38,6 +39,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		"cosmos.authz.v1beta1.Authorization",
 		(*Authorization)(nil),
 		&GenericAuthorization{},
+		&LimitedAuthorization{},
 		&bank.SendAuthorization{},
 		&staking.StakeAuthorization{},
 	)
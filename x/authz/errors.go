@@ -20,4 +20,6 @@ var (
 	ErrAuthorizationNumOfSigners = errors.Register(ModuleName, 9, "authorization can be given to msg with only one signer")
 	// ErrNegativeMaxTokens error if the max tokens is negative
 	ErrNegativeMaxTokens = errors.Register(ModuleName, 12, "max tokens should be positive")
+	// ErrRateLimitExceeded error if the executions of an authorization exceed its rate limit
+	ErrRateLimitExceeded = errors.Register(ModuleName, 13, "authorization rate limit exceeded")
 )
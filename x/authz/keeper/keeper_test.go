@@ -433,6 +433,58 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDispatchLimitedAuthorization() {
+	require := s.Require()
+	granterAddr, granteeAddr := s.addrs[0], s.addrs[1]
+	granterStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granterAddr)
+	require.NoError(err)
+	granteeStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(granteeAddr)
+	require.NoError(err)
+	recipientStrAddr, err := s.accountKeeper.AddressCodec().BytesToString(s.addrs[2])
+	require.NoError(err)
+
+	a, err := authz.NewLimitedAuthorization(&banktypes.SendAuthorization{SpendLimit: coins100}, 2, 10, 1, []string{recipientStrAddr})
+	require.NoError(err)
+	require.NoError(s.authzKeeper.SaveGrant(s.ctx, granteeAddr, granterAddr, a, nil))
+
+	msgs := []sdk.Msg{&banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: recipientStrAddr}}
+	ctx := s.ctx.WithHeaderInfo(header.Info{Height: 1, Time: s.ctx.HeaderInfo().Time})
+	_, err = s.authzKeeper.DispatchActions(ctx, granteeAddr, msgs)
+	require.NoError(err)
+
+	s.T().Log("verify the remaining quota is visible in the grants query")
+	res, err := s.queryClient.Grants(ctx, &authz.QueryGrantsRequest{Granter: granterStrAddr, Grantee: granteeStrAddr})
+	require.NoError(err)
+	require.Len(res.Grants, 1)
+	var auth authz.Authorization
+	require.NoError(s.encCfg.InterfaceRegistry.UnpackAny(res.Grants[0].Authorization, &auth))
+	limited, ok := auth.(*authz.LimitedAuthorization)
+	require.True(ok)
+	require.Equal(uint64(1), limited.RemainingExecutions)
+	require.Equal(int64(1), limited.WindowStartHeight)
+	require.Equal(uint64(1), limited.WindowExecutions)
+	send, err := limited.GetAuthorization()
+	require.NoError(err)
+	require.Equal(coins100.Sub(coins10...), send.(*banktypes.SendAuthorization).SpendLimit)
+
+	s.T().Log("verify the rate limit")
+	_, err = s.authzKeeper.DispatchActions(ctx.WithHeaderInfo(header.Info{Height: 10, Time: ctx.HeaderInfo().Time}), granteeAddr, msgs)
+	require.ErrorIs(err, authz.ErrRateLimitExceeded)
+
+	s.T().Log("verify the recipients")
+	_, err = s.authzKeeper.DispatchActions(ctx.WithHeaderInfo(header.Info{Height: 11, Time: ctx.HeaderInfo().Time}), granteeAddr, []sdk.Msg{
+		&banktypes.MsgSend{Amount: coins10, FromAddress: granterStrAddr, ToAddress: granteeStrAddr},
+	})
+	require.ErrorContains(err, "cannot execute")
+
+	s.T().Log("verify the grant is removed after the last execution")
+	_, err = s.authzKeeper.DispatchActions(ctx.WithHeaderInfo(header.Info{Height: 11, Time: ctx.HeaderInfo().Time}), granteeAddr, msgs)
+	require.NoError(err)
+	authorizations, err := s.authzKeeper.GetAuthorizations(ctx, granteeAddr, granterAddr)
+	require.NoError(err)
+	require.Len(authorizations, 0)
+}
+
 func (s *TestSuite) TestDequeueAllGrantsQueue() {
 	require := s.Require()
 	addrs := s.addrs
@@ -0,0 +1,246 @@
+package authz
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	errorsmod "cosmossdk.io/errors"
+	bank "cosmossdk.io/x/bank/types"
+	staking "cosmossdk.io/x/staking/types"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/authz"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// gasCostPerRecipient is the gas consumed per allowed recipient checked.
+const gasCostPerRecipient = uint64(10)
+
+// recipientGetters returns the recipients of the msgs which can be restricted
+// by the allowed recipients of a LimitedAuthorization, by msg type URL.
+var recipientGetters = map[string]func(sdk.Msg) []string{
+	sdk.MsgTypeURL(&bank.MsgSend{}): func(msg sdk.Msg) []string {
+		return []string{msg.(*bank.MsgSend).ToAddress}
+	},
+	sdk.MsgTypeURL(&bank.MsgMultiSend{}): func(msg sdk.Msg) []string {
+		outputs := msg.(*bank.MsgMultiSend).Outputs
+		recipients := make([]string, len(outputs))
+		for i, output := range outputs {
+			recipients[i] = output.Address
+		}
+		return recipients
+	},
+	sdk.MsgTypeURL(&staking.MsgDelegate{}): func(msg sdk.Msg) []string {
+		return []string{msg.(*staking.MsgDelegate).ValidatorAddress}
+	},
+	sdk.MsgTypeURL(&staking.MsgUndelegate{}): func(msg sdk.Msg) []string {
+		return []string{msg.(*staking.MsgUndelegate).ValidatorAddress}
+	},
+	sdk.MsgTypeURL(&staking.MsgBeginRedelegate{}): func(msg sdk.Msg) []string {
+		return []string{msg.(*staking.MsgBeginRedelegate).ValidatorDstAddress}
+	},
+	sdk.MsgTypeURL(&staking.MsgCancelUnbondingDelegation{}): func(msg sdk.Msg) []string {
+		return []string{msg.(*staking.MsgCancelUnbondingDelegation).ValidatorAddress}
+	},
+}
+
+var _ cdctypes.UnpackInterfacesMessage = &LimitedAuthorization{}
+
+// NewLimitedAuthorization creates a new LimitedAuthorization wrapping the
+// provided authorization. maxExecutions limits the total number of executions,
+// and maxExecutionsPerWindow the number of executions per windowBlocks blocks.
+// Zero values disable the corresponding limit.
+func NewLimitedAuthorization(
+	authorization Authorization,
+	maxExecutions, windowBlocks, maxExecutionsPerWindow uint64,
+	allowedRecipients []string,
+) (*LimitedAuthorization, error) {
+	a := &LimitedAuthorization{
+		RemainingExecutions:    maxExecutions,
+		WindowBlocks:           windowBlocks,
+		MaxExecutionsPerWindow: maxExecutionsPerWindow,
+		AllowedRecipients:      allowedRecipients,
+	}
+	if err := a.setAuthorization(authorization); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// GetAuthorization returns the cached value of the wrapped authorization.
+func (a LimitedAuthorization) GetAuthorization() (Authorization, error) {
+	if a.Authorization == nil {
+		return nil, sdkerrors.ErrInvalidType.Wrap("authorization is nil")
+	}
+	av := a.Authorization.GetCachedValue()
+	authorization, ok := av.(Authorization)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), av)
+	}
+	return authorization, nil
+}
+
+func (a *LimitedAuthorization) setAuthorization(authorization Authorization) error {
+	msg, ok := authorization.(proto.Message)
+	if !ok {
+		return sdkerrors.ErrPackAny.Wrapf("cannot proto marshal %T", authorization)
+	}
+	any, err := cdctypes.NewAnyWithValue(msg)
+	if err != nil {
+		return err
+	}
+	a.Authorization = any
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a LimitedAuthorization) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	var authorization Authorization
+	return unpacker.UnpackAny(a.Authorization, &authorization)
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a LimitedAuthorization) MsgTypeURL() string {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return ""
+	}
+	return authorization.MsgTypeURL()
+}
+
+// Accept implements Authorization.Accept. The msg must be accepted by the
+// wrapped authorization too, and the grant is deleted once either of them is
+// exhausted.
+func (a LimitedAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	authzEnv, ok := ctx.Value(corecontext.EnvironmentContextKey).(appmodule.Environment)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("environment not set")
+	}
+
+	if len(a.AllowedRecipients) > 0 {
+		getRecipients, ok := recipientGetters[sdk.MsgTypeURL(msg)]
+		if !ok {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot restrict the recipients of %s", sdk.MsgTypeURL(msg))
+		}
+
+		for _, recipient := range getRecipients(msg) {
+			if err := authzEnv.GasService.GasMeter(ctx).Consume(gasCostPerRecipient*uint64(len(a.AllowedRecipients)), "limited authorization"); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+			if !slices.Contains(a.AllowedRecipients, recipient) {
+				return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("cannot execute %s for recipient %s", sdk.MsgTypeURL(msg), recipient)
+			}
+		}
+	}
+
+	updated := a
+	if a.WindowBlocks > 0 {
+		// a window starts with the first execution following the previous window
+		height := authzEnv.HeaderService.HeaderInfo(ctx).Height
+		if a.WindowExecutions == 0 || height < a.WindowStartHeight || height >= a.WindowStartHeight+int64(a.WindowBlocks) {
+			updated.WindowStartHeight = height
+			updated.WindowExecutions = 0
+		}
+		if updated.WindowExecutions >= a.MaxExecutionsPerWindow {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(ErrRateLimitExceeded,
+				"%d executions per %d blocks, next window starts at height %d", a.MaxExecutionsPerWindow, a.WindowBlocks, updated.WindowStartHeight+int64(a.WindowBlocks))
+		}
+		updated.WindowExecutions++
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if resp.Delete {
+		return resp, nil
+	}
+	if resp.Updated != nil {
+		inner, ok := resp.Updated.(Authorization)
+		if !ok {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", (Authorization)(nil), resp.Updated)
+		}
+		authorization = inner
+	}
+	if !resp.Accept {
+		// keep the counters unchanged, but the updates of the wrapped authorization
+		if resp.Updated != nil {
+			updated = a
+			if err := updated.setAuthorization(authorization); err != nil {
+				return authz.AcceptResponse{}, err
+			}
+			resp.Updated = &updated
+		}
+		return resp, nil
+	}
+
+	if a.RemainingExecutions > 0 {
+		updated.RemainingExecutions--
+		if updated.RemainingExecutions == 0 {
+			return authz.AcceptResponse{Accept: true, Delete: true}, nil
+		}
+	}
+
+	if err := updated.setAuthorization(authorization); err != nil {
+		return authz.AcceptResponse{}, err
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a LimitedAuthorization) ValidateBasic() error {
+	authorization, err := a.GetAuthorization()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := authorization.(*LimitedAuthorization); ok {
+		return sdkerrors.ErrInvalidType.Wrap("limited authorizations cannot be nested")
+	}
+
+	if err := authorization.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if (a.WindowBlocks == 0) != (a.MaxExecutionsPerWindow == 0) {
+		return sdkerrors.ErrInvalidRequest.Wrap("window blocks and max executions per window must be set together")
+	}
+
+	if a.WindowExecutions > a.MaxExecutionsPerWindow {
+		return sdkerrors.ErrInvalidRequest.Wrap("window executions cannot exceed max executions per window")
+	}
+
+	if a.RemainingExecutions == 0 && a.WindowBlocks == 0 && len(a.AllowedRecipients) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("at least one limit must be set")
+	}
+
+	if len(a.AllowedRecipients) > 0 {
+		if _, ok := recipientGetters[authorization.MsgTypeURL()]; !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("cannot restrict the recipients of %s", authorization.MsgTypeURL())
+		}
+	}
+
+	found := make(map[string]bool, len(a.AllowedRecipients))
+	for _, recipient := range a.AllowedRecipients {
+		if recipient == "" {
+			return sdkerrors.ErrInvalidAddress.Wrap("allowed recipient cannot be empty")
+		}
+		if found[recipient] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate allowed recipient %s", recipient)
+		}
+		found[recipient] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/appmodule/v2"
+	corecontext "cosmossdk.io/core/context"
+	coregas "cosmossdk.io/core/gas"
+	coreheader "cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/authz"
+	banktypes "cosmossdk.io/x/bank/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	fromAddrStr = "cosmos1ta047h6lveex7mfqta047h6ln9jal0"
+	toAddrStr   = "cosmos1ta047h6lta0hgm6lta047h6lta0stgm2m3"
+	otherAddr   = "cosmos1ta047h6lw4hxkmn0wah97h6lta0sml880l"
+)
+
+type headerService struct{}
+
+func (h headerService) HeaderInfo(ctx context.Context) coreheader.Info {
+	return sdk.UnwrapSDKContext(ctx).HeaderInfo()
+}
+
+type mockGasService struct {
+	coregas.Service
+}
+
+func (m mockGasService) GasMeter(ctx context.Context) coregas.Meter {
+	return mockGasMeter{}
+}
+
+type mockGasMeter struct {
+	coregas.Meter
+}
+
+func (m mockGasMeter) Consume(amount coregas.Gas, descriptor string) error {
+	return nil
+}
+
+func TestLimitedAuthorizationValidateBasic(t *testing.T) {
+	generic := authz.NewGenericAuthorization(banktypes.SendAuthorization{}.MsgTypeURL())
+	vote := authz.NewGenericAuthorization("/cosmos.gov.v1.MsgVote")
+	nested, err := authz.NewLimitedAuthorization(generic, 1, 0, 0, nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name              string
+		authorization     authz.Authorization
+		maxExecutions     uint64
+		windowBlocks      uint64
+		maxPerWindow      uint64
+		allowedRecipients []string
+		expErr            string
+	}{
+		{"valid max executions", generic, 3, 0, 0, nil, ""},
+		{"valid rate limit", generic, 0, 10, 2, nil, ""},
+		{"valid allowed recipients", generic, 0, 0, 0, []string{toAddrStr}, ""},
+		{"invalid wrapped authorization", authz.NewGenericAuthorization(""), 1, 0, 0, nil, "msg type cannot be empty"},
+		{"nested", nested, 1, 0, 0, nil, "cannot be nested"},
+		{"no limit", generic, 0, 0, 0, nil, "at least one limit must be set"},
+		{"window without max executions", generic, 0, 10, 0, nil, "must be set together"},
+		{"max executions without window", generic, 0, 0, 2, nil, "must be set together"},
+		{"duplicate recipient", generic, 0, 0, 0, []string{toAddrStr, toAddrStr}, "duplicate allowed recipient"},
+		{"unsupported recipients", vote, 0, 0, 0, []string{toAddrStr}, "cannot restrict the recipients"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := authz.NewLimitedAuthorization(tc.authorization, tc.maxExecutions, tc.windowBlocks, tc.maxPerWindow, tc.allowedRecipients)
+			require.NoError(t, err)
+			require.Equal(t, tc.authorization.MsgTypeURL(), a.MsgTypeURL())
+
+			err = a.ValidateBasic()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestLimitedAuthorizationAccept(t *testing.T) {
+	sdkCtx := testutil.DefaultContextWithDB(t, storetypes.NewKVStoreKey(authz.ModuleName), storetypes.NewTransientStoreKey("transient_test")).Ctx
+	ctxAt := func(height int64) context.Context {
+		return context.WithValue(sdkCtx.WithHeaderInfo(coreheader.Info{Height: height}), corecontext.EnvironmentContextKey, appmodule.Environment{
+			HeaderService: headerService{},
+			GasService:    mockGasService{},
+		})
+	}
+	send := banktypes.NewMsgSend(fromAddrStr, toAddrStr, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	t.Log("verify the max executions")
+	a, err := authz.NewLimitedAuthorization(authz.NewGenericAuthorization(sdk.MsgTypeURL(send)), 2, 0, 0, nil)
+	require.NoError(t, err)
+	resp, err := a.Accept(ctxAt(1), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	a = resp.Updated.(*authz.LimitedAuthorization)
+	require.Equal(t, uint64(1), a.RemainingExecutions)
+	resp, err = a.Accept(ctxAt(1), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	t.Log("verify the rate limit")
+	a, err = authz.NewLimitedAuthorization(authz.NewGenericAuthorization(sdk.MsgTypeURL(send)), 0, 10, 2, nil)
+	require.NoError(t, err)
+	for _, height := range []int64{5, 14} {
+		resp, err = a.Accept(ctxAt(height), send)
+		require.NoError(t, err)
+		require.True(t, resp.Accept)
+		a = resp.Updated.(*authz.LimitedAuthorization)
+	}
+	require.Equal(t, int64(5), a.WindowStartHeight)
+	require.Equal(t, uint64(2), a.WindowExecutions)
+	_, err = a.Accept(ctxAt(14), send)
+	require.ErrorIs(t, err, authz.ErrRateLimitExceeded)
+	resp, err = a.Accept(ctxAt(15), send)
+	require.NoError(t, err)
+	a = resp.Updated.(*authz.LimitedAuthorization)
+	require.Equal(t, int64(15), a.WindowStartHeight)
+	require.Equal(t, uint64(1), a.WindowExecutions)
+
+	t.Log("verify the allowed recipients")
+	a, err = authz.NewLimitedAuthorization(authz.NewGenericAuthorization(sdk.MsgTypeURL(send)), 0, 0, 0, []string{otherAddr})
+	require.NoError(t, err)
+	_, err = a.Accept(ctxAt(1), send)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	a.AllowedRecipients = []string{toAddrStr}
+	resp, err = a.Accept(ctxAt(1), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	t.Log("verify the wrapped send authorization is updated and deletes the grant once exhausted")
+	a, err = authz.NewLimitedAuthorization(&banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 20))}, 5, 0, 0, nil)
+	require.NoError(t, err)
+	resp, err = a.Accept(ctxAt(1), send)
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	a = resp.Updated.(*authz.LimitedAuthorization)
+	require.Equal(t, uint64(4), a.RemainingExecutions)
+	inner, err := a.GetAuthorization()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), inner.(*banktypes.SendAuthorization).SpendLimit)
+	resp, err = a.Accept(ctxAt(1), send)
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+}
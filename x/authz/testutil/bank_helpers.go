@@ -27,3 +27,23 @@ func (k MockBankKeeper) UpdateParams(ctx context.Context, req *bank.MsgUpdatePar
 func (k MockBankKeeper) SetSendEnabled(ctx context.Context, req *bank.MsgSetSendEnabled) (*bank.MsgSetSendEnabledResponse, error) {
 	return nil, nil
 }
+
+func (k MockBankKeeper) CreateDenom(ctx context.Context, req *bank.MsgCreateDenom) (*bank.MsgCreateDenomResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) Mint(ctx context.Context, req *bank.MsgMint) (*bank.MsgMintResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ForceTransfer(ctx context.Context, req *bank.MsgForceTransfer) (*bank.MsgForceTransferResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) ChangeAdmin(ctx context.Context, req *bank.MsgChangeAdmin) (*bank.MsgChangeAdminResponse, error) {
+	return nil, nil
+}
+
+func (k MockBankKeeper) SetDenomSendHook(ctx context.Context, req *bank.MsgSetDenomSendHook) (*bank.MsgSetDenomSendHookResponse, error) {
+	return nil, nil
+}
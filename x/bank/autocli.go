@@ -87,6 +87,18 @@ To look up all denoms, do not provide any arguments.`,
 					),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denoms", Varargs: true}},
 				},
+				{
+					RpcMethod:      "DenomAuthorityMetadata",
+					Use:            "denom-authority-metadata [denom]",
+					Short:          "Query the authority metadata of a token factory denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod:      "DenomsFromCreator",
+					Use:            "denoms-from-creator [creator]",
+					Short:          "Query the token factory denoms created by an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -128,6 +140,38 @@ Note: multiple coins can be send by space separated.`,
 					},
 					GovProposal: true,
 				},
+				{
+					RpcMethod:      "CreateDenom",
+					Use:            "create-denom [subdenom] --from [sender]",
+					Short:          "Create the factory/{sender}/{subdenom} token factory denom, paying the denom creation fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "subdenom"}},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [amount] --from [admin]",
+					Short:          "Mint coins of a token factory denom, to the admin or to the --mint-to-address account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ForceTransfer",
+					Use:            "force-transfer [amount] [transfer_from_address] [transfer_to_address] --from [admin]",
+					Short:          "Transfer coins of a token factory denom from any account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "transfer_from_address"}, {ProtoField: "transfer_to_address"}},
+				},
+				{
+					RpcMethod:      "ChangeAdmin",
+					Use:            "change-admin [denom] [new_admin] --from [admin]",
+					Short:          "Change the admin of a token factory denom",
+					Long:           "Change the admin of a token factory denom. An empty new admin renounces the administration of the denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "new_admin"}},
+				},
+				{
+					RpcMethod:      "SetDenomSendHook",
+					Use:            "set-denom-send-hook [denom] [send_hook] --from [admin]",
+					Short:          "Set the send hook restricting the transfers of a token factory denom",
+					Long:           "Set the send hook restricting the transfers of a token factory denom. An empty send hook removes the send hook of the denom.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}, {ProtoField: "send_hook"}},
+				},
 			},
 		},
 	}
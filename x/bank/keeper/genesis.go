@@ -57,6 +57,12 @@ func (k BaseKeeper) InitGenesis(ctx context.Context, genState *types.GenesisStat
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, factoryDenom := range genState.FactoryDenoms {
+		if err := k.setDenomAuthorityMetadata(ctx, factoryDenom.Denom, factoryDenom.AuthorityMetadata); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -74,5 +80,18 @@ func (k BaseKeeper) ExportGenesis(ctx context.Context) (*types.GenesisState, err
 		k.GetAllDenomMetaData(ctx),
 		k.GetAllSendEnabledEntries(ctx),
 	)
+
+	err = k.DenomAuthorities.Walk(ctx, nil, func(key collections.Pair[string, string], metadata types.DenomAuthorityMetadata) (bool, error) {
+		denom, err := types.GetFactoryDenom(key.K1(), key.K2())
+		if err != nil {
+			return true, err
+		}
+		rv.FactoryDenoms = append(rv.FactoryDenoms, types.FactoryDenom{Denom: denom, AuthorityMetadata: metadata})
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return rv, nil
 }
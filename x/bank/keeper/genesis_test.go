@@ -84,6 +84,26 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *KeeperTestSuite) TestFactoryDenomsGenesis() {
+	admin, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+
+	denom := suite.createFactoryDenom(accAddrs[0], "token")
+	suite.Require().NoError(suite.bankKeeper.ChangeAdmin(suite.ctx, accAddrs[0], denom, admin))
+
+	exportGenesis, err := suite.bankKeeper.ExportGenesis(suite.ctx)
+	suite.Require().NoError(err)
+	expected := []types.FactoryDenom{{Denom: denom, AuthorityMetadata: types.DenomAuthorityMetadata{Admin: admin}}}
+	suite.Require().Equal(expected, exportGenesis.FactoryDenoms)
+	suite.Require().NoError(exportGenesis.Validate())
+
+	suite.SetupTest()
+	suite.Require().NoError(suite.bankKeeper.InitGenesis(suite.ctx, exportGenesis))
+	authority, err := suite.bankKeeper.GetDenomAuthorityMetadata(suite.ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(admin, authority.Admin)
+}
+
 func (suite *KeeperTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
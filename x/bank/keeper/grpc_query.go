@@ -351,3 +351,47 @@ func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDeno
 
 	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: resp.DenomOwners, Pagination: resp.Pagination}, nil
 }
+
+// DenomAuthorityMetadata implements the Query/DenomAuthorityMetadata gRPC method
+func (k BaseKeeper) DenomAuthorityMetadata(ctx context.Context, req *types.QueryDenomAuthorityMetadataRequest) (*types.QueryDenomAuthorityMetadataResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, _, err := types.ParseFactoryDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryDenomAuthorityMetadataResponse{AuthorityMetadata: metadata}, nil
+}
+
+// DenomsFromCreator implements the Query/DenomsFromCreator gRPC method
+func (k BaseKeeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFromCreatorRequest) (*types.QueryDenomsFromCreatorResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := k.ak.AddressCodec().StringToBytes(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denoms, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.DenomAuthorities,
+		req.Pagination,
+		func(key collections.Pair[string, string], _ types.DenomAuthorityMetadata) (string, error) {
+			return types.GetFactoryDenom(key.K1(), key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Creator),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms, Pagination: pageRes}, nil
+}
@@ -926,3 +926,39 @@ func (suite *KeeperTestSuite) TestGRPCDenomOwnersByQuery() {
 
 	suite.Require().True(true)
 }
+
+func (suite *KeeperTestSuite) TestQueryFactoryDenoms() {
+	creator, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	otherCreator, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+
+	denom1 := suite.createFactoryDenom(accAddrs[0], "token1")
+	denom2 := suite.createFactoryDenom(accAddrs[0], "token2")
+	suite.createFactoryDenom(accAddrs[1], "token1")
+
+	res, err := suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: denom1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DenomAuthorityMetadata{Admin: creator}, res.AuthorityMetadata)
+
+	_, err = suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: fooDenom})
+	suite.Require().ErrorContains(err, "invalid token factory denom")
+	_, err = suite.queryClient.DenomAuthorityMetadata(gocontext.Background(), &types.QueryDenomAuthorityMetadataRequest{Denom: "factory/" + creator + "/token3"})
+	suite.Require().ErrorContains(err, "does not exist")
+
+	denomsRes, err := suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: creator})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom1, denom2}, denomsRes.Denoms)
+
+	denomsRes, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: creator, Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denom1}, denomsRes.Denoms)
+	suite.Require().Equal(uint64(2), denomsRes.Pagination.Total)
+
+	denomsRes, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: otherCreator})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{"factory/" + otherCreator + "/token1"}, denomsRes.Denoms)
+
+	_, err = suite.queryClient.DenomsFromCreator(gocontext.Background(), &types.QueryDenomsFromCreatorRequest{Creator: "invalid"})
+	suite.Require().Error(err)
+}
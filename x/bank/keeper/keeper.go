@@ -50,6 +50,14 @@ type Keeper interface {
 	DelegateCoins(ctx context.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error
 	UndelegateCoins(ctx context.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error
 
+	CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error)
+	MintFactoryCoins(ctx context.Context, admin, recipient sdk.AccAddress, amount sdk.Coin) error
+	ForceTransfer(ctx context.Context, admin, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin) error
+	ChangeAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error
+	SetDenomSendHook(ctx context.Context, admin sdk.AccAddress, denom, sendHook string) error
+	GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error)
+	RegisterFactorySendHook(name string, hook types.SendRestrictionFn)
+
 	types.QueryServer
 }
 
@@ -61,6 +69,9 @@ type BaseKeeper struct {
 	ak                     types.AccountKeeper
 	cdc                    codec.BinaryCodec
 	mintCoinsRestrictionFn types.MintingRestrictionFn
+
+	// factorySendHooks are the send hooks the token factory denoms can opt in to, by name.
+	factorySendHooks map[string]types.SendRestrictionFn
 }
 
 // GetPaginatedTotalSupply queries for the supply, ignoring 0 coins, with a given pagination
@@ -101,6 +112,7 @@ func NewBaseKeeper(
 		ak:                     ak,
 		cdc:                    cdc,
 		mintCoinsRestrictionFn: types.NoOpMintingRestrictionFn,
+		factorySendHooks:       make(map[string]types.SendRestrictionFn),
 	}
 }
 
@@ -1,13 +1,22 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
+
+	"github.com/golang/mock/gomock"
+
 	authtypes "cosmossdk.io/x/auth/types"
+	banktestutil "cosmossdk.io/x/bank/testutil"
 	banktypes "cosmossdk.io/x/bank/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var govAcc = authtypes.NewEmptyModuleAccount(banktypes.GovModuleName, authtypes.Minter)
+var (
+	govAcc  = authtypes.NewEmptyModuleAccount(banktypes.GovModuleName, authtypes.Minter)
+	poolAcc = authtypes.NewEmptyModuleAccount(banktypes.ProtocolPoolModuleName)
+)
 
 func (suite *KeeperTestSuite) TestMsgUpdateParams() {
 	// default params
@@ -440,3 +449,317 @@ func (suite *KeeperTestSuite) TestMsgBurn() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgCreateDenom() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10))
+	params := suite.bankKeeper.GetParams(suite.ctx)
+	params.DenomCreationFee = fee
+	suite.Require().NoError(suite.bankKeeper.SetParams(suite.ctx, params))
+
+	suite.mockFundAccount(accAddrs[0])
+	suite.Require().NoError(banktestutil.FundAccount(suite.ctx, suite.bankKeeper, accAddrs[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))))
+	suite.authKeeper.EXPECT().GetModuleAccount(suite.ctx, banktypes.ProtocolPoolModuleName).Return(poolAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, gomock.Any()).Return(nil).AnyTimes()
+
+	creator, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	poorCreator, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgCreateDenom
+		expErrMsg string
+	}{
+		{
+			name:      "invalid sender",
+			input:     banktypes.NewMsgCreateDenom("invalid", "token"),
+			expErrMsg: "invalid sender address",
+		},
+		{
+			name:      "invalid subdenom",
+			input:     banktypes.NewMsgCreateDenom(creator, "to/ken"),
+			expErrMsg: "invalid subdenom",
+		},
+		{
+			name:      "creation fee not paid",
+			input:     banktypes.NewMsgCreateDenom(poorCreator, "token"),
+			expErrMsg: "unable to pay the denom creation fee",
+		},
+		{
+			name:  "all good",
+			input: banktypes.NewMsgCreateDenom(creator, "token"),
+		},
+		{
+			name:      "denom already exists",
+			input:     banktypes.NewMsgCreateDenom(creator, "token"),
+			expErrMsg: "denom already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			res, err := suite.msgServer.CreateDenom(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal("factory/"+creator+"/token", res.NewTokenDenom)
+		})
+	}
+
+	denom := "factory/" + creator + "/token"
+	authority, err := suite.bankKeeper.GetDenomAuthorityMetadata(suite.ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(banktypes.DenomAuthorityMetadata{Admin: creator}, authority)
+
+	metadata, found := suite.bankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(banktypes.FactoryDenomMetadata(denom, "token"), metadata)
+
+	// the creation fee is sent to the community pool
+	suite.Require().Equal(fee, suite.bankKeeper.GetAllBalances(suite.ctx, poolAcc.GetAddress()))
+	suite.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 90), suite.bankKeeper.GetBalance(suite.ctx, accAddrs[0], sdk.DefaultBondDenom))
+}
+
+// createFactoryDenom creates the factory/{creator}/{subdenom} denom without creation fee.
+func (suite *KeeperTestSuite) createFactoryDenom(creator sdk.AccAddress, subdenom string) string {
+	denom, err := suite.bankKeeper.CreateDenom(suite.ctx, creator, subdenom)
+	suite.Require().NoError(err)
+	return denom
+}
+
+func (suite *KeeperTestSuite) TestMsgMint() {
+	admin, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	other, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+	blocked, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[4])
+	suite.Require().NoError(err)
+
+	denom := suite.createFactoryDenom(accAddrs[0], "token")
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgMint
+		expErrMsg string
+	}{
+		{
+			name:      "invalid amount",
+			input:     banktypes.NewMsgMint(admin, sdk.NewInt64Coin(denom, 0), ""),
+			expErrMsg: "invalid coins",
+		},
+		{
+			name:      "not a factory denom",
+			input:     banktypes.NewMsgMint(admin, sdk.NewInt64Coin(fooDenom, 10), ""),
+			expErrMsg: "invalid token factory denom",
+		},
+		{
+			name:      "unknown factory denom",
+			input:     banktypes.NewMsgMint(admin, sdk.NewInt64Coin("factory/"+admin+"/other", 10), ""),
+			expErrMsg: "does not exist",
+		},
+		{
+			name:      "not the admin",
+			input:     banktypes.NewMsgMint(other, sdk.NewInt64Coin(denom, 10), ""),
+			expErrMsg: "unauthorized token factory denom admin",
+		},
+		{
+			name:      "blocked recipient",
+			input:     banktypes.NewMsgMint(admin, sdk.NewInt64Coin(denom, 10), blocked),
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name:  "mint to admin",
+			input: banktypes.NewMsgMint(admin, sdk.NewInt64Coin(denom, 10), ""),
+		},
+		{
+			name:  "mint to other address",
+			input: banktypes.NewMsgMint(admin, sdk.NewInt64Coin(denom, 5), other),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.Mint(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 10), suite.bankKeeper.GetBalance(suite.ctx, accAddrs[0], denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 5), suite.bankKeeper.GetBalance(suite.ctx, accAddrs[1], denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 15), suite.bankKeeper.GetSupply(suite.ctx, denom))
+}
+
+func (suite *KeeperTestSuite) TestMsgForceTransfer() {
+	admin, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	holderAddr, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+	recipient, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[2])
+	suite.Require().NoError(err)
+	moduleAddr, err := suite.authKeeper.AddressCodec().BytesToString(holderAcc.GetAddress())
+	suite.Require().NoError(err)
+
+	denom := suite.createFactoryDenom(accAddrs[0], "token")
+	suite.Require().NoError(suite.bankKeeper.MintFactoryCoins(suite.ctx, accAddrs[0], accAddrs[1], sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(suite.bankKeeper.MintFactoryCoins(suite.ctx, accAddrs[0], holderAcc.GetAddress(), sdk.NewInt64Coin(denom, 10)))
+
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, holderAcc.GetAddress()).Return(holderAcc).AnyTimes()
+	suite.authKeeper.EXPECT().GetAccount(suite.ctx, gomock.Any()).Return(nil).AnyTimes()
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgForceTransfer
+		expErrMsg string
+	}{
+		{
+			name:      "not the admin",
+			input:     banktypes.NewMsgForceTransfer(holderAddr, sdk.NewInt64Coin(denom, 5), holderAddr, recipient),
+			expErrMsg: "unauthorized token factory denom admin",
+		},
+		{
+			name:      "from module account",
+			input:     banktypes.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 5), moduleAddr, recipient),
+			expErrMsg: "cannot force transfer from module account",
+		},
+		{
+			name:      "insufficient funds",
+			input:     banktypes.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 11), holderAddr, recipient),
+			expErrMsg: "insufficient funds",
+		},
+		{
+			name:  "all good",
+			input: banktypes.NewMsgForceTransfer(admin, sdk.NewInt64Coin(denom, 5), holderAddr, recipient),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.ForceTransfer(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 5), suite.bankKeeper.GetBalance(suite.ctx, accAddrs[1], denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 5), suite.bankKeeper.GetBalance(suite.ctx, accAddrs[2], denom))
+}
+
+func (suite *KeeperTestSuite) TestMsgChangeAdmin() {
+	admin, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+	newAdmin, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[1])
+	suite.Require().NoError(err)
+
+	denom := suite.createFactoryDenom(accAddrs[0], "token")
+
+	testCases := []struct {
+		name      string
+		input     *banktypes.MsgChangeAdmin
+		expAdmin  string
+		expErrMsg string
+	}{
+		{
+			name:      "not the admin",
+			input:     banktypes.NewMsgChangeAdmin(newAdmin, denom, newAdmin),
+			expErrMsg: "unauthorized token factory denom admin",
+		},
+		{
+			name:      "invalid new admin",
+			input:     banktypes.NewMsgChangeAdmin(admin, denom, "invalid"),
+			expErrMsg: "invalid new admin address",
+		},
+		{
+			name:     "change admin",
+			input:    banktypes.NewMsgChangeAdmin(admin, denom, newAdmin),
+			expAdmin: newAdmin,
+		},
+		{
+			name:      "previous admin",
+			input:     banktypes.NewMsgChangeAdmin(admin, denom, admin),
+			expErrMsg: "unauthorized token factory denom admin",
+		},
+		{
+			name:     "renounce administration",
+			input:    banktypes.NewMsgChangeAdmin(newAdmin, denom, ""),
+			expAdmin: "",
+		},
+		{
+			name:      "no admin",
+			input:     banktypes.NewMsgChangeAdmin(newAdmin, denom, newAdmin),
+			expErrMsg: "unauthorized token factory denom admin",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.ChangeAdmin(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+
+			authority, err := suite.bankKeeper.GetDenomAuthorityMetadata(suite.ctx, denom)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expAdmin, authority.Admin)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgSetDenomSendHook() {
+	admin, err := suite.authKeeper.AddressCodec().BytesToString(accAddrs[0])
+	suite.Require().NoError(err)
+
+	denom := suite.createFactoryDenom(accAddrs[0], "token")
+	otherDenom := suite.createFactoryDenom(accAddrs[0], "other")
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom, 10), sdk.NewInt64Coin(otherDenom, 10), newFooCoin(10))
+
+	// the hook only sees the coins of the denoms opting in to it
+	var hookCoins sdk.Coins
+	errFrozen := errors.New("frozen")
+	suite.bankKeeper.RegisterFactorySendHook("record", func(_ context.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		hookCoins = hookCoins.Add(amt...)
+		return toAddr, nil
+	})
+	suite.bankKeeper.RegisterFactorySendHook("freeze", func(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		return nil, errFrozen
+	})
+	suite.Require().Panics(func() {
+		suite.bankKeeper.RegisterFactorySendHook("freeze", banktypes.NoOpSendRestrictionFn)
+	})
+
+	_, err = suite.msgServer.SetDenomSendHook(suite.ctx, banktypes.NewMsgSetDenomSendHook(admin, denom, "unknown"))
+	suite.Require().ErrorIs(err, banktypes.ErrUnknownSendHook)
+
+	_, err = suite.msgServer.SetDenomSendHook(suite.ctx, banktypes.NewMsgSetDenomSendHook(admin, denom, "record"))
+	suite.Require().NoError(err)
+	toAddr, err := suite.bankKeeper.GetSendRestrictionFn()(suite.ctx, accAddrs[0], accAddrs[1], coins)
+	suite.Require().NoError(err)
+	suite.Require().Equal(accAddrs[1], toAddr)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denom, 10)), hookCoins)
+
+	_, err = suite.msgServer.SetDenomSendHook(suite.ctx, banktypes.NewMsgSetDenomSendHook(admin, otherDenom, "freeze"))
+	suite.Require().NoError(err)
+	_, err = suite.bankKeeper.GetSendRestrictionFn()(suite.ctx, accAddrs[0], accAddrs[1], coins)
+	suite.Require().ErrorIs(err, errFrozen)
+
+	// removing the send hook
+	_, err = suite.msgServer.SetDenomSendHook(suite.ctx, banktypes.NewMsgSetDenomSendHook(admin, otherDenom, ""))
+	suite.Require().NoError(err)
+	_, err = suite.bankKeeper.GetSendRestrictionFn()(suite.ctx, accAddrs[0], accAddrs[1], coins)
+	suite.Require().NoError(err)
+}
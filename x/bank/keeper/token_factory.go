@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/bank/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CreateDenom creates the factory/{creator}/{subdenom} denom, with the creator
+// as its admin, and registers its metadata. The denom creation fee, if any, is
+// sent from the creator to the community pool.
+func (k BaseKeeper) CreateDenom(ctx context.Context, creator sdk.AccAddress, subdenom string) (string, error) {
+	creatorStr, err := k.ak.AddressCodec().BytesToString(creator)
+	if err != nil {
+		return "", err
+	}
+
+	denom, err := types.GetFactoryDenom(creatorStr, subdenom)
+	if err != nil {
+		return "", err
+	}
+
+	has, err := k.DenomAuthorities.Has(ctx, collections.Join(creatorStr, subdenom))
+	if err != nil {
+		return "", err
+	}
+	if has || k.HasDenomMetaData(ctx, denom) || k.HasSupply(ctx, denom) {
+		return "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.SendCoinsFromAccountToModule(ctx, creator, types.ProtocolPoolModuleName, fee); err != nil {
+			return "", errorsmod.Wrap(err, "unable to pay the denom creation fee")
+		}
+	}
+
+	if err := k.DenomAuthorities.Set(ctx, collections.Join(creatorStr, subdenom), types.DenomAuthorityMetadata{Admin: creatorStr}); err != nil {
+		return "", err
+	}
+	k.SetDenomMetaData(ctx, types.FactoryDenomMetadata(denom, subdenom))
+
+	return denom, k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCreateDenom,
+		event.NewAttribute(types.AttributeKeyCreator, creatorStr),
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+	)
+}
+
+// GetDenomAuthorityMetadata returns the authority metadata of a token factory denom.
+func (k BaseKeeper) GetDenomAuthorityMetadata(ctx context.Context, denom string) (types.DenomAuthorityMetadata, error) {
+	creator, subdenom, err := types.ParseFactoryDenom(denom)
+	if err != nil {
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	metadata, err := k.DenomAuthorities.Get(ctx, collections.Join(creator, subdenom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(types.ErrInvalidFactoryDenom, "denom %s does not exist", denom)
+	}
+
+	return metadata, err
+}
+
+// setDenomAuthorityMetadata sets the authority metadata of a token factory denom.
+func (k BaseKeeper) setDenomAuthorityMetadata(ctx context.Context, denom string, metadata types.DenomAuthorityMetadata) error {
+	creator, subdenom, err := types.ParseFactoryDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	return k.DenomAuthorities.Set(ctx, collections.Join(creator, subdenom), metadata)
+}
+
+// checkFactoryDenomAdmin returns the authority metadata of a token factory
+// denom, or an error if admin is not the admin of the denom.
+func (k BaseKeeper) checkFactoryDenomAdmin(ctx context.Context, admin sdk.AccAddress, denom string) (types.DenomAuthorityMetadata, error) {
+	metadata, err := k.GetDenomAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	adminStr, err := k.ak.AddressCodec().BytesToString(admin)
+	if err != nil {
+		return types.DenomAuthorityMetadata{}, err
+	}
+
+	if metadata.Admin == "" || metadata.Admin != adminStr {
+		return types.DenomAuthorityMetadata{}, errorsmod.Wrapf(types.ErrUnauthorizedAdmin, "%s is not the admin of %s", adminStr, denom)
+	}
+
+	return metadata, nil
+}
+
+// MintFactoryCoins mints coins of a token factory denom to the recipient. Only
+// the admin of the denom can mint it.
+func (k BaseKeeper) MintFactoryCoins(ctx context.Context, admin, recipient sdk.AccAddress, amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if _, err := k.checkFactoryDenomAdmin(ctx, admin, amount.Denom); err != nil {
+		return err
+	}
+
+	if k.BlockedAddr(recipient) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipient)
+	}
+
+	amounts := sdk.NewCoins(amount)
+	if err := k.mintCoinsRestrictionFn(ctx, amounts); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, recipient, amounts); err != nil {
+		return err
+	}
+
+	supply := k.GetSupply(ctx, amount.Denom)
+	k.setSupply(ctx, supply.Add(amount))
+
+	adminStr, err := k.ak.AddressCodec().BytesToString(admin)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeCoinMint,
+		event.NewAttribute(types.AttributeKeyMinter, adminStr),
+		event.NewAttribute(sdk.AttributeKeyAmount, amounts.String()),
+	)
+}
+
+// ForceTransfer transfers coins of a token factory denom from any account but
+// module accounts. Only the admin of the denom can force transfer it.
+func (k BaseKeeper) ForceTransfer(ctx context.Context, admin, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin) error {
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	metadata, err := k.checkFactoryDenomAdmin(ctx, admin, amount.Denom)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := k.ak.GetAccount(ctx, fromAddr).(sdk.ModuleAccountI); ok {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot force transfer from module account %s", fromAddr)
+	}
+
+	if k.BlockedAddr(toAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddr)
+	}
+
+	if err := k.SendCoins(ctx, fromAddr, toAddr, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeForceTransfer,
+		event.NewAttribute(types.AttributeKeyAdmin, metadata.Admin),
+		event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+	)
+}
+
+// ChangeAdmin changes the admin of a token factory denom. An empty new admin
+// renounces the administration of the denom.
+func (k BaseKeeper) ChangeAdmin(ctx context.Context, admin sdk.AccAddress, denom, newAdmin string) error {
+	metadata, err := k.checkFactoryDenomAdmin(ctx, admin, denom)
+	if err != nil {
+		return err
+	}
+
+	if newAdmin != "" {
+		if _, err := k.ak.AddressCodec().StringToBytes(newAdmin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid new admin address: %s", err)
+		}
+	}
+
+	metadata.Admin = newAdmin
+	if err := k.setDenomAuthorityMetadata(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeChangeAdmin,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeyNewAdmin, newAdmin),
+	)
+}
+
+// SetDenomSendHook sets the send hook run on transfers of a token factory
+// denom, see RegisterFactorySendHook. An empty send hook removes the send hook
+// of the denom.
+func (k BaseKeeper) SetDenomSendHook(ctx context.Context, admin sdk.AccAddress, denom, sendHook string) error {
+	metadata, err := k.checkFactoryDenomAdmin(ctx, admin, denom)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := k.factorySendHooks[sendHook]; sendHook != "" && !ok {
+		return errorsmod.Wrapf(types.ErrUnknownSendHook, "%q", sendHook)
+	}
+
+	metadata.SendHook = sendHook
+	if err := k.setDenomAuthorityMetadata(ctx, denom, metadata); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeSetDenomSendHook,
+		event.NewAttribute(types.AttributeKeyDenom, denom),
+		event.NewAttribute(types.AttributeKeySendHook, sendHook),
+	)
+}
+
+// RegisterFactorySendHook registers a send hook the admins of the token
+// factory denoms can opt in to with MsgSetDenomSendHook. The hook is called
+// with the coins of the denom only, and can restrict the transfer or provide a
+// new receiver address.
+//
+// The send hooks are run by a SendRestrictionFn registered with
+// AppendSendRestriction along with the first send hook. It must be called
+// during the app initialization.
+func (k BaseKeeper) RegisterFactorySendHook(name string, hook types.SendRestrictionFn) {
+	if name == "" || hook == nil {
+		panic("send hook name and function cannot be empty")
+	}
+	if _, ok := k.factorySendHooks[name]; ok {
+		panic(fmt.Sprintf("send hook %q is already registered", name))
+	}
+
+	if len(k.factorySendHooks) == 0 {
+		k.AppendSendRestriction(k.factoryDenomSendRestriction)
+	}
+	k.factorySendHooks[name] = hook
+}
+
+// factoryDenomSendRestriction is a SendRestrictionFn running the send hooks of
+// the token factory denoms being transferred.
+func (k BaseKeeper) factoryDenomSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	for _, coin := range amt {
+		if !types.IsFactoryDenom(coin.Denom) {
+			continue
+		}
+
+		metadata, err := k.GetDenomAuthorityMetadata(ctx, coin.Denom)
+		if err != nil {
+			if errors.Is(err, types.ErrInvalidFactoryDenom) {
+				continue
+			}
+			return nil, err
+		}
+		if metadata.SendHook == "" {
+			continue
+		}
+
+		hook, ok := k.factorySendHooks[metadata.SendHook]
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrUnknownSendHook, "%q of denom %s", metadata.SendHook, coin.Denom)
+		}
+
+		toAddr, err = hook(ctx, fromAddr, toAddr, sdk.NewCoins(coin))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return toAddr, nil
+}
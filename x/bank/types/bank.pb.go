@@ -37,6 +37,9 @@ type Params struct {
 	// As of cosmos-sdk 0.47, this only exists for backwards compatibility of genesis files.
 	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
+	// denom_creation_fee is the fee charged for the creation of a token factory
+	// denom. It is sent to the community pool.
+	DenomCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=denom_creation_fee,json=denomCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -87,6 +90,13 @@ func (m *Params) GetDefaultSendEnabled() bool {
 	return false
 }
 
+func (m *Params) GetDenomCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFee
+	}
+	return nil
+}
+
 // SendEnabled maps coin denom to a send_enabled status (whether a denom is
 // sendable).
 type SendEnabled struct {
@@ -443,6 +453,64 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+// DenomAuthorityMetadata holds the administration of a token factory denom.
+type DenomAuthorityMetadata struct {
+	// admin is the account allowed to mint the denom, force transfer it and
+	// change its admin. An empty admin means the denom can no longer be
+	// administered.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// send_hook is the name of the send hook restricting transfers of the denom,
+	// if any. Send hooks are registered by the application.
+	SendHook string `protobuf:"bytes,2,opt,name=send_hook,json=sendHook,proto3" json:"send_hook,omitempty"`
+}
+
+func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
+func (m *DenomAuthorityMetadata) String() string { return proto.CompactTextString(m) }
+func (*DenomAuthorityMetadata) ProtoMessage()    {}
+func (*DenomAuthorityMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd052eee12edf988, []int{7}
+}
+func (m *DenomAuthorityMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomAuthorityMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomAuthorityMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomAuthorityMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomAuthorityMetadata.Merge(m, src)
+}
+func (m *DenomAuthorityMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomAuthorityMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomAuthorityMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomAuthorityMetadata proto.InternalMessageInfo
+
+func (m *DenomAuthorityMetadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *DenomAuthorityMetadata) GetSendHook() string {
+	if m != nil {
+		return m.SendHook
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -451,57 +519,63 @@ func init() {
 	proto.RegisterType((*Supply)(nil), "cosmos.bank.v1beta1.Supply")
 	proto.RegisterType((*DenomUnit)(nil), "cosmos.bank.v1beta1.DenomUnit")
 	proto.RegisterType((*Metadata)(nil), "cosmos.bank.v1beta1.Metadata")
+	proto.RegisterType((*DenomAuthorityMetadata)(nil), "cosmos.bank.v1beta1.DenomAuthorityMetadata")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x54, 0xbd, 0x6f, 0x13, 0x4b,
-	0x10, 0xf7, 0xfa, 0x3b, 0xeb, 0x3c, 0x3d, 0xbd, 0x8d, 0xa5, 0x77, 0xf1, 0xd3, 0x3b, 0x5b, 0x6e,
-	0x30, 0x06, 0xdb, 0xf9, 0x40, 0x48, 0xb8, 0x41, 0x38, 0x7c, 0xb9, 0x40, 0xa0, 0x8b, 0x22, 0x24,
-	0x1a, 0x6b, 0xed, 0x5b, 0xec, 0x55, 0xee, 0x76, 0x4f, 0xb7, 0x7b, 0x21, 0x6e, 0xa9, 0x10, 0x15,
-	0x35, 0x55, 0x44, 0x85, 0x10, 0x85, 0x8b, 0xf4, 0xb4, 0x51, 0xaa, 0x88, 0x0a, 0xa5, 0x08, 0xc8,
-	0x29, 0x9c, 0x3f, 0x03, 0xdd, 0xde, 0x9d, 0xed, 0x08, 0xa7, 0x45, 0xa2, 0xb1, 0x77, 0xe6, 0xf7,
-	0x9b, 0x99, 0xdf, 0xcc, 0xce, 0x2d, 0xd4, 0x7b, 0x5c, 0xd8, 0x5c, 0x34, 0xba, 0x98, 0xed, 0x36,
-	0xf6, 0xd6, 0xbb, 0x44, 0xe2, 0x75, 0x65, 0xd4, 0x1d, 0x97, 0x4b, 0x8e, 0x56, 0x02, 0xbc, 0xae,
-	0x5c, 0x21, 0x5e, 0xc8, 0xf7, 0x79, 0x9f, 0x2b, 0xbc, 0xe1, 0x9f, 0x02, 0x6a, 0x61, 0x35, 0xa0,
-	0x76, 0x02, 0x20, 0x8c, 0x0b, 0xa0, 0x59, 0x15, 0x41, 0xa6, 0x55, 0x7a, 0x9c, 0xb2, 0x10, 0xff,
-	0x37, 0xc4, 0x6d, 0xd1, 0x6f, 0xec, 0xad, 0xfb, 0x7f, 0x21, 0xf0, 0x0f, 0xb6, 0x29, 0xe3, 0x0d,
-	0xf5, 0x1b, 0xb8, 0xca, 0x1f, 0x00, 0x4c, 0x3f, 0xc3, 0x2e, 0xb6, 0x05, 0x7a, 0x04, 0x97, 0x05,
-	0x61, 0x66, 0x87, 0x30, 0xdc, 0xb5, 0x88, 0xa9, 0x81, 0x52, 0xa2, 0x92, 0xdb, 0x28, 0xd5, 0x17,
-	0x68, 0xae, 0x6f, 0x13, 0x66, 0x3e, 0x08, 0x78, 0xad, 0xb8, 0x06, 0x8c, 0x9c, 0x98, 0x39, 0xd0,
-	0x1a, 0xcc, 0x9b, 0xe4, 0x25, 0xf6, 0x2c, 0xd9, 0xb9, 0x94, 0x30, 0x5e, 0x02, 0x95, 0xac, 0x81,
-	0x42, 0x6c, 0x2e, 0x45, 0xf3, 0xff, 0xb7, 0x93, 0x51, 0x55, 0x0b, 0x0a, 0xd5, 0x84, 0xb9, 0xdb,
-	0xd8, 0x0f, 0x46, 0x18, 0x28, 0x2b, 0x6f, 0xc1, 0xdc, 0x1c, 0x1b, 0xe5, 0x61, 0xca, 0x24, 0x8c,
-	0xdb, 0x1a, 0x28, 0x81, 0xca, 0x92, 0x11, 0x18, 0x48, 0x83, 0x99, 0xcb, 0x85, 0x22, 0xb3, 0x99,
-	0xbc, 0x38, 0x28, 0x82, 0xf2, 0x31, 0x80, 0xa9, 0x36, 0x73, 0x3c, 0x89, 0x36, 0x60, 0x06, 0x9b,
-	0xa6, 0x4b, 0x84, 0x08, 0x32, 0xb4, 0xb4, 0xaf, 0x87, 0xb5, 0x7c, 0xd8, 0xe6, 0xbd, 0x00, 0xd9,
-	0x96, 0x2e, 0x65, 0x7d, 0x23, 0x22, 0xa2, 0x57, 0x30, 0xe5, 0x4f, 0x58, 0x68, 0x71, 0x35, 0x95,
-	0xd5, 0xd9, 0x54, 0x04, 0x99, 0x4e, 0x65, 0x8b, 0x53, 0xd6, 0x7a, 0x78, 0x74, 0x56, 0x8c, 0x7d,
-	0xfa, 0x5e, 0xac, 0xf4, 0xa9, 0x1c, 0x78, 0xdd, 0x7a, 0x8f, 0xdb, 0xe1, 0xf5, 0x35, 0xe6, 0x1a,
-	0x94, 0x43, 0x87, 0x08, 0x15, 0x20, 0xde, 0x4f, 0x46, 0xd5, 0x65, 0x8b, 0xf4, 0x71, 0x6f, 0xd8,
-	0x51, 0x35, 0x3e, 0x4e, 0x46, 0x55, 0x60, 0x04, 0xf5, 0x9a, 0xf9, 0x37, 0x07, 0xc5, 0xd8, 0xc5,
-	0x41, 0x31, 0xf6, 0x7a, 0x32, 0xaa, 0x46, 0x72, 0xca, 0x5f, 0x00, 0x4c, 0x3f, 0xf5, 0xe4, 0x1f,
-	0xd7, 0x4d, 0x36, 0xea, 0xa6, 0xfc, 0x19, 0xc0, 0xf4, 0xb6, 0xe7, 0x38, 0xd6, 0xd0, 0x57, 0x23,
-	0xb9, 0xc4, 0x56, 0xb8, 0x71, 0xbf, 0x43, 0x8d, 0xaa, 0xd7, 0xbc, 0x1e, 0xaa, 0x01, 0xc7, 0x87,
-	0xb5, 0xff, 0x16, 0xae, 0xb9, 0x12, 0xd8, 0xd6, 0x40, 0xf9, 0x39, 0x5c, 0xba, 0xef, 0xaf, 0xd9,
-	0x0e, 0xa3, 0xf2, 0x8a, 0x05, 0x2c, 0xc0, 0x2c, 0xd9, 0x77, 0x38, 0x23, 0x4c, 0xaa, 0x0d, 0xfc,
-	0xcb, 0x98, 0xda, 0xfe, 0x72, 0x62, 0x8b, 0x62, 0x41, 0x84, 0x96, 0x28, 0x25, 0x2a, 0x4b, 0x46,
-	0x64, 0x96, 0x4f, 0xe3, 0x30, 0xfb, 0x84, 0x48, 0x6c, 0x62, 0x89, 0x51, 0x09, 0xe6, 0x4c, 0x22,
-	0x7a, 0x2e, 0x75, 0x24, 0xe5, 0x2c, 0x4c, 0x3f, 0xef, 0x42, 0x77, 0x7d, 0x06, 0xe3, 0x76, 0xc7,
-	0x63, 0x54, 0x46, 0xf7, 0xa7, 0x2f, 0xfc, 0x46, 0xa7, 0x7a, 0x0d, 0x68, 0x46, 0x47, 0x81, 0x10,
-	0x4c, 0xfa, 0x73, 0xd5, 0x12, 0x2a, 0xb7, 0x3a, 0xfb, 0xea, 0x4c, 0x2a, 0x1c, 0x0b, 0x0f, 0xb5,
-	0xa4, 0x72, 0x47, 0x26, 0xba, 0x06, 0x93, 0x0c, 0xdb, 0x44, 0x4b, 0xa9, 0xcd, 0x5a, 0x39, 0x3d,
-	0xac, 0xfd, 0x3d, 0x1b, 0x74, 0x69, 0xad, 0x7e, 0x6b, 0xd3, 0x50, 0x04, 0x74, 0x03, 0xa6, 0xc5,
-	0xd0, 0xee, 0x72, 0x4b, 0x4b, 0x5f, 0x4d, 0x0d, 0x29, 0xe8, 0x26, 0x4c, 0x78, 0x2e, 0xd5, 0x32,
-	0x8a, 0x59, 0x18, 0x9f, 0x15, 0x13, 0x3b, 0x46, 0xfb, 0xd7, 0x80, 0xdb, 0x86, 0x4f, 0x43, 0x77,
-	0x60, 0xd6, 0x73, 0x69, 0x67, 0x80, 0xc5, 0x40, 0xcb, 0xaa, 0x10, 0x7d, 0x7c, 0x56, 0xcc, 0xec,
-	0x18, 0xed, 0xc7, 0x58, 0x0c, 0x16, 0x85, 0x65, 0x3c, 0x97, 0xfa, 0x58, 0x6b, 0xf3, 0x68, 0xac,
-	0x83, 0x93, 0xb1, 0x0e, 0x7e, 0x8c, 0x75, 0xf0, 0xee, 0x5c, 0x8f, 0x9d, 0x9c, 0xeb, 0xb1, 0x6f,
-	0xe7, 0x7a, 0xec, 0x45, 0xf8, 0xbc, 0x0a, 0x73, 0xb7, 0x4e, 0x79, 0xf4, 0xdc, 0xa8, 0xc5, 0xe9,
-	0xa6, 0xd5, 0xcb, 0xb8, 0xf9, 0x33, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x68, 0x6d, 0x04, 0xcd, 0x05,
-	0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xbf, 0x8f, 0x1b, 0x45,
+	0x14, 0xf6, 0xf8, 0xb7, 0xc7, 0x41, 0xc0, 0xc4, 0x22, 0x13, 0x47, 0xac, 0xad, 0x6d, 0x30, 0x06,
+	0xaf, 0x73, 0x77, 0x80, 0x84, 0x1b, 0x14, 0x1f, 0x84, 0xb8, 0x40, 0xa0, 0x3d, 0x9d, 0x10, 0x34,
+	0xd6, 0xd8, 0x3b, 0xb1, 0x47, 0xde, 0x9d, 0x59, 0xed, 0xcc, 0x86, 0xb8, 0xa1, 0xa0, 0x42, 0x54,
+	0xd4, 0x54, 0x27, 0x2a, 0x84, 0x28, 0x5c, 0x5c, 0x4f, 0x49, 0x94, 0x2a, 0x4a, 0x85, 0x52, 0x1c,
+	0xc8, 0x57, 0x38, 0x7f, 0x06, 0xda, 0xd9, 0x5d, 0xdb, 0xa7, 0xf3, 0xe9, 0x44, 0x83, 0x44, 0x63,
+	0xcf, 0x7b, 0xdf, 0x7b, 0x6f, 0xbe, 0xf9, 0xe6, 0xbd, 0x59, 0x68, 0x8c, 0x85, 0xf4, 0x84, 0xec,
+	0x8e, 0x08, 0x9f, 0x75, 0x1f, 0xed, 0x8d, 0xa8, 0x22, 0x7b, 0xda, 0xb0, 0xfc, 0x40, 0x28, 0x81,
+	0x6e, 0xc6, 0xb8, 0xa5, 0x5d, 0x09, 0x5e, 0xaf, 0x4d, 0xc4, 0x44, 0x68, 0xbc, 0x1b, 0xad, 0xe2,
+	0xd0, 0xfa, 0xed, 0x38, 0x74, 0x18, 0x03, 0x49, 0x5e, 0x0c, 0x6d, 0x76, 0x91, 0x74, 0xbd, 0xcb,
+	0x58, 0x30, 0x9e, 0xe0, 0xb7, 0x12, 0xdc, 0x93, 0x93, 0xee, 0xa3, 0xbd, 0xe8, 0x2f, 0x01, 0x5e,
+	0x27, 0x1e, 0xe3, 0xa2, 0xab, 0x7f, 0x63, 0x97, 0xf9, 0x47, 0x16, 0x16, 0xbf, 0x20, 0x01, 0xf1,
+	0x24, 0xfa, 0x14, 0xde, 0x90, 0x94, 0x3b, 0x43, 0xca, 0xc9, 0xc8, 0xa5, 0x0e, 0x06, 0xcd, 0x5c,
+	0xab, 0xba, 0xdf, 0xb4, 0x76, 0x70, 0xb6, 0x8e, 0x28, 0x77, 0x3e, 0x89, 0xe3, 0xfa, 0x59, 0x0c,
+	0xec, 0xaa, 0xdc, 0x38, 0xd0, 0x5d, 0x58, 0x73, 0xe8, 0x43, 0x12, 0xba, 0x6a, 0x78, 0xa1, 0x60,
+	0xb6, 0x09, 0x5a, 0x65, 0x1b, 0x25, 0xd8, 0x56, 0x09, 0xf4, 0x33, 0x80, 0xc8, 0xa1, 0x5c, 0x78,
+	0xc3, 0x71, 0x40, 0x89, 0x62, 0x82, 0x0f, 0x1f, 0x52, 0x8a, 0x73, 0x9a, 0xc1, 0xed, 0x0d, 0x03,
+	0x49, 0xd7, 0x0c, 0x0e, 0x05, 0xe3, 0xfd, 0xaf, 0x9e, 0x9c, 0x35, 0x32, 0xbf, 0xfe, 0xd5, 0x68,
+	0x4d, 0x98, 0x9a, 0x86, 0x23, 0x6b, 0x2c, 0xbc, 0x44, 0xaa, 0xe4, 0xaf, 0x23, 0x9d, 0x59, 0x57,
+	0xcd, 0x7d, 0x2a, 0x75, 0x82, 0x7c, 0x71, 0xda, 0x79, 0x75, 0x83, 0x34, 0xef, 0x5a, 0xef, 0xef,
+	0xff, 0xb4, 0x5a, 0xb4, 0x6f, 0xb8, 0x74, 0x42, 0xc6, 0xf3, 0x61, 0x24, 0xa2, 0xfc, 0x65, 0xb5,
+	0x68, 0x03, 0xfb, 0x35, 0xcd, 0xe7, 0x30, 0xa1, 0x73, 0x9f, 0xd2, 0xde, 0x9b, 0x3f, 0xac, 0x16,
+	0x6d, 0xbc, 0x55, 0xf7, 0x71, 0x7c, 0xcf, 0xb1, 0x7c, 0xe6, 0x21, 0xac, 0x6e, 0x1f, 0xa9, 0x06,
+	0x0b, 0xba, 0x02, 0x06, 0x4d, 0xd0, 0xaa, 0xd8, 0xb1, 0x81, 0x30, 0x2c, 0x5d, 0x54, 0x23, 0x35,
+	0x7b, 0xf9, 0x97, 0x27, 0x0d, 0x60, 0x3e, 0x05, 0xb0, 0x30, 0xe0, 0x7e, 0xa8, 0xd0, 0x3e, 0x2c,
+	0x11, 0xc7, 0x09, 0xa8, 0x94, 0x71, 0x85, 0x3e, 0x7e, 0x7e, 0xda, 0xa9, 0x25, 0x4a, 0xdc, 0x8b,
+	0x91, 0x23, 0x15, 0x30, 0x3e, 0xb1, 0xd3, 0x40, 0xf4, 0x0d, 0x2c, 0xe8, 0x13, 0xe0, 0xec, 0x75,
+	0xc2, 0xdd, 0xff, 0xb7, 0xc2, 0x5d, 0xa1, 0x52, 0xbc, 0x5f, 0xaf, 0xf6, 0xfd, 0x49, 0x23, 0xf3,
+	0xf2, 0xa4, 0x91, 0xf9, 0x6e, 0xb5, 0x68, 0xa7, 0x74, 0xcc, 0xdf, 0x01, 0x2c, 0x7e, 0x1e, 0xaa,
+	0xff, 0xdd, 0x69, 0xca, 0xe9, 0x69, 0xcc, 0xdf, 0x00, 0x2c, 0x1e, 0x85, 0xbe, 0xef, 0xce, 0x23,
+	0x36, 0x4a, 0x28, 0xe2, 0x62, 0xf0, 0x9f, 0xb1, 0xd1, 0xfb, 0xf5, 0xde, 0x4e, 0xd8, 0x80, 0xa7,
+	0xa7, 0x9d, 0x3b, 0x3b, 0x67, 0x51, 0x13, 0x1c, 0x60, 0x60, 0x7e, 0x09, 0x2b, 0x1f, 0x47, 0x6d,
+	0x76, 0xcc, 0x99, 0xba, 0xa2, 0x01, 0xeb, 0xb0, 0x4c, 0x1f, 0xfb, 0x82, 0x53, 0xae, 0x74, 0x07,
+	0xbe, 0x62, 0xaf, 0xed, 0xa8, 0x39, 0x89, 0xcb, 0x88, 0xa4, 0x52, 0x4f, 0x5e, 0xc5, 0x4e, 0x4d,
+	0xf3, 0x45, 0x16, 0x96, 0x3f, 0xa3, 0x8a, 0x38, 0x44, 0x11, 0xd4, 0x84, 0x55, 0x87, 0xca, 0x71,
+	0xc0, 0xfc, 0x68, 0x32, 0x92, 0xf2, 0xdb, 0x2e, 0xf4, 0x11, 0xac, 0xc6, 0xd3, 0x1c, 0x72, 0xa6,
+	0xd2, 0xfb, 0x33, 0x76, 0x3e, 0x24, 0x6b, 0xbe, 0x36, 0x74, 0xd2, 0xa5, 0x44, 0x08, 0xe6, 0x23,
+	0x5d, 0x71, 0x4e, 0xd7, 0xd6, 0xeb, 0x88, 0x9d, 0xc3, 0xa4, 0xef, 0x92, 0x39, 0xce, 0x6b, 0x77,
+	0x6a, 0xa2, 0xb7, 0x60, 0x9e, 0x13, 0x8f, 0xe2, 0x82, 0xee, 0xac, 0x9b, 0x97, 0x66, 0xfc, 0xbd,
+	0x03, 0x5b, 0x07, 0xa0, 0x77, 0x60, 0x51, 0xce, 0xbd, 0x91, 0x70, 0x71, 0xf1, 0xea, 0xd0, 0x24,
+	0x04, 0xbd, 0x0b, 0x73, 0x61, 0xc0, 0x70, 0x49, 0x47, 0xd6, 0x97, 0x67, 0x8d, 0xdc, 0xb1, 0x3d,
+	0xb8, 0x9c, 0xf0, 0x81, 0x1d, 0x85, 0xa1, 0x0f, 0x61, 0x39, 0x0c, 0xd8, 0x70, 0x4a, 0xe4, 0x14,
+	0x97, 0x75, 0x8a, 0xb1, 0x3c, 0x6b, 0x94, 0x8e, 0xed, 0xc1, 0x03, 0x22, 0xa7, 0xbb, 0xd2, 0x4a,
+	0x61, 0xc0, 0x22, 0xcc, 0xfc, 0x16, 0xbe, 0xa1, 0x55, 0xb8, 0x17, 0xaa, 0xa9, 0x08, 0x98, 0x9a,
+	0xaf, 0x95, 0xb6, 0x60, 0x81, 0x38, 0x1e, 0xe3, 0xd7, 0xce, 0x4c, 0x1c, 0x86, 0xee, 0xc0, 0x8a,
+	0x7e, 0x70, 0xa7, 0x42, 0xcc, 0xf4, 0xed, 0x56, 0xec, 0x72, 0xe4, 0x78, 0x20, 0xc4, 0xac, 0x77,
+	0x2b, 0xea, 0xa1, 0xe7, 0x97, 0xdf, 0xbf, 0xfe, 0xc1, 0x93, 0xa5, 0x01, 0x9e, 0x2d, 0x0d, 0xf0,
+	0xf7, 0xd2, 0x00, 0x3f, 0x9e, 0x1b, 0x99, 0x67, 0xe7, 0x46, 0xe6, 0xcf, 0x73, 0x23, 0xf3, 0x75,
+	0xf2, 0x0d, 0x92, 0xce, 0xcc, 0x62, 0x22, 0x7d, 0xee, 0x74, 0xe3, 0x8e, 0x8a, 0xfa, 0xf3, 0x71,
+	0xf0, 0xcf, 0x00, 0x16, 0xce, 0xfa, 0x5c, 0xf2, 0x06, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -560,6 +634,33 @@ func (this *Supply) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomAuthorityMetadata)
+	if !ok {
+		that2, ok := that.(DenomAuthorityMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Admin != that1.Admin {
+		return false
+	}
+	if this.SendHook != that1.SendHook {
+		return false
+	}
+	return true
+}
 func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -580,6 +681,20 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomCreationFee) > 0 {
+		for iNdEx := len(m.DenomCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBank(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.DefaultSendEnabled {
 		i--
 		if m.DefaultSendEnabled {
@@ -902,6 +1017,43 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomAuthorityMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SendHook) > 0 {
+		i -= len(m.SendHook)
+		copy(dAtA[i:], m.SendHook)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.SendHook)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBank(v)
 	base := offset
@@ -928,6 +1080,12 @@ func (m *Params) Size() (n int) {
 	if m.DefaultSendEnabled {
 		n += 2
 	}
+	if len(m.DenomCreationFee) > 0 {
+		for _, e := range m.DenomCreationFee {
+			l = e.Size()
+			n += 1 + l + sovBank(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1065,6 +1223,23 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *DenomAuthorityMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	l = len(m.SendHook)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
+	return n
+}
+
 func sovBank(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1154,6 +1329,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DefaultSendEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFee = append(m.DenomCreationFee, types.Coin{})
+			if err := m.DenomCreationFee[len(m.DenomCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -2034,6 +2243,120 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomAuthorityMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendHook", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendHook = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBank(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
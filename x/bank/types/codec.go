@@ -16,6 +16,11 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgMultiSend{}, "cosmos-sdk/MsgMultiSend")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled")
+	legacy.RegisterAminoMsg(cdc, &MsgCreateDenom{}, "cosmos-sdk/x/bank/MsgCreateDenom")
+	legacy.RegisterAminoMsg(cdc, &MsgMint{}, "cosmos-sdk/x/bank/MsgMint")
+	legacy.RegisterAminoMsg(cdc, &MsgForceTransfer{}, "cosmos-sdk/x/bank/MsgForceTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgChangeAdmin{}, "cosmos-sdk/x/bank/MsgChangeAdmin")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomSendHook{}, "cosmos-sdk/x/bank/MsgSetDenomSendHook")
 
 	cdc.RegisterConcrete(&SendAuthorization{}, "cosmos-sdk/SendAuthorization")
 	cdc.RegisterConcrete(&Params{}, "cosmos-sdk/x/bank/Params")
@@ -28,6 +33,11 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgBurn{},
 		&MsgSetSendEnabled{},
+		&MsgCreateDenom{},
+		&MsgMint{},
+		&MsgForceTransfer{},
+		&MsgChangeAdmin{},
+		&MsgSetDenomSendHook{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -21,4 +21,16 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// token factory events name and attributes
+	EventTypeCreateDenom      = "create_denom"
+	EventTypeForceTransfer    = "force_transfer"
+	EventTypeChangeAdmin      = "change_admin"
+	EventTypeSetDenomSendHook = "set_denom_send_hook"
+
+	AttributeKeyCreator  = "creator"
+	AttributeKeyDenom    = "denom"
+	AttributeKeyAdmin    = "admin"
+	AttributeKeyNewAdmin = "new_admin"
+	AttributeKeySendHook = "send_hook"
 )
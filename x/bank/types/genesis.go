@@ -23,6 +23,7 @@ func (gs GenesisState) Validate() error {
 	seenSendEnabled := make(map[string]bool)
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
+	seenFactoryDenoms := make(map[string]bool)
 
 	totalSupply := sdk.Coins{}
 
@@ -62,6 +63,18 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	for _, factoryDenom := range gs.FactoryDenoms {
+		if seenFactoryDenoms[factoryDenom.Denom] {
+			return fmt.Errorf("duplicate factory denom %s", factoryDenom.Denom)
+		}
+
+		if _, _, err := ParseFactoryDenom(factoryDenom.Denom); err != nil {
+			return err
+		}
+
+		seenFactoryDenoms[factoryDenom.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
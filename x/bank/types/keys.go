@@ -24,6 +24,11 @@ const (
 	// It should be synced with the mint module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/0e34478eb7420b69869ed50f129fc274a97a9b06/x/mint/types/keys.go#L13
 	MintModuleName = "mint"
+
+	// ProtocolPoolModuleName duplicates the protocolpool module's name to avoid a cyclic dependency with x/protocolpool.
+	// The protocolpool module account holds the community pool, which receives the token factory denom creation fees.
+	// It should be synced with the protocolpool module's name if it is ever changed.
+	ProtocolPoolModuleName = "protocolpool"
 )
 
 // KVStore keys
@@ -39,6 +44,9 @@ var (
 
 	// ParamsKey is the prefix for x/bank parameters
 	ParamsKey = collections.NewPrefix(5)
+
+	// DenomAuthorityPrefix is the prefix for the authority metadata of the token factory denoms.
+	DenomAuthorityPrefix = collections.NewPrefix(6)
 )
 
 // BalanceValueCodec is a codec for encoding bank balances in a backwards compatible way.
@@ -10,6 +10,11 @@ var (
 	_ coretransaction.Msg = &MsgSend{}
 	_ coretransaction.Msg = &MsgMultiSend{}
 	_ coretransaction.Msg = &MsgUpdateParams{}
+	_ coretransaction.Msg = &MsgCreateDenom{}
+	_ coretransaction.Msg = &MsgMint{}
+	_ coretransaction.Msg = &MsgForceTransfer{}
+	_ coretransaction.Msg = &MsgChangeAdmin{}
+	_ coretransaction.Msg = &MsgSetDenomSendHook{}
 )
 
 // NewMsgSend - construct a msg to send coins from one account to another.
@@ -30,3 +35,28 @@ func NewMsgSetSendEnabled(authority string, sendEnabled []*SendEnabled, useDefau
 		UseDefaultFor: useDefaultFor,
 	}
 }
+
+// NewMsgCreateDenom creates a message to create the factory/{sender}/{subdenom} denom.
+func NewMsgCreateDenom(sender, subdenom string) *MsgCreateDenom {
+	return &MsgCreateDenom{Sender: sender, Subdenom: subdenom}
+}
+
+// NewMsgMint creates a message to mint coins of a token factory denom.
+func NewMsgMint(admin string, amount sdk.Coin, mintToAddress string) *MsgMint {
+	return &MsgMint{Admin: admin, Amount: amount, MintToAddress: mintToAddress}
+}
+
+// NewMsgForceTransfer creates a message to transfer coins of a token factory denom from any account.
+func NewMsgForceTransfer(admin string, amount sdk.Coin, fromAddr, toAddr string) *MsgForceTransfer {
+	return &MsgForceTransfer{Admin: admin, Amount: amount, TransferFromAddress: fromAddr, TransferToAddress: toAddr}
+}
+
+// NewMsgChangeAdmin creates a message to change the admin of a token factory denom.
+func NewMsgChangeAdmin(admin, denom, newAdmin string) *MsgChangeAdmin {
+	return &MsgChangeAdmin{Admin: admin, Denom: denom, NewAdmin: newAdmin}
+}
+
+// NewMsgSetDenomSendHook creates a message to set the send hook of a token factory denom.
+func NewMsgSetDenomSendHook(admin, denom, sendHook string) *MsgSetDenomSendHook {
+	return &MsgSetDenomSendHook{Admin: admin, Denom: denom, SendHook: sendHook}
+}
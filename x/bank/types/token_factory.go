@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FactoryDenomPrefix is the prefix of the denoms created with the token factory.
+const FactoryDenomPrefix = "factory"
+
+// GetFactoryDenom returns the token factory denom created by creator for the
+// given subdenom, in the factory/{creator}/{subdenom} format.
+func GetFactoryDenom(creator, subdenom string) (string, error) {
+	if creator == "" || strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid creator %q", creator)
+	}
+	if subdenom == "" || strings.Contains(subdenom, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "invalid subdenom %q", subdenom)
+	}
+
+	denom := strings.Join([]string{FactoryDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidFactoryDenom, err.Error())
+	}
+
+	return denom, nil
+}
+
+// ParseFactoryDenom returns the creator and the subdenom of a token factory denom.
+func ParseFactoryDenom(denom string) (creator, subdenom string, err error) {
+	parts := strings.Split(denom, "/")
+	if len(parts) != 3 || parts[0] != FactoryDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidFactoryDenom, "%q is not in the %s/{creator}/{subdenom} format", denom, FactoryDenomPrefix)
+	}
+
+	if _, err := GetFactoryDenom(parts[1], parts[2]); err != nil {
+		return "", "", err
+	}
+
+	return parts[1], parts[2], nil
+}
+
+// IsFactoryDenom returns true if the denom has the token factory prefix.
+func IsFactoryDenom(denom string) bool {
+	return strings.HasPrefix(denom, FactoryDenomPrefix+"/")
+}
+
+// FactoryDenomMetadata returns the metadata registered for a new token factory denom.
+func FactoryDenomMetadata(denom, subdenom string) Metadata {
+	return Metadata{
+		Description: "token factory denom " + denom,
+		DenomUnits:  []*DenomUnit{{Denom: denom, Exponent: 0}},
+		Base:        denom,
+		Display:     denom,
+		Name:        denom,
+		Symbol:      subdenom,
+	}
+}
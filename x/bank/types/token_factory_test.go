@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/x/bank/types"
+)
+
+func TestFactoryDenom(t *testing.T) {
+	const creator = "cosmos1qypqxpq9qcrsszg2pvxq6rs0zqg3yyc5lzv7xu"
+
+	denom, err := types.GetFactoryDenom(creator, "token")
+	require.NoError(t, err)
+	require.Equal(t, "factory/"+creator+"/token", denom)
+	require.True(t, types.IsFactoryDenom(denom))
+	require.False(t, types.IsFactoryDenom("stake"))
+
+	parsedCreator, subdenom, err := types.ParseFactoryDenom(denom)
+	require.NoError(t, err)
+	require.Equal(t, creator, parsedCreator)
+	require.Equal(t, "token", subdenom)
+
+	require.NoError(t, types.FactoryDenomMetadata(denom, subdenom).Validate())
+
+	for _, tc := range []struct{ creator, subdenom string }{
+		{creator, ""},
+		{creator, "sub/denom"},
+		{"", "token"},
+		{creator, "token!"},
+	} {
+		_, err := types.GetFactoryDenom(tc.creator, tc.subdenom)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, tc)
+	}
+
+	for _, denom := range []string{"stake", "factory/" + creator, "factory/" + creator + "/token/x", "ibc/" + creator + "/token"} {
+		_, _, err := types.ParseFactoryDenom(denom)
+		require.ErrorIs(t, err, types.ErrInvalidFactoryDenom, denom)
+	}
+}
@@ -12,6 +12,12 @@ type CircuitBreaker interface {
 	IsAllowed(ctx context.Context, typeURL string) (bool, error)
 }
 
+// RateLimiter is an optional interface of a CircuitBreaker which enforces throughput
+// limits on the messages of a type URL. It matches baseapp's RateLimitedCircuitBreaker.
+type RateLimiter interface {
+	RecordMsg(ctx context.Context, typeURL string) error
+}
+
 // CircuitBreakerDecorator is an AnteDecorator that checks if the transaction type is allowed to enter the mempool or be executed
 type CircuitBreakerDecorator struct {
 	circuitKeeper CircuitBreaker
@@ -38,6 +44,15 @@ func (cbd CircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		if !isAllowed {
 			return ctx, errors.New("tx type not allowed")
 		}
+
+		// messages are accounted when they are first checked, to keep them out of the mempool
+		// once the rate limit is reached. The executed messages, including the nested ones,
+		// are accounted by the msg service router of baseapp.
+		if rateLimiter, ok := cbd.circuitKeeper.(RateLimiter); ok && !simulate && ctx.ExecMode() == sdk.ExecModeCheck {
+			if err := rateLimiter.RecordMsg(ctx, sdk.MsgTypeURL(msg)); err != nil {
+				return ctx, err
+			}
+		}
 	}
 
 	return next(ctx, tx, simulate)
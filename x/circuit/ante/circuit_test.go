@@ -91,3 +91,52 @@ func TestCircuitBreakerDecorator(t *testing.T) {
 		}
 	}
 }
+
+type MockRateLimitedCircuitBreaker struct {
+	MockCircuitBreaker
+	recorded []string
+}
+
+func (m *MockRateLimitedCircuitBreaker) RecordMsg(ctx context.Context, typeURL string) error {
+	m.recorded = append(m.recorded, typeURL)
+	if len(m.recorded) > 1 {
+		return cbtypes.ErrRateLimitExceeded
+	}
+	return nil
+}
+
+func TestCircuitBreakerDecoratorRateLimit(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+
+	circuitBreaker := &MockRateLimitedCircuitBreaker{}
+	decorator := ante.NewCircuitBreakerDecorator(circuitBreaker)
+
+	err := f.txBuilder.SetMsgs(&cbtypes.MsgAuthorizeCircuitBreaker{
+		Grantee: "cosmos139f7kncmglres2nf3h4hc4tade85ekfr8sulz5",
+		Granter: "cosmos16wfryel63g7axeamw68630wglalcnk3l0zuadc",
+	})
+	require.NoError(t, err)
+	tx := f.txBuilder.GetTx()
+
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, err error) {
+		return ctx, nil
+	}
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx)
+
+	// simulations, rechecks and executions are not accounted
+	_, err = decorator.AnteHandle(sdkCtx, tx, true, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(sdkCtx.WithIsReCheckTx(true), tx, false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(sdkCtx.WithExecMode(sdk.ExecModeFinalize), tx, false, next)
+	require.NoError(t, err)
+	require.Empty(t, circuitBreaker.recorded)
+
+	_, err = decorator.AnteHandle(sdkCtx, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/cosmos.circuit.v1.MsgAuthorizeCircuitBreaker"}, circuitBreaker.recorded)
+
+	_, err = decorator.AnteHandle(sdkCtx, tx, false, next)
+	require.ErrorIs(t, err, cbtypes.ErrRateLimitExceeded)
+}
@@ -30,6 +30,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "disabled-list",
 					Short:     "Query a list of all disabled message types",
 				},
+				{
+					RpcMethod:      "RateLimit",
+					Use:            "rate-limit [msg_type_url]",
+					Short:          "Query the rate limit of a message type and its current counter",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "msg_type_url"}},
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query all the rate limits and their current counters",
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -53,7 +64,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "TripCircuitBreaker",
 					Use:       "disable [msg_type_urls]",
 					Short:     "Disable a message from being executed",
-					Example:   fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend cosmos.bank.v1beta1.MsgMultiSend"`, version.AppName),
+					Example:   fmt.Sprintf(`%s circuit disable "cosmos.bank.v1beta1.MsgSend cosmos.bank.v1beta1.MsgMultiSend" --expiry-height 1000`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
@@ -67,6 +78,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "msg_type_urls", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "SetRateLimit",
+					Use:       "set-rate-limit [rate_limit_json]",
+					Short:     "Set the throughput limit of a message type, a max_msgs of 0 removes it",
+					Example:   fmt.Sprintf(`%s circuit set-rate-limit '{"msg_type_url":"/cosmos.bank.v1beta1.MsgSend","max_msgs":100,"window_blocks":10,"trip_duration":50}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "rate_limit"},
+					},
+				},
 			},
 		},
 	}
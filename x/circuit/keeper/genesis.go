@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 )
 
@@ -10,6 +12,8 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 	var (
 		permissions  []*types.GenesisAccountPermissions
 		disabledMsgs []string
+		rateLimits   []*types.RateLimit
+		tripExpiries []*types.TripExpiry
 	)
 
 	err = k.Permissions.Walk(ctx, nil, func(address []byte, perm types.Permissions) (stop bool, err error) {
@@ -30,6 +34,11 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 	}
 
 	err = k.DisableList.Walk(ctx, nil, func(msgUrl string) (stop bool, err error) {
+		// expired trips are not exported
+		expired, err := k.isTripExpired(ctx, msgUrl)
+		if err != nil || expired {
+			return err != nil, err
+		}
 		disabledMsgs = append(disabledMsgs, msgUrl)
 		return false, nil
 	})
@@ -37,9 +46,30 @@ func (k *Keeper) ExportGenesis(ctx context.Context) (data *types.GenesisState, e
 		return nil, err
 	}
 
+	for _, msgUrl := range disabledMsgs {
+		expiry, err := k.TripExpiries.Get(ctx, msgUrl)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		tripExpiries = append(tripExpiries, &types.TripExpiry{MsgTypeUrl: msgUrl, ExpiryHeight: expiry})
+	}
+
+	err = k.RateLimits.Walk(ctx, nil, func(_ string, limit types.RateLimit) (stop bool, err error) {
+		rateLimits = append(rateLimits, &limit)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.GenesisState{
 		AccountPermissions: permissions,
 		DisabledTypeUrls:   disabledMsgs,
+		RateLimits:         rateLimits,
+		TripExpiries:       tripExpiries,
 	}, nil
 }
 
@@ -62,6 +92,16 @@ func (k *Keeper) InitGenesis(ctx context.Context, genState *types.GenesisState)
 			return err
 		}
 	}
+	for _, expiry := range genState.TripExpiries {
+		if err := k.TripExpiries.Set(ctx, expiry.MsgTypeUrl, expiry.ExpiryHeight); err != nil {
+			return err
+		}
+	}
+	for _, limit := range genState.RateLimits {
+		if err := k.RateLimits.Set(ctx, limit.MsgTypeUrl, *limit); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -76,7 +76,10 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 	genesisState := &types.GenesisState{
 		AccountPermissions: accounts,
 		DisabledTypeUrls:   []string{url},
+		RateLimits:         []*types.RateLimit{{MsgTypeUrl: url, MaxMsgs: 10, WindowBlocks: 5, TripDuration: 100}},
+		TripExpiries:       []*types.TripExpiry{{MsgTypeUrl: url, ExpiryHeight: 50}},
 	}
+	s.Require().NoError(genesisState.Validate())
 
 	err = s.keeper.InitGenesis(s.ctx, genesisState)
 	s.Require().NoError(err)
@@ -92,4 +95,6 @@ func (s *GenesisTestSuite) TestInitExportGenesis() {
 
 	s.Require().Equal(genesisState.AccountPermissions, exportedGenesisState.AccountPermissions)
 	s.Require().Equal(genesisState.DisabledTypeUrls, exportedGenesisState.DisabledTypeUrls)
+	s.Require().Equal(genesisState.RateLimits, exportedGenesisState.RateLimits)
+	s.Require().Equal(genesisState.TripExpiries, exportedGenesisState.TripExpiries)
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
@@ -24,6 +25,12 @@ type Keeper struct {
 	Permissions collections.Map[[]byte, types.Permissions]
 	// DisableList contains the message URLs that are disabled
 	DisableList collections.KeySet[string]
+	// TripExpiries contains the height at which a disabled message URL is allowed again
+	TripExpiries collections.Map[string, int64]
+	// RateLimits contains the throughput limit of the message URLs
+	RateLimits collections.Map[string, types.RateLimit]
+	// RateLimitCounters contains the number of messages processed in the current window of a rate limit
+	RateLimitCounters collections.Map[string, types.RateLimitCounter]
 }
 
 // NewKeeper constructs a new Circuit Keeper instance
@@ -53,6 +60,27 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, authority strin
 			"disable_list",
 			collections.StringKey,
 		),
+		TripExpiries: collections.NewMap(
+			sb,
+			types.TripExpiryPrefix,
+			"trip_expiries",
+			collections.StringKey,
+			collections.Int64Value,
+		),
+		RateLimits: collections.NewMap(
+			sb,
+			types.RateLimitPrefix,
+			"rate_limits",
+			collections.StringKey,
+			codec.CollValue[types.RateLimit](cdc),
+		),
+		RateLimitCounters: collections.NewMap(
+			sb,
+			types.RateLimitCounterPrefix,
+			"rate_limit_counters",
+			collections.StringKey,
+			codec.CollValue[types.RateLimitCounter](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -68,8 +96,50 @@ func (k *Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
-// IsAllowed returns true when msg URL is not found in the DisableList for given context, else false.
+// IsAllowed returns true when msg URL is not found in the DisableList for given context,
+// or when its trip has expired, else false.
 func (k *Keeper) IsAllowed(ctx context.Context, msgURL string) (bool, error) {
 	has, err := k.DisableList.Has(ctx, msgURL)
-	return !has, err
+	if err != nil || !has {
+		return !has, err
+	}
+
+	return k.isTripExpired(ctx, msgURL)
+}
+
+// isTripExpired returns true when the msg URL was tripped with an expiry height
+// which has been reached.
+func (k *Keeper) isTripExpired(ctx context.Context, msgURL string) (bool, error) {
+	expiry, err := k.TripExpiries.Get(ctx, msgURL)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return k.HeaderService.HeaderInfo(ctx).Height >= expiry, nil
+}
+
+// trip disables the msg URL. A non-zero expiry height makes the circuit breaker
+// reset itself at that height.
+func (k *Keeper) trip(ctx context.Context, msgURL string, expiryHeight int64) error {
+	if err := k.DisableList.Set(ctx, msgURL); err != nil {
+		return err
+	}
+
+	if expiryHeight == 0 {
+		return k.TripExpiries.Remove(ctx, msgURL)
+	}
+
+	return k.TripExpiries.Set(ctx, msgURL, expiryHeight)
+}
+
+// reset enables back the msg URL.
+func (k *Keeper) reset(ctx context.Context, msgURL string) error {
+	if err := k.DisableList.Remove(ctx, msgURL); err != nil {
+		return err
+	}
+
+	return k.TripExpiries.Remove(ctx, msgURL)
 }
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/collections"
@@ -89,6 +90,10 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 		return nil, err
 	}
 
+	if msg.ExpiryHeight < 0 || (msg.ExpiryHeight > 0 && msg.ExpiryHeight <= srv.HeaderService.HeaderInfo(ctx).Height) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "expiry height must be in the future: %d", msg.ExpiryHeight)
+	}
+
 	for _, msgTypeURL := range msg.MsgTypeUrls {
 		// check if the message is in the list of allowed messages
 		isAllowed, err := srv.IsAllowed(ctx, msgTypeURL)
@@ -112,7 +117,7 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to trip circuit breaker")
 		}
 
-		if err = srv.DisableList.Set(ctx, msgTypeURL); err != nil {
+		if err = srv.trip(ctx, msgTypeURL, msg.ExpiryHeight); err != nil {
 			return nil, err
 		}
 
@@ -120,11 +125,15 @@ func (srv msgServer) TripCircuitBreaker(ctx context.Context, msg *types.MsgTripC
 
 	urls := strings.Join(msg.GetMsgTypeUrls(), ",")
 
-	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
-		"trip_circuit_breaker",
+	attrs := []event.Attribute{
 		event.NewAttribute("authority", msg.Authority),
 		event.NewAttribute("msg_url", urls),
-	); err != nil {
+	}
+	if msg.ExpiryHeight > 0 {
+		attrs = append(attrs, event.NewAttribute("expiry_height", strconv.FormatInt(msg.ExpiryHeight, 10)))
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV("trip_circuit_breaker", attrs...); err != nil {
 		return nil, err
 	}
 
@@ -171,7 +180,7 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to reset circuit breaker")
 		}
 
-		if err = srv.DisableList.Remove(ctx, msgTypeURL); err != nil {
+		if err = srv.reset(ctx, msgTypeURL); err != nil {
 			return nil, err
 		}
 	}
@@ -189,6 +198,67 @@ func (srv msgServer) ResetCircuitBreaker(ctx context.Context, msg *types.MsgRese
 	return &types.MsgResetCircuitBreakerResponse{Success: true}, nil
 }
 
+// SetRateLimit sets, or removes when max msgs is zero, the throughput limit of a Msg type URL.
+func (srv msgServer) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	address, err := srv.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.RateLimit == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rate limit cannot be nil")
+	}
+	limit := *msg.RateLimit
+
+	perms, err := srv.Permissions.Get(ctx, address)
+	if err != nil && !errorsmod.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
+	switch {
+	case perms.Level == types.Permissions_LEVEL_SUPER_ADMIN || perms.Level == types.Permissions_LEVEL_ALL_MSGS || bytes.Equal(address, srv.GetAuthority()):
+		// if the sender is a super admin or the module authority, no need to check perms
+	case perms.Level == types.Permissions_LEVEL_SOME_MSGS:
+		// if the sender has permission for some messages, check if the sender has permission for this specific message
+		if !hasPermissionForMsg(perms, limit.MsgTypeUrl) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "account does not have permission to set rate limit for message %s", limit.MsgTypeUrl)
+		}
+	default:
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "account does not have permission to set rate limit")
+	}
+
+	// a new rate limit starts a new window
+	if err = srv.RateLimitCounters.Remove(ctx, limit.MsgTypeUrl); err != nil {
+		return nil, err
+	}
+
+	if limit.MaxMsgs == 0 {
+		if limit.MsgTypeUrl == "" {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "rate limit msg type url cannot be empty")
+		}
+		if err = srv.RateLimits.Remove(ctx, limit.MsgTypeUrl); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = limit.Validate(); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err = srv.RateLimits.Set(ctx, limit.MsgTypeUrl, limit); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = srv.Keeper.EventService.EventManager(ctx).EmitKV(
+		"set_rate_limit",
+		event.NewAttribute("authority", msg.Authority),
+		event.NewAttribute("rate_limit", limit.String()),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
 // hasPermissionForMsg returns true if the account can trip or reset the message.
 func hasPermissionForMsg(perms types.Permissions, msg string) bool {
 	for _, msgurl := range perms.LimitTypeUrls {
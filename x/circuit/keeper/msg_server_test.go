@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
@@ -373,3 +374,102 @@ func TestResetCircuitBreakerSomeMsgs(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, allowed, "circuit breaker should be reset")
 }
+
+func TestTripCircuitBreakerWithExpiry(t *testing.T) {
+	ft := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(ft.ctx).WithHeaderInfo(header.Info{Height: 10})
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	// expiry height in the past
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 10})
+	require.ErrorContains(t, err, "expiry height must be in the future")
+
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}, ExpiryHeight: 15})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"trip_circuit_breaker",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("expiry_height", "15"),
+		),
+		lastEvent(ctx),
+	)
+
+	allowed, err := ft.keeper.IsAllowed(ctx.WithHeaderInfo(header.Info{Height: 14}), msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed, "circuit breaker should be tripped")
+
+	// the circuit breaker resets itself at the expiry height
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 15})
+	allowed, err = ft.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed, "circuit breaker should be reset")
+
+	// an expired trip can be tripped again, without expiry
+	_, err = srv.TripCircuitBreaker(ctx, &types.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{msgSend}})
+	require.NoError(t, err)
+
+	allowed, err = ft.keeper.IsAllowed(ctx.WithHeaderInfo(header.Info{Height: 1000}), msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed, "circuit breaker should be tripped")
+
+	has, err := ft.keeper.TripExpiries.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestSetRateLimit(t *testing.T) {
+	ft := initFixture(t)
+
+	srv := keeper.NewMsgServerImpl(ft.keeper)
+	authority, err := ft.ac.BytesToString(ft.mockAddr)
+	require.NoError(t, err)
+
+	limit := types.RateLimit{MsgTypeUrl: msgSend, MaxMsgs: 10, WindowBlocks: 5}
+
+	// invalid rate limits
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: authority})
+	require.ErrorContains(t, err, "rate limit cannot be nil")
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: &types.RateLimit{MsgTypeUrl: msgSend, MaxMsgs: 1}})
+	require.ErrorContains(t, err, "window blocks must be positive")
+
+	// the module authority sets a rate limit
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: &limit})
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"set_rate_limit",
+			sdk.NewAttribute("authority", authority),
+			sdk.NewAttribute("rate_limit", limit.String()),
+		),
+		lastEvent(ft.ctx),
+	)
+
+	got, err := ft.keeper.RateLimits.Get(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, limit, got)
+
+	// user with permissions for another message
+	somemsgs := &types.Permissions{Level: types.Permissions_LEVEL_SOME_MSGS, LimitTypeUrls: []string{"cosmos.gov.v1beta1.MsgVote"}}
+	_, err = srv.AuthorizeCircuitBreaker(ft.ctx, &types.MsgAuthorizeCircuitBreaker{Granter: authority, Grantee: addresses[2], Permissions: somemsgs})
+	require.NoError(t, err)
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: addresses[2], RateLimit: &limit})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// user with no permission
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: addresses[4], RateLimit: &limit})
+	require.ErrorContains(t, err, "unauthorized")
+
+	// a max msgs of zero removes the rate limit
+	_, err = srv.SetRateLimit(ft.ctx, &types.MsgSetRateLimit{Authority: authority, RateLimit: &types.RateLimit{MsgTypeUrl: msgSend}})
+	require.NoError(t, err)
+	has, err := ft.keeper.RateLimits.Has(ft.ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/circuit/types"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -64,6 +66,11 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 	// Iterate over disabled list and perform the callback
 	var msgs []string
 	err := qs.keeper.DisableList.Walk(ctx, nil, func(msgUrl string) (bool, error) {
+		expired, err := qs.keeper.isTripExpired(ctx, msgUrl)
+		if err != nil || expired {
+			return err != nil, err
+		}
+
 		msgs = append(msgs, msgUrl)
 		return false, nil
 	})
@@ -71,5 +78,59 @@ func (qs QueryServer) DisabledList(ctx context.Context, req *types.QueryDisabled
 		return nil, err
 	}
 
-	return &types.DisabledListResponse{DisabledList: msgs}, nil
+	var expiries []*types.TripExpiry
+	for _, msgUrl := range msgs {
+		expiry, err := qs.keeper.TripExpiries.Get(ctx, msgUrl)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		expiries = append(expiries, &types.TripExpiry{MsgTypeUrl: msgUrl, ExpiryHeight: expiry})
+	}
+
+	return &types.DisabledListResponse{DisabledList: msgs, TripExpiries: expiries}, nil
+}
+
+// RateLimit returns the rate limit of a msg type url and its current counter.
+func (qs QueryServer) RateLimit(ctx context.Context, req *types.QueryRateLimitRequest) (*types.RateLimitResponse, error) {
+	limit, err := qs.keeper.RateLimits.Get(ctx, req.MsgTypeUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := qs.rateLimitState(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RateLimitResponse{RateLimit: state}, nil
+}
+
+// RateLimits returns all the rate limits and their current counters.
+func (qs QueryServer) RateLimits(ctx context.Context, req *types.QueryRateLimitsRequest) (*types.RateLimitsResponse, error) {
+	results, pageRes, err := query.CollectionPaginate(
+		ctx,
+		qs.keeper.RateLimits,
+		req.Pagination,
+		func(_ string, value types.RateLimit) (*types.RateLimitState, error) {
+			return qs.rateLimitState(ctx, value)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RateLimitsResponse{RateLimits: results, Pagination: pageRes}, nil
+}
+
+func (qs QueryServer) rateLimitState(ctx context.Context, limit types.RateLimit) (*types.RateLimitState, error) {
+	counter, err := qs.keeper.GetRateLimitCounter(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RateLimitState{RateLimit: &limit, Counter: &counter}, nil
 }
@@ -5,9 +5,11 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/circuit/keeper"
 	"cosmossdk.io/x/circuit/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
@@ -98,3 +100,53 @@ func TestQueryDisabledList(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
 }
+
+func TestQueryDisabledListWithExpiry(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 10})
+
+	qs := keeper.NewQueryServer(f.keeper)
+
+	require.NoError(t, f.keeper.DisableList.Set(ctx, f.mockMsgURL))
+	require.NoError(t, f.keeper.DisableList.Set(ctx, "expired_url"))
+	require.NoError(t, f.keeper.TripExpiries.Set(ctx, f.mockMsgURL, 20))
+	require.NoError(t, f.keeper.TripExpiries.Set(ctx, "expired_url", 10))
+
+	// expired trips are not listed
+	disabledList, err := qs.DisabledList(ctx, &types.QueryDisabledListRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{f.mockMsgURL}, disabledList.DisabledList)
+	require.Equal(t, []*types.TripExpiry{{MsgTypeUrl: f.mockMsgURL, ExpiryHeight: 20}}, disabledList.TripExpiries)
+}
+
+func TestQueryRateLimits(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 10})
+
+	qs := keeper.NewQueryServer(f.keeper)
+
+	_, err := qs.RateLimit(ctx, &types.QueryRateLimitRequest{MsgTypeUrl: f.mockMsgURL})
+	require.Error(t, err)
+
+	limit := types.RateLimit{MsgTypeUrl: f.mockMsgURL, MaxMsgs: 5, WindowBlocks: 10}
+	require.NoError(t, f.keeper.RateLimits.Set(ctx, f.mockMsgURL, limit))
+	require.NoError(t, f.keeper.RecordMsg(ctx, f.mockMsgURL))
+
+	res, err := qs.RateLimit(ctx, &types.QueryRateLimitRequest{MsgTypeUrl: f.mockMsgURL})
+	require.NoError(t, err)
+	require.Equal(t, &types.RateLimitState{
+		RateLimit: &limit,
+		Counter:   &types.RateLimitCounter{WindowStart: 10, Count: 1},
+	}, res.RateLimit)
+
+	// the counter of an elapsed window is reported as a new window
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 20})
+	all, err := qs.RateLimits(ctx, &types.QueryRateLimitsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []*types.RateLimitState{{
+		RateLimit: &limit,
+		Counter:   &types.RateLimitCounter{WindowStart: 20},
+	}}, all.RateLimits)
+}
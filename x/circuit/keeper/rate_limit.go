@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/circuit/types"
+)
+
+// RecordMsg accounts a message of the given type URL against its rate limit, if any.
+// It returns ErrRateLimitExceeded when the rate limit has already been reached in
+// the current window. The message which reaches the rate limit trips the circuit
+// breaker for the type URL, until the end of the window or for the trip duration
+// of the rate limit.
+func (k *Keeper) RecordMsg(ctx context.Context, msgURL string) error {
+	limit, err := k.RateLimits.Get(ctx, msgURL)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	height := k.HeaderService.HeaderInfo(ctx).Height
+	counter, err := k.rateLimitCounter(ctx, limit, height)
+	if err != nil {
+		return err
+	}
+
+	if counter.Count >= limit.MaxMsgs {
+		return errorsmod.Wrapf(types.ErrRateLimitExceeded, "%d messages of type %s per %d blocks", limit.MaxMsgs, msgURL, limit.WindowBlocks)
+	}
+
+	counter.Count++
+	if err := k.RateLimitCounters.Set(ctx, msgURL, counter); err != nil {
+		return err
+	}
+
+	if counter.Count < limit.MaxMsgs {
+		return nil
+	}
+
+	expiryHeight := counter.WindowStart + int64(limit.WindowBlocks)
+	if limit.TripDuration > 0 {
+		expiryHeight = height + int64(limit.TripDuration)
+	}
+
+	if err := k.trip(ctx, msgURL, expiryHeight); err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		"rate_limit_circuit_breaker",
+		event.NewAttribute("msg_url", msgURL),
+		event.NewAttribute("expiry_height", strconv.FormatInt(expiryHeight, 10)),
+	)
+}
+
+// GetRateLimitCounter returns the counter of the current window of the given rate limit.
+func (k *Keeper) GetRateLimitCounter(ctx context.Context, limit types.RateLimit) (types.RateLimitCounter, error) {
+	return k.rateLimitCounter(ctx, limit, k.HeaderService.HeaderInfo(ctx).Height)
+}
+
+// rateLimitCounter returns the counter of the window of the rate limit which
+// includes the given height, starting a new window when the stored one is over.
+func (k *Keeper) rateLimitCounter(ctx context.Context, limit types.RateLimit, height int64) (types.RateLimitCounter, error) {
+	counter, err := k.RateLimitCounters.Get(ctx, limit.MsgTypeUrl)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.RateLimitCounter{}, err
+	}
+
+	if errors.Is(err, collections.ErrNotFound) || height >= counter.WindowStart+int64(limit.WindowBlocks) {
+		return types.RateLimitCounter{WindowStart: height}, nil
+	}
+
+	return counter, nil
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestRecordMsg(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 100})
+
+	// messages without rate limit are not accounted
+	require.NoError(t, f.keeper.RecordMsg(ctx, msgSend))
+	has, err := f.keeper.RateLimitCounters.Has(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	limit := types.RateLimit{MsgTypeUrl: msgSend, MaxMsgs: 3, WindowBlocks: 10}
+	require.NoError(t, f.keeper.RateLimits.Set(ctx, msgSend, limit))
+
+	require.NoError(t, f.keeper.RecordMsg(ctx, msgSend))
+	require.NoError(t, f.keeper.RecordMsg(ctx.WithHeaderInfo(header.Info{Height: 105}), msgSend))
+
+	counter, err := f.keeper.GetRateLimitCounter(ctx, limit)
+	require.NoError(t, err)
+	require.Equal(t, types.RateLimitCounter{WindowStart: 100, Count: 2}, counter)
+
+	allowed, err := f.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	// the message reaching the limit trips the circuit breaker until the end of the window
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 107})
+	require.NoError(t, f.keeper.RecordMsg(ctx, msgSend))
+	require.Equal(
+		t,
+		sdk.NewEvent(
+			"rate_limit_circuit_breaker",
+			sdk.NewAttribute("msg_url", msgSend),
+			sdk.NewAttribute("expiry_height", "110"),
+		),
+		lastEvent(ctx),
+	)
+
+	allowed, err = f.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// messages above the limit are rejected
+	require.ErrorIs(t, f.keeper.RecordMsg(ctx, msgSend), types.ErrRateLimitExceeded)
+
+	// the next window starts from a zero counter, with the circuit breaker reset
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 110})
+	allowed, err = f.keeper.IsAllowed(ctx, msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	counter, err = f.keeper.GetRateLimitCounter(ctx, limit)
+	require.NoError(t, err)
+	require.Equal(t, types.RateLimitCounter{WindowStart: 110}, counter)
+	require.NoError(t, f.keeper.RecordMsg(ctx, msgSend))
+}
+
+func TestRecordMsgTripDuration(t *testing.T) {
+	t.Parallel()
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithHeaderInfo(header.Info{Height: 100})
+
+	// one message per block, tripping the circuit breaker for 50 blocks
+	limit := types.RateLimit{MsgTypeUrl: msgSend, MaxMsgs: 1, WindowBlocks: 1, TripDuration: 50}
+	require.NoError(t, f.keeper.RateLimits.Set(ctx, msgSend, limit))
+
+	require.NoError(t, f.keeper.RecordMsg(ctx, msgSend))
+
+	expiry, err := f.keeper.TripExpiries.Get(ctx, msgSend)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), expiry)
+
+	allowed, err := f.keeper.IsAllowed(ctx.WithHeaderInfo(header.Info{Height: 149}), msgSend)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = f.keeper.IsAllowed(ctx.WithHeaderInfo(header.Info{Height: 150}), msgSend)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -0,0 +1,6 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// ErrRateLimitExceeded is returned when more messages of a type URL than allowed by its rate limit are processed.
+var ErrRateLimitExceeded = errors.Register(ModuleName, 2, "rate limit exceeded")
@@ -20,6 +20,28 @@ func (gs *GenesisState) Validate() error {
 		}
 	}
 
+	disabled := make(map[string]bool, len(gs.DisabledTypeUrls))
+	for _, url := range gs.DisabledTypeUrls {
+		disabled[url] = true
+	}
+	for _, expiry := range gs.TripExpiries {
+		if !disabled[expiry.MsgTypeUrl] {
+			return fmt.Errorf("trip expiry for a msg type url which is not disabled: %s", expiry.MsgTypeUrl)
+		}
+		if expiry.ExpiryHeight <= 0 {
+			return fmt.Errorf("trip expiry height must be positive, msg type url: %s", expiry.MsgTypeUrl)
+		}
+	}
+
+	for _, limit := range gs.RateLimits {
+		if limit == nil {
+			return fmt.Errorf("rate limit cannot be nil")
+		}
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -14,4 +14,7 @@ const (
 var (
 	AccountPermissionPrefix = collections.NewPrefix(1)
 	DisableListPrefix       = collections.NewPrefix(2)
+	TripExpiryPrefix        = collections.NewPrefix(3)
+	RateLimitPrefix         = collections.NewPrefix(4)
+	RateLimitCounterPrefix  = collections.NewPrefix(5)
 )
@@ -0,0 +1,18 @@
+package types
+
+import "fmt"
+
+// Validate performs a basic validation of the rate limit.
+func (r RateLimit) Validate() error {
+	if r.MsgTypeUrl == "" {
+		return fmt.Errorf("rate limit msg type url cannot be empty")
+	}
+	if r.MaxMsgs == 0 {
+		return fmt.Errorf("rate limit max msgs must be positive, msg type url: %s", r.MsgTypeUrl)
+	}
+	if r.WindowBlocks == 0 {
+		return fmt.Errorf("rate limit window blocks must be positive, msg type url: %s", r.MsgTypeUrl)
+	}
+
+	return nil
+}
@@ -85,6 +85,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query the amount of coins in the community pool",
 					Example:   fmt.Sprintf(`$ %s query distribution community-pool`, version.AppName),
 				},
+				{
+					RpcMethod: "AutoCompound",
+					Use:       "auto-compound [delegator-addr]",
+					Short:     "Query whether the rewards of a delegator are auto-compounded",
+					Example:   fmt.Sprintf("$ %s query distribution auto-compound [delegator-address]", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -125,6 +134,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount", Varargs: true},
 					},
 				},
+				{
+					RpcMethod: "WithdrawTokenizeShareRecordReward",
+					Use:       "withdraw-tokenize-share-rewards --from [owner]",
+					Short:     "Withdraw the rewards of all the tokenize share records owned by an address",
+					Example:   fmt.Sprintf("$ %s tx distribution withdraw-tokenize-share-rewards --from mykey", version.AppName),
+				},
+				{
+					RpcMethod: "SetAutoCompound",
+					Use:       "set-auto-compound [enabled]",
+					Short:     "Enable or disable the auto-compounding of the delegation rewards",
+					Long:      "When enabled, the rewards of all the delegations of the delegator are withdrawn and re-delegated to the same validators at the end of each auto-compound epoch.",
+					Example:   fmt.Sprintf("$ %s tx distribution set-auto-compound true --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "enabled"},
+					},
+				},
 				{
 					RpcMethod:  "FundCommunityPool",
 					Deprecated: fmt.Sprintf("Use %s tx protocolpool fund-community-pool", version.AppName),
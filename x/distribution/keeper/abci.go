@@ -34,6 +34,11 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		}
 	}
 
+	// compound the rewards of the delegators of the auto-compound round in progress
+	if err := k.compoundDelegators(ctx); err != nil {
+		return err
+	}
+
 	// record the proposer for when we payout on the next block
 	consAddr := sdk.ConsAddress(ctx.BlockHeader().ProposerAddress)
 	return k.PreviousProposer.Set(ctx, consAddr)
@@ -0,0 +1,240 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetAutoCompound enables or disables the auto-compounding of the rewards of a delegator.
+func (k Keeper) SetAutoCompound(ctx context.Context, delAddr sdk.AccAddress, enabled bool) error {
+	if enabled {
+		return k.AutoCompoundDelegators.Set(ctx, delAddr)
+	}
+
+	return k.AutoCompoundDelegators.Remove(ctx, delAddr)
+}
+
+// startAutoCompoundRound starts a new auto-compound round from the first delegator
+// who enabled auto-compounding. A round still in progress is continued instead.
+func (k Keeper) startAutoCompoundRound(ctx context.Context) error {
+	inProgress, err := k.AutoCompoundCursor.Has(ctx)
+	if err != nil || inProgress {
+		return err
+	}
+
+	iter, err := k.AutoCompoundDelegators.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return nil
+	}
+
+	first, err := iter.Key()
+	if err != nil {
+		return err
+	}
+
+	return k.AutoCompoundCursor.Set(ctx, collections.Join(first, sdk.ValAddress{}))
+}
+
+// compoundDelegators compounds at most MaxAutoCompoundPerBlock delegations of the
+// auto-compound round in progress, starting from the cursor. A delegator who has no
+// delegation to compound counts as one delegation, so that a block always progresses
+// through a bounded number of delegators.
+func (k Keeper) compoundDelegators(ctx context.Context) error {
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// auto-compounding was disabled during the round
+	if params.AutoCompoundEpochIdentifier == "" {
+		return k.AutoCompoundCursor.Remove(ctx)
+	}
+
+	iter, err := k.AutoCompoundDelegators.Iterate(ctx, new(collections.Range[sdk.AccAddress]).StartInclusive(cursor.K1()))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	budget := params.MaxAutoCompoundPerBlock
+	for ; iter.Valid(); iter.Next() {
+		delAddr, err := iter.Key()
+		if err != nil {
+			return err
+		}
+
+		if budget == 0 {
+			return k.AutoCompoundCursor.Set(ctx, collections.Join(delAddr, sdk.ValAddress{}))
+		}
+
+		// the delegator of the cursor is resumed from the validator of the cursor
+		var fromValAddr sdk.ValAddress
+		if delAddr.Equals(cursor.K1()) {
+			fromValAddr = cursor.K2()
+		}
+
+		nextValAddr, compounded, err := k.compoundDelegator(ctx, delAddr, fromValAddr, budget)
+		if err != nil {
+			return err
+		}
+		if nextValAddr != nil {
+			return k.AutoCompoundCursor.Set(ctx, collections.Join(delAddr, nextValAddr))
+		}
+		budget -= compounded
+	}
+
+	return k.AutoCompoundCursor.Remove(ctx)
+}
+
+// compoundDelegator withdraws the rewards of at most limit delegations of a delegator,
+// in validator address order starting from fromValAddr, and re-delegates the bond denom
+// rewards to the same validators. It returns the number of delegations used from the
+// limit, and the validator address of the next delegation to compound when the limit
+// is reached before all the delegations were compounded. The rewards of a delegator
+// whose withdraw address is another account are not compounded.
+func (k Keeper) compoundDelegator(ctx context.Context, delAddr sdk.AccAddress, fromValAddr sdk.ValAddress, limit uint32) (sdk.ValAddress, uint32, error) {
+	withdrawAddr, err := k.GetDelegatorWithdrawAddr(ctx, delAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !withdrawAddr.Equals(delAddr) {
+		return nil, 1, nil
+	}
+
+	delegator, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// at most limit+1 delegations are read, the last one being where the next block resumes
+	var valAddrs []sdk.ValAddress
+	var iterErr error
+	err = k.stakingKeeper.IterateDelegatorDelegationsFrom(ctx, delAddr, fromValAddr, func(delegation stakingtypes.Delegation) bool {
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(delegation.ValidatorAddress)
+		if err != nil {
+			iterErr = err
+			return true
+		}
+		valAddrs = append(valAddrs, valAddr)
+		return uint32(len(valAddrs)) > limit
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if iterErr != nil {
+		return nil, 0, iterErr
+	}
+
+	if len(valAddrs) == 0 {
+		return nil, 1, nil
+	}
+
+	var compounded uint32
+	for _, valAddr := range valAddrs {
+		if compounded == limit {
+			return valAddr, compounded, nil
+		}
+		compounded++
+
+		validator, err := k.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// a delegation failing to compound must not prevent the other ones from compounding
+		err = k.BranchService.Execute(ctx, func(ctx context.Context) error {
+			rewards, err := k.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+			if err != nil {
+				return err
+			}
+
+			amount := sdk.NewCoin(bondDenom, rewards.AmountOf(bondDenom))
+			if !amount.IsPositive() {
+				return nil
+			}
+
+			msg := &stakingtypes.MsgDelegate{
+				DelegatorAddress: delegator,
+				ValidatorAddress: validator,
+				Amount:           amount,
+			}
+			if err := k.MsgRouterService.InvokeTyped(ctx, msg, &stakingtypes.MsgDelegateResponse{}); err != nil {
+				return err
+			}
+
+			return k.EventService.EventManager(ctx).EmitKV(
+				types.EventTypeAutoCompound,
+				event.NewAttribute(types.AttributeKeyDelegator, delegator),
+				event.NewAttribute(types.AttributeKeyValidator, validator),
+				event.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			)
+		})
+		if err != nil {
+			k.Logger.Error("failed to auto-compound delegation rewards", "delegator", delegator, "validator", validator, "err", err)
+		}
+	}
+
+	return nil, compounded, nil
+}
+
+// EpochHooks wrapper struct for the distribution keeper
+type EpochHooks struct {
+	k Keeper
+}
+
+var _ types.EpochHooks = EpochHooks{}
+
+// EpochHooks returns the distribution epoch hooks
+func (k Keeper) EpochHooks() EpochHooks {
+	return EpochHooks{k}
+}
+
+// GetModuleName implements types.EpochHooks.
+func (EpochHooks) GetModuleName() string {
+	return types.ModuleName
+}
+
+// BeforeEpochStart implements types.EpochHooks.
+func (EpochHooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// AfterEpochEnd starts an auto-compound round at the end of each auto-compound epoch.
+// The delegators are then compounded in the following blocks.
+func (h EpochHooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AutoCompoundEpochIdentifier == "" || epochIdentifier != params.AutoCompoundEpochIdentifier {
+		return nil
+	}
+
+	return h.k.startAutoCompoundRound(ctx)
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAutoCompoundRound(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+
+	params := types.DefaultParams()
+	params.MaxAutoCompoundPerBlock = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	delegators := simtestutil.CreateIncrementalAccounts(3)
+	for _, delAddr := range delegators {
+		require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, true))
+	}
+
+	// disabling removes the delegator from the round
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delegators[2], false))
+	has, err := distrKeeper.AutoCompoundDelegators.Has(ctx, delegators[2])
+	require.NoError(t, err)
+	require.False(t, has)
+	require.NoError(t, distrKeeper.SetAutoCompound(ctx, delegators[2], true))
+
+	// no round is started by another epoch
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, "week", 1))
+	has, err = distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, params.AutoCompoundEpochIdentifier, 1))
+	cursor, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, delegators[0], cursor.K1())
+	require.Empty(t, cursor.K2())
+
+	compounded := map[string]int{}
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	dep.stakingKeeper.EXPECT().IterateDelegatorDelegationsFrom(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, delAddr sdk.AccAddress, _ sdk.ValAddress, _ func(stakingtypes.Delegation) bool) error {
+			compounded[delAddr.String()]++
+			return nil
+		}).AnyTimes()
+
+	// the first block only compounds MaxAutoCompoundPerBlock delegators without delegations
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	require.Len(t, compounded, 2)
+	cursor, err = distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, delegators[2], cursor.K1())
+	require.Empty(t, cursor.K2())
+
+	// the second block ends the round
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	require.Len(t, compounded, 3)
+	has, err = distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// no delegator is compounded until the next epoch
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	for _, delAddr := range delegators {
+		require.Equal(t, 1, compounded[delAddr.String()])
+	}
+}
+
+func TestAutoCompoundRoundDelegationBudget(t *testing.T) {
+	ctx, _, distrKeeper, dep := initFixture(t)
+
+	params := types.DefaultParams()
+	params.MaxAutoCompoundPerBlock = 2
+	require.NoError(t, distrKeeper.Params.Set(ctx, params))
+
+	delegators := simtestutil.CreateIncrementalAccounts(2)
+	for _, delAddr := range delegators {
+		require.NoError(t, distrKeeper.SetAutoCompound(ctx, delAddr, true))
+	}
+
+	// the first delegator has 3 delegations, in validator address order
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	valAddrs := []sdk.ValAddress{
+		sdk.ValAddress(delegators[0]),
+		sdk.ValAddress(delegators[1]),
+		sdk.ValAddress(simtestutil.CreateIncrementalAccounts(3)[2]),
+	}
+	var delegations []stakingtypes.Delegation
+	for _, valAddr := range valAddrs {
+		val, err := valCodec.BytesToString(valAddr)
+		require.NoError(t, err)
+		delegations = append(delegations, stakingtypes.Delegation{ValidatorAddress: val})
+	}
+
+	dep.stakingKeeper.EXPECT().BondDenom(gomock.Any()).Return(sdk.DefaultBondDenom, nil).AnyTimes()
+	var read int
+	dep.stakingKeeper.EXPECT().IterateDelegatorDelegationsFrom(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, delAddr sdk.AccAddress, fromValAddr sdk.ValAddress, cb func(stakingtypes.Delegation) bool) error {
+			if !delAddr.Equals(delegators[0]) {
+				return nil
+			}
+			for i, delegation := range delegations {
+				if bytes.Compare(valAddrs[i], fromValAddr) < 0 {
+					continue
+				}
+				read++
+				if cb(delegation) {
+					return nil
+				}
+			}
+			return nil
+		}).AnyTimes()
+
+	// the withdrawal of the rewards fails, which is logged and does not stop the round
+	var compounded []sdk.ValAddress
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, valAddr sdk.ValAddress) (sdk.ValidatorI, error) {
+			compounded = append(compounded, valAddr)
+			return nil, errors.New("no validator")
+		}).AnyTimes()
+
+	require.NoError(t, distrKeeper.EpochHooks().AfterEpochEnd(ctx, params.AutoCompoundEpochIdentifier, 1))
+
+	// the first block compounds the first 2 delegations of the first delegator
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0], valAddrs[1]}, compounded)
+	require.Equal(t, 3, read)
+	cursor, err := distrKeeper.AutoCompoundCursor.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, collections.Join(delegators[0], valAddrs[2]), cursor)
+
+	// the second block resumes from the validator of the cursor, compounds the last
+	// delegation and the second delegator
+	require.NoError(t, distrKeeper.BeginBlocker(ctx))
+	require.Equal(t, []sdk.ValAddress{valAddrs[0], valAddrs[1], valAddrs[2]}, compounded)
+	require.Equal(t, 4, read)
+	has, err := distrKeeper.AutoCompoundCursor.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -126,6 +127,36 @@ func (k Keeper) InitGenesis(ctx context.Context, data types.GenesisState) error
 		}
 	}
 
+	for _, del := range data.AutoCompoundDelegators {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(del)
+		if err != nil {
+			return err
+		}
+
+		if err := k.AutoCompoundDelegators.Set(ctx, delAddr); err != nil {
+			return err
+		}
+	}
+
+	if cursor := data.AutoCompoundCursor; cursor != nil {
+		delAddr, err := k.authKeeper.AddressCodec().StringToBytes(cursor.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+
+		valAddr := sdk.ValAddress{}
+		if cursor.ValidatorAddress != "" {
+			valAddr, err = k.stakingKeeper.ValidatorAddressCodec().StringToBytes(cursor.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := k.AutoCompoundCursor.Set(ctx, collections.Join(sdk.AccAddress(delAddr), valAddr)); err != nil {
+			return err
+		}
+	}
+
 	moduleHoldings = moduleHoldings.Add(data.FeePool.DecimalPool...)
 	moduleHoldingsInt, _ := moduleHoldings.TruncateDecimal()
 
@@ -303,9 +334,46 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	autoCompoundDelegators := make([]string, 0)
+	err = k.AutoCompoundDelegators.Walk(ctx, nil, func(delAddr sdk.AccAddress) (stop bool, err error) {
+		del, err := k.authKeeper.AddressCodec().BytesToString(delAddr)
+		if err != nil {
+			return true, err
+		}
+
+		autoCompoundDelegators = append(autoCompoundDelegators, del)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var autoCompoundCursor *types.AutoCompoundCursor
+	cursor, err := k.AutoCompoundCursor.Get(ctx)
+	if err == nil {
+		autoCompoundCursor = &types.AutoCompoundCursor{}
+		autoCompoundCursor.DelegatorAddress, err = k.authKeeper.AddressCodec().BytesToString(cursor.K1())
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cursor.K2()) > 0 {
+			autoCompoundCursor.ValidatorAddress, err = k.stakingKeeper.ValidatorAddressCodec().BytesToString(cursor.K2())
+			if err != nil {
+				return nil, err
+			}
+		}
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return nil, err
+	}
+
 	ppAddr, err := k.stakingKeeper.ConsensusAddressCodec().BytesToString(pp)
 	if err != nil {
 		return nil, err
 	}
-	return types.NewGenesisState(params, feePool, dwi, ppAddr, outstanding, acc, his, cur, dels, slashes), nil
+
+	genState := types.NewGenesisState(params, feePool, dwi, ppAddr, outstanding, acc, his, cur, dels, slashes)
+	genState.AutoCompoundDelegators = autoCompoundDelegators
+	genState.AutoCompoundCursor = autoCompoundCursor
+	return genState, nil
 }
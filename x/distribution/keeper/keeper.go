@@ -51,6 +51,11 @@ type Keeper struct {
 	PreviousProposer           collections.Item[sdk.ConsAddress]
 	// ValidatorSlashEvents key: valAddr+height+period | value: ValidatorSlashEvent
 	ValidatorSlashEvents collections.Map[collections.Triple[sdk.ValAddress, uint64, uint64], types.ValidatorSlashEvent]
+	// AutoCompoundDelegators key: delAddr | the delegators who enabled auto-compounding
+	AutoCompoundDelegators collections.KeySet[sdk.AccAddress]
+	// AutoCompoundCursor value: delAddr+valAddr | the next delegation to compound, it is
+	// only set while an auto-compound round is in progress
+	AutoCompoundCursor collections.Item[collections.Pair[sdk.AccAddress, sdk.ValAddress]]
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 }
@@ -129,6 +134,8 @@ func NewKeeper(
 			collections.TripleKeyCodec(sdk.LengthPrefixedAddressKey(sdk.ValAddressKey), collections.Uint64Key, collections.Uint64Key), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			codec.CollValue[types.ValidatorSlashEvent](cdc),
 		),
+		AutoCompoundDelegators: collections.NewKeySet(sb, types.AutoCompoundDelegatorsPrefix, "auto_compound_delegators", sdk.AccAddressKey),
+		AutoCompoundCursor:     collections.NewItem(sb, types.AutoCompoundCursorKey, "auto_compound_cursor", collcodec.KeyToValueCodec(collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey))),
 	}
 
 	schema, err := sb.Build()
@@ -260,6 +267,66 @@ func (k Keeper) WithdrawValidatorCommission(ctx context.Context, valAddr sdk.Val
 	return commission, nil
 }
 
+// WithdrawTokenizeShareRecordReward withdraws the rewards of the delegations of all
+// the tokenize share records owned by ownerAddr and sends them to the owner.
+func (k Keeper) WithdrawTokenizeShareRecordReward(ctx context.Context, ownerAddr sdk.AccAddress) (sdk.Coins, error) {
+	records, err := k.stakingKeeper.GetTokenizeShareRecordsByOwner(ctx, ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, types.ErrNoTokenizeShareRecords
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, record := range records {
+		recordAddr, err := k.authKeeper.AddressCodec().StringToBytes(record.ModuleAccount)
+		if err != nil {
+			return nil, err
+		}
+
+		valAddr, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(record.Validator)
+		if err != nil {
+			return nil, err
+		}
+
+		// the rewards are withdrawn to the record account, which may also hold
+		// rewards withdrawn when the record delegation was modified
+		_, err = k.WithdrawDelegationRewards(ctx, recordAddr, valAddr)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+
+		rewards := k.bankKeeper.GetAllBalances(ctx, recordAddr)
+		if rewards.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, ownerAddr, rewards); err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	owner, err := k.authKeeper.AddressCodec().BytesToString(ownerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeWithdrawTokenizeShareReward,
+		event.NewAttribute(types.AttributeKeyWithdrawAddress, owner),
+		event.NewAttribute(sdk.AttributeKeyAmount, totalRewards.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return totalRewards, nil
+}
+
 // GetTotalRewards returns the total amount of fee distribution rewards held in the store
 func (k Keeper) GetTotalRewards(ctx context.Context) (totalRewards sdk.DecCoins) {
 	err := k.ValidatorOutstandingRewards.Walk(ctx, nil, func(_ sdk.ValAddress, rewards types.ValidatorOutstandingRewards) (stop bool, err error) {
@@ -7,6 +7,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/log"
 	"cosmossdk.io/math"
@@ -16,6 +17,7 @@ import (
 	"cosmossdk.io/x/distribution/keeper"
 	distrtestutil "cosmossdk.io/x/distribution/testutil"
 	"cosmossdk.io/x/distribution/types"
+	stakingtypes "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -154,3 +156,33 @@ func TestGetTotalRewards(t *testing.T) {
 
 	require.Equal(t, expectedRewards, totalRewards)
 }
+
+func TestWithdrawTokenizeShareRecordReward(t *testing.T) {
+	ctx, addrs, distrKeeper, dep := initFixture(t)
+
+	owner := addrs[0]
+	recordAddr := stakingtypes.TokenizeShareRecordAddress(1)
+	valAddr := sdk.ValAddress(addrs[1])
+
+	// no records owned
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return(nil, nil)
+	_, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.ErrorIs(t, err, types.ErrNoTokenizeShareRecords)
+
+	recordAddrStr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recordAddr)
+	require.NoError(t, err)
+	valAddrStr, err := codectestutil.CodecOptions{}.GetValidatorCodec().BytesToString(valAddr)
+	require.NoError(t, err)
+	record := stakingtypes.TokenizeShareRecord{Id: 1, ModuleAccount: recordAddrStr, Validator: valAddrStr}
+
+	// the record delegation is gone, only the balance of the record account is sent
+	rewards := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(50)))
+	dep.stakingKeeper.EXPECT().GetTokenizeShareRecordsByOwner(gomock.Any(), owner).Return([]stakingtypes.TokenizeShareRecord{record}, nil)
+	dep.stakingKeeper.EXPECT().Validator(gomock.Any(), valAddr).Return(nil, collections.ErrNotFound)
+	dep.bankKeeper.EXPECT().GetAllBalances(gomock.Any(), recordAddr).Return(rewards)
+	dep.bankKeeper.EXPECT().SendCoins(gomock.Any(), recordAddr, owner, rewards).Return(nil)
+
+	withdrawn, err := distrKeeper.WithdrawTokenizeShareRecordReward(ctx, owner)
+	require.NoError(t, err)
+	require.Equal(t, rewards, withdrawn)
+}
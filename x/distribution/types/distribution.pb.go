@@ -38,6 +38,13 @@ type Params struct {
 	// in the x/distribution module's reward mechanism.
 	BonusProposerReward cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=bonus_proposer_reward,json=bonusProposerReward,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"bonus_proposer_reward"` // Deprecated: Do not use.
 	WithdrawAddrEnabled bool                        `protobuf:"varint,4,opt,name=withdraw_addr_enabled,json=withdrawAddrEnabled,proto3" json:"withdraw_addr_enabled,omitempty"`
+	// auto_compound_epoch_identifier is the identifier of the x/epochs epoch at the end of
+	// which the rewards of the delegators who enabled auto-compounding are re-delegated.
+	// An empty identifier disables auto-compounding.
+	AutoCompoundEpochIdentifier string `protobuf:"bytes,5,opt,name=auto_compound_epoch_identifier,json=autoCompoundEpochIdentifier,proto3" json:"auto_compound_epoch_identifier,omitempty"`
+	// max_auto_compound_per_block is the maximum number of delegations whose rewards are
+	// compounded in a single block.
+	MaxAutoCompoundPerBlock uint32 `protobuf:"varint,6,opt,name=max_auto_compound_per_block,json=maxAutoCompoundPerBlock,proto3" json:"max_auto_compound_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -80,6 +87,20 @@ func (m *Params) GetWithdrawAddrEnabled() bool {
 	return false
 }
 
+func (m *Params) GetAutoCompoundEpochIdentifier() string {
+	if m != nil {
+		return m.AutoCompoundEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxAutoCompoundPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAutoCompoundPerBlock
+	}
+	return 0
+}
+
 // ValidatorHistoricalRewards represents historical rewards for a validator.
 // Height is implicit within the store key.
 // Cumulative reward ratio is the sum from the zeroeth period
@@ -657,73 +678,78 @@ func init() {
 }
 
 var fileDescriptor_cd78a31ea281a992 = []byte{
-	// 1044 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xe4, 0x87, 0xd3, 0x4c, 0xd2, 0x84, 0x4e, 0x7e, 0xd4, 0x71, 0x8b, 0x9d, 0xae, 0xa8,
-	0x08, 0x81, 0xd8, 0xa4, 0x95, 0x10, 0xf2, 0x05, 0x35, 0x76, 0x2b, 0x90, 0x0a, 0x44, 0x1b, 0x04,
-	0x12, 0x1c, 0x56, 0xe3, 0xdd, 0x89, 0x3d, 0x64, 0x77, 0x66, 0x99, 0x19, 0x3b, 0xc9, 0x09, 0x8e,
-	0x85, 0x03, 0x70, 0x03, 0xf5, 0x54, 0xc1, 0xa5, 0xe2, 0x94, 0x43, 0xfe, 0x88, 0x8a, 0x53, 0x55,
-	0x01, 0x42, 0x1c, 0x02, 0x24, 0x87, 0x20, 0xfe, 0x0a, 0x34, 0x3b, 0xe3, 0x5d, 0x27, 0x04, 0x14,
-	0x82, 0xa2, 0x5e, 0x2c, 0xcf, 0x7b, 0xb3, 0xef, 0xfb, 0xbe, 0x37, 0xef, 0xbd, 0x19, 0x58, 0xf1,
-	0xb9, 0x8c, 0xb8, 0xac, 0x06, 0x54, 0x2a, 0x41, 0x9b, 0x1d, 0x45, 0x39, 0xab, 0x76, 0x97, 0x9b,
-	0x44, 0xe1, 0xe5, 0x23, 0xc6, 0x4a, 0x2c, 0xb8, 0xe2, 0xe8, 0x8a, 0xd9, 0x5f, 0x39, 0xe2, 0xb2,
-	0xfb, 0x8b, 0xd3, 0x2d, 0xde, 0xe2, 0xc9, 0xbe, 0xaa, 0xfe, 0x67, 0x3e, 0x29, 0x96, 0x2c, 0x44,
-	0x13, 0x4b, 0x92, 0x86, 0xf6, 0x39, 0xb5, 0x21, 0x8b, 0x73, 0xc6, 0xef, 0x99, 0x0f, 0x6d, 0x7c,
-	0xe3, 0xba, 0x84, 0x23, 0xca, 0x78, 0x35, 0xf9, 0x35, 0x26, 0xe7, 0xfe, 0x20, 0xcc, 0xaf, 0x62,
-	0x81, 0x23, 0x89, 0x3e, 0x80, 0x17, 0x7d, 0x1e, 0x45, 0x1d, 0x46, 0xd5, 0xb6, 0xa7, 0xf0, 0x56,
-	0x01, 0xcc, 0x83, 0x85, 0xd1, 0x95, 0x57, 0x1e, 0xed, 0x95, 0x73, 0xbf, 0xec, 0x95, 0x2d, 0x55,
-	0x19, 0x6c, 0x54, 0x28, 0xaf, 0x46, 0x58, 0xb5, 0x2b, 0x77, 0x49, 0x0b, 0xfb, 0xdb, 0x0d, 0xe2,
-	0x3f, 0xd9, 0x5d, 0x82, 0x16, 0xa9, 0x41, 0xfc, 0x87, 0x87, 0x3b, 0x8b, 0xc0, 0x1d, 0x4f, 0x83,
-	0xbd, 0x83, 0xb7, 0xd0, 0x87, 0x70, 0x5a, 0x13, 0xd6, 0xac, 0x62, 0x2e, 0x89, 0xf0, 0x04, 0xd9,
-	0xc4, 0x22, 0x28, 0x0c, 0x24, 0x18, 0xaf, 0x9e, 0x0d, 0xa3, 0x00, 0x5c, 0xa4, 0xa3, 0xae, 0xda,
-	0xa0, 0x6e, 0x12, 0x13, 0x85, 0x70, 0xa6, 0xc9, 0x59, 0x47, 0xfe, 0x0d, 0x6c, 0xf0, 0x7f, 0x82,
-	0x4d, 0x25, 0x61, 0x8f, 0xa1, 0xdd, 0x80, 0x33, 0x9b, 0x54, 0xb5, 0x03, 0x81, 0x37, 0x3d, 0x1c,
-	0x04, 0xc2, 0x23, 0x0c, 0x37, 0x43, 0x12, 0x14, 0x86, 0xe6, 0xc1, 0xc2, 0x05, 0x77, 0xaa, 0xe7,
-	0xbc, 0x15, 0x04, 0xe2, 0xb6, 0x71, 0xd5, 0xae, 0x7f, 0x76, 0xb8, 0xb3, 0x38, 0x6f, 0x00, 0x96,
-	0x64, 0xb0, 0x51, 0xdd, 0x3a, 0x5a, 0x31, 0xe6, 0x44, 0x9c, 0x9f, 0x00, 0x2c, 0xbe, 0x8b, 0x43,
-	0x1a, 0x60, 0xc5, 0xc5, 0xeb, 0x54, 0x2a, 0x2e, 0xa8, 0x8f, 0x43, 0x03, 0x2c, 0xd1, 0xe7, 0x00,
-	0x5e, 0xf6, 0x3b, 0x51, 0x27, 0xc4, 0x8a, 0x76, 0x89, 0x15, 0xe9, 0x09, 0xac, 0x28, 0x2f, 0x80,
-	0xf9, 0xc1, 0x85, 0xb1, 0x1b, 0x57, 0x6d, 0x3d, 0x56, 0x74, 0x96, 0x7a, 0x75, 0xa5, 0x15, 0xd5,
-	0x39, 0x65, 0x26, 0x11, 0xdf, 0xfd, 0x5a, 0x7e, 0xb1, 0x45, 0x55, 0xbb, 0xd3, 0xac, 0xf8, 0x3c,
-	0xb2, 0xf5, 0x52, 0xed, 0xa3, 0xa6, 0xb6, 0x63, 0x22, 0x7b, 0xdf, 0x48, 0x73, 0xb6, 0x33, 0x19,
-	0xac, 0x21, 0xe3, 0x6a, 0x50, 0xf4, 0x3c, 0x9c, 0x14, 0x64, 0x9d, 0x08, 0xc2, 0x7c, 0xe2, 0xf9,
-	0xbc, 0xc3, 0x54, 0x72, 0xbe, 0x17, 0xdd, 0x89, 0xd4, 0x5c, 0xd7, 0x56, 0xe7, 0x5b, 0x00, 0x2f,
-	0xa7, 0xc2, 0xea, 0x1d, 0x21, 0x08, 0x53, 0x3d, 0x55, 0x31, 0x1c, 0x31, 0x4a, 0xe4, 0x39, 0x8b,
-	0xe8, 0xc1, 0xa0, 0x59, 0x98, 0x8f, 0x89, 0xa0, 0xdc, 0x54, 0xe3, 0x90, 0x6b, 0x57, 0xce, 0xd7,
-	0x00, 0x96, 0x52, 0x96, 0xb7, 0x7c, 0xab, 0x99, 0x04, 0x75, 0x1e, 0x45, 0x54, 0x4a, 0xca, 0x19,
-	0xea, 0x42, 0xe8, 0xa7, 0xab, 0x73, 0xe6, 0xdb, 0x87, 0xe4, 0x7c, 0x01, 0xe0, 0x95, 0x94, 0xda,
-	0xdb, 0x1d, 0x25, 0x15, 0x66, 0x01, 0x65, 0xad, 0xa7, 0x96, 0x44, 0xcd, 0x68, 0x2a, 0x65, 0xb4,
-	0x16, 0x62, 0xd9, 0xbe, 0xdd, 0x25, 0x4c, 0xa1, 0x17, 0xe0, 0x33, 0xdd, 0x9e, 0xd9, 0xb3, 0x69,
-	0x06, 0x49, 0x9a, 0x27, 0x53, 0xfb, 0x6a, 0x62, 0x46, 0x6f, 0xc2, 0x0b, 0xeb, 0x02, 0xfb, 0xba,
-	0x03, 0xec, 0x5c, 0x58, 0xfe, 0xcf, 0xad, 0xea, 0xa6, 0x21, 0x9c, 0x4f, 0x01, 0x9c, 0x3e, 0x81,
-	0x91, 0x44, 0x1f, 0xc1, 0xd9, 0x8c, 0x92, 0xd4, 0x0e, 0x8f, 0x24, 0x1e, 0x9b, 0xab, 0x97, 0x2b,
-	0xff, 0x32, 0x95, 0x2b, 0x27, 0x84, 0x5c, 0x19, 0xd5, 0x3c, 0x4d, 0x42, 0xa6, 0xbb, 0x27, 0x40,
-	0x3a, 0x9f, 0x0c, 0xc0, 0x91, 0x3b, 0x84, 0xac, 0x72, 0x1e, 0xa2, 0x8f, 0xe1, 0x44, 0x36, 0x67,
-	0x63, 0xce, 0xc3, 0x53, 0x1d, 0x51, 0xed, 0xac, 0x47, 0x54, 0x00, 0x6e, 0x36, 0xd7, 0x13, 0x02,
-	0x0a, 0x8e, 0x07, 0xc4, 0xa7, 0x11, 0x0e, 0x0d, 0xfc, 0xc0, 0x29, 0xe0, 0x6f, 0x9e, 0x01, 0xde,
-	0x1d, 0xb3, 0x30, 0x1a, 0xd5, 0xf9, 0x6a, 0x00, 0x16, 0xeb, 0xfd, 0x3c, 0xd6, 0x62, 0xc2, 0x02,
-	0x33, 0x4c, 0x71, 0x88, 0xa6, 0xe1, 0xb0, 0xa2, 0x2a, 0x24, 0xe6, 0xd6, 0x71, 0xcd, 0x02, 0xcd,
-	0xc3, 0xb1, 0x80, 0x48, 0x5f, 0xd0, 0x38, 0xab, 0x0a, 0xb7, 0xdf, 0x84, 0xae, 0xc2, 0x51, 0x41,
-	0x7c, 0x1a, 0x53, 0xc2, 0x94, 0x19, 0xf0, 0x6e, 0x66, 0x40, 0xdb, 0x30, 0x8f, 0xa3, 0x64, 0x10,
-	0x0d, 0x25, 0x22, 0xe7, 0x4e, 0x14, 0x99, 0x28, 0xbc, 0x63, 0x15, 0x2e, 0x9c, 0x42, 0x61, 0x22,
-	0xef, 0xfe, 0xe1, 0xce, 0xe2, 0x78, 0x98, 0x94, 0xa1, 0xe7, 0x67, 0x1d, 0x61, 0x01, 0x6b, 0x0b,
-	0xf7, 0x1e, 0x94, 0x73, 0x7f, 0x3c, 0x28, 0xe7, 0xbe, 0xdf, 0x5d, 0x2a, 0x5a, 0xd4, 0x16, 0xef,
-	0xf6, 0x81, 0x32, 0xa5, 0x39, 0x03, 0xe7, 0x07, 0x00, 0x67, 0x1a, 0x44, 0x47, 0xd2, 0x55, 0xa3,
-	0xb0, 0x50, 0x94, 0xb5, 0xde, 0x60, 0xeb, 0xc9, 0x40, 0x8d, 0x05, 0xe9, 0x52, 0xae, 0x2f, 0xb3,
-	0xfe, 0xde, 0x99, 0xe8, 0x99, 0x6d, 0xeb, 0xdc, 0x85, 0xc3, 0x52, 0xe1, 0x0d, 0x62, 0xfb, 0xe6,
-	0xac, 0x77, 0xb6, 0x09, 0x82, 0x1a, 0x30, 0xdf, 0x26, 0xb4, 0xd5, 0x36, 0x09, 0x1d, 0x5a, 0x79,
-	0xe9, 0xcf, 0xbd, 0xf2, 0xa4, 0x2f, 0x88, 0x1e, 0xf2, 0xcc, 0x33, 0xae, 0x6f, 0x0e, 0x77, 0x16,
-	0x8f, 0xdb, 0x6c, 0x02, 0xcc, 0xc2, 0xf9, 0x1d, 0xc0, 0x39, 0x2b, 0x8b, 0x72, 0x96, 0x0a, 0xb4,
-	0xd7, 0xe6, 0x5b, 0xf0, 0x52, 0xd6, 0x84, 0xfa, 0xde, 0x24, 0x52, 0xda, 0x17, 0xc7, 0xb5, 0x27,
-	0xbb, 0x4b, 0xcf, 0x5a, 0x6a, 0xd9, 0xfc, 0x35, 0x5b, 0xd6, 0x94, 0xd0, 0x63, 0x2e, 0x9b, 0x29,
-	0xd6, 0x8e, 0x18, 0xcc, 0xa7, 0x4f, 0x8a, 0xf3, 0x1c, 0x78, 0x16, 0xa5, 0x36, 0xa4, 0x8f, 0xd7,
-	0xf9, 0x11, 0xc0, 0xeb, 0xff, 0x5c, 0xd4, 0xef, 0x51, 0xd5, 0x6e, 0x90, 0x98, 0x4b, 0xaa, 0xce,
-	0xa9, 0xbe, 0x67, 0xfb, 0xea, 0x5b, 0xbb, 0xec, 0x0a, 0x15, 0xe0, 0x48, 0x60, 0x80, 0x0b, 0xc3,
-	0x89, 0xa3, 0xb7, 0xac, 0x3d, 0x77, 0xef, 0x14, 0x25, 0xb9, 0xf2, 0xda, 0xc3, 0xfd, 0x12, 0x78,
-	0xb4, 0x5f, 0x02, 0x8f, 0xf7, 0x4b, 0xe0, 0xb7, 0xfd, 0x12, 0xf8, 0xf2, 0xa0, 0x94, 0x7b, 0x7c,
-	0x50, 0xca, 0xfd, 0x7c, 0x50, 0xca, 0xbd, 0x7f, 0xed, 0x48, 0x59, 0x1d, 0x7b, 0xbb, 0x24, 0x49,
-	0x6b, 0xe6, 0x93, 0xe7, 0xe5, 0xcd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x08, 0x33, 0x8d,
-	0x11, 0x0b, 0x00, 0x00,
+	// 1131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xa4, 0xae, 0xdb, 0x4c, 0x7e, 0xb5, 0x9b, 0x5f, 0x8e, 0xd3, 0xaf, 0xed, 0x5a, 0xdf,
+	0x0a, 0x13, 0x88, 0xdd, 0xa4, 0x02, 0xa1, 0x5c, 0x50, 0xe2, 0xa4, 0xa2, 0x52, 0x81, 0xb0, 0x41,
+	0x80, 0xe0, 0xb0, 0x1a, 0xcf, 0x4e, 0xec, 0x21, 0xbb, 0x33, 0xcb, 0xcc, 0xd8, 0x49, 0x4e, 0x70,
+	0x2c, 0x1c, 0x80, 0x1b, 0x88, 0x53, 0x05, 0x97, 0x8a, 0x53, 0x0e, 0xf9, 0x1f, 0xa8, 0x38, 0x55,
+	0x15, 0x20, 0xd4, 0x43, 0x80, 0xe4, 0x10, 0xc4, 0x5f, 0x81, 0x66, 0x67, 0xbc, 0x76, 0x42, 0x40,
+	0x21, 0x55, 0xc4, 0xc5, 0xf2, 0xbc, 0xb7, 0xef, 0x7d, 0x3e, 0xef, 0xcd, 0xfb, 0x31, 0xb0, 0x82,
+	0xb9, 0x0c, 0xb9, 0xac, 0xfa, 0x54, 0x2a, 0x41, 0xeb, 0x2d, 0x45, 0x39, 0xab, 0xb6, 0xe7, 0xea,
+	0x44, 0xa1, 0xb9, 0x23, 0xc2, 0x4a, 0x24, 0xb8, 0xe2, 0xce, 0xb4, 0xf9, 0xbe, 0x72, 0x44, 0x65,
+	0xbf, 0xcf, 0x8d, 0x35, 0x78, 0x83, 0xc7, 0xdf, 0x55, 0xf5, 0x3f, 0x63, 0x92, 0xcb, 0x5b, 0x88,
+	0x3a, 0x92, 0x24, 0x71, 0x8d, 0x39, 0xb5, 0x2e, 0x73, 0x53, 0x46, 0xef, 0x19, 0x43, 0xeb, 0xdf,
+	0xa8, 0xae, 0xa2, 0x90, 0x32, 0x5e, 0x8d, 0x7f, 0x8d, 0xa8, 0xf4, 0x5d, 0x1a, 0x66, 0x56, 0x91,
+	0x40, 0xa1, 0x74, 0xde, 0x83, 0x43, 0x98, 0x87, 0x61, 0x8b, 0x51, 0xb5, 0xed, 0x29, 0xb4, 0x95,
+	0x05, 0x45, 0x50, 0xee, 0x5f, 0x7a, 0xf1, 0xe1, 0x5e, 0x21, 0xf5, 0x64, 0xaf, 0x60, 0xa9, 0x4a,
+	0x7f, 0xa3, 0x42, 0x79, 0x35, 0x44, 0xaa, 0x59, 0xb9, 0x4b, 0x1a, 0x08, 0x6f, 0x2f, 0x13, 0xfc,
+	0x78, 0x77, 0x16, 0x5a, 0xa4, 0x65, 0x82, 0x1f, 0x1c, 0xee, 0xcc, 0x00, 0x77, 0x30, 0x71, 0xf6,
+	0x26, 0xda, 0x72, 0xde, 0x87, 0x63, 0x9a, 0xb0, 0x66, 0x15, 0x71, 0x49, 0x84, 0x27, 0xc8, 0x26,
+	0x12, 0x7e, 0xb6, 0x2f, 0xc6, 0x78, 0xe9, 0x6c, 0x18, 0x59, 0xe0, 0x3a, 0xda, 0xeb, 0xaa, 0x75,
+	0xea, 0xc6, 0x3e, 0x9d, 0x00, 0x8e, 0xd7, 0x39, 0x6b, 0xc9, 0xbf, 0x80, 0x5d, 0x78, 0x4a, 0xb0,
+	0xd1, 0xd8, 0xed, 0x31, 0xb4, 0x79, 0x38, 0xbe, 0x49, 0x55, 0xd3, 0x17, 0x68, 0xd3, 0x43, 0xbe,
+	0x2f, 0x3c, 0xc2, 0x50, 0x3d, 0x20, 0x7e, 0x36, 0x5d, 0x04, 0xe5, 0xcb, 0xee, 0x68, 0x47, 0xb9,
+	0xe8, 0xfb, 0x62, 0xc5, 0xa8, 0x9c, 0x77, 0x60, 0x1e, 0xb5, 0x14, 0xf7, 0x30, 0x0f, 0x23, 0xde,
+	0x62, 0xbe, 0x47, 0x22, 0x8e, 0x9b, 0x1e, 0xf5, 0x09, 0x53, 0x74, 0x9d, 0x12, 0x91, 0xbd, 0x18,
+	0x53, 0x1d, 0x7d, 0xb2, 0x3b, 0x3b, 0x62, 0x78, 0xcc, 0x4a, 0x7f, 0xa3, 0x78, 0xb3, 0xf2, 0xc2,
+	0xbc, 0x3b, 0xad, 0x4d, 0x6b, 0xd6, 0x72, 0x45, 0x1b, 0xde, 0x49, 0xec, 0x9c, 0x37, 0xe0, 0x74,
+	0x88, 0xb6, 0xbc, 0xa3, 0xde, 0x23, 0x22, 0xbc, 0x7a, 0xc0, 0xf1, 0x46, 0x36, 0x53, 0x04, 0xe5,
+	0xa1, 0x93, 0xdd, 0x4e, 0x86, 0x68, 0x6b, 0xb1, 0xc7, 0xf3, 0x2a, 0x11, 0x4b, 0xda, 0x66, 0xe1,
+	0xc6, 0x27, 0x87, 0x3b, 0x33, 0xc5, 0xee, 0xe7, 0xd5, 0xad, 0xa3, 0xe5, 0x6d, 0xca, 0xa7, 0xf4,
+	0x13, 0x80, 0xb9, 0xb7, 0x50, 0x40, 0x7d, 0xa4, 0xb8, 0x78, 0x85, 0x4a, 0xc5, 0x05, 0xc5, 0x28,
+	0x30, 0x59, 0x92, 0xce, 0xa7, 0x00, 0x4e, 0xe2, 0x56, 0xd8, 0x0a, 0x90, 0xa2, 0x6d, 0x62, 0x6f,
+	0xc4, 0x13, 0x48, 0x51, 0x9e, 0x05, 0xc5, 0x0b, 0xe5, 0x81, 0xf9, 0x6b, 0xb6, 0x79, 0x2a, 0xfa,
+	0x4a, 0x3b, 0x4d, 0xa0, 0xd3, 0x5f, 0xe3, 0x94, 0x99, 0x5b, 0xfb, 0xf6, 0x97, 0xc2, 0x73, 0x0d,
+	0xaa, 0x9a, 0xad, 0x7a, 0x05, 0xf3, 0xd0, 0x16, 0x77, 0xb5, 0x87, 0x9a, 0xda, 0x8e, 0x88, 0xec,
+	0xd8, 0x48, 0x53, 0x88, 0xe3, 0x5d, 0x58, 0x43, 0xc6, 0xd5, 0xa0, 0xce, 0x33, 0x70, 0x44, 0x90,
+	0x75, 0x22, 0x08, 0xc3, 0xc4, 0xc3, 0xbc, 0xc5, 0x54, 0x5c, 0x8c, 0x43, 0xee, 0x70, 0x22, 0xae,
+	0x69, 0x69, 0xe9, 0x1b, 0x00, 0x27, 0x93, 0xc0, 0x6a, 0x2d, 0x21, 0x08, 0x53, 0x9d, 0xa8, 0x22,
+	0x78, 0xc9, 0x44, 0x22, 0xcf, 0x39, 0x88, 0x0e, 0x8c, 0x33, 0x01, 0x33, 0x11, 0x11, 0x94, 0x9b,
+	0xd6, 0x49, 0xbb, 0xf6, 0x54, 0xfa, 0x12, 0xc0, 0x7c, 0xc2, 0x72, 0x11, 0xdb, 0x98, 0x89, 0x5f,
+	0xe3, 0x61, 0x48, 0xa5, 0xa4, 0x9c, 0x39, 0x6d, 0x08, 0x71, 0x72, 0x3a, 0x67, 0xbe, 0x3d, 0x48,
+	0xa5, 0xcf, 0x00, 0x9c, 0x4e, 0xa8, 0xbd, 0xde, 0x52, 0x52, 0x21, 0xe6, 0x53, 0xd6, 0xf8, 0xcf,
+	0x92, 0xa8, 0x19, 0x8d, 0x26, 0x8c, 0xd6, 0x02, 0x24, 0x9b, 0x2b, 0x6d, 0xc2, 0x94, 0xf3, 0x2c,
+	0xbc, 0xd2, 0xee, 0x88, 0x3d, 0x9b, 0x66, 0x10, 0xa7, 0x79, 0x24, 0x91, 0xaf, 0xc6, 0x62, 0xe7,
+	0x55, 0x78, 0x79, 0x5d, 0x20, 0xac, 0x3b, 0xc0, 0x0e, 0xb1, 0xb9, 0x7f, 0x3d, 0x57, 0xdc, 0xc4,
+	0x45, 0xe9, 0x63, 0x00, 0xc7, 0x4e, 0x60, 0x24, 0x9d, 0x0f, 0xe0, 0x44, 0x97, 0x92, 0xd4, 0x0a,
+	0x8f, 0xc4, 0x1a, 0x9b, 0xab, 0x9b, 0x95, 0x7f, 0x58, 0x21, 0x95, 0x13, 0x5c, 0x2e, 0xf5, 0x6b,
+	0x9e, 0x26, 0x21, 0x63, 0xed, 0x13, 0x20, 0x4b, 0x1f, 0xf5, 0xc1, 0x4b, 0xb7, 0x09, 0x59, 0xe5,
+	0x3c, 0x70, 0x3e, 0x84, 0xc3, 0xdd, 0xa5, 0x10, 0x71, 0x1e, 0x9c, 0xea, 0x8a, 0x16, 0xce, 0x7a,
+	0x45, 0x59, 0xe0, 0x76, 0x97, 0x50, 0x4c, 0x40, 0xc1, 0x41, 0x9f, 0x60, 0x1a, 0xa2, 0xc0, 0xc0,
+	0xf7, 0x9d, 0x02, 0xfe, 0xd6, 0x19, 0xe0, 0xdd, 0x01, 0x0b, 0xa3, 0x51, 0x4b, 0x5f, 0xf4, 0xc1,
+	0x5c, 0xad, 0x97, 0xc7, 0x5a, 0x44, 0x98, 0x6f, 0x26, 0x3f, 0x0a, 0x9c, 0x31, 0x78, 0x51, 0x51,
+	0x15, 0x10, 0xb3, 0x22, 0x5d, 0x73, 0x70, 0x8a, 0x70, 0xc0, 0x27, 0x12, 0x0b, 0x1a, 0x75, 0xab,
+	0xc2, 0xed, 0x15, 0x39, 0xd7, 0x60, 0xbf, 0x20, 0x98, 0x46, 0x94, 0x30, 0x65, 0xb6, 0x91, 0xdb,
+	0x15, 0x38, 0xdb, 0x30, 0x83, 0xc2, 0x78, 0x10, 0xa5, 0xe3, 0x20, 0xa7, 0x4e, 0x0c, 0x32, 0x8e,
+	0xf0, 0xb6, 0x8d, 0xb0, 0x7c, 0x8a, 0x08, 0xe3, 0xf0, 0xbe, 0x3a, 0xdc, 0x99, 0x19, 0x0c, 0xe2,
+	0x32, 0xf4, 0x70, 0xb7, 0x23, 0x2c, 0xe0, 0x42, 0xf9, 0xde, 0xfd, 0x42, 0xea, 0xf7, 0xfb, 0x85,
+	0xd4, 0xf7, 0xbb, 0xb3, 0x39, 0x8b, 0xda, 0xe0, 0xed, 0x1e, 0x50, 0xa6, 0x34, 0x67, 0x50, 0xfa,
+	0x01, 0xc0, 0xf1, 0x65, 0xa2, 0x3d, 0xe9, 0xaa, 0x51, 0x48, 0x28, 0xca, 0x1a, 0x77, 0xd8, 0x7a,
+	0x3c, 0x50, 0x23, 0x41, 0xda, 0x94, 0xeb, 0xcd, 0xdb, 0xdb, 0x3b, 0xc3, 0x1d, 0xb1, 0x6d, 0x9d,
+	0xbb, 0xf0, 0xa2, 0x54, 0x68, 0x83, 0x64, 0xfb, 0x9e, 0xea, 0x81, 0x61, 0x9c, 0x38, 0xcb, 0x30,
+	0xd3, 0x24, 0xb4, 0xd1, 0x34, 0x09, 0x4d, 0x2f, 0x3d, 0xff, 0xc7, 0x5e, 0x61, 0x04, 0x0b, 0xa2,
+	0x87, 0x3c, 0xf3, 0x8c, 0xea, 0xeb, 0xc3, 0x9d, 0x99, 0xe3, 0x32, 0x9b, 0x00, 0x73, 0x28, 0xfd,
+	0x06, 0xe0, 0x94, 0x0d, 0x8b, 0x72, 0x96, 0x04, 0x68, 0x77, 0xfc, 0x6b, 0xf0, 0x6a, 0xb7, 0x09,
+	0xf5, 0x92, 0x27, 0x52, 0xda, 0xe7, 0xd1, 0xf5, 0xc7, 0xbb, 0xb3, 0xff, 0xb3, 0xd4, 0xba, 0xf3,
+	0xd7, 0x7c, 0xb2, 0xa6, 0x84, 0x1e, 0x73, 0x57, 0xda, 0xc7, 0xe4, 0x0e, 0x83, 0x99, 0xe4, 0xfd,
+	0x73, 0x9e, 0x03, 0xcf, 0xa2, 0x2c, 0xa4, 0xf5, 0xf5, 0x96, 0x7e, 0x04, 0xf0, 0xc6, 0xdf, 0x17,
+	0xf5, 0xdb, 0x54, 0x35, 0x97, 0x49, 0xc4, 0x25, 0x55, 0xe7, 0x54, 0xdf, 0x13, 0x3d, 0xf5, 0xad,
+	0x55, 0xf6, 0xe4, 0x64, 0xe1, 0x25, 0xdf, 0x00, 0x9b, 0x67, 0x8f, 0xdb, 0x39, 0x2e, 0xfc, 0xff,
+	0xde, 0x29, 0x4a, 0x72, 0xe9, 0xe5, 0x07, 0xfb, 0x79, 0xf0, 0x70, 0x3f, 0x0f, 0x1e, 0xed, 0xe7,
+	0xc1, 0xaf, 0xfb, 0x79, 0xf0, 0xf9, 0x41, 0x3e, 0xf5, 0xe8, 0x20, 0x9f, 0xfa, 0xf9, 0x20, 0x9f,
+	0x7a, 0xf7, 0xfa, 0x91, 0xb2, 0x3a, 0xf6, 0x76, 0x89, 0x93, 0x56, 0xcf, 0xc4, 0x6f, 0xe1, 0x5b,
+	0x7f, 0x0e, 0x00, 0x8a, 0xd3, 0x31, 0xcb, 0xbe, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -757,6 +783,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.WithdrawAddrEnabled != that1.WithdrawAddrEnabled {
 		return false
 	}
+	if this.AutoCompoundEpochIdentifier != that1.AutoCompoundEpochIdentifier {
+		return false
+	}
+	if this.MaxAutoCompoundPerBlock != that1.MaxAutoCompoundPerBlock {
+		return false
+	}
 	return true
 }
 func (this *ValidatorHistoricalRewards) Equal(that interface{}) bool {
@@ -1092,6 +1124,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAutoCompoundPerBlock != 0 {
+		i = encodeVarintDistribution(dAtA, i, uint64(m.MaxAutoCompoundPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.AutoCompoundEpochIdentifier) > 0 {
+		i -= len(m.AutoCompoundEpochIdentifier)
+		copy(dAtA[i:], m.AutoCompoundEpochIdentifier)
+		i = encodeVarintDistribution(dAtA, i, uint64(len(m.AutoCompoundEpochIdentifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.WithdrawAddrEnabled {
 		i--
 		if m.WithdrawAddrEnabled {
@@ -1648,6 +1692,13 @@ func (m *Params) Size() (n int) {
 	if m.WithdrawAddrEnabled {
 		n += 2
 	}
+	l = len(m.AutoCompoundEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovDistribution(uint64(l))
+	}
+	if m.MaxAutoCompoundPerBlock != 0 {
+		n += 1 + sovDistribution(uint64(m.MaxAutoCompoundPerBlock))
+	}
 	return n
 }
 
@@ -2016,6 +2067,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.WithdrawAddrEnabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDistribution
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoCompoundPerBlock", wireType)
+			}
+			m.MaxAutoCompoundPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDistribution
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoCompoundPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDistribution(dAtA[iNdEx:])
@@ -26,6 +26,7 @@ type BankKeeper interface {
 
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
@@ -65,6 +66,13 @@ type StakingKeeper interface {
 	GetAllSDKDelegations(ctx context.Context) ([]stakingtypes.Delegation, error)
 	GetAllValidators(ctx context.Context) ([]stakingtypes.Validator, error)
 	GetAllDelegatorDelegations(ctx context.Context, delegator sdk.AccAddress) ([]stakingtypes.Delegation, error)
+	// IterateDelegatorDelegationsFrom iterates through the delegations of a delegator in
+	// validator address order, starting from the delegation to fromValAddr included.
+	IterateDelegatorDelegationsFrom(ctx context.Context, delegator sdk.AccAddress, fromValAddr sdk.ValAddress,
+		cb func(delegation stakingtypes.Delegation) (stop bool)) error
+
+	// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by an address.
+	GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]stakingtypes.TokenizeShareRecord, error)
 }
 
 // StakingHooks event hooks for staking validator object (noalias)
@@ -72,3 +80,17 @@ type StakingHooks interface {
 	AfterValidatorCreated(ctx context.Context, valAddr sdk.ValAddress) error // Must be called when a validator is created
 	AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 }
+
+// EpochHooks defines the epoch hooks implemented by the distribution module. It
+// matches the x/epochs EpochHooks interface without importing x/epochs, so that
+// the app registers the hooks with the epochs keeper.
+type EpochHooks interface {
+	// AfterEpochEnd is called when an epoch is going to be ended, epochNumber is the
+	// number of the epoch that is ending.
+	AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error
+	// BeforeEpochStart is called when a new epoch is going to be started, epochNumber
+	// is the number of the epoch that is starting.
+	BeforeEpochStart(ctx context.Context, epochIdentifier string, epochNumber int64) error
+	// GetModuleName returns the name of the module implementing the hooks.
+	GetModuleName() string
+}
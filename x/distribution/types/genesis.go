@@ -1,5 +1,7 @@
 package types
 
+import "errors"
+
 func NewGenesisState(
 	params Params, fp FeePool, dwis []DelegatorWithdrawInfo, pp string, r []ValidatorOutstandingRewardsRecord,
 	acc []ValidatorAccumulatedCommissionRecord, historical []ValidatorHistoricalRewardsRecord,
@@ -40,5 +42,8 @@ func ValidateGenesis(gs *GenesisState) error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
+	if gs.AutoCompoundCursor != nil && gs.AutoCompoundCursor.DelegatorAddress == "" {
+		return errors.New("auto-compound cursor delegator address cannot be empty")
+	}
 	return gs.FeePool.ValidateGenesis()
 }
@@ -326,6 +326,48 @@ func (m *ValidatorSlashEventRecord) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValidatorSlashEventRecord proto.InternalMessageInfo
 
+// AutoCompoundCursor is the next delegation to compound of an auto-compound round.
+type AutoCompoundCursor struct {
+	// delegator_address is the address of the delegator.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address is the validator address of the delegation, an empty address
+	// means the first delegation of the delegator.
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *AutoCompoundCursor) Reset()         { *m = AutoCompoundCursor{} }
+func (m *AutoCompoundCursor) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundCursor) ProtoMessage()    {}
+func (*AutoCompoundCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_76eed0f9489db580, []int{7}
+}
+func (m *AutoCompoundCursor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundCursor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundCursor.Merge(m, src)
+}
+func (m *AutoCompoundCursor) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundCursor proto.InternalMessageInfo
+
 // GenesisState defines the distribution module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
@@ -348,13 +390,18 @@ type GenesisState struct {
 	DelegatorStartingInfos []DelegatorStartingInfoRecord `protobuf:"bytes,9,rep,name=delegator_starting_infos,json=delegatorStartingInfos,proto3" json:"delegator_starting_infos"`
 	// fee_pool defines the validator slash events at genesis.
 	ValidatorSlashEvents []ValidatorSlashEventRecord `protobuf:"bytes,10,rep,name=validator_slash_events,json=validatorSlashEvents,proto3" json:"validator_slash_events"`
+	// auto_compound_delegators defines the delegators who enabled auto-compounding at genesis.
+	AutoCompoundDelegators []string `protobuf:"bytes,11,rep,name=auto_compound_delegators,json=autoCompoundDelegators,proto3" json:"auto_compound_delegators,omitempty"`
+	// auto_compound_cursor defines the next delegation to compound of the auto-compound
+	// round in progress at genesis, it is unset when no round is in progress.
+	AutoCompoundCursor *AutoCompoundCursor `protobuf:"bytes,12,opt,name=auto_compound_cursor,json=autoCompoundCursor,proto3" json:"auto_compound_cursor,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_76eed0f9489db580, []int{7}
+	return fileDescriptor_76eed0f9489db580, []int{8}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -391,6 +438,7 @@ func init() {
 	proto.RegisterType((*ValidatorCurrentRewardsRecord)(nil), "cosmos.distribution.v1beta1.ValidatorCurrentRewardsRecord")
 	proto.RegisterType((*DelegatorStartingInfoRecord)(nil), "cosmos.distribution.v1beta1.DelegatorStartingInfoRecord")
 	proto.RegisterType((*ValidatorSlashEventRecord)(nil), "cosmos.distribution.v1beta1.ValidatorSlashEventRecord")
+	proto.RegisterType((*AutoCompoundCursor)(nil), "cosmos.distribution.v1beta1.AutoCompoundCursor")
 	proto.RegisterType((*GenesisState)(nil), "cosmos.distribution.v1beta1.GenesisState")
 }
 
@@ -399,66 +447,72 @@ func init() {
 }
 
 var fileDescriptor_76eed0f9489db580 = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x3a, 0x25, 0x4d, 0x26, 0x45, 0x34, 0xd3, 0x34, 0x6c, 0xd2, 0x76, 0x9d, 0x94, 0x1e,
-	0x0a, 0x28, 0x6b, 0x1a, 0x10, 0x54, 0x45, 0x80, 0x1a, 0xb7, 0xe5, 0xc7, 0x01, 0x22, 0x5b, 0x02,
-	0x81, 0x90, 0xac, 0xf1, 0xee, 0x78, 0x3d, 0xaa, 0xbd, 0x63, 0xcd, 0x8c, 0xd7, 0x80, 0xc4, 0x81,
-	0x53, 0x11, 0xe2, 0xc0, 0x09, 0x71, 0xac, 0x38, 0x55, 0x48, 0x48, 0x1c, 0xfa, 0x47, 0xf4, 0x58,
-	0x55, 0x1c, 0x38, 0x01, 0x72, 0x0e, 0x20, 0x8e, 0xfc, 0x05, 0x68, 0x67, 0x66, 0x77, 0xc7, 0xd9,
-	0xed, 0xd6, 0x0d, 0xf5, 0x25, 0xf1, 0xce, 0xbc, 0xf7, 0xbe, 0xef, 0x7b, 0xef, 0xed, 0x7b, 0x0b,
-	0x9e, 0xf7, 0x28, 0x1f, 0x50, 0x5e, 0xf7, 0x09, 0x17, 0x8c, 0x74, 0x46, 0x82, 0xd0, 0xb0, 0x1e,
-	0x5d, 0xea, 0x60, 0x81, 0x2e, 0xd5, 0x03, 0x1c, 0x62, 0x4e, 0xb8, 0x3b, 0x64, 0x54, 0x50, 0x78,
-	0x46, 0x99, 0xba, 0xa6, 0xa9, 0xab, 0x4d, 0x37, 0xd7, 0x02, 0x1a, 0x50, 0x69, 0x57, 0x8f, 0x7f,
-	0x29, 0x97, 0x4d, 0x47, 0x47, 0xef, 0x20, 0x8e, 0xd3, 0xa8, 0x1e, 0x25, 0xa1, 0xbe, 0x77, 0xcb,
-	0xd0, 0xa7, 0x70, 0x94, 0xfd, 0x86, 0xb2, 0x6f, 0x2b, 0x20, 0xcd, 0x47, 0x5d, 0xad, 0xa2, 0x01,
-	0x09, 0x69, 0x5d, 0xfe, 0x55, 0x47, 0xe7, 0x7f, 0xb6, 0xc0, 0xe9, 0x6b, 0xb8, 0x8f, 0x03, 0x24,
-	0x28, 0xfb, 0x88, 0x88, 0x9e, 0xcf, 0xd0, 0xf8, 0xdd, 0xb0, 0x4b, 0xe1, 0x75, 0xb0, 0xea, 0x27,
-	0x17, 0x6d, 0xe4, 0xfb, 0x0c, 0x73, 0x6e, 0x5b, 0x5b, 0xd6, 0xc5, 0xe5, 0x3d, 0xfb, 0xc1, 0xdd,
-	0x9d, 0x35, 0x1d, 0xf9, 0xaa, 0xba, 0x69, 0x09, 0x46, 0xc2, 0xa0, 0x79, 0x32, 0x75, 0xd1, 0xe7,
-	0xb0, 0x01, 0x4e, 0x8e, 0x75, 0xd8, 0x34, 0x4a, 0xf5, 0x11, 0x51, 0x9e, 0x49, 0x3c, 0xf4, 0xf1,
-	0x95, 0xa5, 0xaf, 0x6f, 0xd7, 0x2a, 0x7f, 0xdf, 0xae, 0x55, 0xce, 0xdf, 0xaa, 0x82, 0xed, 0x0f,
-	0x51, 0x9f, 0xf8, 0x31, 0xc6, 0x07, 0x23, 0xc1, 0x05, 0x0a, 0xfd, 0xd8, 0x07, 0x8f, 0x11, 0xf3,
-	0x79, 0x13, 0x7b, 0x94, 0xf9, 0xf0, 0x7d, 0xb0, 0x1a, 0x25, 0x46, 0x87, 0xb8, 0x6f, 0x3f, 0xb8,
-	0xbb, 0x73, 0x4e, 0xa3, 0xa6, 0x81, 0x0e, 0x89, 0x88, 0x0e, 0x9d, 0xc3, 0x5b, 0x16, 0x38, 0x45,
-	0x33, 0xb0, 0x36, 0x53, 0x68, 0x76, 0x75, 0x6b, 0xe1, 0xe2, 0xca, 0xee, 0x59, 0x5d, 0x22, 0x37,
-	0x2e, 0x61, 0x52, 0x6d, 0xf7, 0x1a, 0xf6, 0x1a, 0x94, 0x84, 0x7b, 0x97, 0xef, 0xfd, 0x5e, 0xab,
-	0xfc, 0xf4, 0x47, 0xed, 0xc5, 0x80, 0x88, 0xde, 0xa8, 0xe3, 0x7a, 0x74, 0xa0, 0xab, 0xa2, 0xff,
-	0xed, 0x70, 0xff, 0x66, 0x5d, 0x7c, 0x3e, 0xc4, 0x3c, 0xf1, 0xe1, 0x77, 0xfe, 0xfa, 0xe5, 0x05,
-	0xab, 0x09, 0x69, 0x4e, 0x9f, 0x91, 0x89, 0x7f, 0x2c, 0x70, 0x21, 0x13, 0xe0, 0x79, 0xa3, 0xc1,
-	0xa8, 0x8f, 0x04, 0xf6, 0x1b, 0x74, 0x30, 0x20, 0x9c, 0x13, 0x1a, 0xce, 0x29, 0x19, 0x3d, 0xb0,
-	0x82, 0x32, 0x38, 0x59, 0xcc, 0x95, 0xdd, 0xd7, 0xdd, 0x92, 0xce, 0x77, 0xcb, 0x79, 0xee, 0x2d,
-	0xc7, 0x29, 0x52, 0x9a, 0xcd, 0xd0, 0x86, 0xd8, 0x7f, 0x2d, 0xb0, 0x95, 0x06, 0x79, 0x87, 0x70,
-	0x41, 0x19, 0xf1, 0x50, 0x7f, 0xbe, 0x55, 0x5f, 0x07, 0x8b, 0x43, 0xcc, 0x08, 0x55, 0x1a, 0x8f,
-	0x35, 0xf5, 0x13, 0xfc, 0x14, 0x1c, 0x4f, 0x1a, 0x60, 0x41, 0x8a, 0x7f, 0x6d, 0x36, 0xf1, 0x39,
-	0xde, 0xa6, 0xf0, 0x24, 0xa4, 0x21, 0xfa, 0x57, 0x0b, 0x9c, 0x4b, 0x9d, 0x1b, 0x23, 0xc6, 0x70,
-	0x28, 0xe6, 0xab, 0xf8, 0xe3, 0x4c, 0x99, 0x2a, 0xeb, 0x2b, 0xb3, 0x29, 0x9b, 0x26, 0xf7, 0x08,
-	0x59, 0x3f, 0x56, 0xc1, 0x99, 0x74, 0xe4, 0xb4, 0x04, 0x62, 0x82, 0x84, 0x41, 0x3c, 0x72, 0xb4,
-	0xa8, 0x27, 0x34, 0x78, 0x0a, 0x73, 0x53, 0x3d, 0x7a, 0x6e, 0x3a, 0xe0, 0x69, 0xae, 0xc9, 0xb6,
-	0x49, 0xd8, 0xa5, 0xba, 0xf6, 0xbb, 0xa5, 0x19, 0x2a, 0xd4, 0x69, 0xe6, 0xe7, 0x04, 0x37, 0x2e,
-	0x8c, 0x24, 0x7d, 0x5f, 0x05, 0x1b, 0x29, 0xb5, 0x56, 0x1f, 0xf1, 0xde, 0xf5, 0x48, 0x66, 0x78,
-	0x5e, 0x9d, 0xde, 0xc3, 0x24, 0xe8, 0x89, 0xa4, 0xd3, 0xd5, 0x93, 0xf1, 0x06, 0x2c, 0x4c, 0xbd,
-	0x01, 0x14, 0x9c, 0xce, 0xf0, 0x79, 0xcc, 0xae, 0x8d, 0x63, 0x7a, 0xf6, 0x31, 0x99, 0x93, 0x97,
-	0x66, 0xeb, 0x9a, 0x4c, 0x96, 0x99, 0x91, 0x53, 0x51, 0xfe, 0xde, 0x48, 0xcc, 0xb7, 0xcb, 0xe0,
-	0xc4, 0xdb, 0x6a, 0xe7, 0xb6, 0x04, 0x12, 0x18, 0xde, 0x00, 0x8b, 0x43, 0xc4, 0xd0, 0x40, 0x25,
-	0x60, 0x65, 0xf7, 0xb9, 0x52, 0xf0, 0x7d, 0x69, 0x6a, 0xe2, 0x69, 0x6f, 0xf8, 0x1e, 0x58, 0xea,
-	0x62, 0xdc, 0x1e, 0x52, 0xda, 0xd7, 0xcd, 0x7f, 0xa1, 0x34, 0xd2, 0x0d, 0x8c, 0xf7, 0x29, 0xed,
-	0x4f, 0x35, 0x7b, 0x57, 0x9d, 0xc1, 0x31, 0xb0, 0xb3, 0x16, 0x4e, 0xd7, 0x5f, 0xdc, 0x35, 0xf1,
-	0xc8, 0x58, 0x98, 0xbd, 0x6d, 0xcc, 0x8d, 0x6c, 0x22, 0xad, 0xfb, 0x45, 0x16, 0x3c, 0x7e, 0x77,
-	0x86, 0x0c, 0x47, 0x84, 0x8e, 0xe4, 0x07, 0xc0, 0x90, 0x72, 0xcc, 0x64, 0x51, 0x4a, 0xdf, 0x9d,
-	0xc4, 0x65, 0x5f, 0x7b, 0xc0, 0x2f, 0x8a, 0xd7, 0xdd, 0x53, 0x92, 0xfa, 0x9b, 0xb3, 0x55, 0xf7,
-	0x61, 0xcb, 0xd9, 0x94, 0x51, 0xb0, 0xe1, 0xe0, 0x0f, 0x16, 0xd8, 0x36, 0x9a, 0x3b, 0x5b, 0x07,
-	0x6d, 0x2f, 0xdd, 0x18, 0xdc, 0x5e, 0x94, 0x54, 0xae, 0xfe, 0x8f, 0xad, 0x93, 0x67, 0x53, 0x8b,
-	0x4a, 0x1d, 0x38, 0xfc, 0xc6, 0x02, 0x67, 0x33, 0x6a, 0xbd, 0x74, 0x9c, 0xa7, 0x09, 0x3a, 0x2e,
-	0x59, 0xbd, 0x71, 0xc4, 0x75, 0x90, 0x67, 0xb4, 0x19, 0x3d, 0xd4, 0x18, 0x7e, 0x65, 0x81, 0x8d,
-	0x8c, 0x8c, 0xa7, 0x26, 0x70, 0xca, 0x64, 0x49, 0x32, 0xb9, 0x72, 0x94, 0xf1, 0x9d, 0xa7, 0xf1,
-	0x6c, 0x54, 0x6c, 0x09, 0xbf, 0x34, 0xfb, 0x7c, 0x6a, 0x3a, 0x72, 0x7b, 0x59, 0x32, 0xb8, 0xfc,
-	0xf8, 0xe3, 0x31, 0x8f, 0x9f, 0x75, 0xbb, 0x69, 0xc7, 0xe1, 0x18, 0xac, 0x17, 0x8e, 0x21, 0x6e,
-	0x03, 0x09, 0xfe, 0xea, 0xe3, 0xce, 0xa1, 0x3c, 0xf4, 0x5a, 0xc1, 0x34, 0x32, 0x96, 0xd9, 0xde,
-	0x5b, 0x77, 0x26, 0x8e, 0x75, 0x6f, 0xe2, 0x58, 0xf7, 0x27, 0x8e, 0xf5, 0xe7, 0xc4, 0xb1, 0xbe,
-	0x3b, 0x70, 0x2a, 0xf7, 0x0f, 0x9c, 0xca, 0x6f, 0x07, 0x4e, 0xe5, 0x93, 0x6d, 0x85, 0xcf, 0xfd,
-	0x9b, 0x2e, 0xa1, 0xf5, 0xcf, 0xa6, 0xbf, 0xe1, 0xe5, 0x27, 0x5f, 0x67, 0x51, 0x7e, 0x87, 0xbf,
-	0xfc, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x95, 0x53, 0xd9, 0xb1, 0x65, 0x0c, 0x00, 0x00,
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xda, 0x21, 0x4d, 0xc6, 0x41, 0x6d, 0x26, 0xa9, 0xd9, 0xa4, 0xad, 0xed, 0x94, 0x1e,
+	0x02, 0x55, 0xd6, 0xad, 0xf9, 0xaa, 0x8a, 0x00, 0xc5, 0x6e, 0xcb, 0xc7, 0x01, 0x22, 0x47, 0x02,
+	0x81, 0x90, 0xac, 0xf1, 0xee, 0xc4, 0x1e, 0xd5, 0xde, 0xb1, 0x66, 0x66, 0xd7, 0x80, 0xc4, 0x81,
+	0x53, 0x11, 0x27, 0x4e, 0x88, 0x63, 0xc5, 0xa9, 0x42, 0x42, 0xe2, 0x10, 0xfe, 0x87, 0x1e, 0xab,
+	0x8a, 0x03, 0xe2, 0x00, 0x28, 0x39, 0x80, 0xe0, 0xc6, 0x5f, 0x80, 0x76, 0x67, 0x76, 0x77, 0x9c,
+	0xdd, 0x38, 0x4e, 0x20, 0x12, 0x97, 0xc4, 0xde, 0x79, 0x1f, 0xbf, 0xdf, 0xef, 0xbd, 0x7d, 0x6f,
+	0x0c, 0x9e, 0xb1, 0x29, 0x1f, 0x50, 0x5e, 0x73, 0x08, 0x17, 0x8c, 0x74, 0x3c, 0x41, 0xa8, 0x5b,
+	0xf3, 0xaf, 0x77, 0xb0, 0x40, 0xd7, 0x6b, 0x5d, 0xec, 0x62, 0x4e, 0xb8, 0x35, 0x64, 0x54, 0x50,
+	0x78, 0x41, 0x9a, 0x5a, 0xba, 0xa9, 0xa5, 0x4c, 0x57, 0x97, 0xbb, 0xb4, 0x4b, 0x43, 0xbb, 0x5a,
+	0xf0, 0x49, 0xba, 0xac, 0x96, 0x55, 0xf4, 0x0e, 0xe2, 0x38, 0x8e, 0x6a, 0x53, 0xe2, 0xaa, 0x73,
+	0x6b, 0x52, 0xf6, 0xb1, 0x3c, 0xd2, 0x7e, 0x45, 0xda, 0xb7, 0x65, 0x22, 0x85, 0x47, 0x1e, 0x2d,
+	0xa2, 0x01, 0x71, 0x69, 0x2d, 0xfc, 0x2b, 0x1f, 0x5d, 0xfe, 0xce, 0x00, 0xe7, 0x6f, 0xe1, 0x3e,
+	0xee, 0x22, 0x41, 0xd9, 0x7b, 0x44, 0xf4, 0x1c, 0x86, 0x46, 0x6f, 0xba, 0x3b, 0x14, 0xde, 0x06,
+	0x8b, 0x4e, 0x74, 0xd0, 0x46, 0x8e, 0xc3, 0x30, 0xe7, 0xa6, 0x51, 0x35, 0xd6, 0xe7, 0x1b, 0xe6,
+	0xe3, 0xdd, 0x8d, 0x65, 0x15, 0x79, 0x53, 0x9e, 0x6c, 0x0b, 0x46, 0xdc, 0x6e, 0xeb, 0x5c, 0xec,
+	0xa2, 0x9e, 0xc3, 0x26, 0x38, 0x37, 0x52, 0x61, 0xe3, 0x28, 0xf9, 0x23, 0xa2, 0x9c, 0x8d, 0x3c,
+	0xd4, 0xe3, 0x9b, 0x73, 0x9f, 0xdf, 0xaf, 0xe4, 0xfe, 0xb8, 0x5f, 0xc9, 0x5d, 0xbe, 0x97, 0x07,
+	0x6b, 0xef, 0xa2, 0x3e, 0x71, 0x82, 0x1c, 0xef, 0x78, 0x82, 0x0b, 0xe4, 0x3a, 0x81, 0x0f, 0x1e,
+	0x21, 0xe6, 0xf0, 0x16, 0xb6, 0x29, 0x73, 0xe0, 0xdb, 0x60, 0xd1, 0x8f, 0x8c, 0x0e, 0x60, 0x5f,
+	0x7b, 0xbc, 0xbb, 0x71, 0x49, 0x65, 0x8d, 0x03, 0x1d, 0x20, 0xe1, 0x1f, 0x78, 0x0e, 0xef, 0x19,
+	0x60, 0x89, 0x26, 0xc9, 0xda, 0x4c, 0x66, 0x33, 0xf3, 0xd5, 0xc2, 0x7a, 0xb1, 0x7e, 0x51, 0x95,
+	0xc8, 0x0a, 0x4a, 0x18, 0x55, 0xdb, 0xba, 0x85, 0xed, 0x26, 0x25, 0x6e, 0xe3, 0xc6, 0xc3, 0x5f,
+	0x2a, 0xb9, 0x6f, 0x7f, 0xad, 0x5c, 0xed, 0x12, 0xd1, 0xf3, 0x3a, 0x96, 0x4d, 0x07, 0xaa, 0x2a,
+	0xea, 0xdf, 0x06, 0x77, 0xee, 0xd6, 0xc4, 0xc7, 0x43, 0xcc, 0x23, 0x1f, 0xfe, 0xe0, 0xf7, 0xef,
+	0x9f, 0x35, 0x5a, 0x90, 0xa6, 0xf8, 0x69, 0x4a, 0xfc, 0x69, 0x80, 0x2b, 0x09, 0x01, 0xdb, 0xf6,
+	0x06, 0x5e, 0x1f, 0x09, 0xec, 0x34, 0xe9, 0x60, 0x40, 0x38, 0x27, 0xd4, 0x3d, 0x25, 0x31, 0x7a,
+	0xa0, 0x88, 0x92, 0x74, 0x61, 0x31, 0x8b, 0xf5, 0x97, 0xad, 0x09, 0x9d, 0x6f, 0x4d, 0xc6, 0xd9,
+	0x98, 0x0f, 0x24, 0x92, 0x9c, 0xf5, 0xd0, 0x1a, 0xd9, 0xbf, 0x0d, 0x50, 0x8d, 0x83, 0xbc, 0x41,
+	0xb8, 0xa0, 0x8c, 0xd8, 0xa8, 0x7f, 0xba, 0x55, 0x2f, 0x81, 0xd9, 0x21, 0x66, 0x84, 0x4a, 0x8e,
+	0x33, 0x2d, 0xf5, 0x0d, 0x7e, 0x08, 0xce, 0x44, 0x0d, 0x50, 0x08, 0xc9, 0xbf, 0x34, 0x1d, 0xf9,
+	0x14, 0x6e, 0x9d, 0x78, 0x14, 0x52, 0x23, 0xfd, 0xa3, 0x01, 0x2e, 0xc5, 0xce, 0x4d, 0x8f, 0x31,
+	0xec, 0x8a, 0xd3, 0x65, 0xfc, 0x7e, 0xc2, 0x4c, 0x96, 0xf5, 0xf9, 0xe9, 0x98, 0x8d, 0x83, 0x3b,
+	0x82, 0xd6, 0x37, 0x79, 0x70, 0x21, 0x1e, 0x39, 0xdb, 0x02, 0x31, 0x41, 0xdc, 0x6e, 0x30, 0x72,
+	0x14, 0xa9, 0xff, 0x68, 0xf0, 0x64, 0x6a, 0x93, 0x3f, 0xb9, 0x36, 0x1d, 0xf0, 0x24, 0x57, 0x60,
+	0xdb, 0xc4, 0xdd, 0xa1, 0xaa, 0xf6, 0xf5, 0x89, 0x0a, 0x65, 0xf2, 0xd4, 0xf5, 0x59, 0xe0, 0xda,
+	0x81, 0x26, 0xd2, 0x57, 0x79, 0xb0, 0x12, 0x43, 0xdb, 0xee, 0x23, 0xde, 0xbb, 0xed, 0x87, 0x0a,
+	0x9f, 0x56, 0xa7, 0xf7, 0x30, 0xe9, 0xf6, 0x44, 0xd4, 0xe9, 0xf2, 0x9b, 0xf6, 0x06, 0x14, 0xc6,
+	0xde, 0x00, 0x0a, 0xce, 0x27, 0xf9, 0x79, 0x80, 0xae, 0x8d, 0x03, 0x78, 0xe6, 0x4c, 0xa8, 0xc9,
+	0xb5, 0xe9, 0xba, 0x26, 0xa1, 0xa5, 0x2b, 0xb2, 0xe4, 0xa7, 0xcf, 0x35, 0x61, 0x7e, 0x30, 0x00,
+	0xdc, 0xf4, 0x04, 0x6d, 0xd2, 0xc1, 0x90, 0x7a, 0xae, 0xd3, 0xf4, 0x18, 0xa7, 0xec, 0x7f, 0xda,
+	0x34, 0x1a, 0xee, 0xbf, 0x00, 0x58, 0x78, 0x5d, 0xde, 0x15, 0xb6, 0x05, 0x12, 0x18, 0xde, 0x01,
+	0xb3, 0x43, 0xc4, 0xd0, 0x40, 0xc2, 0x2c, 0xd6, 0x9f, 0x9e, 0x28, 0xda, 0x56, 0x68, 0xaa, 0xeb,
+	0xa4, 0xbc, 0xe1, 0x5b, 0x60, 0x6e, 0x07, 0xe3, 0xf6, 0x90, 0xd2, 0xbe, 0x7a, 0x69, 0xaf, 0x4c,
+	0x8c, 0x74, 0x07, 0xe3, 0x2d, 0x4a, 0xfb, 0x63, 0x2f, 0xe9, 0x8e, 0x7c, 0x06, 0x47, 0xc0, 0x4c,
+	0x54, 0x8c, 0xd7, 0x76, 0xd0, 0xed, 0xc1, 0xa8, 0x2b, 0x4c, 0xdf, 0xee, 0xfa, 0x4d, 0x42, 0xcf,
+	0x54, 0x72, 0xb2, 0x2c, 0x78, 0x50, 0xbe, 0x21, 0xc3, 0x3e, 0xa1, 0x5e, 0x78, 0x71, 0x19, 0x52,
+	0x8e, 0x99, 0x39, 0x73, 0x54, 0xf9, 0x22, 0x97, 0x2d, 0xe5, 0x01, 0x3f, 0xc9, 0x5e, 0xd3, 0x4f,
+	0x84, 0xd0, 0x5f, 0x9d, 0xae, 0x2b, 0x0f, 0xbb, 0x54, 0xe8, 0x34, 0x32, 0x36, 0x33, 0xfc, 0xda,
+	0x00, 0x6b, 0x5a, 0xef, 0x24, 0x6b, 0xac, 0x6d, 0xc7, 0x9b, 0x8e, 0x9b, 0xb3, 0x21, 0x94, 0xcd,
+	0x7f, 0xb1, 0x2d, 0xd3, 0x68, 0x2a, 0xfe, 0x44, 0x07, 0x0e, 0xbf, 0x30, 0xc0, 0xc5, 0x04, 0x5a,
+	0x2f, 0x5e, 0x43, 0xb1, 0x40, 0x67, 0x42, 0x54, 0xaf, 0x9c, 0x70, 0x8d, 0xa5, 0x11, 0xad, 0xfa,
+	0x87, 0x1a, 0xc3, 0xcf, 0x0c, 0xb0, 0x92, 0x80, 0xb1, 0xe5, 0xe6, 0x88, 0x91, 0xcc, 0x85, 0x48,
+	0x6e, 0x9e, 0x64, 0xed, 0xa4, 0x61, 0x3c, 0xe5, 0x67, 0x5b, 0xc2, 0x4f, 0xf5, 0x3e, 0x1f, 0x9b,
+	0xea, 0xdc, 0x9c, 0x0f, 0x11, 0xdc, 0x38, 0xfe, 0x58, 0x4f, 0xe7, 0x2f, 0x39, 0x59, 0x76, 0x1c,
+	0x8e, 0x40, 0x29, 0x73, 0x7c, 0x72, 0x13, 0x84, 0xc9, 0x5f, 0x3c, 0xee, 0xfc, 0x4c, 0xa7, 0x5e,
+	0xce, 0x98, 0xa2, 0x1c, 0x62, 0x60, 0x22, 0x4f, 0xd0, 0xb6, 0xad, 0x86, 0x67, 0x3b, 0x06, 0xc8,
+	0xcd, 0x62, 0xb5, 0xb0, 0x3e, 0xdf, 0xb8, 0x7a, 0xd8, 0xdb, 0xf6, 0xf3, 0xee, 0xc6, 0xd9, 0xe4,
+	0xbe, 0x5a, 0xbd, 0x66, 0xbd, 0x50, 0x6f, 0x95, 0x90, 0x36, 0x88, 0x63, 0x4d, 0x38, 0xf4, 0xc1,
+	0xf2, 0x78, 0x1a, 0x3b, 0x1c, 0xd2, 0xe6, 0x42, 0x38, 0x9e, 0x6a, 0x13, 0xd9, 0xa5, 0x67, 0x7b,
+	0x63, 0x29, 0x2b, 0x37, 0x44, 0x29, 0xc3, 0x64, 0xda, 0x36, 0x5e, 0x7b, 0xb0, 0x57, 0x36, 0x1e,
+	0xee, 0x95, 0x8d, 0x47, 0x7b, 0x65, 0xe3, 0xb7, 0xbd, 0xb2, 0xf1, 0xe5, 0x7e, 0x39, 0xf7, 0x68,
+	0xbf, 0x9c, 0xfb, 0x69, 0xbf, 0x9c, 0xfb, 0x60, 0x4d, 0x06, 0xe3, 0xce, 0x5d, 0x8b, 0xd0, 0xda,
+	0x47, 0xe3, 0x3f, 0xad, 0xc2, 0x9b, 0x78, 0x67, 0x36, 0xfc, 0x79, 0xf4, 0xdc, 0x3f, 0x03, 0x00,
+	0xbb, 0x34, 0xf7, 0xce, 0xfc, 0x0d, 0x00, 0x00,
 }
 
 func (m *DelegatorWithdrawInfo) Marshal() (dAtA []byte, err error) {
@@ -764,6 +818,43 @@ func (m *ValidatorSlashEventRecord) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *AutoCompoundCursor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoCompoundCursor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoCompoundCursor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -784,6 +875,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCompoundCursor != nil {
+		{
+			size, err := m.AutoCompoundCursor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoCompoundDelegators[iNdEx])
+			copy(dAtA[i:], m.AutoCompoundDelegators[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoCompoundDelegators[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ValidatorSlashEvents) > 0 {
 		for iNdEx := len(m.ValidatorSlashEvents) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1047,6 +1159,23 @@ func (m *ValidatorSlashEventRecord) Size() (n int) {
 	return n
 }
 
+func (m *AutoCompoundCursor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1103,6 +1232,16 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AutoCompoundDelegators) > 0 {
+		for _, s := range m.AutoCompoundDelegators {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.AutoCompoundCursor != nil {
+		l = m.AutoCompoundCursor.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -2006,6 +2145,120 @@ func (m *ValidatorSlashEventRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *AutoCompoundCursor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundCursor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundCursor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2371,6 +2624,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegators = append(m.AutoCompoundDelegators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundCursor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoCompoundCursor == nil {
+				m.AutoCompoundCursor = &AutoCompoundCursor{}
+			}
+			if err := m.AutoCompoundCursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
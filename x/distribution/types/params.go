@@ -6,19 +6,39 @@ import (
 	"cosmossdk.io/math"
 )
 
+// Default auto-compound parameters
+const (
+	DefaultAutoCompoundEpochIdentifier = "day"
+	DefaultMaxAutoCompoundPerBlock     = uint32(100)
+)
+
 // DefaultParams returns default distribution parameters
 func DefaultParams() Params {
 	return Params{
-		CommunityTax:        math.LegacyNewDecWithPrec(2, 2), // 2%
-		BaseProposerReward:  math.LegacyZeroDec(),            // deprecated
-		BonusProposerReward: math.LegacyZeroDec(),            // deprecated
-		WithdrawAddrEnabled: true,
+		CommunityTax:                math.LegacyNewDecWithPrec(2, 2), // 2%
+		BaseProposerReward:          math.LegacyZeroDec(),            // deprecated
+		BonusProposerReward:         math.LegacyZeroDec(),            // deprecated
+		WithdrawAddrEnabled:         true,
+		AutoCompoundEpochIdentifier: DefaultAutoCompoundEpochIdentifier,
+		MaxAutoCompoundPerBlock:     DefaultMaxAutoCompoundPerBlock,
 	}
 }
 
 // ValidateBasic performs basic validation on distribution parameters.
 func (p Params) ValidateBasic() error {
-	return validateCommunityTax(p.CommunityTax)
+	if err := validateCommunityTax(p.CommunityTax); err != nil {
+		return err
+	}
+
+	return validateAutoCompound(p.AutoCompoundEpochIdentifier, p.MaxAutoCompoundPerBlock)
+}
+
+func validateAutoCompound(epochIdentifier string, maxPerBlock uint32) error {
+	if epochIdentifier != "" && maxPerBlock == 0 {
+		return fmt.Errorf("max auto-compound per block must be positive when auto-compounding is enabled")
+	}
+
+	return nil
 }
 
 func validateCommunityTax(i interface{}) error {
@@ -960,6 +960,100 @@ func (m *QueryCommunityPoolResponse) GetPool() github_com_cosmos_cosmos_sdk_type
 	return nil
 }
 
+// QueryAutoCompoundRequest is the request type for the Query/AutoCompound RPC
+// method.
+type QueryAutoCompoundRequest struct {
+	// delegator_address defines the delegator address to query for.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *QueryAutoCompoundRequest) Reset()         { *m = QueryAutoCompoundRequest{} }
+func (m *QueryAutoCompoundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundRequest) ProtoMessage()    {}
+func (*QueryAutoCompoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{20}
+}
+func (m *QueryAutoCompoundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundRequest.Merge(m, src)
+}
+func (m *QueryAutoCompoundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundRequest proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundRequest) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// QueryAutoCompoundResponse is the response type for the Query/AutoCompound RPC
+// method.
+type QueryAutoCompoundResponse struct {
+	// enabled is true if the rewards of the delegator are auto-compounded.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryAutoCompoundResponse) Reset()         { *m = QueryAutoCompoundResponse{} }
+func (m *QueryAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoCompoundResponse) ProtoMessage()    {}
+func (*QueryAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5efd02cbc06efdc9, []int{21}
+}
+func (m *QueryAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoCompoundResponse.Merge(m, src)
+}
+func (m *QueryAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAutoCompoundResponse proto.InternalMessageInfo
+
+func (m *QueryAutoCompoundResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.distribution.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.distribution.v1beta1.QueryParamsResponse")
@@ -981,6 +1075,8 @@ func init() {
 	proto.RegisterType((*QueryDelegatorWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.QueryDelegatorWithdrawAddressResponse")
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.QueryCommunityPoolResponse")
+	proto.RegisterType((*QueryAutoCompoundRequest)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundRequest")
+	proto.RegisterType((*QueryAutoCompoundResponse)(nil), "cosmos.distribution.v1beta1.QueryAutoCompoundResponse")
 }
 
 func init() {
@@ -988,89 +1084,93 @@ func init() {
 }
 
 var fileDescriptor_5efd02cbc06efdc9 = []byte{
-	// 1299 bytes of a gzipped FileDescriptorProto
+	// 1376 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x6c, 0x1b, 0xc5,
-	0x17, 0xce, 0x38, 0x69, 0xfa, 0xcb, 0xeb, 0xaf, 0x24, 0x9e, 0x06, 0xe4, 0x6c, 0x53, 0x27, 0xdd,
-	0xd0, 0x26, 0x6a, 0x14, 0x6f, 0x9a, 0x8a, 0x52, 0x12, 0x2a, 0x88, 0x9d, 0x84, 0x56, 0x44, 0x69,
-	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0xb3, 0x8e, 0x89,
-	0xaa, 0x5e, 0xca, 0xa5, 0xf4, 0x84, 0xe0, 0xc2, 0x0d, 0x8e, 0x88, 0x13, 0x87, 0xdc, 0xe1, 0x58,
-	0xc1, 0xa5, 0x0a, 0x12, 0x42, 0x1c, 0x00, 0x25, 0x48, 0x94, 0x03, 0x88, 0x1b, 0x57, 0xe4, 0x99,
-	0x59, 0x7b, 0xd7, 0x5e, 0xaf, 0xff, 0xc9, 0x97, 0xc4, 0x9a, 0x9d, 0xf7, 0x7d, 0xef, 0x7b, 0xef,
-	0xcd, 0xec, 0x67, 0xc3, 0x74, 0x86, 0xd0, 0x3c, 0xa1, 0x8a, 0x66, 0x50, 0xdb, 0x32, 0xd2, 0x45,
-	0xdb, 0x20, 0xa6, 0xb2, 0x7b, 0x31, 0xad, 0xdb, 0xea, 0x45, 0x65, 0xa7, 0xa8, 0x5b, 0x7b, 0xb1,
-	0x82, 0x45, 0x6c, 0x82, 0x4f, 0xf3, 0x8d, 0x31, 0xf7, 0xc6, 0x98, 0xd8, 0x28, 0x5d, 0x10, 0x28,
-	0x69, 0x95, 0xea, 0x3c, 0xaa, 0x82, 0x51, 0x50, 0xb3, 0x86, 0xa9, 0xb2, 0xdd, 0x0c, 0x48, 0x1a,
-	0xcd, 0x92, 0x2c, 0x61, 0x1f, 0x95, 0xf2, 0x27, 0xb1, 0x3a, 0x9e, 0x25, 0x24, 0x9b, 0xd3, 0x15,
-	0xb5, 0x60, 0x28, 0xaa, 0x69, 0x12, 0x9b, 0x85, 0x50, 0xf1, 0x34, 0xea, 0xc6, 0x77, 0x90, 0x33,
-	0xc4, 0x70, 0x30, 0x63, 0x41, 0x2a, 0x3c, 0x19, 0xf3, 0xfd, 0x63, 0x7c, 0x7f, 0x8a, 0xa7, 0x21,
-	0x94, 0xf1, 0x47, 0x61, 0x35, 0x6f, 0x98, 0x44, 0x61, 0x7f, 0xf9, 0x92, 0x3c, 0x0a, 0xf8, 0x56,
-	0x59, 0xd3, 0x4d, 0xd5, 0x52, 0xf3, 0x34, 0xa9, 0xef, 0x14, 0x75, 0x6a, 0xcb, 0x77, 0xe1, 0x94,
-	0x67, 0x95, 0x16, 0x88, 0x49, 0x75, 0xbc, 0x06, 0x83, 0x05, 0xb6, 0x12, 0x41, 0x93, 0x68, 0xe6,
-	0xc4, 0xc2, 0x54, 0x2c, 0xa0, 0x70, 0x31, 0x1e, 0x1c, 0x1f, 0x7a, 0xf2, 0xcb, 0x44, 0xdf, 0x97,
-	0x7f, 0x7c, 0x7d, 0x01, 0x25, 0x45, 0xb4, 0x5c, 0x82, 0x73, 0x0c, 0xfe, 0x6d, 0x35, 0x67, 0x68,
-	0xaa, 0x4d, 0xac, 0x15, 0x57, 0xfc, 0x75, 0x73, 0x8b, 0x88, 0x3c, 0xf0, 0x06, 0x84, 0x77, 0x9d,
-	0x3d, 0x29, 0x55, 0xd3, 0x2c, 0x9d, 0x72, 0xee, 0xa1, 0xf8, 0xd9, 0x83, 0xfd, 0xb9, 0x33, 0x82,
-	0xbe, 0x82, 0xb3, 0xcc, 0xb7, 0xdc, 0xb6, 0x2d, 0xc3, 0xcc, 0x26, 0x47, 0x76, 0x6b, 0xd6, 0xe5,
-	0xbf, 0x43, 0x70, 0xbe, 0x19, 0xb3, 0xd0, 0xba, 0x0e, 0x23, 0xa4, 0xa0, 0x5b, 0x9d, 0x31, 0x0f,
-	0x3b, 0xa1, 0x62, 0x19, 0x3f, 0x44, 0x10, 0xa6, 0x7a, 0x6e, 0x2b, 0x95, 0x26, 0xa6, 0x96, 0xb2,
-	0xf4, 0x92, 0x6a, 0x69, 0x34, 0x12, 0x9a, 0xec, 0x9f, 0x39, 0xb1, 0x30, 0xee, 0x54, 0xb1, 0x3c,
-	0x01, 0x95, 0xea, 0xad, 0xe8, 0x99, 0x04, 0x31, 0xcc, 0xf8, 0x95, 0x72, 0xf9, 0xbe, 0xfa, 0x75,
-	0x62, 0x36, 0x6b, 0xd8, 0xdb, 0xc5, 0x74, 0x2c, 0x43, 0xf2, 0xa2, 0xa9, 0xe2, 0xdf, 0x1c, 0xd5,
-	0xee, 0x29, 0xf6, 0x5e, 0x41, 0xa7, 0x4e, 0x0c, 0xe5, 0xd5, 0x1e, 0x2e, 0x13, 0xc6, 0x89, 0xa9,
-	0x25, 0x39, 0x1d, 0xde, 0x01, 0xc8, 0x90, 0x7c, 0xde, 0xa0, 0xd4, 0x20, 0x66, 0xa4, 0xbf, 0x05,
-	0xf2, 0x4b, 0x1d, 0x90, 0x27, 0x5d, 0x24, 0xf2, 0x1e, 0x4c, 0x7b, 0xeb, 0x7d, 0xa3, 0x68, 0x53,
-	0x5b, 0x35, 0xb5, 0x72, 0x95, 0x78, 0x5a, 0xbd, 0xea, 0xf5, 0x47, 0x08, 0x66, 0x9a, 0x73, 0x8b,
-	0x6e, 0xdf, 0x85, 0xe3, 0x4e, 0x53, 0xf8, 0x68, 0x5f, 0x09, 0x1c, 0xed, 0x00, 0x48, 0xf7, 0xbc,
-	0x3b, 0x98, 0xf2, 0x0e, 0x4c, 0x78, 0x53, 0x49, 0x54, 0x4a, 0xd4, 0x2b, 0xf9, 0x8f, 0x11, 0x4c,
-	0x36, 0xe6, 0x14, 0xb2, 0xb7, 0x3c, 0x13, 0xc1, 0x95, 0x2f, 0xb5, 0xa6, 0x7c, 0x39, 0x93, 0x29,
-	0xe6, 0x8b, 0x39, 0xd5, 0xd6, 0xb5, 0x2a, 0xb0, 0x5b, 0xbc, 0x7b, 0x0c, 0x1e, 0x87, 0x60, 0xdc,
-	0x9b, 0xcc, 0xed, 0x9c, 0x4a, 0xb7, 0xf5, 0x5e, 0x35, 0x1f, 0x4f, 0xc3, 0x30, 0xb5, 0x55, 0xcb,
-	0x36, 0xcc, 0x6c, 0x6a, 0x5b, 0x37, 0xb2, 0xdb, 0x76, 0x24, 0x34, 0x89, 0x66, 0x06, 0x92, 0xcf,
-	0x39, 0xcb, 0xd7, 0xd8, 0x2a, 0x9e, 0x82, 0x93, 0x3a, 0x6b, 0x9f, 0xb3, 0xad, 0x9f, 0x6d, 0xfb,
-	0x3f, 0x5f, 0x14, 0x9b, 0xd6, 0x00, 0xaa, 0x57, 0x7d, 0x64, 0x80, 0x95, 0xe9, 0xbc, 0xe7, 0xe0,
-	0xf0, 0xb7, 0x49, 0xf5, 0xe6, 0xcb, 0xea, 0x42, 0x59, 0xd2, 0x15, 0xb9, 0x38, 0xf0, 0xe8, 0x8b,
-	0x89, 0x3e, 0xf9, 0x1b, 0x04, 0x67, 0x1a, 0x14, 0x43, 0xb4, 0xe5, 0x2d, 0x38, 0x4e, 0xf9, 0x52,
-	0x04, 0xb1, 0x53, 0x3a, 0xdf, 0x5a, 0x4f, 0x18, 0xce, 0xea, 0xae, 0x6e, 0xda, 0x9e, 0x29, 0x14,
-	0x58, 0xf8, 0x0d, 0x8f, 0x8c, 0x10, 0x93, 0x31, 0xdd, 0x54, 0x06, 0xcf, 0xc9, 0xad, 0x43, 0xfe,
-	0xd6, 0x51, 0xb0, 0xa2, 0xe7, 0xf4, 0x2c, 0x5b, 0xab, 0x39, 0xcc, 0xab, 0x10, 0xd6, 0xf8, 0xb3,
-	0xba, 0x7e, 0x46, 0x0e, 0xf6, 0xe7, 0x46, 0x05, 0x69, 0x4d, 0x1b, 0x2b, 0x21, 0x4e, 0x1b, 0x7d,
-	0xc7, 0x22, 0xd4, 0xf1, 0x58, 0x2c, 0xfe, 0xaf, 0xdc, 0x80, 0x67, 0xe5, 0x26, 0x7c, 0x82, 0x20,
-	0xda, 0x48, 0x82, 0xe8, 0x42, 0xc1, 0x7d, 0x27, 0xf4, 0xf2, 0xa2, 0xae, 0x5c, 0x13, 0x45, 0x90,
-	0x6b, 0x72, 0xba, 0x43, 0x6c, 0x35, 0xd7, 0x93, 0xda, 0xba, 0x6a, 0xf1, 0x0f, 0x82, 0xa9, 0x40,
-	0x5e, 0x51, 0x90, 0xf7, 0x6a, 0x0b, 0x72, 0x39, 0x70, 0x2c, 0xab, 0x68, 0x2b, 0x0e, 0x37, 0x47,
-	0xf4, 0xbb, 0x22, 0x71, 0x0e, 0x8e, 0xd9, 0x65, 0xd2, 0x1e, 0xbf, 0x14, 0x39, 0x89, 0x6c, 0x89,
-	0x0b, 0xb9, 0x92, 0x59, 0x65, 0x84, 0x7a, 0x57, 0xe6, 0x75, 0x71, 0x21, 0xfb, 0x72, 0x8a, 0x12,
-	0x47, 0x01, 0x2a, 0x43, 0xcb, 0xab, 0x3c, 0x94, 0x74, 0xad, 0xb8, 0xd0, 0x4a, 0xf0, 0xa2, 0x17,
-	0x6d, 0xd3, 0xb0, 0xb7, 0x35, 0x4b, 0x2d, 0x09, 0xe2, 0x9e, 0xc9, 0xd8, 0x15, 0xe6, 0xad, 0x31,
-	0xb1, 0xd0, 0x92, 0x80, 0x91, 0x92, 0x78, 0xd4, 0x32, 0xf1, 0x70, 0xc9, 0x0b, 0xe6, 0xe2, 0x5d,
-	0x80, 0x31, 0xc6, 0x5b, 0x7e, 0xdb, 0x14, 0x4d, 0xc3, 0xde, 0xbb, 0x49, 0x48, 0x4e, 0xa8, 0x5c,
-	0x7c, 0xfe, 0x60, 0x7f, 0x6e, 0xb8, 0xda, 0xfa, 0xc9, 0xf9, 0xd8, 0x4b, 0xf3, 0x11, 0x24, 0x7f,
-	0x8e, 0x40, 0xf2, 0x0b, 0x12, 0x19, 0xbe, 0x0f, 0x03, 0x05, 0x42, 0x72, 0x3d, 0x3e, 0xde, 0x8c,
-	0xa3, 0x41, 0x86, 0x0b, 0x3f, 0x87, 0xe1, 0x18, 0xcb, 0x10, 0x7f, 0x86, 0x60, 0x90, 0x5b, 0x66,
-	0xac, 0x04, 0x9e, 0xab, 0x7a, 0xbf, 0x2e, 0xcd, 0xb7, 0x1e, 0xc0, 0xa5, 0xcb, 0xb3, 0x0f, 0x7f,
-	0xf8, 0xfd, 0xd3, 0xd0, 0x39, 0x3c, 0xa5, 0x04, 0x7d, 0xbd, 0xe0, 0x7e, 0x1d, 0xff, 0x89, 0x60,
-	0xac, 0xa1, 0x63, 0xc6, 0xf1, 0xe6, 0xe4, 0xcd, 0x8c, 0xbe, 0x94, 0xe8, 0x0a, 0x43, 0x68, 0x4a,
-	0x30, 0x4d, 0x57, 0xf1, 0x52, 0xa0, 0xa6, 0xea, 0x69, 0x52, 0xee, 0xd7, 0xbd, 0x5c, 0x1e, 0xe0,
-	0x0f, 0x43, 0x70, 0x3a, 0xc0, 0xde, 0xe1, 0x95, 0x36, 0x32, 0x6d, 0x68, 0x76, 0xa5, 0xd5, 0x2e,
-	0x51, 0x84, 0xe2, 0x4d, 0xa6, 0xf8, 0x16, 0xbe, 0xd1, 0x85, 0x62, 0x85, 0x54, 0xf1, 0x9d, 0x6f,
-	0x26, 0xf8, 0x10, 0xc1, 0x29, 0x1f, 0xe3, 0x88, 0x5f, 0x6d, 0x23, 0xef, 0x3a, 0x8f, 0x2b, 0x5d,
-	0xed, 0x30, 0x5a, 0xa8, 0xdd, 0x60, 0x6a, 0xaf, 0xe1, 0xb5, 0x6e, 0xd4, 0x56, 0x5d, 0x29, 0xfe,
-	0x11, 0xc1, 0x48, 0xad, 0x07, 0xc3, 0xaf, 0xb4, 0x91, 0xa3, 0xd7, 0xc4, 0x4a, 0x8b, 0x9d, 0x84,
-	0x0a, 0x6d, 0x6f, 0x32, 0x6d, 0xab, 0x38, 0xd1, 0x8d, 0x36, 0xc7, 0xe8, 0xfd, 0x85, 0x20, 0x5c,
-	0xe7, 0x6b, 0x70, 0x0b, 0xe9, 0x35, 0xf2, 0x73, 0xd2, 0x52, 0x47, 0xb1, 0x42, 0x5b, 0x8a, 0x69,
-	0x7b, 0x07, 0x6f, 0x06, 0x6a, 0xab, 0xbc, 0x72, 0xa8, 0x72, 0xbf, 0xee, 0x8d, 0xf5, 0x40, 0x11,
-	0x93, 0xe9, 0x7b, 0x66, 0x9f, 0x21, 0x78, 0xc1, 0xdf, 0xbb, 0xe0, 0xd7, 0xda, 0x49, 0xdc, 0xc7,
-	0x6d, 0x49, 0xaf, 0x77, 0x0e, 0xd0, 0x56, 0x6b, 0x5b, 0x93, 0xcf, 0x0e, 0xa6, 0x8f, 0x81, 0x68,
-	0xe5, 0x60, 0x36, 0xf6, 0x3a, 0xad, 0x1c, 0xcc, 0x00, 0xd7, 0xd2, 0xe2, 0xc1, 0x6c, 0xa2, 0xb0,
-	0x3a, 0xdb, 0xf8, 0x5f, 0x04, 0x91, 0x46, 0xf6, 0x02, 0x2f, 0xb7, 0x91, 0xab, 0xbf, 0x27, 0x92,
-	0xe2, 0xdd, 0x40, 0x08, 0xcd, 0x77, 0x98, 0xe6, 0x0d, 0xbc, 0xde, 0x8d, 0xe6, 0x5a, 0x7f, 0x84,
-	0xbf, 0x47, 0x70, 0xd2, 0xe3, 0x55, 0xf0, 0xe5, 0xe6, 0xb9, 0xfa, 0x39, 0x22, 0xe9, 0xe5, 0xb6,
-	0xe3, 0x84, 0xb0, 0xeb, 0xdf, 0xd5, 0x1b, 0x15, 0xa6, 0x75, 0x0e, 0xcf, 0x06, 0x6a, 0xcd, 0x38,
-	0x70, 0xa9, 0xb2, 0xe1, 0x79, 0x14, 0x42, 0xf1, 0xa5, 0x27, 0x87, 0x51, 0xf4, 0xf4, 0x30, 0x8a,
-	0x7e, 0x3b, 0x8c, 0xa2, 0x8f, 0x8f, 0xa2, 0x7d, 0x4f, 0x8f, 0xa2, 0x7d, 0x3f, 0x1d, 0x45, 0xfb,
-	0xde, 0x3d, 0xcb, 0x81, 0xa8, 0x76, 0x2f, 0x66, 0x10, 0xe5, 0x03, 0x2f, 0x20, 0x33, 0x51, 0xe9,
-	0x41, 0xf6, 0x03, 0xe5, 0xa5, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xa6, 0x54, 0xf1, 0x6b, 0xc6,
-	0x15, 0x00, 0x00,
+	0x17, 0xce, 0xb8, 0xe9, 0xbf, 0xd7, 0xf6, 0x97, 0x64, 0xd2, 0x1f, 0x72, 0xb6, 0xad, 0xe3, 0x6e,
+	0x68, 0x13, 0xb5, 0x8a, 0x37, 0x75, 0xd5, 0x52, 0x12, 0x22, 0x88, 0x9d, 0x84, 0x86, 0x46, 0x69,
+	0xeb, 0x16, 0x22, 0x40, 0x95, 0xb5, 0xf6, 0x6e, 0x9c, 0xa5, 0xf6, 0x8e, 0xb3, 0x7f, 0x6c, 0xa2,
+	0xaa, 0x97, 0x72, 0x29, 0x3d, 0x21, 0xb8, 0x70, 0x83, 0x23, 0xe2, 0xc4, 0x21, 0x77, 0x7a, 0xac,
+	0xe0, 0x52, 0x05, 0x09, 0x71, 0x02, 0x94, 0x20, 0x51, 0x0e, 0x20, 0x6e, 0x5c, 0x91, 0x67, 0x66,
+	0xed, 0x5d, 0x7b, 0xbd, 0xfe, 0x87, 0x2f, 0xad, 0x3b, 0x3b, 0xef, 0xfb, 0xde, 0xf7, 0xde, 0x9b,
+	0xd9, 0x6f, 0x0b, 0x93, 0x59, 0x62, 0x16, 0x88, 0x29, 0x29, 0x9a, 0x69, 0x19, 0x5a, 0xc6, 0xb6,
+	0x34, 0xa2, 0x4b, 0xa5, 0x4b, 0x19, 0xd5, 0x92, 0x2f, 0x49, 0x5b, 0xb6, 0x6a, 0x6c, 0xc7, 0x8a,
+	0x06, 0xb1, 0x08, 0x3e, 0xc5, 0x36, 0xc6, 0xdc, 0x1b, 0x63, 0x7c, 0xa3, 0x70, 0x81, 0xa3, 0x64,
+	0x64, 0x53, 0x65, 0x51, 0x55, 0x8c, 0xa2, 0x9c, 0xd3, 0x74, 0x99, 0xee, 0xa6, 0x40, 0xc2, 0xc9,
+	0x1c, 0xc9, 0x11, 0xfa, 0x53, 0xaa, 0xfc, 0xe2, 0xab, 0xa7, 0x73, 0x84, 0xe4, 0xf2, 0xaa, 0x24,
+	0x17, 0x35, 0x49, 0xd6, 0x75, 0x62, 0xd1, 0x10, 0x93, 0x3f, 0x8d, 0xb8, 0xf1, 0x1d, 0xe4, 0x2c,
+	0xd1, 0x1c, 0xcc, 0x58, 0x90, 0x0a, 0x4f, 0xc6, 0x6c, 0xff, 0x18, 0xdb, 0x9f, 0x66, 0x69, 0x70,
+	0x65, 0xec, 0xd1, 0x88, 0x5c, 0xd0, 0x74, 0x22, 0xd1, 0x3f, 0xd9, 0x92, 0x78, 0x12, 0xf0, 0xed,
+	0x8a, 0xa6, 0x5b, 0xb2, 0x21, 0x17, 0xcc, 0x94, 0xba, 0x65, 0xab, 0xa6, 0x25, 0xde, 0x83, 0x51,
+	0xcf, 0xaa, 0x59, 0x24, 0xba, 0xa9, 0xe2, 0x65, 0x38, 0x54, 0xa4, 0x2b, 0x61, 0x14, 0x45, 0x53,
+	0xc7, 0xe2, 0x13, 0xb1, 0x80, 0xc2, 0xc5, 0x58, 0x70, 0xe2, 0xe8, 0xb3, 0x9f, 0xc7, 0x07, 0xbe,
+	0xfa, 0xfd, 0x9b, 0x0b, 0x28, 0xc5, 0xa3, 0xc5, 0x32, 0x9c, 0xa3, 0xf0, 0xef, 0xc8, 0x79, 0x4d,
+	0x91, 0x2d, 0x62, 0x2c, 0xba, 0xe2, 0x57, 0xf4, 0x0d, 0xc2, 0xf3, 0xc0, 0x6b, 0x30, 0x52, 0x72,
+	0xf6, 0xa4, 0x65, 0x45, 0x31, 0x54, 0x93, 0x71, 0x1f, 0x4d, 0x9c, 0xdd, 0xdd, 0x99, 0x3e, 0xc3,
+	0xe9, 0xab, 0x38, 0x0b, 0x6c, 0xcb, 0x1d, 0xcb, 0xd0, 0xf4, 0x5c, 0x6a, 0xb8, 0x54, 0xb7, 0x2e,
+	0xfe, 0x15, 0x82, 0xf3, 0xad, 0x98, 0xb9, 0xd6, 0x55, 0x18, 0x26, 0x45, 0xd5, 0xe8, 0x8e, 0x79,
+	0xc8, 0x09, 0xe5, 0xcb, 0xf8, 0x11, 0x82, 0x11, 0x53, 0xcd, 0x6f, 0xa4, 0x33, 0x44, 0x57, 0xd2,
+	0x86, 0x5a, 0x96, 0x0d, 0xc5, 0x0c, 0x87, 0xa2, 0x07, 0xa6, 0x8e, 0xc5, 0x4f, 0x3b, 0x55, 0xac,
+	0x4c, 0x40, 0xb5, 0x7a, 0x8b, 0x6a, 0x36, 0x49, 0x34, 0x3d, 0x71, 0xad, 0x52, 0xbe, 0xaf, 0x7f,
+	0x19, 0xbf, 0x98, 0xd3, 0xac, 0x4d, 0x3b, 0x13, 0xcb, 0x92, 0x02, 0x6f, 0x2a, 0xff, 0x6b, 0xda,
+	0x54, 0xee, 0x4b, 0xd6, 0x76, 0x51, 0x35, 0x9d, 0x18, 0x93, 0x55, 0x7b, 0xa8, 0x42, 0x98, 0x20,
+	0xba, 0x92, 0x62, 0x74, 0x78, 0x0b, 0x20, 0x4b, 0x0a, 0x05, 0xcd, 0x34, 0x35, 0xa2, 0x87, 0x0f,
+	0xb4, 0x41, 0x7e, 0xb9, 0x0b, 0xf2, 0x94, 0x8b, 0x44, 0xdc, 0x86, 0x49, 0x6f, 0xbd, 0x6f, 0xda,
+	0x96, 0x69, 0xc9, 0xba, 0x52, 0xa9, 0x12, 0x4b, 0xab, 0x5f, 0xbd, 0xfe, 0x18, 0xc1, 0x54, 0x6b,
+	0x6e, 0xde, 0xed, 0x7b, 0x70, 0xd8, 0x69, 0x0a, 0x1b, 0xed, 0x6b, 0x81, 0xa3, 0x1d, 0x00, 0xe9,
+	0x9e, 0x77, 0x07, 0x53, 0xdc, 0x82, 0x71, 0x6f, 0x2a, 0xc9, 0x6a, 0x89, 0xfa, 0x25, 0xff, 0x09,
+	0x82, 0x68, 0x73, 0x4e, 0x2e, 0x7b, 0xc3, 0x33, 0x11, 0x4c, 0xf9, 0x5c, 0x7b, 0xca, 0x17, 0xb2,
+	0x59, 0xbb, 0x60, 0xe7, 0x65, 0x4b, 0x55, 0x6a, 0xc0, 0x6e, 0xf1, 0xee, 0x31, 0x78, 0x12, 0x82,
+	0xd3, 0xde, 0x64, 0xee, 0xe4, 0x65, 0x73, 0x53, 0xed, 0x57, 0xf3, 0xf1, 0x24, 0x0c, 0x99, 0x96,
+	0x6c, 0x58, 0x9a, 0x9e, 0x4b, 0x6f, 0xaa, 0x5a, 0x6e, 0xd3, 0x0a, 0x87, 0xa2, 0x68, 0x6a, 0x30,
+	0xf5, 0x3f, 0x67, 0xf9, 0x3a, 0x5d, 0xc5, 0x13, 0x70, 0x42, 0xd5, 0x15, 0xd7, 0xb6, 0x03, 0x74,
+	0xdb, 0x71, 0xb6, 0xc8, 0x37, 0x2d, 0x03, 0xd4, 0xae, 0xfa, 0xf0, 0x20, 0x2d, 0xd3, 0x79, 0xcf,
+	0xc1, 0x61, 0x6f, 0x93, 0xda, 0xcd, 0x97, 0x53, 0xb9, 0xb2, 0x94, 0x2b, 0x72, 0x76, 0xf0, 0xf1,
+	0x97, 0xe3, 0x03, 0xe2, 0xb7, 0x08, 0xce, 0x34, 0x29, 0x06, 0x6f, 0xcb, 0xdb, 0x70, 0xd8, 0x64,
+	0x4b, 0x61, 0x44, 0x4f, 0xe9, 0x4c, 0x7b, 0x3d, 0xa1, 0x38, 0x4b, 0x25, 0x55, 0xb7, 0x3c, 0x53,
+	0xc8, 0xb1, 0xf0, 0x9b, 0x1e, 0x19, 0x21, 0x2a, 0x63, 0xb2, 0xa5, 0x0c, 0x96, 0x93, 0x5b, 0x87,
+	0xf8, 0xd4, 0x51, 0xb0, 0xa8, 0xe6, 0xd5, 0x1c, 0x5d, 0xab, 0x3b, 0xcc, 0x4b, 0x30, 0xa2, 0xb0,
+	0x67, 0x0d, 0xfd, 0x0c, 0xef, 0xee, 0x4c, 0x9f, 0xe4, 0xa4, 0x75, 0x6d, 0xac, 0x86, 0x38, 0x6d,
+	0xf4, 0x1d, 0x8b, 0x50, 0xd7, 0x63, 0x31, 0x7b, 0xa4, 0xd2, 0x80, 0x17, 0x95, 0x26, 0x7c, 0x8a,
+	0x20, 0xd2, 0x4c, 0x02, 0xef, 0x42, 0xd1, 0x7d, 0x27, 0xf4, 0xf3, 0xa2, 0xae, 0x5e, 0x13, 0x36,
+	0x88, 0x75, 0x39, 0xdd, 0x25, 0x96, 0x9c, 0xef, 0x4b, 0x6d, 0x5d, 0xb5, 0xf8, 0x1b, 0xc1, 0x44,
+	0x20, 0x2f, 0x2f, 0xc8, 0xfb, 0xf5, 0x05, 0xb9, 0x1a, 0x38, 0x96, 0x35, 0xb4, 0x45, 0x87, 0x9b,
+	0x21, 0xfa, 0x5d, 0x91, 0x38, 0x0f, 0x07, 0xad, 0x0a, 0x69, 0x9f, 0x5f, 0x8a, 0x8c, 0x44, 0x34,
+	0xf8, 0x85, 0x5c, 0xcd, 0xac, 0x3a, 0x42, 0xfd, 0x2b, 0xf3, 0x2a, 0x44, 0x9b, 0x73, 0xf2, 0x12,
+	0x47, 0x00, 0xaa, 0x43, 0xcb, 0xaa, 0x7c, 0x34, 0xe5, 0x5a, 0x71, 0xa1, 0x95, 0xe1, 0x65, 0x2f,
+	0xda, 0xba, 0x66, 0x6d, 0x2a, 0x86, 0x5c, 0xe6, 0xc4, 0x7d, 0x93, 0x51, 0x82, 0x73, 0x2d, 0x88,
+	0xb9, 0x96, 0x24, 0x0c, 0x97, 0xf9, 0xa3, 0xb6, 0x89, 0x87, 0xca, 0x5e, 0x30, 0x17, 0x6f, 0x1c,
+	0xc6, 0x28, 0x6f, 0xe5, 0x6d, 0x63, 0xeb, 0x9a, 0xb5, 0x7d, 0x8b, 0x90, 0x3c, 0x57, 0x39, 0xfb,
+	0xff, 0xdd, 0x9d, 0xe9, 0xa1, 0x5a, 0xeb, 0xa3, 0x33, 0xb1, 0x2b, 0x33, 0x61, 0x24, 0x7e, 0x81,
+	0x40, 0xf0, 0x0b, 0xe2, 0x19, 0x7e, 0x00, 0x83, 0x45, 0x42, 0xf2, 0x7d, 0x3e, 0xde, 0x94, 0xa3,
+	0x59, 0x86, 0x25, 0x08, 0xd3, 0x04, 0x17, 0x6c, 0x8b, 0x24, 0x49, 0xa1, 0x48, 0x6c, 0x5d, 0xe1,
+	0xa2, 0xfe, 0xab, 0xd6, 0x8d, 0x36, 0x32, 0xc7, 0xc5, 0xb7, 0x60, 0xcc, 0x87, 0x97, 0xd7, 0x25,
+	0x0c, 0x87, 0x55, 0x5d, 0xce, 0xe4, 0x55, 0x85, 0xd2, 0x1d, 0x49, 0x39, 0xff, 0xf4, 0xc5, 0x8a,
+	0x3f, 0x1d, 0x85, 0x83, 0x14, 0x0c, 0x7f, 0x8e, 0xe0, 0x10, 0xb3, 0xfd, 0x58, 0x0a, 0xbc, 0x1b,
+	0x1a, 0xbf, 0x39, 0x84, 0x99, 0xf6, 0x03, 0x58, 0x9a, 0xe2, 0xc5, 0x47, 0x3f, 0xfc, 0xf6, 0x59,
+	0xe8, 0x1c, 0x9e, 0x90, 0x82, 0x3e, 0x91, 0xd8, 0x37, 0x07, 0xfe, 0x03, 0xc1, 0x58, 0x53, 0xd7,
+	0x8f, 0x13, 0xad, 0xc9, 0x5b, 0x7d, 0xac, 0x08, 0xc9, 0x9e, 0x30, 0xb8, 0xa6, 0x24, 0xd5, 0x34,
+	0x8f, 0xe7, 0x02, 0x35, 0xd5, 0x6e, 0x04, 0xe9, 0x41, 0xc3, 0x0b, 0xf2, 0x21, 0xfe, 0x28, 0x04,
+	0xa7, 0x02, 0x2c, 0x2a, 0x5e, 0xec, 0x20, 0xd3, 0xa6, 0x86, 0x5d, 0x58, 0xea, 0x11, 0x85, 0x2b,
+	0x5e, 0xa7, 0x8a, 0x6f, 0xe3, 0x9b, 0x3d, 0x28, 0x96, 0x48, 0x0d, 0xdf, 0xf9, 0xba, 0xc2, 0x7b,
+	0x08, 0x46, 0x7d, 0xcc, 0x2f, 0x7e, 0xad, 0x83, 0xbc, 0x1b, 0x7c, 0xba, 0x30, 0xdf, 0x65, 0x34,
+	0x57, 0xbb, 0x46, 0xd5, 0x5e, 0xc7, 0xcb, 0xbd, 0xa8, 0xad, 0x39, 0x6b, 0xfc, 0x23, 0x82, 0xe1,
+	0x7a, 0x1f, 0x89, 0x5f, 0xed, 0x20, 0x47, 0xaf, 0x11, 0x17, 0x66, 0xbb, 0x09, 0xe5, 0xda, 0x6e,
+	0x50, 0x6d, 0x4b, 0x38, 0xd9, 0x8b, 0x36, 0xc7, 0xac, 0xfe, 0x89, 0x60, 0xa4, 0xc1, 0x9b, 0xe1,
+	0x36, 0xd2, 0x6b, 0xe6, 0x49, 0x85, 0xb9, 0xae, 0x62, 0xb9, 0xb6, 0x34, 0xd5, 0xf6, 0x2e, 0x5e,
+	0x0f, 0xd4, 0x56, 0xbd, 0x7b, 0x4d, 0xe9, 0x41, 0xc3, 0xd5, 0xfd, 0x50, 0xe2, 0x93, 0xe9, 0x7b,
+	0x66, 0x5f, 0x20, 0x78, 0xc9, 0xdf, 0x7f, 0xe1, 0xd7, 0x3b, 0x49, 0xdc, 0xc7, 0x31, 0x0a, 0x6f,
+	0x74, 0x0f, 0xd0, 0x51, 0x6b, 0xdb, 0x93, 0x4f, 0x0f, 0xa6, 0x8f, 0x09, 0x6a, 0xe7, 0x60, 0x36,
+	0xf7, 0x6b, 0xc2, 0x7c, 0x97, 0xd1, 0x1d, 0x1d, 0xcc, 0x16, 0x0a, 0x6b, 0xb3, 0x8d, 0xff, 0x41,
+	0x10, 0x6e, 0x66, 0x91, 0xf0, 0x42, 0x07, 0xb9, 0xfa, 0xfb, 0x3a, 0x21, 0xd1, 0x0b, 0x04, 0xd7,
+	0x7c, 0x97, 0x6a, 0x5e, 0xc3, 0xab, 0xbd, 0x68, 0xae, 0xf7, 0x78, 0xf8, 0x7b, 0x04, 0x27, 0x3c,
+	0x7e, 0x0b, 0x5f, 0x6d, 0x9d, 0xab, 0x9f, 0xab, 0x13, 0x5e, 0xe9, 0x38, 0x8e, 0x0b, 0x5b, 0xf9,
+	0xae, 0xd1, 0x6c, 0x51, 0xad, 0xd3, 0xf8, 0x62, 0xa0, 0xd6, 0xac, 0x03, 0x97, 0xae, 0x98, 0xb6,
+	0xc7, 0x21, 0x84, 0xf7, 0x11, 0x1c, 0x77, 0x9b, 0x24, 0x7c, 0xa5, 0x75, 0x52, 0x3e, 0x66, 0x4e,
+	0xb8, 0xda, 0x69, 0x18, 0x97, 0xa2, 0x34, 0x4a, 0x89, 0x53, 0x29, 0x37, 0xf0, 0x4a, 0x2f, 0x6d,
+	0x93, 0x6d, 0x8b, 0xa4, 0xb3, 0x9c, 0x2d, 0x31, 0xf7, 0x6c, 0x2f, 0x82, 0x9e, 0xef, 0x45, 0xd0,
+	0xaf, 0x7b, 0x11, 0xf4, 0xc9, 0x7e, 0x64, 0xe0, 0xf9, 0x7e, 0x64, 0xe0, 0xa7, 0xfd, 0xc8, 0xc0,
+	0x7b, 0x67, 0x19, 0x87, 0xa9, 0xdc, 0x8f, 0x69, 0x44, 0xfa, 0xd0, 0xcb, 0x45, 0xed, 0x6e, 0xe6,
+	0x10, 0xfd, 0xaf, 0xe4, 0xcb, 0xff, 0x0e, 0x00, 0x0f, 0x38, 0x39, 0xb3, 0x70, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1108,6 +1208,8 @@ type QueryClient interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries whether auto-compounding is enabled for a delegator.
+	AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error)
 }
 
 type queryClient struct {
@@ -1209,6 +1311,15 @@ func (c *queryClient) CommunityPool(ctx context.Context, in *QueryCommunityPoolR
 	return out, nil
 }
 
+func (c *queryClient) AutoCompound(ctx context.Context, in *QueryAutoCompoundRequest, opts ...grpc.CallOption) (*QueryAutoCompoundResponse, error) {
+	out := new(QueryAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Query/AutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the distribution module.
@@ -1234,6 +1345,8 @@ type QueryServer interface {
 	//
 	// Deprecated: Prefer to use x/protocolpool module's CommunityPool rpc method.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
+	// AutoCompound queries whether auto-compounding is enabled for a delegator.
+	AutoCompound(context.Context, *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1270,6 +1383,9 @@ func (*UnimplementedQueryServer) DelegatorWithdrawAddress(ctx context.Context, r
 func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommunityPool not implemented")
 }
+func (*UnimplementedQueryServer) AutoCompound(ctx context.Context, req *QueryAutoCompoundRequest) (*QueryAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoCompound not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1455,6 +1571,24 @@ func _Query_CommunityPool_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoCompoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Query/AutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoCompound(ctx, req.(*QueryAutoCompoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1499,6 +1633,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommunityPool",
 			Handler:    _Query_CommunityPool_Handler,
 		},
+		{
+			MethodName: "AutoCompound",
+			Handler:    _Query_AutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/query.proto",
@@ -2212,6 +2350,69 @@ func (m *QueryCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAutoCompoundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2515,6 +2716,31 @@ func (m *QueryCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryAutoCompoundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4352,6 +4578,158 @@ func (m *QueryCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAutoCompoundRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,12 @@
 package epochs
 
 import (
+	"fmt"
+
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	epochsv1beta1 "cosmossdk.io/api/cosmos/epochs/v1beta1"
+
+	"github.com/cosmos/cosmos-sdk/version"
 )
 
 // AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
@@ -23,5 +27,33 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 			},
 		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: epochsv1beta1.Msg_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "CreateEpoch",
+					Use:            "create-epoch-proposal [identifier] [duration]",
+					Short:          "Submit a proposal to create a new epoch",
+					Example:        fmt.Sprintf(`%s tx epochs create-epoch-proposal fortnight 336h --start-time 2030-01-01T00:00:00Z`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "UpdateEpochDuration",
+					Use:            "update-epoch-duration-proposal [identifier] [duration]",
+					Short:          "Submit a proposal to update the duration of an epoch",
+					Example:        fmt.Sprintf(`%s tx epochs update-epoch-duration-proposal day 12h`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}, {ProtoField: "duration"}},
+					GovProposal:    true,
+				},
+				{
+					RpcMethod:      "RemoveEpoch",
+					Use:            "remove-epoch-proposal [identifier]",
+					Short:          "Submit a proposal to remove an epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "identifier"}},
+					GovProposal:    true,
+				},
+			},
+		},
 	}
 }
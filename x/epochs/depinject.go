@@ -7,9 +7,11 @@ import (
 	"golang.org/x/exp/maps"
 
 	modulev1 "cosmossdk.io/api/cosmos/epochs/module/v1"
+	"cosmossdk.io/core/address"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
 	"cosmossdk.io/depinject/appconfig"
+	authtypes "cosmossdk.io/x/auth/types"
 	"cosmossdk.io/x/epochs/keeper"
 	"cosmossdk.io/x/epochs/types"
 
@@ -34,23 +36,36 @@ type ModuleInputs struct {
 	Config      *modulev1.Module
 	Cdc         codec.Codec
 	Environment appmodule.Environment
+
+	AddressCodec address.Codec
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	EpochKeeper keeper.Keeper
+	EpochKeeper *keeper.Keeper
 	Module      appmodule.AppModule
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
-	k := keeper.NewKeeper(in.Environment, in.Cdc)
-	m := NewAppModule(in.Cdc, k)
-	return ModuleOutputs{EpochKeeper: k, Module: m}
+	// default to governance authority if not provided
+	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	if in.Config.Authority != "" {
+		authority = authtypes.NewModuleAddressOrBech32Address(in.Config.Authority)
+	}
+
+	as, err := in.AddressCodec.BytesToString(authority)
+	if err != nil {
+		panic(err)
+	}
+
+	k := keeper.NewKeeper(in.Environment, in.Cdc, as)
+	m := NewAppModule(in.Cdc, &k)
+	return ModuleOutputs{EpochKeeper: &k, Module: m}
 }
 
-func InvokeSetHooks(keeper keeper.Keeper, hooks map[string]types.EpochHooksWrapper) error {
-	if hooks == nil {
+func InvokeSetHooks(keeper *keeper.Keeper, hooks map[string]types.EpochHooksWrapper) error {
+	if len(hooks) == 0 {
 		return nil
 	}
 
@@ -69,6 +84,6 @@ func InvokeSetHooks(keeper keeper.Keeper, hooks map[string]types.EpochHooksWrapp
 		multiHooks = append(multiHooks, hook)
 	}
 
-	keeper.SetHooks(multiHooks)
+	*keeper = keeper.SetHooks(multiHooks)
 	return nil
 }
@@ -23,12 +23,15 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 )
 
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
 type KeeperTestSuite struct {
 	suite.Suite
 	Ctx          sdk.Context
 	environment  appmodule.Environment
 	EpochsKeeper epochskeeper.Keeper
 	queryClient  types.QueryClient
+	msgServer    types.MsgServer
 }
 
 func (s *KeeperTestSuite) SetupTest() {
@@ -47,6 +50,7 @@ func (s *KeeperTestSuite) SetupTest() {
 	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{})
 	grpcQueryService.SetInterfaceRegistry(encCfg.InterfaceRegistry)
 	s.queryClient = types.NewQueryClient(grpcQueryService)
+	s.msgServer = epochskeeper.NewMsgServerImpl(s.EpochsKeeper)
 }
 
 func Setup(t *testing.T) (sdk.Context, epochskeeper.Keeper, appmodule.Environment) {
@@ -62,6 +66,7 @@ func Setup(t *testing.T) (sdk.Context, epochskeeper.Keeper, appmodule.Environmen
 	epochsKeeper := epochskeeper.NewKeeper(
 		environment,
 		encCfg.Codec,
+		govModuleNameStr,
 	)
 	epochsKeeper = epochsKeeper.SetHooks(types.NewMultiEpochHooks())
 	ctx.WithHeaderInfo(header.Info{Height: 1, Time: time.Now().UTC(), ChainID: "epochs"})
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/epochs/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+// msgServer is a wrapper of Keeper.
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/epochs MsgServer interface.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return &msgServer{
+		Keeper: k,
+	}
+}
+
+// CreateEpoch adds a new epoch.
+func (ms msgServer) CreateEpoch(ctx context.Context, msg *types.MsgCreateEpoch) (*types.MsgCreateEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if err := types.ValidateEpochIdentifierString(msg.Identifier); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEpoch, err.Error())
+	}
+	if msg.Duration <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpoch, "epoch duration must be positive: %s", msg.Duration)
+	}
+
+	// an epoch starting in the past would tick once per block until it caught up.
+	blockTime := ms.HeaderService.HeaderInfo(ctx).Time
+	startTime := msg.StartTime
+	if startTime.IsZero() {
+		startTime = blockTime
+	}
+	if startTime.Before(blockTime) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpoch, "epoch start time %s is before the block time %s", startTime, blockTime)
+	}
+
+	exists, err := ms.EpochInfo.Has(ctx, msg.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errorsmod.Wrapf(types.ErrEpochExists, "identifier %s", msg.Identifier)
+	}
+
+	epoch := types.NewGenesisEpochInfo(msg.Identifier, msg.Duration)
+	epoch.StartTime = startTime
+	if err := ms.AddEpochInfo(ctx, epoch); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).Emit(&types.EventEpochCreated{
+		Identifier: msg.Identifier,
+		StartTime:  startTime.Unix(),
+		Duration:   int64(msg.Duration),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateEpochResponse{}, nil
+}
+
+// UpdateEpochDuration updates the duration of an existing epoch.
+// The new duration applies to the epoch in progress. When the epoch in progress
+// has already lasted longer than the new duration, its CurrentEpochStartTime is
+// rewritten to the block time minus the new duration: it ends in the next block and
+// the following epochs are scheduled from the current block time, instead of
+// ticking once per block until the missed epochs are caught up.
+func (ms msgServer) UpdateEpochDuration(ctx context.Context, msg *types.MsgUpdateEpochDuration) (*types.MsgUpdateEpochDurationResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	if msg.Duration <= 0 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEpoch, "epoch duration must be positive: %s", msg.Duration)
+	}
+
+	epoch, err := ms.getEpochInfo(ctx, msg.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	oldDuration := epoch.Duration
+	epoch.Duration = msg.Duration
+
+	blockTime := ms.HeaderService.HeaderInfo(ctx).Time
+	if epoch.EpochCountingStarted && epoch.CurrentEpochStartTime.Add(epoch.Duration).Before(blockTime) {
+		epoch.CurrentEpochStartTime = blockTime.Add(-epoch.Duration)
+	}
+
+	if err := ms.EpochInfo.Set(ctx, epoch.Identifier, epoch); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).Emit(&types.EventEpochDurationUpdated{
+		Identifier:  msg.Identifier,
+		OldDuration: int64(oldDuration),
+		NewDuration: int64(msg.Duration),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateEpochDurationResponse{}, nil
+}
+
+// RemoveEpoch removes an existing epoch.
+// The epoch in progress, if any, is abandoned: no AfterEpochEnd hook is called for it.
+func (ms msgServer) RemoveEpoch(ctx context.Context, msg *types.MsgRemoveEpoch) (*types.MsgRemoveEpochResponse, error) {
+	if ms.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, msg.Authority)
+	}
+
+	epoch, err := ms.getEpochInfo(ctx, msg.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.EpochInfo.Remove(ctx, epoch.Identifier); err != nil {
+		return nil, err
+	}
+
+	if err := ms.EventService.EventManager(ctx).Emit(&types.EventEpochRemoved{
+		Identifier:   msg.Identifier,
+		CurrentEpoch: epoch.CurrentEpoch,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveEpochResponse{}, nil
+}
+
+func (ms msgServer) getEpochInfo(ctx context.Context, identifier string) (types.EpochInfo, error) {
+	epoch, err := ms.EpochInfo.Get(ctx, identifier)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.EpochInfo{}, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", identifier)
+	}
+
+	return epoch, err
+}
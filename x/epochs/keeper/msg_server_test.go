@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/x/epochs/types"
+)
+
+func (s *KeeperTestSuite) TestMsgCreateEpoch() {
+	blockTime := s.Ctx.HeaderInfo().Time
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgCreateEpoch
+		expErr   error
+		expStart time.Time
+	}{
+		{
+			name:   "invalid authority",
+			msg:    &types.MsgCreateEpoch{Authority: "foo", Identifier: "fortnight", Duration: 14 * 24 * time.Hour},
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "empty identifier",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Duration: time.Hour},
+			expErr: types.ErrInvalidEpoch,
+		},
+		{
+			name:   "zero duration",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "fortnight"},
+			expErr: types.ErrInvalidEpoch,
+		},
+		{
+			name:   "start time in the past",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "fortnight", StartTime: blockTime.Add(-time.Second), Duration: time.Hour},
+			expErr: types.ErrInvalidEpoch,
+		},
+		{
+			name:   "existing identifier",
+			msg:    &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "day", Duration: time.Hour},
+			expErr: types.ErrEpochExists,
+		},
+		{
+			name:     "start time defaults to the block time",
+			msg:      &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "fortnight", Duration: 14 * 24 * time.Hour},
+			expStart: blockTime,
+		},
+		{
+			name:     "start time in the future",
+			msg:      &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "minute", StartTime: blockTime.Add(time.Hour), Duration: time.Minute},
+			expStart: blockTime.Add(time.Hour),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.CreateEpoch(s.Ctx, tc.msg)
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			epoch, err := s.EpochsKeeper.EpochInfo.Get(s.Ctx, tc.msg.Identifier)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.msg.Duration, epoch.Duration)
+			s.Require().Equal(tc.expStart, epoch.StartTime)
+			s.Require().False(epoch.EpochCountingStarted)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgCreateEpochStartsCounting() {
+	_, err := s.msgServer.CreateEpoch(s.Ctx, &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "minute", Duration: time.Minute})
+	s.Require().NoError(err)
+
+	ctx := s.Ctx.WithHeaderInfo(header.Info{Height: 2, Time: s.Ctx.HeaderInfo().Time.Add(time.Second)})
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+
+	epoch, err := s.EpochsKeeper.EpochInfo.Get(ctx, "minute")
+	s.Require().NoError(err)
+	s.Require().True(epoch.EpochCountingStarted)
+	s.Require().Equal(int64(1), epoch.CurrentEpoch)
+}
+
+func (s *KeeperTestSuite) TestMsgUpdateEpochDuration() {
+	_, err := s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: "foo", Identifier: "day", Duration: time.Hour})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "unknown", Duration: time.Hour})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	_, err = s.msgServer.UpdateEpochDuration(s.Ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "day", Duration: -time.Hour})
+	s.Require().ErrorIs(err, types.ErrInvalidEpoch)
+
+	// start the epochs
+	startTime := s.Ctx.HeaderInfo().Time
+	ctx := s.Ctx.WithHeaderInfo(header.Info{Height: 2, Time: startTime.Add(time.Second)})
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+
+	// lengthening the duration delays the end of the epoch in progress
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 3, Time: startTime.Add(20 * time.Hour)})
+	_, err = s.msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "day", Duration: 48 * time.Hour})
+	s.Require().NoError(err)
+
+	epoch, err := s.EpochsKeeper.EpochInfo.Get(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(48*time.Hour, epoch.Duration)
+	s.Require().Equal(startTime, epoch.CurrentEpochStartTime)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 4, Time: startTime.Add(25 * time.Hour)})
+	s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	epoch, err = s.EpochsKeeper.EpochInfo.Get(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), epoch.CurrentEpoch)
+
+	// shortening the duration below the time elapsed in the epoch in progress ends
+	// it in the next block, with a single tick.
+	now := startTime.Add(30 * time.Hour)
+	ctx = ctx.WithHeaderInfo(header.Info{Height: 5, Time: now})
+	_, err = s.msgServer.UpdateEpochDuration(ctx, &types.MsgUpdateEpochDuration{Authority: govModuleNameStr, Identifier: "day", Duration: time.Hour})
+	s.Require().NoError(err)
+
+	for height := int64(6); height < 10; height++ {
+		ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: now.Add(time.Duration(height-5) * time.Second)})
+		s.Require().NoError(s.EpochsKeeper.BeginBlocker(ctx))
+	}
+
+	epoch, err = s.EpochsKeeper.EpochInfo.Get(ctx, "day")
+	s.Require().NoError(err)
+	s.Require().Equal(int64(2), epoch.CurrentEpoch)
+	s.Require().Equal(now, epoch.CurrentEpochStartTime)
+	s.Require().Equal(int64(6), epoch.CurrentEpochStartHeight)
+}
+
+func (s *KeeperTestSuite) TestMsgRemoveEpoch() {
+	_, err := s.msgServer.RemoveEpoch(s.Ctx, &types.MsgRemoveEpoch{Authority: "foo", Identifier: "day"})
+	s.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	_, err = s.msgServer.RemoveEpoch(s.Ctx, &types.MsgRemoveEpoch{Authority: govModuleNameStr, Identifier: "unknown"})
+	s.Require().ErrorIs(err, types.ErrEpochNotFound)
+
+	_, err = s.msgServer.RemoveEpoch(s.Ctx, &types.MsgRemoveEpoch{Authority: govModuleNameStr, Identifier: "day"})
+	s.Require().NoError(err)
+
+	has, err := s.EpochsKeeper.EpochInfo.Has(s.Ctx, "day")
+	s.Require().NoError(err)
+	s.Require().False(has)
+
+	// the identifier can be reused
+	_, err = s.msgServer.CreateEpoch(s.Ctx, &types.MsgCreateEpoch{Authority: govModuleNameStr, Identifier: "day", Duration: 12 * time.Hour})
+	s.Require().NoError(err)
+}
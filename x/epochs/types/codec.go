@@ -0,0 +1,29 @@
+package types
+
+import (
+	corelegacy "cosmossdk.io/core/legacy"
+	"cosmossdk.io/core/registry"
+	coretransaction "cosmossdk.io/core/transaction"
+
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers concrete types on the LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
+	legacy.RegisterAminoMsg(cdc, &MsgCreateEpoch{}, "cosmos-sdk/MsgCreateEpoch")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateEpochDuration{}, "cosmos-sdk/MsgUpdateEpochDuration")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveEpoch{}, "cosmos-sdk/MsgRemoveEpoch")
+}
+
+// RegisterInterfaces registers the interfaces types with the interface registry.
+func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
+	registrar.RegisterImplementations(
+		(*coretransaction.Msg)(nil),
+		&MsgCreateEpoch{},
+		&MsgUpdateEpochDuration{},
+		&MsgRemoveEpoch{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
+}
@@ -0,0 +1,10 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var (
+	ErrInvalidSigner = errors.Register(ModuleName, 2, "expected authority account as only signer for proposal message")
+	ErrEpochNotFound = errors.Register(ModuleName, 3, "epoch not found")
+	ErrEpochExists   = errors.Register(ModuleName, 4, "epoch already exists")
+	ErrInvalidEpoch  = errors.Register(ModuleName, 5, "invalid epoch")
+)
@@ -121,9 +121,187 @@ func (m *EventEpochStart) GetEpochStartTime() int64 {
 	return 0
 }
 
+// EventEpochCreated is an event emitted when an epoch is created through governance.
+type EventEpochCreated struct {
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	StartTime  int64  `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Duration   int64  `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (m *EventEpochCreated) Reset()         { *m = EventEpochCreated{} }
+func (m *EventEpochCreated) String() string { return proto.CompactTextString(m) }
+func (*EventEpochCreated) ProtoMessage()    {}
+func (*EventEpochCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{2}
+}
+func (m *EventEpochCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochCreated.Merge(m, src)
+}
+func (m *EventEpochCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochCreated proto.InternalMessageInfo
+
+func (m *EventEpochCreated) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EventEpochCreated) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *EventEpochCreated) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// EventEpochDurationUpdated is an event emitted when the duration of an epoch is updated.
+type EventEpochDurationUpdated struct {
+	Identifier  string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	OldDuration int64  `protobuf:"varint,2,opt,name=old_duration,json=oldDuration,proto3" json:"old_duration,omitempty"`
+	NewDuration int64  `protobuf:"varint,3,opt,name=new_duration,json=newDuration,proto3" json:"new_duration,omitempty"`
+}
+
+func (m *EventEpochDurationUpdated) Reset()         { *m = EventEpochDurationUpdated{} }
+func (m *EventEpochDurationUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventEpochDurationUpdated) ProtoMessage()    {}
+func (*EventEpochDurationUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{3}
+}
+func (m *EventEpochDurationUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochDurationUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochDurationUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochDurationUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochDurationUpdated.Merge(m, src)
+}
+func (m *EventEpochDurationUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochDurationUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochDurationUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochDurationUpdated proto.InternalMessageInfo
+
+func (m *EventEpochDurationUpdated) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EventEpochDurationUpdated) GetOldDuration() int64 {
+	if m != nil {
+		return m.OldDuration
+	}
+	return 0
+}
+
+func (m *EventEpochDurationUpdated) GetNewDuration() int64 {
+	if m != nil {
+		return m.NewDuration
+	}
+	return 0
+}
+
+// EventEpochRemoved is an event emitted when an epoch is removed.
+type EventEpochRemoved struct {
+	Identifier   string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	CurrentEpoch int64  `protobuf:"varint,2,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+}
+
+func (m *EventEpochRemoved) Reset()         { *m = EventEpochRemoved{} }
+func (m *EventEpochRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventEpochRemoved) ProtoMessage()    {}
+func (*EventEpochRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_691f9b4b0a500cb4, []int{4}
+}
+func (m *EventEpochRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochRemoved.Merge(m, src)
+}
+func (m *EventEpochRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochRemoved proto.InternalMessageInfo
+
+func (m *EventEpochRemoved) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *EventEpochRemoved) GetCurrentEpoch() int64 {
+	if m != nil {
+		return m.CurrentEpoch
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventEpochEnd)(nil), "cosmos.epochs.v1beta1.EventEpochEnd")
 	proto.RegisterType((*EventEpochStart)(nil), "cosmos.epochs.v1beta1.EventEpochStart")
+	proto.RegisterType((*EventEpochCreated)(nil), "cosmos.epochs.v1beta1.EventEpochCreated")
+	proto.RegisterType((*EventEpochDurationUpdated)(nil), "cosmos.epochs.v1beta1.EventEpochDurationUpdated")
+	proto.RegisterType((*EventEpochRemoved)(nil), "cosmos.epochs.v1beta1.EventEpochRemoved")
 }
 
 func init() {
@@ -131,22 +309,29 @@ func init() {
 }
 
 var fileDescriptor_691f9b4b0a500cb4 = []byte{
-	// 225 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2d, 0xc8, 0x4f, 0xce, 0x28, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49,
-	0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x85, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x83, 0xaa, 0x91, 0x92, 0x84, 0x08, 0xc7, 0x83, 0x15, 0xe9,
-	0x43, 0xd5, 0x80, 0x39, 0x52, 0xca, 0xd8, 0x4d, 0x4d, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x84, 0x2a,
-	0x52, 0x32, 0xe2, 0xe2, 0x75, 0x05, 0x59, 0xe3, 0x0a, 0x52, 0xe4, 0x9a, 0x97, 0x22, 0xa4, 0xc8,
-	0xc5, 0x03, 0xd6, 0x10, 0x9f, 0x57, 0x9a, 0x9b, 0x94, 0x5a, 0x24, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1,
-	0x1c, 0xc4, 0x0d, 0x16, 0xf3, 0x03, 0x0b, 0x29, 0xc5, 0x71, 0xf1, 0x23, 0xf4, 0x04, 0x97, 0x24,
-	0x16, 0x95, 0x10, 0xa1, 0x4b, 0x48, 0x83, 0x4b, 0x00, 0xa2, 0xa4, 0x18, 0xa4, 0x23, 0xbe, 0x24,
-	0x33, 0x37, 0x55, 0x82, 0x09, 0xac, 0x8c, 0x2f, 0x15, 0x6e, 0x50, 0x48, 0x66, 0x6e, 0xaa, 0x93,
-	0xe9, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x49, 0x43, 0xbc, 0x54, 0x9c,
-	0x92, 0xad, 0x97, 0x99, 0xaf, 0x5f, 0x01, 0xf3, 0x5a, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0xd8, 0x47, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x88, 0x5e, 0xe4, 0x4e, 0x01, 0x00,
-	0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0xe9, 0x47, 0xf2, 0x45, 0x0e, 0xe0, 0x4f, 0x13, 0x13, 0xc0, 0x38, 0x91, 0xb2, 0x61,
+	0xd5, 0x06, 0x8d, 0x37, 0xa0, 0xb2, 0x75, 0x81, 0x9a, 0x18, 0x17, 0x36, 0x85, 0x39, 0xea, 0x44,
+	0x3a, 0xd3, 0xcc, 0x0c, 0xa0, 0x4b, 0xef, 0xc0, 0xcb, 0x72, 0xc9, 0xd2, 0xa5, 0x81, 0x1b, 0x31,
+	0x9d, 0x4e, 0x81, 0xa8, 0x8b, 0x2e, 0xcf, 0xdb, 0xe7, 0x3c, 0x6f, 0xdb, 0x1c, 0xf0, 0x46, 0x42,
+	0xc5, 0x42, 0x05, 0x98, 0x88, 0xd1, 0x93, 0x0a, 0xa6, 0xbd, 0x21, 0xea, 0xa8, 0x17, 0xe0, 0x14,
+	0xb9, 0x56, 0x7e, 0x22, 0x85, 0x16, 0xee, 0x7e, 0xc6, 0xf8, 0x19, 0xe3, 0x5b, 0xa6, 0xd5, 0xcc,
+	0xe2, 0xd0, 0x40, 0x81, 0x65, 0xcc, 0xd0, 0xea, 0xfc, 0x6d, 0x7d, 0x44, 0x8e, 0x8a, 0x59, 0xc8,
+	0x3b, 0x86, 0x7a, 0x3f, 0xad, 0xe9, 0xa7, 0x50, 0x9f, 0x53, 0xb7, 0x0d, 0x35, 0xb3, 0x10, 0xf2,
+	0x49, 0x3c, 0x44, 0xd9, 0x70, 0x8e, 0x9c, 0x6e, 0x79, 0x50, 0x35, 0xd9, 0xa5, 0x89, 0xbc, 0x7b,
+	0xd8, 0x59, 0xef, 0x5c, 0xe9, 0x48, 0xea, 0x02, 0x5b, 0x6e, 0x17, 0x76, 0x33, 0x44, 0xa5, 0x1b,
+	0xa1, 0x66, 0x31, 0x36, 0xfe, 0x19, 0x6c, 0x1b, 0x57, 0xa2, 0x6b, 0x16, 0xa3, 0xc7, 0x61, 0x6f,
+	0xed, 0x3f, 0x97, 0x18, 0x69, 0xa4, 0x2e, 0x01, 0x60, 0x14, 0xb9, 0x66, 0x0f, 0xcc, 0xfa, 0x2b,
+	0x83, 0x8d, 0xc4, 0x3d, 0x04, 0xf8, 0x25, 0xae, 0xa8, 0xdc, 0xe9, 0xb6, 0x60, 0x8b, 0x4e, 0x64,
+	0xa4, 0x99, 0xe0, 0x8d, 0xb2, 0x79, 0xb8, 0x9a, 0xbd, 0x37, 0x07, 0x9a, 0xeb, 0xc2, 0x0b, 0x1b,
+	0xdf, 0x24, 0xb4, 0x50, 0x71, 0x1b, 0x6a, 0x62, 0x4c, 0xc3, 0x95, 0x3d, 0xab, 0xae, 0x8a, 0x31,
+	0xcd, 0x4d, 0x29, 0xc2, 0x71, 0x16, 0xfe, 0x78, 0x81, 0x2a, 0xc7, 0x59, 0x8e, 0x78, 0xb7, 0x9b,
+	0xdf, 0x3c, 0xc0, 0x58, 0x4c, 0x0b, 0x54, 0x77, 0xa0, 0x3e, 0x9a, 0x48, 0x89, 0x5c, 0x87, 0xe6,
+	0x17, 0xda, 0xee, 0x9a, 0x0d, 0x8d, 0xeb, 0xec, 0xf4, 0x63, 0x41, 0x9c, 0xf9, 0x82, 0x38, 0x5f,
+	0x0b, 0xe2, 0xbc, 0x2f, 0x49, 0x69, 0xbe, 0x24, 0xa5, 0xcf, 0x25, 0x29, 0xdd, 0x1d, 0x64, 0x07,
+	0xa2, 0xe8, 0xb3, 0xcf, 0x44, 0xf0, 0x92, 0x1f, 0x8a, 0x7e, 0x4d, 0x50, 0x0d, 0xff, 0x9b, 0xfb,
+	0x38, 0xf9, 0x1e, 0x00, 0x19, 0x73, 0x7e, 0x6b, 0x9c, 0x02, 0x00, 0x00,
 }
 
 func (m *EventEpochEnd) Marshal() (dAtA []byte, err error) {
@@ -210,6 +395,121 @@ func (m *EventEpochStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEpochCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochDurationUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochDurationUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochDurationUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OldDuration != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -248,6 +548,60 @@ func (m *EventEpochStart) Size() (n int) {
 	return n
 }
 
+func (m *EventEpochCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovEvents(uint64(m.StartTime))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovEvents(uint64(m.Duration))
+	}
+	return n
+}
+
+func (m *EventEpochDurationUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OldDuration != 0 {
+		n += 1 + sovEvents(uint64(m.OldDuration))
+	}
+	if m.NewDuration != 0 {
+		n += 1 + sovEvents(uint64(m.NewDuration))
+	}
+	return n
+}
+
+func (m *EventEpochRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentEpoch))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -411,6 +765,347 @@ func (m *EventEpochStart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventEpochCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochDurationUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochDurationUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochDurationUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldDuration", wireType)
+			}
+			m.OldDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDuration", wireType)
+			}
+			m.NewDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
+			}
+			m.CurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
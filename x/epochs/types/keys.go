@@ -10,6 +10,9 @@ const (
 
 	// StoreKey defines the primary module store key.
 	StoreKey = ModuleName
+
+	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
+	GovModuleName = "gov"
 )
 
 // KeyPrefixEpoch defines prefix key for storing epochs.
@@ -3,6 +3,7 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -24,6 +25,8 @@ const (
 	FlagPeriodLimit = "period-limit"
 	FlagSpendLimit  = "spend-limit"
 	FlagAllowedMsgs = "allowed-messages"
+	FlagGasLimits   = "gas-limits"
+	FlagMaxGrantees = "max-grantees"
 )
 
 // GetTxCmd returns the transaction commands for feegrant module
@@ -60,8 +63,11 @@ Examples:
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 3600 --period-limit 10stake --expiration 2022-01-30T15:04:05Z or
 %s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --expiration 2022-01-30T15:04:05Z 
-	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote"
+	--allowed-messages "/cosmos.gov.v1beta1.MsgSubmitProposal,/cosmos.gov.v1beta1.MsgVote" or
+%s tx %s grant cosmos1skjw... cosmos1skjw... --spend-limit 100stake --period 86400 --max-grantees 100
+	--gas-limits "/cosmos.gov.v1.MsgVote=2000000,/cosmos.gov.v1.MsgDeposit=500000"
 				`, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName, version.AppName, feegrant.ModuleName,
+				version.AppName, feegrant.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -128,9 +134,45 @@ Examples:
 				return err
 			}
 
-			// check any of period or periodLimit flags are set,
-			// if set consider it as periodic fee allowance.
-			if periodClock > 0 || periodLimitVal != "" {
+			gasLimitsVal, err := cmd.Flags().GetStringSlice(FlagGasLimits)
+			if err != nil {
+				return err
+			}
+
+			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
+			if err != nil {
+				return err
+			}
+
+			// check if gasLimits flag is set, if set consider it as gas fee allowance.
+			if len(gasLimitsVal) > 0 {
+				if periodLimitVal != "" || len(allowedMsgs) > 0 {
+					return fmt.Errorf("--%s cannot be used with --%s or --%s", FlagGasLimits, FlagPeriodLimit, FlagAllowedMsgs)
+				}
+
+				if periodClock <= 0 {
+					return errors.New("period clock was not set")
+				}
+
+				msgGasLimits, err := parseMsgGasLimits(gasLimitsVal)
+				if err != nil {
+					return err
+				}
+
+				maxGrantees, err := cmd.Flags().GetUint64(FlagMaxGrantees)
+				if err != nil {
+					return err
+				}
+
+				grant = &feegrant.GasAllowance{
+					Basic:        basic,
+					Period:       getPeriod(periodClock),
+					MsgGasLimits: msgGasLimits,
+					MaxGrantees:  maxGrantees,
+				}
+			} else if periodClock > 0 || periodLimitVal != "" {
+				// check any of period or periodLimit flags are set,
+				// if set consider it as periodic fee allowance.
 				periodLimit, err := sdk.ParseCoinsNormalized(periodLimitVal)
 				if err != nil {
 					return err
@@ -159,11 +201,6 @@ Examples:
 				grant = &periodic
 			}
 
-			allowedMsgs, err := cmd.Flags().GetStringSlice(FlagAllowedMsgs)
-			if err != nil {
-				return err
-			}
-
 			if len(allowedMsgs) > 0 {
 				grant, err = feegrant.NewAllowedMsgAllowance(grant, allowedMsgs)
 				if err != nil {
@@ -186,10 +223,36 @@ Examples:
 	cmd.Flags().String(FlagSpendLimit, "", "Spend limit specifies the max limit can be used, if not mentioned there is no limit")
 	cmd.Flags().Int64(FlagPeriod, 0, "period specifies the time duration(in seconds) in which period_limit coins can be spent before that allowance is reset (ex: 3600)")
 	cmd.Flags().String(FlagPeriodLimit, "", "period limit specifies the maximum number of coins that can be spent in the period")
+	cmd.Flags().StringSlice(FlagGasLimits, []string{}, "Gas limits per period of the allowed messages, as msg_type_url=gas (ex: /cosmos.gov.v1.MsgVote=2000000)")
+	cmd.Flags().Uint64(FlagMaxGrantees, 0, "max grantees specifies the maximum number of grantees the granter can sponsor with gas allowances, 0 means no cap")
 
 	return cmd
 }
 
+// parseMsgGasLimits parses gas limits given as msg_type_url=gas.
+func parseMsgGasLimits(gasLimits []string) ([]feegrant.MsgGasLimit, error) {
+	msgGasLimits := make([]feegrant.MsgGasLimit, 0, len(gasLimits))
+	for _, gasLimit := range gasLimits {
+		typeURL, gasVal, ok := strings.Cut(gasLimit, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid gas limit %s, expected msg_type_url=gas", gasLimit)
+		}
+
+		gas, err := strconv.ParseUint(gasVal, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas of %s: %w", typeURL, err)
+		}
+
+		msgGasLimits = append(msgGasLimits, feegrant.MsgGasLimit{
+			MsgTypeUrl:     typeURL,
+			PeriodGasLimit: gas,
+			PeriodGasLeft:  gas,
+		})
+	}
+
+	return msgGasLimits, nil
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -19,6 +19,7 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	cdc.RegisterConcrete(&BasicAllowance{}, "cosmos-sdk/BasicAllowance")
 	cdc.RegisterConcrete(&PeriodicAllowance{}, "cosmos-sdk/PeriodicAllowance")
 	cdc.RegisterConcrete(&AllowedMsgAllowance{}, "cosmos-sdk/AllowedMsgAllowance")
+	cdc.RegisterConcrete(&GasAllowance{}, "cosmos-sdk/GasAllowance")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry
@@ -34,6 +35,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&BasicAllowance{},
 		&PeriodicAllowance{},
 		&AllowedMsgAllowance{},
+		&GasAllowance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -20,4 +20,8 @@ var (
 	ErrNoMessages = errors.Register(DefaultCodespace, 6, "allowed messages are empty")
 	// ErrMessageNotAllowed error if message is not allowed
 	ErrMessageNotAllowed = errors.Register(DefaultCodespace, 7, "message not allowed")
+	// ErrGasLimitExceeded error if there is not enough gas budget left to cover the transaction gas
+	ErrGasLimitExceeded = errors.Register(DefaultCodespace, 8, "gas limit exceeded")
+	// ErrGranteeLimitExceeded error if the granter already sponsors the maximum number of grantees
+	ErrGranteeLimitExceeded = errors.Register(DefaultCodespace, 9, "grantee limit exceeded")
 )
@@ -219,6 +219,218 @@ func (m *AllowedMsgAllowance) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_AllowedMsgAllowance proto.InternalMessageInfo
 
+// MsgGasLimit defines the gas budget of a message type in a GasAllowance.
+type MsgGasLimit struct {
+	// msg_type_url is the type url of the message, e.g. "/cosmos.gov.v1.MsgVote".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// period_gas_limit is the maximum amount of gas that can be consumed by the
+	// transactions containing this message type in the period.
+	PeriodGasLimit uint64 `protobuf:"varint,2,opt,name=period_gas_limit,json=periodGasLimit,proto3" json:"period_gas_limit,omitempty"`
+	// period_gas_left is the amount of gas left to be consumed before the period_reset time.
+	PeriodGasLeft uint64 `protobuf:"varint,3,opt,name=period_gas_left,json=periodGasLeft,proto3" json:"period_gas_left,omitempty"`
+}
+
+func (m *MsgGasLimit) Reset()         { *m = MsgGasLimit{} }
+func (m *MsgGasLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgGasLimit) ProtoMessage()    {}
+func (*MsgGasLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{3}
+}
+func (m *MsgGasLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGasLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGasLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGasLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGasLimit.Merge(m, src)
+}
+func (m *MsgGasLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGasLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGasLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGasLimit proto.InternalMessageInfo
+
+func (m *MsgGasLimit) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgGasLimit) GetPeriodGasLimit() uint64 {
+	if m != nil {
+		return m.PeriodGasLimit
+	}
+	return 0
+}
+
+func (m *MsgGasLimit) GetPeriodGasLeft() uint64 {
+	if m != nil {
+		return m.PeriodGasLeft
+	}
+	return 0
+}
+
+// GasAllowance extends Allowance to allow for gas budgets per message type and
+// per time period. Only the transactions made of the message types of the
+// allowance can use it.
+type GasAllowance struct {
+	// basic specifies a struct of `BasicAllowance`
+	Basic BasicAllowance `protobuf:"bytes,1,opt,name=basic,proto3" json:"basic"`
+	// period specifies the time duration in which the gas budgets can be consumed
+	// before they are reset
+	Period time.Duration `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+	// msg_gas_limits are the gas budgets of the allowed message types.
+	MsgGasLimits []MsgGasLimit `protobuf:"bytes,3,rep,name=msg_gas_limits,json=msgGasLimits,proto3" json:"msg_gas_limits"`
+	// period_reset is the time at which this period resets and a new one begins,
+	// it is calculated from the start time of the first transaction after the
+	// last period ended
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+	// max_grantees is the maximum number of distinct grantees the granter can
+	// sponsor with gas allowances, including this one. Zero means no cap.
+	// The first non zero max_grantees of a granter is stored as the granter cap,
+	// which later gas allowances can lower but neither raise nor remove. The cap
+	// is removed along with the last gas allowance of the granter.
+	MaxGrantees uint64 `protobuf:"varint,5,opt,name=max_grantees,json=maxGrantees,proto3" json:"max_grantees,omitempty"`
+}
+
+func (m *GasAllowance) Reset()         { *m = GasAllowance{} }
+func (m *GasAllowance) String() string { return proto.CompactTextString(m) }
+func (*GasAllowance) ProtoMessage()    {}
+func (*GasAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{4}
+}
+func (m *GasAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasAllowance.Merge(m, src)
+}
+func (m *GasAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasAllowance proto.InternalMessageInfo
+
+func (m *GasAllowance) GetBasic() BasicAllowance {
+	if m != nil {
+		return m.Basic
+	}
+	return BasicAllowance{}
+}
+
+func (m *GasAllowance) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *GasAllowance) GetMsgGasLimits() []MsgGasLimit {
+	if m != nil {
+		return m.MsgGasLimits
+	}
+	return nil
+}
+
+func (m *GasAllowance) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func (m *GasAllowance) GetMaxGrantees() uint64 {
+	if m != nil {
+		return m.MaxGrantees
+	}
+	return 0
+}
+
+// GranterMaxGrantees is the maximum number of distinct grantees a granter can
+// sponsor with gas allowances.
+type GranterMaxGrantees struct {
+	// granter is the address of the granter.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// max_grantees is the cap of the granter.
+	MaxGrantees uint64 `protobuf:"varint,2,opt,name=max_grantees,json=maxGrantees,proto3" json:"max_grantees,omitempty"`
+}
+
+func (m *GranterMaxGrantees) Reset()         { *m = GranterMaxGrantees{} }
+func (m *GranterMaxGrantees) String() string { return proto.CompactTextString(m) }
+func (*GranterMaxGrantees) ProtoMessage()    {}
+func (*GranterMaxGrantees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7279582900c30aea, []int{5}
+}
+func (m *GranterMaxGrantees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GranterMaxGrantees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GranterMaxGrantees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GranterMaxGrantees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GranterMaxGrantees.Merge(m, src)
+}
+func (m *GranterMaxGrantees) XXX_Size() int {
+	return m.Size()
+}
+func (m *GranterMaxGrantees) XXX_DiscardUnknown() {
+	xxx_messageInfo_GranterMaxGrantees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GranterMaxGrantees proto.InternalMessageInfo
+
+func (m *GranterMaxGrantees) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *GranterMaxGrantees) GetMaxGrantees() uint64 {
+	if m != nil {
+		return m.MaxGrantees
+	}
+	return 0
+}
+
 // Grant is stored in the KVStore to record a grant with full context
 type Grant struct {
 	// granter is the address of the user granting an allowance of their funds.
@@ -233,7 +445,7 @@ func (m *Grant) Reset()         { *m = Grant{} }
 func (m *Grant) String() string { return proto.CompactTextString(m) }
 func (*Grant) ProtoMessage()    {}
 func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7279582900c30aea, []int{3}
+	return fileDescriptor_7279582900c30aea, []int{6}
 }
 func (m *Grant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,6 +499,9 @@ func init() {
 	proto.RegisterType((*BasicAllowance)(nil), "cosmos.feegrant.v1beta1.BasicAllowance")
 	proto.RegisterType((*PeriodicAllowance)(nil), "cosmos.feegrant.v1beta1.PeriodicAllowance")
 	proto.RegisterType((*AllowedMsgAllowance)(nil), "cosmos.feegrant.v1beta1.AllowedMsgAllowance")
+	proto.RegisterType((*MsgGasLimit)(nil), "cosmos.feegrant.v1beta1.MsgGasLimit")
+	proto.RegisterType((*GasAllowance)(nil), "cosmos.feegrant.v1beta1.GasAllowance")
+	proto.RegisterType((*GranterMaxGrantees)(nil), "cosmos.feegrant.v1beta1.GranterMaxGrantees")
 	proto.RegisterType((*Grant)(nil), "cosmos.feegrant.v1beta1.Grant")
 }
 
@@ -295,49 +510,60 @@ func init() {
 }
 
 var fileDescriptor_7279582900c30aea = []byte{
-	// 658 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x4f, 0xd4, 0x4e,
-	0x14, 0xdf, 0x61, 0x81, 0x6f, 0x76, 0x96, 0x2f, 0x42, 0x25, 0xb1, 0x4b, 0x4c, 0x4b, 0x36, 0x51,
-	0x17, 0x12, 0xda, 0x80, 0x37, 0x4e, 0x50, 0x0c, 0xa8, 0x81, 0x84, 0x14, 0x4f, 0x26, 0xa6, 0x99,
-	0xb6, 0x43, 0x9d, 0xb0, 0xed, 0x34, 0x9d, 0xa2, 0xec, 0xd5, 0x93, 0xd1, 0x83, 0x1c, 0x8d, 0x27,
-	0x8e, 0xc6, 0x13, 0x07, 0xfe, 0x08, 0xe2, 0xc1, 0x10, 0x4f, 0x7a, 0x11, 0x03, 0x07, 0xce, 0xfe,
-	0x07, 0xa6, 0x33, 0xd3, 0x6e, 0x61, 0x25, 0x42, 0x62, 0xb8, 0xec, 0x76, 0xde, 0xbc, 0xf7, 0xf9,
-	0xf1, 0xde, 0x6b, 0x0a, 0xef, 0x7a, 0x94, 0x85, 0x94, 0x99, 0x1b, 0x18, 0x07, 0x09, 0x8a, 0x52,
-	0xf3, 0xc5, 0x8c, 0x8b, 0x53, 0x34, 0x53, 0x04, 0x8c, 0x38, 0xa1, 0x29, 0x55, 0x6e, 0x89, 0x3c,
-	0xa3, 0x08, 0xcb, 0xbc, 0xf1, 0xb1, 0x80, 0x06, 0x94, 0xe7, 0x98, 0xd9, 0x93, 0x48, 0x1f, 0x6f,
-	0x04, 0x94, 0x06, 0x6d, 0x6c, 0xf2, 0x93, 0xbb, 0xb5, 0x61, 0xa2, 0xa8, 0x93, 0x5f, 0x09, 0x24,
-	0x47, 0xd4, 0x48, 0x58, 0x71, 0xa5, 0x49, 0x31, 0x2e, 0x62, 0xb8, 0x10, 0xe2, 0x51, 0x12, 0xc9,
-	0xfb, 0x51, 0x14, 0x92, 0x88, 0x9a, 0xfc, 0x57, 0x86, 0xf4, 0xf3, 0x44, 0x29, 0x09, 0x31, 0x4b,
-	0x51, 0x18, 0xe7, 0x98, 0xe7, 0x13, 0xfc, 0xad, 0x04, 0xa5, 0x84, 0x4a, 0xcc, 0xe6, 0x6e, 0x1f,
-	0x1c, 0xb6, 0x10, 0x23, 0xde, 0x42, 0xbb, 0x4d, 0x5f, 0xa2, 0xc8, 0xc3, 0xca, 0x2b, 0x00, 0xeb,
-	0x2c, 0xc6, 0x91, 0xef, 0xb4, 0x49, 0x48, 0x52, 0x15, 0x4c, 0x54, 0x5b, 0xf5, 0xd9, 0x86, 0x21,
-	0xb5, 0x66, 0xea, 0x72, 0xfb, 0xc6, 0x22, 0x25, 0x91, 0xb5, 0x74, 0xf0, 0x43, 0xaf, 0x7c, 0x3a,
-	0xd2, 0x5b, 0x01, 0x49, 0x9f, 0x6f, 0xb9, 0x86, 0x47, 0x43, 0x69, 0x4c, 0xfe, 0x4d, 0x33, 0x7f,
-	0xd3, 0x4c, 0x3b, 0x31, 0x66, 0xbc, 0x80, 0x7d, 0x38, 0xdd, 0x9b, 0x1a, 0x6a, 0xe3, 0x00, 0x79,
-	0x1d, 0x27, 0xf3, 0xc7, 0x3e, 0x9e, 0xee, 0x4d, 0x01, 0x1b, 0x72, 0xd6, 0x95, 0x8c, 0x54, 0x99,
-	0x87, 0x10, 0x6f, 0xc7, 0x44, 0x68, 0x55, 0xfb, 0x26, 0x40, 0xab, 0x3e, 0x3b, 0x6e, 0x08, 0x33,
-	0x46, 0x6e, 0xc6, 0x78, 0x92, 0xbb, 0xb5, 0xfa, 0x77, 0x8e, 0x74, 0x60, 0x97, 0x6a, 0xe6, 0x96,
-	0x3f, 0xef, 0x4f, 0xdf, 0xb9, 0x60, 0x6c, 0xc6, 0x12, 0xc6, 0x85, 0xe1, 0x47, 0x6f, 0x4e, 0xf7,
-	0xa6, 0x1a, 0x25, 0xa5, 0x67, 0xfb, 0xd1, 0xfc, 0xde, 0x0f, 0x47, 0xd7, 0x70, 0x42, 0xa8, 0x5f,
-	0xee, 0xd2, 0x43, 0x38, 0xe0, 0x66, 0x79, 0x2a, 0xe0, 0xda, 0xee, 0x19, 0x17, 0x51, 0x9d, 0x45,
-	0xb3, 0x6a, 0x59, 0xb3, 0x84, 0x5f, 0x01, 0xa0, 0xcc, 0xc3, 0xc1, 0x98, 0xc3, 0x4b, 0x9b, 0x8d,
-	0x1e, 0x9b, 0x0f, 0xe4, 0xcc, 0xac, 0xff, 0xb3, 0xe2, 0xf7, 0x47, 0x3a, 0x10, 0x00, 0xb2, 0x4e,
-	0x79, 0x07, 0xa0, 0x22, 0x1e, 0x9d, 0xf2, 0xe0, 0xaa, 0xd7, 0x35, 0xb8, 0x11, 0x41, 0xbe, 0xde,
-	0x1d, 0xdf, 0x5b, 0x00, 0x65, 0xd0, 0xf1, 0x50, 0x24, 0x54, 0xa9, 0xfd, 0xd7, 0xa5, 0x67, 0x58,
-	0x50, 0x2f, 0xa2, 0x88, 0x4b, 0x52, 0x56, 0xe0, 0x90, 0x14, 0x93, 0x60, 0x86, 0x53, 0x75, 0xe0,
-	0xaf, 0xeb, 0xc4, 0x1b, 0xbd, 0x53, 0x34, 0xba, 0x2e, 0xca, 0xed, 0xac, 0x7a, 0xee, 0xf1, 0x95,
-	0x16, 0xeb, 0x76, 0x49, 0x79, 0xcf, 0x16, 0x35, 0x7f, 0x01, 0x78, 0x93, 0x9f, 0xb0, 0xbf, 0xca,
-	0x82, 0xee, 0x76, 0x3d, 0x83, 0x35, 0x94, 0x1f, 0xe4, 0x86, 0x8d, 0xf5, 0xc8, 0x5d, 0x88, 0x3a,
-	0xd6, 0xe4, 0xa5, 0xc5, 0xd8, 0x5d, 0x44, 0x65, 0x12, 0x8e, 0x20, 0xc1, 0xea, 0x84, 0x98, 0x31,
-	0x14, 0x60, 0xa6, 0xf6, 0x4d, 0x54, 0x5b, 0x35, 0xfb, 0x86, 0x8c, 0xaf, 0xca, 0xf0, 0xdc, 0xda,
-	0xeb, 0x5d, 0xbd, 0x72, 0x25, 0xc7, 0x5a, 0xc9, 0xf1, 0x1f, 0xbc, 0x35, 0xbf, 0x00, 0x38, 0xb0,
-	0x9c, 0x41, 0x28, 0xb3, 0xf0, 0x3f, 0x8e, 0x85, 0x13, 0xee, 0xb1, 0x66, 0xa9, 0x5f, 0xf7, 0xa7,
-	0xc7, 0x24, 0xd1, 0x82, 0xef, 0x27, 0x98, 0xb1, 0xf5, 0x34, 0x21, 0x51, 0x60, 0xe7, 0x89, 0xdd,
-	0x1a, 0xcc, 0x5f, 0x97, 0x4b, 0xd4, 0x9c, 0xeb, 0x66, 0xf5, 0x5f, 0x77, 0xd3, 0x9a, 0x39, 0x38,
-	0xd6, 0xc0, 0xe1, 0xb1, 0x06, 0x7e, 0x1e, 0x6b, 0x60, 0xe7, 0x44, 0xab, 0x1c, 0x9e, 0x68, 0x95,
-	0x6f, 0x27, 0x5a, 0xe5, 0xa9, 0xfc, 0x6c, 0x30, 0x7f, 0xd3, 0x20, 0xd4, 0xdc, 0x2e, 0xbe, 0x2a,
-	0xee, 0x20, 0xa7, 0xbd, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x76, 0xbd, 0x15, 0x80, 0x06,
-	0x00, 0x00,
+	// 836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x4f, 0xe3, 0x46,
+	0x14, 0xcf, 0x24, 0x81, 0x2a, 0x93, 0xf0, 0x65, 0x90, 0x70, 0x50, 0xe5, 0xa4, 0x51, 0x4b, 0x03,
+	0x52, 0xec, 0x92, 0xaa, 0x97, 0x9c, 0xc0, 0x54, 0xa4, 0xad, 0x88, 0x84, 0x0c, 0x48, 0x55, 0xa5,
+	0xca, 0x9a, 0xc4, 0x13, 0xd7, 0x22, 0xf6, 0x44, 0x1e, 0xa7, 0x4d, 0x6e, 0x55, 0x4f, 0x55, 0x7b,
+	0x28, 0xa7, 0xaa, 0xea, 0x89, 0x63, 0xd5, 0x13, 0x07, 0x8e, 0xfd, 0x03, 0x50, 0x0f, 0x15, 0xe2,
+	0xd4, 0x5e, 0xca, 0x0a, 0x0e, 0x9c, 0xf7, 0x3f, 0x58, 0xd9, 0x33, 0xfe, 0x80, 0x80, 0x16, 0x76,
+	0x57, 0x68, 0x2f, 0x89, 0xe7, 0xcd, 0xfb, 0xf8, 0xfd, 0xde, 0xfb, 0x3d, 0xcb, 0x70, 0xb9, 0x43,
+	0xa8, 0x4d, 0xa8, 0xd2, 0xc5, 0xd8, 0x74, 0x91, 0xe3, 0x29, 0xdf, 0xae, 0xb5, 0xb1, 0x87, 0xd6,
+	0x22, 0x83, 0xdc, 0x77, 0x89, 0x47, 0x84, 0x45, 0xe6, 0x27, 0x47, 0x66, 0xee, 0xb7, 0xb4, 0x60,
+	0x12, 0x93, 0x04, 0x3e, 0x8a, 0xff, 0xc4, 0xdc, 0x97, 0x8a, 0x26, 0x21, 0x66, 0x0f, 0x2b, 0xc1,
+	0xa9, 0x3d, 0xe8, 0x2a, 0xc8, 0x19, 0x85, 0x57, 0x2c, 0x93, 0xce, 0x62, 0x78, 0x5a, 0x76, 0x25,
+	0x71, 0x30, 0x6d, 0x44, 0x71, 0x04, 0xa4, 0x43, 0x2c, 0x87, 0xdf, 0xcf, 0x21, 0xdb, 0x72, 0x88,
+	0x12, 0xfc, 0x72, 0x53, 0xe9, 0x76, 0x21, 0xcf, 0xb2, 0x31, 0xf5, 0x90, 0xdd, 0x0f, 0x73, 0xde,
+	0x76, 0x30, 0x06, 0x2e, 0xf2, 0x2c, 0xc2, 0x73, 0x56, 0x8e, 0xd2, 0x70, 0x5a, 0x45, 0xd4, 0xea,
+	0x6c, 0xf4, 0x7a, 0xe4, 0x3b, 0xe4, 0x74, 0xb0, 0xf0, 0x03, 0x80, 0x79, 0xda, 0xc7, 0x8e, 0xa1,
+	0xf7, 0x2c, 0xdb, 0xf2, 0x44, 0x50, 0xce, 0x54, 0xf3, 0xf5, 0xa2, 0xcc, 0xb1, 0xfa, 0xe8, 0x42,
+	0xfa, 0xf2, 0x26, 0xb1, 0x1c, 0x75, 0xeb, 0xf4, 0xff, 0x52, 0xea, 0xcf, 0x8b, 0x52, 0xd5, 0xb4,
+	0xbc, 0x6f, 0x06, 0x6d, 0xb9, 0x43, 0x6c, 0x4e, 0x8c, 0xff, 0xd5, 0xa8, 0x71, 0xa0, 0x78, 0xa3,
+	0x3e, 0xa6, 0x41, 0x00, 0xfd, 0xfd, 0xfa, 0x78, 0xb5, 0xd0, 0xc3, 0x26, 0xea, 0x8c, 0x74, 0x9f,
+	0x1f, 0xfd, 0xe3, 0xfa, 0x78, 0x15, 0x68, 0x30, 0xa8, 0xba, 0xed, 0x17, 0x15, 0xd6, 0x21, 0xc4,
+	0xc3, 0xbe, 0xc5, 0xb0, 0x8a, 0xe9, 0x32, 0xa8, 0xe6, 0xeb, 0x4b, 0x32, 0x23, 0x23, 0x87, 0x64,
+	0xe4, 0xbd, 0x90, 0xad, 0x9a, 0x3d, 0xbc, 0x28, 0x01, 0x2d, 0x11, 0xd3, 0x68, 0xfe, 0x7d, 0x52,
+	0xfb, 0xe0, 0x9e, 0xb1, 0xc9, 0x5b, 0x18, 0x47, 0x84, 0x3f, 0xff, 0xe9, 0xfa, 0x78, 0xb5, 0x98,
+	0x40, 0x7a, 0xb3, 0x1f, 0x95, 0xff, 0xb2, 0x70, 0x6e, 0x07, 0xbb, 0x16, 0x31, 0x92, 0x5d, 0xfa,
+	0x0c, 0x4e, 0xb4, 0x7d, 0x3f, 0x11, 0x04, 0xd8, 0x3e, 0x94, 0xef, 0x2b, 0x75, 0x33, 0x9b, 0x9a,
+	0xf3, 0x9b, 0xc5, 0xf8, 0xb2, 0x04, 0xc2, 0x3a, 0x9c, 0xec, 0x07, 0xe9, 0x39, 0xcd, 0xe2, 0x18,
+	0xcd, 0x4f, 0xf9, 0xcc, 0xd4, 0x29, 0x3f, 0xf8, 0xb7, 0x8b, 0x12, 0x60, 0x09, 0x78, 0x9c, 0xf0,
+	0x0b, 0x80, 0x02, 0x7b, 0xd4, 0x93, 0x83, 0xcb, 0x3c, 0xd5, 0xe0, 0x66, 0x59, 0xf1, 0xdd, 0x78,
+	0x7c, 0x3f, 0x03, 0xc8, 0x8d, 0x7a, 0x07, 0x39, 0x0c, 0x95, 0x98, 0x7d, 0x2a, 0x3c, 0xd3, 0xac,
+	0xf4, 0x26, 0x72, 0x02, 0x48, 0xc2, 0x36, 0x2c, 0x70, 0x30, 0x2e, 0xa6, 0xd8, 0x13, 0x27, 0x5e,
+	0x2a, 0xa7, 0xa0, 0xd1, 0x87, 0x51, 0xa3, 0xf3, 0x2c, 0x5c, 0xf3, 0xa3, 0x1b, 0x5f, 0x3c, 0x4a,
+	0x58, 0xef, 0x26, 0x90, 0x8f, 0xa9, 0xa8, 0xf2, 0x1c, 0xc0, 0xf9, 0xe0, 0x84, 0x8d, 0x16, 0x35,
+	0x63, 0x75, 0x7d, 0x0d, 0x73, 0x28, 0x3c, 0x70, 0x85, 0x2d, 0x8c, 0xc1, 0xdd, 0x70, 0x46, 0xea,
+	0xca, 0x83, 0xc1, 0x68, 0x71, 0x46, 0x61, 0x05, 0xce, 0x22, 0x56, 0x55, 0xb7, 0x31, 0xa5, 0xc8,
+	0xc4, 0x54, 0x4c, 0x97, 0x33, 0xd5, 0x9c, 0x36, 0xc3, 0xed, 0x2d, 0x6e, 0x6e, 0xec, 0xfc, 0x78,
+	0x54, 0x4a, 0x3d, 0x8a, 0xb1, 0x94, 0x60, 0x7c, 0x07, 0xb7, 0xca, 0xaf, 0x00, 0xe6, 0x5b, 0xd4,
+	0x6c, 0x22, 0xca, 0xb4, 0x52, 0x86, 0x05, 0x9b, 0x9a, 0xba, 0x3f, 0x56, 0x7d, 0xe0, 0xf6, 0x02,
+	0xba, 0x39, 0x0d, 0xda, 0xd4, 0xdc, 0x1b, 0xf5, 0xf1, 0xbe, 0xdb, 0x13, 0xaa, 0x91, 0x98, 0x4c,
+	0x44, 0xb9, 0xb8, 0xfd, 0x5d, 0xc9, 0x86, 0x93, 0x8e, 0x72, 0x2d, 0xc3, 0x99, 0xa4, 0x27, 0xee,
+	0xfa, 0x5b, 0xe0, 0x3b, 0x4e, 0xc5, 0x8e, 0xb8, 0xeb, 0x35, 0xe6, 0xcf, 0x4f, 0x6a, 0x33, 0x31,
+	0xce, 0xf2, 0x47, 0xf2, 0x27, 0xf5, 0xca, 0x5f, 0x19, 0x58, 0x68, 0x22, 0xfa, 0x76, 0xee, 0xf8,
+	0x3e, 0x9c, 0xf6, 0xbb, 0x14, 0x35, 0x80, 0xf2, 0xf5, 0x7e, 0xff, 0x5e, 0x50, 0x89, 0x1e, 0x27,
+	0x11, 0x15, 0xec, 0xd8, 0x4e, 0xc7, 0x56, 0x23, 0xfb, 0x3a, 0xab, 0x21, 0xbc, 0x07, 0x0b, 0x36,
+	0x1a, 0xea, 0x01, 0x0e, 0x8c, 0x69, 0xb0, 0x68, 0x59, 0x2d, 0x6f, 0xa3, 0x61, 0x93, 0x9b, 0x1a,
+	0x5f, 0x3e, 0x58, 0x4b, 0x77, 0x8c, 0xc8, 0x97, 0xd7, 0x62, 0x6c, 0x53, 0x92, 0xd3, 0xaa, 0x7c,
+	0x0f, 0xa0, 0xc0, 0xca, 0xb8, 0xad, 0xb8, 0xa0, 0x50, 0x87, 0xef, 0x30, 0x3c, 0x2e, 0x53, 0x96,
+	0x2a, 0x9e, 0x9f, 0xd4, 0x16, 0x38, 0x82, 0x0d, 0xc3, 0x70, 0x31, 0xa5, 0xbb, 0x9e, 0x6b, 0x39,
+	0xa6, 0x16, 0x3a, 0x8e, 0xf1, 0x48, 0x8f, 0xf3, 0xb8, 0x53, 0x41, 0xff, 0x00, 0x38, 0x11, 0x78,
+	0xbc, 0x52, 0xd5, 0x28, 0x06, 0x8b, 0xe9, 0x87, 0xc5, 0xdc, 0x7a, 0x51, 0x64, 0xde, 0xf4, 0x8b,
+	0x42, 0x5d, 0x3b, 0xbd, 0x94, 0xc0, 0xd9, 0xa5, 0x04, 0x9e, 0x5d, 0x4a, 0xe0, 0xf0, 0x4a, 0x4a,
+	0x9d, 0x5d, 0x49, 0xa9, 0x7f, 0xaf, 0xa4, 0xd4, 0x57, 0x7c, 0x0c, 0xd4, 0x38, 0x90, 0x2d, 0xa2,
+	0x0c, 0xa3, 0x0f, 0xa6, 0xf6, 0x64, 0x50, 0xf6, 0xe3, 0x17, 0x03, 0x00, 0x82, 0x7d, 0xb7, 0x41,
+	0x5b, 0x09, 0x00, 0x00,
 }
 
 func (m *BasicAllowance) Marshal() (dAtA []byte, err error) {
@@ -508,6 +734,149 @@ func (m *AllowedMsgAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgGasLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGasLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGasLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeriodGasLeft != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.PeriodGasLeft))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PeriodGasLimit != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.PeriodGasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGrantees != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGrantees))
+		i--
+		dAtA[i] = 0x28
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFeegrant(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.MsgGasLimits) > 0 {
+		for iNdEx := len(m.MsgGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeegrant(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintFeegrant(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Basic.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeegrant(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GranterMaxGrantees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GranterMaxGrantees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GranterMaxGrantees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGrantees != 0 {
+		i = encodeVarintFeegrant(dAtA, i, uint64(m.MaxGrantees))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintFeegrant(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Grant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -633,45 +1002,104 @@ func (m *AllowedMsgAllowance) Size() (n int) {
 	return n
 }
 
-func (m *Grant) Size() (n int) {
+func (m *MsgGasLimit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Granter)
+	l = len(m.MsgTypeUrl)
 	if l > 0 {
 		n += 1 + l + sovFeegrant(uint64(l))
 	}
-	l = len(m.Grantee)
-	if l > 0 {
-		n += 1 + l + sovFeegrant(uint64(l))
+	if m.PeriodGasLimit != 0 {
+		n += 1 + sovFeegrant(uint64(m.PeriodGasLimit))
 	}
-	if m.Allowance != nil {
-		l = m.Allowance.Size()
-		n += 1 + l + sovFeegrant(uint64(l))
+	if m.PeriodGasLeft != 0 {
+		n += 1 + sovFeegrant(uint64(m.PeriodGasLeft))
 	}
 	return n
 }
 
-func sovFeegrant(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeegrant(x uint64) (n int) {
-	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFeegrant
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+func (m *GasAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Basic.Size()
+	n += 1 + l + sovFeegrant(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if len(m.MsgGasLimits) > 0 {
+		for _, e := range m.MsgGasLimits {
+			l = e.Size()
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovFeegrant(uint64(l))
+	if m.MaxGrantees != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGrantees))
+	}
+	return n
+}
+
+func (m *GranterMaxGrantees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.MaxGrantees != 0 {
+		n += 1 + sovFeegrant(uint64(m.MaxGrantees))
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BasicAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -1115,6 +1543,429 @@ func (m *AllowedMsgAllowance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgGasLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGasLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGasLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodGasLimit", wireType)
+			}
+			m.PeriodGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodGasLeft", wireType)
+			}
+			m.PeriodGasLeft = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodGasLeft |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basic", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Basic.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasLimits = append(m.MsgGasLimits, MsgGasLimit{})
+			if err := m.MsgGasLimits[len(m.MsgGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGrantees", wireType)
+			}
+			m.MaxGrantees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGrantees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GranterMaxGrantees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GranterMaxGrantees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GranterMaxGrantees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGrantees", wireType)
+			}
+			m.MaxGrantees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGrantees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Grant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,161 @@
+package feegrant
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ FeeAllowanceI = (*GasAllowance)(nil)
+
+// Accept can use fee payment requested as well as timestamp of the current block
+// to determine whether or not to process this. This is checked in
+// Keeper.UseGrantedFees and the return values should match how it is handled there.
+//
+// The gas limit of the transaction is charged to the gas budget of each message
+// type of the transaction, a transaction containing a message type without gas
+// budget is rejected. The gas is not charged when simulating transactions.
+// The allowance is used by the ante handler before the messages are executed, so
+// the gas they consume is not known yet: like the fee, which pays for the whole
+// gas limit, the gas limit is charged and the unused gas is not refunded.
+//
+// If remove is true (regardless of the error), the FeeAllowance will be deleted from storage
+// (eg. when it is used up). (See call to RevokeAllowance in Keeper.UseGrantedFees)
+func (a *GasAllowance) Accept(ctx context.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.HeaderInfo().Time
+
+	if a.Basic.Expiration != nil && blockTime.After(*a.Basic.Expiration) {
+		return true, errorsmod.Wrap(ErrFeeLimitExpired, "absolute limit")
+	}
+
+	a.tryResetPeriod(blockTime)
+
+	var gasLimit uint64
+	if sdkCtx.ExecMode() != sdk.ExecModeSimulate {
+		gasLimit = sdkCtx.GasMeter().Limit()
+	}
+
+	charged := make(map[string]bool, len(msgs))
+	for _, msg := range msgs {
+		sdkCtx.GasMeter().ConsumeGas(gasCostPerIteration, "check msg")
+
+		typeURL := sdk.MsgTypeURL(msg)
+		if charged[typeURL] {
+			continue
+		}
+
+		budget := a.msgGasLimit(typeURL)
+		if budget == nil {
+			return false, errorsmod.Wrapf(ErrMessageNotAllowed, "no gas budget for %s", typeURL)
+		}
+
+		if budget.PeriodGasLeft < gasLimit {
+			return false, errorsmod.Wrapf(ErrGasLimitExceeded, "%s period limit: %d gas left, %d required", typeURL, budget.PeriodGasLeft, gasLimit)
+		}
+
+		budget.PeriodGasLeft -= gasLimit
+		charged[typeURL] = true
+	}
+
+	if a.Basic.SpendLimit != nil {
+		var isNeg bool
+		a.Basic.SpendLimit, isNeg = a.Basic.SpendLimit.SafeSub(fee...)
+		if isNeg {
+			return false, errorsmod.Wrap(ErrFeeLimitExceeded, "absolute limit")
+		}
+
+		return a.Basic.SpendLimit.IsZero(), nil
+	}
+
+	return false, nil
+}
+
+// msgGasLimit returns the gas budget of the message type, or nil if the message
+// type has no gas budget.
+func (a *GasAllowance) msgGasLimit(typeURL string) *MsgGasLimit {
+	for i := range a.MsgGasLimits {
+		if a.MsgGasLimits[i].MsgTypeUrl == typeURL {
+			return &a.MsgGasLimits[i]
+		}
+	}
+
+	return nil
+}
+
+// tryResetPeriod will check if the PeriodReset has been hit. If not, it is a no-op.
+// If we hit the reset period, it will top up the gas left of every message type
+// to its period gas limit and update the PeriodReset the same way PeriodicAllowance does.
+func (a *GasAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+
+	for i := range a.MsgGasLimits {
+		a.MsgGasLimits[i].PeriodGasLeft = a.MsgGasLimits[i].PeriodGasLimit
+	}
+
+	_ = a.UpdatePeriodReset(a.PeriodReset)
+	if blockTime.After(a.PeriodReset) {
+		_ = a.UpdatePeriodReset(blockTime)
+	}
+}
+
+// PeriodBudget returns the gas budgets and the end of the period at the given block time,
+// without updating the allowance.
+func (a GasAllowance) PeriodBudget(blockTime time.Time) ([]MsgGasLimit, time.Time) {
+	a.MsgGasLimits = append([]MsgGasLimit(nil), a.MsgGasLimits...)
+	a.tryResetPeriod(blockTime)
+
+	return a.MsgGasLimits, a.PeriodReset
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a GasAllowance) ValidateBasic() error {
+	if err := a.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if a.Period <= 0 {
+		return errorsmod.Wrap(ErrInvalidDuration, "period must be positive")
+	}
+
+	if len(a.MsgGasLimits) == 0 {
+		return errorsmod.Wrap(ErrNoMessages, "msg gas limits shouldn't be empty")
+	}
+
+	typeURLs := make(map[string]bool, len(a.MsgGasLimits))
+	for _, limit := range a.MsgGasLimits {
+		if limit.MsgTypeUrl == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "msg type url cannot be empty")
+		}
+		if typeURLs[limit.MsgTypeUrl] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate gas limit for %s", limit.MsgTypeUrl)
+		}
+		typeURLs[limit.MsgTypeUrl] = true
+
+		if limit.PeriodGasLimit == 0 {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "period gas limit of %s must be positive", limit.MsgTypeUrl)
+		}
+		if limit.PeriodGasLeft > limit.PeriodGasLimit {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "period gas left of %s cannot exceed its period gas limit", limit.MsgTypeUrl)
+		}
+	}
+
+	return nil
+}
+
+// ExpiresAt returns the expiry time of the GasAllowance.
+func (a GasAllowance) ExpiresAt() (*time.Time, error) {
+	return a.Basic.ExpiresAt()
+}
+
+// UpdatePeriodReset update "PeriodReset" of the GasAllowance.
+func (a *GasAllowance) UpdatePeriodReset(validTime time.Time) error {
+	a.PeriodReset = validTime.Add(a.Period)
+	return nil
+}
@@ -0,0 +1,141 @@
+package feegrant_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	storetypes "cosmossdk.io/store/types"
+	banktypes "cosmossdk.io/x/bank/types"
+	"cosmossdk.io/x/feegrant"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGasAllowanceValidateBasic(t *testing.T) {
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	cases := map[string]struct {
+		allow feegrant.GasAllowance
+		valid bool
+	}{
+		"empty": {
+			allow: feegrant.GasAllowance{},
+			valid: false,
+		},
+		"no period": {
+			allow: feegrant.GasAllowance{
+				MsgGasLimits: []feegrant.MsgGasLimit{{MsgTypeUrl: sendURL, PeriodGasLimit: 100}},
+			},
+			valid: false,
+		},
+		"no gas limits": {
+			allow: feegrant.GasAllowance{
+				Period: time.Hour,
+			},
+			valid: false,
+		},
+		"zero gas limit": {
+			allow: feegrant.GasAllowance{
+				Period:       time.Hour,
+				MsgGasLimits: []feegrant.MsgGasLimit{{MsgTypeUrl: sendURL}},
+			},
+			valid: false,
+		},
+		"gas left above limit": {
+			allow: feegrant.GasAllowance{
+				Period:       time.Hour,
+				MsgGasLimits: []feegrant.MsgGasLimit{{MsgTypeUrl: sendURL, PeriodGasLimit: 100, PeriodGasLeft: 101}},
+			},
+			valid: false,
+		},
+		"duplicate msg type": {
+			allow: feegrant.GasAllowance{
+				Period: time.Hour,
+				MsgGasLimits: []feegrant.MsgGasLimit{
+					{MsgTypeUrl: sendURL, PeriodGasLimit: 100},
+					{MsgTypeUrl: sendURL, PeriodGasLimit: 200},
+				},
+			},
+			valid: false,
+		},
+		"valid": {
+			allow: feegrant.GasAllowance{
+				Basic:        feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+				Period:       time.Hour,
+				MsgGasLimits: []feegrant.MsgGasLimit{{MsgTypeUrl: sendURL, PeriodGasLimit: 100, PeriodGasLeft: 100}},
+				MaxGrantees:  10,
+			},
+			valid: true,
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.allow.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestGasAllowanceAccept(t *testing.T) {
+	key := storetypes.NewKVStoreKey(feegrant.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+	now := time.Now()
+	ctx := testCtx.Ctx.WithHeaderInfo(header.Info{Time: now}).WithGasMeter(storetypes.NewGasMeter(400))
+
+	sendURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 10))
+
+	allow := &feegrant.GasAllowance{
+		Basic:  feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("atom", 100))},
+		Period: time.Hour,
+		MsgGasLimits: []feegrant.MsgGasLimit{
+			{MsgTypeUrl: sendURL, PeriodGasLimit: 1000, PeriodGasLeft: 1000},
+		},
+		PeriodReset: now.Add(time.Hour),
+	}
+	require.NoError(t, allow.ValidateBasic())
+
+	// the tx gas limit is charged once per message type
+	remove, err := allow.Accept(ctx, fee, []sdk.Msg{&banktypes.MsgSend{}, &banktypes.MsgSend{}})
+	require.NoError(t, err)
+	require.False(t, remove)
+	require.Equal(t, uint64(600), allow.MsgGasLimits[0].PeriodGasLeft)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("atom", 90)), allow.Basic.SpendLimit)
+
+	// message types without gas budget are rejected
+	_, err = allow.Accept(ctx, fee, []sdk.Msg{&banktypes.MsgMultiSend{}})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	_, err = allow.Accept(ctx, fee, []sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+
+	// the budget left does not cover the tx gas limit
+	_, err = allow.Accept(ctx, fee, []sdk.Msg{&banktypes.MsgSend{}})
+	require.ErrorIs(t, err, feegrant.ErrGasLimitExceeded)
+
+	// the gas is not charged when simulating
+	_, err = allow.Accept(ctx.WithExecMode(sdk.ExecModeSimulate), fee, []sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+
+	// the budget is reset in the next period
+	budget, periodReset := allow.PeriodBudget(now.Add(150 * time.Minute))
+	require.Equal(t, uint64(1000), budget[0].PeriodGasLeft)
+	require.Equal(t, now.Add(210*time.Minute), periodReset)
+	require.Equal(t, uint64(200), allow.MsgGasLimits[0].PeriodGasLeft)
+
+	ctx = ctx.WithHeaderInfo(header.Info{Time: now.Add(150 * time.Minute)})
+	_, err = allow.Accept(ctx, fee, []sdk.Msg{&banktypes.MsgSend{}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(600), allow.MsgGasLimits[0].PeriodGasLeft)
+}
@@ -1,6 +1,8 @@
 package feegrant
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -15,6 +17,7 @@ func NewGenesisState(entries []Grant) *GenesisState {
 
 // ValidateGenesis ensures all grants in the genesis state are valid
 func ValidateGenesis(data GenesisState) error {
+	gasAllowanceGranters := make(map[string]bool)
 	for _, f := range data.Allowances {
 		grant, err := f.GetGrant()
 		if err != nil {
@@ -24,6 +27,23 @@ func ValidateGenesis(data GenesisState) error {
 		if err != nil {
 			return err
 		}
+		if _, ok := grant.(*GasAllowance); ok {
+			gasAllowanceGranters[f.Granter] = true
+		}
+	}
+	seen := make(map[string]bool, len(data.GranterMaxGrantees))
+	for _, m := range data.GranterMaxGrantees {
+		if m.MaxGrantees == 0 {
+			return fmt.Errorf("max grantees of granter %s must be positive", m.Granter)
+		}
+		// the cap of a granter is removed along with its last gas allowance
+		if !gasAllowanceGranters[m.Granter] {
+			return fmt.Errorf("granter %s has max grantees but no gas allowance", m.Granter)
+		}
+		if seen[m.Granter] {
+			return fmt.Errorf("duplicate max grantees of granter %s", m.Granter)
+		}
+		seen[m.Granter] = true
 	}
 	return nil
 }
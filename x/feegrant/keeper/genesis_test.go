@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"gotest.tools/v3/assert"
@@ -94,6 +95,47 @@ func TestImportExportGenesis(t *testing.T) {
 	assert.DeepEqual(t, genesis, newGenesis)
 }
 
+func TestImportExportGasAllowanceMaxGrantees(t *testing.T) {
+	f := initFixture(t)
+
+	allowance := &feegrant.GasAllowance{
+		Period: time.Hour,
+		MsgGasLimits: []feegrant.MsgGasLimit{
+			{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", PeriodGasLimit: 2_000_000, PeriodGasLeft: 2_000_000},
+		},
+		MaxGrantees: 2,
+	}
+	err := f.feegrantKeeper.GrantAllowance(f.ctx, granterAddr, granteeAddr, allowance)
+	assert.NilError(t, err)
+
+	genesis, err := f.feegrantKeeper.ExportGenesis(f.ctx)
+	assert.NilError(t, err)
+	granter, err := f.accountKeeper.AddressCodec().BytesToString(granterAddr.Bytes())
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []feegrant.GranterMaxGrantees{{Granter: granter, MaxGrantees: 2}}, genesis.GranterMaxGrantees)
+
+	assert.NilError(t, feegrant.ValidateGenesis(*genesis))
+	f = initFixture(t)
+	err = f.feegrantKeeper.InitGenesis(f.ctx, genesis)
+	assert.NilError(t, err)
+
+	maxGrantees, err := f.feegrantKeeper.GasAllowanceMaxGrantees.Get(f.ctx, granterAddr)
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(2), maxGrantees)
+
+	genesis.GranterMaxGrantees = append(genesis.GranterMaxGrantees, genesis.GranterMaxGrantees[0])
+	assert.ErrorContains(t, feegrant.ValidateGenesis(*genesis), "duplicate max grantees")
+
+	genesis.GranterMaxGrantees = genesis.GranterMaxGrantees[:1]
+	genesis.GranterMaxGrantees[0].MaxGrantees = 0
+	assert.ErrorContains(t, feegrant.ValidateGenesis(*genesis), "must be positive")
+
+	// the cap of a granter is removed along with its last gas allowance
+	genesis.GranterMaxGrantees[0].MaxGrantees = 2
+	genesis.Allowances = nil
+	assert.ErrorContains(t, feegrant.ValidateGenesis(*genesis), "no gas allowance")
+}
+
 func TestInitGenesis(t *testing.T) {
 	any, err := codectypes.NewAnyWithValue(&testdata.Dog{})
 	assert.NilError(t, err)
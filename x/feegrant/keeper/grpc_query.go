@@ -116,3 +116,44 @@ func (q Keeper) AllowancesByGranter(c context.Context, req *feegrant.QueryAllowa
 
 	return &feegrant.QueryAllowancesByGranterResponse{Allowances: grants, Pagination: pageRes}, nil
 }
+
+// GasAllowanceBudget queries the gas budgets left in the current period of the gas allowance
+// granted to the grantee by the granter.
+func (q Keeper) GasAllowanceBudget(ctx context.Context, req *feegrant.QueryGasAllowanceBudgetRequest) (*feegrant.QueryGasAllowanceBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	granterAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Granter)
+	if err != nil {
+		return nil, err
+	}
+
+	granteeAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	feeAllowance, err := q.GetAllowance(ctx, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+
+	gasAllowance, ok := feeAllowance.(*feegrant.GasAllowance)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "allowance is not a gas allowance: %T", feeAllowance)
+	}
+
+	count, err := q.GetGasAllowanceGranteesCount(ctx, granterAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	msgGasLimits, periodReset := gasAllowance.PeriodBudget(q.HeaderService.HeaderInfo(ctx).Time)
+
+	return &feegrant.QueryGasAllowanceBudgetResponse{
+		MsgGasLimits:    msgGasLimits,
+		PeriodReset:     periodReset,
+		GranterGrantees: count,
+	}, nil
+}
@@ -1,6 +1,9 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/core/header"
 	"cosmossdk.io/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -241,3 +244,43 @@ func (suite *KeeperTestSuite) grantFeeAllowance(granter, grantee sdk.AccAddress)
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestGasAllowanceBudget() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+
+	_, err := suite.feegrantKeeper.GasAllowanceBudget(ctx, nil)
+	suite.Require().Error(err)
+
+	// basic allowances have no gas budget
+	suite.grantFeeAllowance(suite.addrs[0], suite.addrs[1])
+	_, err = suite.feegrantKeeper.GasAllowanceBudget(ctx, &feegrant.QueryGasAllowanceBudgetRequest{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[1],
+	})
+	suite.Require().Error(err)
+
+	msgGasLimits := []feegrant.MsgGasLimit{
+		{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", PeriodGasLimit: 2_000_000, PeriodGasLeft: 500_000},
+	}
+	err = suite.feegrantKeeper.GrantAllowance(ctx, suite.addrs[0], suite.addrs[2], &feegrant.GasAllowance{
+		Period:       time.Hour,
+		MsgGasLimits: msgGasLimits,
+	})
+	suite.Require().NoError(err)
+
+	resp, err := suite.feegrantKeeper.GasAllowanceBudget(ctx, &feegrant.QueryGasAllowanceBudgetRequest{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[2],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(msgGasLimits, resp.MsgGasLimits)
+	suite.Require().Equal(uint64(1), resp.GranterGrantees)
+
+	// the budget is reset once the period ended
+	resp, err = suite.feegrantKeeper.GasAllowanceBudget(ctx.WithHeaderInfo(header.Info{Time: resp.PeriodReset}), &feegrant.QueryGasAllowanceBudgetRequest{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[2],
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2_000_000), resp.MsgGasLimits[0].PeriodGasLeft)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -30,6 +31,10 @@ type Keeper struct {
 	FeeAllowance collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], feegrant.Grant]
 	// FeeAllowanceQueue key: expiration time+grantee+granter | value: bool
 	FeeAllowanceQueue collections.Map[collections.Triple[time.Time, sdk.AccAddress, sdk.AccAddress], bool]
+	// GasAllowanceGrantees key: granter+grantee of the gas allowances
+	GasAllowanceGrantees collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	// GasAllowanceMaxGrantees key: granter | value: max grantees of the granter
+	GasAllowanceMaxGrantees collections.Map[sdk.AccAddress, uint64]
 }
 
 var _ ante.FeegrantKeeper = &Keeper{}
@@ -56,6 +61,19 @@ func NewKeeper(env appmodule.Environment, cdc codec.BinaryCodec, ak feegrant.Acc
 			collections.TripleKeyCodec(sdk.TimeKey, sdk.LengthPrefixedAddressKey(sdk.AccAddressKey), sdk.LengthPrefixedAddressKey(sdk.AccAddressKey)), //nolint: staticcheck // sdk.LengthPrefixedAddressKey is needed to retain state compatibility
 			collections.BoolValue,
 		),
+		GasAllowanceGrantees: collections.NewKeySet(
+			sb,
+			feegrant.GasAllowanceGranteesKeyPrefix,
+			"gas_allowance_grantees",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+		),
+		GasAllowanceMaxGrantees: collections.NewMap(
+			sb,
+			feegrant.GasAllowanceMaxGranteesKeyPrefix,
+			"gas_allowance_max_grantees",
+			sdk.AccAddressKey,
+			collections.Uint64Value,
+		),
 	}
 }
 
@@ -118,6 +136,23 @@ func (k Keeper) GrantAllowance(ctx context.Context, granter, grantee sdk.AccAddr
 		return err
 	}
 
+	// track the grantees sponsored with gas allowances to enforce their max grantees
+	if gasAllowance, ok := feeAllowance.(*feegrant.GasAllowance); ok {
+		if err := k.GasAllowanceGrantees.Set(ctx, collections.Join(granter, grantee)); err != nil {
+			return err
+		}
+
+		maxGrantees, err := k.GetGasAllowanceMaxGrantees(ctx, granter, gasAllowance)
+		if err != nil {
+			return err
+		}
+		if maxGrantees > 0 {
+			if err := k.GasAllowanceMaxGrantees.Set(ctx, granter, maxGrantees); err != nil {
+				return err
+			}
+		}
+	}
+
 	return k.EventService.EventManager(ctx).EmitKV(
 		feegrant.EventTypeSetFeeGrant,
 		event.NewAttribute(feegrant.AttributeKeyGranter, grant.Granter),
@@ -168,6 +203,10 @@ func (k Keeper) revokeAllowance(ctx context.Context, granter, grantee sdk.AccAdd
 		return err
 	}
 
+	if err := k.removeGasAllowanceGrantee(ctx, granter, grantee); err != nil {
+		return err
+	}
+
 	exp, err := grant.ExpiresAt()
 	if err != nil {
 		return err
@@ -207,6 +246,61 @@ func (k Keeper) GetAllowance(ctx context.Context, granter, grantee sdk.AccAddres
 	return grant.GetGrant()
 }
 
+// GetGasAllowanceGranteesCount returns the number of distinct grantees the granter
+// sponsors with gas allowances.
+func (k Keeper) GetGasAllowanceGranteesCount(ctx context.Context, granter sdk.AccAddress) (uint64, error) {
+	iter, err := k.GasAllowanceGrantees.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](granter))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+
+	return count, nil
+}
+
+// removeGasAllowanceGrantee removes the grantee from the grantees sponsored by the granter
+// with gas allowances. The cap of the granter is removed along with its last grantee.
+func (k Keeper) removeGasAllowanceGrantee(ctx context.Context, granter, grantee sdk.AccAddress) error {
+	if err := k.GasAllowanceGrantees.Remove(ctx, collections.Join(granter, grantee)); err != nil {
+		return err
+	}
+
+	iter, err := k.GasAllowanceGrantees.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](granter))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	if iter.Valid() {
+		return nil
+	}
+
+	return k.GasAllowanceMaxGrantees.Remove(ctx, granter)
+}
+
+// GetGasAllowanceMaxGrantees returns the maximum number of distinct grantees the granter
+// can sponsor with gas allowances when granting the given gas allowance, zero meaning no cap.
+// The cap of the granter is the lowest non zero max grantees of its gas allowances granted
+// since it last had none, so a gas allowance can lower it but neither raise nor remove it
+// while the granter sponsors other grantees with gas allowances.
+func (k Keeper) GetGasAllowanceMaxGrantees(ctx context.Context, granter sdk.AccAddress, gasAllowance *feegrant.GasAllowance) (uint64, error) {
+	maxGrantees, err := k.GasAllowanceMaxGrantees.Get(ctx, granter)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+
+	if gasAllowance.MaxGrantees > 0 && (maxGrantees == 0 || gasAllowance.MaxGrantees < maxGrantees) {
+		maxGrantees = gasAllowance.MaxGrantees
+	}
+
+	return maxGrantees, nil
+}
+
 // IterateAllFeeAllowances iterates over all the grants in the store.
 // Callback to get all data, returns true to stop, false to keep reading
 // Calling this without pagination is very expensive and only designed for export genesis
@@ -280,6 +374,18 @@ func (k Keeper) InitGenesis(ctx context.Context, data *feegrant.GenesisState) er
 			return err
 		}
 	}
+
+	for _, m := range data.GranterMaxGrantees {
+		granter, err := k.authKeeper.AddressCodec().StringToBytes(m.Granter)
+		if err != nil {
+			return err
+		}
+
+		err = k.GasAllowanceMaxGrantees.Set(ctx, granter, m.MaxGrantees)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -291,9 +397,24 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*feegrant.GenesisState, erro
 		grants = append(grants, grant)
 		return false
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	var granterMaxGrantees []feegrant.GranterMaxGrantees
+	err = k.GasAllowanceMaxGrantees.Walk(ctx, nil, func(granter sdk.AccAddress, maxGrantees uint64) (stop bool, err error) {
+		granterStr, err := k.authKeeper.AddressCodec().BytesToString(granter)
+		if err != nil {
+			return true, err
+		}
+
+		granterMaxGrantees = append(granterMaxGrantees, feegrant.GranterMaxGrantees{Granter: granterStr, MaxGrantees: maxGrantees})
+		return false, nil
+	})
 
 	return &feegrant.GenesisState{
-		Allowances: grants,
+		Allowances:         grants,
+		GranterMaxGrantees: granterMaxGrantees,
 	}, err
 }
 
@@ -311,6 +432,10 @@ func (k Keeper) RemoveExpiredAllowances(ctx context.Context, limit int) error {
 			return true, err
 		}
 
+		if err := k.removeGasAllowanceGrantee(ctx, granter, grantee); err != nil {
+			return true, err
+		}
+
 		keysToRemove = append(keysToRemove, key)
 
 		// limit the amount of iterations to avoid taking too much time
@@ -54,6 +54,24 @@ func (k msgServer) GrantAllowance(ctx context.Context, msg *feegrant.MsgGrantAll
 		return nil, err
 	}
 
+	if gasAllowance, ok := allowance.(*feegrant.GasAllowance); ok {
+		maxGrantees, err := k.GetGasAllowanceMaxGrantees(ctx, granter, gasAllowance)
+		if err != nil {
+			return nil, err
+		}
+
+		if maxGrantees > 0 {
+			count, err := k.GetGasAllowanceGranteesCount(ctx, granter)
+			if err != nil {
+				return nil, err
+			}
+
+			if count >= maxGrantees {
+				return nil, errorsmod.Wrapf(feegrant.ErrGranteeLimitExceeded, "granter already sponsors %d grantees", count)
+			}
+		}
+	}
+
 	err = k.Keeper.GrantAllowance(ctx, granter, grantee, allowance)
 	if err != nil {
 		return nil, err
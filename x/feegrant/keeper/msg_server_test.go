@@ -373,3 +373,141 @@ func (suite *KeeperTestSuite) TestPruneAllowances() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(1, count)
 }
+
+func (suite *KeeperTestSuite) TestGrantGasAllowanceMaxGrantees() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	granter := suite.addrs[0]
+
+	grant := func(grantee string) error {
+		any, err := codectypes.NewAnyWithValue(&feegrant.GasAllowance{
+			Period: time.Hour,
+			MsgGasLimits: []feegrant.MsgGasLimit{
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", PeriodGasLimit: 2_000_000, PeriodGasLeft: 2_000_000},
+			},
+			MaxGrantees: 2,
+		})
+		suite.Require().NoError(err)
+
+		_, err = suite.msgSrvr.GrantAllowance(ctx, &feegrant.MsgGrantAllowance{
+			Granter:   suite.encodedAddrs[0],
+			Grantee:   grantee,
+			Allowance: any,
+		})
+		return err
+	}
+
+	suite.Require().NoError(grant(suite.encodedAddrs[1]))
+	suite.Require().NoError(grant(suite.encodedAddrs[2]))
+	suite.Require().ErrorIs(grant(suite.encodedAddrs[3]), feegrant.ErrGranteeLimitExceeded)
+
+	// basic allowances are not counted
+	any, err := codectypes.NewAnyWithValue(&feegrant.BasicAllowance{})
+	suite.Require().NoError(err)
+	_, err = suite.msgSrvr.GrantAllowance(ctx, &feegrant.MsgGrantAllowance{
+		Granter:   suite.encodedAddrs[0],
+		Grantee:   suite.encodedAddrs[4],
+		Allowance: any,
+	})
+	suite.Require().NoError(err)
+
+	count, err := suite.feegrantKeeper.GetGasAllowanceGranteesCount(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), count)
+
+	// revoking a gas allowance frees a slot
+	_, err = suite.msgSrvr.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+		Granter: suite.encodedAddrs[0],
+		Grantee: suite.encodedAddrs[1],
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(grant(suite.encodedAddrs[3]))
+}
+
+func (suite *KeeperTestSuite) TestGrantGasAllowanceMaxGranteesOfGranter() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	granter := suite.addrs[0]
+
+	grant := func(grantee string, maxGrantees uint64) error {
+		any, err := codectypes.NewAnyWithValue(&feegrant.GasAllowance{
+			Period: time.Hour,
+			MsgGasLimits: []feegrant.MsgGasLimit{
+				{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", PeriodGasLimit: 2_000_000, PeriodGasLeft: 2_000_000},
+			},
+			MaxGrantees: maxGrantees,
+		})
+		suite.Require().NoError(err)
+
+		_, err = suite.msgSrvr.GrantAllowance(ctx, &feegrant.MsgGrantAllowance{
+			Granter:   suite.encodedAddrs[0],
+			Grantee:   grantee,
+			Allowance: any,
+		})
+		return err
+	}
+
+	suite.Require().NoError(grant(suite.encodedAddrs[1], 3))
+	maxGrantees, err := suite.feegrantKeeper.GasAllowanceMaxGrantees.Get(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), maxGrantees)
+
+	// the cap of the granter can't be removed nor raised by later gas allowances
+	suite.Require().NoError(grant(suite.encodedAddrs[2], 0))
+	suite.Require().NoError(grant(suite.encodedAddrs[3], 10))
+	suite.Require().ErrorIs(grant(suite.encodedAddrs[4], 0), feegrant.ErrGranteeLimitExceeded)
+	suite.Require().ErrorIs(grant(suite.encodedAddrs[4], 10), feegrant.ErrGranteeLimitExceeded)
+
+	// but it can be lowered
+	for _, grantee := range suite.encodedAddrs[1:3] {
+		_, err = suite.msgSrvr.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+			Granter: suite.encodedAddrs[0],
+			Grantee: grantee,
+		})
+		suite.Require().NoError(err)
+	}
+	suite.Require().NoError(grant(suite.encodedAddrs[4], 2))
+	suite.Require().ErrorIs(grant(suite.encodedAddrs[5], 0), feegrant.ErrGranteeLimitExceeded)
+	maxGrantees, err = suite.feegrantKeeper.GasAllowanceMaxGrantees.Get(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), maxGrantees)
+
+	// the cap is removed along with the last gas allowance of the granter
+	for _, grantee := range suite.encodedAddrs[3:5] {
+		_, err = suite.msgSrvr.RevokeAllowance(ctx, &feegrant.MsgRevokeAllowance{
+			Granter: suite.encodedAddrs[0],
+			Grantee: grantee,
+		})
+		suite.Require().NoError(err)
+	}
+	has, err := suite.feegrantKeeper.GasAllowanceMaxGrantees.Has(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// so that a new gas allowance sets it again
+	suite.Require().NoError(grant(suite.encodedAddrs[1], 3))
+	maxGrantees, err = suite.feegrantKeeper.GasAllowanceMaxGrantees.Get(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), maxGrantees)
+}
+
+func (suite *KeeperTestSuite) TestExpiredGasAllowanceMaxGrantees() {
+	ctx := suite.ctx.WithHeaderInfo(header.Info{Time: time.Now()})
+	granter := suite.addrs[0]
+
+	exp := ctx.HeaderInfo().Time.Add(time.Hour)
+	err := suite.feegrantKeeper.GrantAllowance(ctx, granter, suite.addrs[1], &feegrant.GasAllowance{
+		Basic:  feegrant.BasicAllowance{Expiration: &exp},
+		Period: time.Minute,
+		MsgGasLimits: []feegrant.MsgGasLimit{
+			{MsgTypeUrl: "/cosmos.gov.v1.MsgVote", PeriodGasLimit: 2_000_000, PeriodGasLeft: 2_000_000},
+		},
+		MaxGrantees: 1,
+	})
+	suite.Require().NoError(err)
+
+	// the cap of the granter expires with its last gas allowance
+	ctx = ctx.WithHeaderInfo(header.Info{Time: exp.Add(time.Hour)})
+	suite.Require().NoError(suite.feegrantKeeper.RemoveExpiredAllowances(ctx, 10))
+	has, err := suite.feegrantKeeper.GasAllowanceMaxGrantees.Has(ctx, granter)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
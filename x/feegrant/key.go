@@ -20,4 +20,12 @@ var (
 	// FeeAllowanceQueueKeyPrefix is the set of the kvstore for fee allowance keys data
 	// - 0x01<allowance_prefix_queue_key_bytes>: <empty value>
 	FeeAllowanceQueueKeyPrefix = collections.NewPrefix(1)
+
+	// GasAllowanceGranteesKeyPrefix is the set of the kvstore for the grantees sponsored with gas allowances
+	// - 0x02<granter_bytes><grantee_bytes>: <empty value>
+	GasAllowanceGranteesKeyPrefix = collections.NewPrefix(2)
+
+	// GasAllowanceMaxGranteesKeyPrefix is the set of the kvstore for the max grantees of the granters
+	// - 0x03<granter_bytes>: <max_grantees>
+	GasAllowanceMaxGranteesKeyPrefix = collections.NewPrefix(3)
 )
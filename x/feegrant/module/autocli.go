@@ -44,6 +44,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "granter"},
 					},
 				},
+				{
+					RpcMethod: "GasAllowanceBudget",
+					Use:       "gas-budget [granter] [grantee]",
+					Short:     "Query the gas budgets left of a gas allowance",
+					Long:      "Query the gas budgets left in the current period of the gas allowance granted to a grantee by a granter.",
+					Example:   fmt.Sprintf(`$ %s query feegrant gas-budget [granter] [grantee]`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "granter"},
+						{ProtoField: "grantee"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
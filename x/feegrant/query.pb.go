@@ -8,21 +8,26 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -349,6 +354,125 @@ func (m *QueryAllowancesByGranterResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryGasAllowanceBudgetRequest is the request type for the Query/GasAllowanceBudget RPC method.
+type QueryGasAllowanceBudgetRequest struct {
+	// granter is the address of the user granting an allowance of their funds.
+	Granter string `protobuf:"bytes,1,opt,name=granter,proto3" json:"granter,omitempty"`
+	// grantee is the address of the user being granted an allowance of another user's funds.
+	Grantee string `protobuf:"bytes,2,opt,name=grantee,proto3" json:"grantee,omitempty"`
+}
+
+func (m *QueryGasAllowanceBudgetRequest) Reset()         { *m = QueryGasAllowanceBudgetRequest{} }
+func (m *QueryGasAllowanceBudgetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasAllowanceBudgetRequest) ProtoMessage()    {}
+func (*QueryGasAllowanceBudgetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{6}
+}
+func (m *QueryGasAllowanceBudgetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasAllowanceBudgetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasAllowanceBudgetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasAllowanceBudgetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasAllowanceBudgetRequest.Merge(m, src)
+}
+func (m *QueryGasAllowanceBudgetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasAllowanceBudgetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasAllowanceBudgetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasAllowanceBudgetRequest proto.InternalMessageInfo
+
+func (m *QueryGasAllowanceBudgetRequest) GetGranter() string {
+	if m != nil {
+		return m.Granter
+	}
+	return ""
+}
+
+func (m *QueryGasAllowanceBudgetRequest) GetGrantee() string {
+	if m != nil {
+		return m.Grantee
+	}
+	return ""
+}
+
+// QueryGasAllowanceBudgetResponse is the response type for the Query/GasAllowanceBudget RPC method.
+type QueryGasAllowanceBudgetResponse struct {
+	// msg_gas_limits are the gas budgets of the allowed message types in the current period.
+	MsgGasLimits []MsgGasLimit `protobuf:"bytes,1,rep,name=msg_gas_limits,json=msgGasLimits,proto3" json:"msg_gas_limits"`
+	// period_reset is the time at which the current period ends.
+	PeriodReset time.Time `protobuf:"bytes,2,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+	// granter_grantees is the number of distinct grantees the granter sponsors with gas allowances.
+	GranterGrantees uint64 `protobuf:"varint,3,opt,name=granter_grantees,json=granterGrantees,proto3" json:"granter_grantees,omitempty"`
+}
+
+func (m *QueryGasAllowanceBudgetResponse) Reset()         { *m = QueryGasAllowanceBudgetResponse{} }
+func (m *QueryGasAllowanceBudgetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasAllowanceBudgetResponse) ProtoMessage()    {}
+func (*QueryGasAllowanceBudgetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59efc303945de53f, []int{7}
+}
+func (m *QueryGasAllowanceBudgetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasAllowanceBudgetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasAllowanceBudgetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasAllowanceBudgetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasAllowanceBudgetResponse.Merge(m, src)
+}
+func (m *QueryGasAllowanceBudgetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasAllowanceBudgetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasAllowanceBudgetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasAllowanceBudgetResponse proto.InternalMessageInfo
+
+func (m *QueryGasAllowanceBudgetResponse) GetMsgGasLimits() []MsgGasLimit {
+	if m != nil {
+		return m.MsgGasLimits
+	}
+	return nil
+}
+
+func (m *QueryGasAllowanceBudgetResponse) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
+func (m *QueryGasAllowanceBudgetResponse) GetGranterGrantees() uint64 {
+	if m != nil {
+		return m.GranterGrantees
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryAllowanceRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceRequest")
 	proto.RegisterType((*QueryAllowanceResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowanceResponse")
@@ -356,6 +480,8 @@ func init() {
 	proto.RegisterType((*QueryAllowancesResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesResponse")
 	proto.RegisterType((*QueryAllowancesByGranterRequest)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterRequest")
 	proto.RegisterType((*QueryAllowancesByGranterResponse)(nil), "cosmos.feegrant.v1beta1.QueryAllowancesByGranterResponse")
+	proto.RegisterType((*QueryGasAllowanceBudgetRequest)(nil), "cosmos.feegrant.v1beta1.QueryGasAllowanceBudgetRequest")
+	proto.RegisterType((*QueryGasAllowanceBudgetResponse)(nil), "cosmos.feegrant.v1beta1.QueryGasAllowanceBudgetResponse")
 }
 
 func init() {
@@ -363,41 +489,54 @@ func init() {
 }
 
 var fileDescriptor_59efc303945de53f = []byte{
-	// 543 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x95, 0x4f, 0x6b, 0x13, 0x4f,
-	0x18, 0xc7, 0x33, 0xfd, 0xfd, 0x54, 0xf2, 0xf4, 0x20, 0x4c, 0xd5, 0xc6, 0x45, 0xd6, 0xb0, 0x42,
-	0xfd, 0x47, 0x66, 0x9a, 0xa8, 0xa5, 0x8a, 0x14, 0x1a, 0xc4, 0x5c, 0x35, 0x82, 0x07, 0x2f, 0x32,
-	0xe9, 0x3e, 0x2e, 0x4b, 0xe3, 0x4e, 0xba, 0xb3, 0x51, 0x8b, 0x14, 0xc1, 0x57, 0x20, 0xe8, 0x2b,
-	0xf0, 0xe0, 0xc9, 0x53, 0xe9, 0x0b, 0xf0, 0x28, 0x9e, 0x42, 0xbd, 0x78, 0x94, 0xc4, 0x17, 0x22,
-	0x99, 0x9d, 0xdd, 0x8d, 0x49, 0x96, 0x2e, 0xea, 0xc1, 0x5b, 0x26, 0xfb, 0xfd, 0x3e, 0xfb, 0xf9,
-	0x3e, 0xcf, 0xcc, 0x2c, 0x5c, 0xd8, 0x92, 0xea, 0xa9, 0x54, 0xfc, 0x09, 0xa2, 0x17, 0x8a, 0x20,
-	0xe2, 0xcf, 0xea, 0x1d, 0x8c, 0x44, 0x9d, 0xef, 0xf4, 0x31, 0xdc, 0x65, 0xbd, 0x50, 0x46, 0x92,
-	0x2e, 0xc7, 0x22, 0x96, 0x88, 0x98, 0x11, 0x59, 0x2b, 0x79, 0xee, 0x54, 0xa9, 0x0b, 0x58, 0x57,
-	0x8c, 0xae, 0x23, 0x14, 0xc6, 0x95, 0x53, 0x65, 0x4f, 0x78, 0x7e, 0x20, 0x22, 0x5f, 0x06, 0x46,
-	0x7b, 0xce, 0x93, 0xd2, 0xeb, 0x22, 0x17, 0x3d, 0x9f, 0x8b, 0x20, 0x90, 0x91, 0x7e, 0xa8, 0xcc,
-	0xd3, 0xb3, 0x71, 0xa5, 0xc7, 0x7a, 0xc5, 0x0d, 0x97, 0x5e, 0x38, 0xaf, 0xe0, 0xf4, 0xfd, 0x71,
-	0xe9, 0xcd, 0x6e, 0x57, 0x3e, 0x17, 0xc1, 0x16, 0xb6, 0x71, 0xa7, 0x8f, 0x2a, 0xa2, 0x0d, 0x38,
-	0xa1, 0x61, 0x30, 0xac, 0x90, 0x2a, 0xb9, 0x54, 0x6e, 0x56, 0x0e, 0x0f, 0x6a, 0xa7, 0x8c, 0x77,
-	0xd3, 0x75, 0x43, 0x54, 0xea, 0x41, 0x14, 0xfa, 0x81, 0xd7, 0x4e, 0x84, 0x99, 0x07, 0x2b, 0x0b,
-	0xc5, 0x3c, 0xe8, 0x3c, 0x84, 0x33, 0xd3, 0x00, 0xaa, 0x27, 0x03, 0x85, 0xf4, 0x36, 0x94, 0x45,
-	0xf2, 0xa7, 0x66, 0x58, 0x6c, 0xd8, 0x2c, 0xa7, 0xa9, 0xac, 0x35, 0x5e, 0xb5, 0x33, 0x83, 0xf3,
-	0x8e, 0x4c, 0x17, 0x56, 0x33, 0xd1, 0xb0, 0x68, 0x34, 0xa4, 0x77, 0x01, 0xb2, 0xa6, 0xeb, 0x74,
-	0x8b, 0x8d, 0x95, 0x84, 0x66, 0x3c, 0x21, 0x16, 0xcf, 0x3e, 0xe1, 0xb9, 0x27, 0xbc, 0xa4, 0x95,
-	0xed, 0x09, 0xa7, 0xf3, 0x9e, 0xc0, 0xf2, 0x0c, 0x96, 0x09, 0xbc, 0x01, 0x90, 0xf2, 0xab, 0x0a,
-	0xa9, 0xfe, 0x57, 0x20, 0xf1, 0x84, 0x83, 0xb6, 0xe6, 0x30, 0x5e, 0x3c, 0x92, 0x31, 0x7e, 0xf9,
-	0x2f, 0x90, 0xfb, 0x04, 0xce, 0x4f, 0x41, 0x36, 0x77, 0x5b, 0xf1, 0x90, 0xff, 0x64, 0x7f, 0xfc,
-	0xa5, 0x26, 0xde, 0x5a, 0x3a, 0x3c, 0xa8, 0x9d, 0x8c, 0x6d, 0x35, 0xe5, 0x6e, 0x57, 0x57, 0xd9,
-	0xf5, 0x35, 0xe7, 0x13, 0x81, 0x6a, 0x3e, 0xf4, 0x3f, 0xd6, 0xe2, 0xb9, 0x11, 0x1a, 0xfb, 0xff,
-	0xc3, 0x31, 0x1d, 0x81, 0x7e, 0x24, 0x50, 0x4e, 0x73, 0x50, 0x96, 0x4b, 0x38, 0xf7, 0xec, 0x5a,
-	0xbc, 0xb0, 0x3e, 0x26, 0x73, 0x36, 0x5e, 0x7f, 0xfd, 0xf1, 0x76, 0x61, 0x9d, 0xae, 0xf1, 0xbc,
-	0xbb, 0x29, 0xed, 0x01, 0x7f, 0x69, 0xa6, 0xb9, 0x97, 0xfc, 0xc2, 0x3d, 0xfa, 0x81, 0x00, 0x64,
-	0x6d, 0xa7, 0x45, 0xdf, 0x9f, 0x9c, 0x48, 0x6b, 0xb5, 0xb8, 0xc1, 0x10, 0xdf, 0xd0, 0xc4, 0x9c,
-	0xd6, 0x8e, 0x26, 0x56, 0x13, 0xa0, 0x03, 0x02, 0x4b, 0x73, 0xf6, 0x07, 0x5d, 0x2f, 0x0a, 0x30,
-	0x7d, 0x0e, 0xac, 0x9b, 0xbf, 0xe1, 0x34, 0x19, 0xee, 0x7c, 0x99, 0xdd, 0x03, 0x3a, 0xd6, 0x55,
-	0x7a, 0x39, 0x37, 0x96, 0xaf, 0x54, 0x1f, 0xdd, 0x6c, 0x0a, 0xcd, 0xfa, 0xe7, 0xa1, 0x4d, 0x06,
-	0x43, 0x9b, 0x7c, 0x1f, 0xda, 0xe4, 0xcd, 0xc8, 0x2e, 0x0d, 0x46, 0x76, 0xe9, 0xdb, 0xc8, 0x2e,
-	0x3d, 0x32, 0x5f, 0x20, 0xe5, 0x6e, 0x33, 0x5f, 0xf2, 0x17, 0x69, 0xad, 0xce, 0x71, 0x7d, 0xf7,
-	0x5f, 0xfb, 0x19, 0x00, 0x00, 0xff, 0xff, 0x84, 0x03, 0x50, 0xd8, 0xc8, 0x06, 0x00, 0x00,
+	// 738 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0x01, 0x44, 0x19, 0x88, 0x98, 0x01, 0x65, 0x6d, 0x4c, 0x77, 0x53, 0x0d, 0x82, 0x66,
+	0x5b, 0x58, 0xe5, 0x97, 0x31, 0x24, 0x6c, 0x8c, 0x7b, 0xd1, 0x04, 0xab, 0xf1, 0xe0, 0x65, 0x33,
+	0x4b, 0x87, 0xa6, 0x61, 0xb7, 0xb3, 0x74, 0xba, 0x2a, 0x31, 0xc4, 0xc4, 0xbf, 0x80, 0x44, 0xaf,
+	0x5e, 0x3c, 0x78, 0xf2, 0x64, 0xf8, 0x03, 0x3c, 0x12, 0x4e, 0x04, 0x2f, 0x26, 0x26, 0x6a, 0xc0,
+	0x3f, 0xc3, 0x83, 0xe9, 0xcc, 0xb4, 0xbb, 0xee, 0xb6, 0x52, 0x7f, 0xc4, 0x78, 0xa2, 0x7d, 0x7d,
+	0xdf, 0x9b, 0xef, 0xfb, 0xe6, 0xbd, 0xc7, 0xc2, 0xf3, 0x2b, 0x94, 0xd5, 0x29, 0x33, 0x56, 0x09,
+	0xb1, 0x3d, 0xec, 0xfa, 0xc6, 0xc3, 0xe9, 0x2a, 0xf1, 0xf1, 0xb4, 0xb1, 0xde, 0x24, 0xde, 0x86,
+	0xde, 0xf0, 0xa8, 0x4f, 0xd1, 0x98, 0x48, 0xd2, 0xc3, 0x24, 0x5d, 0x26, 0x29, 0xe3, 0x49, 0xe8,
+	0x28, 0x93, 0x17, 0x50, 0x2e, 0xc9, 0xbc, 0x2a, 0x66, 0x44, 0x54, 0x8e, 0x32, 0x1b, 0xd8, 0x76,
+	0x5c, 0xec, 0x3b, 0xd4, 0x95, 0xb9, 0xe7, 0x6c, 0x4a, 0xed, 0x1a, 0x31, 0x70, 0xc3, 0x31, 0xb0,
+	0xeb, 0x52, 0x9f, 0x7f, 0x64, 0xf2, 0xeb, 0x59, 0x51, 0xa9, 0xc2, 0xdf, 0x0c, 0xc9, 0x4b, 0x7c,
+	0x1a, 0xb5, 0xa9, 0x4d, 0x45, 0x3c, 0x78, 0x92, 0xd1, 0x9c, 0x2c, 0xc7, 0xdf, 0xaa, 0xcd, 0x55,
+	0xc3, 0x77, 0xea, 0x84, 0xf9, 0xb8, 0xde, 0x10, 0x09, 0xda, 0x53, 0x78, 0xfa, 0x4e, 0xc0, 0x68,
+	0xa9, 0x56, 0xa3, 0x8f, 0xb0, 0xbb, 0x42, 0x4c, 0xb2, 0xde, 0x24, 0xcc, 0x47, 0x45, 0x78, 0x9c,
+	0x6b, 0x20, 0x5e, 0x16, 0xe4, 0xc1, 0xc4, 0x40, 0x29, 0xbb, 0xbf, 0x5d, 0x18, 0x95, 0x47, 0x2e,
+	0x59, 0x96, 0x47, 0x18, 0xbb, 0xeb, 0x7b, 0x8e, 0x6b, 0x9b, 0x61, 0x62, 0x0b, 0x43, 0xb2, 0x3d,
+	0xe9, 0x30, 0x44, 0xbb, 0x0f, 0xcf, 0x74, 0x12, 0x60, 0x0d, 0xea, 0x32, 0x82, 0xae, 0xc3, 0x01,
+	0x1c, 0x06, 0x39, 0x87, 0xc1, 0xa2, 0xaa, 0x27, 0xdc, 0x85, 0x5e, 0x0e, 0xde, 0xcc, 0x16, 0x40,
+	0x7b, 0x01, 0x3a, 0x0b, 0xb3, 0x2e, 0x69, 0x24, 0xad, 0x34, 0x82, 0x6e, 0x42, 0xd8, 0xba, 0x2b,
+	0xae, 0x6e, 0xb0, 0x38, 0x1e, 0xb2, 0x09, 0x2e, 0x56, 0x17, 0x2d, 0x13, 0xf2, 0x59, 0xc6, 0x76,
+	0x68, 0xa5, 0xd9, 0x86, 0xd4, 0x5e, 0x01, 0x38, 0xd6, 0x45, 0x4b, 0x0a, 0x5e, 0x84, 0x30, 0xe2,
+	0xcf, 0xb2, 0x20, 0xdf, 0x9b, 0x42, 0x71, 0x1b, 0x02, 0x95, 0x63, 0x38, 0x5e, 0x3c, 0x92, 0xa3,
+	0x38, 0xfc, 0x07, 0x92, 0x6f, 0x01, 0xcc, 0x75, 0x90, 0x2c, 0x6d, 0x94, 0xc5, 0x25, 0xff, 0x49,
+	0x7f, 0xfc, 0x25, 0x13, 0xaf, 0x8d, 0xec, 0x6f, 0x17, 0x86, 0x05, 0xac, 0xc0, 0xac, 0xb5, 0xfc,
+	0x94, 0x7e, 0x75, 0x56, 0x7b, 0x07, 0x60, 0x3e, 0x99, 0xf4, 0x7f, 0x66, 0x71, 0xbc, 0x84, 0x97,
+	0x00, 0xaa, 0x5c, 0x42, 0x19, 0xb3, 0x48, 0x45, 0xa9, 0x69, 0xd9, 0xc4, 0xff, 0xc7, 0x63, 0x19,
+	0xc3, 0x6f, 0xa6, 0xa8, 0x7d, 0x0b, 0xfb, 0x22, 0x8e, 0x9f, 0x74, 0x78, 0x19, 0x9e, 0xac, 0x33,
+	0xbb, 0x62, 0x63, 0x56, 0xa9, 0x39, 0x75, 0xc7, 0x0f, 0x5d, 0xbe, 0x90, 0xe8, 0xf2, 0x6d, 0x66,
+	0x97, 0x31, 0xbb, 0x15, 0x24, 0x97, 0xfa, 0x76, 0x3e, 0xe5, 0x32, 0xe6, 0x50, 0xbd, 0x15, 0x0a,
+	0x3c, 0x1f, 0x6a, 0x10, 0xcf, 0xa1, 0x56, 0xc5, 0x23, 0x8c, 0xf8, 0xd2, 0x75, 0x45, 0x17, 0xab,
+	0x4d, 0x0f, 0x57, 0x9b, 0x7e, 0x2f, 0x5c, 0x6d, 0xa5, 0x13, 0x41, 0x95, 0xad, 0xcf, 0x39, 0x60,
+	0x0e, 0x0a, 0xa4, 0x19, 0x00, 0xd1, 0x24, 0x3c, 0x25, 0x2d, 0xa9, 0x88, 0xbf, 0x84, 0x65, 0x7b,
+	0xf3, 0x60, 0xa2, 0xcf, 0x1c, 0x96, 0xf1, 0xb2, 0x0c, 0xc7, 0xca, 0x2f, 0x6e, 0xf5, 0xc3, 0x63,
+	0x5c, 0x3e, 0x7a, 0x03, 0xe0, 0x40, 0x64, 0x00, 0xd2, 0x13, 0xa5, 0xc5, 0xae, 0x56, 0xc5, 0x48,
+	0x9d, 0x2f, 0x3c, 0xd5, 0x16, 0x9f, 0xbd, 0xff, 0xfa, 0xbc, 0x67, 0x1e, 0xcd, 0x1a, 0x49, 0xff,
+	0x71, 0xa2, 0x16, 0x35, 0x9e, 0x48, 0x29, 0x9b, 0xe1, 0x13, 0xd9, 0x44, 0xaf, 0x01, 0x84, 0xad,
+	0xa9, 0x40, 0x69, 0xcf, 0x0f, 0x17, 0xa6, 0x32, 0x95, 0x1e, 0x20, 0x19, 0xcf, 0x70, 0xc6, 0x06,
+	0x2a, 0x1c, 0xcd, 0x98, 0xb5, 0x11, 0xdd, 0x03, 0x70, 0x24, 0x66, 0x7c, 0xd1, 0x7c, 0x5a, 0x02,
+	0x9d, 0x6b, 0x4a, 0x59, 0xf8, 0x0d, 0xa4, 0xd4, 0x70, 0x63, 0xb7, 0x7b, 0x44, 0xb9, 0xac, 0xcb,
+	0x68, 0x32, 0x51, 0x96, 0xc3, 0x58, 0x93, 0x58, 0xad, 0x5b, 0x40, 0x1f, 0x01, 0x44, 0xdd, 0xe3,
+	0x82, 0xe6, 0x7e, 0xce, 0x2b, 0x71, 0x01, 0x28, 0xf3, 0xbf, 0x0e, 0x94, 0x7a, 0xcc, 0xdd, 0xee,
+	0x9e, 0xe6, 0x7a, 0x16, 0xd0, 0x5c, 0xa2, 0x9e, 0x60, 0x8e, 0xab, 0xbc, 0x4e, 0x5c, 0x67, 0x95,
+	0xa6, 0x77, 0x0e, 0x54, 0xb0, 0x77, 0xa0, 0x82, 0x2f, 0x07, 0x2a, 0xd8, 0x3a, 0x54, 0x33, 0x7b,
+	0x87, 0x6a, 0xe6, 0xc3, 0xa1, 0x9a, 0x79, 0x20, 0x7f, 0x35, 0x31, 0x6b, 0x4d, 0x77, 0xa8, 0xf1,
+	0x38, 0xaa, 0x5c, 0xed, 0xe7, 0x03, 0x7b, 0xe5, 0xfb, 0x00, 0x45, 0x8e, 0x24, 0xf4, 0x7c, 0x09,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -418,6 +557,9 @@ type QueryClient interface {
 	Allowances(ctx context.Context, in *QueryAllowancesRequest, opts ...grpc.CallOption) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(ctx context.Context, in *QueryAllowancesByGranterRequest, opts ...grpc.CallOption) (*QueryAllowancesByGranterResponse, error)
+	// GasAllowanceBudget returns the gas budgets left in the current period of
+	// the gas allowance granted to the grantee by the granter.
+	GasAllowanceBudget(ctx context.Context, in *QueryGasAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryGasAllowanceBudgetResponse, error)
 }
 
 type queryClient struct {
@@ -455,6 +597,15 @@ func (c *queryClient) AllowancesByGranter(ctx context.Context, in *QueryAllowanc
 	return out, nil
 }
 
+func (c *queryClient) GasAllowanceBudget(ctx context.Context, in *QueryGasAllowanceBudgetRequest, opts ...grpc.CallOption) (*QueryGasAllowanceBudgetResponse, error) {
+	out := new(QueryGasAllowanceBudgetResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.feegrant.v1beta1.Query/GasAllowanceBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Allowance returns granted allowance to the grantee by the granter.
@@ -463,6 +614,9 @@ type QueryServer interface {
 	Allowances(context.Context, *QueryAllowancesRequest) (*QueryAllowancesResponse, error)
 	// AllowancesByGranter returns all the grants given by an address
 	AllowancesByGranter(context.Context, *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error)
+	// GasAllowanceBudget returns the gas budgets left in the current period of
+	// the gas allowance granted to the grantee by the granter.
+	GasAllowanceBudget(context.Context, *QueryGasAllowanceBudgetRequest) (*QueryGasAllowanceBudgetResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -478,6 +632,9 @@ func (*UnimplementedQueryServer) Allowances(ctx context.Context, req *QueryAllow
 func (*UnimplementedQueryServer) AllowancesByGranter(ctx context.Context, req *QueryAllowancesByGranterRequest) (*QueryAllowancesByGranterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowancesByGranter not implemented")
 }
+func (*UnimplementedQueryServer) GasAllowanceBudget(ctx context.Context, req *QueryGasAllowanceBudgetRequest) (*QueryGasAllowanceBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasAllowanceBudget not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -537,6 +694,24 @@ func _Query_AllowancesByGranter_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasAllowanceBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasAllowanceBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasAllowanceBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.feegrant.v1beta1.Query/GasAllowanceBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasAllowanceBudget(ctx, req.(*QueryGasAllowanceBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.feegrant.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -553,6 +728,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllowancesByGranter",
 			Handler:    _Query_AllowancesByGranter_Handler,
 		},
+		{
+			MethodName: "GasAllowanceBudget",
+			Handler:    _Query_GasAllowanceBudget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/feegrant/v1beta1/query.proto",
@@ -812,6 +991,93 @@ func (m *QueryAllowancesByGranterResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGasAllowanceBudgetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasAllowanceBudgetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasAllowanceBudgetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantee) > 0 {
+		i -= len(m.Grantee)
+		copy(dAtA[i:], m.Grantee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Grantee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Granter) > 0 {
+		i -= len(m.Granter)
+		copy(dAtA[i:], m.Granter)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Granter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasAllowanceBudgetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasAllowanceBudgetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasAllowanceBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GranterGrantees != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GranterGrantees))
+		i--
+		dAtA[i] = 0x18
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgGasLimits) > 0 {
+		for iNdEx := len(m.MsgGasLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgGasLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -925,6 +1191,43 @@ func (m *QueryAllowancesByGranterResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasAllowanceBudgetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Granter)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Grantee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasAllowanceBudgetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgGasLimits) > 0 {
+		for _, e := range m.MsgGasLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GranterGrantees != 0 {
+		n += 1 + sovQuery(uint64(m.GranterGrantees))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1607,6 +1910,256 @@ func (m *QueryAllowancesByGranterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasAllowanceBudgetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasAllowanceBudgetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasAllowanceBudgetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grantee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasAllowanceBudgetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasAllowanceBudgetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasAllowanceBudgetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgGasLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgGasLimits = append(m.MsgGasLimits, MsgGasLimit{})
+			if err := m.MsgGasLimits[len(m.MsgGasLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GranterGrantees", wireType)
+			}
+			m.GranterGrantees = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GranterGrantees |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
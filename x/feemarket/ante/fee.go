@@ -0,0 +1,81 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	authante "cosmossdk.io/x/auth/ante"
+	"cosmossdk.io/x/feemarket/keeper"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTxFeeChecker returns a TxFeeChecker, to be given to the x/auth DeductFeeDecorator,
+// which enforces the base fee of the x/feemarket module.
+// The fee paid in the fee denom must cover the base fee times the gas limit of the tx,
+// the remainder being the tip which determines the tx priority. The base fee portion
+// of the fee is accounted to be burned or routed to the community pool at the end
+// of the block. The base fee of the next block is computed from the gas consumed by
+// the transactions, not from their gas limit. When the base fee is not enabled, the provided fallback is used.
+func NewTxFeeChecker(k keeper.Keeper, fallback authante.TxFeeChecker) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		// genesis transactions are not subject to the base fee.
+		if !params.Enabled || ctx.BlockHeight() == 0 {
+			if fallback != nil {
+				return fallback(ctx, tx)
+			}
+			return feeTx.GetFee(), 0, nil
+		}
+
+		baseFee, err := k.GetBaseFee(ctx)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		feeCoins := feeTx.GetFee()
+		gas := feeTx.GetGas()
+		required := baseFee.Mul(sdkmath.NewIntFromUint64(gas))
+		paid := feeCoins.AmountOf(params.FeeDenom)
+		if paid.LT(required) {
+			return nil, 0, errorsmod.Wrapf(
+				sdkerrors.ErrInsufficientFee,
+				"insufficient fees; got: %s required: %s",
+				feeCoins, sdk.NewCoin(params.FeeDenom, required),
+			)
+		}
+
+		if ctx.ExecMode() == sdk.ExecModeFinalize {
+			if err := k.RecordBaseFees(ctx, required); err != nil {
+				return nil, 0, err
+			}
+		}
+
+		return feeCoins, getTxPriority(paid.Sub(required), gas), nil
+	}
+}
+
+// getTxPriority returns the tx priority as the tip paid per unit of gas.
+func getTxPriority(tip sdkmath.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	tipPerGas := tip.Quo(sdkmath.NewIntFromUint64(gas))
+	if !tipPerGas.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return tipPerGas.Int64()
+}
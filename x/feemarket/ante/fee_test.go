@@ -0,0 +1,113 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/ante"
+	"cosmossdk.io/x/feemarket/keeper"
+	feemarkettestutil "cosmossdk.io/x/feemarket/testutil"
+	"cosmossdk.io/x/feemarket/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+type feeTx struct {
+	fee sdk.Coins
+	gas uint64
+}
+
+func (feeTx) GetMsgs() []sdk.Msg                                  { return nil }
+func (feeTx) GetReflectMessages() ([]protoreflect.Message, error) { return nil, nil }
+func (tx feeTx) GetGas() uint64                                   { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins                                { return tx.fee }
+func (feeTx) FeePayer() []byte                                    { return nil }
+func (feeTx) FeeGranter() []byte                                  { return nil }
+
+func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	env := runtime.NewEnvironment(runtime.NewKVStoreService(key), log.NewNopLogger())
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	ctrl := gomock.NewController(t)
+	accountKeeper := feemarkettestutil.NewMockAccountKeeper(ctrl)
+	accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+
+	k := keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		accountKeeper,
+		feemarkettestutil.NewMockBankKeeper(ctrl),
+		nil,
+		authtypes.FeeCollectorName,
+		authtypes.NewModuleAddress("gov").String(),
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+	require.NoError(t, k.BaseFee.Set(ctx, math.NewInt(10)))
+
+	return k, ctx.WithBlockHeight(1)
+}
+
+func TestTxFeeChecker(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	checker := ante.NewTxFeeChecker(k, nil)
+
+	// fee lower than the base fee times the gas limit
+	_, _, err := checker(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 999)), gas: 100})
+	require.ErrorContains(t, err, "insufficient fees")
+
+	// fee in another denom
+	_, _, err = checker(ctx, feeTx{fee: sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), gas: 100})
+	require.ErrorContains(t, err, "insufficient fees")
+
+	// the tip determines the priority
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500))
+	paid, priority, err := checker(ctx.WithExecMode(sdk.ExecModeCheck), feeTx{fee: fee, gas: 100})
+	require.NoError(t, err)
+	require.Equal(t, fee, paid)
+	require.Equal(t, int64(5), priority)
+
+	// the fee is only recorded when finalizing the block
+	has, err := k.BlockBaseFees.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, _, err = checker(ctx.WithExecMode(sdk.ExecModeFinalize), feeTx{fee: fee, gas: 100})
+	require.NoError(t, err)
+	blockBaseFees, err := k.BlockBaseFees.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(1000), blockBaseFees)
+}
+
+func TestTxFeeCheckerDisabled(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	params := types.DefaultParams()
+	params.Enabled = false
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	fallbackCalled := false
+	checker := ante.NewTxFeeChecker(k, func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		fallbackCalled = true
+		return nil, 7, nil
+	})
+
+	_, priority, err := checker(ctx, feeTx{gas: 100})
+	require.NoError(t, err)
+	require.True(t, fallbackCalled)
+	require.Equal(t, int64(7), priority)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker burns, or routes to the community pool, the base fee portion of the
+// fees collected in the block and computes the base fee of the next block from
+// the gas consumed by the transactions of the block.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	blockGas := blockGasConsumed(ctx)
+
+	blockBaseFees, err := k.BlockBaseFees.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		blockBaseFees = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+	if err := k.BlockBaseFees.Remove(ctx); err != nil {
+		return err
+	}
+
+	if err := k.settleBaseFees(ctx, params, blockBaseFees); err != nil {
+		return err
+	}
+
+	if !params.Enabled {
+		return nil
+	}
+
+	baseFee, err := k.GetBaseFee(ctx)
+	if err != nil {
+		return err
+	}
+
+	nextBaseFee := params.NextBaseFee(baseFee, blockGas)
+	if err := k.BaseFee.Set(ctx, nextBaseFee); err != nil {
+		return err
+	}
+
+	destination := types.AttributeKeyBurned
+	if params.RouteToCommunityPool {
+		destination = types.AttributeKeyRouted
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(
+		types.EventTypeBaseFee,
+		event.NewAttribute(types.AttributeKeyBaseFee, nextBaseFee.String()),
+		event.NewAttribute(types.AttributeKeyBlockGas, math.NewIntFromUint64(blockGas).String()),
+		event.NewAttribute(destination, sdk.NewCoin(params.FeeDenom, blockBaseFees).String()),
+	)
+}
+
+// settleBaseFees burns, or routes to the community pool, the base fees collected
+// by the fee collector.
+func (k Keeper) settleBaseFees(ctx context.Context, params types.Params, baseFees math.Int) error {
+	if !baseFees.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.FeeDenom, baseFees))
+	if params.RouteToCommunityPool {
+		if k.poolKeeper == nil {
+			return types.ErrNoCommunityPool
+		}
+		return k.poolKeeper.FundCommunityPool(ctx, coins, k.authKeeper.GetModuleAddress(k.feeCollectorName))
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+}
@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestEndBlocker() {
+	params, err := s.feemarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name        string
+		baseFee     math.Int
+		blockGas    uint64
+		expBaseFee  math.Int
+		routeToPool bool
+	}{
+		{
+			name:       "full block increases the base fee by 12.5%",
+			baseFee:    math.NewInt(1000),
+			blockGas:   2 * params.TargetBlockGas,
+			expBaseFee: math.NewInt(1125),
+		},
+		{
+			name:       "target block keeps the base fee",
+			baseFee:    math.NewInt(1000),
+			blockGas:   params.TargetBlockGas,
+			expBaseFee: math.NewInt(1000),
+		},
+		{
+			name:       "empty block decreases the base fee by 12.5%",
+			baseFee:    math.NewInt(1000),
+			blockGas:   0,
+			expBaseFee: math.NewInt(875),
+		},
+		{
+			name:       "base fee does not go below the min base fee",
+			baseFee:    math.NewInt(10),
+			blockGas:   0,
+			expBaseFee: math.NewInt(10),
+		},
+		{
+			name:        "base fees are routed to the community pool",
+			baseFee:     math.NewInt(1000),
+			blockGas:    params.TargetBlockGas,
+			expBaseFee:  math.NewInt(1000),
+			routeToPool: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// the block gas meter is charged with the gas consumed by the block txs
+			ctx := s.ctx.WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+			ctx.BlockGasMeter().ConsumeGas(tc.blockGas, "block gas")
+
+			params.RouteToCommunityPool = tc.routeToPool
+			s.Require().NoError(s.feemarketKeeper.Params.Set(ctx, params))
+			s.Require().NoError(s.feemarketKeeper.BaseFee.Set(ctx, tc.baseFee))
+
+			baseFees := tc.baseFee.Mul(math.NewIntFromUint64(tc.blockGas))
+			if tc.blockGas > 0 {
+				s.Require().NoError(s.feemarketKeeper.RecordBaseFees(ctx, baseFees))
+				coins := sdk.NewCoins(sdk.NewCoin(params.FeeDenom, baseFees))
+				if tc.routeToPool {
+					s.poolKeeper.EXPECT().FundCommunityPool(ctx, coins, feeCollectorAddr).Return(nil)
+				} else {
+					s.bankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, coins).Return(nil)
+					s.bankKeeper.EXPECT().BurnCoins(ctx, types.ModuleName, coins).Return(nil)
+				}
+			}
+
+			s.Require().NoError(s.feemarketKeeper.EndBlocker(ctx))
+
+			baseFee, err := s.feemarketKeeper.GetBaseFee(ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expBaseFee, baseFee)
+
+			has, err := s.feemarketKeeper.BlockBaseFees.Has(ctx)
+			s.Require().NoError(err)
+			s.Require().False(has)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestEndBlockerDisabled() {
+	params, err := s.feemarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	params.Enabled = false
+	s.Require().NoError(s.feemarketKeeper.Params.Set(s.ctx, params))
+	s.Require().NoError(s.feemarketKeeper.BaseFee.Set(s.ctx, math.NewInt(1000)))
+
+	s.Require().NoError(s.feemarketKeeper.EndBlocker(s.ctx))
+
+	baseFee, err := s.feemarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1000), baseFee)
+}
@@ -0,0 +1,22 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	params := types.DefaultParams()
+	params.MinBaseFee = math.NewInt(5)
+	genesisState := types.NewGenesisState(params, math.NewInt(777))
+
+	s.Require().NoError(s.feemarketKeeper.InitGenesis(s.ctx, genesisState))
+
+	baseFee, err := s.feemarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(777), baseFee)
+
+	exported, err := s.feemarketKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState, exported)
+}
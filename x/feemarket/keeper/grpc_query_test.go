@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestGRPCParams() {
+	params, err := s.queryServer.Params(s.ctx, &types.QueryParamsRequest{})
+	s.Require().NoError(err)
+	kparams, err := s.feemarketKeeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(kparams, params.Params)
+
+	baseFee, err := s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(kparams.FeeDenom, kparams.MinBaseFee), baseFee.BaseFee)
+
+	s.Require().NoError(s.feemarketKeeper.BaseFee.Set(s.ctx, math.NewInt(1234)))
+	baseFee, err = s.queryServer.BaseFee(s.ctx, &types.QueryBaseFeeRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoin(kparams.FeeDenom, math.NewInt(1234)), baseFee.BaseFee)
+}
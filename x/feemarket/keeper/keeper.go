@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	appmodule.Environment
+
+	cdc              codec.BinaryCodec
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	poolKeeper       types.PoolKeeper
+	feeCollectorName string
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// BaseFee is the base fee, per unit of gas, of the current block.
+	BaseFee collections.Item[math.Int]
+	// BlockBaseFees is the base fee portion of the fees paid in the current block.
+	BlockBaseFees collections.Item[math.Int]
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	env appmodule.Environment,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	pk types.PoolKeeper,
+	feeCollectorName string,
+	authority string,
+) Keeper {
+	// ensure feemarket module account is set
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(fmt.Sprintf("the x/%s module account has not been set", types.ModuleName))
+	}
+
+	sb := collections.NewSchemaBuilder(env.KVStoreService)
+	k := Keeper{
+		Environment:      env,
+		cdc:              cdc,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		poolKeeper:       pk,
+		feeCollectorName: feeCollectorName,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		BaseFee:          collections.NewItem(sb, types.BaseFeeKey, "base_fee", sdk.IntValue),
+		BlockBaseFees:    collections.NewItem(sb, types.BlockBaseFeesKey, "block_base_fees", sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+	return k
+}
+
+// GetAuthority returns the x/feemarket module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetBaseFee returns the base fee, per unit of gas, of the current block.
+// It defaults to the min base fee when it was never set.
+func (k Keeper) GetBaseFee(ctx context.Context) (math.Int, error) {
+	baseFee, err := k.BaseFee.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return math.Int{}, err
+		}
+		return params.MinBaseFee, nil
+	}
+
+	return baseFee, err
+}
+
+// RecordBaseFees accounts the base fee portion of the fees paid by a transaction
+// included in the current block.
+func (k Keeper) RecordBaseFees(ctx context.Context, baseFees math.Int) error {
+	blockBaseFees, err := k.BlockBaseFees.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		blockBaseFees = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+
+	return k.BlockBaseFees.Set(ctx, blockBaseFees.Add(baseFees))
+}
+
+// blockGasConsumed returns the gas consumed by the transactions of the current block.
+// The block gas meter is charged by baseapp with the gas consumed by each transaction,
+// once it has been executed, whether it succeeded or not.
+func blockGasConsumed(ctx context.Context) uint64 {
+	blockGasMeter := sdk.UnwrapSDKContext(ctx).BlockGasMeter()
+	if blockGasMeter == nil {
+		return 0
+	}
+
+	return blockGasMeter.GasConsumed()
+}
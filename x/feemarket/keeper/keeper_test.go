@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	authtypes "cosmossdk.io/x/auth/types"
+	"cosmossdk.io/x/feemarket"
+	"cosmossdk.io/x/feemarket/keeper"
+	feemarkettestutil "cosmossdk.io/x/feemarket/testutil"
+	"cosmossdk.io/x/feemarket/types"
+
+	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+)
+
+const govModuleNameStr = "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn"
+
+var feeCollectorAddr = sdk.AccAddress("fee_collector_______")
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	feemarketKeeper keeper.Keeper
+	ctx             sdk.Context
+	msgServer       types.MsgServer
+	queryServer     types.QueryServer
+	accountKeeper   *feemarkettestutil.MockAccountKeeper
+	bankKeeper      *feemarkettestutil.MockBankKeeper
+	poolKeeper      *feemarkettestutil.MockPoolKeeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	encCfg := moduletestutil.MakeTestEncodingConfig(codectestutil.CodecOptions{}, feemarket.AppModule{})
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(key)
+	env := runtime.NewEnvironment(storeService, log.NewNopLogger())
+	testCtx := testutil.DefaultContextWithDB(s.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	s.ctx = testCtx.Ctx.WithBlockHeight(1)
+
+	// gomock initializations
+	ctrl := gomock.NewController(s.T())
+	s.accountKeeper = feemarkettestutil.NewMockAccountKeeper(ctrl)
+	s.bankKeeper = feemarkettestutil.NewMockBankKeeper(ctrl)
+	s.poolKeeper = feemarkettestutil.NewMockPoolKeeper(ctrl)
+
+	s.accountKeeper.EXPECT().GetModuleAddress(types.ModuleName).Return(sdk.AccAddress{})
+	s.accountKeeper.EXPECT().GetModuleAddress(authtypes.FeeCollectorName).Return(feeCollectorAddr).AnyTimes()
+
+	s.feemarketKeeper = keeper.NewKeeper(
+		encCfg.Codec,
+		env,
+		s.accountKeeper,
+		s.bankKeeper,
+		s.poolKeeper,
+		authtypes.FeeCollectorName,
+		govModuleNameStr,
+	)
+
+	params := types.DefaultParams()
+	params.MinBaseFee = math.NewInt(10)
+	s.Require().NoError(s.feemarketKeeper.Params.Set(s.ctx, params))
+
+	s.msgServer = keeper.NewMsgServerImpl(s.feemarketKeeper)
+	s.queryServer = keeper.NewQueryServerImpl(s.feemarketKeeper)
+}
+
+func (s *KeeperTestSuite) TestGetBaseFee() {
+	baseFee, err := s.feemarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(10), baseFee)
+
+	s.Require().NoError(s.feemarketKeeper.BaseFee.Set(s.ctx, math.NewInt(42)))
+	baseFee, err = s.feemarketKeeper.GetBaseFee(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(42), baseFee)
+}
+
+func (s *KeeperTestSuite) TestRecordBaseFees() {
+	s.Require().NoError(s.feemarketKeeper.RecordBaseFees(s.ctx, math.NewInt(1000)))
+	s.Require().NoError(s.feemarketKeeper.RecordBaseFees(s.ctx, math.NewInt(500)))
+
+	blockBaseFees, err := s.feemarketKeeper.BlockBaseFees.Get(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1500), blockBaseFees)
+}
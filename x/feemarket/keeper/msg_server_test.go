@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateParams
+		expectErr bool
+	}{
+		{
+			name: "set invalid authority",
+			request: &types.MsgUpdateParams{
+				Authority: "foo",
+			},
+			expectErr: true,
+		},
+		{
+			name: "set invalid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feemarketKeeper.GetAuthority(),
+				Params: types.Params{
+					FeeDenom:                 "stake",
+					MinBaseFee:               math.NewInt(-1),
+					TargetBlockGas:           1,
+					BaseFeeChangeDenominator: 1,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.feemarketKeeper.GetAuthority(),
+				Params: types.Params{
+					Enabled:                  true,
+					FeeDenom:                 "stake",
+					MinBaseFee:               math.NewInt(100),
+					TargetBlockGas:           10_000_000,
+					BaseFeeChangeDenominator: 16,
+					RouteToCommunityPool:     true,
+				},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.UpdateParams(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				params, err := s.feemarketKeeper.Params.Get(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.request.Params, params)
+			}
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package types
+
+import "cosmossdk.io/errors"
+
+var ErrInvalidSigner = errors.Register(ModuleName, 1, "expected authority account as only signer for proposal message")
+
+var ErrNoCommunityPool = errors.Register(ModuleName, 2, "routing base fees to the community pool requires a pool keeper")
@@ -0,0 +1,11 @@
+package types
+
+// feemarket module event types
+const (
+	EventTypeBaseFee = "base_fee"
+
+	AttributeKeyBaseFee  = "base_fee"
+	AttributeKeyBlockGas = "block_gas"
+	AttributeKeyBurned   = "burned"
+	AttributeKeyRouted   = "routed_to_community_pool"
+)
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams returns Params instance with the given values.
+func NewParams(enabled bool, feeDenom string, minBaseFee math.Int, targetBlockGas, baseFeeChangeDenominator uint64, routeToCommunityPool bool) Params {
+	return Params{
+		Enabled:                  enabled,
+		FeeDenom:                 feeDenom,
+		MinBaseFee:               minBaseFee,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeDenominator: baseFeeChangeDenominator,
+		RouteToCommunityPool:     routeToCommunityPool,
+	}
+}
+
+// DefaultParams returns default x/feemarket module parameters.
+func DefaultParams() Params {
+	return Params{
+		Enabled:                  true,
+		FeeDenom:                 sdk.DefaultBondDenom,
+		MinBaseFee:               math.ZeroInt(),
+		TargetBlockGas:           15_000_000,
+		BaseFeeChangeDenominator: 8, // as in EIP-1559, the base fee changes by at most 12.5% per block
+		RouteToCommunityPool:     false,
+	}
+}
+
+// Validate does the sanity check on the params.
+func (p Params) Validate() error {
+	if strings.TrimSpace(p.FeeDenom) == "" {
+		return errors.New("fee denom cannot be blank")
+	}
+	if err := sdk.ValidateDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if p.MinBaseFee.IsNil() || p.MinBaseFee.IsNegative() {
+		return fmt.Errorf("min base fee cannot be nil or negative: %s", p.MinBaseFee)
+	}
+	if p.TargetBlockGas == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", p.TargetBlockGas)
+	}
+	if p.BaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("base fee change denominator must be positive: %d", p.BaseFeeChangeDenominator)
+	}
+
+	return nil
+}
+
+// NextBaseFee computes the base fee of the next block following EIP-1559, given the
+// base fee and the gas consumed by the current block:
+//   - if the block consumed exactly the target gas, the base fee is unchanged.
+//   - if it consumed more, the base fee increases by at least 1, proportionally to the
+//     excess of gas, and by at most baseFee/BaseFeeChangeDenominator.
+//   - if it consumed less, the base fee decreases proportionally to the missing gas,
+//     by at most baseFee/BaseFeeChangeDenominator.
+//
+// The resulting base fee is never lower than MinBaseFee.
+func (p Params) NextBaseFee(baseFee math.Int, blockGas uint64) math.Int {
+	target := math.NewIntFromUint64(p.TargetBlockGas)
+	denominator := math.NewIntFromUint64(p.BaseFeeChangeDenominator)
+	gas := math.NewIntFromUint64(blockGas)
+
+	next := baseFee
+	switch {
+	case gas.GT(target):
+		delta := baseFee.Mul(gas.Sub(target)).Quo(target).Quo(denominator)
+		next = baseFee.Add(math.MaxInt(delta, math.OneInt()))
+	case gas.LT(target):
+		delta := baseFee.Mul(target.Sub(gas)).Quo(target).Quo(denominator)
+		next = baseFee.Sub(delta)
+	}
+
+	return math.MaxInt(next, p.MinBaseFee)
+}
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.NoError(t, types.DefaultParams().Validate())
+
+	params := types.DefaultParams()
+	params.FeeDenom = ""
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MinBaseFee = math.NewInt(-1)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.TargetBlockGas = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.BaseFeeChangeDenominator = 0
+	require.Error(t, params.Validate())
+}
+
+func TestNextBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.TargetBlockGas = 1000
+	params.MinBaseFee = math.NewInt(5)
+
+	testCases := []struct {
+		name     string
+		baseFee  int64
+		blockGas uint64
+		expected int64
+	}{
+		{"target", 800, 1000, 800},
+		{"full block", 800, 2000, 900},
+		{"more than full block", 800, 4000, 1100},
+		{"half full block", 800, 1500, 850},
+		{"small increase is at least 1", 5, 1001, 6},
+		{"empty block", 800, 0, 700},
+		{"half empty block", 800, 500, 750},
+		{"min base fee", 5, 0, 5},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			next := params.NextBaseFee(math.NewInt(tc.baseFee), tc.blockGas)
+			require.Equal(t, math.NewInt(tc.expected), next)
+		})
+	}
+}
@@ -94,6 +94,39 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "constitution",
 					Short:     "Query the current chain constitution",
 				},
+				{
+					RpcMethod: "GovernanceDelegation",
+					Use:       "governance-delegation [delegator-addr]",
+					Short:     "Query the governor of a delegator",
+					Example:   fmt.Sprintf("%s query gov governance-delegation cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "delegator_address"},
+					},
+				},
+				{
+					RpcMethod: "Governor",
+					Use:       "governor [governor-addr]",
+					Short:     "Query a governor and the voting power it represents",
+					Example:   fmt.Sprintf("%s query gov governor cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "Governors",
+					Use:       "governors",
+					Short:     "Query all the governors and the voting power they represent",
+				},
+				{
+					RpcMethod: "SimulateProposal",
+					Use:       "simulate-proposal [messages]...",
+					Short:     "Simulate the execution of the messages of a proposal before submitting it",
+					Long:      "Simulate the execution of the messages of a proposal, as if the proposal passed, and return the result, events and error of each message. Each message is a proto-JSON-encoded sdk.Msg or a path to a JSON file containing it. No state is persisted.",
+					Example:   fmt.Sprintf(`%s query gov simulate-proposal '{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"cosmos1...","to_address":"cosmos1...","amount":[{"denom":"stake","amount":"10"}]}'`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "messages", Varargs: true},
+					},
+				},
 			},
 			EnhanceCustomCommand: true, // We still have manual commands in gov that we want to keep
 		},
@@ -134,6 +167,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						"metadata": {Name: "metadata", Usage: "Add a description to the vote"},
 					},
 				},
+				{
+					RpcMethod: "DelegateGovernance",
+					Use:       "delegate-governance [governor-addr]",
+					Short:     "Delegate your governance voting power to a governor",
+					Long:      "Delegate your governance voting power to a governor. The governor votes on your behalf unless you vote directly on a proposal.",
+					Example:   fmt.Sprintf("$ %s tx gov delegate-governance cosmos1... --from mykey", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "governor_address"},
+					},
+				},
+				{
+					RpcMethod: "UndelegateGovernance",
+					Use:       "undelegate-governance",
+					Short:     "Remove the delegation of your governance voting power",
+					Example:   fmt.Sprintf("$ %s tx gov undelegate-governance --from mykey", version.AppName),
+				},
 				{
 					RpcMethod:      "UpdateParams",
 					Use:            "update-params-proposal [params]",
@@ -30,6 +30,9 @@ const (
 	FlagSummary   = "summary"
 	FlagExpedited = "expedited"
 
+	// FlagSkipSimulation skips the simulation of the proposal messages on submission.
+	FlagSkipSimulation = "skip-simulation"
+
 	// Deprecated: only used for v1beta1 legacy proposals.
 	FlagProposal = "proposal"
 	// Deprecated: only used for v1beta1 legacy proposals.
@@ -114,6 +117,10 @@ Where proposal.json contains:
   "proposal_type": "standard",
 }
 
+The messages are simulated against the current state of the chain before the
+proposal is submitted, and the submission is aborted if a message fails. Use
+--%s to submit the proposal without simulating its messages.
+
 metadata example: 
 {
 	"title": "",
@@ -124,7 +131,7 @@ metadata example:
 	"vote_option_context": "",
 }
 `,
-				version.AppName,
+				version.AppName, FlagSkipSimulation,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -138,6 +145,17 @@ metadata example:
 				return err
 			}
 
+			skipSimulation, err := cmd.Flags().GetBool(FlagSkipSimulation)
+			if err != nil {
+				return err
+			}
+
+			if !skipSimulation && !clientCtx.Offline {
+				if err := simulateProposal(cmd, clientCtx, msgs); err != nil {
+					return err
+				}
+			}
+
 			addr, err := clientCtx.AddressCodec.BytesToString(clientCtx.GetFromAddress())
 			if err != nil {
 				return err
@@ -152,11 +170,37 @@ metadata example:
 		},
 	}
 
+	cmd.Flags().Bool(FlagSkipSimulation, false, "Submit the proposal without simulating its messages")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// simulateProposal simulates the execution of the proposal messages and returns an
+// error if a message fails.
+func simulateProposal(cmd *cobra.Command, clientCtx client.Context, msgs []sdk.Msg) error {
+	req, err := v1.NewQuerySimulateProposalRequest(msgs)
+	if err != nil {
+		return err
+	}
+
+	res, err := v1.NewQueryClient(clientCtx).SimulateProposal(cmd.Context(), req)
+	if err != nil {
+		return fmt.Errorf("failed to simulate proposal: %w", err)
+	}
+
+	if !res.Success {
+		for i, result := range res.Results {
+			if result.Error != "" {
+				return fmt.Errorf("proposal simulation failed on message %d (%s): %s; use --%s to submit it anyway", i, result.MsgTypeUrl, result.Error, FlagSkipSimulation)
+			}
+		}
+		return fmt.Errorf("proposal simulation failed; use --%s to submit it anyway", FlagSkipSimulation)
+	}
+
+	return nil
+}
+
 // NewCmdSubmitLegacyProposal implements submitting a proposal transaction command.
 // Deprecated: please use NewCmdSubmitProposal instead.
 func NewCmdSubmitLegacyProposal() *cobra.Command {
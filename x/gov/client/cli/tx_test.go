@@ -136,9 +136,19 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 	validPropFile := testutil.WriteToNewTempFile(s.T(), validProp)
 	defer validPropFile.Close()
 
+	execLegacyContentURL := sdk.MsgTypeURL(&v1.MsgExecLegacyContent{})
+	validArgs := []string{
+		validPropFile.Name(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val0StrAddr),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
+	}
+
 	testCases := []struct {
 		name         string
 		args         []string
+		simRes       *v1.QuerySimulateProposalResponse
 		expectErrMsg string
 	}{
 		{
@@ -148,17 +158,25 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
 			},
+			nil,
 			"invalid character in coin string",
 		},
 		{
 			"valid proposal",
-			[]string{
-				validPropFile.Name(),
-				fmt.Sprintf("--%s=%s", flags.FlagFrom, val0StrAddr),
-				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
-				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
-				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewInt(10))).String()),
-			},
+			validArgs,
+			&v1.QuerySimulateProposalResponse{Success: true, Results: []v1.ProposalMsgResult{{MsgTypeUrl: execLegacyContentURL}}},
+			"",
+		},
+		{
+			"failing proposal simulation",
+			validArgs,
+			&v1.QuerySimulateProposalResponse{Results: []v1.ProposalMsgResult{{MsgTypeUrl: execLegacyContentURL, Error: "failed to execute legacy content"}}},
+			"proposal simulation failed on message 0 (/cosmos.gov.v1.MsgExecLegacyContent): failed to execute legacy content",
+		},
+		{
+			"failing proposal simulation skipped",
+			append([]string{fmt.Sprintf("--%s=true", cli.FlagSkipSimulation)}, validArgs...),
+			&v1.QuerySimulateProposalResponse{Results: []v1.ProposalMsgResult{{MsgTypeUrl: execLegacyContentURL, Error: "failed to execute legacy content"}}},
 			"",
 		},
 	}
@@ -169,7 +187,14 @@ func (s *CLITestSuite) TestNewCmdSubmitProposal() {
 		s.Run(tc.name, func() {
 			cmd := cli.NewCmdSubmitProposal()
 
-			out, err := clitestutil.ExecTestCLICmd(s.clientCtx, cmd, tc.args)
+			clientCtx := s.clientCtx
+			if tc.simRes != nil {
+				bz, err := s.encCfg.Codec.Marshal(tc.simRes)
+				s.Require().NoError(err)
+				clientCtx = s.baseCtx.WithClient(clitestutil.NewMockCometRPC(abci.QueryResponse{Value: bz}))
+			}
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
 			if tc.expectErrMsg != "" {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.expectErrMsg)
@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/x/gov/keeper"
 	govtypes "cosmossdk.io/x/gov/types"
 	"cosmossdk.io/x/gov/types/v1beta1"
+	staking "cosmossdk.io/x/staking/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -54,6 +55,7 @@ type ModuleOutputs struct {
 	Module       appmodule.AppModule
 	Keeper       *keeper.Keeper
 	HandlerRoute v1beta1.HandlerRoute
+	StakingHooks staking.StakingHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -67,6 +69,12 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	if in.Config.MaxSummaryLen != 0 {
 		defaultConfig.MaxSummaryLen = in.Config.MaxSummaryLen
 	}
+	if in.Config.MaxSimulateProposalMsgs != 0 {
+		defaultConfig.MaxSimulateProposalMsgs = in.Config.MaxSimulateProposalMsgs
+	}
+	if in.Config.SimulateProposalGasLimit != 0 {
+		defaultConfig.SimulateProposalGasLimit = in.Config.SimulateProposalGasLimit
+	}
 	if in.LegacyProposalHandler == nil {
 		in.LegacyProposalHandler = []govclient.ProposalHandler{}
 	}
@@ -94,7 +102,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper, in.PoolKeeper, in.LegacyProposalHandler...)
 	hr := v1beta1.HandlerRoute{Handler: v1beta1.ProposalHandler, RouteKey: govtypes.RouterKey}
 
-	return ModuleOutputs{Module: m, Keeper: k, HandlerRoute: hr}
+	return ModuleOutputs{Module: m, Keeper: k, HandlerRoute: hr, StakingHooks: staking.StakingHooksWrapper{StakingHooks: k.StakingHooks()}}
 }
 
 func InvokeAddRoutes(keeper *keeper.Keeper, routes []v1beta1.HandlerRoute) {
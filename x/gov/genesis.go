@@ -73,6 +73,20 @@ func InitGenesis(ctx context.Context, ak types.AccountKeeper, bk types.BankKeepe
 		}
 	}
 
+	for _, delegation := range data.GovernanceDelegations {
+		delegator, err := ak.AddressCodec().StringToBytes(delegation.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		governor, err := ak.AddressCodec().StringToBytes(delegation.GovernorAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.DelegateVotingPower(ctx, delegator, governor); err != nil {
+			return err
+		}
+	}
+
 	// if account has zero balance it probably means it's not set, so we set it
 	balance := bk.GetAllBalances(ctx, moduleAcc.GetAddress())
 	if balance.IsZero() {
@@ -131,12 +145,19 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) (*v1.GenesisState, err
 		return nil, err
 	}
 
+	// export governance delegations
+	governanceDelegations, err := k.GetAllGovernanceDelegations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &v1.GenesisState{
-		StartingProposalId: startingProposalID,
-		Deposits:           proposalsDeposits,
-		Votes:              proposalsVotes,
-		Proposals:          proposals,
-		Params:             &params,
-		Constitution:       constitution,
+		StartingProposalId:    startingProposalID,
+		Deposits:              proposalsDeposits,
+		Votes:                 proposalsVotes,
+		Proposals:             proposals,
+		Params:                &params,
+		Constitution:          constitution,
+		GovernanceDelegations: governanceDelegations,
 	}, nil
 }
@@ -30,6 +30,10 @@ type Config struct {
 	// This only applies to WeightedVoteOption messages and not to the VoteOption messages
 	// 0 means this param is disabled, hence all supported options are allowed
 	MaxVoteOptionsLen uint64
+	// MaxSimulateProposalMsgs defines the maximum number of messages a proposal simulation can execute
+	MaxSimulateProposalMsgs uint64
+	// SimulateProposalGasLimit defines the gas limit of the execution of the messages of a proposal simulation
+	SimulateProposalGasLimit uint64
 	// CalculateVoteResultsAndVotingPowerFn is a function signature for calculating vote results and voting power
 	// Keeping it nil will use the default implementation
 	CalculateVoteResultsAndVotingPowerFn CalculateVoteResultsAndVotingPowerFn
@@ -42,6 +46,8 @@ func DefaultConfig() Config {
 		MaxMetadataLen:                       255,
 		MaxSummaryLen:                        10200,
 		MaxVoteOptionsLen:                    0, // 0 means this param is disabled, hence all supported options are allowed
+		MaxSimulateProposalMsgs:              100,
+		SimulateProposalGasLimit:             10_000_000,
 		CalculateVoteResultsAndVotingPowerFn: nil,
 	}
 }
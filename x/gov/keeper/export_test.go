@@ -16,3 +16,9 @@ func (k Keeper) ValidateInitialDeposit(ctx sdk.Context, initialDeposit sdk.Coins
 
 	return k.validateInitialDeposit(params, initialDeposit, proposalType)
 }
+
+// SetSimulateProposalGasLimit is a helper function used only in query tests to lower
+// the gas limit of the proposal simulations.
+func (k *Keeper) SetSimulateProposalGasLimit(gasLimit uint64) {
+	k.config.SimulateProposalGasLimit = gasLimit
+}
@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/event"
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DelegateVotingPower delegates the governance voting power of a delegator to a governor.
+// A previous governance delegation of the delegator is replaced.
+func (k Keeper) DelegateVotingPower(ctx context.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	if delegatorAddr.Equals(governorAddr) {
+		return errors.Wrap(types.ErrInvalidGovernor, "cannot delegate governance voting power to self")
+	}
+
+	if err := k.removeGovernanceDelegation(ctx, delegatorAddr); err != nil && !stderrors.Is(err, types.ErrNoGovernanceDelegation) {
+		return err
+	}
+
+	if err := k.setGovernanceDelegation(ctx, delegatorAddr, governorAddr); err != nil {
+		return err
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	governorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeDelegateGovernance,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+		event.NewAttribute(types.AttributeKeyGovernor, governorStrAddr),
+	)
+}
+
+// UndelegateVotingPower removes the governance delegation of a delegator.
+func (k Keeper) UndelegateVotingPower(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	if err := k.removeGovernanceDelegation(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.EventService.EventManager(ctx).EmitKV(types.EventTypeUndelegateGovernance,
+		event.NewAttribute(types.AttributeKeyDelegator, delegatorStrAddr),
+	)
+}
+
+// setGovernanceDelegation stores the governance delegation and the indexes of the governor.
+func (k Keeper) setGovernanceDelegation(ctx context.Context, delegatorAddr, governorAddr sdk.AccAddress) error {
+	if err := k.GovernanceDelegations.Set(ctx, delegatorAddr, governorAddr); err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegators.Set(ctx, collections.Join(governorAddr, delegatorAddr)); err != nil {
+		return err
+	}
+
+	count, err := k.Governors.Get(ctx, governorAddr)
+	if err != nil && !stderrors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+
+	if err := k.Governors.Set(ctx, governorAddr, count+1); err != nil {
+		return err
+	}
+
+	return k.addGovernorDelegatorShares(ctx, governorAddr, delegatorAddr, false)
+}
+
+// removeGovernanceDelegation removes the governance delegation and the indexes of the governor.
+// The governor is removed once it has no delegators left.
+func (k Keeper) removeGovernanceDelegation(ctx context.Context, delegatorAddr sdk.AccAddress) error {
+	governorAddr, err := k.GovernanceDelegations.Get(ctx, delegatorAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return errors.Wrapf(types.ErrNoGovernanceDelegation, "delegator %s", delegatorAddr)
+		}
+
+		return err
+	}
+
+	if err := k.GovernanceDelegations.Remove(ctx, delegatorAddr); err != nil {
+		return err
+	}
+
+	if err := k.GovernorDelegators.Remove(ctx, collections.Join(sdk.AccAddress(governorAddr), delegatorAddr)); err != nil {
+		return err
+	}
+
+	if err := k.addGovernorDelegatorShares(ctx, governorAddr, delegatorAddr, true); err != nil {
+		return err
+	}
+
+	count, err := k.Governors.Get(ctx, governorAddr)
+	if err != nil {
+		return err
+	}
+
+	if count <= 1 {
+		return k.Governors.Remove(ctx, governorAddr)
+	}
+
+	return k.Governors.Set(ctx, governorAddr, count-1)
+}
+
+// addGovernorDelegatorShares adds the delegation shares of the delegator to the shares
+// of the governor, or subtracts them when sub is true.
+func (k Keeper) addGovernorDelegatorShares(ctx context.Context, governorAddr, delegatorAddr sdk.AccAddress, sub bool) error {
+	var iterErr error
+	err := k.sk.IterateDelegations(ctx, delegatorAddr, func(_ int64, delegation sdk.DelegationI) (stop bool) {
+		valAddr, err := k.sk.ValidatorAddressCodec().StringToBytes(delegation.GetValidatorAddr())
+		if err != nil {
+			iterErr = err
+			return true
+		}
+
+		shares := delegation.GetShares()
+		if sub {
+			shares = shares.Neg()
+		}
+
+		iterErr = k.addGovernorValShares(ctx, governorAddr, valAddr, shares)
+		return iterErr != nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return iterErr
+}
+
+// addGovernorValShares adds shares to the delegation shares of the governor delegators
+// to a validator. The entry is removed once no shares are left.
+func (k Keeper) addGovernorValShares(ctx context.Context, governorAddr sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	key := collections.Join(governorAddr, valAddr)
+	current, err := k.GovernorValShares.Get(ctx, key)
+	if err != nil {
+		if !stderrors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		current = math.LegacyZeroDec()
+	}
+
+	current = current.Add(shares)
+	if !current.IsPositive() {
+		return k.GovernorValShares.Remove(ctx, key)
+	}
+
+	return k.GovernorValShares.Set(ctx, key, current)
+}
+
+// GetAllGovernanceDelegations returns all the governance delegations.
+func (k Keeper) GetAllGovernanceDelegations(ctx context.Context) ([]*v1.GovernanceDelegation, error) {
+	var delegations []*v1.GovernanceDelegation
+	err := k.GovernanceDelegations.Walk(ctx, nil, func(delegatorAddr sdk.AccAddress, governorAddr []byte) (bool, error) {
+		delegatorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(delegatorAddr)
+		if err != nil {
+			return true, err
+		}
+
+		governorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+		if err != nil {
+			return true, err
+		}
+
+		delegations = append(delegations, &v1.GovernanceDelegation{
+			DelegatorAddress: delegatorStrAddr,
+			GovernorAddress:  governorStrAddr,
+		})
+		return false, nil
+	})
+
+	return delegations, err
+}
+
+// GetGovernor returns the governor with its number of delegators and the voting power it represents.
+func (k Keeper) GetGovernor(ctx context.Context, governorAddr sdk.AccAddress) (v1.Governor, error) {
+	count, err := k.Governors.Get(ctx, governorAddr)
+	if err != nil {
+		return v1.Governor{}, err
+	}
+
+	validators, err := k.getCurrentValidators(ctx)
+	if err != nil {
+		return v1.Governor{}, err
+	}
+
+	power := math.LegacyZeroDec()
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](governorAddr)
+	if err := k.GovernorValShares.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], shares math.LegacyDec) (bool, error) {
+		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		if val, ok := validators[valAddrStr]; ok {
+			// delegation shares * bonded / total shares
+			power = power.Add(shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+		}
+		return false, nil
+	}); err != nil {
+		return v1.Governor{}, err
+	}
+
+	governorStrAddr, err := k.authKeeper.AddressCodec().BytesToString(governorAddr)
+	if err != nil {
+		return v1.Governor{}, err
+	}
+
+	return v1.Governor{
+		GovernorAddress:  governorStrAddr,
+		Delegators:       count,
+		RepresentedPower: power.String(),
+	}, nil
+}
@@ -248,6 +248,105 @@ func (q queryServer) MessageBasedParams(ctx context.Context, req *v1.QueryMessag
 	return nil, status.Error(codes.Internal, err.Error())
 }
 
+// GovernanceDelegation queries the governor of a delegator.
+func (q queryServer) GovernanceDelegation(ctx context.Context, req *v1.QueryGovernanceDelegationRequest) (*v1.QueryGovernanceDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.DelegatorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty delegator address")
+	}
+
+	delegator, err := q.k.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	governor, err := q.k.GovernanceDelegations.Get(ctx, delegator)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governance delegation of %s not found", req.DelegatorAddress)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	governorStrAddr, err := q.k.authKeeper.AddressCodec().BytesToString(governor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.QueryGovernanceDelegationResponse{GovernorAddress: governorStrAddr}, nil
+}
+
+// Governor queries a governor and the voting power it represents.
+func (q queryServer) Governor(ctx context.Context, req *v1.QueryGovernorRequest) (*v1.QueryGovernorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.GovernorAddress == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty governor address")
+	}
+
+	governorAddr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.GovernorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	governor, err := q.k.GetGovernor(ctx, governorAddr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "governor %s not found", req.GovernorAddress)
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorResponse{Governor: &governor}, nil
+}
+
+// Governors queries all the governors and the voting power they represent.
+func (q queryServer) Governors(ctx context.Context, req *v1.QueryGovernorsRequest) (*v1.QueryGovernorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	governors, pageRes, err := query.CollectionPaginate(ctx, q.k.Governors, req.Pagination, func(governorAddr sdk.AccAddress, _ uint64) (*v1.Governor, error) {
+		governor, err := q.k.GetGovernor(ctx, governorAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		return &governor, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v1.QueryGovernorsResponse{Governors: governors, Pagination: pageRes}, nil
+}
+
+// SimulateProposal executes the messages of a proposal in a branched state and returns their results.
+func (q queryServer) SimulateProposal(ctx context.Context, req *v1.QuerySimulateProposalRequest) (*v1.QuerySimulateProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	success, results, err := q.k.SimulateProposal(ctx, msgs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &v1.QuerySimulateProposalResponse{Success: success, Results: results}, nil
+}
+
 // Deposit queries single deposit information based on proposalID, depositAddr.
 func (q queryServer) Deposit(ctx context.Context, req *v1.QueryDepositRequest) (*v1.QueryDepositResponse, error) {
 	if req == nil {
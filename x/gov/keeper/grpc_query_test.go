@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/gov/keeper"
 	v3 "cosmossdk.io/x/gov/migrations/v3"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -2041,3 +2042,133 @@ func (suite *KeeperTestSuite) TestProposalVoteOptions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryGovernors() {
+	suite.reset()
+	ctx, queryClient, addrs := suite.ctx, suite.queryClient, suite.addrs
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[0])
+	suite.Require().NoError(err)
+	addr2Str, err := suite.acctKeeper.AddressCodec().BytesToString(addrs[2])
+	suite.Require().NoError(err)
+
+	_, err = queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addr0Str})
+	suite.Require().ErrorContains(err, "not found")
+	_, err = queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: addr2Str})
+	suite.Require().ErrorContains(err, "not found")
+
+	suite.Require().NoError(suite.govKeeper.DelegateVotingPower(ctx, addrs[0], addrs[2]))
+	suite.Require().NoError(suite.govKeeper.DelegateVotingPower(ctx, addrs[1], addrs[2]))
+
+	delegation, err := queryClient.GovernanceDelegation(gocontext.Background(), &v1.QueryGovernanceDelegationRequest{DelegatorAddress: addr0Str})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2Str, delegation.GovernorAddress)
+
+	governor, err := queryClient.Governor(gocontext.Background(), &v1.QueryGovernorRequest{GovernorAddress: addr2Str})
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2Str, governor.Governor.GovernorAddress)
+	suite.Require().Equal(uint64(2), governor.Governor.Delegators)
+
+	governors, err := queryClient.Governors(gocontext.Background(), &v1.QueryGovernorsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(governors.Governors, 1)
+	suite.Require().Equal(*governor.Governor, *governors.Governors[0])
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuerySimulateProposal() {
+	suite.reset()
+	ctx, queryClient := suite.ctx, suite.queryClient
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+
+	newParams := v1.DefaultParams()
+	newParams.Quorum = "0.5"
+	invalidParams := v1.DefaultParams()
+	invalidParams.Quorum = "2"
+
+	tooManyMsgs := make([]sdk.Msg, keeper.DefaultConfig().MaxSimulateProposalMsgs+1)
+	for i := range tooManyMsgs {
+		tooManyMsgs[i] = &v1.MsgUpdateParams{Authority: suite.govKeeper.GetAuthority(), Params: newParams}
+	}
+
+	testCases := []struct {
+		msg        string
+		msgs       []sdk.Msg
+		gasLimit   uint64
+		expErrMsg  string
+		expSuccess bool
+		expResults []string // expected error of each executed message
+	}{
+		{
+			msg:       "no messages",
+			msgs:      []sdk.Msg{},
+			expErrMsg: "no messages proposed",
+		},
+		{
+			msg:       "too many messages",
+			msgs:      tooManyMsgs,
+			expErrMsg: "too many messages to simulate: got 101, maximum 100",
+		},
+		{
+			msg:        "invalid signer",
+			msgs:       []sdk.Msg{&v1.MsgUpdateParams{Authority: addr0Str, Params: newParams}},
+			expResults: []string{"expected authority account as only signer for proposal message"},
+		},
+		{
+			msg: "failing message stops the execution",
+			msgs: []sdk.Msg{
+				&v1.MsgUpdateParams{Authority: suite.govKeeper.GetAuthority(), Params: invalidParams},
+				&v1.MsgUpdateParams{Authority: suite.govKeeper.GetAuthority(), Params: newParams},
+			},
+			expResults: []string{"quorum too large"},
+		},
+		{
+			msg:        "out of gas",
+			msgs:       []sdk.Msg{&v1.MsgUpdateParams{Authority: suite.govKeeper.GetAuthority(), Params: newParams}},
+			gasLimit:   1000,
+			expResults: []string{"simulation gas limit of 1000 exceeded: out of gas"},
+		},
+		{
+			msg:        "valid",
+			msgs:       []sdk.Msg{&v1.MsgUpdateParams{Authority: suite.govKeeper.GetAuthority(), Params: newParams}},
+			expSuccess: true,
+			expResults: []string{""},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			gasLimit := keeper.DefaultConfig().SimulateProposalGasLimit
+			if tc.gasLimit != 0 {
+				gasLimit = tc.gasLimit
+			}
+			suite.govKeeper.SetSimulateProposalGasLimit(gasLimit)
+
+			req, err := v1.NewQuerySimulateProposalRequest(tc.msgs)
+			suite.Require().NoError(err)
+
+			res, err := queryClient.SimulateProposal(gocontext.Background(), req)
+			if tc.expErrMsg != "" {
+				suite.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expSuccess, res.Success)
+			suite.Require().Len(res.Results, len(tc.expResults))
+			for i, expErr := range tc.expResults {
+				suite.Require().Equal(sdk.MsgTypeURL(tc.msgs[i]), res.Results[i].MsgTypeUrl)
+				if expErr == "" {
+					suite.Require().Empty(res.Results[i].Error)
+					suite.Require().NotNil(res.Results[i].MsgResponse)
+				} else {
+					suite.Require().Contains(res.Results[i].Error, expErr)
+				}
+			}
+
+			// the simulation does not persist any state change, the quorum is not updated
+			params, err := suite.govKeeper.Params.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(v1.DefaultParams(), params)
+			suite.Require().NotEqual(newParams.Quorum, params.Quorum)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/appmodule"
+	"cosmossdk.io/math"
 	"cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
@@ -65,6 +66,14 @@ type Keeper struct {
 	ActiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
 	// InactiveProposalsQueue key: depositEndTime+proposalID | value: proposalID
 	InactiveProposalsQueue collections.Map[collections.Pair[time.Time, uint64], uint64] // TODO(tip): this should be simplified and go into an index.
+	// GovernanceDelegations key: delegatorAddr | value: governorAddr
+	GovernanceDelegations collections.Map[sdk.AccAddress, []byte]
+	// GovernorDelegators key: governorAddr+delegatorAddr | value: none
+	GovernorDelegators collections.KeySet[collections.Pair[sdk.AccAddress, sdk.AccAddress]]
+	// Governors key: governorAddr | value: number of delegators
+	Governors collections.Map[sdk.AccAddress, uint64]
+	// GovernorValShares key: governorAddr+valAddr | value: delegation shares of the governor delegators
+	GovernorValShares collections.Map[collections.Pair[sdk.AccAddress, sdk.ValAddress], math.LegacyDec]
 }
 
 // GetAuthority returns the x/gov module's authority.
@@ -110,6 +119,14 @@ func NewKeeper(
 	if config.MaxVoteOptionsLen == 0 {
 		config.MaxVoteOptionsLen = defaultConfig.MaxVoteOptionsLen
 	}
+	// If MaxSimulateProposalMsgs not set by app developer, set to default value.
+	if config.MaxSimulateProposalMsgs == 0 {
+		config.MaxSimulateProposalMsgs = defaultConfig.MaxSimulateProposalMsgs
+	}
+	// If SimulateProposalGasLimit not set by app developer, set to default value.
+	if config.SimulateProposalGasLimit == 0 {
+		config.SimulateProposalGasLimit = defaultConfig.SimulateProposalGasLimit
+	}
 
 	sb := collections.NewSchemaBuilder(env.KVStoreService)
 	k := &Keeper{
@@ -131,6 +148,10 @@ func NewKeeper(
 		ProposalVoteOptions:    collections.NewMap(sb, types.ProposalVoteOptionsKeyPrefix, "proposal_vote_options", collections.Uint64Key, codec.CollValue[v1.ProposalVoteOptions](cdc)),
 		ActiveProposalsQueue:   collections.NewMap(sb, types.ActiveProposalQueuePrefix, "active_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),     // sdk.TimeKey is needed to retain state compatibility
 		InactiveProposalsQueue: collections.NewMap(sb, types.InactiveProposalQueuePrefix, "inactive_proposals_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value), // sdk.TimeKey is needed to retain state compatibility
+		GovernanceDelegations:  collections.NewMap(sb, types.GovernanceDelegationsKeyPrefix, "governance_delegations", sdk.AccAddressKey, collections.BytesValue),
+		GovernorDelegators:     collections.NewKeySet(sb, types.GovernorDelegatorsKeyPrefix, "governor_delegators", collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey)),
+		Governors:              collections.NewMap(sb, types.GovernorsKeyPrefix, "governors", sdk.AccAddressKey, collections.Uint64Value),
+		GovernorValShares:      collections.NewMap(sb, types.GovernorValSharesKeyPrefix, "governor_val_shares", collections.PairKeyCodec(sdk.AccAddressKey, sdk.ValAddressKey), sdk.LegacyDecValue),
 	}
 	schema, err := sb.Build()
 	if err != nil {
@@ -4,8 +4,10 @@ import (
 	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	sdkmath "cosmossdk.io/math"
 	banktypes "cosmossdk.io/x/bank/types"
+	govtypes "cosmossdk.io/x/gov/types"
 	v1 "cosmossdk.io/x/gov/types/v1"
 	"cosmossdk.io/x/gov/types/v1beta1"
 
@@ -2320,3 +2322,78 @@ func (suite *KeeperTestSuite) TestMsgSudoExec() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgDelegateGovernance() {
+	suite.reset()
+	addr0Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[0])
+	suite.Require().NoError(err)
+	addr1Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[1])
+	suite.Require().NoError(err)
+	addr2Str, err := suite.acctKeeper.AddressCodec().BytesToString(suite.addrs[2])
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		input     *v1.MsgDelegateGovernance
+		expErrMsg string
+	}{
+		{
+			name:      "invalid delegator",
+			input:     &v1.MsgDelegateGovernance{DelegatorAddress: "invalid", GovernorAddress: addr1Str},
+			expErrMsg: "invalid delegator address",
+		},
+		{
+			name:      "invalid governor",
+			input:     &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: "invalid"},
+			expErrMsg: "invalid governor address",
+		},
+		{
+			name:      "delegate to self",
+			input:     &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: addr0Str},
+			expErrMsg: "cannot delegate governance voting power to self",
+		},
+		{
+			name:  "valid",
+			input: &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: addr1Str},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.msgSrvr.DelegateGovernance(suite.ctx, tc.input)
+			if tc.expErrMsg != "" {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	count, err := suite.govKeeper.Governors.Get(suite.ctx, suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), count)
+
+	// delegating again replaces the previous governor
+	_, err = suite.msgSrvr.DelegateGovernance(suite.ctx, &v1.MsgDelegateGovernance{DelegatorAddress: addr0Str, GovernorAddress: addr2Str})
+	suite.Require().NoError(err)
+	has, err := suite.govKeeper.Governors.Has(suite.ctx, suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	governor, err := suite.govKeeper.GovernanceDelegations.Get(suite.ctx, suite.addrs[0])
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.addrs[2].Bytes(), governor)
+
+	_, err = suite.msgSrvr.UndelegateGovernance(suite.ctx, &v1.MsgUndelegateGovernance{DelegatorAddress: addr0Str})
+	suite.Require().NoError(err)
+	has, err = suite.govKeeper.Governors.Has(suite.ctx, suite.addrs[2])
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	has, err = suite.govKeeper.GovernorDelegators.Has(suite.ctx, collections.Join(suite.addrs[2], suite.addrs[0]))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	_, err = suite.msgSrvr.UndelegateGovernance(suite.ctx, &v1.MsgUndelegateGovernance{DelegatorAddress: addr0Str})
+	suite.Require().ErrorIs(err, govtypes.ErrNoGovernanceDelegation)
+}
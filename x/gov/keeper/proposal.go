@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -63,33 +62,11 @@ func (k Keeper) SubmitProposal(ctx context.Context, messages []sdk.Msg, metadata
 			}
 		}
 
-		// perform a basic validation of the message
-		if m, ok := msg.(sdk.HasValidateBasic); ok {
-			if err := m.ValidateBasic(); err != nil {
-				return v1.Proposal{}, errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
-			}
-		}
-
-		signers, _, err := k.cdc.GetMsgSigners(msg)
-		if err != nil {
+		// perform a basic validation of the message, and assert that it is routable
+		// and that the governance module account is its only signer
+		if err := k.assertProposalMsg(ctx, msg); err != nil {
 			return v1.Proposal{}, err
 		}
-		if len(signers) != 1 {
-			return v1.Proposal{}, types.ErrInvalidSigner
-		}
-
-		// assert that the governance module account is the only signer of the messages
-		if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
-			addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
-			if err != nil {
-				return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, err.Error())
-			}
-			return v1.Proposal{}, errorsmod.Wrapf(types.ErrInvalidSigner, addr)
-		}
-
-		if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
-			return v1.Proposal{}, errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
-		}
 
 		// Only if it's a MsgExecLegacyContent we try to execute the
 		// proposal in a cached context.
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/gov/types"
+	v1 "cosmossdk.io/x/gov/types/v1"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// errDiscardSimulation is returned by the simulation branch to discard its state changes.
+var errDiscardSimulation = errors.New("proposal simulation state is discarded")
+
+// SimulateProposal executes the messages of a proposal in a branched state, as if the
+// proposal passed, and returns the result of each executed message. The messages are
+// checked the same way as on proposal submission, and the execution stops at the first
+// failing message. No state change is persisted.
+// The number of messages is limited by the MaxSimulateProposalMsgs config, and their
+// execution is limited by the SimulateProposalGasLimit config: a message running out
+// of gas fails the simulation.
+func (k Keeper) SimulateProposal(ctx context.Context, messages []sdk.Msg) (success bool, results []v1.ProposalMsgResult, err error) {
+	if len(messages) == 0 {
+		return false, nil, types.ErrNoProposalMsgs
+	}
+
+	if uint64(len(messages)) > k.config.MaxSimulateProposalMsgs {
+		return false, nil, errorsmod.Wrapf(types.ErrInvalidProposalMsg, "too many messages to simulate: got %d, maximum %d", len(messages), k.config.MaxSimulateProposalMsgs)
+	}
+
+	for _, msg := range messages {
+		if err := k.assertProposalMsg(ctx, msg); err != nil {
+			return false, []v1.ProposalMsgResult{{MsgTypeUrl: sdk.MsgTypeURL(msg), Error: err.Error()}}, nil
+		}
+	}
+
+	success = true
+	_, err = k.BranchService.ExecuteWithGasLimit(ctx, k.config.SimulateProposalGasLimit, func(ctx context.Context) error {
+		for _, msg := range messages {
+			// collect the events emitted by the message only
+			msgCtx := sdk.UnwrapSDKContext(ctx).WithEventManager(sdk.NewEventManager())
+			result := v1.ProposalMsgResult{MsgTypeUrl: sdk.MsgTypeURL(msg)}
+
+			res, err := safeExecuteHandler(msgCtx, msg, k.MsgRouterService)
+			result.Events = msgCtx.EventManager().ABCIEvents()
+			if err != nil {
+				// the out of gas panic is recovered by safeExecuteHandler
+				if msgCtx.GasMeter().IsOutOfGas() {
+					err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "simulation gas limit of %d exceeded", k.config.SimulateProposalGasLimit)
+				}
+				result.Error = err.Error()
+				results = append(results, result)
+				success = false
+				break
+			}
+
+			if res != nil {
+				if result.MsgResponse, err = codectypes.NewAnyWithValue(res); err != nil {
+					return err
+				}
+			}
+			results = append(results, result)
+		}
+
+		return errDiscardSimulation
+	})
+	if err != nil && !errors.Is(err, errDiscardSimulation) {
+		return false, nil, err
+	}
+
+	return success, results, nil
+}
+
+// assertProposalMsg checks that the message can be executed by a proposal: it must
+// be valid, routable, and have the governance module account as only signer.
+func (k Keeper) assertProposalMsg(ctx context.Context, msg sdk.Msg) error {
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidProposalMsg, err.Error())
+		}
+	}
+
+	signers, _, err := k.cdc.GetMsgSigners(msg)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 {
+		return types.ErrInvalidSigner
+	}
+
+	if !bytes.Equal(signers[0], k.GetGovernanceAccount(ctx).GetAddress()) {
+		addr, err := k.authKeeper.AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidSigner, err.Error())
+		}
+		return errorsmod.Wrap(types.ErrInvalidSigner, addr)
+	}
+
+	if err := k.MsgRouterService.CanInvoke(ctx, sdk.MsgTypeURL(msg)); err != nil {
+		return errorsmod.Wrap(types.ErrUnroutableProposalMsg, err.Error())
+	}
+
+	return nil
+}
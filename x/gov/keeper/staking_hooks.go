@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	stderrors "errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingHooks keeps the delegation shares of the governors up to date with the
+// delegations of their delegators, so that the voting power of a governor is
+// tallied without iterating over its delegators.
+type StakingHooks struct {
+	k Keeper
+}
+
+var _ stakingtypes.StakingHooks = StakingHooks{}
+
+// StakingHooks returns the staking hooks of the gov module.
+func (k Keeper) StakingHooks() StakingHooks {
+	return StakingHooks{k}
+}
+
+// BeforeDelegationSharesModified removes the shares of the delegation from the shares
+// of the governor of the delegator, they are added back by AfterDelegationModified.
+func (h StakingHooks) BeforeDelegationSharesModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.moveDelegationShares(ctx, delAddr, valAddr, true)
+}
+
+// AfterDelegationModified adds the shares of the delegation to the shares of the
+// governor of the delegator.
+func (h StakingHooks) AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	return h.moveDelegationShares(ctx, delAddr, valAddr, false)
+}
+
+// moveDelegationShares adds the shares of the delegation to the shares of the governor
+// of the delegator, or subtracts them when sub is true.
+func (h StakingHooks) moveDelegationShares(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sub bool) error {
+	governorAddr, err := h.k.GovernanceDelegations.Get(ctx, delAddr)
+	if err != nil {
+		if stderrors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	delegation, err := h.k.sk.Delegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return err
+	}
+
+	shares := delegation.GetShares()
+	if sub {
+		shares = shares.Neg()
+	}
+
+	return h.k.addGovernorValShares(ctx, governorAddr, valAddr, shares)
+}
+
+// BeforeDelegationRemoved is a no-op: the shares of the removed delegation were already
+// subtracted by BeforeDelegationSharesModified.
+func (StakingHooks) BeforeDelegationRemoved(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorCreated(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeValidatorModified(context.Context, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorRemoved(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBonded(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) AfterValidatorBeginUnbonding(context.Context, sdk.ConsAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeDelegationCreated(context.Context, sdk.AccAddress, sdk.ValAddress) error {
+	return nil
+}
+
+func (StakingHooks) BeforeValidatorSlashed(context.Context, sdk.ValAddress, math.LegacyDec) error {
+	return nil
+}
+
+func (StakingHooks) AfterUnbondingInitiated(context.Context, uint64) error {
+	return nil
+}
+
+func (StakingHooks) AfterConsensusPubKeyUpdate(context.Context, cryptotypes.PubKey, cryptotypes.PubKey, sdk.Coin) error {
+	return nil
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	stakingtypes "cosmossdk.io/x/staking/types"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestStakingHooksGovernorShares(t *testing.T) {
+	govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+	addrs := simtestutil.CreateRandomAccounts(3)
+	delegator, governor, valAddr := addrs[0], addrs[1], sdk.ValAddress(addrs[2])
+
+	valCodec := address.NewBech32Codec("cosmosvaloper")
+	valAddrStr, err := valCodec.BytesToString(valAddr)
+	require.NoError(t, err)
+	mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(valCodec).AnyTimes()
+
+	shares := sdkmath.LegacyNewDec(10)
+	delegation := func() stakingtypes.Delegation {
+		return stakingtypes.Delegation{ValidatorAddress: valAddrStr, Shares: shares}
+	}
+	mocks.stakingKeeper.EXPECT().IterateDelegations(gomock.Any(), delegator, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ sdk.AccAddress, fn func(int64, sdk.DelegationI) bool) error {
+			fn(0, delegation())
+			return nil
+		}).AnyTimes()
+	mocks.stakingKeeper.EXPECT().Delegation(gomock.Any(), delegator, valAddr).DoAndReturn(
+		func(context.Context, sdk.AccAddress, sdk.ValAddress) (sdk.DelegationI, error) {
+			return delegation(), nil
+		}).AnyTimes()
+
+	governorShares := func() sdkmath.LegacyDec {
+		t.Helper()
+		shares, err := govKeeper.GovernorValShares.Get(ctx, collections.Join(governor, valAddr))
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdkmath.LegacyZeroDec()
+		}
+		require.NoError(t, err)
+		return shares
+	}
+
+	// the delegations of the delegator are added to the governor
+	require.NoError(t, govKeeper.DelegateVotingPower(ctx, delegator, governor))
+	require.Equal(t, sdkmath.LegacyNewDec(10), governorShares())
+
+	// and follow the changes of the delegation shares
+	hooks := govKeeper.StakingHooks()
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delegator, valAddr))
+	shares = sdkmath.LegacyNewDec(25)
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delegator, valAddr))
+	require.Equal(t, sdkmath.LegacyNewDec(25), governorShares())
+
+	// the delegations of an account without governor are not tracked
+	require.NoError(t, hooks.AfterDelegationModified(ctx, governor, valAddr))
+
+	// an unbonded delegation is removed from the governor
+	require.NoError(t, hooks.BeforeDelegationSharesModified(ctx, delegator, valAddr))
+	require.NoError(t, hooks.BeforeDelegationRemoved(ctx, delegator, valAddr))
+	require.True(t, governorShares().IsZero())
+	require.NoError(t, hooks.AfterDelegationModified(ctx, delegator, valAddr))
+	require.Equal(t, sdkmath.LegacyNewDec(25), governorShares())
+
+	// the shares of the delegator are removed with its governance delegation
+	require.NoError(t, govKeeper.UndelegateVotingPower(ctx, delegator))
+	has, err := govKeeper.GovernorValShares.Has(ctx, collections.Join(governor, valAddr))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -245,6 +245,11 @@ func defaultCalculateVoteResultsAndVotingPower(
 	totalVP := math.LegacyZeroDec()
 	results := createEmptyResults()
 
+	// governorVotes records the votes of governors, and directShares the delegation shares,
+	// by governor and validator, of the governor delegators that voted directly
+	var governorVotes []v1.Vote
+	directShares := make(map[string]map[string]math.LegacyDec)
+
 	// iterate over all votes, tally up the voting power of each validator
 	rng := collections.NewPrefixedPairRange[uint64, sdk.AccAddress](proposalID)
 	votesToRemove := []collections.Pair[uint64, sdk.AccAddress]{}
@@ -255,6 +260,23 @@ func defaultCalculateVoteResultsAndVotingPower(
 			return false, err
 		}
 
+		isGovernor, err := k.Governors.Has(ctx, voter)
+		if err != nil {
+			return false, err
+		}
+		if isGovernor {
+			governorVotes = append(governorVotes, vote)
+		}
+
+		// a direct vote of the delegator overrides the vote of its governor
+		governorAddr, err := k.GovernanceDelegations.Get(ctx, voter)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return false, err
+		}
+		if governorAddr != nil && directShares[string(governorAddr)] == nil {
+			directShares[string(governorAddr)] = make(map[string]math.LegacyDec)
+		}
+
 		valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(voter)
 		if err != nil {
 			return false, err
@@ -275,6 +297,14 @@ func defaultCalculateVoteResultsAndVotingPower(
 				val.DelegatorDeductions = val.DelegatorDeductions.Add(delegation.GetShares())
 				validators[valAddrStr] = val
 
+				if governorAddr != nil {
+					shares, ok := directShares[string(governorAddr)][valAddrStr]
+					if !ok {
+						shares = math.LegacyZeroDec()
+					}
+					directShares[string(governorAddr)][valAddrStr] = shares.Add(delegation.GetShares())
+				}
+
 				// delegation shares * bonded / total shares
 				votingPower := delegation.GetShares().MulInt(val.BondedTokens).Quo(val.DelegatorShares)
 
@@ -306,6 +336,15 @@ func defaultCalculateVoteResultsAndVotingPower(
 		}
 	}
 
+	// tally the voting power delegated to the governors that voted
+	if len(governorVotes) > 0 {
+		governorsVP, err := tallyGovernorVotes(ctx, k, governorVotes, directShares, validators, results)
+		if err != nil {
+			return math.LegacyDec{}, nil, err
+		}
+		totalVP = totalVP.Add(governorsVP)
+	}
+
 	// iterate over the validators again to tally their voting power
 	for _, val := range validators {
 		if len(val.Vote) == 0 {
@@ -326,6 +365,101 @@ func defaultCalculateVoteResultsAndVotingPower(
 	return totalVP, results, nil
 }
 
+// tallyGovernorVotes tallies the voting power delegated to the governors that voted, deducting
+// it from the validators. Delegators that voted directly keep their own vote. The power counted
+// for a governor is capped at GovernorPowerCap of the total bonded tokens, the same fraction of
+// the shares of each validator is deducted so that the excess stays with the validators.
+// The shares of the governor delegators are kept up to date by the staking hooks, so the tally
+// of a governor is bounded by the number of validators rather than by its delegators.
+func tallyGovernorVotes(
+	ctx context.Context,
+	k Keeper,
+	governorVotes []v1.Vote,
+	directShares map[string]map[string]math.LegacyDec,
+	validators map[string]v1.ValidatorGovInfo,
+	results map[v1.VoteOption]math.LegacyDec,
+) (math.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	totalBonded, err := k.sk.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	powerCap, err := math.LegacyNewDecFromStr(params.GovernorPowerCap)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	maxPower := powerCap.MulInt(totalBonded)
+
+	type valShares struct {
+		valAddr string
+		shares  math.LegacyDec
+	}
+
+	totalVP := math.LegacyZeroDec()
+	for _, vote := range governorVotes {
+		governor, err := k.authKeeper.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		votingPower := math.LegacyZeroDec()
+		var deductions []valShares
+		rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](governor)
+		if err := k.GovernorValShares.Walk(ctx, rng, func(key collections.Pair[sdk.AccAddress, sdk.ValAddress], shares math.LegacyDec) (bool, error) {
+			valAddrStr, err := k.sk.ValidatorAddressCodec().BytesToString(key.K2())
+			if err != nil {
+				return true, err
+			}
+
+			val, ok := validators[valAddrStr]
+			if !ok {
+				return false, nil
+			}
+
+			if direct, ok := directShares[string(governor)][valAddrStr]; ok {
+				shares = shares.Sub(direct)
+			}
+			if !shares.IsPositive() {
+				return false, nil
+			}
+
+			deductions = append(deductions, valShares{valAddr: valAddrStr, shares: shares})
+			// delegation shares * bonded / total shares
+			votingPower = votingPower.Add(shares.MulInt(val.BondedTokens).Quo(val.DelegatorShares))
+			return false, nil
+		}); err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		// the power above the cap is left to the validators
+		ratio := math.LegacyOneDec()
+		if votingPower.GT(maxPower) {
+			ratio = maxPower.Quo(votingPower)
+			votingPower = maxPower
+		}
+
+		for _, deduction := range deductions {
+			val := validators[deduction.valAddr]
+			val.DelegatorDeductions = val.DelegatorDeductions.Add(deduction.shares.Mul(ratio))
+			validators[deduction.valAddr] = val
+		}
+
+		for _, option := range vote.Options {
+			weight, _ := math.LegacyNewDecFromStr(option.Weight)
+			subPower := votingPower.Mul(weight)
+			results[option.Option] = results[option.Option].Add(subPower)
+		}
+		totalVP = totalVP.Add(votingPower)
+	}
+
+	return totalVP, nil
+}
+
 func createEmptyResults() map[v1.VoteOption]math.LegacyDec {
 	results := make(map[v1.VoteOption]math.LegacyDec)
 	results[v1.OptionYes] = math.LegacyZeroDec()
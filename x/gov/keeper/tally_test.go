@@ -1546,3 +1546,141 @@ func TestTally_MultipleChoice(t *testing.T) {
 		})
 	}
 }
+
+func TestTally_GovernanceDelegation(t *testing.T) {
+	delegation := func(s tallyFixture, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares int64) []stakingtypes.Delegation {
+		delAddrStr, err := s.mocks.acctKeeper.AddressCodec().BytesToString(delAddr)
+		require.NoError(s.t, err)
+		valAddrStr, err := s.mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddr)
+		require.NoError(s.t, err)
+		return []stakingtypes.Delegation{{
+			DelegatorAddress: delAddrStr,
+			ValidatorAddress: valAddrStr,
+			Shares:           sdkmath.LegacyNewDec(shares),
+		}}
+	}
+	delegateGovernance := func(s tallyFixture, delegator, governor sdk.AccAddress, delegations []stakingtypes.Delegation) {
+		s.mocks.stakingKeeper.EXPECT().
+			IterateDelegations(s.ctx, delegator, gomock.Any()).
+			DoAndReturn(
+				func(ctx context.Context, delegator sdk.AccAddress, fn func(index int64, d sdk.DelegationI) bool) error {
+					for i, d := range delegations {
+						fn(int64(i), d)
+					}
+					return nil
+				}).AnyTimes()
+		require.NoError(s.t, s.keeper.DelegateVotingPower(s.ctx, delegator, governor))
+	}
+	setTotalBondedAnyTimes := func(s tallyFixture, n int64) {
+		s.mocks.stakingKeeper.EXPECT().ValidatorAddressCodec().Return(address.NewBech32Codec("cosmosvaloper")).AnyTimes()
+		s.mocks.stakingKeeper.EXPECT().TotalBondedTokens(gomock.Any()).Return(sdkmath.NewInt(n), nil).AnyTimes()
+	}
+
+	tests := []struct {
+		name        string
+		powerCap    string
+		setup       func(tallyFixture)
+		expectedYes string
+		expectedNo  string
+	}{
+		{
+			name: "governor votes on behalf of its delegator",
+			setup: func(s tallyFixture) {
+				setTotalBondedAnyTimes(s, 10000000)
+				delegateGovernance(s, s.delAddrs[1], s.delAddrs[0], delegation(s, s.delAddrs[1], s.valAddrs[0], 42))
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedYes: "42",
+			expectedNo:  "999958",
+		},
+		{
+			name: "direct vote of the delegator overrides the governor",
+			setup: func(s tallyFixture) {
+				setTotalBondedAnyTimes(s, 10000000)
+				delegateGovernance(s, s.delAddrs[1], s.delAddrs[0], delegation(s, s.delAddrs[1], s.valAddrs[0], 42))
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				// the delegations of the delegator are already mocked by delegateGovernance
+				err := s.keeper.AddVote(s.ctx, s.proposal.Id, s.delAddrs[1], v1.NewNonSplitVoteOption(v1.VoteOption_VOTE_OPTION_THREE), "")
+				require.NoError(s.t, err)
+			},
+			expectedYes: "0",
+			expectedNo:  "42",
+		},
+		{
+			name: "delegators fall back to their validators when the governor does not vote",
+			setup: func(s tallyFixture) {
+				setTotalBondedAnyTimes(s, 10000000)
+				delegateGovernance(s, s.delAddrs[1], s.delAddrs[0], delegation(s, s.delAddrs[1], s.valAddrs[0], 42))
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			expectedYes: "0",
+			expectedNo:  "1000000",
+		},
+		{
+			name:     "governor power is capped",
+			powerCap: "0.00001",
+			setup: func(s tallyFixture) {
+				setTotalBondedAnyTimes(s, 10000000)
+				delegateGovernance(s, s.delAddrs[1], s.delAddrs[0], delegation(s, s.delAddrs[1], s.valAddrs[0], 60))
+				delegateGovernance(s, s.delAddrs[2], s.delAddrs[0], delegation(s, s.delAddrs[2], s.valAddrs[0], 60))
+				delegatorVote(s, s.delAddrs[0], nil, v1.VoteOption_VOTE_OPTION_ONE)
+				validatorVote(s, s.valAddrs[0], v1.VoteOption_VOTE_OPTION_THREE)
+			},
+			// the 20 shares above the cap stay with the validator
+			expectedYes: "100",
+			expectedNo:  "999900",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			govKeeper, mocks, _, ctx := setupGovKeeper(t, mockAccountKeeperExpectations)
+			params := v1.DefaultParams()
+			if tt.powerCap != "" {
+				params.GovernorPowerCap = tt.powerCap
+			}
+			require.NoError(t, govKeeper.Params.Set(ctx, params))
+			var (
+				numVals       = 2
+				numDelegators = 3
+				addrs         = simtestutil.CreateRandomAccounts(numVals + numDelegators)
+				valAddrs      = simtestutil.ConvertAddrsToValAddrs(addrs[:numVals])
+				delAddrs      = addrs[numVals:]
+			)
+			mocks.stakingKeeper.EXPECT().
+				IterateBondedValidatorsByPower(ctx, gomock.Any()).
+				DoAndReturn(
+					func(ctx context.Context, fn func(index int64, validator sdk.ValidatorI) bool) error {
+						for i := int64(0); i < int64(numVals); i++ {
+							valAddr, err := mocks.stakingKeeper.ValidatorAddressCodec().BytesToString(valAddrs[i])
+							require.NoError(t, err)
+							fn(i, stakingtypes.Validator{
+								OperatorAddress: valAddr,
+								Status:          stakingtypes.Bonded,
+								Tokens:          sdkmath.NewInt(1000000),
+								DelegatorShares: sdkmath.LegacyNewDec(1000000),
+							})
+						}
+						return nil
+					})
+
+			proposal, err := govKeeper.SubmitProposal(ctx, TestProposal, "", "title", "summary", delAddrs[0], v1.ProposalType_PROPOSAL_TYPE_STANDARD)
+			require.NoError(t, err)
+			require.NoError(t, govKeeper.ActivateVotingPeriod(ctx, proposal))
+			tt.setup(tallyFixture{
+				t:        t,
+				proposal: proposal,
+				valAddrs: valAddrs,
+				delAddrs: delAddrs,
+				ctx:      ctx,
+				keeper:   govKeeper,
+				mocks:    mocks,
+			})
+
+			_, _, tally, err := govKeeper.Tally(ctx, proposal)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedYes, tally.YesCount)
+			assert.Equal(t, tt.expectedNo, tally.NoCount)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package v7
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	v1 "cosmossdk.io/x/gov/types/v1"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. The
+// migration includes:
+//
+// Addition of the governor power cap param for governance delegations.
+func MigrateStore(ctx context.Context, paramsCollection collections.Item[v1.Params]) error {
+	govParams, err := paramsCollection.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get gov params: %w", err)
+	}
+
+	govParams.GovernorPowerCap = v1.DefaultParams().GovernorPowerCap
+
+	return paramsCollection.Set(ctx, govParams)
+}
@@ -27,7 +27,7 @@ import (
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 )
 
-const ConsensusVersion = 6
+const ConsensusVersion = 7
 
 var (
 	_ module.HasName             = AppModule{}
@@ -160,6 +160,10 @@ func (am AppModule) RegisterMigrations(mr appmodule.MigrationRegistrar) error {
 		return fmt.Errorf("failed to migrate x/gov from version 5 to 6: %w", err)
 	}
 
+	if err := mr.Register(govtypes.ModuleName, 6, m.Migrate6to7); err != nil {
+		return fmt.Errorf("failed to migrate x/gov from version 6 to 7: %w", err)
+	}
+
 	return nil
 }
 
@@ -33,6 +33,7 @@ const (
 	ProposalCancelRate            = "proposal_cancel_rate"
 	ProposalMaxCancelVotingPeriod = "proposal_max_cancel_voting_period"
 	MinDepositRatio               = "min_deposit_ratio"
+	GovernorPowerCap              = "governor_power_cap"
 
 	// ExpeditedThreshold must be at least as large as the regular Threshold
 	// Therefore, we use this break out point in randomization.
@@ -118,6 +119,11 @@ func GenMinDepositRatio(r *rand.Rand) sdkmath.LegacyDec {
 	return sdkmath.LegacyMustNewDecFromStr("0.01")
 }
 
+// GenGovernorPowerCap returns randomized GovernorPowerCap
+func GenGovernorPowerCap(r *rand.Rand) sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 1000)), 3)
+}
+
 // RandomizedGenState generates a random GenesisState for gov
 func RandomizedGenState(simState *module.SimulationState) {
 	startingProposalID := uint64(simState.Rand.Intn(100))
@@ -170,6 +176,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 	var minDepositRatio sdkmath.LegacyDec
 	simState.AppParams.GetOrGenerate(MinDepositRatio, &minDepositRatio, simState.Rand, func(r *rand.Rand) { minDepositRatio = GenMinDepositRatio(r) })
 
+	var governorPowerCap sdkmath.LegacyDec
+	simState.AppParams.GetOrGenerate(GovernorPowerCap, &governorPowerCap, simState.Rand, func(r *rand.Rand) { governorPowerCap = GenGovernorPowerCap(r) })
+
 	govGenesis := v1.NewGenesisState(
 		startingProposalID,
 		v1.NewParams(
@@ -194,6 +203,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			minDepositRatio.String(),
 			optimisticRejectedThreshold.String(),
 			[]string{},
+			governorPowerCap.String(),
 		),
 	)
 
@@ -9,6 +9,9 @@ const (
 	EventTypeActiveProposal   = "active_proposal"
 	EventTypeCancelProposal   = "cancel_proposal"
 
+	EventTypeDelegateGovernance   = "delegate_governance"
+	EventTypeUndelegateGovernance = "undelegate_governance"
+
 	AttributeKeyProposalResult       = "proposal_result"
 	AttributeKeyVoter                = "voter"
 	AttributeKeyOption               = "option"
@@ -19,6 +22,8 @@ const (
 	AttributeKeyProposalLog          = "proposal_log"           // log of proposal execution
 	AttributeKeyProposalDepositError = "proposal_deposit_error" // error on proposal deposit refund/burn
 	AttributeKeyProposalProposer     = "proposal_proposer"      // account address of the proposer
+	AttributeKeyDelegator            = "delegator"
+	AttributeKeyGovernor             = "governor"
 
 	AttributeValueProposalDropped            = "proposal_dropped"             // didn't meet min deposit
 	AttributeValueProposalPassed             = "proposal_passed"              // met vote quorum
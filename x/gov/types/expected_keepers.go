@@ -22,6 +22,7 @@ type StakingKeeper interface {
 		ctx context.Context, delegator sdk.AccAddress,
 		fn func(index int64, delegation sdk.DelegationI) (stop bool),
 	) error
+	Delegation(context.Context, sdk.AccAddress, sdk.ValAddress) (sdk.DelegationI, error) // get a particular delegation of a delegator to a validator
 }
 
 // AccountKeeper defines the expected account keeper (noalias)
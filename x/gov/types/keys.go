@@ -16,16 +16,20 @@ const (
 )
 
 var (
-	ProposalsKeyPrefix           = collections.NewPrefix(0)  // ProposalsKeyPrefix stores the proposals raw bytes.
-	ActiveProposalQueuePrefix    = collections.NewPrefix(1)  // ActiveProposalQueuePrefix stores the active proposals.
-	InactiveProposalQueuePrefix  = collections.NewPrefix(2)  // InactiveProposalQueuePrefix stores the inactive proposals.
-	ProposalIDKey                = collections.NewPrefix(3)  // ProposalIDKey stores the sequence representing the next proposal ID.
-	DepositsKeyPrefix            = collections.NewPrefix(16) // DepositsKeyPrefix stores deposits.
-	VotesKeyPrefix               = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
-	ParamsKey                    = collections.NewPrefix(48) // ParamsKey stores the module's params.
-	ConstitutionKey              = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
-	ProposalVoteOptionsKeyPrefix = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
-	MessageBasedParamsKey        = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	ProposalsKeyPrefix             = collections.NewPrefix(0)  // ProposalsKeyPrefix stores the proposals raw bytes.
+	ActiveProposalQueuePrefix      = collections.NewPrefix(1)  // ActiveProposalQueuePrefix stores the active proposals.
+	InactiveProposalQueuePrefix    = collections.NewPrefix(2)  // InactiveProposalQueuePrefix stores the inactive proposals.
+	ProposalIDKey                  = collections.NewPrefix(3)  // ProposalIDKey stores the sequence representing the next proposal ID.
+	DepositsKeyPrefix              = collections.NewPrefix(16) // DepositsKeyPrefix stores deposits.
+	VotesKeyPrefix                 = collections.NewPrefix(32) // VotesKeyPrefix stores the votes of proposals.
+	ParamsKey                      = collections.NewPrefix(48) // ParamsKey stores the module's params.
+	ConstitutionKey                = collections.NewPrefix(49) // ConstitutionKey stores a chain's constitution.
+	ProposalVoteOptionsKeyPrefix   = collections.NewPrefix(50) // ProposalVoteOptionsKeyPrefix stores the vote options of proposals.
+	MessageBasedParamsKey          = collections.NewPrefix(51) // MessageBasedParamsKey stores the message based gov params.
+	GovernanceDelegationsKeyPrefix = collections.NewPrefix(52) // GovernanceDelegationsKeyPrefix stores the governor of each delegator.
+	GovernorDelegatorsKeyPrefix    = collections.NewPrefix(53) // GovernorDelegatorsKeyPrefix stores the delegators of each governor.
+	GovernorsKeyPrefix             = collections.NewPrefix(54) // GovernorsKeyPrefix stores the number of delegators of each governor.
+	GovernorValSharesKeyPrefix     = collections.NewPrefix(55) // GovernorValSharesKeyPrefix stores the delegation shares of the delegators of each governor, by validator.
 )
 
 // Reserved kvstore keys
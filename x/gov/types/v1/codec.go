@@ -21,6 +21,8 @@ func RegisterLegacyAminoCodec(cdc corelegacy.Amino) {
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "cosmos-sdk/x/gov/v1/MsgUpdateParams")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateMessageParams{}, "x/gov/v1/MsgUpdateMessageParams")
 	legacy.RegisterAminoMsg(cdc, &MsgSudoExec{}, "cosmos-sdk/x/gov/v1/MsgSudoExec")
+	legacy.RegisterAminoMsg(cdc, &MsgDelegateGovernance{}, "cosmos-sdk/v1/MsgDelegateGovernance")
+	legacy.RegisterAminoMsg(cdc, &MsgUndelegateGovernance{}, "cosmos-sdk/v1/MsgUndelegateGovernance")
 }
 
 // RegisterInterfaces registers the interfaces types with the Interface Registry.
@@ -35,6 +37,8 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgUpdateParams{},
 		&MsgUpdateMessageParams{},
 		&MsgSudoExec{},
+		&MsgDelegateGovernance{},
+		&MsgUndelegateGovernance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
@@ -99,6 +99,30 @@ func ValidateGenesis(ac address.Codec, data *GenesisState) error {
 		return nil
 	})
 
+	// weed out duplicate and self governance delegations
+	errGroup.Go(func() error {
+		delegators := make(map[string]struct{})
+		for _, d := range data.GovernanceDelegations {
+			if _, err := ac.StringToBytes(d.DelegatorAddress); err != nil {
+				return fmt.Errorf("invalid governance delegation delegator address %s: %w", d.DelegatorAddress, err)
+			}
+			if _, err := ac.StringToBytes(d.GovernorAddress); err != nil {
+				return fmt.Errorf("invalid governance delegation governor address %s: %w", d.GovernorAddress, err)
+			}
+			if d.DelegatorAddress == d.GovernorAddress {
+				return fmt.Errorf("delegator cannot be its own governor: %s", d.DelegatorAddress)
+			}
+
+			if _, ok := delegators[d.DelegatorAddress]; ok {
+				return fmt.Errorf("duplicate governance delegation: %v", d)
+			}
+
+			delegators[d.DelegatorAddress] = struct{}{}
+		}
+
+		return nil
+	})
+
 	// verify params
 	errGroup.Go(func() error {
 		return data.Params.ValidateBasic(ac)
@@ -49,6 +49,8 @@ type GenesisState struct {
 	// There are no amendments, to go outside of scope, just fork.
 	// constitution is an immutable string in genesis for a chain builder to lay out their vision, ideas and ideals.
 	Constitution string `protobuf:"bytes,9,opt,name=constitution,proto3" json:"constitution,omitempty"`
+	// governance_delegations defines all the governance delegations present at genesis.
+	GovernanceDelegations []*GovernanceDelegation `protobuf:"bytes,10,rep,name=governance_delegations,json=governanceDelegations,proto3" json:"governance_delegations,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +152,13 @@ func (m *GenesisState) GetConstitution() string {
 	return ""
 }
 
+func (m *GenesisState) GetGovernanceDelegations() []*GovernanceDelegation {
+	if m != nil {
+		return m.GovernanceDelegations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.gov.v1.GenesisState")
 }
@@ -157,32 +166,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/gov/v1/genesis.proto", fileDescriptor_ef7cfd15e3ded621) }
 
 var fileDescriptor_ef7cfd15e3ded621 = []byte{
-	// 400 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbd, 0x6e, 0xe2, 0x40,
-	0x14, 0x85, 0x19, 0xfe, 0x16, 0x06, 0xb3, 0x2b, 0x0d, 0xbb, 0x8b, 0x17, 0x56, 0x96, 0xb5, 0x95,
-	0xb7, 0xc0, 0x06, 0x12, 0x44, 0x1d, 0x84, 0x84, 0xd2, 0x21, 0x27, 0x4a, 0x91, 0x06, 0x39, 0xd8,
-	0xb2, 0x2c, 0xc0, 0x63, 0x71, 0x27, 0xa3, 0xf0, 0x16, 0x79, 0x89, 0xbc, 0x41, 0x1e, 0x22, 0x25,
-	0x65, 0x94, 0x2a, 0x82, 0x17, 0x89, 0x98, 0xb1, 0x03, 0x38, 0x94, 0x73, 0xcf, 0x77, 0xce, 0x1c,
-	0x5d, 0x5d, 0xdc, 0x9c, 0x52, 0x58, 0x50, 0xb0, 0x7c, 0xca, 0x2d, 0xde, 0xb1, 0x7c, 0x2f, 0xf4,
-	0x20, 0x00, 0x33, 0x5a, 0x52, 0x46, 0x49, 0x55, 0x8a, 0xa6, 0x4f, 0xb9, 0xc9, 0x3b, 0x8d, 0x7a,
-	0x8a, 0xa5, 0x5c, 0x72, 0x8d, 0x3f, 0x52, 0x98, 0x88, 0x97, 0x15, 0x9b, 0xc4, 0xe3, 0xdf, 0x53,
-	0x1e, 0x2b, 0x23, 0x19, 0x7a, 0xc5, 0x1c, 0xe6, 0x91, 0x36, 0xfe, 0x09, 0xcc, 0x59, 0xb2, 0x20,
-	0xf4, 0x77, 0x7c, 0x44, 0xc1, 0x99, 0x4f, 0x02, 0x57, 0x45, 0x3a, 0x32, 0xf2, 0x36, 0x49, 0xb4,
-	0x71, 0x2c, 0x5d, 0xba, 0xa4, 0x8b, 0x4b, 0xae, 0x17, 0x51, 0x08, 0x18, 0xa8, 0x59, 0x3d, 0x67,
-	0x54, 0xba, 0xbf, 0xcd, 0xa3, 0x62, 0xe6, 0x50, 0xca, 0xf6, 0x27, 0x47, 0xfe, 0xe3, 0x02, 0xa7,
-	0xcc, 0x03, 0x35, 0x27, 0x0c, 0xb5, 0x94, 0xe1, 0x86, 0x32, 0xcf, 0x96, 0x04, 0xe9, 0xe1, 0x72,
-	0xd2, 0x03, 0xd4, 0xbc, 0xc0, 0xeb, 0x29, 0x3c, 0x29, 0x63, 0xef, 0x49, 0x32, 0xc2, 0xdf, 0xe3,
-	0xdf, 0x26, 0x91, 0xb3, 0x74, 0x16, 0xa0, 0x16, 0x74, 0x64, 0x54, 0xba, 0x7f, 0x4f, 0x77, 0x1b,
-	0x0b, 0x66, 0x90, 0x55, 0x91, 0x5d, 0x75, 0x0f, 0x47, 0x64, 0x88, 0xab, 0x9c, 0xca, 0x75, 0xc8,
-	0x9c, 0xa2, 0xc8, 0x69, 0x7e, 0xad, 0xbc, 0x5b, 0xcb, 0x3e, 0x46, 0xe1, 0x07, 0x13, 0x72, 0x81,
-	0x15, 0xe6, 0xcc, 0xe7, 0xab, 0x24, 0xe4, 0x9b, 0x08, 0x69, 0xa4, 0x42, 0xae, 0x77, 0xc8, 0x41,
-	0x46, 0x85, 0xed, 0x07, 0x64, 0x80, 0x8b, 0xb1, 0xb9, 0x24, 0xcc, 0xbf, 0xd2, 0x5b, 0x90, 0xbe,
-	0xda, 0xdb, 0x73, 0xeb, 0x87, 0x54, 0x5a, 0xe0, 0xce, 0xf4, 0xb6, 0x79, 0xde, 0xb7, 0x63, 0x27,
-	0xe9, 0x63, 0x65, 0x4a, 0x43, 0x60, 0x01, 0xbb, 0x67, 0x01, 0x0d, 0xd5, 0xb2, 0x8e, 0x8c, 0xf2,
-	0x09, 0x4b, 0xaf, 0x6d, 0x1f, 0x81, 0x83, 0xde, 0xcb, 0x46, 0x43, 0xeb, 0x8d, 0x86, 0xde, 0x37,
-	0x1a, 0x7a, 0xdc, 0x6a, 0x99, 0xf5, 0x56, 0xcb, 0xbc, 0x6e, 0xb5, 0xcc, 0x6d, 0x7c, 0xa1, 0xe0,
-	0xce, 0xcc, 0x80, 0x5a, 0x0f, 0xe2, 0xfa, 0xd8, 0x2a, 0xf2, 0xc0, 0xe2, 0x9d, 0xbb, 0xa2, 0xb8,
-	0xb2, 0xb3, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0xe0, 0xfa, 0xb1, 0xc7, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0x45, 0xe3, 0xb6, 0x09, 0xcd, 0xc4, 0x01, 0x34, 0xa5, 0xad, 0x49, 0x91, 0x65, 0xc1, 0x26,
+	0x2c, 0x32, 0x76, 0x02, 0x51, 0xd7, 0x44, 0x91, 0x22, 0x76, 0x95, 0x41, 0x2c, 0xd8, 0x44, 0x43,
+	0x3c, 0xb2, 0xac, 0xba, 0x7e, 0x56, 0xde, 0x30, 0xa2, 0x7f, 0x81, 0xc4, 0xaf, 0xf4, 0x23, 0x58,
+	0x76, 0x89, 0xba, 0x42, 0xc9, 0x8f, 0x20, 0xcf, 0xd8, 0x49, 0x6a, 0xb2, 0x9c, 0x77, 0xcf, 0xbd,
+	0xbe, 0x7a, 0x9e, 0x21, 0x17, 0x0b, 0xc0, 0x1b, 0x40, 0x3f, 0x06, 0xe5, 0xab, 0xa1, 0x1f, 0x8b,
+	0x4c, 0x60, 0x82, 0x2c, 0x5f, 0x82, 0x04, 0xda, 0x35, 0x22, 0x8b, 0x41, 0x31, 0x35, 0xec, 0x9d,
+	0xd7, 0x58, 0x50, 0x86, 0xeb, 0xbd, 0x34, 0xc2, 0x5c, 0x9f, 0xfc, 0xd2, 0xa4, 0x0f, 0xaf, 0x7f,
+	0x35, 0x89, 0x3d, 0x33, 0xa1, 0x9f, 0x24, 0x97, 0x82, 0x06, 0xe4, 0x05, 0x4a, 0xbe, 0x94, 0x49,
+	0x16, 0x17, 0x7c, 0x0e, 0xc8, 0xd3, 0x79, 0x12, 0x39, 0x96, 0x67, 0xf5, 0x8f, 0x42, 0x5a, 0x69,
+	0x57, 0xa5, 0xf4, 0x31, 0xa2, 0x23, 0x72, 0x1c, 0x89, 0x1c, 0x30, 0x91, 0xe8, 0x1c, 0x78, 0x87,
+	0xfd, 0xce, 0xe8, 0x8c, 0x3d, 0x2a, 0xc6, 0xa6, 0x46, 0x0e, 0x37, 0x1c, 0x7d, 0x4b, 0x9a, 0x0a,
+	0xa4, 0x40, 0xe7, 0x50, 0x1b, 0x4e, 0x6a, 0x86, 0x2f, 0x20, 0x45, 0x68, 0x08, 0x3a, 0x26, 0xed,
+	0xaa, 0x07, 0x3a, 0x47, 0x1a, 0x3f, 0xaf, 0xe1, 0x55, 0x99, 0x70, 0x4b, 0xd2, 0x19, 0x79, 0x5a,
+	0x7e, 0x6d, 0x9e, 0xf3, 0x25, 0xbf, 0x41, 0xa7, 0xe9, 0x59, 0xfd, 0xce, 0xe8, 0xd5, 0xfe, 0x6e,
+	0x57, 0x9a, 0x99, 0x1c, 0x38, 0x56, 0xd8, 0x8d, 0x76, 0x47, 0x74, 0x4a, 0xba, 0x0a, 0xcc, 0x3a,
+	0x4c, 0x4e, 0x4b, 0xe7, 0x5c, 0xfc, 0x5f, 0xb9, 0x58, 0xcb, 0x36, 0xc6, 0x56, 0x3b, 0x13, 0xfa,
+	0x81, 0xd8, 0x92, 0xa7, 0xe9, 0x6d, 0x15, 0xf2, 0x44, 0x87, 0xf4, 0x6a, 0x21, 0x9f, 0x0b, 0x64,
+	0x27, 0xa3, 0x23, 0xb7, 0x03, 0x3a, 0x21, 0xad, 0xd2, 0x7c, 0xac, 0xcd, 0xa7, 0xf5, 0x2d, 0x18,
+	0xdf, 0xc9, 0xc3, 0xdd, 0xe0, 0x99, 0x51, 0x06, 0x18, 0x5d, 0x7b, 0x01, 0x7b, 0x7f, 0x19, 0x96,
+	0x4e, 0x7a, 0x49, 0xec, 0x05, 0x64, 0x28, 0x13, 0xf9, 0x5d, 0x26, 0x90, 0x39, 0x6d, 0xcf, 0xea,
+	0xb7, 0xf7, 0x58, 0xc6, 0x41, 0xf8, 0x08, 0xa4, 0x29, 0x39, 0x8b, 0x41, 0x89, 0x65, 0xc6, 0xb3,
+	0x85, 0x98, 0x47, 0x22, 0x15, 0x31, 0x2f, 0x04, 0x74, 0x88, 0xfe, 0x25, 0x6f, 0x6a, 0x65, 0x66,
+	0x1b, 0x78, 0xba, 0x61, 0x27, 0xcf, 0x1f, 0xee, 0x06, 0xf6, 0x8f, 0xe2, 0x62, 0x7a, 0x6a, 0xc8,
+	0x02, 0x16, 0x84, 0xa7, 0xf1, 0x1e, 0x0e, 0x27, 0xe3, 0xdf, 0x2b, 0xd7, 0xba, 0x5f, 0xb9, 0xd6,
+	0xdf, 0x95, 0x6b, 0xfd, 0x5c, 0xbb, 0x8d, 0xfb, 0xb5, 0xdb, 0xf8, 0xb3, 0x76, 0x1b, 0x5f, 0xcb,
+	0xf7, 0x80, 0xd1, 0x35, 0x4b, 0xc0, 0xd7, 0x59, 0xbe, 0xbc, 0xcd, 0x05, 0xfa, 0x6a, 0xf8, 0xad,
+	0xa5, 0xef, 0xf4, 0xbb, 0x7f, 0x03, 0x00, 0x87, 0x09, 0xec, 0x6b, 0x35, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -205,6 +217,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernanceDelegations) > 0 {
+		for iNdEx := len(m.GovernanceDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GovernanceDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.Constitution) > 0 {
 		i -= len(m.Constitution)
 		copy(dAtA[i:], m.Constitution)
@@ -368,6 +394,12 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.GovernanceDelegations) > 0 {
+		for _, e := range m.GovernanceDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -703,6 +735,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.Constitution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernanceDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernanceDelegations = append(m.GovernanceDelegations, &GovernanceDelegation{})
+			if err := m.GovernanceDelegations[len(m.GovernanceDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -956,15 +956,15 @@ type Params struct {
 	MaxDepositPeriod *time.Duration `protobuf:"bytes,2,opt,name=max_deposit_period,json=maxDepositPeriod,proto3,stdduration" json:"max_deposit_period,omitempty"`
 	// Duration of the voting period.
 	VotingPeriod *time.Duration `protobuf:"bytes,3,opt,name=voting_period,json=votingPeriod,proto3,stdduration" json:"voting_period,omitempty"`
-	// Minimum percentage of total stake needed to vote for a result to be
-	// considered valid.
+	//  Minimum percentage of total stake needed to vote for a result to be
+	//  considered valid.
 	Quorum string `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum,omitempty"`
-	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
+	//  Minimum proportion of Yes votes for proposal to pass. Default value: 0.5.
 	Threshold string `protobuf:"bytes,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	// Minimum value of Veto votes to Total votes ratio for proposal to be
-	// vetoed. Default value: 1/3.
+	//  Minimum value of Veto votes to Total votes ratio for proposal to be
+	//  vetoed. Default value: 1/3.
 	VetoThreshold string `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
-	// The ratio representing the proportion of the deposit value that must be paid at proposal submission.
+	//  The ratio representing the proportion of the deposit value that must be paid at proposal submission.
 	MinInitialDepositRatio string `protobuf:"bytes,7,opt,name=min_initial_deposit_ratio,json=minInitialDepositRatio,proto3" json:"min_initial_deposit_ratio,omitempty"`
 	// The cancel ratio which will not be returned back to the depositors when a proposal is cancelled.
 	ProposalCancelRatio string `protobuf:"bytes,8,opt,name=proposal_cancel_ratio,json=proposalCancelRatio,proto3" json:"proposal_cancel_ratio,omitempty"`
@@ -975,7 +975,7 @@ type Params struct {
 	ExpeditedVotingPeriod *time.Duration `protobuf:"bytes,10,opt,name=expedited_voting_period,json=expeditedVotingPeriod,proto3,stdduration" json:"expedited_voting_period,omitempty"`
 	// Minimum proportion of Yes votes for proposal to pass. Default value: 0.67.
 	ExpeditedThreshold string `protobuf:"bytes,11,opt,name=expedited_threshold,json=expeditedThreshold,proto3" json:"expedited_threshold,omitempty"`
-	// Minimum expedited deposit for a proposal to enter voting period.
+	//  Minimum expedited deposit for a proposal to enter voting period.
 	ExpeditedMinDeposit []types.Coin `protobuf:"bytes,12,rep,name=expedited_min_deposit,json=expeditedMinDeposit,proto3" json:"expedited_min_deposit"`
 	// burn deposits if a proposal does not meet quorum
 	BurnVoteQuorum bool `protobuf:"varint,13,opt,name=burn_vote_quorum,json=burnVoteQuorum,proto3" json:"burn_vote_quorum,omitempty"`
@@ -1004,6 +1004,9 @@ type Params struct {
 	// Minimum percentage of total stake needed to vote for a result to be
 	// considered valid for an expedited proposal.
 	ExpeditedQuorum string `protobuf:"bytes,21,opt,name=expedited_quorum,json=expeditedQuorum,proto3" json:"expedited_quorum,omitempty"`
+	// governor_power_cap defines the maximum voting power delegated to a governor that is counted in a tally.
+	// The cap is expressed as a percentage of the total bonded tokens.
+	GovernorPowerCap string `protobuf:"bytes,22,opt,name=governor_power_cap,json=governorPowerCap,proto3" json:"governor_power_cap,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1186,6 +1189,13 @@ func (m *Params) GetExpeditedQuorum() string {
 	return ""
 }
 
+func (m *Params) GetGovernorPowerCap() string {
+	if m != nil {
+		return m.GovernorPowerCap
+	}
+	return ""
+}
+
 // MessageBasedParams defines the parameters of specific messages in a proposal.
 // It is used to define the parameters of a proposal that is based on a specific message.
 // Once a message has message based params, it only supports a standard proposal type.
@@ -1271,6 +1281,126 @@ func (m *MessageBasedParams) GetVetoThreshold() string {
 	return ""
 }
 
+// GovernanceDelegation defines a delegation of the governance voting power of
+// a delegator to a governor.
+type GovernanceDelegation struct {
+	// delegator_address is the address of the account delegating its governance voting power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the address of the account voting on behalf of the delegator.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *GovernanceDelegation) Reset()         { *m = GovernanceDelegation{} }
+func (m *GovernanceDelegation) String() string { return proto.CompactTextString(m) }
+func (*GovernanceDelegation) ProtoMessage()    {}
+func (*GovernanceDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{11}
+}
+func (m *GovernanceDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GovernanceDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GovernanceDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GovernanceDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GovernanceDelegation.Merge(m, src)
+}
+func (m *GovernanceDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GovernanceDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GovernanceDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GovernanceDelegation proto.InternalMessageInfo
+
+func (m *GovernanceDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *GovernanceDelegation) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// Governor defines an account to which governance voting power is delegated.
+type Governor struct {
+	// governor_address is the address of the governor.
+	GovernorAddress string `protobuf:"bytes,1,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+	// delegators is the number of accounts delegating their governance voting power to the governor.
+	Delegators uint64 `protobuf:"varint,2,opt,name=delegators,proto3" json:"delegators,omitempty"`
+	// represented_power is the bonded voting power of the delegators of the governor.
+	RepresentedPower string `protobuf:"bytes,3,opt,name=represented_power,json=representedPower,proto3" json:"represented_power,omitempty"`
+}
+
+func (m *Governor) Reset()         { *m = Governor{} }
+func (m *Governor) String() string { return proto.CompactTextString(m) }
+func (*Governor) ProtoMessage()    {}
+func (*Governor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e05cb1c0d030febb, []int{12}
+}
+func (m *Governor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Governor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Governor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Governor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Governor.Merge(m, src)
+}
+func (m *Governor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Governor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Governor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Governor proto.InternalMessageInfo
+
+func (m *Governor) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+func (m *Governor) GetDelegators() uint64 {
+	if m != nil {
+		return m.Delegators
+	}
+	return 0
+}
+
+func (m *Governor) GetRepresentedPower() string {
+	if m != nil {
+		return m.RepresentedPower
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.gov.v1.ProposalType", ProposalType_name, ProposalType_value)
 	proto.RegisterEnum("cosmos.gov.v1.VoteOption", VoteOption_name, VoteOption_value)
@@ -1286,136 +1416,145 @@ func init() {
 	proto.RegisterType((*TallyParams)(nil), "cosmos.gov.v1.TallyParams")
 	proto.RegisterType((*Params)(nil), "cosmos.gov.v1.Params")
 	proto.RegisterType((*MessageBasedParams)(nil), "cosmos.gov.v1.MessageBasedParams")
+	proto.RegisterType((*GovernanceDelegation)(nil), "cosmos.gov.v1.GovernanceDelegation")
+	proto.RegisterType((*Governor)(nil), "cosmos.gov.v1.Governor")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/gov.proto", fileDescriptor_e05cb1c0d030febb) }
 
 var fileDescriptor_e05cb1c0d030febb = []byte{
-	// 1971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x19, 0xf6, 0x92, 0x14, 0x25, 0xbe, 0x22, 0xa9, 0xd5, 0x48, 0x8a, 0xd6, 0x52, 0x44, 0xc9, 0x44,
-	0x11, 0xa8, 0x4e, 0x44, 0x4a, 0x49, 0xd5, 0xa6, 0x6e, 0x72, 0x20, 0xc5, 0x75, 0xbc, 0x86, 0x25,
-	0xb2, 0xcb, 0xb5, 0x6c, 0xb7, 0x28, 0x16, 0x2b, 0xed, 0x58, 0xda, 0x84, 0xbb, 0xc3, 0xee, 0x0e,
-	0xf5, 0xd1, 0x5f, 0x91, 0x63, 0x4f, 0x45, 0x6f, 0xcd, 0xb1, 0x07, 0xa3, 0xf7, 0xde, 0x82, 0x1e,
-	0x8a, 0xc0, 0xa7, 0x22, 0x40, 0xdd, 0xc2, 0x3e, 0x14, 0xc8, 0x4f, 0x28, 0x0a, 0xb4, 0x98, 0xd9,
-	0x59, 0xee, 0xf2, 0x43, 0x16, 0x15, 0xf4, 0x62, 0x53, 0xf3, 0x3e, 0xcf, 0x33, 0xef, 0xbc, 0x5f,
-	0x33, 0x24, 0x2c, 0x1f, 0x93, 0xc0, 0x25, 0x41, 0xf5, 0x84, 0x9c, 0x55, 0xcf, 0x76, 0xd8, 0x7f,
-	0x95, 0xae, 0x4f, 0x28, 0x41, 0x85, 0xd0, 0x50, 0x61, 0x2b, 0x67, 0x3b, 0x2b, 0x25, 0x81, 0x3b,
-	0xb2, 0x02, 0x5c, 0x3d, 0xdb, 0x39, 0xc2, 0xd4, 0xda, 0xa9, 0x1e, 0x13, 0xc7, 0x0b, 0xe1, 0x2b,
-	0x8b, 0x27, 0xe4, 0x84, 0xf0, 0x8f, 0x55, 0xf6, 0x49, 0xac, 0xae, 0x9f, 0x10, 0x72, 0xd2, 0xc1,
-	0x55, 0xfe, 0xd7, 0x51, 0xef, 0x79, 0x95, 0x3a, 0x2e, 0x0e, 0xa8, 0xe5, 0x76, 0x05, 0xe0, 0xf6,
-	0x30, 0xc0, 0xf2, 0x2e, 0x85, 0xa9, 0x34, 0x6c, 0xb2, 0x7b, 0xbe, 0x45, 0x1d, 0x12, 0xed, 0x78,
-	0x3b, 0xf4, 0xc8, 0x0c, 0x37, 0x15, 0xde, 0x86, 0xa6, 0x79, 0xcb, 0x75, 0x3c, 0x52, 0xe5, 0xff,
-	0x86, 0x4b, 0x65, 0x02, 0xe8, 0x09, 0x76, 0x4e, 0x4e, 0x29, 0xb6, 0x0f, 0x09, 0xc5, 0xcd, 0x2e,
-	0x53, 0x42, 0x3b, 0x90, 0x25, 0xfc, 0x93, 0x22, 0x6d, 0x48, 0x9b, 0xc5, 0x0f, 0x6f, 0x57, 0x06,
-	0x4e, 0x5d, 0x89, 0xa1, 0xba, 0x00, 0xa2, 0xf7, 0x20, 0x7b, 0xce, 0x85, 0x94, 0xd4, 0x86, 0xb4,
-	0x99, 0xab, 0x17, 0x5f, 0xbe, 0xd8, 0x02, 0xc1, 0x6a, 0xe0, 0x63, 0x5d, 0x58, 0xcb, 0xbf, 0x97,
-	0x60, 0xba, 0x81, 0xbb, 0x24, 0x70, 0x28, 0x5a, 0x87, 0xd9, 0xae, 0x4f, 0xba, 0x24, 0xb0, 0x3a,
-	0xa6, 0x63, 0xf3, 0xbd, 0x32, 0x3a, 0x44, 0x4b, 0x9a, 0x8d, 0x7e, 0x0c, 0x39, 0x3b, 0xc4, 0x12,
-	0x5f, 0xe8, 0x2a, 0x2f, 0x5f, 0x6c, 0x2d, 0x0a, 0xdd, 0x9a, 0x6d, 0xfb, 0x38, 0x08, 0xda, 0xd4,
-	0x77, 0xbc, 0x13, 0x3d, 0x86, 0xa2, 0x4f, 0x20, 0x6b, 0xb9, 0xa4, 0xe7, 0x51, 0x25, 0xbd, 0x91,
-	0xde, 0x9c, 0x8d, 0xfd, 0x67, 0x69, 0xaa, 0x88, 0x34, 0x55, 0xf6, 0x88, 0xe3, 0xd5, 0x73, 0x5f,
-	0xbf, 0x5a, 0xbf, 0xf5, 0xd5, 0xbf, 0xfe, 0x78, 0x57, 0xd2, 0x05, 0xa7, 0xfc, 0xe7, 0x69, 0x98,
-	0x69, 0x09, 0x27, 0x50, 0x11, 0x52, 0x7d, 0xd7, 0x52, 0x8e, 0x8d, 0xb6, 0x61, 0xc6, 0xc5, 0x41,
-	0x60, 0x9d, 0xe0, 0x40, 0x49, 0x71, 0xf1, 0xc5, 0x4a, 0x98, 0x91, 0x4a, 0x94, 0x91, 0x4a, 0xcd,
-	0xbb, 0xd4, 0xfb, 0x28, 0xb4, 0x0b, 0xd9, 0x80, 0x5a, 0xb4, 0x17, 0x28, 0x69, 0x1e, 0xcc, 0xb5,
-	0xa1, 0x60, 0x46, 0x5b, 0xb5, 0x39, 0x48, 0x17, 0x60, 0xf4, 0x00, 0xd0, 0x73, 0xc7, 0xb3, 0x3a,
-	0x26, 0xb5, 0x3a, 0x9d, 0x4b, 0xd3, 0xc7, 0x41, 0xaf, 0x43, 0x95, 0xcc, 0x86, 0xb4, 0x39, 0xfb,
-	0xe1, 0xca, 0x90, 0x84, 0xc1, 0x20, 0x3a, 0x47, 0xe8, 0x32, 0x67, 0x25, 0x56, 0x50, 0x0d, 0x66,
-	0x83, 0xde, 0x91, 0xeb, 0x50, 0x93, 0x95, 0x99, 0x32, 0x25, 0x24, 0x86, 0xbd, 0x36, 0xa2, 0x1a,
-	0xac, 0x67, 0xbe, 0xfc, 0xc7, 0xba, 0xa4, 0x43, 0x48, 0x62, 0xcb, 0xe8, 0x21, 0xc8, 0x22, 0xba,
-	0x26, 0xf6, 0xec, 0x50, 0x27, 0x3b, 0xa1, 0x4e, 0x51, 0x30, 0x55, 0xcf, 0xe6, 0x5a, 0x1a, 0x14,
-	0x28, 0xa1, 0x56, 0xc7, 0x14, 0xeb, 0xca, 0xf4, 0x0d, 0x72, 0x94, 0xe7, 0xd4, 0xa8, 0x80, 0x1e,
-	0xc1, 0xfc, 0x19, 0xa1, 0x8e, 0x77, 0x62, 0x06, 0xd4, 0xf2, 0xc5, 0xf9, 0x66, 0x26, 0xf4, 0x6b,
-	0x2e, 0xa4, 0xb6, 0x19, 0x93, 0x3b, 0xf6, 0x00, 0xc4, 0x52, 0x7c, 0xc6, 0xdc, 0x84, 0x5a, 0x85,
-	0x90, 0x18, 0x1d, 0x71, 0x85, 0x15, 0x09, 0xb5, 0x6c, 0x8b, 0x5a, 0x0a, 0xb0, 0xb2, 0xd5, 0xfb,
-	0x7f, 0xa3, 0x1f, 0xc2, 0x14, 0x75, 0x68, 0x07, 0x2b, 0xb3, 0xbc, 0x9e, 0x17, 0xbe, 0x7d, 0xb1,
-	0x35, 0x17, 0x9e, 0x7c, 0x2b, 0xb0, 0xbf, 0xd8, 0xd8, 0xae, 0xfc, 0xe8, 0x27, 0x7a, 0x88, 0x40,
-	0x5b, 0x30, 0x1d, 0xf4, 0x5c, 0xd7, 0xf2, 0x2f, 0x95, 0xfc, 0xd5, 0xe0, 0x08, 0x83, 0x3e, 0x83,
-	0x99, 0xb0, 0x77, 0xb0, 0xaf, 0x14, 0x38, 0xfe, 0xfd, 0xab, 0x9a, 0x65, 0x9c, 0x4e, 0x9f, 0x8c,
-	0x3e, 0x82, 0x1c, 0xbe, 0xe8, 0x62, 0xdb, 0xa1, 0xd8, 0x56, 0x8a, 0x1b, 0xd2, 0xe6, 0x4c, 0x7d,
-	0x69, 0x84, 0xb1, 0xbb, 0xad, 0x48, 0x7a, 0x8c, 0x43, 0x1f, 0x43, 0xe1, 0xb9, 0xe5, 0x74, 0xb0,
-	0x6d, 0xfa, 0xd8, 0x0a, 0x88, 0xa7, 0xcc, 0x5d, 0xe1, 0xf2, 0xee, 0xb6, 0x9e, 0x0f, 0x91, 0x3a,
-	0x07, 0x22, 0x1d, 0x0a, 0xfd, 0x31, 0x40, 0x2f, 0xbb, 0x58, 0x91, 0x79, 0x9f, 0xac, 0x5e, 0xd1,
-	0x27, 0xc6, 0x65, 0x17, 0xd7, 0xe5, 0x6f, 0x5f, 0x6c, 0xe5, 0x2f, 0xd8, 0x5c, 0xde, 0x38, 0xdb,
-	0xa9, 0x6c, 0x57, 0xb6, 0xf5, 0x7c, 0x37, 0x61, 0x2f, 0xff, 0x45, 0x82, 0x85, 0x88, 0x10, 0x4f,
-	0xab, 0x00, 0xad, 0x01, 0x84, 0x03, 0xcb, 0x24, 0x1e, 0xe6, 0x6d, 0x9d, 0xd3, 0x73, 0xe1, 0x4a,
-	0xd3, 0xc3, 0x09, 0x33, 0x3d, 0x27, 0xe1, 0xc4, 0x89, 0xcc, 0xc6, 0x39, 0x41, 0x77, 0x20, 0x1f,
-	0x99, 0x4f, 0x7d, 0x8c, 0x79, 0x43, 0xe7, 0xf4, 0x59, 0x01, 0x60, 0x4b, 0x6c, 0xa6, 0x09, 0xc8,
-	0x73, 0xd2, 0xf3, 0x79, 0xbf, 0xe6, 0x74, 0x21, 0x7a, 0x9f, 0xf4, 0xfc, 0x04, 0x20, 0xe8, 0x5a,
-	0x2e, 0xef, 0xc6, 0x3e, 0xa0, 0xdd, 0xb5, 0xdc, 0x7b, 0xf2, 0xcb, 0xa1, 0xa3, 0x95, 0xff, 0x93,
-	0x86, 0xd9, 0x64, 0x43, 0x6f, 0x41, 0xee, 0x12, 0x07, 0xe6, 0x31, 0x9f, 0x70, 0xfc, 0x0c, 0x75,
-	0x39, 0x31, 0x6e, 0x35, 0xb6, 0xaa, 0xcf, 0x5c, 0xe2, 0x60, 0x8f, 0x21, 0xd0, 0x2e, 0x14, 0xac,
-	0xa3, 0x80, 0x5a, 0x8e, 0x27, 0x28, 0xa9, 0x2b, 0x28, 0x79, 0x01, 0x0b, 0x69, 0xef, 0xc3, 0x8c,
-	0x47, 0x04, 0x23, 0x7d, 0x05, 0x63, 0xda, 0x23, 0x21, 0xf8, 0x53, 0x40, 0x1e, 0x31, 0xcf, 0x1d,
-	0x7a, 0x6a, 0x9e, 0x61, 0x1a, 0xd1, 0x32, 0x57, 0xd0, 0xe6, 0x3c, 0xf2, 0xc4, 0xa1, 0xa7, 0x87,
-	0x98, 0x0a, 0xfa, 0xc7, 0x20, 0xc7, 0x69, 0x11, 0xe4, 0xa9, 0x91, 0x7b, 0x44, 0xf3, 0xa8, 0x5e,
-	0xec, 0x27, 0x6b, 0x98, 0x49, 0xcf, 0xa3, 0x6d, 0xb3, 0x6f, 0x63, 0x1a, 0xe7, 0x62, 0xcf, 0x4f,
-	0x00, 0x25, 0x93, 0x29, 0xb8, 0xd3, 0x63, 0xb9, 0x72, 0x22, 0xc5, 0x21, 0xfb, 0x1e, 0xcc, 0x27,
-	0xf2, 0x2c, 0xc8, 0x33, 0x63, 0xc9, 0x73, 0x71, 0xf6, 0x43, 0xee, 0x16, 0x00, 0xcb, 0xbd, 0x20,
-	0xe5, 0xc6, 0x92, 0x72, 0x0c, 0xc1, 0xe1, 0xe5, 0x3f, 0x49, 0x90, 0x61, 0x35, 0x7c, 0xfd, 0x7d,
-	0x59, 0x81, 0xa9, 0x33, 0x42, 0xf1, 0xf5, 0x77, 0x65, 0x08, 0x43, 0x3f, 0x83, 0xe9, 0xd0, 0xb7,
-	0x40, 0xc9, 0xf0, 0x21, 0x7c, 0x67, 0xa8, 0xe7, 0x46, 0xdf, 0x06, 0x7a, 0xc4, 0x18, 0x18, 0x72,
-	0x53, 0x83, 0x43, 0xee, 0x61, 0x66, 0x26, 0x2d, 0x67, 0xca, 0x7f, 0x97, 0xa0, 0x20, 0x46, 0x75,
-	0xcb, 0xf2, 0x2d, 0x37, 0x40, 0xcf, 0x60, 0xd6, 0x75, 0xbc, 0xfe, 0xe4, 0x97, 0xae, 0x9b, 0xfc,
-	0x6b, 0x6c, 0xf2, 0x7f, 0xf7, 0x6a, 0x7d, 0x29, 0xc1, 0xfa, 0x80, 0xb8, 0x0e, 0xc5, 0x6e, 0x97,
-	0x5e, 0xea, 0xe0, 0x3a, 0x5e, 0x74, 0x17, 0xb8, 0x80, 0x5c, 0xeb, 0x22, 0x02, 0x99, 0x5d, 0xec,
-	0x3b, 0xc4, 0xe6, 0x81, 0x60, 0x3b, 0x0c, 0x0f, 0xf0, 0x86, 0x78, 0x34, 0xd5, 0x7f, 0xf0, 0xdd,
-	0xab, 0xf5, 0x77, 0x47, 0x89, 0xf1, 0x26, 0xbf, 0x65, 0xf3, 0x5d, 0x76, 0xad, 0x8b, 0xe8, 0x24,
-	0xdc, 0x7e, 0x2f, 0xa5, 0x48, 0xe5, 0xa7, 0x90, 0x3f, 0xe4, 0x73, 0x5f, 0x9c, 0xae, 0x01, 0xe2,
-	0x1e, 0x88, 0x76, 0x97, 0xae, 0xdb, 0x3d, 0xc3, 0xd5, 0xf3, 0x21, 0x2b, 0xa1, 0xfc, 0x3b, 0x49,
-	0x74, 0xbc, 0x50, 0x7e, 0x0f, 0xb2, 0xbf, 0xee, 0x11, 0xbf, 0xe7, 0x8a, 0x76, 0x1f, 0x79, 0x5d,
-	0x85, 0x56, 0xf4, 0x01, 0xe4, 0x58, 0x31, 0x07, 0xa7, 0xa4, 0x63, 0x5f, 0xf1, 0x10, 0x8b, 0x01,
-	0x68, 0x17, 0x8a, 0xbc, 0x59, 0x63, 0x4a, 0x7a, 0x2c, 0xa5, 0xc0, 0x50, 0x46, 0x04, 0xe2, 0x0e,
-	0xfe, 0x37, 0x0f, 0x59, 0xe1, 0x9b, 0x7a, 0xc3, 0x9c, 0x26, 0x6e, 0xf3, 0x64, 0xfe, 0xf6, 0xbf,
-	0x5f, 0xfe, 0x32, 0xe3, 0xf3, 0x33, 0x9a, 0x8b, 0xf4, 0xf7, 0xc8, 0x45, 0x22, 0xee, 0x99, 0xc9,
-	0xe3, 0x3e, 0x75, 0xf3, 0xb8, 0x67, 0x27, 0x88, 0x3b, 0xd2, 0xe0, 0x36, 0x0b, 0xb4, 0xe3, 0x39,
-	0xd4, 0x89, 0x9f, 0x4f, 0x26, 0x77, 0x7f, 0xcc, 0xdc, 0x62, 0x0a, 0xef, 0xb8, 0x8e, 0xa7, 0x85,
-	0x78, 0x11, 0x1e, 0x9d, 0xa1, 0xd1, 0x63, 0x58, 0xea, 0x4f, 0x92, 0x63, 0xcb, 0x3b, 0xc6, 0x1d,
-	0x21, 0x13, 0x4e, 0xb0, 0x3b, 0x83, 0x32, 0xe3, 0xae, 0xf0, 0x85, 0x88, 0xbf, 0xc7, 0xe9, 0xa1,
-	0xec, 0xaf, 0x60, 0x71, 0x58, 0xd6, 0xc6, 0x41, 0x34, 0xe2, 0x26, 0x7f, 0x8d, 0xec, 0x6e, 0xeb,
-	0x68, 0x50, 0xbf, 0x81, 0x03, 0x8a, 0x3e, 0x87, 0xe5, 0xfe, 0x7b, 0xc3, 0x1c, 0xcc, 0x2e, 0x5c,
-	0x97, 0xdd, 0x65, 0x96, 0xdd, 0x71, 0x1b, 0x2d, 0xf5, 0x25, 0x0f, 0x93, 0x99, 0xd7, 0x61, 0x21,
-	0xde, 0x2b, 0x4e, 0xd4, 0xec, 0xa4, 0xf1, 0x41, 0x7d, 0x76, 0x9c, 0xc0, 0xa7, 0x10, 0x6f, 0x66,
-	0x26, 0x7b, 0x26, 0x7f, 0x83, 0x9e, 0x89, 0xdd, 0xda, 0x8f, 0x9b, 0xe7, 0x53, 0x90, 0x8f, 0x7a,
-	0xbe, 0xc7, 0x82, 0x82, 0x4d, 0x51, 0xb1, 0x05, 0xfe, 0x70, 0x1b, 0xfb, 0x64, 0x2c, 0x32, 0x30,
-	0x9b, 0xe9, 0x3f, 0x0f, 0xcb, 0xf7, 0x10, 0xd6, 0x38, 0xbd, 0x9f, 0xbc, 0x7e, 0x17, 0xfa, 0x98,
-	0x49, 0x8a, 0x47, 0xe0, 0x58, 0xad, 0x15, 0xc6, 0x8c, 0x9e, 0x5a, 0x51, 0x0f, 0x86, 0x34, 0xf4,
-	0x53, 0x28, 0xc6, 0x6e, 0xb1, 0x62, 0xe6, 0x8f, 0xc2, 0x2b, 0x84, 0xf2, 0x91, 0x53, 0xec, 0x59,
-	0x80, 0xf6, 0x61, 0x3e, 0x11, 0x21, 0x51, 0x9d, 0xf2, 0xa4, 0xd1, 0x9f, 0x8b, 0x07, 0x4b, 0x58,
-	0x99, 0xbf, 0x84, 0x95, 0xe1, 0xca, 0x64, 0xd3, 0x46, 0x54, 0xcf, 0x3c, 0xd7, 0x2d, 0x8d, 0xe8,
-	0x0e, 0xbe, 0x30, 0x97, 0x07, 0x4b, 0x72, 0xdf, 0xba, 0x10, 0xb5, 0xd2, 0x85, 0x75, 0x76, 0x29,
-	0xba, 0x4e, 0x40, 0x9d, 0x63, 0xd3, 0xea, 0xd1, 0x53, 0xe2, 0x3b, 0xbf, 0xc1, 0xb6, 0x69, 0x85,
-	0x55, 0x8e, 0x03, 0x05, 0x6d, 0xa4, 0x37, 0x73, 0xf5, 0xcd, 0xb7, 0x74, 0xc0, 0xe0, 0x5e, 0x6b,
-	0xb1, 0x60, 0xad, 0xaf, 0x57, 0x8b, 0xe4, 0xd0, 0x11, 0x24, 0x00, 0xa6, 0x8f, 0x3f, 0xc7, 0xc7,
-	0x83, 0x75, 0xba, 0x30, 0xd1, 0x89, 0x56, 0x63, 0x11, 0x5d, 0x68, 0xc4, 0xd5, 0xfa, 0x29, 0x00,
-	0x7b, 0x65, 0x8a, 0x6a, 0x5a, 0x9c, 0x48, 0x90, 0xbd, 0x4b, 0x45, 0x4d, 0x69, 0x20, 0xc7, 0xc5,
-	0x2e, 0x44, 0x96, 0x26, 0x12, 0x99, 0xeb, 0xf3, 0x42, 0xa9, 0x7b, 0x0b, 0x2f, 0x47, 0xcb, 0xa5,
-	0xfc, 0x55, 0x0a, 0xd0, 0x7e, 0xf8, 0x1d, 0xbb, 0x6e, 0x05, 0xd8, 0xfe, 0x7f, 0xde, 0xc1, 0x89,
-	0xb9, 0x9f, 0x7a, 0xeb, 0xdc, 0xdf, 0x1a, 0x13, 0xa3, 0x91, 0xc1, 0x1f, 0xc7, 0x64, 0xe0, 0x9a,
-	0x48, 0xdf, 0xfc, 0x9a, 0xc8, 0x4c, 0x72, 0x3d, 0x8f, 0x7c, 0x7f, 0xb8, 0xfb, 0x07, 0x09, 0xf2,
-	0xc9, 0x6f, 0x4f, 0x68, 0x0d, 0x6e, 0xb7, 0xf4, 0x66, 0xab, 0xd9, 0xae, 0x3d, 0x32, 0x8d, 0x67,
-	0x2d, 0xd5, 0x7c, 0x7c, 0xd0, 0x6e, 0xa9, 0x7b, 0xda, 0x7d, 0x4d, 0x6d, 0xc8, 0xb7, 0xd0, 0x0a,
-	0xbc, 0x33, 0x68, 0x6e, 0x1b, 0xb5, 0x83, 0x46, 0x4d, 0x6f, 0xc8, 0x12, 0xba, 0x03, 0x6b, 0x83,
-	0xb6, 0xfd, 0xc7, 0x8f, 0x0c, 0xad, 0xf5, 0x48, 0x35, 0xf7, 0x1e, 0x34, 0xb5, 0x3d, 0x55, 0x4e,
-	0xa1, 0x77, 0x41, 0x19, 0x84, 0x34, 0x5b, 0x86, 0xb6, 0xaf, 0xb5, 0x0d, 0x6d, 0x4f, 0x4e, 0xa3,
-	0x55, 0x58, 0x1e, 0xb4, 0xaa, 0x4f, 0x5b, 0x6a, 0x43, 0x33, 0xd4, 0x86, 0x9c, 0xb9, 0xfb, 0x6f,
-	0x09, 0x20, 0xf1, 0x3b, 0xd4, 0x2a, 0x2c, 0x1f, 0x36, 0x8d, 0x50, 0xa0, 0x79, 0x30, 0xe4, 0xe5,
-	0x02, 0xcc, 0x25, 0x8d, 0xcf, 0xd4, 0xb6, 0x2c, 0x0d, 0x2f, 0x36, 0x0f, 0x54, 0x59, 0x42, 0xcb,
-	0xb0, 0x90, 0x5c, 0xac, 0xd5, 0xdb, 0x46, 0x4d, 0x3b, 0x90, 0x53, 0xc3, 0x68, 0xe3, 0x49, 0x53,
-	0x4e, 0x21, 0x04, 0xc5, 0xe4, 0xe2, 0x41, 0x53, 0x4e, 0xa3, 0x25, 0x98, 0x1f, 0x00, 0x3e, 0xd0,
-	0x55, 0x55, 0x4e, 0xb3, 0x93, 0x0e, 0x42, 0xcd, 0x27, 0x9a, 0xf1, 0xc0, 0x3c, 0x54, 0x8d, 0xa6,
-	0x9c, 0x41, 0x8b, 0x20, 0x27, 0xad, 0xf7, 0x9b, 0x8f, 0xf5, 0xd1, 0xd5, 0x76, 0xab, 0xb6, 0x2f,
-	0x4f, 0xad, 0xa4, 0x64, 0xe9, 0xee, 0x5f, 0x25, 0x28, 0x0e, 0xfe, 0x18, 0x84, 0xd6, 0x61, 0xb5,
-	0x1f, 0xac, 0xb6, 0x51, 0x33, 0x1e, 0xb7, 0x87, 0x82, 0x50, 0x86, 0xd2, 0x30, 0xa0, 0xa1, 0xb6,
-	0x9a, 0x6d, 0xcd, 0x30, 0x5b, 0xaa, 0xae, 0x35, 0x87, 0x53, 0x26, 0x30, 0x87, 0x4d, 0x43, 0x3b,
-	0xf8, 0x2c, 0x82, 0xa4, 0x06, 0x32, 0x2e, 0x20, 0xad, 0x5a, 0xbb, 0xad, 0x36, 0xc2, 0x43, 0x0e,
-	0xdb, 0x74, 0xf5, 0xa1, 0xba, 0xc7, 0x33, 0x36, 0x8e, 0x79, 0xbf, 0xa6, 0x3d, 0x52, 0x1b, 0xf2,
-	0x54, 0x7d, 0xf7, 0xeb, 0xd7, 0x25, 0xe9, 0x9b, 0xd7, 0x25, 0xe9, 0x9f, 0xaf, 0x4b, 0xd2, 0x97,
-	0x6f, 0x4a, 0xb7, 0xbe, 0x79, 0x53, 0xba, 0xf5, 0xb7, 0x37, 0xa5, 0x5b, 0xbf, 0x58, 0x0d, 0xcb,
-	0x37, 0xb0, 0xbf, 0xa8, 0x38, 0xa4, 0xca, 0x8b, 0xb5, 0xca, 0xbe, 0xfa, 0x07, 0xd5, 0xb3, 0x9d,
-	0xa3, 0x2c, 0xef, 0xd1, 0x8f, 0xfe, 0x17, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x55, 0x4b, 0xa4, 0x84,
-	0x15, 0x00, 0x00,
+	// 2088 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
+	0x19, 0x0e, 0x25, 0xf9, 0x43, 0xaf, 0x25, 0x99, 0x1e, 0xdb, 0x31, 0x63, 0xaf, 0x65, 0xc7, 0x28,
+	0x16, 0x6e, 0x76, 0x2d, 0xdb, 0xbb, 0x75, 0xbb, 0xcd, 0x6e, 0x0e, 0x92, 0xc5, 0x24, 0x0c, 0x6c,
+	0x4b, 0xa5, 0x18, 0x27, 0x69, 0x51, 0x10, 0xb4, 0x39, 0x91, 0xb9, 0x2b, 0x72, 0x54, 0x72, 0x64,
+	0xc7, 0xfd, 0x15, 0x7b, 0xec, 0xa9, 0xe8, 0xad, 0x7b, 0xec, 0x21, 0xe8, 0xb1, 0x40, 0x6f, 0x8b,
+	0x02, 0x2d, 0x16, 0x39, 0x15, 0x0b, 0x34, 0x2d, 0x92, 0x43, 0x81, 0xfd, 0x09, 0x45, 0x0f, 0xc5,
+	0x0c, 0x87, 0x22, 0xf5, 0x15, 0xcb, 0x8b, 0x5e, 0x12, 0xeb, 0x9d, 0xe7, 0x79, 0xe6, 0x9d, 0xf7,
+	0x6b, 0x46, 0x82, 0xa5, 0x53, 0x12, 0xb8, 0x24, 0xd8, 0x6e, 0x92, 0xf3, 0xed, 0xf3, 0x5d, 0xf6,
+	0x5f, 0xa9, 0xed, 0x13, 0x4a, 0x50, 0x3e, 0x5c, 0x28, 0x31, 0xcb, 0xf9, 0xee, 0x72, 0x51, 0xe0,
+	0x4e, 0xac, 0x00, 0x6f, 0x9f, 0xef, 0x9e, 0x60, 0x6a, 0xed, 0x6e, 0x9f, 0x12, 0xc7, 0x0b, 0xe1,
+	0xcb, 0x0b, 0x4d, 0xd2, 0x24, 0xfc, 0xcf, 0x6d, 0xf6, 0x97, 0xb0, 0xae, 0x35, 0x09, 0x69, 0xb6,
+	0xf0, 0x36, 0xff, 0x74, 0xd2, 0x79, 0xbe, 0x4d, 0x1d, 0x17, 0x07, 0xd4, 0x72, 0xdb, 0x02, 0x70,
+	0xab, 0x1f, 0x60, 0x79, 0x97, 0x62, 0xa9, 0xd8, 0xbf, 0x64, 0x77, 0x7c, 0x8b, 0x3a, 0x24, 0xda,
+	0xf1, 0x56, 0xe8, 0x91, 0x19, 0x6e, 0x2a, 0xbc, 0x0d, 0x97, 0xe6, 0x2c, 0xd7, 0xf1, 0xc8, 0x36,
+	0xff, 0x37, 0x34, 0x6d, 0x10, 0x40, 0x4f, 0xb0, 0xd3, 0x3c, 0xa3, 0xd8, 0x3e, 0x26, 0x14, 0xd7,
+	0xda, 0x4c, 0x09, 0xed, 0xc2, 0x24, 0xe1, 0x7f, 0x29, 0xd2, 0xba, 0xb4, 0x59, 0xf8, 0xe8, 0x56,
+	0xa9, 0xe7, 0xd4, 0xa5, 0x18, 0xaa, 0x0b, 0x20, 0x7a, 0x1f, 0x26, 0x2f, 0xb8, 0x90, 0x92, 0x5a,
+	0x97, 0x36, 0xb3, 0x95, 0xc2, 0xab, 0x97, 0x5b, 0x20, 0x58, 0x55, 0x7c, 0xaa, 0x8b, 0xd5, 0x8d,
+	0xdf, 0x49, 0x30, 0x55, 0xc5, 0x6d, 0x12, 0x38, 0x14, 0xad, 0xc1, 0x4c, 0xdb, 0x27, 0x6d, 0x12,
+	0x58, 0x2d, 0xd3, 0xb1, 0xf9, 0x5e, 0x19, 0x1d, 0x22, 0x93, 0x66, 0xa3, 0x1f, 0x43, 0xd6, 0x0e,
+	0xb1, 0xc4, 0x17, 0xba, 0xca, 0xab, 0x97, 0x5b, 0x0b, 0x42, 0xb7, 0x6c, 0xdb, 0x3e, 0x0e, 0x82,
+	0x06, 0xf5, 0x1d, 0xaf, 0xa9, 0xc7, 0x50, 0xf4, 0x19, 0x4c, 0x5a, 0x2e, 0xe9, 0x78, 0x54, 0x49,
+	0xaf, 0xa7, 0x37, 0x67, 0x62, 0xff, 0x59, 0x9a, 0x4a, 0x22, 0x4d, 0xa5, 0x7d, 0xe2, 0x78, 0x95,
+	0xec, 0xd7, 0xaf, 0xd7, 0x6e, 0x7c, 0xf5, 0xef, 0x3f, 0xdc, 0x91, 0x74, 0xc1, 0xd9, 0xf8, 0xf3,
+	0x14, 0x4c, 0xd7, 0x85, 0x13, 0xa8, 0x00, 0xa9, 0xae, 0x6b, 0x29, 0xc7, 0x46, 0x3b, 0x30, 0xed,
+	0xe2, 0x20, 0xb0, 0x9a, 0x38, 0x50, 0x52, 0x5c, 0x7c, 0xa1, 0x14, 0x66, 0xa4, 0x14, 0x65, 0xa4,
+	0x54, 0xf6, 0x2e, 0xf5, 0x2e, 0x0a, 0xed, 0xc1, 0x64, 0x40, 0x2d, 0xda, 0x09, 0x94, 0x34, 0x0f,
+	0xe6, 0x6a, 0x5f, 0x30, 0xa3, 0xad, 0x1a, 0x1c, 0xa4, 0x0b, 0x30, 0x7a, 0x08, 0xe8, 0xb9, 0xe3,
+	0x59, 0x2d, 0x93, 0x5a, 0xad, 0xd6, 0xa5, 0xe9, 0xe3, 0xa0, 0xd3, 0xa2, 0x4a, 0x66, 0x5d, 0xda,
+	0x9c, 0xf9, 0x68, 0xb9, 0x4f, 0xc2, 0x60, 0x10, 0x9d, 0x23, 0x74, 0x99, 0xb3, 0x12, 0x16, 0x54,
+	0x86, 0x99, 0xa0, 0x73, 0xe2, 0x3a, 0xd4, 0x64, 0x65, 0xa6, 0x4c, 0x08, 0x89, 0x7e, 0xaf, 0x8d,
+	0xa8, 0x06, 0x2b, 0x99, 0x2f, 0xff, 0xb9, 0x26, 0xe9, 0x10, 0x92, 0x98, 0x19, 0x3d, 0x02, 0x59,
+	0x44, 0xd7, 0xc4, 0x9e, 0x1d, 0xea, 0x4c, 0x8e, 0xa9, 0x53, 0x10, 0x4c, 0xd5, 0xb3, 0xb9, 0x96,
+	0x06, 0x79, 0x4a, 0xa8, 0xd5, 0x32, 0x85, 0x5d, 0x99, 0xba, 0x46, 0x8e, 0x72, 0x9c, 0x1a, 0x15,
+	0xd0, 0x01, 0xcc, 0x9d, 0x13, 0xea, 0x78, 0x4d, 0x33, 0xa0, 0x96, 0x2f, 0xce, 0x37, 0x3d, 0xa6,
+	0x5f, 0xb3, 0x21, 0xb5, 0xc1, 0x98, 0xdc, 0xb1, 0x87, 0x20, 0x4c, 0xf1, 0x19, 0xb3, 0x63, 0x6a,
+	0xe5, 0x43, 0x62, 0x74, 0xc4, 0x65, 0x56, 0x24, 0xd4, 0xb2, 0x2d, 0x6a, 0x29, 0xc0, 0xca, 0x56,
+	0xef, 0x7e, 0x46, 0x3f, 0x84, 0x09, 0xea, 0xd0, 0x16, 0x56, 0x66, 0x78, 0x3d, 0xcf, 0x7f, 0xfb,
+	0x72, 0x6b, 0x36, 0x3c, 0xf9, 0x56, 0x60, 0x7f, 0xb1, 0xbe, 0x53, 0xfa, 0xd1, 0x4f, 0xf4, 0x10,
+	0x81, 0xb6, 0x60, 0x2a, 0xe8, 0xb8, 0xae, 0xe5, 0x5f, 0x2a, 0xb9, 0xd1, 0xe0, 0x08, 0x83, 0x1e,
+	0xc0, 0x74, 0xd8, 0x3b, 0xd8, 0x57, 0xf2, 0x1c, 0xff, 0xc1, 0xa8, 0x66, 0x19, 0xa6, 0xd3, 0x25,
+	0xa3, 0x8f, 0x21, 0x8b, 0x5f, 0xb4, 0xb1, 0xed, 0x50, 0x6c, 0x2b, 0x85, 0x75, 0x69, 0x73, 0xba,
+	0xb2, 0x38, 0xc0, 0xd8, 0xdb, 0x51, 0x24, 0x3d, 0xc6, 0xa1, 0x4f, 0x20, 0xff, 0xdc, 0x72, 0x5a,
+	0xd8, 0x36, 0x7d, 0x6c, 0x05, 0xc4, 0x53, 0x66, 0x47, 0xb8, 0xbc, 0xb7, 0xa3, 0xe7, 0x42, 0xa4,
+	0xce, 0x81, 0x48, 0x87, 0x7c, 0x77, 0x0c, 0xd0, 0xcb, 0x36, 0x56, 0x64, 0xde, 0x27, 0x2b, 0x23,
+	0xfa, 0xc4, 0xb8, 0x6c, 0xe3, 0x8a, 0xfc, 0xed, 0xcb, 0xad, 0xdc, 0x0b, 0x36, 0x97, 0xd7, 0xcf,
+	0x77, 0x4b, 0x3b, 0xa5, 0x1d, 0x3d, 0xd7, 0x4e, 0xac, 0x6f, 0xfc, 0x45, 0x82, 0xf9, 0x88, 0x10,
+	0x4f, 0xab, 0x00, 0xad, 0x02, 0x84, 0x03, 0xcb, 0x24, 0x1e, 0xe6, 0x6d, 0x9d, 0xd5, 0xb3, 0xa1,
+	0xa5, 0xe6, 0xe1, 0xc4, 0x32, 0xbd, 0x20, 0x4a, 0x2a, 0xb9, 0x6c, 0x5c, 0x10, 0x74, 0x1b, 0x72,
+	0xd1, 0xf2, 0x99, 0x8f, 0x31, 0x6f, 0xe8, 0xac, 0x3e, 0x23, 0x00, 0xcc, 0xc4, 0x66, 0x9a, 0x80,
+	0x3c, 0x27, 0x1d, 0x9f, 0xf7, 0x6b, 0x56, 0x17, 0xa2, 0xf7, 0x49, 0xc7, 0x4f, 0x00, 0x82, 0xb6,
+	0xe5, 0x2a, 0x13, 0x49, 0x40, 0xa3, 0x6d, 0xb9, 0x77, 0xe5, 0x57, 0x7d, 0x47, 0xdb, 0xf8, 0x6f,
+	0x1a, 0x66, 0x92, 0x0d, 0xbd, 0x05, 0xd9, 0x4b, 0x1c, 0x98, 0xa7, 0x7c, 0xc2, 0xf1, 0x33, 0x54,
+	0xe4, 0xc4, 0xb8, 0xd5, 0x98, 0x55, 0x9f, 0xbe, 0xc4, 0xc1, 0x3e, 0x43, 0xa0, 0x3d, 0xc8, 0x5b,
+	0x27, 0x01, 0xb5, 0x1c, 0x4f, 0x50, 0x52, 0x23, 0x28, 0x39, 0x01, 0x0b, 0x69, 0x1f, 0xc0, 0xb4,
+	0x47, 0x04, 0x23, 0x3d, 0x82, 0x31, 0xe5, 0x91, 0x10, 0x7c, 0x0f, 0x90, 0x47, 0xcc, 0x0b, 0x87,
+	0x9e, 0x99, 0xe7, 0x98, 0x46, 0xb4, 0xcc, 0x08, 0xda, 0xac, 0x47, 0x9e, 0x38, 0xf4, 0xec, 0x18,
+	0x53, 0x41, 0xff, 0x04, 0xe4, 0x38, 0x2d, 0x82, 0x3c, 0x31, 0x70, 0x8f, 0x68, 0x1e, 0xd5, 0x0b,
+	0xdd, 0x64, 0xf5, 0x33, 0xe9, 0x45, 0xb4, 0xed, 0xe4, 0xbb, 0x98, 0xc6, 0x85, 0xd8, 0xf3, 0x33,
+	0x40, 0xc9, 0x64, 0x0a, 0xee, 0xd4, 0x50, 0xae, 0x9c, 0x48, 0x71, 0xc8, 0xbe, 0x0b, 0x73, 0x89,
+	0x3c, 0x0b, 0xf2, 0xf4, 0x50, 0xf2, 0x6c, 0x9c, 0xfd, 0x90, 0xbb, 0x05, 0xc0, 0x72, 0x2f, 0x48,
+	0xd9, 0xa1, 0xa4, 0x2c, 0x43, 0x70, 0xf8, 0xc6, 0x1f, 0x25, 0xc8, 0xb0, 0x1a, 0xbe, 0xfa, 0xbe,
+	0x2c, 0xc1, 0xc4, 0x39, 0xa1, 0xf8, 0xea, 0xbb, 0x32, 0x84, 0xa1, 0x4f, 0x61, 0x2a, 0xf4, 0x2d,
+	0x50, 0x32, 0x7c, 0x08, 0xdf, 0xee, 0xeb, 0xb9, 0xc1, 0xb7, 0x81, 0x1e, 0x31, 0x7a, 0x86, 0xdc,
+	0x44, 0xef, 0x90, 0x7b, 0x94, 0x99, 0x4e, 0xcb, 0x99, 0x8d, 0x7f, 0x48, 0x90, 0x17, 0xa3, 0xba,
+	0x6e, 0xf9, 0x96, 0x1b, 0xa0, 0x67, 0x30, 0xe3, 0x3a, 0x5e, 0x77, 0xf2, 0x4b, 0x57, 0x4d, 0xfe,
+	0x55, 0x36, 0xf9, 0xbf, 0x7b, 0xbd, 0xb6, 0x98, 0x60, 0x7d, 0x48, 0x5c, 0x87, 0x62, 0xb7, 0x4d,
+	0x2f, 0x75, 0x70, 0x1d, 0x2f, 0xba, 0x0b, 0x5c, 0x40, 0xae, 0xf5, 0x22, 0x02, 0x99, 0x6d, 0xec,
+	0x3b, 0xc4, 0xe6, 0x81, 0x60, 0x3b, 0xf4, 0x0f, 0xf0, 0xaa, 0x78, 0x34, 0x55, 0x7e, 0xf0, 0xdd,
+	0xeb, 0xb5, 0xf7, 0x06, 0x89, 0xf1, 0x26, 0xbf, 0x61, 0xf3, 0x5d, 0x76, 0xad, 0x17, 0xd1, 0x49,
+	0xf8, 0xfa, 0xdd, 0x94, 0x22, 0x6d, 0x3c, 0x85, 0xdc, 0x31, 0x9f, 0xfb, 0xe2, 0x74, 0x55, 0x10,
+	0xf7, 0x40, 0xb4, 0xbb, 0x74, 0xd5, 0xee, 0x19, 0xae, 0x9e, 0x0b, 0x59, 0x09, 0xe5, 0xdf, 0x4a,
+	0xa2, 0xe3, 0x85, 0xf2, 0xfb, 0x30, 0xf9, 0xab, 0x0e, 0xf1, 0x3b, 0xae, 0x22, 0x0d, 0x54, 0x0b,
+	0x7f, 0x5d, 0x85, 0xab, 0xe8, 0x43, 0xc8, 0xb2, 0x62, 0x0e, 0xce, 0x48, 0xcb, 0x1e, 0xf1, 0x10,
+	0x8b, 0x01, 0x68, 0x0f, 0x0a, 0xbc, 0x59, 0x63, 0x4a, 0x7a, 0x28, 0x25, 0xcf, 0x50, 0x46, 0x04,
+	0xe2, 0x0e, 0xfe, 0x35, 0x0f, 0x93, 0xc2, 0x37, 0xf5, 0x9a, 0x39, 0x4d, 0xdc, 0xe6, 0xc9, 0xfc,
+	0x1d, 0x7e, 0xbf, 0xfc, 0x65, 0x86, 0xe7, 0x67, 0x30, 0x17, 0xe9, 0xef, 0x91, 0x8b, 0x44, 0xdc,
+	0x33, 0xe3, 0xc7, 0x7d, 0xe2, 0xfa, 0x71, 0x9f, 0x1c, 0x23, 0xee, 0x48, 0x83, 0x5b, 0x2c, 0xd0,
+	0x8e, 0xe7, 0x50, 0x27, 0x7e, 0x3e, 0x99, 0xdc, 0x7d, 0x65, 0x6a, 0xa8, 0xc2, 0x4d, 0xd7, 0xf1,
+	0xb4, 0x10, 0x2f, 0xc2, 0xa3, 0x33, 0x34, 0x7a, 0x0c, 0x8b, 0xdd, 0x49, 0x72, 0x6a, 0x79, 0xa7,
+	0xb8, 0x25, 0x64, 0xc2, 0x09, 0x76, 0xbb, 0x57, 0x66, 0xd8, 0x15, 0x3e, 0x1f, 0xf1, 0xf7, 0x39,
+	0x3d, 0x94, 0xfd, 0x25, 0x2c, 0xf4, 0xcb, 0xda, 0x38, 0x88, 0x46, 0xdc, 0xf8, 0xaf, 0x91, 0xbd,
+	0x1d, 0x1d, 0xf5, 0xea, 0x57, 0x71, 0x40, 0xd1, 0xe7, 0xb0, 0xd4, 0x7d, 0x6f, 0x98, 0xbd, 0xd9,
+	0x85, 0xab, 0xb2, 0xbb, 0xc4, 0xb2, 0x3b, 0x6c, 0xa3, 0xc5, 0xae, 0xe4, 0x71, 0x32, 0xf3, 0x3a,
+	0xcc, 0xc7, 0x7b, 0xc5, 0x89, 0x9a, 0x19, 0x37, 0x3e, 0xa8, 0xcb, 0x8e, 0x13, 0xf8, 0x14, 0xe2,
+	0xcd, 0xcc, 0x64, 0xcf, 0xe4, 0xae, 0xd1, 0x33, 0xb1, 0x5b, 0x87, 0x71, 0xf3, 0xdc, 0x03, 0xf9,
+	0xa4, 0xe3, 0x7b, 0x2c, 0x28, 0xd8, 0x14, 0x15, 0x9b, 0xe7, 0x0f, 0xb7, 0xa1, 0x4f, 0xc6, 0x02,
+	0x03, 0xb3, 0x99, 0xfe, 0xb3, 0xb0, 0x7c, 0x8f, 0x61, 0x95, 0xd3, 0xbb, 0xc9, 0xeb, 0x76, 0xa1,
+	0x8f, 0x99, 0xa4, 0x52, 0x18, 0xad, 0xb5, 0xcc, 0x98, 0xd1, 0x53, 0x2b, 0xea, 0xc1, 0x90, 0x86,
+	0x7e, 0x0a, 0x85, 0xd8, 0x2d, 0x56, 0xcc, 0xca, 0xec, 0x68, 0xa1, 0x5c, 0xe4, 0x14, 0x7b, 0x16,
+	0xa0, 0x43, 0x98, 0x4b, 0x44, 0x48, 0x54, 0xa7, 0x3c, 0x6e, 0xf4, 0x67, 0xe3, 0xc1, 0x12, 0x56,
+	0xe6, 0x2f, 0x60, 0xb9, 0xbf, 0x32, 0xd9, 0xb4, 0x11, 0xd5, 0x33, 0xc7, 0x75, 0x8b, 0x03, 0xba,
+	0xbd, 0x2f, 0xcc, 0xa5, 0xde, 0x92, 0x3c, 0xb4, 0x5e, 0x88, 0x5a, 0x69, 0xc3, 0x1a, 0xbb, 0x14,
+	0x5d, 0x27, 0xa0, 0xce, 0xa9, 0x69, 0x75, 0xe8, 0x19, 0xf1, 0x9d, 0x5f, 0x63, 0xdb, 0xb4, 0xc2,
+	0x2a, 0xc7, 0x81, 0x82, 0xd6, 0xd3, 0x9b, 0xd9, 0xca, 0xe6, 0x3b, 0x3a, 0xa0, 0x77, 0xaf, 0xd5,
+	0x58, 0xb0, 0xdc, 0xd5, 0x2b, 0x47, 0x72, 0xe8, 0x04, 0x12, 0x00, 0xd3, 0xc7, 0x9f, 0xe3, 0xd3,
+	0xde, 0x3a, 0x9d, 0x1f, 0xeb, 0x44, 0x2b, 0xb1, 0x88, 0x2e, 0x34, 0xe2, 0x6a, 0xbd, 0x07, 0xc0,
+	0x5e, 0x99, 0xa2, 0x9a, 0x16, 0xc6, 0x12, 0x64, 0xef, 0x52, 0x51, 0x53, 0x1a, 0xc8, 0x71, 0xb1,
+	0x0b, 0x91, 0xc5, 0xb1, 0x44, 0x66, 0xbb, 0x3c, 0x21, 0x75, 0x00, 0xa8, 0x49, 0xce, 0xb1, 0xef,
+	0x11, 0xdf, 0x6c, 0x93, 0x0b, 0xec, 0x9b, 0xa7, 0x56, 0x5b, 0xb9, 0x39, 0x96, 0x98, 0x1c, 0x31,
+	0xeb, 0x8c, 0xb8, 0x6f, 0xb5, 0xef, 0xce, 0xbf, 0x1a, 0x2c, 0xbe, 0x8d, 0xaf, 0x52, 0x80, 0x0e,
+	0xc3, 0x6f, 0xec, 0x15, 0x2b, 0xc0, 0xf6, 0xff, 0xf3, 0x46, 0x4f, 0xdc, 0x22, 0xa9, 0x77, 0xde,
+	0x22, 0x5b, 0x43, 0x22, 0x3e, 0x70, 0x8d, 0xc4, 0x11, 0xee, 0xb9, 0x74, 0xd2, 0xd7, 0xbf, 0x74,
+	0x32, 0xe3, 0x5c, 0xf6, 0x83, 0xdf, 0x46, 0x5e, 0x4a, 0xb0, 0xf0, 0x80, 0x07, 0x95, 0xb5, 0x41,
+	0x15, 0xb7, 0x70, 0x93, 0x1f, 0x1d, 0xa9, 0x30, 0x67, 0x87, 0x9f, 0x88, 0x1f, 0x95, 0xbe, 0x22,
+	0x5d, 0xf1, 0x12, 0x95, 0xbb, 0x14, 0x61, 0x47, 0xfb, 0xd0, 0xcd, 0x59, 0x57, 0xe5, 0xaa, 0xf7,
+	0xec, 0x6c, 0xc4, 0x10, 0xe6, 0x21, 0x6e, 0xff, 0x49, 0x82, 0xe9, 0x07, 0x02, 0x35, 0x74, 0x0f,
+	0xe9, 0x9a, 0x7b, 0xa0, 0x22, 0x40, 0xd7, 0xf9, 0xd0, 0xc5, 0x8c, 0x9e, 0xb0, 0xa0, 0x4f, 0x61,
+	0xce, 0xc7, 0x6d, 0x1f, 0x07, 0xd8, 0x63, 0x3d, 0xc0, 0x2b, 0x77, 0x44, 0x9e, 0xe4, 0x04, 0x90,
+	0x17, 0xea, 0xe0, 0x01, 0xee, 0xfc, 0x5e, 0x82, 0x5c, 0xf2, 0x3b, 0x30, 0x5a, 0x85, 0x5b, 0x75,
+	0xbd, 0x56, 0xaf, 0x35, 0xca, 0x07, 0xa6, 0xf1, 0xac, 0xae, 0x9a, 0x8f, 0x8f, 0x1a, 0x75, 0x75,
+	0x5f, 0xbb, 0xaf, 0xa9, 0x55, 0xf9, 0x06, 0x5a, 0x86, 0x9b, 0xbd, 0xcb, 0x0d, 0xa3, 0x7c, 0x54,
+	0x2d, 0xeb, 0x55, 0x59, 0x42, 0xb7, 0x61, 0xb5, 0x77, 0xed, 0xf0, 0xf1, 0x81, 0xa1, 0xd5, 0x0f,
+	0x54, 0x73, 0xff, 0x61, 0x4d, 0xdb, 0x57, 0xe5, 0x14, 0x7a, 0x0f, 0x94, 0x5e, 0x48, 0xad, 0x6e,
+	0x68, 0x87, 0x5a, 0xc3, 0xd0, 0xf6, 0xe5, 0x34, 0x5a, 0x81, 0xa5, 0xde, 0x55, 0xf5, 0x69, 0x5d,
+	0xad, 0x6a, 0x86, 0x5a, 0x95, 0x33, 0x77, 0xfe, 0x23, 0x01, 0x24, 0x7e, 0x4d, 0x5c, 0x81, 0xa5,
+	0xe3, 0x9a, 0x11, 0x0a, 0xd4, 0x8e, 0xfa, 0xbc, 0x9c, 0x87, 0xd9, 0xe4, 0xe2, 0x33, 0xb5, 0x21,
+	0x4b, 0xfd, 0xc6, 0xda, 0x91, 0x2a, 0x4b, 0x68, 0x09, 0xe6, 0x93, 0xc6, 0x72, 0xa5, 0x61, 0x94,
+	0xb5, 0x23, 0x39, 0xd5, 0x8f, 0x36, 0x9e, 0xd4, 0xe4, 0x14, 0x42, 0x50, 0x48, 0x1a, 0x8f, 0x6a,
+	0x72, 0x1a, 0x2d, 0xc2, 0x5c, 0x0f, 0xf0, 0xa1, 0xae, 0xaa, 0x72, 0x9a, 0x9d, 0xb4, 0x17, 0x6a,
+	0x3e, 0xd1, 0x8c, 0x87, 0xe6, 0xb1, 0x6a, 0xd4, 0xe4, 0x0c, 0x5a, 0x00, 0x39, 0xb9, 0x7a, 0xbf,
+	0xf6, 0x58, 0x1f, 0xb4, 0x36, 0xea, 0xe5, 0x43, 0x79, 0x62, 0x39, 0x25, 0x4b, 0x77, 0xfe, 0x26,
+	0x41, 0xa1, 0xf7, 0x27, 0x3d, 0xb4, 0x06, 0x2b, 0xdd, 0x60, 0x35, 0x8c, 0xb2, 0xf1, 0xb8, 0xd1,
+	0x17, 0x84, 0x0d, 0x28, 0xf6, 0x03, 0xaa, 0x6a, 0xbd, 0xd6, 0xd0, 0x0c, 0xb3, 0xae, 0xea, 0x5a,
+	0xad, 0x3f, 0x65, 0x02, 0x73, 0x5c, 0x33, 0xb4, 0xa3, 0x07, 0x11, 0x24, 0xd5, 0x93, 0x71, 0x01,
+	0xa9, 0x97, 0x1b, 0x0d, 0xb5, 0x1a, 0x1e, 0xb2, 0x7f, 0x4d, 0x57, 0x1f, 0xa9, 0xfb, 0x3c, 0x63,
+	0xc3, 0x98, 0xf7, 0xcb, 0xda, 0x81, 0x5a, 0x95, 0x27, 0x2a, 0x7b, 0x5f, 0xbf, 0x29, 0x4a, 0xdf,
+	0xbc, 0x29, 0x4a, 0xff, 0x7a, 0x53, 0x94, 0xbe, 0x7c, 0x5b, 0xbc, 0xf1, 0xcd, 0xdb, 0xe2, 0x8d,
+	0xbf, 0xbf, 0x2d, 0xde, 0xf8, 0xf9, 0x4a, 0x58, 0xc1, 0x81, 0xfd, 0x45, 0xc9, 0x21, 0xdb, 0xbc,
+	0x58, 0xb7, 0xd9, 0x0f, 0x38, 0x01, 0xfb, 0x25, 0x7c, 0x92, 0xcf, 0xc6, 0x8f, 0xff, 0x37, 0x00,
+	0x38, 0x9a, 0x2a, 0x1d, 0x4a, 0x17, 0x00, 0x00,
 }
 
 func (m *WeightedVoteOption) Marshal() (dAtA []byte, err error) {
@@ -2011,6 +2150,15 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GovernorPowerCap) > 0 {
+		i -= len(m.GovernorPowerCap)
+		copy(dAtA[i:], m.GovernorPowerCap)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorPowerCap)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if len(m.ExpeditedQuorum) > 0 {
 		i -= len(m.ExpeditedQuorum)
 		copy(dAtA[i:], m.ExpeditedQuorum)
@@ -2270,6 +2418,85 @@ func (m *MessageBasedParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GovernanceDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GovernanceDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GovernanceDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Governor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Governor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Governor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RepresentedPower) > 0 {
+		i -= len(m.RepresentedPower)
+		copy(dAtA[i:], m.RepresentedPower)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.RepresentedPower)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Delegators != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.Delegators))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -2638,6 +2865,10 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovGov(uint64(l))
 	}
+	l = len(m.GovernorPowerCap)
+	if l > 0 {
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -2670,6 +2901,43 @@ func (m *MessageBasedParams) Size() (n int) {
 	return n
 }
 
+func (m *GovernanceDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
+func (m *Governor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.Delegators != 0 {
+		n += 1 + sovGov(uint64(m.Delegators))
+	}
+	l = len(m.RepresentedPower)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5195,6 +5463,38 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ExpeditedQuorum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorPowerCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorPowerCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGov(dAtA[iNdEx:])
@@ -5430,6 +5730,253 @@ func (m *MessageBasedParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GovernanceDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GovernanceDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GovernanceDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Governor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Governor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Governor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegators", wireType)
+			}
+			m.Delegators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Delegators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepresentedPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepresentedPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -37,6 +37,7 @@ var (
 	DefaultMinDepositRatio              = sdkmath.LegacyMustNewDecFromStr("0.01")
 	DefaultOptimisticRejectedThreshold  = sdkmath.LegacyMustNewDecFromStr("0.1")
 	DefaultOptimisticAuthorizedAddreses = []string(nil)
+	DefaultGovernorPowerCap             = sdkmath.LegacyNewDecWithPrec(1, 1)
 )
 
 // NewParams creates a new Params instance with given values.
@@ -47,6 +48,7 @@ func NewParams(
 	burnProposalDeposit, burnVoteQuorum, burnVoteVeto bool,
 	minDepositRatio, optimisticRejectedThreshold string,
 	optimisticAuthorizedAddresses []string,
+	governorPowerCap string,
 ) Params {
 	return Params{
 		MinDeposit:                    minDeposit,
@@ -70,6 +72,7 @@ func NewParams(
 		MinDepositRatio:               minDepositRatio,
 		OptimisticRejectedThreshold:   optimisticRejectedThreshold,
 		OptimisticAuthorizedAddresses: optimisticAuthorizedAddresses,
+		GovernorPowerCap:              governorPowerCap,
 	}
 }
 
@@ -97,6 +100,7 @@ func DefaultParams() Params {
 		DefaultMinDepositRatio.String(),
 		DefaultOptimisticRejectedThreshold.String(),
 		DefaultOptimisticAuthorizedAddreses,
+		DefaultGovernorPowerCap.String(),
 	)
 }
 
@@ -203,6 +207,17 @@ func (p Params) ValidateBasic(addressCodec address.Codec) error {
 		return fmt.Errorf("optimistic rejected threshold too large: %s", optimisticRejectedThreshold)
 	}
 
+	governorPowerCap, err := sdkmath.LegacyNewDecFromStr(p.GovernorPowerCap)
+	if err != nil {
+		return fmt.Errorf("invalid governor power cap string: %w", err)
+	}
+	if !governorPowerCap.IsPositive() {
+		return fmt.Errorf("governor power cap must be positive: %s", governorPowerCap)
+	}
+	if governorPowerCap.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("governor power cap too large: %s", governorPowerCap)
+	}
+
 	if p.VotingPeriod == nil {
 		return fmt.Errorf("voting period must not be nil: %d", p.VotingPeriod)
 	}
@@ -1169,6 +1169,182 @@ func (m *MsgSudoExecResponse) GetResult() []byte {
 	return nil
 }
 
+// MsgDelegateGovernance defines a message to delegate the governance voting power of an account to a governor.
+// The governor votes on behalf of the delegator unless the delegator votes directly.
+type MsgDelegateGovernance struct {
+	// delegator_address is the address of the account delegating its governance voting power.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// governor_address is the address of the account voting on behalf of the delegator.
+	GovernorAddress string `protobuf:"bytes,2,opt,name=governor_address,json=governorAddress,proto3" json:"governor_address,omitempty"`
+}
+
+func (m *MsgDelegateGovernance) Reset()         { *m = MsgDelegateGovernance{} }
+func (m *MsgDelegateGovernance) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernance) ProtoMessage()    {}
+func (*MsgDelegateGovernance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{20}
+}
+func (m *MsgDelegateGovernance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernance.Merge(m, src)
+}
+func (m *MsgDelegateGovernance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernance proto.InternalMessageInfo
+
+func (m *MsgDelegateGovernance) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *MsgDelegateGovernance) GetGovernorAddress() string {
+	if m != nil {
+		return m.GovernorAddress
+	}
+	return ""
+}
+
+// MsgDelegateGovernanceResponse defines the Msg/DelegateGovernance response type.
+type MsgDelegateGovernanceResponse struct {
+}
+
+func (m *MsgDelegateGovernanceResponse) Reset()         { *m = MsgDelegateGovernanceResponse{} }
+func (m *MsgDelegateGovernanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelegateGovernanceResponse) ProtoMessage()    {}
+func (*MsgDelegateGovernanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{21}
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelegateGovernanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelegateGovernanceResponse.Merge(m, src)
+}
+func (m *MsgDelegateGovernanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelegateGovernanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelegateGovernanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelegateGovernanceResponse proto.InternalMessageInfo
+
+// MsgUndelegateGovernance defines a message to remove the governance delegation of an account.
+type MsgUndelegateGovernance struct {
+	// delegator_address is the address of the account removing its governance delegation.
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+}
+
+func (m *MsgUndelegateGovernance) Reset()         { *m = MsgUndelegateGovernance{} }
+func (m *MsgUndelegateGovernance) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernance) ProtoMessage()    {}
+func (*MsgUndelegateGovernance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{22}
+}
+func (m *MsgUndelegateGovernance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernance.Merge(m, src)
+}
+func (m *MsgUndelegateGovernance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernance proto.InternalMessageInfo
+
+func (m *MsgUndelegateGovernance) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// MsgUndelegateGovernanceResponse defines the Msg/UndelegateGovernance response type.
+type MsgUndelegateGovernanceResponse struct {
+}
+
+func (m *MsgUndelegateGovernanceResponse) Reset()         { *m = MsgUndelegateGovernanceResponse{} }
+func (m *MsgUndelegateGovernanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUndelegateGovernanceResponse) ProtoMessage()    {}
+func (*MsgUndelegateGovernanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ff8f4a63b6fc9a9, []int{23}
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUndelegateGovernanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUndelegateGovernanceResponse.Merge(m, src)
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUndelegateGovernanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUndelegateGovernanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUndelegateGovernanceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1.MsgSubmitProposalResponse")
@@ -1190,105 +1366,118 @@ func init() {
 	proto.RegisterType((*MsgUpdateMessageParamsResponse)(nil), "cosmos.gov.v1.MsgUpdateMessageParamsResponse")
 	proto.RegisterType((*MsgSudoExec)(nil), "cosmos.gov.v1.MsgSudoExec")
 	proto.RegisterType((*MsgSudoExecResponse)(nil), "cosmos.gov.v1.MsgSudoExecResponse")
+	proto.RegisterType((*MsgDelegateGovernance)(nil), "cosmos.gov.v1.MsgDelegateGovernance")
+	proto.RegisterType((*MsgDelegateGovernanceResponse)(nil), "cosmos.gov.v1.MsgDelegateGovernanceResponse")
+	proto.RegisterType((*MsgUndelegateGovernance)(nil), "cosmos.gov.v1.MsgUndelegateGovernance")
+	proto.RegisterType((*MsgUndelegateGovernanceResponse)(nil), "cosmos.gov.v1.MsgUndelegateGovernanceResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1/tx.proto", fileDescriptor_9ff8f4a63b6fc9a9) }
 
 var fileDescriptor_9ff8f4a63b6fc9a9 = []byte{
-	// 1480 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xc6, 0x89, 0xed, 0x4c, 0xdc, 0xa4, 0xd9, 0xb8, 0xc9, 0x66, 0x9b, 0xda, 0xee, 0x16,
-	0xa8, 0x49, 0xf1, 0xda, 0x4e, 0x9b, 0x02, 0xa6, 0xaa, 0x54, 0x87, 0x02, 0x95, 0x30, 0x54, 0xdb,
-	0x0f, 0x24, 0xa8, 0x64, 0x6d, 0xbc, 0xc3, 0x66, 0x55, 0xaf, 0x67, 0xf1, 0xac, 0xad, 0xf8, 0x80,
-	0x84, 0x38, 0xf6, 0xd4, 0x0b, 0x12, 0x42, 0xe2, 0x0e, 0x9c, 0x72, 0xf0, 0x09, 0x8e, 0x5c, 0xaa,
-	0x1c, 0x50, 0x95, 0x03, 0x42, 0x3d, 0xb4, 0xa8, 0x15, 0x44, 0xe2, 0x9f, 0x00, 0xcd, 0xec, 0xec,
-	0xda, 0xfb, 0x61, 0x27, 0x2d, 0x12, 0xe2, 0xd2, 0x7a, 0xdf, 0xd7, 0xbc, 0xf7, 0x9b, 0x37, 0xbf,
-	0xf7, 0x02, 0x96, 0x1a, 0x08, 0x9b, 0x08, 0x17, 0x75, 0xd4, 0x2d, 0x76, 0xcb, 0x45, 0x7b, 0x47,
-	0xb6, 0xda, 0xc8, 0x46, 0xfc, 0x31, 0x47, 0x2e, 0xeb, 0xa8, 0x2b, 0x77, 0xcb, 0x62, 0x86, 0x99,
-	0x6d, 0xa9, 0x18, 0x16, 0xbb, 0xe5, 0x2d, 0x68, 0xab, 0xe5, 0x62, 0x03, 0x19, 0x2d, 0xc7, 0x5c,
-	0x5c, 0xf6, 0x87, 0x21, 0x5e, 0x8e, 0x22, 0xad, 0x23, 0x1d, 0xd1, 0x9f, 0x45, 0xf2, 0x8b, 0x49,
-	0x57, 0x1c, 0xf3, 0xba, 0xa3, 0x60, 0x47, 0x31, 0x95, 0x8e, 0x90, 0xde, 0x84, 0x45, 0xfa, 0xb5,
-	0xd5, 0xf9, 0xb4, 0xa8, 0xb6, 0x7a, 0x81, 0x43, 0x4c, 0xac, 0x93, 0x43, 0x4c, 0xac, 0x33, 0xc5,
-	0x82, 0x6a, 0x1a, 0x2d, 0x54, 0xa4, 0xff, 0x32, 0x51, 0x36, 0x18, 0xc6, 0x36, 0x4c, 0x88, 0x6d,
-	0xd5, 0xb4, 0x1c, 0x03, 0xe9, 0xc7, 0x29, 0xb0, 0x50, 0xc3, 0xfa, 0x8d, 0xce, 0x96, 0x69, 0xd8,
-	0xd7, 0xdb, 0xc8, 0x42, 0x58, 0x6d, 0xf2, 0x25, 0x90, 0x34, 0x21, 0xc6, 0xaa, 0x0e, 0xb1, 0xc0,
-	0xe5, 0x62, 0xf9, 0xd9, 0xf5, 0xb4, 0xec, 0x44, 0x92, 0xdd, 0x48, 0xf2, 0x95, 0x56, 0x4f, 0xf1,
-	0xac, 0xf8, 0x7b, 0x1c, 0x98, 0x37, 0x5a, 0x86, 0x6d, 0xa8, 0xcd, 0xba, 0x06, 0x2d, 0x84, 0x0d,
-	0x5b, 0x98, 0xa4, 0x9e, 0x2b, 0x32, 0x2b, 0x8c, 0x80, 0x26, 0x33, 0xd0, 0xe4, 0x4d, 0x64, 0xb4,
-	0xaa, 0xef, 0x3c, 0x78, 0x9c, 0x9d, 0xf8, 0xe1, 0x49, 0x36, 0xaf, 0x1b, 0xf6, 0x76, 0x67, 0x4b,
-	0x6e, 0x20, 0x93, 0xa1, 0xc0, 0xfe, 0x2b, 0x60, 0xed, 0x6e, 0xd1, 0xee, 0x59, 0x10, 0x53, 0x07,
-	0xfc, 0xcd, 0xc1, 0xee, 0x5a, 0xaa, 0x09, 0x75, 0xb5, 0xd1, 0xab, 0x13, 0xd8, 0xf1, 0x77, 0x07,
-	0xbb, 0x6b, 0x9c, 0x32, 0xc7, 0x4e, 0x7e, 0xdb, 0x39, 0x98, 0xbf, 0x00, 0x92, 0x16, 0x2d, 0x05,
-	0xb6, 0x85, 0x58, 0x8e, 0xcb, 0xcf, 0x54, 0x85, 0xfd, 0x7e, 0x21, 0xcd, 0xf2, 0xb8, 0xa2, 0x69,
-	0x6d, 0x88, 0xf1, 0x0d, 0xbb, 0x6d, 0xb4, 0x74, 0xc5, 0xb3, 0xe4, 0x45, 0x52, 0xb4, 0xad, 0x6a,
-	0xaa, 0xad, 0x0a, 0x53, 0xc4, 0x4b, 0xf1, 0xbe, 0xf9, 0x57, 0xc1, 0xb4, 0x6d, 0xd8, 0x4d, 0x28,
-	0x4c, 0xd3, 0x70, 0x8b, 0x8f, 0xfa, 0x85, 0xf9, 0x41, 0x8a, 0xb9, 0x92, 0x7c, 0xe1, 0x75, 0xc5,
-	0xb1, 0xe0, 0x0b, 0x20, 0x81, 0x3b, 0xa6, 0xa9, 0xb6, 0x7b, 0x42, 0x7c, 0xb4, 0xb1, 0x6b, 0xc3,
-	0x9f, 0x07, 0x33, 0x70, 0xc7, 0x82, 0x9a, 0x61, 0x43, 0x4d, 0x48, 0xe4, 0xb8, 0x7c, 0xb2, 0x7a,
-	0x22, 0xe4, 0xb0, 0x51, 0x12, 0x38, 0x65, 0x60, 0xc7, 0x2b, 0xe0, 0x98, 0xc5, 0xee, 0xaa, 0x4e,
-	0xe0, 0x11, 0x92, 0x39, 0x2e, 0x3f, 0xb7, 0x7e, 0x52, 0xf6, 0xb5, 0xab, 0xec, 0xde, 0xe7, 0xcd,
-	0x9e, 0x05, 0xab, 0xc7, 0x1f, 0xf5, 0x0b, 0xa9, 0x1d, 0xd2, 0x93, 0xb9, 0x6e, 0x59, 0x2e, 0xc9,
-	0x25, 0x25, 0x65, 0x0d, 0xe9, 0x2b, 0xe5, 0x2f, 0x0f, 0x76, 0xd7, 0x3c, 0x34, 0xee, 0x1d, 0xec,
-	0xae, 0x65, 0x87, 0x2e, 0xa1, 0x5b, 0x2e, 0x86, 0xda, 0x44, 0xba, 0x04, 0x56, 0x42, 0x42, 0x05,
-	0x62, 0x0b, 0xb5, 0x30, 0xe4, 0xb3, 0x60, 0xd6, 0xcb, 0xd1, 0xd0, 0x04, 0x2e, 0xc7, 0xe5, 0xa7,
-	0x14, 0xe0, 0x8a, 0xae, 0x69, 0xd2, 0x4f, 0x1c, 0x48, 0xd7, 0xb0, 0x7e, 0x75, 0x07, 0x36, 0xde,
-	0xa7, 0x57, 0xba, 0x89, 0x5a, 0x36, 0x6c, 0xd9, 0xfc, 0x07, 0x20, 0xd1, 0x70, 0x7e, 0x52, 0xaf,
-	0x11, 0xcd, 0x57, 0xcd, 0xec, 0xf5, 0x0b, 0xa2, 0xaf, 0x60, 0xb7, 0xb5, 0xa8, 0xaf, 0xe2, 0x06,
-	0xe1, 0x57, 0xc1, 0x8c, 0xda, 0xb1, 0xb7, 0x51, 0xdb, 0xb0, 0x7b, 0xc2, 0x24, 0xbd, 0xd9, 0x81,
-	0xa0, 0xb2, 0x41, 0xea, 0x1e, 0x7c, 0x93, 0xc2, 0xa5, 0x50, 0xe1, 0xa1, 0x24, 0xa5, 0x0c, 0x58,
-	0x8d, 0x92, 0xbb, 0xe5, 0x4b, 0x7f, 0x70, 0x20, 0x51, 0xc3, 0xfa, 0x6d, 0x64, 0x43, 0x7e, 0x23,
-	0x02, 0x8a, 0x6a, 0xfa, 0xaf, 0xc7, 0xd9, 0x61, 0xb1, 0xd3, 0xca, 0x43, 0x00, 0xf1, 0x32, 0x98,
-	0xee, 0x22, 0x1b, 0xb6, 0x9d, 0x9c, 0xc7, 0xf4, 0xb0, 0x63, 0xc6, 0x97, 0x41, 0x1c, 0x59, 0xb6,
-	0x81, 0x5a, 0xb4, 0xe9, 0xe7, 0x06, 0x2f, 0x8f, 0xb5, 0x03, 0xc9, 0xe5, 0x43, 0x6a, 0xa0, 0x30,
-	0xc3, 0x71, 0x3d, 0x5f, 0x79, 0x89, 0x00, 0xe3, 0x84, 0x26, 0xa0, 0x9c, 0x08, 0x81, 0x42, 0xe2,
-	0x49, 0x0b, 0x60, 0x9e, 0xfd, 0xf4, 0x4a, 0xff, 0x9b, 0xf3, 0x64, 0x1f, 0x41, 0x43, 0xdf, 0x26,
-	0x1d, 0xfb, 0x1f, 0x41, 0xf0, 0x16, 0x48, 0x38, 0x95, 0x61, 0x21, 0x46, 0xd9, 0xe7, 0x74, 0x00,
-	0x03, 0x37, 0xa1, 0x21, 0x2c, 0x5c, 0x8f, 0xb1, 0x60, 0xbc, 0xe6, 0x07, 0xe3, 0x54, 0x24, 0x18,
-	0x6e, 0x70, 0x69, 0x05, 0x2c, 0x07, 0x44, 0x1e, 0x38, 0x7f, 0x72, 0x00, 0xd4, 0xb0, 0xee, 0x52,
-	0xd5, 0x0b, 0xe2, 0x72, 0x11, 0xcc, 0x30, 0x96, 0x45, 0x87, 0x63, 0x33, 0x30, 0xe5, 0x2f, 0x81,
-	0xb8, 0x6a, 0xa2, 0x4e, 0xcb, 0x66, 0xf0, 0x8c, 0x21, 0xe7, 0x19, 0x42, 0xce, 0xce, 0xc9, 0xcc,
-	0xa7, 0x72, 0x8e, 0x3e, 0x15, 0x2f, 0x1a, 0x01, 0x42, 0x08, 0x01, 0xc1, 0x2a, 0x93, 0xd2, 0x80,
-	0x1f, 0x7c, 0x79, 0xe5, 0xff, 0xe2, 0xf4, 0xc6, 0x2d, 0x4b, 0x53, 0x6d, 0x78, 0x5d, 0x6d, 0xab,
-	0x26, 0x26, 0xc5, 0x0c, 0xde, 0x27, 0x77, 0x58, 0x31, 0x9e, 0x29, 0xff, 0x06, 0x88, 0x5b, 0x34,
-	0x02, 0x45, 0x60, 0x76, 0xfd, 0x44, 0x90, 0xfe, 0xa8, 0xd2, 0x57, 0x88, 0x63, 0x5f, 0xb9, 0xb6,
-	0x1f, 0xa6, 0xe4, 0x30, 0x0d, 0x9c, 0x19, 0xaa, 0x6d, 0xc7, 0x9d, 0xe9, 0x81, 0xe4, 0x25, 0x99,
-	0x5e, 0xf5, 0xb0, 0xc8, 0xad, 0xb5, 0xb2, 0x18, 0x71, 0x8a, 0xf4, 0x2d, 0x47, 0x07, 0xee, 0xa6,
-	0xda, 0x6a, 0xc0, 0xe6, 0xd0, 0xc0, 0x8d, 0x68, 0x83, 0xf9, 0x40, 0x1b, 0xf8, 0x3a, 0x60, 0x78,
-	0xc6, 0x4d, 0x1e, 0x75, 0xc6, 0x55, 0x72, 0xfb, 0xe1, 0xd1, 0xe2, 0xe3, 0x7d, 0xe9, 0x57, 0x8e,
-	0x92, 0xba, 0x3f, 0x3f, 0x8f, 0xd4, 0x9f, 0x3f, 0xcf, 0x6b, 0xe0, 0x58, 0x83, 0xc6, 0x82, 0x5a,
-	0x9d, 0x2c, 0x1f, 0xec, 0xae, 0xc4, 0x10, 0xa5, 0xdf, 0x74, 0x37, 0x93, 0x6a, 0x92, 0x5c, 0xd8,
-	0xfd, 0x27, 0x59, 0x4e, 0x49, 0xb9, 0xae, 0x44, 0xc9, 0x9f, 0x05, 0xf3, 0x5e, 0xa8, 0x6d, 0xfa,
-	0xae, 0x28, 0xd1, 0x4d, 0x29, 0x73, 0xae, 0xf8, 0x3d, 0x2a, 0x8d, 0x00, 0x7e, 0xa3, 0x24, 0x7d,
-	0x15, 0x03, 0x59, 0x6f, 0x5a, 0xd5, 0x3a, 0x4d, 0xdb, 0xb0, 0x9a, 0x70, 0x73, 0x1b, 0x19, 0x0d,
-	0xe8, 0x5d, 0x43, 0xd4, 0x16, 0xc3, 0xfd, 0x1f, 0xb6, 0x98, 0xc9, 0x17, 0xda, 0x62, 0x62, 0x81,
-	0x2d, 0x26, 0xed, 0x6e, 0x31, 0x0e, 0xbb, 0xb1, 0x85, 0x45, 0x18, 0x2c, 0x2c, 0x74, 0xbb, 0x19,
-	0xec, 0x26, 0x57, 0x41, 0x8a, 0x30, 0x5e, 0xdd, 0xa5, 0xd4, 0x38, 0xbd, 0x3a, 0x69, 0xc4, 0x96,
-	0x31, 0xa0, 0x54, 0xac, 0xcc, 0x76, 0x07, 0x1f, 0x95, 0xd5, 0xfd, 0x7e, 0x61, 0xd6, 0xd9, 0x3c,
-	0xe8, 0xe2, 0xe1, 0x6f, 0xb8, 0x4f, 0xc0, 0xd9, 0x43, 0xae, 0xe5, 0xc8, 0x2b, 0x45, 0x65, 0x3e,
-	0x70, 0x92, 0xf4, 0x33, 0x07, 0x96, 0xbc, 0xe7, 0x59, 0x73, 0x96, 0xd5, 0x7f, 0xc9, 0x3a, 0xcb,
-	0x20, 0x61, 0x62, 0xbd, 0xde, 0x69, 0x37, 0xd9, 0x2e, 0x11, 0x37, 0xb1, 0x7e, 0xab, 0xdd, 0xe4,
-	0xdf, 0xf4, 0xe8, 0x28, 0x46, 0x71, 0x0a, 0x8e, 0x1e, 0x76, 0x7c, 0x55, 0xc5, 0x50, 0x63, 0x4c,
-	0xe1, 0xf2, 0xd1, 0xa9, 0x08, 0x84, 0x06, 0x47, 0x4a, 0x65, 0x90, 0x89, 0x2e, 0xc2, 0xa3, 0x9a,
-	0x50, 0xe1, 0xdf, 0x73, 0x60, 0x96, 0xc2, 0xaa, 0x21, 0xb2, 0xa3, 0xbc, 0x70, 0xb5, 0x9b, 0x20,
-	0x66, 0x62, 0x9d, 0x3d, 0xda, 0xe8, 0x3d, 0xec, 0xe4, 0x5e, 0xbf, 0xb0, 0x1c, 0xf5, 0x3a, 0x6a,
-	0x58, 0x57, 0x88, 0xf7, 0x61, 0xe5, 0x5d, 0x06, 0x8b, 0x43, 0xa9, 0x7a, 0xb7, 0xbd, 0x04, 0xe2,
-	0x6d, 0x88, 0x3b, 0x4d, 0x67, 0x0b, 0x4c, 0x29, 0xec, 0x2b, 0x54, 0xeb, 0xfa, 0xbd, 0x24, 0x88,
-	0xd5, 0xb0, 0xce, 0xdf, 0x01, 0x73, 0x81, 0xbf, 0x63, 0x72, 0xc1, 0x2b, 0x08, 0x6e, 0xab, 0x62,
-	0xfe, 0x30, 0x0b, 0x2f, 0x1d, 0x08, 0x16, 0xc2, 0xab, 0xea, 0x99, 0xb0, 0x7b, 0xc8, 0x48, 0x3c,
-	0x77, 0x04, 0x23, 0xef, 0x98, 0xcb, 0x60, 0x8a, 0xee, 0x8c, 0x4b, 0x61, 0x27, 0x22, 0x17, 0x33,
-	0xd1, 0x72, 0xcf, 0xff, 0x36, 0x48, 0xf9, 0x16, 0xaf, 0x11, 0xf6, 0xae, 0x5e, 0x7c, 0x65, 0xbc,
-	0xde, 0x8b, 0xfb, 0x2e, 0x48, 0xb8, 0xc4, 0xb4, 0x12, 0x76, 0x61, 0x2a, 0xf1, 0xf4, 0x48, 0x95,
-	0x17, 0xe8, 0x2e, 0x48, 0xf9, 0xa6, 0x7f, 0x44, 0x82, 0xc3, 0xfa, 0xa8, 0x04, 0xa3, 0xa6, 0xad,
-	0xb4, 0xb8, 0x17, 0x9e, 0xb6, 0xfc, 0x67, 0x60, 0x2e, 0x30, 0x69, 0x23, 0x5a, 0xc2, 0x6f, 0x11,
-	0xd5, 0x12, 0xd1, 0xd3, 0x30, 0xe2, 0xc8, 0x8d, 0x12, 0xff, 0x35, 0x07, 0x56, 0xc7, 0x0e, 0x19,
-	0x79, 0x54, 0xcb, 0x45, 0xdb, 0x8b, 0x17, 0x9f, 0xcf, 0xde, 0xcb, 0xee, 0xf8, 0x5e, 0xbf, 0x90,
-	0xca, 0x0d, 0x3d, 0x14, 0xfe, 0x73, 0xb0, 0x18, 0xc5, 0x84, 0x2f, 0x8f, 0x42, 0xd8, 0x67, 0x26,
-	0x16, 0x8e, 0x64, 0x36, 0xe6, 0xf8, 0x3b, 0x20, 0xe9, 0xf1, 0x91, 0x18, 0x55, 0x94, 0xa3, 0x13,
-	0xa5, 0xd1, 0xba, 0xd1, 0xd1, 0xc5, 0xe9, 0x2f, 0xc8, 0x14, 0xad, 0x6e, 0x3c, 0x78, 0x9a, 0xe1,
-	0x1e, 0x3e, 0xcd, 0x70, 0xbf, 0x3f, 0xcd, 0x70, 0xf7, 0x9f, 0x65, 0x26, 0x1e, 0x3e, 0xcb, 0x4c,
-	0xfc, 0xf6, 0x2c, 0x33, 0xf1, 0xf1, 0x49, 0x27, 0x2a, 0xd6, 0xee, 0xca, 0x06, 0x62, 0x0b, 0x1d,
-	0x9d, 0xca, 0xc5, 0x6e, 0x79, 0x2b, 0x4e, 0x29, 0xed, 0xfc, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x41, 0x4c, 0x36, 0xd9, 0x09, 0x12, 0x00, 0x00,
+	// 1620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x1b, 0xd5,
+	0x16, 0xcf, 0xc4, 0x89, 0x93, 0x9c, 0xb8, 0x71, 0x32, 0x71, 0x92, 0xc9, 0x34, 0xb5, 0xdd, 0xe9,
+	0x97, 0x5f, 0x5a, 0x8f, 0xed, 0xb4, 0xe9, 0x7b, 0xcf, 0xaf, 0xaa, 0x54, 0xe7, 0x95, 0x52, 0x09,
+	0xd3, 0x6a, 0xfa, 0x81, 0x04, 0x95, 0xac, 0x89, 0xe7, 0x32, 0x19, 0xd5, 0xe3, 0x3b, 0xf8, 0x8e,
+	0xad, 0x78, 0x81, 0x40, 0x2c, 0x58, 0x74, 0xd5, 0x0d, 0x12, 0x42, 0x62, 0x0f, 0xac, 0x82, 0x94,
+	0x15, 0x2c, 0xd9, 0x54, 0x59, 0xa0, 0x2a, 0x0b, 0x84, 0xba, 0x68, 0x51, 0x2b, 0x88, 0xc4, 0x92,
+	0x7f, 0x00, 0x34, 0x77, 0x3e, 0x6c, 0xcf, 0x8c, 0xed, 0xb4, 0x08, 0xc4, 0xa6, 0xf5, 0x3d, 0xe7,
+	0x77, 0xce, 0xbd, 0xe7, 0x77, 0xcf, 0x3d, 0xe7, 0x4c, 0x60, 0xb1, 0x8a, 0x89, 0x8e, 0x49, 0x4e,
+	0xc5, 0xad, 0x5c, 0xab, 0x90, 0x33, 0xb7, 0x45, 0xa3, 0x81, 0x4d, 0xcc, 0x1e, 0xb1, 0xe5, 0xa2,
+	0x8a, 0x5b, 0x62, 0xab, 0xc0, 0x27, 0x1d, 0xd8, 0xa6, 0x4c, 0x50, 0xae, 0x55, 0xd8, 0x44, 0xa6,
+	0x5c, 0xc8, 0x55, 0xb1, 0x56, 0xb7, 0xe1, 0xfc, 0x52, 0xaf, 0x1b, 0xcb, 0xca, 0x56, 0x24, 0x54,
+	0xac, 0x62, 0xfa, 0x33, 0x67, 0xfd, 0x72, 0xa4, 0xcb, 0x36, 0xbc, 0x62, 0x2b, 0x9c, 0xad, 0x1c,
+	0x95, 0x8a, 0xb1, 0x5a, 0x43, 0x39, 0xba, 0xda, 0x6c, 0xbe, 0x9b, 0x93, 0xeb, 0x6d, 0xdf, 0x26,
+	0x3a, 0x51, 0xad, 0x4d, 0x74, 0xa2, 0x3a, 0x8a, 0x39, 0x59, 0xd7, 0xea, 0x38, 0x47, 0xff, 0x75,
+	0x44, 0x29, 0xbf, 0x1b, 0x53, 0xd3, 0x11, 0x31, 0x65, 0xdd, 0xb0, 0x01, 0xc2, 0x37, 0x63, 0x30,
+	0x57, 0x26, 0xea, 0xad, 0xe6, 0xa6, 0xae, 0x99, 0x37, 0x1b, 0xd8, 0xc0, 0x44, 0xae, 0xb1, 0x79,
+	0x98, 0xd4, 0x11, 0x21, 0xb2, 0x8a, 0x08, 0xc7, 0xa4, 0x23, 0x99, 0xe9, 0xb5, 0x84, 0x68, 0x7b,
+	0x12, 0x5d, 0x4f, 0xe2, 0x95, 0x7a, 0x5b, 0xf2, 0x50, 0xec, 0x03, 0x06, 0xe2, 0x5a, 0x5d, 0x33,
+	0x35, 0xb9, 0x56, 0x51, 0x90, 0x81, 0x89, 0x66, 0x72, 0xa3, 0xd4, 0x72, 0x59, 0x74, 0x02, 0xb3,
+	0x48, 0x13, 0x1d, 0xd2, 0xc4, 0x0d, 0xac, 0xd5, 0x4b, 0xaf, 0x3d, 0x7a, 0x9a, 0x1a, 0xf9, 0xea,
+	0x59, 0x2a, 0xa3, 0x6a, 0xe6, 0x56, 0x73, 0x53, 0xac, 0x62, 0xdd, 0x61, 0xc1, 0xf9, 0x2f, 0x4b,
+	0x94, 0xfb, 0x39, 0xb3, 0x6d, 0x20, 0x42, 0x0d, 0xc8, 0x67, 0x07, 0x3b, 0xab, 0xb1, 0x1a, 0x52,
+	0xe5, 0x6a, 0xbb, 0x62, 0xd1, 0x4e, 0xbe, 0x38, 0xd8, 0x59, 0x65, 0xa4, 0x19, 0x67, 0xe7, 0xff,
+	0xdb, 0x1b, 0xb3, 0x17, 0x60, 0xd2, 0xa0, 0xa1, 0xa0, 0x06, 0x17, 0x49, 0x33, 0x99, 0xa9, 0x12,
+	0xb7, 0xbf, 0x9b, 0x4d, 0x38, 0xe7, 0xb8, 0xa2, 0x28, 0x0d, 0x44, 0xc8, 0x2d, 0xb3, 0xa1, 0xd5,
+	0x55, 0xc9, 0x43, 0xb2, 0xbc, 0x15, 0xb4, 0x29, 0x2b, 0xb2, 0x29, 0x73, 0x63, 0x96, 0x95, 0xe4,
+	0xad, 0xd9, 0x7f, 0xc1, 0xb8, 0xa9, 0x99, 0x35, 0xc4, 0x8d, 0x53, 0x77, 0xf3, 0x4f, 0x76, 0xb3,
+	0xf1, 0xce, 0x11, 0xd3, 0x79, 0xf1, 0xc2, 0xbf, 0x25, 0x1b, 0xc1, 0x66, 0x61, 0x82, 0x34, 0x75,
+	0x5d, 0x6e, 0xb4, 0xb9, 0x68, 0x7f, 0xb0, 0x8b, 0x61, 0xcf, 0xc3, 0x14, 0xda, 0x36, 0x90, 0xa2,
+	0x99, 0x48, 0xe1, 0x26, 0xd2, 0x4c, 0x66, 0xb2, 0xb4, 0x10, 0x30, 0x58, 0xcf, 0x73, 0x8c, 0xd4,
+	0xc1, 0xb1, 0x12, 0x1c, 0x31, 0x9c, 0xbb, 0xaa, 0x58, 0xf4, 0x70, 0x93, 0x69, 0x26, 0x33, 0xb3,
+	0x76, 0x54, 0xec, 0x49, 0x57, 0xd1, 0xbd, 0xcf, 0xdb, 0x6d, 0x03, 0x95, 0x66, 0x9f, 0xec, 0x66,
+	0x63, 0xdb, 0x56, 0x4e, 0xa6, 0x5b, 0x05, 0x31, 0x2f, 0xe6, 0xa5, 0x98, 0xd1, 0xa5, 0x2f, 0x16,
+	0x3e, 0x3a, 0xd8, 0x59, 0xf5, 0xd8, 0x78, 0x70, 0xb0, 0xb3, 0x9a, 0xea, 0xba, 0x84, 0x56, 0x21,
+	0x17, 0x48, 0x13, 0xe1, 0x12, 0x2c, 0x07, 0x84, 0x12, 0x22, 0x06, 0xae, 0x13, 0xc4, 0xa6, 0x60,
+	0xda, 0x3b, 0xa3, 0xa6, 0x70, 0x4c, 0x9a, 0xc9, 0x8c, 0x49, 0xe0, 0x8a, 0xae, 0x2b, 0xc2, 0xb7,
+	0x0c, 0x24, 0xca, 0x44, 0xbd, 0xba, 0x8d, 0xaa, 0x6f, 0xd0, 0x2b, 0xdd, 0xc0, 0x75, 0x13, 0xd5,
+	0x4d, 0xf6, 0x4d, 0x98, 0xa8, 0xda, 0x3f, 0xa9, 0x55, 0x9f, 0xe4, 0x2b, 0x25, 0xf7, 0x76, 0xb3,
+	0x7c, 0x4f, 0xc0, 0x6e, 0x6a, 0x51, 0x5b, 0xc9, 0x75, 0xc2, 0xae, 0xc0, 0x94, 0xdc, 0x34, 0xb7,
+	0x70, 0x43, 0x33, 0xdb, 0xdc, 0x28, 0xbd, 0xd9, 0x8e, 0xa0, 0xb8, 0x6e, 0xc5, 0xdd, 0x59, 0x5b,
+	0x81, 0x0b, 0x81, 0xc0, 0x03, 0x87, 0x14, 0x92, 0xb0, 0x12, 0x26, 0x77, 0xc3, 0x17, 0x7e, 0x66,
+	0x60, 0xa2, 0x4c, 0xd4, 0xbb, 0xd8, 0x44, 0xec, 0x7a, 0x08, 0x15, 0xa5, 0xc4, 0xaf, 0x4f, 0x53,
+	0xdd, 0x62, 0x3b, 0x95, 0xbb, 0x08, 0x62, 0x45, 0x18, 0x6f, 0x61, 0x13, 0x35, 0xb8, 0xd1, 0x21,
+	0x39, 0x6c, 0xc3, 0xd8, 0x02, 0x44, 0xb1, 0x61, 0x6a, 0xb8, 0x4e, 0x93, 0x7e, 0xa6, 0xf3, 0xf2,
+	0x9c, 0x74, 0xb0, 0xce, 0x72, 0x83, 0x02, 0x24, 0x07, 0x38, 0x28, 0xe7, 0x8b, 0x27, 0x2d, 0x62,
+	0x6c, 0xd7, 0x16, 0x29, 0x0b, 0x01, 0x52, 0x2c, 0x7f, 0xc2, 0x1c, 0xc4, 0x9d, 0x9f, 0x5e, 0xe8,
+	0xbf, 0x33, 0x9e, 0xec, 0x2d, 0xa4, 0xa9, 0x5b, 0x56, 0xc6, 0xfe, 0x4d, 0x14, 0xfc, 0x0f, 0x26,
+	0xec, 0xc8, 0x08, 0x17, 0xa1, 0xd5, 0xe7, 0xb8, 0x8f, 0x03, 0xf7, 0x40, 0x5d, 0x5c, 0xb8, 0x16,
+	0x03, 0xc9, 0x38, 0xd7, 0x4b, 0xc6, 0xb1, 0x50, 0x32, 0x5c, 0xe7, 0xc2, 0x32, 0x2c, 0xf9, 0x44,
+	0x1e, 0x39, 0xbf, 0x30, 0x00, 0x65, 0xa2, 0xba, 0xa5, 0xea, 0x15, 0x79, 0xb9, 0x08, 0x53, 0x4e,
+	0x95, 0xc5, 0xc3, 0xb9, 0xe9, 0x40, 0xd9, 0x4b, 0x10, 0x95, 0x75, 0xdc, 0xac, 0x9b, 0x0e, 0x3d,
+	0x03, 0x8a, 0xf3, 0x94, 0x55, 0x9c, 0xed, 0x9d, 0x1d, 0x9b, 0xe2, 0x59, 0xfa, 0x54, 0x3c, 0x6f,
+	0x16, 0x11, 0x5c, 0x80, 0x08, 0x27, 0x32, 0x21, 0x01, 0x6c, 0x67, 0xe5, 0x85, 0xff, 0xbd, 0x9d,
+	0x1b, 0x77, 0x0c, 0x45, 0x36, 0xd1, 0x4d, 0xb9, 0x21, 0xeb, 0xc4, 0x0a, 0xa6, 0xf3, 0x3e, 0x99,
+	0x61, 0xc1, 0x78, 0x50, 0xf6, 0x3f, 0x10, 0x35, 0xa8, 0x07, 0xca, 0xc0, 0xf4, 0xda, 0x82, 0xbf,
+	0xfc, 0x51, 0x65, 0x4f, 0x20, 0x36, 0xbe, 0x78, 0x7d, 0x3f, 0x58, 0x92, 0x83, 0x65, 0xe0, 0x44,
+	0x57, 0x6c, 0xdb, 0x6e, 0x4f, 0xf7, 0x1d, 0x5e, 0x10, 0x61, 0xc9, 0x27, 0x72, 0x63, 0x2d, 0xce,
+	0x87, 0xec, 0x22, 0x7c, 0xce, 0xd0, 0x86, 0xbb, 0x21, 0xd7, 0xab, 0xa8, 0xd6, 0xd5, 0x70, 0x43,
+	0xd2, 0x20, 0xee, 0x4b, 0x83, 0x9e, 0x0c, 0xe8, 0xee, 0x71, 0xa3, 0x87, 0xed, 0x71, 0xc5, 0xf4,
+	0x7e, 0xb0, 0xb5, 0xf4, 0xd4, 0x7d, 0xe1, 0x07, 0x06, 0x96, 0x03, 0xe7, 0xf3, 0x8a, 0xfa, 0xcb,
+	0x9f, 0xf3, 0x3a, 0x1c, 0xa9, 0x52, 0x5f, 0x48, 0xa9, 0x58, 0xc3, 0x87, 0x73, 0x57, 0x7c, 0xa0,
+	0xa4, 0xdf, 0x76, 0x27, 0x93, 0xd2, 0xa4, 0x75, 0x61, 0x0f, 0x9f, 0xa5, 0x18, 0x29, 0xe6, 0x9a,
+	0x5a, 0x4a, 0xf6, 0x0c, 0xc4, 0x3d, 0x57, 0x5b, 0xf4, 0x5d, 0xd1, 0x42, 0x37, 0x26, 0xcd, 0xb8,
+	0xe2, 0xd7, 0xa9, 0x34, 0x84, 0xf8, 0xf5, 0xbc, 0xf0, 0x49, 0x04, 0x52, 0x5e, 0xb7, 0x2a, 0x37,
+	0x6b, 0xa6, 0x66, 0xd4, 0xd0, 0xc6, 0x16, 0xd6, 0xaa, 0xc8, 0xbb, 0x86, 0xb0, 0x29, 0x86, 0xf9,
+	0x27, 0x4c, 0x31, 0xa3, 0xaf, 0x34, 0xc5, 0x44, 0x7c, 0x53, 0x4c, 0xc2, 0x9d, 0x62, 0xec, 0xea,
+	0x66, 0x2f, 0x58, 0xae, 0x33, 0xb0, 0xd0, 0xe9, 0xa6, 0x33, 0x9b, 0x5c, 0x85, 0x98, 0x55, 0xf1,
+	0x2a, 0x6e, 0x49, 0x8d, 0xd2, 0xab, 0x13, 0xfa, 0x4c, 0x19, 0x9d, 0x92, 0x4a, 0xa4, 0xe9, 0x56,
+	0x67, 0x51, 0x5c, 0xd9, 0xdf, 0xcd, 0x4e, 0xdb, 0x93, 0x07, 0x1d, 0x3c, 0x7a, 0x13, 0xee, 0x1d,
+	0x38, 0x33, 0xe4, 0x5a, 0x0e, 0x3d, 0x52, 0x14, 0xe3, 0xbe, 0x9d, 0x84, 0xef, 0x18, 0x58, 0xf4,
+	0x9e, 0x67, 0xd9, 0x1e, 0x56, 0xff, 0x64, 0xd5, 0x59, 0x82, 0x09, 0x9d, 0xa8, 0x95, 0x66, 0xa3,
+	0xe6, 0xcc, 0x12, 0x51, 0x9d, 0xa8, 0x77, 0x1a, 0x35, 0xf6, 0xbf, 0x5e, 0x39, 0x8a, 0xa4, 0x99,
+	0x90, 0xd6, 0xe3, 0x6c, 0x5f, 0x92, 0x09, 0x52, 0x9c, 0x4a, 0xe1, 0xd6, 0xa3, 0x63, 0x21, 0x0c,
+	0x75, 0xb6, 0x14, 0x0a, 0x90, 0x0c, 0x0f, 0xc2, 0x2b, 0x35, 0x81, 0xc0, 0xbf, 0x64, 0x60, 0x9a,
+	0xd2, 0xaa, 0x60, 0x6b, 0x46, 0x79, 0xe5, 0x68, 0x37, 0x20, 0xa2, 0x13, 0x95, 0x1b, 0x1d, 0x30,
+	0x87, 0x1d, 0xdd, 0xdb, 0xcd, 0x2e, 0x85, 0xbd, 0x8e, 0x32, 0x51, 0x25, 0xcb, 0x7a, 0x58, 0x78,
+	0x97, 0x61, 0xbe, 0xeb, 0xa8, 0xde, 0x6d, 0x2f, 0x42, 0xb4, 0x81, 0x48, 0xb3, 0x66, 0x4f, 0x81,
+	0x31, 0xc9, 0x59, 0x05, 0x63, 0xfd, 0x8d, 0x81, 0x05, 0xda, 0x6a, 0xac, 0x37, 0x65, 0xa2, 0x6b,
+	0xb8, 0x85, 0x1a, 0x75, 0xab, 0x22, 0xb0, 0x57, 0x61, 0x4e, 0xb1, 0xa5, 0xb8, 0x51, 0x91, 0xed,
+	0x18, 0x87, 0x46, 0x3f, 0xeb, 0x99, 0x38, 0x72, 0x76, 0x03, 0x66, 0x55, 0xea, 0xb4, 0xcb, 0xcb,
+	0xb0, 0x17, 0x19, 0x77, 0x2d, 0x1c, 0x71, 0xb1, 0x1c, 0x42, 0x42, 0xf0, 0x74, 0xfe, 0xbe, 0xe3,
+	0xf6, 0x54, 0x7f, 0x68, 0x42, 0x1e, 0x8e, 0x85, 0x2a, 0xfa, 0xa7, 0xc4, 0xd7, 0x8c, 0xdd, 0xaa,
+	0xea, 0xca, 0x5f, 0x45, 0x54, 0xf1, 0xc6, 0xa1, 0x63, 0x3c, 0x15, 0x88, 0x31, 0xec, 0x5c, 0xc2,
+	0x1a, 0xa4, 0xfa, 0xa8, 0xfa, 0xc6, 0xb9, 0xf6, 0x31, 0x40, 0xa4, 0x4c, 0x54, 0xf6, 0x1e, 0xcc,
+	0xf8, 0x3e, 0x6b, 0xd3, 0xfe, 0x17, 0xe9, 0xff, 0x78, 0xe1, 0x33, 0xc3, 0x10, 0x5e, 0x76, 0x22,
+	0x98, 0x0b, 0x7e, 0xb9, 0x9c, 0x08, 0x9a, 0x07, 0x40, 0xfc, 0xd9, 0x43, 0x80, 0xbc, 0x6d, 0x2e,
+	0xc3, 0x18, 0xfd, 0x84, 0x58, 0x0c, 0x1a, 0x59, 0x72, 0x3e, 0x19, 0x2e, 0xf7, 0xec, 0xef, 0x42,
+	0xac, 0x67, 0x0e, 0xef, 0x83, 0x77, 0xf5, 0xfc, 0xe9, 0xc1, 0x7a, 0xcf, 0xef, 0x35, 0x98, 0x70,
+	0xfb, 0xd4, 0x72, 0xd0, 0xc4, 0x51, 0xf1, 0xc7, 0xfb, 0xaa, 0x3c, 0x47, 0xf7, 0x21, 0xd6, 0x33,
+	0x0c, 0x86, 0x1c, 0xb0, 0x5b, 0xcf, 0x9f, 0x1e, 0xac, 0xf7, 0x06, 0xcd, 0xf9, 0xbd, 0xe0, 0xf0,
+	0xc5, 0xbe, 0x07, 0x33, 0xbe, 0xc1, 0x2b, 0x24, 0x25, 0x7a, 0x11, 0x7c, 0x66, 0x18, 0x62, 0xc0,
+	0x96, 0xeb, 0x79, 0xf6, 0x53, 0x06, 0x56, 0x06, 0xce, 0x1c, 0x62, 0xbf, 0x94, 0x0b, 0xc7, 0xf3,
+	0x17, 0x5f, 0x0e, 0xef, 0x9d, 0x6e, 0x76, 0x6f, 0x37, 0x1b, 0x4b, 0x77, 0x3d, 0x14, 0xf6, 0x7d,
+	0x98, 0x0f, 0x6b, 0x8c, 0xa7, 0xfa, 0x31, 0xdc, 0x03, 0xe3, 0xb3, 0x87, 0x82, 0x0d, 0xd8, 0xfe,
+	0x1e, 0x4c, 0x7a, 0xed, 0x89, 0x0f, 0x0b, 0xca, 0xd6, 0xf1, 0x42, 0x7f, 0xdd, 0x00, 0xef, 0x6d,
+	0x60, 0x43, 0x1a, 0xc2, 0xc9, 0xb0, 0x84, 0xf4, 0xa3, 0xf8, 0x73, 0x87, 0x41, 0x79, 0x7b, 0xc7,
+	0xf7, 0x7a, 0x0b, 0x10, 0xfb, 0x01, 0x24, 0x42, 0x8b, 0x6c, 0x58, 0xea, 0x86, 0xe0, 0x78, 0xf1,
+	0x70, 0xb8, 0xbe, 0x07, 0xe0, 0xc7, 0x3f, 0xb4, 0x06, 0xca, 0xd2, 0xfa, 0xa3, 0xe7, 0x49, 0xe6,
+	0xf1, 0xf3, 0x24, 0xf3, 0xd3, 0xf3, 0x24, 0xf3, 0xf0, 0x45, 0x72, 0xe4, 0xf1, 0x8b, 0xe4, 0xc8,
+	0x8f, 0x2f, 0x92, 0x23, 0x6f, 0x1f, 0xb5, 0x37, 0x20, 0xca, 0x7d, 0x51, 0xc3, 0xce, 0xb7, 0x0d,
+	0x1d, 0x50, 0xad, 0x3f, 0x6a, 0x46, 0x69, 0x77, 0x3f, 0xff, 0xc7, 0x00, 0x25, 0x6d, 0x45, 0x9e,
+	0x14, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1326,6 +1515,10 @@ type MsgClient interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.CallOption) (*MsgSudoExecResponse, error)
+	// DelegateGovernance defines a method to delegate the governance voting power of an account to a governor.
+	DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation of an account.
+	UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error)
 }
 
 type msgClient struct {
@@ -1426,6 +1619,24 @@ func (c *msgClient) SudoExec(ctx context.Context, in *MsgSudoExec, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) DelegateGovernance(ctx context.Context, in *MsgDelegateGovernance, opts ...grpc.CallOption) (*MsgDelegateGovernanceResponse, error) {
+	out := new(MsgDelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/DelegateGovernance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UndelegateGovernance(ctx context.Context, in *MsgUndelegateGovernance, opts ...grpc.CallOption) (*MsgUndelegateGovernanceResponse, error) {
+	out := new(MsgUndelegateGovernanceResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1.Msg/UndelegateGovernance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given the messages.
@@ -1451,6 +1662,10 @@ type MsgServer interface {
 	// SudoExec defines a method to execute an inner message as the governance module.
 	// It permits to execute any message from a proposal, even if they weren't meant to be governance proposals.
 	SudoExec(context.Context, *MsgSudoExec) (*MsgSudoExecResponse, error)
+	// DelegateGovernance defines a method to delegate the governance voting power of an account to a governor.
+	DelegateGovernance(context.Context, *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error)
+	// UndelegateGovernance defines a method to remove the governance delegation of an account.
+	UndelegateGovernance(context.Context, *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1487,6 +1702,12 @@ func (*UnimplementedMsgServer) UpdateMessageParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) SudoExec(ctx context.Context, req *MsgSudoExec) (*MsgSudoExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SudoExec not implemented")
 }
+func (*UnimplementedMsgServer) DelegateGovernance(ctx context.Context, req *MsgDelegateGovernance) (*MsgDelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegateGovernance not implemented")
+}
+func (*UnimplementedMsgServer) UndelegateGovernance(ctx context.Context, req *MsgUndelegateGovernance) (*MsgUndelegateGovernanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndelegateGovernance not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1672,6 +1893,42 @@ func _Msg_SudoExec_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_DelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/DelegateGovernance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelegateGovernance(ctx, req.(*MsgDelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UndelegateGovernance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegateGovernance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UndelegateGovernance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1.Msg/UndelegateGovernance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndelegateGovernance(ctx, req.(*MsgUndelegateGovernance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1716,6 +1973,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SudoExec",
 			Handler:    _Msg_SudoExec_Handler,
 		},
+		{
+			MethodName: "DelegateGovernance",
+			Handler:    _Msg_DelegateGovernance_Handler,
+		},
+		{
+			MethodName: "UndelegateGovernance",
+			Handler:    _Msg_UndelegateGovernance_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1/tx.proto",
@@ -2517,6 +2782,119 @@ func (m *MsgSudoExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelegateGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GovernorAddress) > 0 {
+		i -= len(m.GovernorAddress)
+		copy(dAtA[i:], m.GovernorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GovernorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateGovernanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateGovernanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateGovernanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2871,16 +3249,64 @@ func (m *MsgSudoExecResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgDelegateGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GovernorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgDelegateGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+
+func (m *MsgUndelegateGovernance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUndelegateGovernanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -5109,6 +5535,302 @@ func (m *MsgSudoExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelegateGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovernorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GovernorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateGovernanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateGovernanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateGovernanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
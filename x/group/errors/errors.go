@@ -17,4 +17,5 @@ var (
 	ErrMetadataTooLong = errors.Register(groupCodespace, 10, "metadata too long")
 	ErrSummaryTooLong  = errors.Register(groupCodespace, 11, "summary too long")
 	ErrTitleTooLong    = errors.Register(groupCodespace, 12, "title too long")
+	ErrMemberLocked    = errors.Register(groupCodespace, 13, "group member locked")
 )
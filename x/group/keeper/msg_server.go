@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	authtypes "cosmossdk.io/x/auth/types"
@@ -155,6 +156,11 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 					return errorsmod.Wrap(sdkerrors.ErrNotFound, "unknown member")
 				}
 
+				// We can't delete a group member locked by its votes.
+				if err := k.assertMemberUnlocked(ctx, prevGroupMember.Member); err != nil {
+					return err
+				}
+
 				previousMemberWeight, err := math.NewPositiveDecFromString(prevGroupMember.Member.Weight)
 				if err != nil {
 					return err
@@ -185,6 +191,7 @@ func (k Keeper) UpdateGroupMembers(ctx context.Context, msg *group.MsgUpdateGrou
 				}
 				// Save updated group member in the groupMemberTable.
 				groupMember.Member.AddedAt = prevGroupMember.Member.AddedAt
+				groupMember.Member.LockedUntil = prevGroupMember.Member.LockedUntil
 				if err := k.groupMemberTable.Update(kvStore, &groupMember); err != nil {
 					return errorsmod.Wrap(err, "add member")
 				}
@@ -767,6 +774,18 @@ func (k Keeper) Vote(ctx context.Context, msg *group.MsgVote) (*group.MsgVoteRes
 		return nil, errorsmod.Wrap(err, "store vote")
 	}
 
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	// Lock the voter in the group for decision policies weighting votes by their lock time.
+	if lockingPolicy, ok := policy.(group.LockingDecisionPolicy); ok {
+		if err := k.lockMember(ctx, &voter, lockingPolicy.VoteLockEnd(proposal, newVote)); err != nil {
+			return nil, errorsmod.Wrap(err, "lock voter")
+		}
+	}
+
 	if err := k.EventService.EventManager(ctx).Emit(&group.EventVote{ProposalId: msg.ProposalId}); err != nil {
 		return nil, err
 	}
@@ -940,6 +959,10 @@ func (k Keeper) LeaveGroup(ctx context.Context, msg *group.MsgLeaveGroup) (*grou
 		return nil, err
 	}
 
+	if err := k.assertMemberUnlocked(ctx, gm.Member); err != nil {
+		return nil, err
+	}
+
 	memberWeight, err := math.NewPositiveDecFromString(gm.Member.Weight)
 	if err != nil {
 		return nil, err
@@ -995,6 +1018,26 @@ func (k Keeper) getGroupMember(ctx context.Context, member *group.GroupMember) (
 	return &groupMember, nil
 }
 
+// lockMember locks the group member in its group until the given time. An
+// existing lock ending later is kept.
+func (k Keeper) lockMember(ctx context.Context, member *group.GroupMember, until time.Time) error {
+	if member.Member.LockedUntil != nil && !until.After(*member.Member.LockedUntil) {
+		return nil
+	}
+
+	member.Member.LockedUntil = &until
+	return k.groupMemberTable.Update(k.KVStoreService.OpenKVStore(ctx), member)
+}
+
+// assertMemberUnlocked returns an error if the member is still locked in its
+// group, i.e. if it can neither leave nor be removed from it.
+func (k Keeper) assertMemberUnlocked(ctx context.Context, member *group.Member) error {
+	if member.LockedUntil != nil && member.LockedUntil.After(k.HeaderService.HeaderInfo(ctx).Time) {
+		return errorsmod.Wrapf(errors.ErrMemberLocked, "%s is locked until %s", member.Address, member.LockedUntil)
+	}
+	return nil
+}
+
 type (
 	actionFn            func(m *group.GroupInfo) error
 	groupPolicyActionFn func(m *group.GroupPolicyInfo) error
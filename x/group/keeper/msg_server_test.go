@@ -14,6 +14,7 @@ import (
 	"cosmossdk.io/core/header"
 	banktypes "cosmossdk.io/x/bank/types"
 	"cosmossdk.io/x/group"
+	grouperrors "cosmossdk.io/x/group/errors"
 	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/keeper"
 	minttypes "cosmossdk.io/x/mint/types"
@@ -3398,6 +3399,116 @@ func (s *TestSuite) TestExecProposalsWhenMemberLeavesOrIsUpdated() {
 	}
 }
 
+func (s *TestSuite) TestExecProposalWithConvictionDecisionPolicy() {
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	member1, member2, member3 := s.addrsStr[1], s.addrsStr[2], s.addrsStr[3]
+
+	groupRes, err := s.groupKeeper.CreateGroup(sdkCtx, &group.MsgCreateGroup{
+		Admin: s.addrsStr[0],
+		Members: []group.MemberRequest{
+			{Address: member1, Weight: "2"},
+			{Address: member2, Weight: "2"},
+			{Address: member3, Weight: "1"},
+		},
+	})
+	s.Require().NoError(err)
+	groupID := groupRes.GroupId
+
+	// votes reach their full weight after being locked for 10 hours
+	policyReq := &group.MsgCreateGroupPolicy{
+		Admin:   s.addrsStr[0],
+		GroupId: groupID,
+	}
+	err = policyReq.SetDecisionPolicy(group.NewConvictionDecisionPolicy("3", 10*time.Hour, 20*time.Hour, 0))
+	s.Require().NoError(err)
+	s.setNextAccount()
+	policyRes, err := s.groupKeeper.CreateGroupPolicy(sdkCtx, policyReq)
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: policyRes.Address,
+		ToAddress:   member1,
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalReq := &group.MsgSubmitProposal{
+		GroupPolicyAddress: policyRes.Address,
+		Proposers:          []string{member1},
+		Exec:               group.Exec_EXEC_TRY,
+	}
+	s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{msgSend}))
+
+	// the proposer votes yes, but its vote has no conviction yet
+	proposalRes, err := s.groupKeeper.SubmitProposal(sdkCtx, proposalReq)
+	s.Require().NoError(err)
+	proposalID := proposalRes.ProposalId
+
+	proposal, err := s.groupKeeper.Proposal(sdkCtx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_STATUS_SUBMITTED, proposal.Proposal.Status)
+
+	// the voter is locked in the group until the end of the voting period
+	votingPeriodEnd := proposal.Proposal.VotingPeriodEnd
+	members, err := s.groupKeeper.GroupMembers(sdkCtx, &group.QueryGroupMembersRequest{GroupId: groupID})
+	s.Require().NoError(err)
+	for _, m := range members.Members {
+		if m.Member.Address == member1 {
+			s.Require().NotNil(m.Member.LockedUntil)
+			s.Require().Equal(votingPeriodEnd, *m.Member.LockedUntil)
+		} else {
+			s.Require().Nil(m.Member.LockedUntil)
+		}
+	}
+
+	_, err = s.groupKeeper.LeaveGroup(sdkCtx, &group.MsgLeaveGroup{GroupId: groupID, Address: member1})
+	s.Require().ErrorIs(err, grouperrors.ErrMemberLocked)
+	_, err = s.groupKeeper.UpdateGroupMembers(sdkCtx, &group.MsgUpdateGroupMembers{
+		Admin:         s.addrsStr[0],
+		GroupId:       groupID,
+		MemberUpdates: []group.MemberRequest{{Address: member1, Weight: "0"}},
+	})
+	s.Require().ErrorIs(err, grouperrors.ErrMemberLocked)
+
+	// members which didn't vote can still leave
+	_, err = s.groupKeeper.LeaveGroup(sdkCtx, &group.MsgLeaveGroup{GroupId: groupID, Address: member3})
+	s.Require().NoError(err)
+
+	// after 5 hours, the proposer vote counts for half of its weight
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(5 * time.Hour)})
+	tally, err := s.groupKeeper.TallyResult(sdkCtx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("1.0", tally.Tally.YesCount)
+
+	execRes, err := s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: member1, ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_NOT_RUN, execRes.Result)
+
+	_, err = s.groupKeeper.Vote(sdkCtx, &group.MsgVote{
+		ProposalId: proposalID,
+		Voter:      member2,
+		Option:     group.VOTE_OPTION_YES,
+	})
+	s.Require().NoError(err)
+
+	// after 10 hours, both votes together reach the threshold
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: s.blockTime.Add(10 * time.Hour)})
+	tally, err = s.groupKeeper.TallyResult(sdkCtx, &group.QueryTallyResultRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal("3.0", tally.Tally.YesCount)
+
+	s.bankKeeper.EXPECT().Send(gomock.Any(), msgSend).Return(nil, nil)
+	execRes, err = s.groupKeeper.Exec(sdkCtx, &group.MsgExec{Executor: member1, ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.PROPOSAL_EXECUTOR_RESULT_SUCCESS, execRes.Result)
+
+	// voters stay locked until the end of the voting period
+	_, err = s.groupKeeper.LeaveGroup(sdkCtx, &group.MsgLeaveGroup{GroupId: groupID, Address: member2})
+	s.Require().ErrorIs(err, grouperrors.ErrMemberLocked)
+
+	sdkCtx = sdkCtx.WithHeaderInfo(header.Info{Time: votingPeriodEnd.Add(time.Second)})
+	_, err = s.groupKeeper.LeaveGroup(sdkCtx, &group.MsgLeaveGroup{GroupId: groupID, Address: member2})
+	s.Require().NoError(err)
+}
+
 func eventTypeFound(events []abci.Event, eventType string) bool {
 	eventTypeFound := false
 	for _, e := range events {
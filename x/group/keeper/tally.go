@@ -6,6 +6,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/x/group"
 	"cosmossdk.io/x/group/errors"
+	"cosmossdk.io/x/group/internal/math"
 	"cosmossdk.io/x/group/internal/orm"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -23,6 +24,21 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 		return p.FinalTallyResult, nil
 	}
 
+	policyInfo, err := k.getGroupPolicyInfo(ctx, p.GroupPolicyAddress)
+	if err != nil {
+		return group.TallyResult{}, errorsmod.Wrap(err, "load group policy")
+	}
+
+	policy, err := policyInfo.GetDecisionPolicy()
+	if err != nil {
+		return group.TallyResult{}, err
+	}
+
+	// Votes on proposals of a locking decision policy are weighted by the
+	// policy, depending on the time they have been locked for.
+	lockingPolicy, isLocking := policy.(group.LockingDecisionPolicy)
+	now := k.HeaderService.HeaderInfo(ctx).Time
+
 	kvStore := k.KVStoreService.OpenKVStore(ctx)
 
 	it, err := k.voteByProposalIndex.Get(kvStore, p.Id)
@@ -59,7 +75,24 @@ func (k Keeper) Tally(ctx context.Context, p group.Proposal, groupID uint64) (gr
 			return group.TallyResult{}, err
 		}
 
-		if err := tallyResult.Add(vote, member.Member.Weight); err != nil {
+		weight := member.Member.Weight
+		if isLocking {
+			weight, err = lockingPolicy.VoteWeight(p, vote, weight, now)
+			if err != nil {
+				return group.TallyResult{}, errorsmod.Wrap(err, "vote weight")
+			}
+
+			// Votes without any weight yet are not counted.
+			voteWeight, err := math.NewNonNegativeDecFromString(weight)
+			if err != nil {
+				return group.TallyResult{}, errorsmod.Wrap(err, "vote weight")
+			}
+			if voteWeight.IsZero() {
+				continue
+			}
+		}
+
+		if err := tallyResult.Add(vote, weight); err != nil {
 			return group.TallyResult{}, errorsmod.Wrap(err, "add new vote")
 		}
 	}
@@ -141,6 +141,51 @@ func (mr *MockBankKeeperMockRecorder) Burn(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Burn", reflect.TypeOf((*MockBankKeeper)(nil).Burn), arg0, arg1)
 }
 
+// ChangeAdmin mocks base method.
+func (m *MockBankKeeper) ChangeAdmin(arg0 context.Context, arg1 *types.MsgChangeAdmin) (*types.MsgChangeAdminResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeAdmin", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgChangeAdminResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeAdmin indicates an expected call of ChangeAdmin.
+func (mr *MockBankKeeperMockRecorder) ChangeAdmin(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAdmin", reflect.TypeOf((*MockBankKeeper)(nil).ChangeAdmin), arg0, arg1)
+}
+
+// CreateDenom mocks base method.
+func (m *MockBankKeeper) CreateDenom(arg0 context.Context, arg1 *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDenom", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgCreateDenomResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDenom indicates an expected call of CreateDenom.
+func (mr *MockBankKeeperMockRecorder) CreateDenom(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDenom", reflect.TypeOf((*MockBankKeeper)(nil).CreateDenom), arg0, arg1)
+}
+
+// ForceTransfer mocks base method.
+func (m *MockBankKeeper) ForceTransfer(arg0 context.Context, arg1 *types.MsgForceTransfer) (*types.MsgForceTransferResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForceTransfer", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgForceTransferResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForceTransfer indicates an expected call of ForceTransfer.
+func (mr *MockBankKeeperMockRecorder) ForceTransfer(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceTransfer", reflect.TypeOf((*MockBankKeeper)(nil).ForceTransfer), arg0, arg1)
+}
+
 // GetAllBalances mocks base method.
 func (m *MockBankKeeper) GetAllBalances(ctx context.Context, addr types0.AccAddress) types0.Coins {
 	m.ctrl.T.Helper()
@@ -155,6 +200,21 @@ func (mr *MockBankKeeperMockRecorder) GetAllBalances(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllBalances", reflect.TypeOf((*MockBankKeeper)(nil).GetAllBalances), ctx, addr)
 }
 
+// Mint mocks base method.
+func (m *MockBankKeeper) Mint(arg0 context.Context, arg1 *types.MsgMint) (*types.MsgMintResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Mint", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgMintResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Mint indicates an expected call of Mint.
+func (mr *MockBankKeeperMockRecorder) Mint(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Mint", reflect.TypeOf((*MockBankKeeper)(nil).Mint), arg0, arg1)
+}
+
 // MintCoins mocks base method.
 func (m *MockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt types0.Coins) error {
 	m.ctrl.T.Helper()
@@ -213,6 +273,21 @@ func (mr *MockBankKeeperMockRecorder) SendCoinsFromModuleToAccount(ctx, senderMo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendCoinsFromModuleToAccount", reflect.TypeOf((*MockBankKeeper)(nil).SendCoinsFromModuleToAccount), ctx, senderModule, recipientAddr, amt)
 }
 
+// SetDenomSendHook mocks base method.
+func (m *MockBankKeeper) SetDenomSendHook(arg0 context.Context, arg1 *types.MsgSetDenomSendHook) (*types.MsgSetDenomSendHookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDenomSendHook", arg0, arg1)
+	ret0, _ := ret[0].(*types.MsgSetDenomSendHookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDenomSendHook indicates an expected call of SetDenomSendHook.
+func (mr *MockBankKeeperMockRecorder) SetDenomSendHook(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDenomSendHook", reflect.TypeOf((*MockBankKeeper)(nil).SetDenomSendHook), arg0, arg1)
+}
+
 // SetSendEnabled mocks base method.
 func (m *MockBankKeeper) SetSendEnabled(arg0 context.Context, arg1 *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
 	m.ctrl.T.Helper()
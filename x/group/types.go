@@ -48,6 +48,20 @@ type DecisionPolicy interface {
 	Validate(g GroupInfo, config Config) error
 }
 
+// LockingDecisionPolicy is a DecisionPolicy locking the members voting on a
+// proposal in their group, and weighting their votes by the time they stay
+// locked.
+type LockingDecisionPolicy interface {
+	DecisionPolicy
+
+	// VoteLockEnd returns the time until which the voter of the given vote
+	// can neither leave nor be removed from the group.
+	VoteLockEnd(proposal Proposal, vote Vote) time.Time
+	// VoteWeight returns the weight of the given vote at the given time, based
+	// on the voter's member weight.
+	VoteWeight(proposal Proposal, vote Vote, memberWeight string, now time.Time) (string, error)
+}
+
 // Implements DecisionPolicy Interface
 var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
@@ -242,6 +256,102 @@ func (p PercentageDecisionPolicy) Allow(tally TallyResult, totalPower string) (D
 	return DecisionPolicyResult{Allow: false, Final: false}, nil
 }
 
+// Implements LockingDecisionPolicy Interface
+var _ LockingDecisionPolicy = &ConvictionDecisionPolicy{}
+
+// NewConvictionDecisionPolicy creates a new conviction DecisionPolicy
+func NewConvictionDecisionPolicy(threshold string, convictionPeriod, votingPeriod, minExecutionPeriod time.Duration) DecisionPolicy {
+	return &ConvictionDecisionPolicy{threshold, convictionPeriod, &DecisionPolicyWindows{votingPeriod, minExecutionPeriod}}
+}
+
+// GetVotingPeriod returns the voting period of ConvictionDecisionPolicy
+func (p ConvictionDecisionPolicy) GetVotingPeriod() time.Duration {
+	return p.Windows.VotingPeriod
+}
+
+// GetMinExecutionPeriod returns the minimum execution period of ConvictionDecisionPolicy
+func (p ConvictionDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	return p.Windows.MinExecutionPeriod
+}
+
+// ValidateBasic does basic validation on ConvictionDecisionPolicy
+func (p ConvictionDecisionPolicy) ValidateBasic() error {
+	if _, err := math.NewPositiveDecFromString(p.Threshold); err != nil {
+		return errorsmod.Wrap(err, "threshold")
+	}
+
+	if p.ConvictionPeriod <= 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "conviction period must be positive")
+	}
+
+	if p.Windows == nil || p.Windows.VotingPeriod == 0 {
+		return errorsmod.Wrap(errors.ErrInvalid, "voting period cannot be zero")
+	}
+
+	if p.ConvictionPeriod > p.Windows.VotingPeriod {
+		return errorsmod.Wrap(errors.ErrInvalid, "conviction period cannot be greater than voting period")
+	}
+
+	return nil
+}
+
+// Validate validates the policy against the group.
+func (p *ConvictionDecisionPolicy) Validate(g GroupInfo, config Config) error {
+	return p.thresholdPolicy().Validate(g, config)
+}
+
+// Allow allows a proposal to pass when the conviction-weighted tally of yes
+// votes equals or exceeds the threshold before the timeout. As the votes
+// convictions are at most the voters weights, it follows the same rules as the
+// ThresholdDecisionPolicy.
+func (p ConvictionDecisionPolicy) Allow(tallyResult TallyResult, totalPower string) (DecisionPolicyResult, error) {
+	return p.thresholdPolicy().Allow(tallyResult, totalPower)
+}
+
+// VoteLockEnd returns the end of the proposal voting period, until which the
+// voter stays locked in the group.
+func (p ConvictionDecisionPolicy) VoteLockEnd(proposal Proposal, _ Vote) time.Time {
+	return proposal.VotingPeriodEnd
+}
+
+// VoteWeight returns the conviction of the vote at the given time: the voter's
+// weight, scaled by the time the vote has been locked over the conviction period.
+func (p ConvictionDecisionPolicy) VoteWeight(proposal Proposal, vote Vote, memberWeight string, now time.Time) (string, error) {
+	weight, err := math.NewNonNegativeDecFromString(memberWeight)
+	if err != nil {
+		return "", errorsmod.Wrap(err, "member weight")
+	}
+
+	// votes stop gaining conviction once their lock ends
+	if lockEnd := p.VoteLockEnd(proposal, vote); now.After(lockEnd) {
+		now = lockEnd
+	}
+
+	locked := now.Sub(vote.SubmitTime)
+	switch {
+	case locked <= 0:
+		return math.NewDecFromInt64(0).String(), nil
+	case locked >= p.ConvictionPeriod:
+		return weight.String(), nil
+	}
+
+	conviction, err := math.NewDecFromInt64(int64(locked)).Quo(math.NewDecFromInt64(int64(p.ConvictionPeriod)))
+	if err != nil {
+		return "", err
+	}
+
+	voteWeight, err := weight.Mul(conviction)
+	if err != nil {
+		return "", err
+	}
+
+	return voteWeight.String(), nil
+}
+
+func (p ConvictionDecisionPolicy) thresholdPolicy() *ThresholdDecisionPolicy {
+	return &ThresholdDecisionPolicy{Threshold: p.Threshold, Windows: p.Windows}
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
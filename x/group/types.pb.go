@@ -167,6 +167,12 @@ type Member struct {
 	Metadata string `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// added_at is a timestamp specifying when a member was added.
 	AddedAt time.Time `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3,stdtime" json:"added_at"`
+	// locked_until is a timestamp until which the member can neither leave nor be
+	// removed from the group. It is set when the member votes on a proposal of a
+	// group policy with a conviction decision policy.
+	//
+	// Since: cosmos-sdk 0.52
+	LockedUntil *time.Time `protobuf:"bytes,5,opt,name=locked_until,json=lockedUntil,proto3,stdtime" json:"locked_until,omitempty"`
 }
 
 func (m *Member) Reset()         { *m = Member{} }
@@ -230,6 +236,13 @@ func (m *Member) GetAddedAt() time.Time {
 	return time.Time{}
 }
 
+func (m *Member) GetLockedUntil() *time.Time {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return nil
+}
+
 // MemberRequest represents a group member to be used in Msg server requests.
 // Contrary to `Member`, it doesn't have any `added_at` field
 // since this field cannot be set as part of requests.
@@ -418,6 +431,84 @@ func (m *PercentageDecisionPolicy) GetWindows() *DecisionPolicyWindows {
 	return nil
 }
 
+// ConvictionDecisionPolicy is a decision policy where a proposal passes when
+// it satisfies the two following conditions:
+//  1. The sum of all `YES` voters' convictions is greater or equal than the
+//     defined `threshold`. The conviction of a vote grows linearly with the time
+//     the vote stays locked, from zero when the vote is cast up to the voter's
+//     weight after `conviction_period`.
+//  2. The voting and execution periods of the proposal respect the parameters
+//     given by `windows`.
+//
+// A vote stays locked until the end of the proposal's voting period, during
+// which the voter can neither leave nor be removed from the group.
+//
+// Since: cosmos-sdk 0.52
+type ConvictionDecisionPolicy struct {
+	// threshold is the minimum conviction-weighted sum of `YES` votes that must
+	// be met or exceeded for a proposal to succeed.
+	Threshold string `protobuf:"bytes,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// conviction_period is the duration a vote must stay locked to count with
+	// the voter's full weight. It must not be greater than the voting period.
+	ConvictionPeriod time.Duration `protobuf:"bytes,2,opt,name=conviction_period,json=convictionPeriod,proto3,stdduration" json:"conviction_period"`
+	// windows defines the different windows for voting and execution.
+	Windows *DecisionPolicyWindows `protobuf:"bytes,3,opt,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (m *ConvictionDecisionPolicy) Reset()         { *m = ConvictionDecisionPolicy{} }
+func (m *ConvictionDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*ConvictionDecisionPolicy) ProtoMessage()    {}
+func (*ConvictionDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+}
+func (m *ConvictionDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConvictionDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConvictionDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConvictionDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConvictionDecisionPolicy.Merge(m, src)
+}
+func (m *ConvictionDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConvictionDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConvictionDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConvictionDecisionPolicy proto.InternalMessageInfo
+
+func (m *ConvictionDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *ConvictionDecisionPolicy) GetConvictionPeriod() time.Duration {
+	if m != nil {
+		return m.ConvictionPeriod
+	}
+	return 0
+}
+
+func (m *ConvictionDecisionPolicy) GetWindows() *DecisionPolicyWindows {
+	if m != nil {
+		return m.Windows
+	}
+	return nil
+}
+
 // DecisionPolicyWindows defines the different windows for voting and execution.
 type DecisionPolicyWindows struct {
 	// voting_period is the duration from submission of a proposal to the end of voting period
@@ -441,7 +532,7 @@ func (m *DecisionPolicyWindows) Reset()         { *m = DecisionPolicyWindows{} }
 func (m *DecisionPolicyWindows) String() string { return proto.CompactTextString(m) }
 func (*DecisionPolicyWindows) ProtoMessage()    {}
 func (*DecisionPolicyWindows) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{4}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
 }
 func (m *DecisionPolicyWindows) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -508,7 +599,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{5}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,7 +682,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{6}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +750,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{7}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -740,7 +831,7 @@ func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{8}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +876,7 @@ func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{9}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -833,7 +924,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5bddd15d7a54a9d, []int{10}
+	return fileDescriptor_f5bddd15d7a54a9d, []int{11}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -905,6 +996,7 @@ func init() {
 	proto.RegisterType((*MemberRequest)(nil), "cosmos.group.v1.MemberRequest")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1.ThresholdDecisionPolicy")
 	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1.PercentageDecisionPolicy")
+	proto.RegisterType((*ConvictionDecisionPolicy)(nil), "cosmos.group.v1.ConvictionDecisionPolicy")
 	proto.RegisterType((*DecisionPolicyWindows)(nil), "cosmos.group.v1.DecisionPolicyWindows")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1.GroupMember")
@@ -917,93 +1009,97 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1/types.proto", fileDescriptor_f5bddd15d7a54a9d) }
 
 var fileDescriptor_f5bddd15d7a54a9d = []byte{
-	// 1375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xce, 0x3a, 0x8e, 0x3f, 0x5e, 0x27, 0xb6, 0x3b, 0xcd, 0xaf, 0xd9, 0x24, 0xfd, 0xd9, 0xc1,
-	0xad, 0x20, 0x04, 0xc5, 0x6e, 0x53, 0x44, 0xa5, 0x9e, 0xb0, 0x9d, 0x2d, 0x75, 0xd4, 0xda, 0xd6,
-	0x7a, 0x9d, 0xd0, 0x5e, 0x56, 0x1b, 0xef, 0xd4, 0x59, 0xd5, 0xde, 0x31, 0xbb, 0xe3, 0xa4, 0xfe,
-	0x0f, 0x2a, 0x2e, 0xf4, 0xc8, 0x05, 0xa9, 0x12, 0x97, 0x1e, 0x7b, 0xa8, 0x38, 0x70, 0x44, 0x1c,
-	0x2a, 0x0e, 0xa8, 0xe2, 0x84, 0x38, 0x00, 0x6a, 0x0f, 0xe5, 0xc4, 0x89, 0x2b, 0x12, 0xda, 0x99,
-	0x59, 0xc7, 0x1f, 0xb1, 0x4b, 0xaa, 0x8a, 0x4b, 0x94, 0x99, 0xe7, 0x79, 0xdf, 0x79, 0x9f, 0xf7,
-	0x6b, 0x13, 0x58, 0x6d, 0x10, 0xb7, 0x4d, 0xdc, 0x5c, 0xd3, 0x21, 0xdd, 0x4e, 0xee, 0xf0, 0x72,
-	0x8e, 0xf6, 0x3a, 0xd8, 0xcd, 0x76, 0x1c, 0x42, 0x09, 0x4a, 0x70, 0x30, 0xcb, 0xc0, 0xec, 0xe1,
-	0xe5, 0x95, 0xc5, 0x26, 0x69, 0x12, 0x86, 0xe5, 0xbc, 0xdf, 0x38, 0x6d, 0x25, 0xd5, 0x24, 0xa4,
-	0xd9, 0xc2, 0x39, 0x76, 0xda, 0xef, 0xde, 0xcd, 0x99, 0x5d, 0xc7, 0xa0, 0x16, 0xb1, 0x05, 0x9e,
-	0x1e, 0xc5, 0xa9, 0xd5, 0xc6, 0x2e, 0x35, 0xda, 0x1d, 0x41, 0x58, 0xe6, 0xef, 0xe8, 0xdc, 0xb3,
-	0x78, 0x54, 0x40, 0xa3, 0xb6, 0x86, 0xdd, 0x13, 0xd0, 0x19, 0xa3, 0x6d, 0xd9, 0x24, 0xc7, 0x7e,
-	0xf2, 0xab, 0xcc, 0x37, 0x12, 0x84, 0x6e, 0xe1, 0xf6, 0x3e, 0x76, 0xd0, 0x16, 0x84, 0x0d, 0xd3,
-	0x74, 0xb0, 0xeb, 0xca, 0xd2, 0x9a, 0xb4, 0x1e, 0x2d, 0xc8, 0x3f, 0x3d, 0xdd, 0x5c, 0x14, 0xbe,
-	0xf3, 0x1c, 0xa9, 0x51, 0xc7, 0xb2, 0x9b, 0xaa, 0x4f, 0x44, 0xe7, 0x20, 0x74, 0x84, 0xad, 0xe6,
-	0x01, 0x95, 0x03, 0x9e, 0x89, 0x2a, 0x4e, 0x68, 0x05, 0x22, 0x6d, 0x4c, 0x0d, 0xd3, 0xa0, 0x86,
-	0x3c, 0xcb, 0x90, 0xfe, 0x19, 0x6d, 0x43, 0xc4, 0x30, 0x4d, 0x6c, 0xea, 0x06, 0x95, 0x83, 0x6b,
-	0xd2, 0x7a, 0x6c, 0x6b, 0x25, 0xcb, 0x63, 0xce, 0xfa, 0x31, 0x67, 0x35, 0x5f, 0x6f, 0x61, 0xe1,
-	0xd9, 0xaf, 0xe9, 0x99, 0x87, 0xbf, 0xa5, 0xa5, 0xc7, 0xaf, 0x9e, 0x6c, 0x48, 0xec, 0x65, 0x6c,
-	0xe6, 0x69, 0xe6, 0x08, 0x16, 0x78, 0xdc, 0x2a, 0xfe, 0xac, 0x8b, 0x5d, 0xfa, 0x5f, 0x85, 0x9f,
-	0xf9, 0x5e, 0x82, 0x25, 0xed, 0xc0, 0xc1, 0xee, 0x01, 0x69, 0x99, 0xdb, 0xb8, 0x61, 0xb9, 0x16,
-	0xb1, 0xab, 0xa4, 0x65, 0x35, 0x7a, 0xe8, 0x3c, 0x44, 0xa9, 0x0f, 0xf1, 0x28, 0xd4, 0xe3, 0x0b,
-	0xf4, 0x31, 0x84, 0x8f, 0x2c, 0xdb, 0x24, 0x47, 0x2e, 0x7b, 0x2e, 0xb6, 0xf5, 0x6e, 0x76, 0xa4,
-	0x5d, 0xb2, 0xc3, 0xfe, 0xf6, 0x38, 0x5b, 0xf5, 0xcd, 0xae, 0x95, 0x7e, 0x78, 0xba, 0x99, 0x9a,
-	0x6e, 0xf3, 0xf9, 0xab, 0x27, 0x1b, 0x19, 0x4e, 0xd9, 0x74, 0xcd, 0x7b, 0xb9, 0x09, 0xa1, 0x66,
-	0x9e, 0x49, 0x20, 0x57, 0xb1, 0xd3, 0xc0, 0x36, 0x35, 0x9a, 0x78, 0x44, 0x47, 0x0a, 0xa0, 0xd3,
-	0xc7, 0x84, 0x90, 0x81, 0x9b, 0xb7, 0xa0, 0x64, 0xe7, 0xdf, 0x29, 0xb9, 0x30, 0xa0, 0x64, 0x52,
-	0xb4, 0x99, 0xef, 0x24, 0xf8, 0xdf, 0x89, 0xcf, 0xa1, 0x5b, 0xb0, 0x70, 0x48, 0xa8, 0x65, 0x37,
-	0xf5, 0x0e, 0x76, 0x2c, 0xc2, 0x6b, 0x12, 0xdb, 0x5a, 0x1e, 0xeb, 0xb7, 0x6d, 0x31, 0x7f, 0xbc,
-	0xdd, 0xbe, 0xec, 0xb7, 0xdb, 0x3c, 0x37, 0xaf, 0x32, 0x6b, 0x74, 0x07, 0x16, 0xdb, 0x96, 0xad,
-	0xe3, 0xfb, 0xb8, 0xd1, 0xf5, 0xd8, 0xbe, 0xd7, 0xc0, 0x29, 0xbd, 0xa2, 0xb6, 0x65, 0x2b, 0xbe,
-	0x13, 0xee, 0x3b, 0xf3, 0xa7, 0x04, 0xd1, 0x4f, 0xbc, 0x44, 0x94, 0xec, 0xbb, 0x04, 0xc5, 0x21,
-	0x60, 0xf1, 0x68, 0x83, 0x6a, 0xc0, 0x32, 0x51, 0x16, 0xe6, 0x0c, 0xb3, 0x6d, 0xd9, 0xbc, 0x4f,
-	0xa7, 0xb4, 0x36, 0xa7, 0x4d, 0x9d, 0x3f, 0x19, 0xc2, 0x87, 0xd8, 0xf1, 0x92, 0xc5, 0xc6, 0x2f,
-	0xa8, 0xfa, 0x47, 0xf4, 0x0e, 0xcc, 0x53, 0x42, 0x8d, 0x96, 0x2e, 0x86, 0x62, 0x8e, 0x59, 0xc6,
-	0xd8, 0xdd, 0x1e, 0x9f, 0x8c, 0x1b, 0x00, 0x0d, 0x07, 0x1b, 0x94, 0x8f, 0x6f, 0xe8, 0xb4, 0xe3,
-	0x1b, 0x15, 0xc6, 0x79, 0x9a, 0xb9, 0x0d, 0x31, 0xa6, 0x57, 0x6c, 0x9f, 0x65, 0x88, 0xb0, 0x3e,
-	0xd0, 0xfb, 0xba, 0xc3, 0xec, 0x5c, 0x32, 0x51, 0x0e, 0x42, 0x6d, 0x46, 0x12, 0x89, 0x5e, 0x1a,
-	0x6b, 0x36, 0xb1, 0x09, 0x04, 0x2d, 0xf3, 0x77, 0x00, 0x12, 0xcc, 0x37, 0xef, 0x06, 0x96, 0xd1,
-	0x37, 0x59, 0x0f, 0x83, 0x31, 0x05, 0x86, 0x63, 0xea, 0x17, 0x64, 0xf6, 0xf4, 0x05, 0x09, 0x4e,
-	0x2e, 0xc8, 0xdc, 0x70, 0x41, 0x0c, 0x48, 0x98, 0xa2, 0xb1, 0xf5, 0x0e, 0xd3, 0x22, 0x52, 0xbe,
-	0x38, 0x96, 0xf2, 0xbc, 0xdd, 0x2b, 0x64, 0x5e, 0x3f, 0x54, 0x6a, 0xdc, 0x1c, 0x1e, 0xf5, 0xe1,
-	0x82, 0x86, 0xdf, 0xbc, 0xa0, 0xd7, 0x22, 0x0f, 0x1e, 0xa5, 0x67, 0xfe, 0x78, 0x94, 0x96, 0x32,
-	0x8f, 0x43, 0x10, 0xa9, 0x3a, 0xa4, 0x43, 0x5c, 0xa3, 0x35, 0xd6, 0xca, 0x3b, 0xb0, 0xc8, 0x93,
-	0xca, 0x05, 0xe9, 0x7e, 0x55, 0x5e, 0xd7, 0xd9, 0xa8, 0x79, 0x5c, 0x51, 0x81, 0x4c, 0x6d, 0xf3,
-	0x8f, 0x20, 0xda, 0x61, 0x31, 0x60, 0xc7, 0x95, 0x83, 0x6b, 0xb3, 0x53, 0x9d, 0x1f, 0x53, 0xd1,
-	0x0e, 0xc4, 0xdc, 0xee, 0x7e, 0xdb, 0xa2, 0xba, 0xf7, 0xd1, 0x65, 0x15, 0x39, 0x55, 0x46, 0x80,
-	0x5b, 0x7b, 0x38, 0xba, 0x00, 0x0b, 0x5c, 0xab, 0x5f, 0xdf, 0x10, 0x4b, 0xc3, 0x3c, 0xbb, 0xdc,
-	0x15, 0x45, 0xbe, 0x34, 0x92, 0x10, 0x9f, 0x1b, 0x66, 0xdc, 0x41, 0xd9, 0xbe, 0xc5, 0x55, 0x08,
-	0xb9, 0xd4, 0xa0, 0x5d, 0x57, 0x8e, 0xac, 0x49, 0xeb, 0xf1, 0xad, 0xf4, 0xd8, 0x40, 0xf8, 0xd9,
-	0xaf, 0x31, 0x9a, 0x2a, 0xe8, 0xa8, 0x0e, 0xe8, 0xae, 0x65, 0x1b, 0x2d, 0x9d, 0x1a, 0xad, 0x56,
-	0x4f, 0x77, 0xb0, 0xdb, 0x6d, 0x51, 0x39, 0xca, 0x24, 0x9e, 0x1f, 0x73, 0xa2, 0x79, 0x24, 0x95,
-	0x71, 0x0a, 0x51, 0x4f, 0x24, 0x17, 0x98, 0x64, 0x2e, 0x06, 0x40, 0x54, 0x87, 0x33, 0x43, 0x6b,
-	0x56, 0xc7, 0xb6, 0x29, 0xc3, 0x69, 0x13, 0x97, 0x18, 0xdc, 0xb5, 0x8a, 0x6d, 0xa2, 0x2a, 0x24,
-	0xf8, 0xaa, 0x25, 0x8e, 0x1f, 0x6a, 0x8c, 0xe9, 0x7d, 0x6f, 0xa2, 0x5e, 0x45, 0xf0, 0x79, 0x60,
-	0x6a, 0x1c, 0x0f, 0x9d, 0xd1, 0x25, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x62, 0x57, 0x9e, 0x5f, 0x9b,
-	0x9d, 0x34, 0x48, 0x6a, 0x9f, 0x85, 0xde, 0x87, 0x39, 0x6a, 0xd1, 0x16, 0x96, 0x17, 0x58, 0x7b,
-	0x9e, 0xfd, 0xe5, 0xe9, 0x66, 0xe2, 0xf8, 0x9b, 0xb4, 0x76, 0x29, 0xfb, 0xe1, 0x55, 0x95, 0x33,
-	0xd0, 0x26, 0x84, 0xdd, 0x6e, 0xbb, 0x6d, 0x38, 0x3d, 0x39, 0x3e, 0x99, 0xec, 0x73, 0xae, 0x05,
-	0xbd, 0x71, 0xc9, 0x7c, 0x25, 0x41, 0x6c, 0x30, 0x95, 0xab, 0x10, 0xed, 0x61, 0x57, 0x6f, 0x90,
-	0xae, 0x4d, 0xc5, 0x87, 0x37, 0xd2, 0xc3, 0x6e, 0xd1, 0x3b, 0x7b, 0xed, 0x64, 0xec, 0xbb, 0xd4,
-	0xb0, 0x6c, 0x41, 0xe0, 0x7f, 0xb5, 0xcc, 0x8b, 0x4b, 0x4e, 0x5a, 0x86, 0x88, 0x4d, 0x04, 0xce,
-	0x67, 0x22, 0x6c, 0x13, 0x0e, 0x7d, 0x00, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c,
-	0x7d, 0x12, 0x5f, 0x47, 0x09, 0x9b, 0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xf1, 0xfd,
-	0x25, 0x41, 0x70, 0x97, 0x50, 0x8c, 0xd2, 0x10, 0xeb, 0x88, 0x24, 0x1f, 0xaf, 0x68, 0xf0, 0xaf,
-	0xf8, 0x46, 0x3c, 0x24, 0x54, 0x2c, 0xe9, 0xa9, 0x1b, 0x91, 0xd1, 0xd0, 0x15, 0x08, 0x91, 0x8e,
-	0xf7, 0x01, 0x64, 0x51, 0xc6, 0xb7, 0x56, 0xc7, 0x8a, 0xea, 0xbd, 0x5b, 0x61, 0x14, 0x55, 0x50,
-	0xa7, 0xae, 0xd1, 0xb7, 0x38, 0xb8, 0x1b, 0x5f, 0x48, 0x00, 0xc7, 0xcf, 0xa3, 0x55, 0x58, 0xda,
-	0xad, 0x68, 0x8a, 0x5e, 0xa9, 0x6a, 0xa5, 0x4a, 0x59, 0xaf, 0x97, 0x6b, 0x55, 0xa5, 0x58, 0xba,
-	0x5e, 0x52, 0xb6, 0x93, 0x33, 0xe8, 0x2c, 0x24, 0x06, 0xc1, 0xdb, 0x4a, 0x2d, 0x29, 0xa1, 0x25,
-	0x38, 0x3b, 0x78, 0x99, 0x2f, 0xd4, 0xb4, 0x7c, 0xa9, 0x9c, 0x0c, 0x20, 0x04, 0xf1, 0x41, 0xa0,
-	0x5c, 0x49, 0xce, 0xa2, 0xf3, 0x20, 0x0f, 0xdf, 0xe9, 0x7b, 0x25, 0xed, 0x86, 0xbe, 0xab, 0x68,
-	0x95, 0x64, 0x70, 0x25, 0xf8, 0xe0, 0xeb, 0xd4, 0xcc, 0xc6, 0x8f, 0x12, 0xc4, 0x87, 0xa7, 0x1a,
-	0xa5, 0x61, 0xb5, 0xaa, 0x56, 0xaa, 0x95, 0x5a, 0xfe, 0xa6, 0x5e, 0xd3, 0xf2, 0x5a, 0xbd, 0x36,
-	0x12, 0xd9, 0xff, 0x61, 0x79, 0x94, 0x50, 0xab, 0x17, 0x6e, 0x95, 0x34, 0x4d, 0xd9, 0x4e, 0x4a,
-	0xde, 0xb3, 0xa3, 0x70, 0xbe, 0x58, 0x54, 0xaa, 0x1e, 0x1a, 0x38, 0x09, 0x55, 0x95, 0x1d, 0xa5,
-	0xe8, 0xa1, 0xb3, 0x5e, 0x46, 0xc6, 0x6c, 0x0b, 0x15, 0xd5, 0x03, 0x83, 0x27, 0xbd, 0xeb, 0x09,
-	0xda, 0x56, 0xf3, 0x7b, 0xe5, 0xe4, 0x9c, 0x10, 0xf4, 0xad, 0x04, 0xe7, 0x4e, 0x1e, 0x5b, 0xb4,
-	0x0e, 0x17, 0xfb, 0xf6, 0xca, 0xa7, 0x4a, 0xb1, 0xae, 0x55, 0x54, 0x5d, 0x55, 0x6a, 0xf5, 0x9b,
-	0xda, 0x88, 0xc2, 0x8b, 0xb0, 0x36, 0x91, 0x59, 0xae, 0x68, 0xba, 0x5a, 0x2f, 0x27, 0xa5, 0xa9,
-	0xac, 0x5a, 0xbd, 0x58, 0x54, 0x6a, 0xb5, 0x64, 0x60, 0x2a, 0xeb, 0x7a, 0xbe, 0x74, 0xb3, 0xae,
-	0x2a, 0xc9, 0x59, 0x1e, 0x7c, 0x21, 0xfb, 0xec, 0x45, 0x4a, 0x7a, 0xfe, 0x22, 0x25, 0xfd, 0xfe,
-	0x22, 0x25, 0x3d, 0x7c, 0x99, 0x9a, 0x79, 0xfe, 0x32, 0x35, 0xf3, 0xf3, 0xcb, 0xd4, 0xcc, 0x1d,
-	0xd1, 0xf3, 0xae, 0x79, 0x2f, 0x6b, 0x91, 0xdc, 0x7d, 0xfe, 0x6f, 0xe2, 0x7e, 0x88, 0xb5, 0xdf,
-	0x95, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x6e, 0xa9, 0xde, 0x3d, 0x0e, 0x00, 0x00,
+	// 1431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xbd, 0x6f, 0x1b, 0x47,
+	0x16, 0xd7, 0x92, 0x14, 0x3f, 0x1e, 0x25, 0x92, 0x1e, 0xeb, 0xac, 0x95, 0xe4, 0x23, 0x75, 0xb4,
+	0x71, 0xa7, 0xd3, 0x41, 0xa4, 0x2d, 0x1f, 0xce, 0x80, 0xab, 0x23, 0xa9, 0xf5, 0x99, 0x82, 0x2d,
+	0x12, 0xcb, 0xa5, 0x74, 0x76, 0xb3, 0x58, 0x71, 0xc7, 0xd4, 0xc2, 0xe4, 0x0e, 0xb3, 0x3b, 0x94,
+	0xcc, 0xff, 0xc0, 0x48, 0x13, 0x97, 0x69, 0x0c, 0x18, 0x48, 0xe3, 0xd2, 0x85, 0xab, 0x94, 0x41,
+	0x0a, 0x23, 0x45, 0x60, 0xa4, 0x0a, 0x52, 0x24, 0x81, 0x5d, 0x38, 0x55, 0xaa, 0x74, 0x41, 0x80,
+	0x60, 0x67, 0x66, 0x29, 0x7e, 0x88, 0x54, 0xe4, 0x18, 0x6e, 0x0c, 0xcf, 0xfc, 0x7e, 0xef, 0xcd,
+	0xfb, 0xbd, 0xaf, 0xa5, 0x60, 0xa5, 0x41, 0xdc, 0x36, 0x71, 0xf3, 0x4d, 0x87, 0x74, 0x3b, 0xf9,
+	0xc3, 0xab, 0x79, 0xda, 0xeb, 0x60, 0x37, 0xd7, 0x71, 0x08, 0x25, 0x28, 0xc9, 0xc1, 0x1c, 0x03,
+	0x73, 0x87, 0x57, 0x97, 0x17, 0x9a, 0xa4, 0x49, 0x18, 0x96, 0xf7, 0xfe, 0xc7, 0x69, 0xcb, 0xe9,
+	0x26, 0x21, 0xcd, 0x16, 0xce, 0xb3, 0xd3, 0x7e, 0xf7, 0x7e, 0xde, 0xec, 0x3a, 0x06, 0xb5, 0x88,
+	0x2d, 0xf0, 0xcc, 0x28, 0x4e, 0xad, 0x36, 0x76, 0xa9, 0xd1, 0xee, 0x08, 0xc2, 0x12, 0x7f, 0x47,
+	0xe7, 0x9e, 0xc5, 0xa3, 0x02, 0x1a, 0xb5, 0x35, 0xec, 0x9e, 0x80, 0xce, 0x19, 0x6d, 0xcb, 0x26,
+	0x79, 0xf6, 0x2f, 0xbf, 0xca, 0xfe, 0x2a, 0x41, 0xf8, 0x0e, 0x6e, 0xef, 0x63, 0x07, 0x6d, 0x42,
+	0xc4, 0x30, 0x4d, 0x07, 0xbb, 0xae, 0x2c, 0xad, 0x4a, 0x6b, 0xb1, 0xa2, 0xfc, 0xcd, 0x8b, 0x8d,
+	0x05, 0xe1, 0xbb, 0xc0, 0x91, 0x1a, 0x75, 0x2c, 0xbb, 0xa9, 0xfa, 0x44, 0x74, 0x01, 0xc2, 0x47,
+	0xd8, 0x6a, 0x1e, 0x50, 0x39, 0xe0, 0x99, 0xa8, 0xe2, 0x84, 0x96, 0x21, 0xda, 0xc6, 0xd4, 0x30,
+	0x0d, 0x6a, 0xc8, 0x41, 0x86, 0xf4, 0xcf, 0x68, 0x0b, 0xa2, 0x86, 0x69, 0x62, 0x53, 0x37, 0xa8,
+	0x1c, 0x5a, 0x95, 0xd6, 0xe2, 0x9b, 0xcb, 0x39, 0x1e, 0x73, 0xce, 0x8f, 0x39, 0xa7, 0xf9, 0x7a,
+	0x8b, 0xf3, 0x2f, 0xbf, 0xcf, 0xcc, 0x3c, 0xfe, 0x21, 0x23, 0x3d, 0x7b, 0xfb, 0x7c, 0x5d, 0x62,
+	0x2f, 0x63, 0xb3, 0x40, 0x51, 0x09, 0xe6, 0x5a, 0xa4, 0xf1, 0x00, 0x9b, 0x7a, 0xd7, 0xa6, 0x56,
+	0x4b, 0x9e, 0x3d, 0xd5, 0x53, 0xc8, 0xf3, 0xa2, 0xc6, 0xb9, 0x55, 0xdd, 0x33, 0xca, 0x1e, 0xc1,
+	0x3c, 0x17, 0xaf, 0xe2, 0x8f, 0xba, 0xd8, 0xa5, 0x1f, 0x2a, 0x07, 0xd9, 0x2f, 0x25, 0x58, 0xd4,
+	0x0e, 0x1c, 0xec, 0x1e, 0x90, 0x96, 0xb9, 0x85, 0x1b, 0x96, 0x6b, 0x11, 0xbb, 0x4a, 0x5a, 0x56,
+	0xa3, 0x87, 0x2e, 0x42, 0x8c, 0xfa, 0x10, 0x8f, 0x42, 0x3d, 0xbe, 0x40, 0xff, 0x85, 0xc8, 0x91,
+	0x65, 0x9b, 0xe4, 0xc8, 0x65, 0xcf, 0xc5, 0x37, 0xff, 0x9e, 0x1b, 0xe9, 0xb9, 0xdc, 0xb0, 0xbf,
+	0x3d, 0xce, 0x56, 0x7d, 0xb3, 0x1b, 0xe5, 0xaf, 0x5e, 0x6c, 0xa4, 0xa7, 0xdb, 0x7c, 0xfc, 0xf6,
+	0xf9, 0x7a, 0x96, 0x53, 0x36, 0x5c, 0xf3, 0x41, 0x7e, 0x42, 0xa8, 0xd9, 0x97, 0x12, 0xc8, 0x55,
+	0xec, 0x34, 0xb0, 0x4d, 0x8d, 0x26, 0x1e, 0xd1, 0x91, 0x06, 0xe8, 0xf4, 0x31, 0x21, 0x64, 0xe0,
+	0xe6, 0x3d, 0x28, 0xd9, 0xfe, 0x63, 0x4a, 0x2e, 0x0d, 0x28, 0x99, 0x14, 0x6d, 0xf6, 0x49, 0x00,
+	0xe4, 0x12, 0xb1, 0x0f, 0xad, 0x86, 0x37, 0x87, 0x67, 0x2a, 0x49, 0x1d, 0xce, 0x35, 0xfa, 0x96,
+	0x7a, 0x07, 0x3b, 0x16, 0x31, 0x85, 0xa4, 0xa5, 0xb1, 0x7e, 0xdc, 0x12, 0x93, 0xce, 0x1b, 0xfb,
+	0xd3, 0x7e, 0x63, 0xa7, 0x8e, 0x5d, 0x54, 0x99, 0x87, 0xc1, 0xfc, 0x04, 0x3f, 0x58, 0x7e, 0x26,
+	0xa5, 0x20, 0xfb, 0x85, 0x04, 0x7f, 0x39, 0xf1, 0x39, 0x74, 0x07, 0xe6, 0x0f, 0x09, 0xb5, 0xec,
+	0xa6, 0x2f, 0x5d, 0x3a, 0xa3, 0xf4, 0x39, 0x6e, 0x2e, 0x64, 0xdf, 0x83, 0x85, 0xb6, 0x65, 0xeb,
+	0xf8, 0x21, 0x6e, 0x74, 0xff, 0x4c, 0x42, 0x51, 0xdb, 0xb2, 0x15, 0xdf, 0x09, 0xf7, 0x9d, 0xfd,
+	0x59, 0x82, 0xd8, 0xff, 0xbc, 0x44, 0x94, 0xed, 0xfb, 0x04, 0x25, 0x20, 0x60, 0xf1, 0x68, 0x43,
+	0x6a, 0xc0, 0x32, 0x51, 0x0e, 0x66, 0x0d, 0xb3, 0x6d, 0xd9, 0x72, 0xe0, 0x94, 0xd1, 0xe7, 0xb4,
+	0xa9, 0x4b, 0x4e, 0x86, 0xc8, 0x21, 0x76, 0xbc, 0x64, 0xb1, 0x1d, 0x17, 0x52, 0xfd, 0x23, 0xfa,
+	0x1b, 0xcc, 0x51, 0x42, 0x8d, 0x96, 0x2e, 0x96, 0xc6, 0x2c, 0xb3, 0x8c, 0xb3, 0xbb, 0x3d, 0x76,
+	0x85, 0x6e, 0x01, 0x34, 0x1c, 0x6c, 0x50, 0xbe, 0x23, 0xc3, 0x67, 0xdd, 0x91, 0x31, 0x61, 0x5c,
+	0xa0, 0xd9, 0xbb, 0x10, 0x67, 0x7a, 0xc5, 0x8a, 0x5f, 0x82, 0x28, 0xeb, 0x03, 0xbd, 0xaf, 0x3b,
+	0xc2, 0xce, 0x65, 0x13, 0xe5, 0x21, 0xdc, 0x66, 0x24, 0x91, 0xe8, 0xc5, 0xb1, 0x66, 0x13, 0x9b,
+	0x52, 0xd0, 0xb2, 0xbf, 0x05, 0x20, 0xc9, 0x7c, 0xf3, 0x6e, 0x60, 0x19, 0x7d, 0x97, 0xf5, 0x39,
+	0x18, 0x53, 0x60, 0x38, 0xa6, 0x7e, 0x41, 0x82, 0x67, 0x2f, 0x48, 0x68, 0x72, 0x41, 0x66, 0x87,
+	0x0b, 0x62, 0x40, 0xd2, 0x14, 0x8d, 0xad, 0x77, 0x98, 0x16, 0x91, 0xf2, 0x85, 0xb1, 0x94, 0x17,
+	0xec, 0x5e, 0x31, 0x7b, 0xfa, 0x50, 0xa9, 0x09, 0x73, 0xe8, 0x3c, 0x52, 0xd0, 0xc8, 0xbb, 0x17,
+	0xf4, 0x46, 0xf4, 0xd1, 0xd3, 0xcc, 0xcc, 0x4f, 0x4f, 0x33, 0x52, 0xf6, 0x59, 0x18, 0xa2, 0x55,
+	0x87, 0x74, 0x88, 0x6b, 0xb4, 0xc6, 0x5a, 0x79, 0x1b, 0x16, 0x78, 0x52, 0xb9, 0x20, 0xdd, 0xaf,
+	0xca, 0x69, 0x9d, 0x8d, 0x9a, 0xc7, 0x15, 0x15, 0xc8, 0xd4, 0x36, 0xff, 0x0f, 0xc4, 0x3a, 0x2c,
+	0x06, 0xec, 0xb8, 0x72, 0x68, 0x35, 0x38, 0xd5, 0xf9, 0x31, 0x15, 0x6d, 0x43, 0xdc, 0xed, 0xee,
+	0xb7, 0x2d, 0xaa, 0x7b, 0xbf, 0x6c, 0xe4, 0xd9, 0xb3, 0x66, 0x04, 0xb8, 0xb5, 0x87, 0xa3, 0x4b,
+	0x30, 0xcf, 0xb5, 0xfa, 0xf5, 0x0d, 0xb3, 0x34, 0xcc, 0xb1, 0xcb, 0x5d, 0x51, 0xe4, 0x2b, 0x23,
+	0x09, 0xf1, 0xb9, 0x11, 0xc6, 0x1d, 0x94, 0xed, 0x5b, 0x5c, 0x87, 0xb0, 0x4b, 0x0d, 0xda, 0x75,
+	0xe5, 0xe8, 0xaa, 0xb4, 0x96, 0xd8, 0xcc, 0x8c, 0x0d, 0x84, 0x9f, 0xfd, 0x1a, 0xa3, 0xa9, 0x82,
+	0x8e, 0xea, 0x80, 0xee, 0x5b, 0xb6, 0xd1, 0xd2, 0xa9, 0xd1, 0x6a, 0xf5, 0x74, 0x07, 0xbb, 0xdd,
+	0x16, 0x95, 0x63, 0x4c, 0xe2, 0xc5, 0x31, 0x27, 0x9a, 0x47, 0x52, 0x19, 0xa7, 0x18, 0xf3, 0x44,
+	0x8a, 0xcf, 0x01, 0x73, 0x31, 0x00, 0x7a, 0x5f, 0x99, 0xa1, 0x35, 0xab, 0x63, 0xdb, 0x94, 0xe1,
+	0xac, 0x89, 0x4b, 0x0e, 0xee, 0x5a, 0xc5, 0x36, 0x51, 0x15, 0x92, 0x7c, 0xd5, 0x12, 0xc7, 0x0f,
+	0x35, 0xce, 0xf4, 0xfe, 0x63, 0xa2, 0x5e, 0x45, 0xf0, 0x79, 0x60, 0x6a, 0x02, 0x0f, 0x9d, 0xd1,
+	0x15, 0xaf, 0x5f, 0x5c, 0xd7, 0x68, 0x62, 0x57, 0x9e, 0x5b, 0x0d, 0x4e, 0x1a, 0x24, 0xb5, 0xcf,
+	0x42, 0xff, 0x84, 0x59, 0x6a, 0xd1, 0x16, 0x96, 0xe7, 0x59, 0x7b, 0x9e, 0xff, 0xee, 0xc5, 0x46,
+	0xf2, 0xf8, 0x9b, 0xb4, 0x7a, 0x25, 0xf7, 0xef, 0xeb, 0x2a, 0x67, 0xa0, 0x0d, 0x88, 0xb8, 0xdd,
+	0x76, 0xdb, 0x70, 0x7a, 0x72, 0x62, 0x32, 0xd9, 0xe7, 0xdc, 0x08, 0x79, 0xe3, 0x92, 0x7d, 0x22,
+	0x41, 0x7c, 0x30, 0x95, 0x2b, 0x10, 0xeb, 0x61, 0x57, 0x6f, 0x90, 0xae, 0x4d, 0xc5, 0xe7, 0x3c,
+	0xda, 0xc3, 0x6e, 0xc9, 0x3b, 0x7b, 0xed, 0x64, 0xec, 0xbb, 0xd4, 0xb0, 0x6c, 0x41, 0xe0, 0xbf,
+	0xea, 0xe6, 0xc4, 0x25, 0x27, 0x2d, 0x41, 0xd4, 0x26, 0x02, 0xe7, 0x33, 0x11, 0xb1, 0x09, 0x87,
+	0xfe, 0x05, 0xc8, 0x26, 0xfa, 0x91, 0x45, 0x0f, 0xf4, 0x43, 0x4c, 0x7d, 0x12, 0x5f, 0x47, 0x49,
+	0x9b, 0xec, 0x59, 0xf4, 0x60, 0x17, 0x53, 0x4e, 0x16, 0xf1, 0xfd, 0x22, 0x41, 0x68, 0x97, 0x50,
+	0x8c, 0x32, 0x10, 0xef, 0x88, 0x24, 0x1f, 0xaf, 0x68, 0xf0, 0xaf, 0xf8, 0x46, 0x3c, 0x24, 0x54,
+	0x2c, 0xe9, 0xa9, 0x1b, 0x91, 0xd1, 0xd0, 0x35, 0x08, 0x93, 0x8e, 0xf7, 0x01, 0x64, 0x51, 0x26,
+	0x36, 0x57, 0xc6, 0x8a, 0xea, 0xbd, 0x5b, 0x61, 0x14, 0x55, 0x50, 0xa7, 0xae, 0xd1, 0xf7, 0x38,
+	0xb8, 0xeb, 0x9f, 0x48, 0x00, 0xc7, 0xcf, 0xa3, 0x15, 0x58, 0xdc, 0xad, 0x68, 0x8a, 0x5e, 0xa9,
+	0x6a, 0xe5, 0xca, 0x8e, 0x5e, 0xdf, 0xa9, 0x55, 0x95, 0x52, 0xf9, 0x66, 0x59, 0xd9, 0x4a, 0xcd,
+	0xa0, 0xf3, 0x90, 0x1c, 0x04, 0xef, 0x2a, 0xb5, 0x94, 0x84, 0x16, 0xe1, 0xfc, 0xe0, 0x65, 0xa1,
+	0x58, 0xd3, 0x0a, 0xe5, 0x9d, 0x54, 0x00, 0x21, 0x48, 0x0c, 0x02, 0x3b, 0x95, 0x54, 0x10, 0x5d,
+	0x04, 0x79, 0xf8, 0x4e, 0xdf, 0x2b, 0x6b, 0xb7, 0xf4, 0x5d, 0x45, 0xab, 0xa4, 0x42, 0xcb, 0xa1,
+	0x47, 0x9f, 0xa5, 0x67, 0xd6, 0xbf, 0x96, 0x20, 0x31, 0x3c, 0xd5, 0x28, 0x03, 0x2b, 0x55, 0xb5,
+	0x52, 0xad, 0xd4, 0x0a, 0xb7, 0xf5, 0x9a, 0x56, 0xd0, 0xea, 0xb5, 0x91, 0xc8, 0xfe, 0x0a, 0x4b,
+	0xa3, 0x84, 0x5a, 0xbd, 0x78, 0xa7, 0xac, 0x69, 0xca, 0x56, 0x4a, 0xf2, 0x9e, 0x1d, 0x85, 0x0b,
+	0xa5, 0x92, 0x52, 0xf5, 0xd0, 0xc0, 0x49, 0xa8, 0xaa, 0x6c, 0x2b, 0x25, 0x0f, 0x0d, 0x7a, 0x19,
+	0x19, 0xb3, 0x2d, 0x56, 0x54, 0x0f, 0x0c, 0x9d, 0xf4, 0xae, 0x27, 0x68, 0x4b, 0x2d, 0xec, 0xed,
+	0xa4, 0x66, 0x85, 0xa0, 0xcf, 0x25, 0xb8, 0x70, 0xf2, 0xd8, 0xa2, 0x35, 0xb8, 0xdc, 0xb7, 0x57,
+	0xfe, 0xaf, 0x94, 0xea, 0x5a, 0x45, 0xd5, 0x55, 0xa5, 0x56, 0xbf, 0xad, 0x8d, 0x28, 0xbc, 0x0c,
+	0xab, 0x13, 0x99, 0x3b, 0x15, 0x4d, 0x57, 0xeb, 0x3b, 0x29, 0x69, 0x2a, 0xab, 0x56, 0x2f, 0x95,
+	0x94, 0x5a, 0x2d, 0x15, 0x98, 0xca, 0xba, 0x59, 0x28, 0xdf, 0xae, 0xab, 0x4a, 0x2a, 0xc8, 0x83,
+	0x2f, 0xe6, 0x5e, 0xbe, 0x4e, 0x4b, 0xaf, 0x5e, 0xa7, 0xa5, 0x1f, 0x5f, 0xa7, 0xa5, 0xc7, 0x6f,
+	0xd2, 0x33, 0xaf, 0xde, 0xa4, 0x67, 0xbe, 0x7d, 0x93, 0x9e, 0xb9, 0x27, 0x7a, 0xde, 0x35, 0x1f,
+	0xe4, 0x2c, 0x92, 0x7f, 0xc8, 0xff, 0x16, 0xdf, 0x0f, 0xb3, 0xf6, 0xbb, 0xf6, 0xfb, 0x00, 0x9f,
+	0xb0, 0x74, 0xaf, 0xa2, 0x0f, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1068,12 +1164,22 @@ func (m *Member) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
-	if err1 != nil {
-		return 0, err1
+	if m.LockedUntil != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LockedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LockedUntil):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTypes(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
 	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.AddedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Metadata) > 0 {
@@ -1228,6 +1334,56 @@ func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ConvictionDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConvictionDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConvictionDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Windows != nil {
+		{
+			size, err := m.Windows.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ConvictionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ConvictionPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *DecisionPolicyWindows) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1248,20 +1404,20 @@ func (m *DecisionPolicyWindows) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
-	if err5 != nil {
-		return 0, err5
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VotingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VotingPeriod):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1287,12 +1443,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err6 != nil {
-		return 0, err6
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n9
+	i = encodeVarintTypes(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1389,12 +1545,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
-	if err8 != nil {
-		return 0, err8
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n8
-	i = encodeVarintTypes(dAtA, i, uint64(n8))
+	i -= n11
+	i = encodeVarintTypes(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1496,12 +1652,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x58
 	}
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
-	if err10 != nil {
-		return 0, err10
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.VotingPeriodEnd, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.VotingPeriodEnd):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x52
 	{
@@ -1529,12 +1685,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err12 != nil {
-		return 0, err12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n12
-	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1639,12 +1795,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
-	if err13 != nil {
-		return 0, err13
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SubmitTime):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n13
-	i = encodeVarintTypes(dAtA, i, uint64(n13))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1705,6 +1861,10 @@ func (m *Member) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.AddedAt)
 	n += 1 + l + sovTypes(uint64(l))
+	if m.LockedUntil != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LockedUntil)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -1763,6 +1923,25 @@ func (m *PercentageDecisionPolicy) Size() (n int) {
 	return n
 }
 
+func (m *ConvictionDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ConvictionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Windows != nil {
+		l = m.Windows.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
 func (m *DecisionPolicyWindows) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2126,6 +2305,42 @@ func (m *Member) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockedUntil == nil {
+				m.LockedUntil = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LockedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2529,6 +2744,157 @@ func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConvictionDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConvictionDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConvictionDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConvictionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ConvictionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Windows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Windows == nil {
+				m.Windows = &DecisionPolicyWindows{}
+			}
+			if err := m.Windows.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DecisionPolicyWindows) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
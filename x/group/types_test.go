@@ -386,3 +386,94 @@ func TestThresholdDecisionPolicyAllow(t *testing.T) {
 		})
 	}
 }
+
+func TestConvictionDecisionPolicyValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy group.DecisionPolicy
+		expErr bool
+	}{
+		{
+			"invalid threshold",
+			group.NewConvictionDecisionPolicy("0", time.Hour, 2*time.Hour, 0),
+			true,
+		},
+		{
+			"zero conviction period",
+			group.NewConvictionDecisionPolicy("5", 0, 2*time.Hour, 0),
+			true,
+		},
+		{
+			"conviction period greater than voting period",
+			group.NewConvictionDecisionPolicy("5", 3*time.Hour, 2*time.Hour, 0),
+			true,
+		},
+		{
+			"all good",
+			group.NewConvictionDecisionPolicy("5", time.Hour, 2*time.Hour, 0),
+			false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.policy.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConvictionDecisionPolicyVoteWeight(t *testing.T) {
+	submitTime := time.Now().UTC()
+	policy := group.ConvictionDecisionPolicy{
+		Threshold:        "5",
+		ConvictionPeriod: 4 * time.Hour,
+		Windows:          &group.DecisionPolicyWindows{VotingPeriod: 8 * time.Hour},
+	}
+	proposal := group.Proposal{SubmitTime: submitTime, VotingPeriodEnd: submitTime.Add(8 * time.Hour)}
+
+	testCases := []struct {
+		name      string
+		voteTime  time.Time
+		now       time.Time
+		expWeight string
+	}{
+		{
+			"vote just cast",
+			submitTime,
+			submitTime,
+			"0",
+		},
+		{
+			"vote locked for half the conviction period",
+			submitTime,
+			submitTime.Add(2 * time.Hour),
+			"1.0",
+		},
+		{
+			"vote locked for the conviction period",
+			submitTime.Add(time.Hour),
+			submitTime.Add(5 * time.Hour),
+			"2",
+		},
+		{
+			"vote conviction stops growing at the end of the voting period",
+			submitTime.Add(7 * time.Hour),
+			submitTime.Add(11 * time.Hour),
+			"0.50",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vote := group.Vote{SubmitTime: tc.voteTime}
+			require.Equal(t, proposal.VotingPeriodEnd, policy.VoteLockEnd(proposal, vote))
+
+			weight, err := policy.VoteWeight(proposal, vote, "2", tc.now)
+			require.NoError(t, err)
+			require.Equal(t, tc.expWeight, weight)
+		})
+	}
+}
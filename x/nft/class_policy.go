@@ -0,0 +1,57 @@
+package nft
+
+import (
+	"cosmossdk.io/core/address"
+)
+
+// Validate performs a basic validation of the class policy.
+func (p ClassPolicy) Validate(ac address.Codec) error {
+	if len(p.ClassId) == 0 {
+		return ErrEmptyClassID
+	}
+
+	if _, err := ac.StringToBytes(p.Creator); err != nil {
+		return ErrInvalidClassPolicy.Wrapf("invalid creator address: %s", err)
+	}
+
+	if _, ok := MintPolicy_name[int32(p.MintPolicy)]; !ok {
+		return ErrInvalidClassPolicy.Wrapf("unknown mint policy %d", p.MintPolicy)
+	}
+
+	if p.MintPolicy != MintPolicy_MINT_POLICY_ALLOW_LIST && len(p.AllowedMinters) > 0 {
+		return ErrInvalidClassPolicy.Wrapf("allowed minters require the %s mint policy", MintPolicy_MINT_POLICY_ALLOW_LIST)
+	}
+
+	seen := make(map[string]bool, len(p.AllowedMinters))
+	for _, minter := range p.AllowedMinters {
+		if _, err := ac.StringToBytes(minter); err != nil {
+			return ErrInvalidClassPolicy.Wrapf("invalid allowed minter address %s: %s", minter, err)
+		}
+		if seen[minter] {
+			return ErrInvalidClassPolicy.Wrapf("duplicate allowed minter %s", minter)
+		}
+		seen[minter] = true
+	}
+
+	return nil
+}
+
+// CanMint returns true if the minter is allowed to mint the NFTs of the class.
+func (p ClassPolicy) CanMint(minter string) bool {
+	switch p.MintPolicy {
+	case MintPolicy_MINT_POLICY_OPEN:
+		return true
+	case MintPolicy_MINT_POLICY_ALLOW_LIST:
+		if minter == p.Creator {
+			return true
+		}
+		for _, allowed := range p.AllowedMinters {
+			if minter == allowed {
+				return true
+			}
+		}
+		return false
+	default:
+		return minter == p.Creator
+	}
+}
@@ -12,4 +12,9 @@ var (
 	ErrNFTNotExists   = errors.Register(ModuleName, 6, "nft does not exist")
 	ErrEmptyClassID   = errors.Register(ModuleName, 7, "empty class id")
 	ErrEmptyNFTID     = errors.Register(ModuleName, 8, "empty nft id")
+
+	ErrClassPolicyNotExists = errors.Register(ModuleName, 9, "nft class policy does not exist")
+	ErrMaxSupplyReached     = errors.Register(ModuleName, 10, "nft class max supply reached")
+	ErrNonTransferable      = errors.Register(ModuleName, 11, "nft is not transferable")
+	ErrInvalidClassPolicy   = errors.Register(ModuleName, 12, "invalid nft class policy")
 )
@@ -6,10 +6,35 @@ import (
 
 // ValidateGenesis checks that the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState, ac address.Codec) error {
+	classes := make(map[string]bool, len(data.Classes))
 	for _, class := range data.Classes {
 		if len(class.Id) == 0 {
 			return ErrEmptyClassID
 		}
+		classes[class.Id] = true
+	}
+	policies := make(map[string]bool, len(data.ClassPolicies))
+	for _, policy := range data.ClassPolicies {
+		if err := policy.Validate(ac); err != nil {
+			return err
+		}
+		if !classes[policy.ClassId] {
+			return ErrClassNotExists.Wrapf("policy of class %s", policy.ClassId)
+		}
+		if policies[policy.ClassId] {
+			return ErrInvalidClassPolicy.Wrapf("duplicate policy of class %s", policy.ClassId)
+		}
+		policies[policy.ClassId] = true
+	}
+	minted := make(map[string]bool, len(data.ClassMinted))
+	for _, classMinted := range data.ClassMinted {
+		if !classes[classMinted.ClassId] {
+			return ErrClassNotExists.Wrapf("minted nfts of class %s", classMinted.ClassId)
+		}
+		if minted[classMinted.ClassId] {
+			return ErrInvalidClassPolicy.Wrapf("duplicate minted nfts of class %s", classMinted.ClassId)
+		}
+		minted[classMinted.ClassId] = true
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -28,6 +28,10 @@ type GenesisState struct {
 	Classes []*Class `protobuf:"bytes,1,rep,name=classes,proto3" json:"classes,omitempty"`
 	// entry defines all nft owned by a person.
 	Entries []*Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+	// class_policies defines the policies of the classes created with Msg/SaveClass.
+	ClassPolicies []*ClassPolicy `protobuf:"bytes,3,rep,name=class_policies,json=classPolicies,proto3" json:"class_policies,omitempty"`
+	// class_minted defines the number of nfts ever minted in the classes.
+	ClassMinted []*ClassMinted `protobuf:"bytes,4,rep,name=class_minted,json=classMinted,proto3" json:"class_minted,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +81,75 @@ func (m *GenesisState) GetEntries() []*Entry {
 	return nil
 }
 
+func (m *GenesisState) GetClassPolicies() []*ClassPolicy {
+	if m != nil {
+		return m.ClassPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassMinted() []*ClassMinted {
+	if m != nil {
+		return m.ClassMinted
+	}
+	return nil
+}
+
+// ClassMinted defines the number of nfts ever minted in a class, the burnt ones included.
+type ClassMinted struct {
+	// class_id is the id of the class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// minted is the number of nfts ever minted in the class.
+	Minted uint64 `protobuf:"varint,2,opt,name=minted,proto3" json:"minted,omitempty"`
+}
+
+func (m *ClassMinted) Reset()         { *m = ClassMinted{} }
+func (m *ClassMinted) String() string { return proto.CompactTextString(m) }
+func (*ClassMinted) ProtoMessage()    {}
+func (*ClassMinted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0095f7548e354a72, []int{1}
+}
+func (m *ClassMinted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassMinted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassMinted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassMinted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassMinted.Merge(m, src)
+}
+func (m *ClassMinted) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassMinted) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassMinted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassMinted proto.InternalMessageInfo
+
+func (m *ClassMinted) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassMinted) GetMinted() uint64 {
+	if m != nil {
+		return m.Minted
+	}
+	return 0
+}
+
 // Entry Defines all nft owned by a person
 type Entry struct {
 	// owner is the owner address of the following nft
@@ -89,7 +162,7 @@ func (m *Entry) Reset()         { *m = Entry{} }
 func (m *Entry) String() string { return proto.CompactTextString(m) }
 func (*Entry) ProtoMessage()    {}
 func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0095f7548e354a72, []int{1}
+	return fileDescriptor_0095f7548e354a72, []int{2}
 }
 func (m *Entry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -134,28 +207,34 @@ func (m *Entry) GetNfts() []*NFT {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.nft.v1beta1.GenesisState")
+	proto.RegisterType((*ClassMinted)(nil), "cosmos.nft.v1beta1.ClassMinted")
 	proto.RegisterType((*Entry)(nil), "cosmos.nft.v1beta1.Entry")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/genesis.proto", fileDescriptor_0095f7548e354a72) }
 
 var fileDescriptor_0095f7548e354a72 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa8,
-	0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x83, 0xaa, 0x90, 0x92, 0xc1, 0xa2, 0x0b, 0x24, 0x0f, 0xd6, 0xa1,
-	0x54, 0xc1, 0xc5, 0xe3, 0x0e, 0x31, 0x22, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x98, 0x8b, 0x3d,
-	0x39, 0x27, 0xb1, 0xb8, 0x38, 0xb5, 0x58, 0x82, 0x51, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x52, 0x0f,
-	0xd3, 0x4c, 0x3d, 0x67, 0x90, 0x92, 0x20, 0x98, 0x4a, 0x90, 0xa6, 0xd4, 0xbc, 0x92, 0xa2, 0xcc,
-	0xd4, 0x62, 0x09, 0x26, 0xdc, 0x9a, 0x5c, 0xf3, 0x4a, 0x8a, 0x2a, 0x83, 0x60, 0x2a, 0x95, 0xbc,
-	0xb8, 0x58, 0xc1, 0x22, 0x42, 0x22, 0x5c, 0xac, 0xf9, 0xe5, 0x79, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
-	0x8c, 0x1a, 0x9c, 0x41, 0x10, 0x8e, 0x90, 0x36, 0x17, 0x4b, 0x5e, 0x5a, 0x09, 0xcc, 0x40, 0x71,
-	0x6c, 0x06, 0xfa, 0xb9, 0x85, 0x04, 0x81, 0x15, 0x39, 0xe9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x34, 0x44, 0x8a, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x2b, 0x40,
-	0x3e, 0x4f, 0x62, 0x03, 0x7b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8, 0x93, 0x50,
-	0x50, 0x01, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x4f, 0x4b, 0xfb, 0x30,
+	0x18, 0xc7, 0x97, 0xfd, 0xfb, 0xfd, 0xcc, 0xa6, 0x87, 0x20, 0xda, 0x89, 0xc4, 0xb2, 0xd3, 0x40,
+	0x49, 0x99, 0x7b, 0x03, 0x32, 0x71, 0xa2, 0xa0, 0x48, 0xf4, 0xe4, 0x45, 0xba, 0x36, 0x95, 0xe0,
+	0x96, 0x8c, 0x26, 0xa8, 0xbb, 0xfb, 0x02, 0x7c, 0x59, 0x1e, 0x77, 0xf4, 0x28, 0xed, 0x1b, 0x91,
+	0xa4, 0xa9, 0x0a, 0x6e, 0x78, 0xfc, 0x92, 0xcf, 0xe7, 0xfb, 0xf4, 0xe9, 0x03, 0xfd, 0x48, 0xaa,
+	0xa9, 0x54, 0x81, 0x48, 0x74, 0xf0, 0xd8, 0x1f, 0x33, 0x1d, 0xf6, 0x83, 0x7b, 0x26, 0x98, 0xe2,
+	0x8a, 0xcc, 0x52, 0xa9, 0x25, 0x42, 0x05, 0x41, 0x44, 0xa2, 0x89, 0x23, 0x76, 0x76, 0x97, 0x58,
+	0xe6, 0xdd, 0x1a, 0xdd, 0x97, 0x2a, 0x6c, 0x9f, 0x16, 0x1d, 0xd7, 0x3a, 0xd4, 0x0c, 0x0d, 0xe0,
+	0xbf, 0x68, 0x12, 0x2a, 0xc5, 0x94, 0x07, 0xfc, 0x5a, 0xaf, 0x75, 0xd8, 0x21, 0xbf, 0x4b, 0xc9,
+	0xb1, 0x41, 0x68, 0x49, 0x1a, 0x89, 0x09, 0x9d, 0x72, 0xa6, 0xbc, 0xea, 0x6a, 0xe9, 0x44, 0xe8,
+	0x74, 0x4e, 0x4b, 0x12, 0x8d, 0xe0, 0x86, 0xf5, 0xef, 0x66, 0x72, 0xc2, 0x23, 0xe3, 0xd6, 0xac,
+	0xbb, 0xb7, 0x72, 0xe0, 0x95, 0x01, 0xe7, 0x74, 0x3d, 0xfa, 0x0a, 0xa6, 0x67, 0x08, 0xdb, 0x45,
+	0xcf, 0x94, 0x0b, 0xcd, 0x62, 0xaf, 0xfe, 0x47, 0xcb, 0x85, 0xc5, 0x68, 0x2b, 0xfa, 0x0e, 0xdd,
+	0x23, 0xd8, 0xfa, 0xf1, 0x86, 0x3a, 0xf0, 0x7f, 0x51, 0xc9, 0x63, 0x0f, 0xf8, 0xa0, 0xb7, 0xe6,
+	0x56, 0x3d, 0x8b, 0xd1, 0x16, 0x6c, 0xba, 0x39, 0x55, 0x1f, 0xf4, 0xea, 0xd4, 0xa5, 0xee, 0x39,
+	0x6c, 0xd8, 0xfd, 0xd0, 0x26, 0x6c, 0xc8, 0x27, 0xc1, 0x52, 0x27, 0x16, 0x01, 0xed, 0xc3, 0xba,
+	0x48, 0x74, 0xf9, 0x7b, 0xb6, 0x97, 0x7d, 0xdc, 0xe5, 0xe8, 0x86, 0x5a, 0x68, 0x78, 0xf0, 0x96,
+	0x61, 0xb0, 0xc8, 0x30, 0xf8, 0xc8, 0x30, 0x78, 0xcd, 0x71, 0x65, 0x91, 0xe3, 0xca, 0x7b, 0x8e,
+	0x2b, 0xb7, 0xee, 0xc0, 0x2a, 0x7e, 0x20, 0x5c, 0x06, 0xcf, 0xe6, 0x90, 0xe3, 0xa6, 0xbd, 0xe4,
+	0xe0, 0x73, 0x00, 0xe9, 0x10, 0x38, 0xb5, 0x1f, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -178,6 +257,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClassMinted) > 0 {
+		for iNdEx := len(m.ClassMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClassPolicies) > 0 {
+		for iNdEx := len(m.ClassPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Entries) > 0 {
 		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -209,6 +316,41 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassMinted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassMinted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassMinted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Minted != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Minted))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Entry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -282,6 +424,34 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClassPolicies) > 0 {
+		for _, e := range m.ClassPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassMinted) > 0 {
+		for _, e := range m.ClassMinted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClassMinted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Minted != 0 {
+		n += 1 + sovGenesis(uint64(m.Minted))
+	}
 	return n
 }
 
@@ -407,6 +577,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassPolicies = append(m.ClassPolicies, &ClassPolicy{})
+			if err := m.ClassPolicies[len(m.ClassPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassMinted = append(m.ClassMinted, &ClassMinted{})
+			if err := m.ClassMinted[len(m.ClassMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassMinted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassMinted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassMinted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			m.Minted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Minted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
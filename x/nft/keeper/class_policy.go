@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetClassPolicy defines a method for setting the policy of an exist nft class
+func (k Keeper) SetClassPolicy(ctx context.Context, policy nft.ClassPolicy) error {
+	if !k.HasClass(ctx, policy.ClassId) {
+		return errors.Wrap(nft.ErrClassNotExists, policy.ClassId)
+	}
+	if err := policy.Validate(k.ac); err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&policy)
+	if err != nil {
+		return errors.Wrap(err, "Marshal nft.ClassPolicy failed")
+	}
+	store := k.KVStoreService.OpenKVStore(ctx)
+	return store.Set(classPolicyStoreKey(policy.ClassId), bz)
+}
+
+// GetClassPolicy returns the policy of the specified class, if the class was
+// created with Msg/SaveClass
+func (k Keeper) GetClassPolicy(ctx context.Context, classID string) (nft.ClassPolicy, bool) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	var policy nft.ClassPolicy
+
+	bz, err := store.Get(classPolicyStoreKey(classID))
+	if err != nil {
+		return policy, false
+	}
+
+	if len(bz) == 0 {
+		return policy, false
+	}
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// GetClassPolicies returns the policies of all classes
+func (k Keeper) GetClassPolicies(ctx context.Context) (policies []*nft.ClassPolicy) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(runtime.KVStoreAdapter(store), ClassPolicyKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy nft.ClassPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, &policy)
+	}
+	return
+}
+
+// checkClassCreator returns an error if creator is not the creator of the
+// class, or if the class was not created with Msg/SaveClass.
+func (k Keeper) checkClassCreator(ctx context.Context, classID, creator string) error {
+	policy, has := k.GetClassPolicy(ctx, classID)
+	if !has {
+		if !k.HasClass(ctx, classID) {
+			return errors.Wrap(nft.ErrClassNotExists, classID)
+		}
+		return errors.Wrap(nft.ErrClassPolicyNotExists, classID)
+	}
+	if policy.Creator != creator {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the creator of class %s", creator, classID)
+	}
+	return nil
+}
+
+// checkMaxSupply returns an error if the max supply of the class is reached.
+// The burnt nfts are counted, so that burning nfts does not allow to mint more
+// nfts than the max supply over the lifetime of the class.
+func (k Keeper) checkMaxSupply(ctx context.Context, classID string) error {
+	policy, has := k.GetClassPolicy(ctx, classID)
+	if !has || policy.MaxSupply == 0 {
+		return nil
+	}
+	if k.GetMinted(ctx, classID) >= policy.MaxSupply {
+		return errors.Wrapf(nft.ErrMaxSupplyReached, "class %s has a max supply of %d", classID, policy.MaxSupply)
+	}
+	return nil
+}
+
+// checkTransferable returns an error if the nfts of the class are soulbound.
+func (k Keeper) checkTransferable(ctx context.Context, classID string) error {
+	if policy, has := k.GetClassPolicy(ctx, classID); has && policy.Soulbound {
+		return errors.Wrapf(nft.ErrNonTransferable, "nfts of class %s are soulbound", classID)
+	}
+	return nil
+}
@@ -27,6 +27,19 @@ func (k Keeper) InitGenesis(ctx context.Context, data *nft.GenesisState) error {
 			}
 		}
 	}
+	// the burnt nfts are counted in the number of nfts minted in the classes
+	for _, minted := range data.ClassMinted {
+		if minted.Minted > k.GetMinted(ctx, minted.ClassId) {
+			k.setMinted(ctx, minted.ClassId, minted.Minted)
+		}
+	}
+	// the policies are set after the entries, so that the max supply of the
+	// classes does not prevent minting their nfts
+	for _, policy := range data.ClassPolicies {
+		if err := k.SetClassPolicy(ctx, *policy); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -56,6 +69,13 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*nft.GenesisState, error) {
 	}
 	sort.Strings(owners)
 
+	var classMinted []*nft.ClassMinted
+	for _, class := range classes {
+		if minted := k.GetMinted(ctx, class.Id); minted > k.GetTotalSupply(ctx, class.Id) {
+			classMinted = append(classMinted, &nft.ClassMinted{ClassId: class.Id, Minted: minted})
+		}
+	}
+
 	entries := make([]*nft.Entry, 0, len(nftMap))
 	for _, owner := range owners {
 		entries = append(entries, &nft.Entry{
@@ -64,7 +84,9 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*nft.GenesisState, error) {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:       classes,
+		Entries:       entries,
+		ClassPolicies: k.GetClassPolicies(ctx),
+		ClassMinted:   classMinted,
 	}, nil
 }
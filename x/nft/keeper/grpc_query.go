@@ -259,3 +259,20 @@ func (k Keeper) Classes(ctx context.Context, r *nft.QueryClassesRequest) (*nft.Q
 		Pagination: pageRes,
 	}, nil
 }
+
+// ClassPolicy return the policy of the nft class created with Msg/SaveClass
+func (k Keeper) ClassPolicy(ctx context.Context, r *nft.QueryClassPolicyRequest) (*nft.QueryClassPolicyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if len(r.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	policy, has := k.GetClassPolicy(ctx, r.ClassId)
+	if !has {
+		return nil, nft.ErrClassPolicyNotExists.Wrapf("not found class policy: %s", r.ClassId)
+	}
+	return &nft.QueryClassPolicyResponse{Policy: &policy}, nil
+}
@@ -44,6 +44,7 @@ type TestSuite struct {
 	addrs         []sdk.AccAddress
 	encodedAddrs  []string
 	queryClient   nft.QueryClient
+	msgServer     nft.MsgServer
 	nftKeeper     keeper.Keeper
 	accountKeeper *nfttestutil.MockAccountKeeper
 
@@ -81,6 +82,7 @@ func (s *TestSuite) SetupTest() {
 
 	s.nftKeeper = nftKeeper
 	s.queryClient = nft.NewQueryClient(queryHelper)
+	s.msgServer = keeper.NewMsgServerImpl(nftKeeper)
 	s.ctx = ctx
 }
 
@@ -386,9 +388,12 @@ func (s *TestSuite) TestInitGenesis() {
 			Owner: s.encodedAddrs[0],
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		ClassMinted: []*nft.ClassMinted{{ClassId: testClassID, Minted: 3}},
 	}
 	err := s.nftKeeper.InitGenesis(s.ctx, expGenesis)
 	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+	s.Require().Equal(uint64(3), s.nftKeeper.GetMinted(s.ctx, testClassID))
 
 	actual, has := s.nftKeeper.GetClass(s.ctx, testClassID)
 	s.Require().True(has)
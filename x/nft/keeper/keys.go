@@ -16,6 +16,8 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ClassPolicyKey       = []byte{0x06}
+	ClassMintedKey       = []byte{0x07}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -49,6 +51,22 @@ func classTotalSupply(classID string) []byte {
 	return key
 }
 
+// classPolicyStoreKey returns the byte representation of the nft class policy key
+func classPolicyStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassPolicyKey)+len(classID))
+	copy(key, ClassPolicyKey)
+	copy(key[len(ClassPolicyKey):], classID)
+	return key
+}
+
+// classMintedStoreKey returns the byte representation of the number of nfts minted in the class
+func classMintedStoreKey(classID string) []byte {
+	key := make([]byte, len(ClassMintedKey)+len(classID))
+	copy(key, ClassMintedKey)
+	copy(key[len(ClassMintedKey):], classID)
+	return key
+}
+
 // nftOfClassByOwnerStoreKey returns the byte representation of the nft owner
 // Items are stored with the following key: values
 // 0x03<owner><Delimiter(1 Byte)><classID><Delimiter(1 Byte)>
@@ -10,10 +10,20 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ nft.MsgServer = Keeper{}
+type msgServer struct {
+	Keeper
+}
+
+var _ nft.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the nft MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) nft.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
 
 // Send implements Send method of the types.MsgServer.
-func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
+func (k msgServer) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendResponse, error) {
 	if len(msg.ClassId) == 0 {
 		return nil, nft.ErrEmptyClassID
 	}
@@ -52,3 +62,156 @@ func (k Keeper) Send(ctx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespons
 
 	return &nft.MsgSendResponse{}, nil
 }
+
+// SaveClass implements SaveClass method of the types.MsgServer.
+func (k msgServer) SaveClass(ctx context.Context, msg *nft.MsgSaveClass) (*nft.MsgSaveClassResponse, error) {
+	if _, err := k.ac.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", msg.Creator)
+	}
+
+	if msg.Class == nil || len(msg.Class.Id) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	policy := nft.ClassPolicy{
+		ClassId:        msg.Class.Id,
+		Creator:        msg.Creator,
+		MintPolicy:     msg.MintPolicy,
+		AllowedMinters: msg.AllowedMinters,
+		MaxSupply:      msg.MaxSupply,
+		Soulbound:      msg.Soulbound,
+	}
+	if err := policy.Validate(k.ac); err != nil {
+		return nil, err
+	}
+
+	if k.HasClass(ctx, msg.Class.Id) {
+		if err := k.checkClassCreator(ctx, msg.Class.Id, msg.Creator); err != nil {
+			return nil, err
+		}
+
+		if minted := k.GetMinted(ctx, msg.Class.Id); msg.MaxSupply != 0 && msg.MaxSupply < minted {
+			return nil, errorsmod.Wrapf(nft.ErrInvalidClassPolicy, "max supply %d is lower than the %d nfts already minted", msg.MaxSupply, minted)
+		}
+
+		if err := k.UpdateClass(ctx, *msg.Class); err != nil {
+			return nil, err
+		}
+	} else if err := k.Keeper.SaveClass(ctx, *msg.Class); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetClassPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventSaveClass{
+		ClassId: msg.Class.Id,
+		Creator: msg.Creator,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgSaveClassResponse{}, nil
+}
+
+// Mint implements Mint method of the types.MsgServer.
+func (k msgServer) Mint(ctx context.Context, msg *nft.MsgMint) (*nft.MsgMintResponse, error) {
+	minter, err := k.ac.StringToBytes(msg.Minter)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid minter address (%s)", msg.Minter)
+	}
+
+	if msg.Nft == nil || len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	receiver := minter
+	if len(msg.Receiver) != 0 {
+		receiver, err = k.ac.StringToBytes(msg.Receiver)
+		if err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid receiver address (%s)", msg.Receiver)
+		}
+	}
+
+	policy, has := k.GetClassPolicy(ctx, msg.Nft.ClassId)
+	if !has {
+		if !k.HasClass(ctx, msg.Nft.ClassId) {
+			return nil, errorsmod.Wrap(nft.ErrClassNotExists, msg.Nft.ClassId)
+		}
+		return nil, errorsmod.Wrap(nft.ErrClassPolicyNotExists, msg.Nft.ClassId)
+	}
+
+	if !policy.CanMint(msg.Minter) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nfts of class %s", msg.Minter, msg.Nft.ClassId)
+	}
+
+	if err := k.Keeper.Mint(ctx, *msg.Nft, receiver); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgMintResponse{}, nil
+}
+
+// Burn implements Burn method of the types.MsgServer.
+func (k msgServer) Burn(ctx context.Context, msg *nft.MsgBurn) (*nft.MsgBurnResponse, error) {
+	if len(msg.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	sender, err := k.ac.StringToBytes(msg.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", msg.Owner)
+	}
+
+	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
+	if !bytes.Equal(owner, sender) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", msg.Owner, msg.Id)
+	}
+
+	if err := k.Keeper.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgBurnResponse{}, nil
+}
+
+// UpdateNFT implements UpdateNFT method of the types.MsgServer.
+func (k msgServer) UpdateNFT(ctx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	if _, err := k.ac.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid creator address (%s)", msg.Creator)
+	}
+
+	if msg.Nft == nil || len(msg.Nft.ClassId) == 0 {
+		return nil, nft.ErrEmptyClassID
+	}
+
+	if len(msg.Nft.Id) == 0 {
+		return nil, nft.ErrEmptyNFTID
+	}
+
+	if err := k.checkClassCreator(ctx, msg.Nft.ClassId, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	if err := k.Update(ctx, *msg.Nft); err != nil {
+		return nil, err
+	}
+
+	if err := k.EventService.EventManager(ctx).Emit(&nft.EventUpdate{
+		ClassId: msg.Nft.ClassId,
+		Id:      msg.Nft.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
@@ -121,7 +121,7 @@ func (s *TestSuite) TestSend() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_, err := s.nftKeeper.Send(s.ctx, tc.req)
+			_, err := s.msgServer.Send(s.ctx, tc.req)
 			if tc.expErr {
 				s.Require().Error(err)
 				s.Require().Contains(err.Error(), tc.errMsg)
@@ -131,3 +131,243 @@ func (s *TestSuite) TestSend() {
 		})
 	}
 }
+
+func (s *TestSuite) TestMsgSaveClass() {
+	testCases := []struct {
+		name   string
+		req    *nft.MsgSaveClass
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "invalid creator",
+			req:    &nft.MsgSaveClass{Creator: "invalid", Class: &ExpClass},
+			expErr: true,
+			errMsg: "Invalid creator address",
+		},
+		{
+			name:   "empty class",
+			req:    &nft.MsgSaveClass{Creator: s.encodedAddrs[0]},
+			expErr: true,
+			errMsg: "empty class id",
+		},
+		{
+			name: "allowed minters without allow list",
+			req: &nft.MsgSaveClass{
+				Creator:        s.encodedAddrs[0],
+				Class:          &ExpClass,
+				AllowedMinters: []string{s.encodedAddrs[1]},
+			},
+			expErr: true,
+			errMsg: "allowed minters require",
+		},
+		{
+			name: "valid class",
+			req: &nft.MsgSaveClass{
+				Creator:        s.encodedAddrs[0],
+				Class:          &ExpClass,
+				MintPolicy:     nft.MintPolicy_MINT_POLICY_ALLOW_LIST,
+				AllowedMinters: []string{s.encodedAddrs[1]},
+				MaxSupply:      1,
+			},
+		},
+		{
+			name: "update by another account",
+			req: &nft.MsgSaveClass{
+				Creator: s.encodedAddrs[1],
+				Class:   &ExpClass,
+			},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not the creator of class %s", s.encodedAddrs[1], testClassID),
+		},
+		{
+			name: "update by the creator",
+			req: &nft.MsgSaveClass{
+				Creator:    s.encodedAddrs[0],
+				Class:      &nft.Class{Id: testClassID, Name: "updated"},
+				MintPolicy: nft.MintPolicy_MINT_POLICY_OPEN,
+				Soulbound:  true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SaveClass(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	class, has := s.nftKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal("updated", class.Name)
+
+	policy, has := s.nftKeeper.GetClassPolicy(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(nft.ClassPolicy{
+		ClassId:    testClassID,
+		Creator:    s.encodedAddrs[0],
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OPEN,
+		Soulbound:  true,
+	}, policy)
+
+	// classes created by the keeper have no policy
+	kittyClass := nft.Class{Id: "kitty2"}
+	s.Require().NoError(s.nftKeeper.SaveClass(s.ctx, kittyClass))
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{Creator: s.encodedAddrs[0], Class: &kittyClass})
+	s.Require().ErrorIs(err, nft.ErrClassPolicyNotExists)
+}
+
+func (s *TestSuite) TestMsgMint() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Creator:        s.encodedAddrs[0],
+		Class:          &ExpClass,
+		MintPolicy:     nft.MintPolicy_MINT_POLICY_ALLOW_LIST,
+		AllowedMinters: []string{s.encodedAddrs[1]},
+		MaxSupply:      2,
+	})
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name   string
+		req    *nft.MsgMint
+		expErr bool
+		errMsg string
+	}{
+		{
+			name:   "empty nft id",
+			req:    &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &nft.NFT{ClassId: testClassID}},
+			expErr: true,
+			errMsg: "empty nft id",
+		},
+		{
+			name:   "class not exists",
+			req:    &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &nft.NFT{ClassId: "unknown", Id: testID}},
+			expErr: true,
+			errMsg: "nft class does not exist",
+		},
+		{
+			name:   "minter not allowed",
+			req:    &nft.MsgMint{Minter: s.encodedAddrs[2], Nft: &ExpNFT},
+			expErr: true,
+			errMsg: fmt.Sprintf("%s is not allowed to mint nfts of class %s", s.encodedAddrs[2], testClassID),
+		},
+		{
+			name: "mint by the creator",
+			req:  &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &ExpNFT, Receiver: s.encodedAddrs[2]},
+		},
+		{
+			name:   "nft exists",
+			req:    &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &ExpNFT},
+			expErr: true,
+			errMsg: "nft already exists",
+		},
+		{
+			name: "mint by an allowed minter",
+			req:  &nft.MsgMint{Minter: s.encodedAddrs[1], Nft: &nft.NFT{ClassId: testClassID, Id: "kitty2"}},
+		},
+		{
+			name:   "max supply reached",
+			req:    &nft.MsgMint{Minter: s.encodedAddrs[1], Nft: &nft.NFT{ClassId: testClassID, Id: "kitty3"}},
+			expErr: true,
+			errMsg: "max supply reached",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.Mint(s.ctx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().Equal(s.addrs[2], s.nftKeeper.GetOwner(s.ctx, testClassID, testID))
+	s.Require().Equal(s.addrs[1], s.nftKeeper.GetOwner(s.ctx, testClassID, "kitty2"))
+
+	// the max supply cannot be set below the current supply
+	_, err = s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{Creator: s.encodedAddrs[0], Class: &ExpClass, MaxSupply: 1})
+	s.Require().ErrorIs(err, nft.ErrInvalidClassPolicy)
+}
+
+func (s *TestSuite) TestMsgBurn() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{
+		Creator:    s.encodedAddrs[0],
+		Class:      &ExpClass,
+		MintPolicy: nft.MintPolicy_MINT_POLICY_OPEN,
+		MaxSupply:  1,
+	})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[1], Nft: &ExpNFT})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[0], ClassId: testClassID, Id: testID})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the owner of nft %s", s.encodedAddrs[0], testID))
+
+	_, err = s.msgServer.Burn(s.ctx, &nft.MsgBurn{Owner: s.encodedAddrs[1], ClassId: testClassID, Id: testID})
+	s.Require().NoError(err)
+	s.Require().False(s.nftKeeper.HasNFT(s.ctx, testClassID, testID))
+
+	// burnt nfts still count against the max supply of the class
+	s.Require().Equal(uint64(0), s.nftKeeper.GetTotalSupply(s.ctx, testClassID))
+	s.Require().Equal(uint64(1), s.nftKeeper.GetMinted(s.ctx, testClassID))
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[2], Nft: &ExpNFT})
+	s.Require().ErrorIs(err, nft.ErrMaxSupplyReached)
+
+	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]*nft.ClassMinted{{ClassId: testClassID, Minted: 1}}, genesis.ClassMinted)
+}
+
+func (s *TestSuite) TestMsgUpdateNFT() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{Creator: s.encodedAddrs[0], Class: &ExpClass})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &ExpNFT, Receiver: s.encodedAddrs[1]})
+	s.Require().NoError(err)
+
+	updated := nft.NFT{ClassId: testClassID, Id: testID, Uri: "updated uri"}
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{Creator: s.encodedAddrs[1], Nft: &updated})
+	s.Require().ErrorContains(err, fmt.Sprintf("%s is not the creator of class %s", s.encodedAddrs[1], testClassID))
+
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{Creator: s.encodedAddrs[0], Nft: &nft.NFT{ClassId: testClassID, Id: "kitty2"}})
+	s.Require().ErrorIs(err, nft.ErrNFTNotExists)
+
+	_, err = s.msgServer.UpdateNFT(s.ctx, &nft.MsgUpdateNFT{Creator: s.encodedAddrs[0], Nft: &updated})
+	s.Require().NoError(err)
+
+	actual, has := s.nftKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(updated, actual)
+}
+
+func (s *TestSuite) TestSoulbound() {
+	_, err := s.msgServer.SaveClass(s.ctx, &nft.MsgSaveClass{Creator: s.encodedAddrs[0], Class: &ExpClass, Soulbound: true})
+	s.Require().NoError(err)
+	_, err = s.msgServer.Mint(s.ctx, &nft.MsgMint{Minter: s.encodedAddrs[0], Nft: &ExpNFT})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.Send(s.ctx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.encodedAddrs[0],
+		Receiver: s.encodedAddrs[1],
+	})
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+
+	err = s.nftKeeper.BatchTransfer(s.ctx, testClassID, []string{testID}, s.addrs[1])
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+
+	genesis, err := s.nftKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(genesis.ClassPolicies, 1)
+	s.Require().True(genesis.ClassPolicies[0].Soulbound)
+}
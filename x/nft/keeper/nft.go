@@ -21,6 +21,10 @@ func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress
 		return errors.Wrap(nft.ErrNFTExists, token.Id)
 	}
 
+	if err := k.checkMaxSupply(ctx, token.ClassId); err != nil {
+		return err
+	}
+
 	return k.mintWithNoCheck(ctx, token, receiver)
 }
 
@@ -30,6 +34,7 @@ func (k Keeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress
 func (k Keeper) mintWithNoCheck(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
 	k.setNFT(ctx, token)
 	k.setOwner(ctx, token.ClassId, token.Id, receiver)
+	k.incrMinted(ctx, token.ClassId)
 	k.incrTotalSupply(ctx, token.ClassId)
 
 	recStr, err := k.ac.BytesToString(receiver.Bytes())
@@ -107,6 +112,7 @@ func (k Keeper) updateWithNoCheck(ctx context.Context, token nft.NFT) {
 }
 
 // Transfer defines a method for sending a nft from one account to another account.
+// The nfts of soulbound classes cannot be transferred.
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Transfer(ctx context.Context,
 	classID string,
@@ -121,6 +127,10 @@ func (k Keeper) Transfer(ctx context.Context,
 		return errors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
+	if err := k.checkTransferable(ctx, classID); err != nil {
+		return err
+	}
+
 	err := k.transferWithNoCheck(ctx, classID, nftID, receiver)
 	if err != nil {
 		return err
@@ -207,6 +217,18 @@ func (k Keeper) GetTotalSupply(ctx context.Context, classID string) uint64 {
 	return sdk.BigEndianToUint64(bz)
 }
 
+// GetMinted returns the number of nfts ever minted under the specified classID,
+// the burnt ones included. It is at least the total supply of the class, as the
+// nfts minted before the number of minted nfts was tracked are not counted.
+func (k Keeper) GetMinted(ctx context.Context, classID string) uint64 {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	bz, err := store.Get(classMintedStoreKey(classID))
+	if err != nil {
+		panic(err)
+	}
+	return max(sdk.BigEndianToUint64(bz), k.GetTotalSupply(ctx, classID))
+}
+
 // HasNFT determines whether the specified classID and nftID exist
 func (k Keeper) HasNFT(ctx context.Context, classID, id string) bool {
 	store := k.getNFTStore(ctx, classID)
@@ -267,6 +289,20 @@ func (k Keeper) decrTotalSupply(ctx context.Context, classID string) {
 	k.updateTotalSupply(ctx, classID, supply)
 }
 
+// incrMinted increments the number of nfts minted in the class, it must be
+// called before the total supply is incremented.
+func (k Keeper) incrMinted(ctx context.Context, classID string) {
+	k.setMinted(ctx, classID, k.GetMinted(ctx, classID)+1)
+}
+
+func (k Keeper) setMinted(ctx context.Context, classID string, minted uint64) {
+	store := k.KVStoreService.OpenKVStore(ctx)
+	err := store.Set(classMintedStoreKey(classID), sdk.Uint64ToBigEndian(minted))
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (k Keeper) updateTotalSupply(ctx context.Context, classID string, supply uint64) {
 	store := k.KVStoreService.OpenKVStore(ctx)
 	supplyKey := classTotalSupply(classID)
@@ -24,6 +24,10 @@ func (k Keeper) BatchMint(ctx context.Context,
 			return errors.Wrap(nft.ErrNFTExists, token.Id)
 		}
 
+		if err := k.checkMaxSupply(ctx, token.ClassId); err != nil {
+			return err
+		}
+
 		checked[token.ClassId] = true
 		if err := k.mintWithNoCheck(ctx, token, receiver); err != nil {
 			return err
@@ -77,6 +81,9 @@ func (k Keeper) BatchTransfer(ctx context.Context,
 	if !k.HasClass(ctx, classID) {
 		return errors.Wrap(nft.ErrClassNotExists, classID)
 	}
+	if err := k.checkTransferable(ctx, classID); err != nil {
+		return err
+	}
 	for _, nftID := range nftIDs {
 		if !k.HasNFT(ctx, classID, nftID) {
 			return errors.Wrap(nft.ErrNFTNotExists, nftID)
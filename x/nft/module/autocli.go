@@ -79,6 +79,15 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query all NFT classes.",
 					Example:   fmt.Sprintf(`%s query %s classes`, version.AppName, nft.ModuleName),
 				},
+				{
+					RpcMethod: "ClassPolicy",
+					Use:       "class-policy [class-id]",
+					Short:     "Query the policy of an NFT class created with MsgSaveClass",
+					Example:   fmt.Sprintf(`%s query %s class-policy <class-id>`, version.AppName, nft.ModuleName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+					},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -95,6 +104,34 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					},
 					// Sender is the signer of the transaction and is automatically added as from flag by AutoCLI.
 				},
+				{
+					RpcMethod: "SaveClass",
+					Use:       "save-class --class [class-json] --from [creator]",
+					Short:     "Create or update an NFT class and its minting policy",
+					Example: fmt.Sprintf(`%s tx %s save-class --class '{"id":"kitty","name":"Crypto Kitty"}' --mint-policy MINT_POLICY_ALLOW_LIST --allowed-minters <address> --max-supply 100 --from <creator>`,
+						version.AppName, nft.ModuleName),
+				},
+				{
+					RpcMethod: "Mint",
+					Use:       "mint --nft [nft-json] --from [minter]",
+					Short:     "Mint an NFT of a class created with MsgSaveClass",
+					Example:   fmt.Sprintf(`%s tx %s mint --nft '{"class_id":"kitty","id":"kitty1","uri":"kitty uri"}' --receiver <receiver> --from <minter>`, version.AppName, nft.ModuleName),
+				},
+				{
+					RpcMethod: "Burn",
+					Use:       "burn [class-id] [nft-id] --from [owner]",
+					Short:     "Burn an owned NFT",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "class_id"},
+						{ProtoField: "id"},
+					},
+				},
+				{
+					RpcMethod: "UpdateNFT",
+					Use:       "update-nft --nft [nft-json] --from [creator]",
+					Short:     "Update an NFT of a class created with MsgSaveClass",
+					Example:   fmt.Sprintf(`%s tx %s update-nft --nft '{"class_id":"kitty","id":"kitty1","uri":"new kitty uri"}' --from <creator>`, version.AppName, nft.ModuleName),
+				},
 			},
 		},
 	}
@@ -5,6 +5,7 @@ package nft
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/cosmos/gogoproto/proto"
 	any "github.com/cosmos/gogoproto/types/any"
 	io "io"
@@ -23,6 +24,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MintPolicy defines who can mint the NFTs of a class with Msg/Mint.
+type MintPolicy int32
+
+const (
+	// MINT_POLICY_CREATOR_ONLY only allows the creator of the class to mint.
+	MintPolicy_MINT_POLICY_CREATOR_ONLY MintPolicy = 0
+	// MINT_POLICY_ALLOW_LIST allows the creator of the class and the allowed minters to mint.
+	MintPolicy_MINT_POLICY_ALLOW_LIST MintPolicy = 1
+	// MINT_POLICY_OPEN allows anyone to mint.
+	MintPolicy_MINT_POLICY_OPEN MintPolicy = 2
+)
+
+var MintPolicy_name = map[int32]string{
+	0: "MINT_POLICY_CREATOR_ONLY",
+	1: "MINT_POLICY_ALLOW_LIST",
+	2: "MINT_POLICY_OPEN",
+}
+
+var MintPolicy_value = map[string]int32{
+	"MINT_POLICY_CREATOR_ONLY": 0,
+	"MINT_POLICY_ALLOW_LIST":   1,
+	"MINT_POLICY_OPEN":         2,
+}
+
+func (x MintPolicy) String() string {
+	return proto.EnumName(MintPolicy_name, int32(x))
+}
+
+func (MintPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{0}
+}
+
 // Class defines the class of the nft type.
 type Class struct {
 	// id defines the unique identifier of the NFT classification, similar to the contract address of ERC721
@@ -205,35 +238,143 @@ func (m *NFT) GetData() *any.Any {
 	return nil
 }
 
+// ClassPolicy defines the policy of a class created with Msg/SaveClass.
+type ClassPolicy struct {
+	// class_id is the id of the class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// creator is the address of the creator of the class, which can update the
+	// class, its policy and its NFTs.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// mint_policy defines who can mint the NFTs of the class.
+	MintPolicy MintPolicy `protobuf:"varint,3,opt,name=mint_policy,json=mintPolicy,proto3,enum=cosmos.nft.v1beta1.MintPolicy" json:"mint_policy,omitempty"`
+	// allowed_minters are the addresses allowed to mint with the allow-list mint policy.
+	AllowedMinters []string `protobuf:"bytes,4,rep,name=allowed_minters,json=allowedMinters,proto3" json:"allowed_minters,omitempty"`
+	// max_supply is the maximum number of NFTs ever minted in the class, the burnt
+	// ones included. Zero means unlimited.
+	MaxSupply uint64 `protobuf:"varint,5,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// soulbound NFTs cannot be transferred once minted.
+	Soulbound bool `protobuf:"varint,6,opt,name=soulbound,proto3" json:"soulbound,omitempty"`
+}
+
+func (m *ClassPolicy) Reset()         { *m = ClassPolicy{} }
+func (m *ClassPolicy) String() string { return proto.CompactTextString(m) }
+func (*ClassPolicy) ProtoMessage()    {}
+func (*ClassPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eb8ebf8e8053172c, []int{2}
+}
+func (m *ClassPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassPolicy.Merge(m, src)
+}
+func (m *ClassPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassPolicy proto.InternalMessageInfo
+
+func (m *ClassPolicy) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ClassPolicy) GetMintPolicy() MintPolicy {
+	if m != nil {
+		return m.MintPolicy
+	}
+	return MintPolicy_MINT_POLICY_CREATOR_ONLY
+}
+
+func (m *ClassPolicy) GetAllowedMinters() []string {
+	if m != nil {
+		return m.AllowedMinters
+	}
+	return nil
+}
+
+func (m *ClassPolicy) GetMaxSupply() uint64 {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return 0
+}
+
+func (m *ClassPolicy) GetSoulbound() bool {
+	if m != nil {
+		return m.Soulbound
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("cosmos.nft.v1beta1.MintPolicy", MintPolicy_name, MintPolicy_value)
 	proto.RegisterType((*Class)(nil), "cosmos.nft.v1beta1.Class")
 	proto.RegisterType((*NFT)(nil), "cosmos.nft.v1beta1.NFT")
+	proto.RegisterType((*ClassPolicy)(nil), "cosmos.nft.v1beta1.ClassPolicy")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/nft.proto", fileDescriptor_eb8ebf8e8053172c) }
 
 var fileDescriptor_eb8ebf8e8053172c = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x3b, 0x49, 0x9a, 0xfc, 0xff, 0x14, 0x44, 0x06, 0x91, 0xa9, 0xc8, 0x10, 0xba, 0xca,
-	0x42, 0x26, 0x54, 0x9f, 0x40, 0x05, 0xd1, 0x8d, 0x8b, 0xe2, 0xca, 0x4d, 0x99, 0x64, 0xd2, 0x66,
-	0x30, 0xc9, 0x94, 0x4c, 0x22, 0xe6, 0x09, 0xdc, 0xfa, 0x40, 0x3e, 0x80, 0xcb, 0x2e, 0x5d, 0x4a,
-	0xf2, 0x22, 0x32, 0x93, 0x58, 0x5c, 0x14, 0xdc, 0xdd, 0x7b, 0xce, 0xe1, 0xf2, 0x1d, 0x2e, 0x3c,
-	0x8d, 0xa5, 0xca, 0xa5, 0x0a, 0x8b, 0x55, 0x15, 0x3e, 0xcf, 0xa3, 0xa4, 0x62, 0x73, 0x3d, 0xd3,
-	0x4d, 0x29, 0x2b, 0x89, 0x50, 0xef, 0x52, 0xad, 0x0c, 0xee, 0xc9, 0x74, 0x2d, 0xe5, 0x3a, 0x4b,
-	0x42, 0x93, 0x88, 0xea, 0x55, 0xc8, 0x8a, 0xa6, 0x8f, 0xcf, 0xde, 0x01, 0x1c, 0x5f, 0x67, 0x4c,
-	0x29, 0x74, 0x00, 0x2d, 0xc1, 0x31, 0xf0, 0x41, 0xf0, 0x7f, 0x61, 0x09, 0x8e, 0x10, 0x74, 0x0a,
-	0x96, 0x27, 0xd8, 0x32, 0x8a, 0x99, 0xd1, 0x31, 0x74, 0x55, 0x93, 0x47, 0x32, 0xc3, 0xb6, 0x51,
-	0x87, 0x0d, 0xf9, 0x70, 0xc2, 0x13, 0x15, 0x97, 0x62, 0x53, 0x09, 0x59, 0x60, 0xc7, 0x98, 0xbf,
-	0x25, 0x74, 0x08, 0xed, 0xba, 0x14, 0x78, 0x6c, 0x1c, 0x3d, 0xa2, 0x29, 0xfc, 0x57, 0x97, 0x62,
-	0x99, 0x32, 0x95, 0x62, 0xd7, 0xc8, 0x5e, 0x5d, 0x8a, 0x5b, 0xa6, 0x52, 0x14, 0x40, 0x87, 0xb3,
-	0x8a, 0x61, 0xcf, 0x07, 0xc1, 0xe4, 0xfc, 0x88, 0xf6, 0xf8, 0xf4, 0x07, 0x9f, 0x5e, 0x16, 0xcd,
-	0xc2, 0x24, 0x66, 0xaf, 0x00, 0xda, 0xf7, 0x37, 0x0f, 0xfa, 0x58, 0xac, 0x5b, 0x2c, 0x77, 0x15,
-	0x3c, 0xb3, 0xdf, 0xf1, 0xa1, 0x97, 0xb5, 0xeb, 0x35, 0x90, 0xd8, 0xfb, 0x49, 0x9c, 0xfd, 0x24,
-	0xf0, 0x2f, 0x92, 0xab, 0xb3, 0x8f, 0x96, 0x80, 0x6d, 0x4b, 0xc0, 0x57, 0x4b, 0xc0, 0x5b, 0x47,
-	0x46, 0xdb, 0x8e, 0x8c, 0x3e, 0x3b, 0x32, 0x7a, 0x1c, 0x3e, 0xa2, 0xf8, 0x13, 0x15, 0x32, 0x7c,
-	0xd1, 0xbf, 0x8a, 0x5c, 0x73, 0xe1, 0xe2, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xf7, 0xce, 0x23,
-	0xcc, 0x01, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xdd, 0x8a, 0xda, 0x40,
+	0x14, 0x36, 0x31, 0xab, 0xeb, 0x11, 0xac, 0x0c, 0xb2, 0x44, 0xb1, 0x41, 0xf6, 0x4a, 0x4a, 0x9b,
+	0xb0, 0xf6, 0x01, 0x8a, 0x2b, 0x5b, 0x2a, 0xf8, 0x47, 0x14, 0xca, 0x96, 0xc2, 0x30, 0x3a, 0x59,
+	0x1d, 0x9a, 0x64, 0x64, 0x26, 0x69, 0xf5, 0x09, 0x7a, 0xdb, 0x17, 0xe8, 0x5b, 0xf4, 0xb2, 0x0f,
+	0xd0, 0xcb, 0xa5, 0x57, 0xbd, 0x2c, 0xfa, 0x22, 0x25, 0x93, 0xac, 0x2b, 0x74, 0xd9, 0xbd, 0x3b,
+	0xe7, 0xfb, 0xbe, 0x99, 0x7c, 0x5f, 0xce, 0x1c, 0x68, 0x2e, 0xb8, 0x0c, 0xb8, 0x74, 0xc2, 0x9b,
+	0xc8, 0xf9, 0x7c, 0x31, 0xf7, 0x22, 0x72, 0x91, 0xd4, 0xf6, 0x5a, 0xf0, 0x88, 0x23, 0x94, 0xb2,
+	0x76, 0x82, 0x64, 0x6c, 0xa3, 0xbe, 0xe4, 0x7c, 0xe9, 0x7b, 0x8e, 0x52, 0xcc, 0xe3, 0x1b, 0x87,
+	0x84, 0xdb, 0x54, 0xde, 0xa8, 0xa7, 0x72, 0xac, 0x3a, 0x27, 0x3b, 0xab, 0x9a, 0xf3, 0x9f, 0x1a,
+	0x9c, 0xf4, 0x7c, 0x22, 0x25, 0xaa, 0x80, 0xce, 0xa8, 0xa9, 0xb5, 0xb4, 0x76, 0xc9, 0xd5, 0x19,
+	0x45, 0x08, 0x8c, 0x90, 0x04, 0x9e, 0xa9, 0x2b, 0x44, 0xd5, 0xe8, 0x0c, 0x0a, 0x72, 0x1b, 0xcc,
+	0xb9, 0x6f, 0xe6, 0x15, 0x9a, 0x75, 0xa8, 0x05, 0x65, 0xea, 0xc9, 0x85, 0x60, 0xeb, 0x88, 0xf1,
+	0xd0, 0x34, 0x14, 0x79, 0x0c, 0xa1, 0x2a, 0xe4, 0x63, 0xc1, 0xcc, 0x13, 0xc5, 0x24, 0x25, 0xaa,
+	0xc3, 0x69, 0x2c, 0x18, 0x5e, 0x11, 0xb9, 0x32, 0x0b, 0x0a, 0x2e, 0xc6, 0x82, 0xbd, 0x23, 0x72,
+	0x85, 0xda, 0x60, 0x50, 0x12, 0x11, 0xb3, 0xd8, 0xd2, 0xda, 0xe5, 0x4e, 0xcd, 0x4e, 0x93, 0xd9,
+	0x77, 0xc9, 0xec, 0x6e, 0xb8, 0x75, 0x95, 0xe2, 0xfc, 0xab, 0x06, 0xf9, 0xd1, 0xdb, 0x59, 0x72,
+	0xd9, 0x22, 0x49, 0x81, 0x0f, 0x11, 0x8a, 0xaa, 0xef, 0xd3, 0x2c, 0x97, 0x7e, 0xc8, 0x95, 0x39,
+	0xc9, 0x3f, 0xec, 0xc4, 0x78, 0xd8, 0x09, 0x3c, 0xe9, 0xe4, 0xbb, 0x0e, 0x65, 0xf5, 0x23, 0x27,
+	0xdc, 0x67, 0x8b, 0xed, 0x63, 0x8e, 0x3a, 0x50, 0x5c, 0x08, 0x8f, 0x44, 0x5c, 0xa4, 0xb6, 0x2e,
+	0xcd, 0xdf, 0x3f, 0x5e, 0xd5, 0xb2, 0xb1, 0x74, 0x29, 0x15, 0x9e, 0x94, 0xd3, 0x48, 0xb0, 0x70,
+	0xe9, 0xde, 0x09, 0xd1, 0x1b, 0x28, 0x07, 0x2c, 0x8c, 0xf0, 0x5a, 0xdd, 0xae, 0xdc, 0x57, 0x3a,
+	0x96, 0xfd, 0xff, 0x3b, 0xb0, 0x87, 0x2c, 0x8c, 0x52, 0x0f, 0x2e, 0x04, 0x87, 0x1a, 0x75, 0xe1,
+	0x19, 0xf1, 0x7d, 0xfe, 0xc5, 0xa3, 0x38, 0x41, 0x3d, 0x21, 0x4d, 0xa3, 0x95, 0x7f, 0xf4, 0xe3,
+	0x95, 0xec, 0xc0, 0x30, 0xd5, 0xa3, 0xe7, 0x00, 0x01, 0xd9, 0x60, 0x19, 0xaf, 0xd7, 0xfe, 0x56,
+	0x8d, 0xd2, 0x70, 0x4b, 0x01, 0xd9, 0x4c, 0x15, 0x80, 0x9a, 0x50, 0x92, 0x3c, 0xf6, 0xe7, 0x3c,
+	0x0e, 0xa9, 0x9a, 0xe8, 0xa9, 0x7b, 0x0f, 0xbc, 0xf8, 0x08, 0x70, 0xef, 0x0c, 0x35, 0xc1, 0x1c,
+	0xf6, 0x47, 0x33, 0x3c, 0x19, 0x0f, 0xfa, 0xbd, 0x6b, 0xdc, 0x73, 0xaf, 0xba, 0xb3, 0xb1, 0x8b,
+	0xc7, 0xa3, 0xc1, 0x75, 0x35, 0x87, 0x1a, 0x70, 0x76, 0xcc, 0x76, 0x07, 0x83, 0xf1, 0x7b, 0x3c,
+	0xe8, 0x4f, 0x67, 0x55, 0x0d, 0xd5, 0xa0, 0x7a, 0xcc, 0x8d, 0x27, 0x57, 0xa3, 0xaa, 0x7e, 0xf9,
+	0xf2, 0xd7, 0xce, 0xd2, 0x6e, 0x77, 0x96, 0xf6, 0x77, 0x67, 0x69, 0xdf, 0xf6, 0x56, 0xee, 0x76,
+	0x6f, 0xe5, 0xfe, 0xec, 0xad, 0xdc, 0x87, 0x6c, 0x55, 0x24, 0xfd, 0x64, 0x33, 0xee, 0x6c, 0x92,
+	0x25, 0x9a, 0x17, 0xd4, 0xfc, 0x5e, 0xff, 0x1b, 0x00, 0xcb, 0x5e, 0x68, 0x5e, 0x65, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Class) Marshal() (dAtA []byte, err error) {
@@ -376,6 +517,72 @@ func (m *NFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClassPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Soulbound {
+		i--
+		if m.Soulbound {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxSupply != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MaxSupply))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedMinters) > 0 {
+		for iNdEx := len(m.AllowedMinters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMinters[iNdEx])
+			copy(dAtA[i:], m.AllowedMinters[iNdEx])
+			i = encodeVarintNft(dAtA, i, uint64(len(m.AllowedMinters[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintPolicy != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.MintPolicy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNft(v)
 	base := offset
@@ -453,6 +660,38 @@ func (m *NFT) Size() (n int) {
 	return n
 }
 
+func (m *ClassPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.MintPolicy != 0 {
+		n += 1 + sovNft(uint64(m.MintPolicy))
+	}
+	if len(m.AllowedMinters) > 0 {
+		for _, s := range m.AllowedMinters {
+			l = len(s)
+			n += 1 + l + sovNft(uint64(l))
+		}
+	}
+	if m.MaxSupply != 0 {
+		n += 1 + sovNft(uint64(m.MaxSupply))
+	}
+	if m.Soulbound {
+		n += 2
+	}
+	return n
+}
+
 func sovNft(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -951,6 +1190,210 @@ func (m *NFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClassPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintPolicy", wireType)
+			}
+			m.MintPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintPolicy |= MintPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMinters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMinters = append(m.AllowedMinters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			m.MaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Soulbound", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Soulbound = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNft(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
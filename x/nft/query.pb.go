@@ -1224,6 +1224,98 @@ func (m *QueryClassesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryClassPolicyRequest is the request type for the Query/ClassPolicy RPC method
+type QueryClassPolicyRequest struct {
+	// class_id associated with the nft
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassPolicyRequest) Reset()         { *m = QueryClassPolicyRequest{} }
+func (m *QueryClassPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassPolicyRequest) ProtoMessage()    {}
+func (*QueryClassPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{24}
+}
+func (m *QueryClassPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassPolicyRequest.Merge(m, src)
+}
+func (m *QueryClassPolicyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryClassPolicyRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+// QueryClassPolicyResponse is the response type for the Query/ClassPolicy RPC method
+type QueryClassPolicyResponse struct {
+	// policy defines the policy of the class.
+	Policy *ClassPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (m *QueryClassPolicyResponse) Reset()         { *m = QueryClassPolicyResponse{} }
+func (m *QueryClassPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassPolicyResponse) ProtoMessage()    {}
+func (*QueryClassPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d24e0db697b0f9d, []int{25}
+}
+func (m *QueryClassPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassPolicyResponse.Merge(m, src)
+}
+func (m *QueryClassPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryClassPolicyResponse) GetPolicy() *ClassPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBalanceRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceRequest")
 	proto.RegisterType((*QueryBalanceByQueryStringRequest)(nil), "cosmos.nft.v1beta1.QueryBalanceByQueryStringRequest")
@@ -1249,74 +1341,80 @@ func init() {
 	proto.RegisterType((*QueryClassByQueryStringResponse)(nil), "cosmos.nft.v1beta1.QueryClassByQueryStringResponse")
 	proto.RegisterType((*QueryClassesRequest)(nil), "cosmos.nft.v1beta1.QueryClassesRequest")
 	proto.RegisterType((*QueryClassesResponse)(nil), "cosmos.nft.v1beta1.QueryClassesResponse")
+	proto.RegisterType((*QueryClassPolicyRequest)(nil), "cosmos.nft.v1beta1.QueryClassPolicyRequest")
+	proto.RegisterType((*QueryClassPolicyResponse)(nil), "cosmos.nft.v1beta1.QueryClassPolicyResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/nft/v1beta1/query.proto", fileDescriptor_0d24e0db697b0f9d) }
 
 var fileDescriptor_0d24e0db697b0f9d = []byte{
-	// 987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0xdc, 0x54,
-	0x10, 0xce, 0xdb, 0x64, 0x13, 0x98, 0x4a, 0xa1, 0x4c, 0x23, 0xba, 0xeb, 0xa6, 0x66, 0x71, 0x9b,
-	0x64, 0x9b, 0x12, 0x3b, 0x9b, 0x14, 0x0e, 0x08, 0x38, 0x04, 0xb1, 0x15, 0x14, 0x05, 0xd8, 0xe6,
-	0x42, 0x11, 0xaa, 0xbc, 0x59, 0xef, 0xca, 0x22, 0xb5, 0xb7, 0xb1, 0xb7, 0x10, 0x45, 0x3d, 0xd0,
-	0x03, 0xa2, 0xe2, 0x82, 0x44, 0x7f, 0x03, 0x47, 0x0e, 0x08, 0xc1, 0x5f, 0x40, 0x9c, 0x2a, 0xb8,
-	0x70, 0x44, 0x09, 0x3f, 0x04, 0xf9, 0xbd, 0xf1, 0xc6, 0x8e, 0x9f, 0x9f, 0xeb, 0x6d, 0x8e, 0x6f,
-	0xdf, 0xcc, 0x7c, 0xdf, 0xcc, 0x9b, 0x99, 0xcf, 0x0b, 0xfa, 0xae, 0x1f, 0xdc, 0xf3, 0x03, 0xcb,
-	0xeb, 0x87, 0xd6, 0x83, 0x56, 0xd7, 0x09, 0xed, 0x96, 0x75, 0x7f, 0xe4, 0xec, 0x1f, 0x98, 0xc3,
-	0x7d, 0x3f, 0xf4, 0x11, 0xc5, 0xbd, 0xe9, 0xf5, 0x43, 0x93, 0xee, 0xb5, 0x55, 0xf2, 0xe9, 0xda,
-	0x81, 0x23, 0x8c, 0xc7, 0xae, 0x43, 0x7b, 0xe0, 0x7a, 0x76, 0xe8, 0xfa, 0x9e, 0xf0, 0xd7, 0x16,
-	0x07, 0xbe, 0x3f, 0xd8, 0x73, 0x2c, 0x7b, 0xe8, 0x5a, 0xb6, 0xe7, 0xf9, 0x21, 0xbf, 0x0c, 0xe2,
-	0x5b, 0x09, 0x7a, 0x84, 0x24, 0x6e, 0xeb, 0xe2, 0xf6, 0x2e, 0x3f, 0x59, 0x44, 0x84, 0x1f, 0x8c,
-	0x36, 0x5c, 0xf8, 0x34, 0x02, 0xde, 0xb2, 0xf7, 0x6c, 0x6f, 0xd7, 0xe9, 0x38, 0xf7, 0x47, 0x4e,
-	0x10, 0x62, 0x1d, 0x5e, 0xd8, 0xdd, 0xb3, 0x83, 0xe0, 0xae, 0xdb, 0xab, 0xb1, 0x06, 0x6b, 0xbe,
-	0xd8, 0x99, 0xe3, 0xe7, 0x0f, 0x7a, 0xb8, 0x00, 0x55, 0xff, 0x2b, 0xcf, 0xd9, 0xaf, 0x55, 0xf8,
-	0xef, 0xe2, 0x60, 0xec, 0x42, 0x23, 0x19, 0x67, 0xeb, 0x80, 0x9f, 0x6e, 0x87, 0xfb, 0xae, 0x37,
-	0x98, 0x34, 0xe8, 0x5b, 0xf3, 0x7f, 0xfd, 0xba, 0x06, 0x5e, 0x3f, 0x6c, 0x3c, 0x58, 0x37, 0x5b,
-	0x66, 0xcb, 0x30, 0x61, 0x21, 0x4d, 0x36, 0x18, 0xfa, 0x5e, 0xe0, 0xe0, 0x2b, 0x30, 0x6b, 0xdf,
-	0xf3, 0x47, 0x5e, 0xc8, 0xc3, 0xce, 0x74, 0xe8, 0x64, 0xdc, 0x82, 0xd7, 0x14, 0xa4, 0xd4, 0xce,
-	0x19, 0xf0, 0x77, 0xe1, 0x65, 0xee, 0xfe, 0x71, 0x44, 0xed, 0x19, 0x52, 0x9a, 0x87, 0x8a, 0xdb,
-	0xa3, 0x7c, 0x2a, 0x6e, 0xcf, 0xf8, 0x1c, 0xf4, 0x13, 0xff, 0xb2, 0xf5, 0x39, 0x15, 0x2c, 0x43,
-	0x6e, 0x15, 0x30, 0x49, 0x8e, 0x52, 0x1b, 0x57, 0x95, 0x25, 0x9f, 0xea, 0x26, 0xbc, 0x9a, 0x4b,
-	0x44, 0xe5, 0x98, 0x01, 0xb5, 0x08, 0xf4, 0xf6, 0x68, 0x38, 0xdc, 0x3b, 0x28, 0xce, 0xc2, 0xf8,
-	0x88, 0x90, 0x85, 0x43, 0xc9, 0x1a, 0x64, 0xe0, 0xd7, 0xa8, 0x75, 0x63, 0xf8, 0x82, 0x66, 0xf8,
-	0x90, 0x3a, 0x54, 0x0a, 0x5e, 0xb2, 0x17, 0xbe, 0x67, 0x70, 0x9e, 0xfb, 0x6f, 0xb7, 0x77, 0x82,
-	0x49, 0xdb, 0x1b, 0xdb, 0x00, 0x27, 0x63, 0x5e, 0x9b, 0x6e, 0xb0, 0xe6, 0xb9, 0x8d, 0x65, 0x93,
-	0xc6, 0x33, 0xda, 0x09, 0xa6, 0x58, 0x20, 0x34, 0xd0, 0xe6, 0x27, 0xf6, 0x20, 0x1e, 0xd0, 0x4e,
-	0xc2, 0xd3, 0x78, 0xcc, 0xa8, 0x35, 0x05, 0x1b, 0xca, 0xe5, 0x3a, 0xcc, 0x78, 0xfd, 0x30, 0xa8,
-	0xb1, 0xc6, 0x74, 0xf3, 0xdc, 0xc6, 0x45, 0x33, 0xbb, 0x7f, 0xcc, 0xed, 0xf6, 0x4e, 0x87, 0x1b,
-	0xe1, 0xcd, 0x14, 0x95, 0x0a, 0xa7, 0xb2, 0x52, 0x48, 0x45, 0x20, 0xa5, 0xb8, 0xbc, 0x0d, 0x2f,
-	0xc5, 0x54, 0x26, 0x98, 0x91, 0xcf, 0x60, 0x31, 0xf6, 0x3e, 0xeb, 0x09, 0x79, 0xe7, 0xe4, 0xc5,
-	0xc6, 0x25, 0xba, 0x06, 0xd3, 0x5e, 0x5f, 0xbc, 0xb5, 0xa2, 0x42, 0x91, 0x8d, 0x71, 0x07, 0x2e,
-	0xe7, 0x30, 0x2b, 0x1d, 0x4b, 0xb2, 0xd6, 0xc4, 0xf3, 0xbd, 0x17, 0xa5, 0xf2, 0x0c, 0x63, 0x74,
-	0x8b, 0x36, 0x09, 0xb7, 0x7f, 0xde, 0x29, 0x7a, 0x9f, 0x86, 0x98, 0xc0, 0x29, 0x1b, 0x0b, 0xaa,
-	0xdc, 0x81, 0xf2, 0xa9, 0xcb, 0xf2, 0x11, 0x1e, 0xc2, 0xce, 0xe8, 0xd2, 0x68, 0xcb, 0x38, 0x4d,
-	0x18, 0x33, 0x43, 0xf5, 0x0b, 0x1a, 0x78, 0x6e, 0xe4, 0x8c, 0x2b, 0x95, 0x1e, 0x23, 0x36, 0xf1,
-	0x18, 0x3d, 0x61, 0x24, 0x2f, 0xe3, 0xf8, 0x44, 0x7c, 0x13, 0x44, 0xf5, 0x9c, 0x78, 0x98, 0x14,
-	0xd4, 0x63, 0xcb, 0x33, 0x9b, 0xa8, 0x8d, 0xdf, 0xe7, 0xa1, 0xca, 0x69, 0xe1, 0x13, 0x06, 0x73,
-	0x24, 0x65, 0xb8, 0x22, 0xa3, 0x20, 0x51, 0x72, 0xad, 0x59, 0x6c, 0x28, 0x40, 0x8d, 0x37, 0x1f,
-	0xfd, 0xfd, 0xdf, 0x8f, 0x95, 0x75, 0x34, 0x2d, 0xc9, 0xc7, 0x44, 0x57, 0x18, 0x5b, 0x87, 0x7c,
-	0x75, 0x3d, 0xb4, 0x0e, 0xe3, 0xfe, 0x7a, 0x88, 0xbf, 0x31, 0x58, 0x90, 0x29, 0x2c, 0xde, 0x28,
-	0x82, 0x96, 0xf5, 0xae, 0xf6, 0x46, 0x49, 0x2f, 0x62, 0xdf, 0xfa, 0x33, 0xd5, 0x2c, 0x3c, 0x97,
-	0xcb, 0x78, 0x49, 0x91, 0x0b, 0x3e, 0x66, 0x50, 0xe5, 0x22, 0x88, 0x4b, 0xb9, 0x98, 0x49, 0xb5,
-	0xd7, 0x96, 0x8b, 0xcc, 0x62, 0x2e, 0x1c, 0xfd, 0x3a, 0x5e, 0x93, 0xa1, 0xf3, 0x02, 0x26, 0xea,
-	0x67, 0x1d, 0x46, 0x45, 0xfc, 0x99, 0x01, 0x66, 0x05, 0x19, 0x37, 0xd4, 0x88, 0xd2, 0x02, 0x6e,
-	0x96, 0xf2, 0x21, 0xca, 0x96, 0xa4, 0x7c, 0x97, 0xb0, 0x9e, 0x9b, 0x00, 0x7e, 0xc7, 0x60, 0x56,
-	0x48, 0x29, 0xe6, 0x97, 0x25, 0xf5, 0x65, 0xa0, 0xad, 0x14, 0xda, 0x11, 0x99, 0x35, 0x0e, 0xbf,
-	0x82, 0x4b, 0x32, 0xf8, 0x80, 0xdb, 0x26, 0x1b, 0xf0, 0x17, 0x06, 0x17, 0x24, 0xaa, 0x8e, 0x9b,
-	0x05, 0x78, 0xd2, 0xea, 0xdd, 0x28, 0xe7, 0x44, 0x8c, 0xd7, 0x25, 0xe5, 0x5b, 0x44, 0x2d, 0x9f,
-	0x3f, 0x8e, 0x60, 0x26, 0x92, 0x6b, 0xbc, 0x9a, 0x8b, 0x97, 0xf8, 0xb6, 0xd0, 0x96, 0x0a, 0xac,
-	0x88, 0x46, 0x83, 0x03, 0x6b, 0x58, 0xb3, 0xe4, 0xff, 0x07, 0x02, 0x7c, 0xc4, 0x60, 0x7a, 0xbb,
-	0xbd, 0x83, 0x57, 0x54, 0x01, 0x63, 0xd4, 0xab, 0x6a, 0xa3, 0x38, 0x77, 0x0e, 0xba, 0x8a, 0xcd,
-	0x3c, 0xd0, 0x4c, 0xb3, 0xff, 0xc4, 0xe0, 0xfc, 0x69, 0x21, 0xc5, 0x75, 0x15, 0x98, 0xf4, 0xa9,
-	0x5a, 0x25, 0x3c, 0xe2, 0xce, 0x92, 0xbc, 0x53, 0x1d, 0x2f, 0xe6, 0x30, 0xc7, 0x6f, 0x19, 0x54,
-	0xf9, 0x5e, 0x57, 0x6c, 0x88, 0xa4, 0x6a, 0x2b, 0x36, 0x44, 0x4a, 0x5f, 0x0d, 0x93, 0x23, 0x37,
-	0x71, 0x59, 0x86, 0x4c, 0x12, 0x92, 0x6c, 0xf1, 0x68, 0x3d, 0x64, 0xa5, 0x55, 0xb1, 0x1e, 0x72,
-	0xbf, 0x0d, 0x14, 0xeb, 0x21, 0x5f, 0xbb, 0xcb, 0xac, 0x07, 0x4e, 0x1a, 0xbf, 0x61, 0x30, 0x47,
-	0x3a, 0xaa, 0xd0, 0xaa, 0xb4, 0x92, 0x2b, 0xb4, 0xea, 0x94, 0x24, 0x1b, 0x57, 0x54, 0xfb, 0x9d,
-	0xea, 0xb7, 0xf5, 0xfa, 0x1f, 0x47, 0x3a, 0x7b, 0x7a, 0xa4, 0xb3, 0x7f, 0x8f, 0x74, 0xf6, 0xc3,
-	0xb1, 0x3e, 0xf5, 0xf4, 0x58, 0x9f, 0xfa, 0xe7, 0x58, 0x9f, 0xba, 0x43, 0x7f, 0xc6, 0x83, 0xde,
-	0x97, 0xa6, 0xeb, 0x5b, 0x5f, 0x47, 0xde, 0xdd, 0x59, 0xfe, 0x87, 0x78, 0xf3, 0xff, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x2e, 0x12, 0xdc, 0xfc, 0xc9, 0x0f, 0x00, 0x00,
+	// 1056 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x6f, 0xdb, 0x54,
+	0x14, 0xef, 0x4d, 0x9b, 0x16, 0x4e, 0xa5, 0xfd, 0x39, 0xad, 0x68, 0xe2, 0x75, 0x6e, 0xf0, 0xd6,
+	0x36, 0x6b, 0x57, 0x3b, 0x7f, 0x06, 0x48, 0x08, 0x78, 0xe8, 0x20, 0x13, 0x0c, 0x95, 0x91, 0xf5,
+	0x85, 0x21, 0x34, 0x39, 0x89, 0x13, 0x59, 0x64, 0x76, 0x56, 0x3b, 0x83, 0xaa, 0xda, 0x03, 0x7b,
+	0x40, 0x4c, 0xbc, 0x20, 0xb1, 0xcf, 0xc0, 0x1b, 0x3c, 0x20, 0xc4, 0x67, 0x40, 0x7b, 0x9a, 0xe0,
+	0x85, 0x47, 0xd4, 0x22, 0xf1, 0x35, 0x90, 0xef, 0xbd, 0x4e, 0xec, 0xfa, 0xda, 0x8e, 0xb3, 0x3e,
+	0xde, 0xdc, 0xf3, 0x3b, 0xbf, 0xdf, 0x39, 0xf7, 0x9e, 0x73, 0x4f, 0x0c, 0x72, 0xdb, 0x76, 0x1e,
+	0xd8, 0x8e, 0x66, 0x75, 0x5d, 0xed, 0x51, 0xb5, 0x65, 0xb8, 0x7a, 0x55, 0x7b, 0x38, 0x34, 0x0e,
+	0x0e, 0xd5, 0xc1, 0x81, 0xed, 0xda, 0x88, 0x6c, 0x5f, 0xb5, 0xba, 0xae, 0xca, 0xf7, 0xa5, 0x2d,
+	0x8e, 0x69, 0xe9, 0x8e, 0xc1, 0x8c, 0x47, 0xd0, 0x81, 0xde, 0x33, 0x2d, 0xdd, 0x35, 0x6d, 0x8b,
+	0xe1, 0xa5, 0xd5, 0x9e, 0x6d, 0xf7, 0xfa, 0x86, 0xa6, 0x0f, 0x4c, 0x4d, 0xb7, 0x2c, 0xdb, 0xa5,
+	0x9b, 0x8e, 0xbf, 0x2b, 0x60, 0xf7, 0x98, 0xd8, 0x6e, 0x91, 0xed, 0xde, 0xa7, 0x2b, 0x8d, 0x2d,
+	0xd8, 0x96, 0xd2, 0x80, 0xa5, 0x4f, 0x3d, 0xe2, 0x5d, 0xbd, 0xaf, 0x5b, 0x6d, 0xa3, 0x69, 0x3c,
+	0x1c, 0x1a, 0x8e, 0x8b, 0x45, 0x78, 0xa5, 0xdd, 0xd7, 0x1d, 0xe7, 0xbe, 0xd9, 0x29, 0x90, 0x12,
+	0x29, 0xbf, 0xda, 0x5c, 0xa0, 0xeb, 0x0f, 0x3b, 0xb8, 0x0c, 0x79, 0xfb, 0x2b, 0xcb, 0x38, 0x28,
+	0xe4, 0xe8, 0xef, 0x6c, 0xa1, 0xb4, 0xa1, 0x14, 0xf4, 0xb3, 0x7b, 0x48, 0x57, 0x77, 0xdd, 0x03,
+	0xd3, 0xea, 0x4d, 0xeb, 0xf4, 0xed, 0x73, 0x7f, 0xfe, 0xb6, 0x03, 0x56, 0xd7, 0x2d, 0x3d, 0xaa,
+	0xa8, 0x55, 0xb5, 0xaa, 0xa8, 0xb0, 0x1c, 0x16, 0xeb, 0x0c, 0x6c, 0xcb, 0x31, 0xf0, 0x35, 0x98,
+	0xd7, 0x1f, 0xd8, 0x43, 0xcb, 0xa5, 0x6e, 0xe7, 0x9a, 0x7c, 0xa5, 0xdc, 0x86, 0xd7, 0x13, 0x44,
+	0x25, 0x83, 0x23, 0xe4, 0xef, 0xc1, 0x45, 0x0a, 0xff, 0xc4, 0x93, 0x36, 0x41, 0x48, 0xe7, 0x20,
+	0x67, 0x76, 0x78, 0x3c, 0x39, 0xb3, 0xa3, 0x7c, 0x0e, 0xf2, 0x18, 0x9f, 0x35, 0x3f, 0xa7, 0x9c,
+	0x45, 0xc4, 0x6d, 0x01, 0x06, 0xc5, 0xf1, 0xd0, 0x46, 0x59, 0x25, 0xc1, 0xa3, 0xba, 0x05, 0x6b,
+	0xb1, 0x42, 0x92, 0x80, 0x11, 0x52, 0x8d, 0x93, 0xde, 0x1d, 0x0e, 0x06, 0xfd, 0xc3, 0xf4, 0x28,
+	0x94, 0x8f, 0x61, 0x2d, 0x00, 0xc8, 0x98, 0x83, 0x08, 0xfd, 0x0e, 0x2c, 0x05, 0xbc, 0xa5, 0x5e,
+	0x86, 0x8f, 0xa0, 0x14, 0x30, 0x7f, 0xb9, 0xbb, 0xf0, 0x3d, 0x81, 0x0b, 0x14, 0xbf, 0xd7, 0xd8,
+	0x77, 0xa6, 0xbd, 0xde, 0xd8, 0x00, 0x18, 0x97, 0x79, 0x61, 0xb6, 0x44, 0xca, 0x8b, 0xb5, 0x0d,
+	0x95, 0x97, 0xa7, 0xd7, 0x13, 0x54, 0xd6, 0x40, 0x78, 0x41, 0xab, 0x77, 0xf4, 0x9e, 0x5f, 0xa0,
+	0xcd, 0x00, 0x52, 0x79, 0x4a, 0xe0, 0x62, 0x40, 0x0d, 0x8f, 0x65, 0x1b, 0xe6, 0xac, 0xae, 0xeb,
+	0x14, 0x48, 0x69, 0xb6, 0xbc, 0x58, 0x5b, 0x51, 0xa3, 0xfd, 0x47, 0xdd, 0x6b, 0xec, 0x37, 0xa9,
+	0x11, 0xde, 0x0a, 0x49, 0xc9, 0x51, 0x29, 0x9b, 0xa9, 0x52, 0x18, 0x53, 0x48, 0xcb, 0x3b, 0x70,
+	0xde, 0x97, 0x32, 0x45, 0x8d, 0x7c, 0x06, 0xab, 0x3e, 0xfa, 0xac, 0x2b, 0xe4, 0xdd, 0xf1, 0x89,
+	0x8d, 0x52, 0x74, 0x0d, 0x66, 0xad, 0x2e, 0x3b, 0xeb, 0x84, 0x0c, 0x79, 0x36, 0xca, 0x3d, 0xb8,
+	0x1c, 0xa3, 0x2c, 0xb3, 0x2f, 0x41, 0x5b, 0x63, 0xc7, 0x77, 0xd3, 0x0b, 0x65, 0x82, 0x32, 0xba,
+	0x0d, 0xf2, 0xd8, 0xfe, 0x65, 0xab, 0xe8, 0x03, 0xc0, 0xb1, 0xb3, 0x51, 0x34, 0x1a, 0xe4, 0x29,
+	0x80, 0xc7, 0x53, 0x14, 0xc5, 0xc3, 0x10, 0xcc, 0x4e, 0x69, 0xf1, 0xd2, 0x16, 0x69, 0x9a, 0xd2,
+	0x67, 0x44, 0xea, 0x17, 0xbc, 0xe0, 0xa9, 0x91, 0x31, 0xca, 0x54, 0xb8, 0x8c, 0xc8, 0xd4, 0x65,
+	0xf4, 0x8c, 0xc0, 0x72, 0xd8, 0x3f, 0x17, 0x5e, 0x07, 0x96, 0x3d, 0xc3, 0x2f, 0xa6, 0x04, 0xe9,
+	0xbe, 0xe5, 0xd9, 0x55, 0xd4, 0xfb, 0xb0, 0x32, 0x56, 0x75, 0xc7, 0xee, 0x9b, 0xed, 0xc3, 0x8c,
+	0xc7, 0x5c, 0x53, 0x2b, 0x4a, 0x1b, 0x0a, 0x51, 0x2f, 0x3c, 0xbe, 0xb7, 0x60, 0x7e, 0x40, 0x7f,
+	0xe1, 0xc9, 0x5b, 0x8b, 0x0d, 0x8f, 0x03, 0xb9, 0xf9, 0x69, 0x92, 0xda, 0x7f, 0xe7, 0x21, 0x4f,
+	0x59, 0xf0, 0x19, 0x81, 0x05, 0xfe, 0xea, 0xe2, 0xa6, 0xc8, 0x9d, 0x60, 0xe8, 0x90, 0xca, 0xe9,
+	0x86, 0x4c, 0xb1, 0xf2, 0xe6, 0x93, 0xbf, 0xfe, 0xfd, 0x31, 0x57, 0x41, 0x55, 0x13, 0xcc, 0x3d,
+	0x2d, 0x66, 0xac, 0x1d, 0xd1, 0x2e, 0xfb, 0x58, 0x3b, 0xf2, 0x73, 0xf4, 0x18, 0x7f, 0x27, 0xb0,
+	0x2c, 0x1a, 0x06, 0xf0, 0x46, 0x1a, 0xb5, 0xa8, 0xcc, 0xa4, 0x37, 0x32, 0xa2, 0xb8, 0xfa, 0xea,
+	0xf3, 0xd0, 0xbd, 0xa6, 0xb1, 0x5c, 0xc6, 0x4b, 0x09, 0xb1, 0xe0, 0x53, 0x02, 0x79, 0xfa, 0x5e,
+	0xe3, 0x7a, 0x2c, 0x67, 0x70, 0x30, 0x91, 0x36, 0xd2, 0xcc, 0x7c, 0x2d, 0x94, 0x7d, 0x1b, 0xaf,
+	0x89, 0xd8, 0x69, 0x02, 0x03, 0xf9, 0xd3, 0x8e, 0xbc, 0x24, 0xfe, 0x42, 0x00, 0xa3, 0xb3, 0x03,
+	0xd6, 0x92, 0x19, 0x85, 0x09, 0xac, 0x67, 0xc2, 0x70, 0xc9, 0x9a, 0x20, 0x7d, 0x97, 0xb0, 0x18,
+	0x1b, 0x00, 0x7e, 0x47, 0x60, 0x9e, 0xbd, 0xfa, 0x18, 0x9f, 0x96, 0xd0, 0x10, 0x23, 0x6d, 0xa6,
+	0xda, 0x71, 0x31, 0x3b, 0x94, 0x7e, 0x13, 0xd7, 0x45, 0xf4, 0x0e, 0xb5, 0x0d, 0x5e, 0xc0, 0x5f,
+	0x09, 0x2c, 0x09, 0x06, 0x10, 0xac, 0xa7, 0xf0, 0x09, 0xb3, 0x77, 0x23, 0x1b, 0x88, 0x2b, 0xae,
+	0x08, 0xd2, 0xb7, 0x8a, 0x52, 0xbc, 0x7e, 0x1c, 0xc2, 0x9c, 0x37, 0x59, 0xe0, 0xd5, 0x58, 0xbe,
+	0xc0, 0x18, 0x24, 0xad, 0xa7, 0x58, 0x71, 0x19, 0x25, 0x4a, 0x2c, 0x61, 0x41, 0x13, 0xff, 0x75,
+	0x71, 0xf0, 0x09, 0x81, 0xd9, 0xbd, 0xc6, 0x3e, 0x5e, 0x49, 0x72, 0xe8, 0xb3, 0x5e, 0x4d, 0x36,
+	0xf2, 0x63, 0xa7, 0xa4, 0x5b, 0x58, 0x8e, 0x23, 0x8d, 0x5c, 0xf6, 0x9f, 0x08, 0x5c, 0x38, 0xfd,
+	0xe6, 0x63, 0x25, 0x89, 0x4c, 0x78, 0x54, 0xd5, 0x0c, 0x08, 0xff, 0x66, 0x09, 0xce, 0xa9, 0x88,
+	0x2b, 0x31, 0xca, 0xf1, 0x5b, 0x02, 0x79, 0xda, 0xa3, 0x13, 0x3a, 0x44, 0x70, 0xc0, 0x90, 0x36,
+	0xd2, 0xcc, 0xb8, 0x0e, 0x95, 0x32, 0x97, 0x71, 0x43, 0xc4, 0xcc, 0x5f, 0xbb, 0xe0, 0x15, 0xf7,
+	0xda, 0x43, 0x74, 0x0a, 0x48, 0x68, 0x0f, 0xb1, 0x63, 0x8c, 0x54, 0xcf, 0x84, 0xc9, 0xde, 0x1e,
+	0xa8, 0x68, 0xfc, 0x86, 0xc0, 0x02, 0x7f, 0xf2, 0x13, 0xde, 0xaa, 0xf0, 0xd0, 0x21, 0x95, 0xd3,
+	0x0d, 0xb9, 0x9e, 0x2b, 0x49, 0xfd, 0xdd, 0x9f, 0x16, 0x7e, 0x26, 0xb0, 0x18, 0x78, 0x61, 0x71,
+	0x3b, 0xd9, 0x7d, 0x68, 0x0c, 0x90, 0xae, 0x4f, 0x66, 0xcc, 0xf5, 0xdc, 0x7c, 0x1e, 0x7a, 0xb4,
+	0xa9, 0x3a, 0x0d, 0x77, 0x26, 0x3b, 0x5d, 0x8d, 0xbd, 0xfc, 0xbb, 0xd7, 0xff, 0x38, 0x96, 0xc9,
+	0x8b, 0x63, 0x99, 0xfc, 0x73, 0x2c, 0x93, 0x1f, 0x4e, 0xe4, 0x99, 0x17, 0x27, 0xf2, 0xcc, 0xdf,
+	0x27, 0xf2, 0xcc, 0x3d, 0xfe, 0x9d, 0xc3, 0xe9, 0x7c, 0xa9, 0x9a, 0xb6, 0xf6, 0xb5, 0xe7, 0xaf,
+	0x35, 0x4f, 0xbf, 0x35, 0xd4, 0xff, 0x1f, 0x00, 0xa9, 0x23, 0xb7, 0x68, 0x24, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1356,6 +1454,8 @@ type QueryClient interface {
 	ClassByQueryString(ctx context.Context, in *QueryClassByQueryStringRequest, opts ...grpc.CallOption) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// ClassPolicy queries the policy of a class created with Msg/SaveClass
+	ClassPolicy(ctx context.Context, in *QueryClassPolicyRequest, opts ...grpc.CallOption) (*QueryClassPolicyResponse, error)
 }
 
 type queryClient struct {
@@ -1474,6 +1574,15 @@ func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ClassPolicy(ctx context.Context, in *QueryClassPolicyRequest, opts ...grpc.CallOption) (*QueryClassPolicyResponse, error) {
+	out := new(QueryClassPolicyResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.nft.v1beta1.Query/ClassPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Balance queries the number of NFTs of a given class owned by the owner, same as balanceOf in ERC721
@@ -1501,6 +1610,8 @@ type QueryServer interface {
 	ClassByQueryString(context.Context, *QueryClassByQueryStringRequest) (*QueryClassByQueryStringResponse, error)
 	// Classes queries all NFT classes
 	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// ClassPolicy queries the policy of a class created with Msg/SaveClass
+	ClassPolicy(context.Context, *QueryClassPolicyRequest) (*QueryClassPolicyResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1543,6 +1654,9 @@ func (*UnimplementedQueryServer) ClassByQueryString(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
 }
+func (*UnimplementedQueryServer) ClassPolicy(ctx context.Context, req *QueryClassPolicyRequest) (*QueryClassPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassPolicy not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1764,6 +1878,24 @@ func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ClassPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.nft.v1beta1.Query/ClassPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassPolicy(ctx, req.(*QueryClassPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.nft.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1816,6 +1948,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Classes",
 			Handler:    _Query_Classes_Handler,
 		},
+		{
+			MethodName: "ClassPolicy",
+			Handler:    _Query_ClassPolicy_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/nft/v1beta1/query.proto",
@@ -2657,6 +2793,71 @@ func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryClassPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != nil {
+		{
+			size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3020,6 +3221,32 @@ func (m *QueryClassesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryClassPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != nil {
+		l = m.Policy.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5298,6 +5525,174 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryClassPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Policy == nil {
+				m.Policy = &ClassPolicy{}
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
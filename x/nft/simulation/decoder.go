@@ -34,7 +34,7 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			ownerA = sdk.AccAddress(kvA.Value)
 			ownerB = sdk.AccAddress(kvB.Value)
 			return fmt.Sprintf("%v\n%v", ownerA, ownerB)
-		case bytes.Equal(kvA.Key[:1], keeper.ClassTotalSupply):
+		case bytes.Equal(kvA.Key[:1], keeper.ClassTotalSupply), bytes.Equal(kvA.Key[:1], keeper.ClassMintedKey):
 			var supplyA, supplyB uint64
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
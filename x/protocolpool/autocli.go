@@ -28,6 +28,12 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s query protocolpool unclaimed-budget cosmos1...`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod: "PendingMilestones",
+					Use:       "pending-milestones",
+					Short:     "Query the budget milestones waiting for approval, optionally filtered by approver",
+					Example:   fmt.Sprintf(`$ %s query protocolpool pending-milestones --approver cosmos1...`, version.AppName),
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -44,7 +50,8 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "SubmitBudgetProposal",
 					Use:       "submit-budget-proposal [recipient] [budget-per-tranche] [start-time] [tranches] [period]",
 					Short:     "Submit a budget proposal",
-					Example:   fmt.Sprintf(`$ %s tx protocolpool submit-budget-proposal cosmos1... 1000000uatom 2023-10-31T12:34:56.789Z 10 1000 --from mykey`, version.AppName),
+					Example: fmt.Sprintf(`$ %[1]s tx protocolpool submit-budget-proposal cosmos1... 1000000uatom,500uosmo 2023-10-31T12:34:56.789Z 10 1000 --from mykey
+$ %[1]s tx protocolpool submit-budget-proposal cosmos1... 1000000uatom 2023-10-31T12:34:56.789Z 0 0 --milestone-approver cosmos1... --milestones design,delivery --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "recipient_address"},
 						{ProtoField: "budget_per_tranche"},
@@ -60,6 +67,13 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Example:        fmt.Sprintf(`$ %s tx protocolpool claim-budget cosmos1... --from mykey`, version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient_address"}},
 				},
+				{
+					RpcMethod:      "ApproveMilestone",
+					Use:            "approve-milestone [recipient] [milestone-index]",
+					Short:          "Approve the next pending milestone of a milestone-gated budget",
+					Example:        fmt.Sprintf(`$ %s tx protocolpool approve-milestone cosmos1... 0 --from approver`, version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient_address"}, {ProtoField: "milestone_index"}},
+				},
 				{
 					RpcMethod: "CreateContinuousFund",
 					Use:       "create-continuous-fund [recipient] [percentage] <expiry>",
@@ -81,12 +81,14 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 			return true, err
 		}
 		budget = append(budget, &types.Budget{
-			RecipientAddress: recipient,
-			ClaimedAmount:    value.ClaimedAmount,
-			LastClaimedAt:    value.LastClaimedAt,
-			TranchesLeft:     value.TranchesLeft,
-			Period:           value.Period,
-			BudgetPerTranche: value.BudgetPerTranche,
+			RecipientAddress:  recipient,
+			ClaimedAmount:     value.ClaimedAmount,
+			LastClaimedAt:     value.LastClaimedAt,
+			TranchesLeft:      value.TranchesLeft,
+			Period:            value.Period,
+			BudgetPerTranche:  value.BudgetPerTranche,
+			MilestoneApprover: value.MilestoneApprover,
+			Milestones:        value.Milestones,
 		})
 		return false, nil
 	})
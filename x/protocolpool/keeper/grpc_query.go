@@ -12,6 +12,7 @@ import (
 	"cosmossdk.io/x/protocolpool/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Querier{}
@@ -51,25 +52,63 @@ func (k Querier) UnclaimedBudget(ctx context.Context, req *types.QueryUnclaimedB
 		return nil, err
 	}
 
-	totalBudgetAmountLeftToDistribute := budget.BudgetPerTranche.Amount.Mul(math.NewIntFromUint64(budget.TranchesLeft))
-	totalBudgetAmountLeft := sdk.NewCoin(budget.BudgetPerTranche.Denom, totalBudgetAmountLeftToDistribute)
+	unclaimedBudget := budget.BudgetPerTranche.MulInt(math.NewIntFromUint64(budget.TranchesLeft))
 
-	var unclaimedBudget sdk.Coin
-	if budget.ClaimedAmount == nil {
-		unclaimedBudget = totalBudgetAmountLeft
-		zeroCoin := sdk.NewCoin(budget.BudgetPerTranche.Denom, math.ZeroInt())
-		budget.ClaimedAmount = &zeroCoin
-	} else {
-		unclaimedBudget = totalBudgetAmountLeft
+	nextClaimFrom := *budget.LastClaimedAt
+	if budget.Period != nil {
+		nextClaimFrom = nextClaimFrom.Add(*budget.Period)
 	}
 
-	nextClaimFrom := budget.LastClaimedAt.Add(*budget.Period)
-
 	return &types.QueryUnclaimedBudgetResponse{
-		ClaimedAmount:   budget.ClaimedAmount,
-		UnclaimedAmount: &unclaimedBudget,
-		NextClaimFrom:   &nextClaimFrom,
-		Period:          budget.Period,
-		TranchesLeft:    budget.TranchesLeft,
+		ClaimedAmount:     budget.ClaimedAmount,
+		UnclaimedAmount:   unclaimedBudget,
+		NextClaimFrom:     &nextClaimFrom,
+		Period:            budget.Period,
+		TranchesLeft:      budget.TranchesLeft,
+		MilestoneApprover: budget.MilestoneApprover,
+		Milestones:        budget.Milestones,
 	}, nil
 }
+
+// PendingMilestones queries the milestones waiting for approval, paginating over budgets
+func (k Querier) PendingMilestones(ctx context.Context, req *types.QueryPendingMilestonesRequest) (*types.QueryPendingMilestonesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Approver != "" {
+		if _, err := k.Keeper.authKeeper.AddressCodec().StringToBytes(req.Approver); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid approver address: %s", err.Error())
+		}
+	}
+
+	results, pageRes, err := query.CollectionFilteredPaginate(ctx, k.Keeper.BudgetProposal, req.Pagination,
+		func(_ sdk.AccAddress, budget types.Budget) (bool, error) {
+			if !budget.IsMilestoneGated() || budget.ApprovedMilestones() == uint64(len(budget.Milestones)) {
+				return false, nil
+			}
+			return req.Approver == "" || req.Approver == budget.MilestoneApprover, nil
+		},
+		func(_ sdk.AccAddress, budget types.Budget) ([]types.PendingMilestone, error) {
+			var pending []types.PendingMilestone
+			for i := budget.ApprovedMilestones(); i < uint64(len(budget.Milestones)); i++ {
+				pending = append(pending, types.PendingMilestone{
+					RecipientAddress: budget.RecipientAddress,
+					Approver:         budget.MilestoneApprover,
+					Index:            i,
+					Description:      budget.Milestones[i].Description,
+				})
+			}
+			return pending, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	milestones := []types.PendingMilestone{}
+	for _, pending := range results {
+		milestones = append(milestones, pending...)
+	}
+
+	return &types.QueryPendingMilestonesResponse{Milestones: milestones, Pagination: pageRes}, nil
+}
@@ -3,7 +3,6 @@ package keeper_test
 import (
 	"time"
 
-	"cosmossdk.io/math"
 	"cosmossdk.io/x/protocolpool/types"
 
 	codectestutil "github.com/cosmos/cosmos-sdk/codec/testutil"
@@ -13,7 +12,6 @@ import (
 func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 	startTime := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time.Add(-70 * time.Second)
 	period := time.Duration(60) * time.Second
-	zeroCoin := sdk.NewCoin("foo", math.ZeroInt())
 	nextClaimFrom := startTime.Add(period)
 	secondClaimFrom := nextClaimFrom.Add(period)
 	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
@@ -24,7 +22,7 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 		req            *types.QueryUnclaimedBudgetRequest
 		expErr         bool
 		expErrMsg      string
-		unclaimedFunds *sdk.Coin
+		unclaimedFunds sdk.Coins
 		resp           *types.QueryUnclaimedBudgetResponse
 	}{
 		{
@@ -52,7 +50,7 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 					LastClaimedAt:    &startTime,
 					TranchesLeft:     2,
 					Period:           &period,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -61,10 +59,9 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 				Address: recipientStrAddr,
 			},
 			expErr:         false,
-			unclaimedFunds: &fooCoin,
+			unclaimedFunds: sdk.NewCoins(fooCoin),
 			resp: &types.QueryUnclaimedBudgetResponse{
-				ClaimedAmount:   &zeroCoin,
-				UnclaimedAmount: &fooCoin,
+				UnclaimedAmount: sdk.NewCoins(fooCoin),
 				NextClaimFrom:   &nextClaimFrom,
 				Period:          &period,
 				TranchesLeft:    2,
@@ -79,7 +76,7 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 					LastClaimedAt:    &startTime,
 					TranchesLeft:     2,
 					Period:           &period,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -97,10 +94,10 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 				Address: recipientStrAddr,
 			},
 			expErr:         false,
-			unclaimedFunds: &fooCoin2,
+			unclaimedFunds: sdk.NewCoins(fooCoin2),
 			resp: &types.QueryUnclaimedBudgetResponse{
-				ClaimedAmount:   &fooCoin2,
-				UnclaimedAmount: &fooCoin2,
+				ClaimedAmount:   sdk.NewCoins(fooCoin2),
+				UnclaimedAmount: sdk.NewCoins(fooCoin2),
 				NextClaimFrom:   &secondClaimFrom,
 				Period:          &period,
 				TranchesLeft:    1,
@@ -123,3 +120,68 @@ func (suite *KeeperTestSuite) TestUnclaimedBudget() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestPendingMilestones() {
+	startTime := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	period := time.Duration(60) * time.Second
+	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	recipientStrAddr, err := addressCodec.BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+	recipient2 := sdk.AccAddress([]byte("to2__________________"))
+	recipient2StrAddr, err := addressCodec.BytesToString(recipient2)
+	suite.Require().NoError(err)
+	recipient3 := sdk.AccAddress([]byte("to3__________________"))
+	recipient3StrAddr, err := addressCodec.BytesToString(recipient3)
+	suite.Require().NoError(err)
+	approverStrAddr, err := addressCodec.BytesToString(approverAddr)
+	suite.Require().NoError(err)
+	approver2StrAddr, err := addressCodec.BytesToString(sdk.AccAddress([]byte("approver2____________")))
+	suite.Require().NoError(err)
+
+	budgets := map[string]types.Budget{
+		recipientStrAddr: {
+			RecipientAddress:  recipientStrAddr,
+			LastClaimedAt:     &startTime,
+			TranchesLeft:      2,
+			BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+			MilestoneApprover: approverStrAddr,
+			Milestones:        []types.Milestone{{Description: "design", Approved: true}, {Description: "delivery"}},
+		},
+		recipient2StrAddr: {
+			RecipientAddress:  recipient2StrAddr,
+			LastClaimedAt:     &startTime,
+			TranchesLeft:      1,
+			BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+			MilestoneApprover: approver2StrAddr,
+			Milestones:        []types.Milestone{{Description: "audit"}},
+		},
+		recipient3StrAddr: {
+			RecipientAddress: recipient3StrAddr,
+			LastClaimedAt:    &startTime,
+			TranchesLeft:     2,
+			Period:           &period,
+			BudgetPerTranche: sdk.NewCoins(fooCoin2),
+		},
+	}
+	for addr, budget := range budgets {
+		recipient, err := addressCodec.StringToBytes(addr)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipient, budget))
+	}
+
+	resp, err := suite.queryServer.PendingMilestones(suite.ctx, &types.QueryPendingMilestonesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.PendingMilestone{
+		{RecipientAddress: recipientStrAddr, Approver: approverStrAddr, Index: 1, Description: "delivery"},
+		{RecipientAddress: recipient2StrAddr, Approver: approver2StrAddr, Index: 0, Description: "audit"},
+	}, resp.Milestones)
+
+	resp, err = suite.queryServer.PendingMilestones(suite.ctx, &types.QueryPendingMilestonesRequest{Approver: approverStrAddr})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PendingMilestone{
+		{RecipientAddress: recipientStrAddr, Approver: approverStrAddr, Index: 1, Description: "delivery"},
+	}, resp.Milestones)
+
+	_, err = suite.queryServer.PendingMilestones(suite.ctx, &types.QueryPendingMilestonesRequest{Approver: "invalid"})
+	suite.Require().ErrorContains(err, "invalid approver address")
+}
@@ -315,56 +315,49 @@ func (k Keeper) iterateAndUpdateFundsDistribution(ctx context.Context, toDistrib
 	return k.ToDistribute.Set(ctx, math.ZeroInt())
 }
 
-func (k Keeper) claimFunds(ctx context.Context, recipientAddr string) (amount sdk.Coin, err error) {
+func (k Keeper) claimFunds(ctx context.Context, recipientAddr string) (amount sdk.Coins, err error) {
 	recipient, err := k.authKeeper.AddressCodec().StringToBytes(recipientAddr)
 	if err != nil {
-		return sdk.Coin{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
 	}
 
 	// get claimable funds from distribution info
 	amount, err = k.getClaimableFunds(ctx, recipientAddr)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("error getting claimable funds: %w", err)
+		return nil, fmt.Errorf("error getting claimable funds: %w", err)
 	}
 
 	// distribute amount from community pool
-	err = k.DistributeFromCommunityPool(ctx, sdk.NewCoins(amount), recipient)
+	err = k.DistributeFromCommunityPool(ctx, amount, recipient)
 	if err != nil {
-		return sdk.Coin{}, fmt.Errorf("error distributing from community pool: %w", err)
+		return nil, fmt.Errorf("error distributing from community pool: %w", err)
 	}
 
 	return amount, nil
 }
 
-func (k Keeper) getClaimableFunds(ctx context.Context, recipientAddr string) (amount sdk.Coin, err error) {
+func (k Keeper) getClaimableFunds(ctx context.Context, recipientAddr string) (amount sdk.Coins, err error) {
 	recipient, err := k.authKeeper.AddressCodec().StringToBytes(recipientAddr)
 	if err != nil {
-		return sdk.Coin{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
 	}
 
 	budget, err := k.BudgetProposal.Get(ctx, recipient)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return sdk.Coin{}, fmt.Errorf("no budget found for recipient: %s", recipientAddr)
+			return nil, fmt.Errorf("no budget found for recipient: %s", recipientAddr)
 		}
-		return sdk.Coin{}, err
+		return nil, err
 	}
 
-	totalBudgetAmountLeftToDistribute := budget.BudgetPerTranche.Amount.Mul(math.NewIntFromUint64(budget.TranchesLeft))
-	totalBudgetAmountLeft := sdk.NewCoin(budget.BudgetPerTranche.Denom, totalBudgetAmountLeftToDistribute)
-	zeroAmount := sdk.NewCoin(totalBudgetAmountLeft.Denom, math.ZeroInt())
-
 	// check if the distribution is completed
-	if budget.TranchesLeft == 0 && budget.ClaimedAmount != nil {
-		// check that total budget amount left to distribute equals zero
-		if totalBudgetAmountLeft.Equal(zeroAmount) {
-			// remove the entry of budget ended recipient
-			if err := k.BudgetProposal.Remove(ctx, recipient); err != nil {
-				return sdk.Coin{}, err
-			}
-			// Return the end of the budget
-			return sdk.Coin{}, fmt.Errorf("budget ended for recipient: %s", recipientAddr)
+	if budget.TranchesLeft == 0 {
+		// remove the entry of budget ended recipient
+		if err := k.BudgetProposal.Remove(ctx, recipient); err != nil {
+			return nil, err
 		}
+		// Return the end of the budget
+		return nil, fmt.Errorf("budget ended for recipient: %s", recipientAddr)
 	}
 
 	currentTime := k.HeaderService.HeaderInfo(ctx).Time
@@ -372,69 +365,111 @@ func (k Keeper) getClaimableFunds(ctx context.Context, recipientAddr string) (am
 	// Check if the distribution time has not reached
 	if budget.LastClaimedAt != nil {
 		if currentTime.Before(*budget.LastClaimedAt) {
-			return sdk.Coin{}, fmt.Errorf("distribution has not started yet")
+			return nil, fmt.Errorf("distribution has not started yet")
 		}
 	}
 
-	if budget.TranchesLeft != 0 && budget.ClaimedAmount == nil {
-		zeroCoin := sdk.NewCoin(budget.BudgetPerTranche.Denom, math.ZeroInt())
-		budget.ClaimedAmount = &zeroCoin
-	}
-
 	return k.calculateClaimableFunds(ctx, recipient, budget, currentTime)
 }
 
-func (k Keeper) calculateClaimableFunds(ctx context.Context, recipient sdk.AccAddress, budget types.Budget, currentTime time.Time) (amount sdk.Coin, err error) {
-	// Calculate the time elapsed since the last claim time
-	timeElapsed := currentTime.Sub(*budget.LastClaimedAt)
+func (k Keeper) calculateClaimableFunds(ctx context.Context, recipient sdk.AccAddress, budget types.Budget, currentTime time.Time) (amount sdk.Coins, err error) {
+	tranches := budget.TranchesLeft
 
-	// Check the time elapsed has passed period length
-	if timeElapsed < *budget.Period {
-		return sdk.Coin{}, fmt.Errorf("budget period has not passed yet")
-	}
+	// Time based tranches are released once per passed period
+	var periodsPassed uint64
+	if budget.Period != nil && *budget.Period > 0 {
+		// Calculate the time elapsed since the last claim time
+		timeElapsed := currentTime.Sub(*budget.LastClaimedAt)
 
-	// Calculate how many periods have passed
-	periodsPassed := int64(timeElapsed) / int64(*budget.Period)
+		// Check the time elapsed has passed period length
+		if timeElapsed < *budget.Period {
+			return nil, fmt.Errorf("budget period has not passed yet")
+		}
 
-	if periodsPassed > int64(budget.TranchesLeft) {
-		periodsPassed = int64(budget.TranchesLeft)
+		// Calculate how many periods have passed
+		periodsPassed = uint64(int64(timeElapsed) / int64(*budget.Period))
+		tranches = min(tranches, periodsPassed)
+	}
+
+	// Milestone-gated tranches are released once their milestone is approved
+	if budget.IsMilestoneGated() {
+		approvedUnclaimed := budget.ApprovedMilestones() - budget.ClaimedTranches()
+		if approvedUnclaimed == 0 {
+			return nil, fmt.Errorf("no approved milestone left to claim")
+		}
+		tranches = min(tranches, approvedUnclaimed)
 	}
 
-	// Calculate the amount to distribute for all passed periods
-	coinsToDistribute := math.NewInt(periodsPassed).Mul(budget.BudgetPerTranche.Amount)
-	amount = sdk.NewCoin(budget.BudgetPerTranche.Denom, coinsToDistribute)
+	// Calculate the amount to distribute for all released tranches
+	amount = budget.BudgetPerTranche.MulInt(math.NewIntFromUint64(tranches))
 
 	// update the budget's remaining tranches
-	if budget.TranchesLeft > uint64(periodsPassed) {
-		budget.TranchesLeft -= uint64(periodsPassed)
-	} else {
-		budget.TranchesLeft = 0
-	}
+	budget.TranchesLeft -= tranches
 
 	// update the ClaimedAmount
-	claimedAmount := budget.ClaimedAmount.Add(amount)
-	budget.ClaimedAmount = &claimedAmount
+	budget.ClaimedAmount = budget.ClaimedAmount.Add(amount...)
 
 	// Update the last claim time for the budget
-	nextClaimFrom := budget.LastClaimedAt.Add(*budget.Period * time.Duration(periodsPassed))
-	budget.LastClaimedAt = &nextClaimFrom
+	if periodsPassed > 0 {
+		nextClaimFrom := budget.LastClaimedAt.Add(*budget.Period * time.Duration(tranches))
+		budget.LastClaimedAt = &nextClaimFrom
+	}
 
-	k.Logger.Debug(fmt.Sprintf("Processing budget for recipient: %s. Amount: %s", budget.RecipientAddress, coinsToDistribute.String()))
+	k.Logger.Debug(fmt.Sprintf("Processing budget for recipient: %s. Amount: %s", budget.RecipientAddress, amount.String()))
 
 	// Save the updated budget in the state
 	if err := k.BudgetProposal.Set(ctx, recipient, budget); err != nil {
-		return sdk.Coin{}, fmt.Errorf("error while updating the budget for recipient %s", budget.RecipientAddress)
+		return nil, fmt.Errorf("error while updating the budget for recipient %s", budget.RecipientAddress)
 	}
 
 	return amount, nil
 }
 
+// approveMilestone approves the milestone at the given index of a milestone-gated budget.
+// Milestones must be approved in order and only by the budget milestone approver.
+func (k Keeper) approveMilestone(ctx context.Context, approver, recipientAddr string, index uint64) error {
+	recipient, err := k.authKeeper.AddressCodec().StringToBytes(recipientAddr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address: %s", err)
+	}
+
+	budget, err := k.BudgetProposal.Get(ctx, recipient)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return fmt.Errorf("no budget found for recipient: %s", recipientAddr)
+		}
+		return err
+	}
+
+	if !budget.IsMilestoneGated() {
+		return errorsmod.Wrapf(types.ErrMilestone, "budget for recipient %s is not milestone-gated", recipientAddr)
+	}
+
+	if budget.MilestoneApprover != approver {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "expected milestone approver %s, got %s", budget.MilestoneApprover, approver)
+	}
+
+	next := budget.ApprovedMilestones()
+	if next == uint64(len(budget.Milestones)) {
+		return errorsmod.Wrapf(types.ErrMilestone, "all milestones of the budget for recipient %s are already approved", recipientAddr)
+	}
+	if index != next {
+		return errorsmod.Wrapf(types.ErrMilestone, "milestones must be approved in order, next milestone to approve is %d", next)
+	}
+
+	currentTime := k.HeaderService.HeaderInfo(ctx).Time
+	budget.Milestones[index].Approved = true
+	budget.Milestones[index].ApprovedAt = &currentTime
+
+	return k.BudgetProposal.Set(ctx, recipient, budget)
+}
+
 func (k Keeper) validateAndUpdateBudgetProposal(ctx context.Context, bp types.MsgSubmitBudgetProposal) (*types.Budget, error) {
 	if bp.BudgetPerTranche.IsZero() {
 		return nil, fmt.Errorf("invalid budget proposal: budget per tranche cannot be zero")
 	}
 
-	if err := validateAmount(sdk.NewCoins(*bp.BudgetPerTranche)); err != nil {
+	if err := validateAmount(bp.BudgetPerTranche); err != nil {
 		return nil, fmt.Errorf("invalid budget proposal: %w", err)
 	}
 
@@ -447,21 +482,45 @@ func (k Keeper) validateAndUpdateBudgetProposal(ctx context.Context, bp types.Ms
 		return nil, fmt.Errorf("invalid budget proposal: start time cannot be less than the current block time")
 	}
 
+	var milestones []types.Milestone
+	if bp.MilestoneApprover != "" {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(bp.MilestoneApprover); err != nil {
+			return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid milestone approver address: %s", err)
+		}
+
+		if len(bp.Milestones) == 0 {
+			return nil, fmt.Errorf("invalid budget proposal: milestones cannot be empty when a milestone approver is set")
+		}
+
+		if bp.Tranches != 0 && bp.Tranches != uint64(len(bp.Milestones)) {
+			return nil, fmt.Errorf("invalid budget proposal: tranches must equal the number of milestones")
+		}
+		bp.Tranches = uint64(len(bp.Milestones))
+
+		for _, description := range bp.Milestones {
+			milestones = append(milestones, types.Milestone{Description: description})
+		}
+	} else if len(bp.Milestones) != 0 {
+		return nil, fmt.Errorf("invalid budget proposal: milestone approver cannot be empty when milestones are set")
+	}
+
 	if bp.Tranches == 0 {
 		return nil, fmt.Errorf("invalid budget proposal: tranches must be greater than zero")
 	}
 
-	if bp.Period == nil || *bp.Period == 0 {
+	if bp.MilestoneApprover == "" && (bp.Period == nil || *bp.Period == 0) {
 		return nil, fmt.Errorf("invalid budget proposal: period length should be greater than zero")
 	}
 
 	// Create and return an updated budget proposal
 	updatedBudget := types.Budget{
-		RecipientAddress: bp.RecipientAddress,
-		BudgetPerTranche: bp.BudgetPerTranche,
-		LastClaimedAt:    bp.StartTime,
-		TranchesLeft:     bp.Tranches,
-		Period:           bp.Period,
+		RecipientAddress:  bp.RecipientAddress,
+		BudgetPerTranche:  bp.BudgetPerTranche,
+		LastClaimedAt:     bp.StartTime,
+		TranchesLeft:      bp.Tranches,
+		Period:            bp.Period,
+		MilestoneApprover: bp.MilestoneApprover,
+		Milestones:        milestones,
 	}
 
 	return &updatedBudget, nil
@@ -34,6 +34,20 @@ func (k MsgServer) ClaimBudget(ctx context.Context, msg *types.MsgClaimBudget) (
 	return &types.MsgClaimBudgetResponse{Amount: amount}, nil
 }
 
+func (k MsgServer) ApproveMilestone(ctx context.Context, msg *types.MsgApproveMilestone) (*types.MsgApproveMilestoneResponse, error) {
+	if _, err := k.authKeeper.AddressCodec().StringToBytes(msg.Approver); err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid approver address: %s", err)
+	}
+
+	if err := k.approveMilestone(ctx, msg.Approver, msg.RecipientAddress, msg.MilestoneIndex); err != nil {
+		return nil, err
+	}
+
+	k.Logger.Info("approved budget milestone", "recipient", msg.RecipientAddress, "milestone", msg.MilestoneIndex)
+
+	return &types.MsgApproveMilestoneResponse{}, nil
+}
+
 func (k MsgServer) SubmitBudgetProposal(ctx context.Context, msg *types.MsgSubmitBudgetProposal) (*types.MsgSubmitBudgetProposalResponse, error) {
 	if err := k.validateAuthority(msg.GetAuthority()); err != nil {
 		return nil, err
@@ -14,9 +14,11 @@ import (
 
 var (
 	recipientAddr = sdk.AccAddress([]byte("to1__________________"))
+	approverAddr  = sdk.AccAddress([]byte("approver1____________"))
 
 	fooCoin  = sdk.NewInt64Coin("foo", 100)
 	fooCoin2 = sdk.NewInt64Coin("foo", 50)
+	barCoin  = sdk.NewInt64Coin("bar", 20)
 )
 
 func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
@@ -27,6 +29,8 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 	zeroPeriod := time.Duration(0) * time.Second
 	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
 	suite.Require().NoError(err)
+	approverStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(approverAddr)
+	suite.Require().NoError(err)
 	testCases := map[string]struct {
 		input     *types.MsgSubmitBudgetProposal
 		expErr    bool
@@ -36,7 +40,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
 				RecipientAddress: "",
-				BudgetPerTranche: &fooCoin,
+				BudgetPerTranche: sdk.NewCoins(fooCoin),
 				StartTime:        &startTime,
 				Tranches:         2,
 				Period:           &period,
@@ -48,7 +52,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        "",
 				RecipientAddress: recipientStrAddr,
-				BudgetPerTranche: &fooCoin,
+				BudgetPerTranche: sdk.NewCoins(fooCoin),
 				StartTime:        &startTime,
 				Tranches:         2,
 				Period:           &period,
@@ -60,7 +64,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        "invalid_authority",
 				RecipientAddress: recipientStrAddr,
-				BudgetPerTranche: &fooCoin,
+				BudgetPerTranche: sdk.NewCoins(fooCoin),
 				StartTime:        &startTime,
 				Tranches:         2,
 				Period:           &period,
@@ -72,7 +76,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
 				RecipientAddress: recipientStrAddr,
-				BudgetPerTranche: &invalidCoin,
+				BudgetPerTranche: sdk.Coins{invalidCoin},
 				StartTime:        &startTime,
 				Tranches:         2,
 				Period:           &period,
@@ -84,7 +88,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
 				RecipientAddress: recipientStrAddr,
-				BudgetPerTranche: &fooCoin,
+				BudgetPerTranche: sdk.NewCoins(fooCoin),
 				StartTime:        &invalidStartTime,
 				Tranches:         2,
 				Period:           &period,
@@ -96,7 +100,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
 				RecipientAddress: recipientStrAddr,
-				BudgetPerTranche: &fooCoin,
+				BudgetPerTranche: sdk.NewCoins(fooCoin),
 				StartTime:        &startTime,
 				Tranches:         0,
 				Period:           &period,
@@ -108,7 +112,7 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
 				RecipientAddress: recipientStrAddr,
-				BudgetPerTranche: &fooCoin,
+				BudgetPerTranche: sdk.NewCoins(fooCoin),
 				StartTime:        &startTime,
 				Tranches:         2,
 				Period:           &zeroPeriod,
@@ -120,13 +124,84 @@ func (suite *KeeperTestSuite) TestMsgSubmitBudgetProposal() {
 			input: &types.MsgSubmitBudgetProposal{
 				Authority:        suite.poolKeeper.GetAuthority(),
 				RecipientAddress: recipientStrAddr,
-				BudgetPerTranche: &fooCoin2,
+				BudgetPerTranche: sdk.NewCoins(fooCoin2),
+				StartTime:        &startTime,
+				Tranches:         2,
+				Period:           &period,
+			},
+			expErr: false,
+		},
+		"multi-denom budget": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: recipientStrAddr,
+				BudgetPerTranche: sdk.NewCoins(fooCoin2, barCoin),
 				StartTime:        &startTime,
 				Tranches:         2,
 				Period:           &period,
 			},
 			expErr: false,
 		},
+		"invalid milestone approver": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:         suite.poolKeeper.GetAuthority(),
+				RecipientAddress:  recipientStrAddr,
+				BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+				StartTime:         &startTime,
+				MilestoneApprover: "invalid_approver",
+				Milestones:        []string{"design", "delivery"},
+			},
+			expErr:    true,
+			expErrMsg: "invalid milestone approver address",
+		},
+		"milestone approver without milestones": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:         suite.poolKeeper.GetAuthority(),
+				RecipientAddress:  recipientStrAddr,
+				BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+				StartTime:         &startTime,
+				MilestoneApprover: approverStrAddr,
+			},
+			expErr:    true,
+			expErrMsg: "milestones cannot be empty when a milestone approver is set",
+		},
+		"milestones without milestone approver": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:        suite.poolKeeper.GetAuthority(),
+				RecipientAddress: recipientStrAddr,
+				BudgetPerTranche: sdk.NewCoins(fooCoin2),
+				StartTime:        &startTime,
+				Tranches:         2,
+				Period:           &period,
+				Milestones:       []string{"design", "delivery"},
+			},
+			expErr:    true,
+			expErrMsg: "milestone approver cannot be empty when milestones are set",
+		},
+		"tranches not matching milestones": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:         suite.poolKeeper.GetAuthority(),
+				RecipientAddress:  recipientStrAddr,
+				BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+				StartTime:         &startTime,
+				Tranches:          3,
+				MilestoneApprover: approverStrAddr,
+				Milestones:        []string{"design", "delivery"},
+			},
+			expErr:    true,
+			expErrMsg: "tranches must equal the number of milestones",
+		},
+		"milestone-gated budget without period": {
+			input: &types.MsgSubmitBudgetProposal{
+				Authority:         suite.poolKeeper.GetAuthority(),
+				RecipientAddress:  recipientStrAddr,
+				BudgetPerTranche:  sdk.NewCoins(fooCoin2, barCoin),
+				StartTime:         &startTime,
+				MilestoneApprover: approverStrAddr,
+				Milestones:        []string{"design", "delivery"},
+			},
+			expErr: false,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -149,6 +224,8 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 	period := time.Duration(60) * time.Second
 	recipientStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(recipientAddr)
 	suite.Require().NoError(err)
+	approverStrAddr, err := codectestutil.CodecOptions{}.GetAddressCodec().BytesToString(approverAddr)
+	suite.Require().NoError(err)
 
 	testCases := map[string]struct {
 		preRun           func()
@@ -156,7 +233,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 		recipientAddress sdk.AccAddress
 		expErr           bool
 		expErrMsg        string
-		claimableFunds   sdk.Coin
+		claimableFunds   sdk.Coins
 	}{
 		"empty recipient addr": {
 			recipientAddress: sdk.AccAddress(""),
@@ -177,7 +254,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 					TranchesLeft:     2,
 					Period:           &period,
 					LastClaimedAt:    &startTime,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -195,7 +272,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 					LastClaimedAt:    &startTime,
 					TranchesLeft:     1,
 					Period:           &period,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -212,14 +289,14 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 					LastClaimedAt:    &startTime,
 					TranchesLeft:     2,
 					Period:           &period,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
 			},
 			recipientAddress: recipientAddr,
 			expErr:           false,
-			claimableFunds:   sdk.NewInt64Coin("foo", 50),
+			claimableFunds:   sdk.NewCoins(sdk.NewInt64Coin("foo", 50)),
 		},
 		"claiming budget after a long time": {
 			preRun: func() {
@@ -229,7 +306,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 					LastClaimedAt:    &startTime,
 					TranchesLeft:     2,
 					Period:           &period,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -240,7 +317,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 				suite.ctx = suite.ctx.WithHeaderInfo(hinfo)
 			},
 			recipientAddress: recipientAddr,
-			claimableFunds:   sdk.NewInt64Coin("foo", 100), // claiming the whole budget, 2 * 50foo = 100foo
+			claimableFunds:   sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), // claiming the whole budget, 2 * 50foo = 100foo
 			postRun: func() {
 				prop, err := suite.poolKeeper.BudgetProposal.Get(suite.ctx, recipientAddr)
 				suite.Require().NoError(err)
@@ -257,7 +334,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 					LastClaimedAt:    &startTime,
 					TranchesLeft:     2,
 					Period:           &period,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -285,7 +362,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 					LastClaimedAt:    &startTimeBeforeMonth,
 					TranchesLeft:     2,
 					Period:           &oneMonthPeriod,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -306,7 +383,94 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 			},
 			recipientAddress: recipientAddr,
 			expErr:           false,
-			claimableFunds:   sdk.NewInt64Coin("foo", 50),
+			claimableFunds:   sdk.NewCoins(sdk.NewInt64Coin("foo", 50)),
+		},
+		"valid multi-denom claim": {
+			preRun: func() {
+				budget := types.Budget{
+					RecipientAddress: recipientStrAddr,
+					LastClaimedAt:    &startTime,
+					TranchesLeft:     2,
+					Period:           &period,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2, barCoin),
+				}
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
+				suite.Require().NoError(err)
+			},
+			recipientAddress: recipientAddr,
+			claimableFunds:   sdk.NewCoins(fooCoin2, barCoin),
+			postRun: func() {
+				prop, err := suite.poolKeeper.BudgetProposal.Get(suite.ctx, recipientAddr)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), prop.TranchesLeft)
+				suite.Require().Equal(sdk.NewCoins(fooCoin2, barCoin), prop.ClaimedAmount)
+			},
+		},
+		"milestone not approved": {
+			preRun: func() {
+				budget := types.Budget{
+					RecipientAddress:  recipientStrAddr,
+					LastClaimedAt:     &startTime,
+					TranchesLeft:      2,
+					BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+					MilestoneApprover: approverStrAddr,
+					Milestones:        []types.Milestone{{Description: "design"}, {Description: "delivery"}},
+				}
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
+				suite.Require().NoError(err)
+			},
+			recipientAddress: recipientAddr,
+			expErr:           true,
+			expErrMsg:        "no approved milestone left to claim",
+		},
+		"claim approved milestones": {
+			preRun: func() {
+				budget := types.Budget{
+					RecipientAddress:  recipientStrAddr,
+					LastClaimedAt:     &startTime,
+					TranchesLeft:      3,
+					BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+					MilestoneApprover: approverStrAddr,
+					Milestones: []types.Milestone{
+						{Description: "design", Approved: true},
+						{Description: "alpha", Approved: true},
+						{Description: "delivery"},
+					},
+				}
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
+				suite.Require().NoError(err)
+			},
+			recipientAddress: recipientAddr,
+			claimableFunds:   sdk.NewCoins(fooCoin),
+			postRun: func() {
+				prop, err := suite.poolKeeper.BudgetProposal.Get(suite.ctx, recipientAddr)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), prop.TranchesLeft)
+				suite.Require().Equal(startTime, *prop.LastClaimedAt)
+
+				// the next milestone is not approved yet
+				_, err = suite.msgServer.ClaimBudget(suite.ctx, &types.MsgClaimBudget{RecipientAddress: recipientStrAddr})
+				suite.Require().ErrorContains(err, "no approved milestone left to claim")
+			},
+		},
+		"milestone approved but period not passed": {
+			preRun: func() {
+				longPeriod := 2 * period
+				budget := types.Budget{
+					RecipientAddress:  recipientStrAddr,
+					LastClaimedAt:     &startTime,
+					TranchesLeft:      1,
+					Period:            &longPeriod,
+					BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+					MilestoneApprover: approverStrAddr,
+					Milestones:        []types.Milestone{{Description: "design", Approved: true}},
+				}
+				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
+				suite.Require().NoError(err)
+			},
+			recipientAddress: recipientAddr,
+			expErr:           true,
+			expErrMsg:        "budget period has not passed yet",
 		},
 		"budget ended for recipient": {
 			preRun: func() {
@@ -316,7 +480,7 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 					LastClaimedAt:    &startTime,
 					TranchesLeft:     2,
 					Period:           &period,
-					BudgetPerTranche: &fooCoin2,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
 				}
 				err := suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget)
 				suite.Require().NoError(err)
@@ -377,6 +541,128 @@ func (suite *KeeperTestSuite) TestMsgClaimBudget() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMsgApproveMilestone() {
+	startTime := suite.environment.HeaderService.HeaderInfo(suite.ctx).Time
+	period := time.Duration(60) * time.Second
+	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
+	recipientStrAddr, err := addressCodec.BytesToString(recipientAddr)
+	suite.Require().NoError(err)
+	approverStrAddr, err := addressCodec.BytesToString(approverAddr)
+	suite.Require().NoError(err)
+	otherStrAddr, err := addressCodec.BytesToString(sdk.AccAddress([]byte("other1_______________")))
+	suite.Require().NoError(err)
+
+	milestoneBudget := func() types.Budget {
+		return types.Budget{
+			RecipientAddress:  recipientStrAddr,
+			LastClaimedAt:     &startTime,
+			TranchesLeft:      2,
+			BudgetPerTranche:  sdk.NewCoins(fooCoin2),
+			MilestoneApprover: approverStrAddr,
+			Milestones:        []types.Milestone{{Description: "design"}, {Description: "delivery"}},
+		}
+	}
+
+	testCases := map[string]struct {
+		preRun    func()
+		input     *types.MsgApproveMilestone
+		expErr    bool
+		expErrMsg string
+		postRun   func()
+	}{
+		"invalid approver address": {
+			input:     &types.MsgApproveMilestone{Approver: "invalid", RecipientAddress: recipientStrAddr},
+			expErr:    true,
+			expErrMsg: "invalid approver address",
+		},
+		"no budget found": {
+			input:     &types.MsgApproveMilestone{Approver: approverStrAddr, RecipientAddress: recipientStrAddr},
+			expErr:    true,
+			expErrMsg: "no budget found for recipient",
+		},
+		"budget not milestone-gated": {
+			preRun: func() {
+				budget := types.Budget{
+					RecipientAddress: recipientStrAddr,
+					LastClaimedAt:    &startTime,
+					TranchesLeft:     2,
+					Period:           &period,
+					BudgetPerTranche: sdk.NewCoins(fooCoin2),
+				}
+				suite.Require().NoError(suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget))
+			},
+			input:     &types.MsgApproveMilestone{Approver: approverStrAddr, RecipientAddress: recipientStrAddr},
+			expErr:    true,
+			expErrMsg: "is not milestone-gated",
+		},
+		"wrong approver": {
+			preRun: func() {
+				suite.Require().NoError(suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, milestoneBudget()))
+			},
+			input:     &types.MsgApproveMilestone{Approver: otherStrAddr, RecipientAddress: recipientStrAddr},
+			expErr:    true,
+			expErrMsg: "expected milestone approver",
+		},
+		"out of order approval": {
+			preRun: func() {
+				suite.Require().NoError(suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, milestoneBudget()))
+			},
+			input:     &types.MsgApproveMilestone{Approver: approverStrAddr, RecipientAddress: recipientStrAddr, MilestoneIndex: 1},
+			expErr:    true,
+			expErrMsg: "next milestone to approve is 0",
+		},
+		"all milestones approved": {
+			preRun: func() {
+				budget := milestoneBudget()
+				budget.Milestones[0].Approved = true
+				budget.Milestones[1].Approved = true
+				suite.Require().NoError(suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, budget))
+			},
+			input:     &types.MsgApproveMilestone{Approver: approverStrAddr, RecipientAddress: recipientStrAddr, MilestoneIndex: 2},
+			expErr:    true,
+			expErrMsg: "already approved",
+		},
+		"valid approval": {
+			preRun: func() {
+				suite.Require().NoError(suite.poolKeeper.BudgetProposal.Set(suite.ctx, recipientAddr, milestoneBudget()))
+			},
+			input: &types.MsgApproveMilestone{Approver: approverStrAddr, RecipientAddress: recipientStrAddr},
+			postRun: func() {
+				budget, err := suite.poolKeeper.BudgetProposal.Get(suite.ctx, recipientAddr)
+				suite.Require().NoError(err)
+				suite.Require().True(budget.Milestones[0].Approved)
+				suite.Require().Equal(suite.environment.HeaderService.HeaderInfo(suite.ctx).Time, *budget.Milestones[0].ApprovedAt)
+				suite.Require().False(budget.Milestones[1].Approved)
+
+				// the approved tranche can now be claimed
+				suite.mockSendCoinsFromModuleToAccount(recipientAddr)
+				resp, err := suite.msgServer.ClaimBudget(suite.ctx, &types.MsgClaimBudget{RecipientAddress: recipientStrAddr})
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoins(fooCoin2), resp.Amount)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			if tc.preRun != nil {
+				tc.preRun()
+			}
+			_, err := suite.msgServer.ApproveMilestone(suite.ctx, tc.input)
+			if tc.expErr {
+				suite.Require().Error(err)
+				suite.Require().Contains(err.Error(), tc.expErrMsg)
+			} else {
+				suite.Require().NoError(err)
+			}
+			if tc.postRun != nil {
+				tc.postRun()
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestWithdrawContinuousFund() {
 	addressCodec := codectestutil.CodecOptions{}.GetAddressCodec()
 	recipient := sdk.AccAddress([]byte("recipientAddr1__________________"))
@@ -0,0 +1,29 @@
+package types
+
+// IsMilestoneGated returns true if the budget tranches are gated by milestones.
+func (b Budget) IsMilestoneGated() bool {
+	return b.MilestoneApprover != ""
+}
+
+// ApprovedMilestones returns the number of approved milestones of the budget.
+// Milestones are approved in order, so they are the first milestones of the budget.
+func (b Budget) ApprovedMilestones() uint64 {
+	var approved uint64
+	for _, m := range b.Milestones {
+		if !m.Approved {
+			break
+		}
+		approved++
+	}
+	return approved
+}
+
+// ClaimedTranches returns the number of tranches of a milestone-gated budget
+// that have already been claimed.
+func (b Budget) ClaimedTranches() uint64 {
+	total := uint64(len(b.Milestones))
+	if b.TranchesLeft > total {
+		return 0
+	}
+	return total - b.TranchesLeft
+}
@@ -14,6 +14,7 @@ func RegisterInterfaces(registrar registry.InterfaceRegistrar) {
 		&MsgCommunityPoolSpend{},
 		&MsgSubmitBudgetProposal{},
 		&MsgClaimBudget{},
+		&MsgApproveMilestone{},
 		&MsgCreateContinuousFund{},
 		&MsgCancelContinuousFund{},
 		&MsgWithdrawContinuousFund{},
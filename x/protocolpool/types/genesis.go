@@ -6,7 +6,6 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -45,7 +44,7 @@ func validateBudget(bp Budget) error {
 	}
 
 	// Validate BudgetPerTranche
-	amount := sdk.NewCoins(*bp.BudgetPerTranche)
+	amount := bp.BudgetPerTranche
 	if amount.IsZero() {
 		return fmt.Errorf("budget per tranche cannot be zero")
 	}
@@ -57,8 +56,24 @@ func validateBudget(bp Budget) error {
 		return fmt.Errorf("invalid budget proposal: tranches must be greater than zero")
 	}
 
-	if bp.Period == nil || *bp.Period == 0 {
-		return fmt.Errorf("invalid budget proposal: period length should be greater than zero")
+	if !bp.IsMilestoneGated() {
+		if len(bp.Milestones) != 0 {
+			return fmt.Errorf("invalid budget proposal: milestone approver cannot be empty when milestones are set")
+		}
+		if bp.Period == nil || *bp.Period == 0 {
+			return fmt.Errorf("invalid budget proposal: period length should be greater than zero")
+		}
+		return nil
+	}
+
+	if bp.TranchesLeft > uint64(len(bp.Milestones)) {
+		return fmt.Errorf("invalid budget proposal: tranches left cannot exceed the number of milestones")
+	}
+	approved := bp.ApprovedMilestones()
+	for i := approved; i < uint64(len(bp.Milestones)); i++ {
+		if bp.Milestones[i].Approved {
+			return fmt.Errorf("invalid budget proposal: milestones must be approved in order")
+		}
 	}
 	return nil
 }
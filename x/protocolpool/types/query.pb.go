@@ -9,6 +9,7 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -173,9 +174,9 @@ func (m *QueryUnclaimedBudgetRequest) GetAddress() string {
 // RPC method.
 type QueryUnclaimedBudgetResponse struct {
 	// claimed_amount is the budget amount already claimed by the recipient
-	ClaimedAmount *types.Coin `protobuf:"bytes,1,opt,name=claimed_amount,json=claimedAmount,proto3" json:"claimed_amount,omitempty"`
+	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=claimed_amount,json=claimedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_amount"`
 	// unclaimed_amount is the remaining budget amount that is unclaimed by the recipient
-	UnclaimedAmount *types.Coin `protobuf:"bytes,2,opt,name=unclaimed_amount,json=unclaimedAmount,proto3" json:"unclaimed_amount,omitempty"`
+	UnclaimedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=unclaimed_amount,json=unclaimedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"unclaimed_amount"`
 	// next_claim_from is the next starting claim time for fund distribution.
 	// It represents the time when we can claim funds after the period time interval has passed.
 	NextClaimFrom *time.Time `protobuf:"bytes,3,opt,name=next_claim_from,json=nextClaimFrom,proto3,stdtime" json:"next_claim_from,omitempty"`
@@ -183,6 +184,10 @@ type QueryUnclaimedBudgetResponse struct {
 	Period *time.Duration `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period,omitempty"`
 	// tranches_left is the number of tranches left for the amount to be distributed
 	TranchesLeft uint64 `protobuf:"varint,5,opt,name=tranches_left,json=tranchesLeft,proto3" json:"tranches_left,omitempty"`
+	// milestone_approver is the address allowed to approve the budget milestones, if any.
+	MilestoneApprover string `protobuf:"bytes,6,opt,name=milestone_approver,json=milestoneApprover,proto3" json:"milestone_approver,omitempty"`
+	// milestones are the milestones gating the budget tranches, if any.
+	Milestones []Milestone `protobuf:"bytes,7,rep,name=milestones,proto3" json:"milestones"`
 }
 
 func (m *QueryUnclaimedBudgetResponse) Reset()         { *m = QueryUnclaimedBudgetResponse{} }
@@ -218,14 +223,14 @@ func (m *QueryUnclaimedBudgetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryUnclaimedBudgetResponse proto.InternalMessageInfo
 
-func (m *QueryUnclaimedBudgetResponse) GetClaimedAmount() *types.Coin {
+func (m *QueryUnclaimedBudgetResponse) GetClaimedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.ClaimedAmount
 	}
 	return nil
 }
 
-func (m *QueryUnclaimedBudgetResponse) GetUnclaimedAmount() *types.Coin {
+func (m *QueryUnclaimedBudgetResponse) GetUnclaimedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.UnclaimedAmount
 	}
@@ -253,11 +258,213 @@ func (m *QueryUnclaimedBudgetResponse) GetTranchesLeft() uint64 {
 	return 0
 }
 
+func (m *QueryUnclaimedBudgetResponse) GetMilestoneApprover() string {
+	if m != nil {
+		return m.MilestoneApprover
+	}
+	return ""
+}
+
+func (m *QueryUnclaimedBudgetResponse) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+// QueryPendingMilestonesRequest is the request type for the Query/PendingMilestones
+// RPC method.
+type QueryPendingMilestonesRequest struct {
+	// approver optionally filters the pending milestones by milestone approver.
+	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	// pagination defines an optional pagination for the request, it paginates over budgets.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingMilestonesRequest) Reset()         { *m = QueryPendingMilestonesRequest{} }
+func (m *QueryPendingMilestonesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMilestonesRequest) ProtoMessage()    {}
+func (*QueryPendingMilestonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{4}
+}
+func (m *QueryPendingMilestonesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMilestonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMilestonesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingMilestonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMilestonesRequest.Merge(m, src)
+}
+func (m *QueryPendingMilestonesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMilestonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMilestonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingMilestonesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingMilestonesRequest) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+func (m *QueryPendingMilestonesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingMilestonesResponse is the response type for the Query/PendingMilestones
+// RPC method.
+type QueryPendingMilestonesResponse struct {
+	// milestones are the milestones waiting for approval.
+	Milestones []PendingMilestone `protobuf:"bytes,1,rep,name=milestones,proto3" json:"milestones"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingMilestonesResponse) Reset()         { *m = QueryPendingMilestonesResponse{} }
+func (m *QueryPendingMilestonesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingMilestonesResponse) ProtoMessage()    {}
+func (*QueryPendingMilestonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{5}
+}
+func (m *QueryPendingMilestonesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingMilestonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingMilestonesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingMilestonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingMilestonesResponse.Merge(m, src)
+}
+func (m *QueryPendingMilestonesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingMilestonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingMilestonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingMilestonesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingMilestonesResponse) GetMilestones() []PendingMilestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *QueryPendingMilestonesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// PendingMilestone is a milestone of a budget that has not been approved yet.
+type PendingMilestone struct {
+	// recipient_address is the address of the budget recipient.
+	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// approver is the address allowed to approve the milestone.
+	Approver string `protobuf:"bytes,2,opt,name=approver,proto3" json:"approver,omitempty"`
+	// index is the index of the milestone in the budget.
+	Index uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	// description is the description of the milestone.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *PendingMilestone) Reset()         { *m = PendingMilestone{} }
+func (m *PendingMilestone) String() string { return proto.CompactTextString(m) }
+func (*PendingMilestone) ProtoMessage()    {}
+func (*PendingMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_51500a0a77d57843, []int{6}
+}
+func (m *PendingMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingMilestone.Merge(m, src)
+}
+func (m *PendingMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingMilestone proto.InternalMessageInfo
+
+func (m *PendingMilestone) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *PendingMilestone) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+func (m *PendingMilestone) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *PendingMilestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QueryCommunityPoolRequest)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolRequest")
 	proto.RegisterType((*QueryCommunityPoolResponse)(nil), "cosmos.protocolpool.v1.QueryCommunityPoolResponse")
 	proto.RegisterType((*QueryUnclaimedBudgetRequest)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetRequest")
 	proto.RegisterType((*QueryUnclaimedBudgetResponse)(nil), "cosmos.protocolpool.v1.QueryUnclaimedBudgetResponse")
+	proto.RegisterType((*QueryPendingMilestonesRequest)(nil), "cosmos.protocolpool.v1.QueryPendingMilestonesRequest")
+	proto.RegisterType((*QueryPendingMilestonesResponse)(nil), "cosmos.protocolpool.v1.QueryPendingMilestonesResponse")
+	proto.RegisterType((*PendingMilestone)(nil), "cosmos.protocolpool.v1.PendingMilestone")
 }
 
 func init() {
@@ -265,46 +472,62 @@ func init() {
 }
 
 var fileDescriptor_51500a0a77d57843 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xd3, 0xb4, 0x9f, 0xbe, 0x29, 0xa1, 0x68, 0x54, 0xa1, 0xd4, 0xad, 0x9c, 0x12, 0x24,
-	0x14, 0x09, 0x75, 0x46, 0x49, 0x2b, 0x21, 0xc1, 0x86, 0xa6, 0x15, 0x62, 0xc1, 0x82, 0x1a, 0xd8,
-	0xb0, 0xb1, 0xfc, 0x33, 0x71, 0xad, 0xda, 0x73, 0x5d, 0xcf, 0xb8, 0x6a, 0x85, 0xd8, 0xc0, 0x0b,
-	0x54, 0x62, 0xc3, 0x13, 0xb0, 0x60, 0xcd, 0x82, 0x47, 0xe8, 0x0a, 0x55, 0xb0, 0x61, 0x45, 0x51,
-	0xcb, 0x8e, 0x97, 0x40, 0x1e, 0x8f, 0xa1, 0x8d, 0x12, 0x50, 0x57, 0x49, 0xee, 0xb9, 0xe7, 0xdc,
-	0x73, 0x72, 0xef, 0xa0, 0x8e, 0x0f, 0x22, 0x01, 0x41, 0xd3, 0x0c, 0x24, 0xf8, 0x10, 0xa7, 0x00,
-	0x31, 0xdd, 0xeb, 0xd1, 0xdd, 0x9c, 0x65, 0x07, 0x44, 0x55, 0xf1, 0xf5, 0xb2, 0x87, 0x9c, 0xef,
-	0x21, 0x7b, 0x3d, 0x73, 0x3e, 0x84, 0x10, 0x54, 0x91, 0x16, 0xdf, 0x4a, 0xdc, 0x5c, 0x0a, 0x01,
-	0xc2, 0x98, 0x51, 0x37, 0x8d, 0xa8, 0xcb, 0x39, 0x48, 0x57, 0x46, 0xc0, 0x35, 0xdb, 0xb4, 0xf4,
-	0x3c, 0xcf, 0x15, 0x8c, 0xee, 0xf5, 0x3c, 0x26, 0xdd, 0x1e, 0xf5, 0x21, 0xe2, 0x1a, 0x5f, 0x28,
-	0x71, 0xa7, 0x94, 0x3d, 0x3f, 0xd8, 0x6c, 0x6b, 0x61, 0xf5, 0xcb, 0xcb, 0x87, 0x54, 0x46, 0x09,
-	0x13, 0xd2, 0x4d, 0xd2, 0x4a, 0x7b, 0xb4, 0x21, 0xc8, 0x33, 0x35, 0xbc, 0xc4, 0x3b, 0x8b, 0x68,
-	0x61, 0xab, 0x88, 0xb5, 0x01, 0x49, 0x92, 0xf3, 0x48, 0x1e, 0x3c, 0x06, 0x88, 0x6d, 0xb6, 0x9b,
-	0x33, 0x21, 0x3b, 0xaf, 0x0d, 0x64, 0x8e, 0x43, 0x45, 0x0a, 0x5c, 0x30, 0xcc, 0x50, 0xa3, 0x88,
-	0xdd, 0x32, 0x96, 0xa7, 0xba, 0xb3, 0xfd, 0x25, 0xa2, 0x9d, 0x15, 0x31, 0x88, 0x8e, 0x41, 0x36,
-	0x99, 0xbf, 0x01, 0x11, 0x1f, 0xac, 0x1e, 0x7d, 0x6b, 0xd7, 0xde, 0x9f, 0xb4, 0x6f, 0x87, 0x91,
-	0xdc, 0xce, 0x3d, 0xe2, 0x43, 0xa2, 0x93, 0xe8, 0x8f, 0x15, 0x11, 0xec, 0x50, 0x79, 0x90, 0x32,
-	0x51, 0x71, 0x84, 0xad, 0xe4, 0x3b, 0x5b, 0x68, 0x51, 0x99, 0x78, 0xc6, 0xfd, 0xd8, 0x8d, 0x12,
-	0x16, 0x0c, 0xf2, 0x20, 0x64, 0x52, 0x9b, 0xc4, 0x7d, 0xf4, 0x9f, 0x1b, 0x04, 0x19, 0x13, 0xa2,
-	0x65, 0x2c, 0x1b, 0xdd, 0xff, 0x07, 0xad, 0xcf, 0x1f, 0x56, 0xe6, 0xb5, 0x97, 0xf5, 0x12, 0x79,
-	0x22, 0xb3, 0x88, 0x87, 0x76, 0xd5, 0xd8, 0xf9, 0x54, 0x47, 0x4b, 0xe3, 0x35, 0x75, 0xb4, 0xfb,
-	0xe8, 0xaa, 0x06, 0x1c, 0x37, 0x81, 0x9c, 0x4b, 0xa5, 0x3d, 0xdb, 0x5f, 0x18, 0x1b, 0xb2, 0x70,
-	0x6b, 0x37, 0x35, 0x61, 0x5d, 0xf5, 0xe3, 0x4d, 0x74, 0x2d, 0xe7, 0x23, 0x1a, 0xf5, 0x7f, 0x69,
-	0xcc, 0xfd, 0xa6, 0x68, 0x95, 0x87, 0x68, 0x8e, 0xb3, 0x7d, 0xe9, 0xa8, 0xaa, 0x33, 0xcc, 0x20,
-	0x69, 0x4d, 0x29, 0x11, 0x93, 0x94, 0x8b, 0x25, 0xd5, 0x62, 0xc9, 0xd3, 0x6a, 0xf3, 0x83, 0xc6,
-	0xe1, 0x49, 0xdb, 0xb0, 0x9b, 0x05, 0x71, 0xa3, 0xe0, 0x3d, 0xc8, 0x20, 0xc1, 0x77, 0xd0, 0x4c,
-	0xca, 0xb2, 0x08, 0x82, 0x56, 0x43, 0xbb, 0x18, 0x15, 0xd8, 0xd4, 0x97, 0x31, 0x68, 0xbc, 0x2d,
-	0xf8, 0xba, 0x1d, 0xdf, 0x44, 0x4d, 0x99, 0xb9, 0xdc, 0xdf, 0x66, 0xc2, 0x89, 0xd9, 0x50, 0xb6,
-	0xa6, 0x97, 0x8d, 0x6e, 0xc3, 0xbe, 0x52, 0x15, 0x1f, 0xb1, 0xa1, 0xec, 0xff, 0xac, 0xa3, 0x69,
-	0xf5, 0x87, 0xe2, 0x77, 0x06, 0x6a, 0x5e, 0x38, 0x17, 0xdc, 0x23, 0xe3, 0xdf, 0x0a, 0x99, 0x78,
-	0x78, 0x66, 0xff, 0x32, 0x94, 0x72, 0x65, 0x1d, 0xf2, 0xea, 0xcb, 0x8f, 0x37, 0xf5, 0x2e, 0xbe,
-	0x45, 0x27, 0x3c, 0x5f, 0xbf, 0xa2, 0x39, 0x45, 0x05, 0x7f, 0x34, 0xd0, 0xdc, 0xc8, 0xfa, 0xf1,
-	0xea, 0x5f, 0xe7, 0x8e, 0x3f, 0x40, 0x73, 0xed, 0x72, 0x24, 0x6d, 0xf7, 0xae, 0xb2, 0xbb, 0x86,
-	0xfb, 0x93, 0xec, 0xfe, 0xb9, 0x1e, 0x4f, 0x31, 0xe9, 0x0b, 0x7d, 0xbd, 0x2f, 0x07, 0xf7, 0x8e,
-	0x4e, 0x2d, 0xe3, 0xf8, 0xd4, 0x32, 0xbe, 0x9f, 0x5a, 0xc6, 0xe1, 0x99, 0x55, 0x3b, 0x3e, 0xb3,
-	0x6a, 0x5f, 0xcf, 0xac, 0xda, 0xf3, 0x1b, 0xa5, 0x98, 0x08, 0x76, 0x48, 0x04, 0x74, 0xff, 0xa2,
-	0xa8, 0x7a, 0x5d, 0xde, 0x8c, 0xaa, 0xad, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x33, 0x02,
-	0x59, 0xe6, 0x04, 0x00, 0x00,
+	// 872 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xde, 0xd9, 0x3a, 0x29, 0x9d, 0xb0, 0x24, 0x19, 0x45, 0xc8, 0xeb, 0x06, 0xef, 0xd6, 0x48,
+	0x65, 0x55, 0xa8, 0xcd, 0x6e, 0x02, 0x48, 0x70, 0xca, 0xa6, 0xb4, 0x1c, 0x00, 0xa5, 0x06, 0x2e,
+	0x5c, 0x2c, 0xaf, 0x3d, 0xeb, 0x8e, 0xba, 0x9e, 0x71, 0x3d, 0xe3, 0x55, 0x22, 0xc4, 0x05, 0xfe,
+	0x81, 0x4a, 0x5c, 0xb8, 0x70, 0x43, 0x1c, 0x38, 0x23, 0x04, 0x77, 0x24, 0x7a, 0xac, 0xe0, 0xc2,
+	0x89, 0xa0, 0x84, 0x3f, 0x04, 0x79, 0x3c, 0xde, 0xec, 0x2f, 0xe7, 0x87, 0xc4, 0x29, 0xf1, 0x9b,
+	0xf7, 0xbd, 0xf7, 0x7d, 0x6f, 0xbe, 0x79, 0x0b, 0xad, 0x80, 0xf1, 0x98, 0x71, 0x27, 0x49, 0x99,
+	0x60, 0x01, 0x1b, 0x25, 0x8c, 0x8d, 0x9c, 0x71, 0xd7, 0x79, 0x92, 0xe1, 0xf4, 0xc8, 0x96, 0x51,
+	0xf4, 0x72, 0x91, 0x63, 0x4f, 0xe7, 0xd8, 0xe3, 0xae, 0xb1, 0x15, 0xb1, 0x88, 0xc9, 0xa0, 0x93,
+	0xff, 0x57, 0x9c, 0x1b, 0xdb, 0x11, 0x63, 0xd1, 0x08, 0x3b, 0x7e, 0x42, 0x1c, 0x9f, 0x52, 0x26,
+	0x7c, 0x41, 0x18, 0x55, 0x68, 0xc3, 0x54, 0xfd, 0x06, 0x3e, 0xc7, 0xce, 0xb8, 0x3b, 0xc0, 0xc2,
+	0xef, 0x3a, 0x01, 0x23, 0x54, 0x9d, 0xdf, 0x99, 0x3e, 0x97, 0x24, 0x26, 0x59, 0x89, 0x1f, 0x11,
+	0x2a, 0x8b, 0xa9, 0xdc, 0x2a, 0xee, 0xe2, 0x28, 0xc1, 0x65, 0xbf, 0x66, 0x91, 0xe3, 0x15, 0x34,
+	0xa7, 0x85, 0x18, 0x2d, 0x45, 0x54, 0x7e, 0x0d, 0xb2, 0xa1, 0x23, 0x48, 0x8c, 0xb9, 0xf0, 0xe3,
+	0xa4, 0xe4, 0x3a, 0x9f, 0x10, 0x66, 0xe9, 0x54, 0x7f, 0xeb, 0x26, 0x6c, 0x3e, 0xcc, 0x19, 0xee,
+	0xb3, 0x38, 0xce, 0x28, 0x11, 0x47, 0x07, 0x8c, 0x8d, 0x5c, 0xfc, 0x24, 0xc3, 0x5c, 0x58, 0x5f,
+	0x03, 0x68, 0x2c, 0x3b, 0xe5, 0x09, 0xa3, 0x1c, 0x23, 0x0c, 0xb5, 0x9c, 0xae, 0x0e, 0xda, 0xd7,
+	0x3a, 0x6b, 0xbd, 0x6d, 0x5b, 0x31, 0xcb, 0x65, 0xdb, 0x4a, 0xb0, 0x7d, 0x0f, 0x07, 0xfb, 0x8c,
+	0xd0, 0xfe, 0xce, 0xb3, 0xbf, 0x5b, 0xb5, 0x1f, 0x8f, 0x5b, 0xaf, 0x47, 0x44, 0x3c, 0xca, 0x06,
+	0x76, 0xc0, 0x62, 0xa5, 0x44, 0xfd, 0xb9, 0xcb, 0xc3, 0xc7, 0x4a, 0xb5, 0xc2, 0x70, 0x57, 0x96,
+	0xb7, 0x1e, 0xc2, 0x9b, 0x92, 0xc4, 0x67, 0x34, 0x18, 0xf9, 0x24, 0xc6, 0x61, 0x3f, 0x0b, 0x23,
+	0x2c, 0x14, 0x49, 0xd4, 0x83, 0xd7, 0xfd, 0x30, 0x4c, 0x31, 0xe7, 0x3a, 0x68, 0x83, 0xce, 0x8d,
+	0xbe, 0xfe, 0xc7, 0x4f, 0x77, 0xb7, 0x14, 0x97, 0xbd, 0xe2, 0xe4, 0x13, 0x91, 0x12, 0x1a, 0xb9,
+	0x65, 0xa2, 0xf5, 0x9b, 0x06, 0xb7, 0x97, 0xd7, 0x54, 0xd2, 0x52, 0xf8, 0x92, 0x3a, 0xf0, 0xfc,
+	0x98, 0x65, 0x54, 0x28, 0x91, 0xcd, 0xa5, 0x22, 0xa5, 0xc2, 0x37, 0x95, 0xc2, 0xce, 0x25, 0x14,
+	0x16, 0xf2, 0x1a, 0xaa, 0xc5, 0x9e, 0xec, 0x80, 0xc6, 0x70, 0x23, 0xa3, 0x73, 0x5d, 0xeb, 0xff,
+	0x7f, 0xd7, 0xf5, 0x8c, 0xce, 0xf6, 0xfd, 0x00, 0xae, 0x53, 0x7c, 0x28, 0x3c, 0x19, 0xf5, 0x86,
+	0x29, 0x8b, 0xf5, 0x6b, 0x6d, 0xd0, 0x59, 0xeb, 0x19, 0x76, 0x61, 0x1e, 0xbb, 0x34, 0x8f, 0xfd,
+	0x69, 0xe9, 0xae, 0xbe, 0xf6, 0xf4, 0xb8, 0x05, 0xdc, 0x46, 0x0e, 0xdc, 0xcf, 0x71, 0xf7, 0x53,
+	0x16, 0xa3, 0x77, 0xe0, 0x6a, 0x82, 0x53, 0xc2, 0x42, 0x5d, 0x93, 0x05, 0x9a, 0x0b, 0x05, 0xee,
+	0x29, 0xf7, 0xf5, 0xb5, 0x6f, 0x73, 0xbc, 0x4a, 0x47, 0xaf, 0xc2, 0x86, 0x48, 0x7d, 0x1a, 0x3c,
+	0xc2, 0xdc, 0x1b, 0xe1, 0xa1, 0xd0, 0x57, 0xda, 0xa0, 0xa3, 0xb9, 0x2f, 0x96, 0xc1, 0x0f, 0xf1,
+	0x50, 0xa0, 0x07, 0x10, 0xc5, 0x64, 0x84, 0xb9, 0x60, 0x14, 0x7b, 0x7e, 0x92, 0xa4, 0x6c, 0x8c,
+	0x53, 0x7d, 0xf5, 0x82, 0x3b, 0xdf, 0x9c, 0x60, 0xf6, 0x14, 0x04, 0x3d, 0x80, 0x70, 0x12, 0xe4,
+	0xfa, 0x75, 0x39, 0xe2, 0x5b, 0xf6, 0xf2, 0x05, 0x61, 0x7f, 0x54, 0x66, 0xf6, 0xb5, 0x7c, 0xd4,
+	0xee, 0x14, 0xd4, 0xfa, 0x0e, 0xc0, 0x57, 0xa4, 0x8d, 0x0e, 0x30, 0x0d, 0x09, 0x8d, 0x26, 0xb9,
+	0xbc, 0x34, 0xe7, 0x2e, 0x7c, 0x61, 0xc2, 0xf4, 0x22, 0x77, 0x4e, 0x32, 0xd1, 0x7d, 0x08, 0xcf,
+	0x16, 0x85, 0x5e, 0x97, 0xb3, 0xbc, 0x3d, 0xe3, 0x81, 0x62, 0xb5, 0x95, 0x4e, 0x38, 0xf0, 0x23,
+	0xac, 0x3a, 0xba, 0x53, 0x48, 0xeb, 0x57, 0x00, 0xcd, 0x2a, 0x7e, 0xca, 0xe8, 0x1f, 0xcf, 0xcc,
+	0xa2, 0x30, 0x79, 0xa7, 0x6a, 0x16, 0xf3, 0x65, 0x16, 0x47, 0x92, 0xcf, 0x76, 0x81, 0xfa, 0x6b,
+	0x17, 0x52, 0x2f, 0xc8, 0xcc, 0x70, 0xff, 0x1d, 0xc0, 0x8d, 0xf9, 0x7e, 0xe8, 0x7d, 0xb8, 0x99,
+	0xe2, 0x80, 0x24, 0x04, 0x53, 0xe1, 0x5d, 0xf6, 0xd5, 0x6f, 0x4c, 0x20, 0x2a, 0x3e, 0x73, 0x2b,
+	0xf5, 0x4b, 0xdf, 0xca, 0x16, 0x5c, 0x21, 0x34, 0xc4, 0x87, 0xf2, 0x75, 0x68, 0x6e, 0xf1, 0x81,
+	0xda, 0x70, 0x2d, 0xc4, 0x3c, 0x48, 0x49, 0x22, 0x15, 0xe7, 0xc6, 0xbf, 0xe1, 0x4e, 0x87, 0x7a,
+	0xdf, 0x6b, 0x70, 0x45, 0xde, 0x02, 0xfa, 0x01, 0xc0, 0xc6, 0xcc, 0x2a, 0x45, 0xdd, 0xaa, 0x51,
+	0x57, 0x2e, 0x65, 0xa3, 0x77, 0x15, 0x48, 0x31, 0x58, 0xcb, 0xfe, 0xea, 0xcf, 0x7f, 0xbf, 0xa9,
+	0x77, 0xd0, 0x6d, 0xa7, 0xe2, 0xe7, 0x26, 0x28, 0x61, 0x5e, 0x1e, 0x41, 0xbf, 0x00, 0xb8, 0x3e,
+	0xb7, 0x1a, 0xd1, 0xce, 0xb9, 0x7d, 0x97, 0x2f, 0x67, 0x63, 0xf7, 0x6a, 0x20, 0x45, 0xf7, 0x5d,
+	0x49, 0x77, 0x17, 0xf5, 0xaa, 0xe8, 0x9e, 0xed, 0xc9, 0x81, 0x44, 0x3a, 0x5f, 0x28, 0x33, 0x7c,
+	0x89, 0x7e, 0x06, 0x70, 0x73, 0xc1, 0xee, 0xe8, 0xad, 0x73, 0x79, 0x54, 0x3d, 0x5f, 0xe3, 0xed,
+	0xab, 0xc2, 0x94, 0x80, 0x9e, 0x14, 0xf0, 0x06, 0xba, 0x53, 0x25, 0x20, 0x29, 0xa0, 0xde, 0xd9,
+	0xcb, 0xe9, 0xbf, 0xf7, 0xec, 0xc4, 0x04, 0xcf, 0x4f, 0x4c, 0xf0, 0xcf, 0x89, 0x09, 0x9e, 0x9e,
+	0x9a, 0xb5, 0xe7, 0xa7, 0x66, 0xed, 0xaf, 0x53, 0xb3, 0xf6, 0xf9, 0xad, 0xa2, 0x08, 0x0f, 0x1f,
+	0xdb, 0x84, 0x39, 0x87, 0xb3, 0xc5, 0xe4, 0x6a, 0x1f, 0xac, 0xca, 0xd8, 0xce, 0x7f, 0x03, 0x00,
+	0xf8, 0x83, 0xc1, 0x8c, 0x0b, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -323,6 +546,9 @@ type QueryClient interface {
 	CommunityPool(ctx context.Context, in *QueryCommunityPoolRequest, opts ...grpc.CallOption) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBudgetRequest, opts ...grpc.CallOption) (*QueryUnclaimedBudgetResponse, error)
+	// PendingMilestones queries the milestones of milestone-gated budgets that are
+	// still waiting for approval.
+	PendingMilestones(ctx context.Context, in *QueryPendingMilestonesRequest, opts ...grpc.CallOption) (*QueryPendingMilestonesResponse, error)
 }
 
 type queryClient struct {
@@ -351,12 +577,24 @@ func (c *queryClient) UnclaimedBudget(ctx context.Context, in *QueryUnclaimedBud
 	return out, nil
 }
 
+func (c *queryClient) PendingMilestones(ctx context.Context, in *QueryPendingMilestonesRequest, opts ...grpc.CallOption) (*QueryPendingMilestonesResponse, error) {
+	out := new(QueryPendingMilestonesResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Query/PendingMilestones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// CommunityPool queries the community pool coins.
 	CommunityPool(context.Context, *QueryCommunityPoolRequest) (*QueryCommunityPoolResponse, error)
 	// UnclaimedBudget queries the remaining budget left to be claimed and it gives overall budget allocation view.
 	UnclaimedBudget(context.Context, *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error)
+	// PendingMilestones queries the milestones of milestone-gated budgets that are
+	// still waiting for approval.
+	PendingMilestones(context.Context, *QueryPendingMilestonesRequest) (*QueryPendingMilestonesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -369,6 +607,9 @@ func (*UnimplementedQueryServer) CommunityPool(ctx context.Context, req *QueryCo
 func (*UnimplementedQueryServer) UnclaimedBudget(ctx context.Context, req *QueryUnclaimedBudgetRequest) (*QueryUnclaimedBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnclaimedBudget not implemented")
 }
+func (*UnimplementedQueryServer) PendingMilestones(ctx context.Context, req *QueryPendingMilestonesRequest) (*QueryPendingMilestonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingMilestones not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -410,6 +651,24 @@ func _Query_UnclaimedBudget_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingMilestones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingMilestonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingMilestones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Query/PendingMilestones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingMilestones(ctx, req.(*QueryPendingMilestonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.protocolpool.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -422,6 +681,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnclaimedBudget",
 			Handler:    _Query_UnclaimedBudget_Handler,
 		},
+		{
+			MethodName: "PendingMilestones",
+			Handler:    _Query_PendingMilestones_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/protocolpool/v1/query.proto",
@@ -537,6 +800,27 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MilestoneApprover) > 0 {
+		i -= len(m.MilestoneApprover)
+		copy(dAtA[i:], m.MilestoneApprover)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MilestoneApprover)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.TranchesLeft != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.TranchesLeft))
 		i--
@@ -562,9 +846,60 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.UnclaimedAmount != nil {
+	if len(m.UnclaimedAmount) > 0 {
+		for iNdEx := len(m.UnclaimedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnclaimedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClaimedAmount) > 0 {
+		for iNdEx := len(m.ClaimedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMilestonesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMilestonesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMilestonesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.UnclaimedAmount.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -574,9 +909,39 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ClaimedAmount != nil {
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingMilestonesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingMilestonesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingMilestonesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.ClaimedAmount.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -584,6 +949,69 @@ func (m *QueryUnclaimedBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -643,13 +1071,17 @@ func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.ClaimedAmount != nil {
-		l = m.ClaimedAmount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClaimedAmount) > 0 {
+		for _, e := range m.ClaimedAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
-	if m.UnclaimedAmount != nil {
-		l = m.UnclaimedAmount.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.UnclaimedAmount) > 0 {
+		for _, e := range m.UnclaimedAmount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	if m.NextClaimFrom != nil {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextClaimFrom)
@@ -662,6 +1094,76 @@ func (m *QueryUnclaimedBudgetResponse) Size() (n int) {
 	if m.TranchesLeft != 0 {
 		n += 1 + sovQuery(uint64(m.TranchesLeft))
 	}
+	l = len(m.MilestoneApprover)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingMilestonesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingMilestonesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -945,10 +1447,8 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClaimedAmount == nil {
-				m.ClaimedAmount = &types.Coin{}
-			}
-			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimedAmount = append(m.ClaimedAmount, types.Coin{})
+			if err := m.ClaimedAmount[len(m.ClaimedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -981,10 +1481,8 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UnclaimedAmount == nil {
-				m.UnclaimedAmount = &types.Coin{}
-			}
-			if err := m.UnclaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnclaimedAmount = append(m.UnclaimedAmount, types.Coin{})
+			if err := m.UnclaimedAmount[len(m.UnclaimedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1079,6 +1577,475 @@ func (m *QueryUnclaimedBudgetResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneApprover", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MilestoneApprover = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMilestonesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMilestonesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMilestonesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingMilestonesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingMilestonesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingMilestonesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, PendingMilestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
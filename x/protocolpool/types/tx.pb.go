@@ -224,7 +224,7 @@ type MsgSubmitBudgetProposal struct {
 	// RecipientAddress is the address of the recipient who can claim the budget.
 	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
 	// BudgetPerTranche is the amount allocated per tranche.
-	BudgetPerTranche *types.Coin `protobuf:"bytes,3,opt,name=budget_per_tranche,json=budgetPerTranche,proto3" json:"budget_per_tranche,omitempty"`
+	BudgetPerTranche github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=budget_per_tranche,json=budgetPerTranche,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget_per_tranche"`
 	// StartTime is the time when the budget becomes claimable.
 	// If StartTime is less than the current block time, proposal will not be accepted.
 	StartTime *time.Time `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
@@ -233,7 +233,14 @@ type MsgSubmitBudgetProposal struct {
 	// Period is the time interval(number of seconds) at which funds distribution should be performed.
 	// For example, if a period is set to 3600, it represents an action that
 	// should occur every hour (3600 seconds).
+	// Period is optional for milestone-gated budgets.
 	Period *time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// MilestoneApprover is the address allowed to approve the budget milestones.
+	// If set, the budget is milestone-gated and milestones must be provided.
+	MilestoneApprover string `protobuf:"bytes,7,opt,name=milestone_approver,json=milestoneApprover,proto3" json:"milestone_approver,omitempty"`
+	// Milestones are the descriptions of the milestones gating each tranche.
+	// If set, tranches must either be zero or equal to the number of milestones.
+	Milestones []string `protobuf:"bytes,8,rep,name=milestones,proto3" json:"milestones,omitempty"`
 }
 
 func (m *MsgSubmitBudgetProposal) Reset()         { *m = MsgSubmitBudgetProposal{} }
@@ -283,7 +290,7 @@ func (m *MsgSubmitBudgetProposal) GetRecipientAddress() string {
 	return ""
 }
 
-func (m *MsgSubmitBudgetProposal) GetBudgetPerTranche() *types.Coin {
+func (m *MsgSubmitBudgetProposal) GetBudgetPerTranche() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.BudgetPerTranche
 	}
@@ -311,6 +318,20 @@ func (m *MsgSubmitBudgetProposal) GetPeriod() *time.Duration {
 	return nil
 }
 
+func (m *MsgSubmitBudgetProposal) GetMilestoneApprover() string {
+	if m != nil {
+		return m.MilestoneApprover
+	}
+	return ""
+}
+
+func (m *MsgSubmitBudgetProposal) GetMilestones() []string {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
 // MsgSubmitBudgetProposalResponse defines the response to executing a
 // MsgSubmitBudgetProposal message.
 type MsgSubmitBudgetProposalResponse struct {
@@ -397,7 +418,7 @@ func (m *MsgClaimBudget) GetRecipientAddress() string {
 // MsgClaimBudgetResponse defines the response to executing a
 // MsgClaimBudget message.
 type MsgClaimBudgetResponse struct {
-	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
 func (m *MsgClaimBudgetResponse) Reset()         { *m = MsgClaimBudgetResponse{} }
@@ -433,12 +454,116 @@ func (m *MsgClaimBudgetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimBudgetResponse proto.InternalMessageInfo
 
-func (m *MsgClaimBudgetResponse) GetAmount() types.Coin {
+func (m *MsgClaimBudgetResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
-	return types.Coin{}
+	return nil
+}
+
+// MsgApproveMilestone defines a message for approving the next pending milestone
+// of a milestone-gated budget.
+type MsgApproveMilestone struct {
+	// Approver is the milestone approver of the budget.
+	Approver string `protobuf:"bytes,1,opt,name=approver,proto3" json:"approver,omitempty"`
+	// RecipientAddress is the address of the budget recipient.
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// MilestoneIndex is the index of the milestone to approve. Milestones must be
+	// approved in order.
+	MilestoneIndex uint64 `protobuf:"varint,3,opt,name=milestone_index,json=milestoneIndex,proto3" json:"milestone_index,omitempty"`
+}
+
+func (m *MsgApproveMilestone) Reset()         { *m = MsgApproveMilestone{} }
+func (m *MsgApproveMilestone) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMilestone) ProtoMessage()    {}
+func (*MsgApproveMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{8}
+}
+func (m *MsgApproveMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMilestone.Merge(m, src)
+}
+func (m *MsgApproveMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveMilestone proto.InternalMessageInfo
+
+func (m *MsgApproveMilestone) GetApprover() string {
+	if m != nil {
+		return m.Approver
+	}
+	return ""
+}
+
+func (m *MsgApproveMilestone) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *MsgApproveMilestone) GetMilestoneIndex() uint64 {
+	if m != nil {
+		return m.MilestoneIndex
+	}
+	return 0
+}
+
+// MsgApproveMilestoneResponse defines the response to executing a
+// MsgApproveMilestone message.
+type MsgApproveMilestoneResponse struct {
+}
+
+func (m *MsgApproveMilestoneResponse) Reset()         { *m = MsgApproveMilestoneResponse{} }
+func (m *MsgApproveMilestoneResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveMilestoneResponse) ProtoMessage()    {}
+func (*MsgApproveMilestoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_09efe14517e7f6dc, []int{9}
+}
+func (m *MsgApproveMilestoneResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
+func (m *MsgApproveMilestoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveMilestoneResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveMilestoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveMilestoneResponse.Merge(m, src)
+}
+func (m *MsgApproveMilestoneResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveMilestoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveMilestoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveMilestoneResponse proto.InternalMessageInfo
 
 // MsgCreateContinuousFund defines a message for adding continuous funds.
 type MsgCreateContinuousFund struct {
@@ -456,7 +581,7 @@ func (m *MsgCreateContinuousFund) Reset()         { *m = MsgCreateContinuousFund
 func (m *MsgCreateContinuousFund) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContinuousFund) ProtoMessage()    {}
 func (*MsgCreateContinuousFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{8}
+	return fileDescriptor_09efe14517e7f6dc, []int{10}
 }
 func (m *MsgCreateContinuousFund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +640,7 @@ func (m *MsgCreateContinuousFundResponse) Reset()         { *m = MsgCreateContin
 func (m *MsgCreateContinuousFundResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateContinuousFundResponse) ProtoMessage()    {}
 func (*MsgCreateContinuousFundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{9}
+	return fileDescriptor_09efe14517e7f6dc, []int{11}
 }
 func (m *MsgCreateContinuousFundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -556,7 +681,7 @@ func (m *MsgCancelContinuousFund) Reset()         { *m = MsgCancelContinuousFund
 func (m *MsgCancelContinuousFund) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelContinuousFund) ProtoMessage()    {}
 func (*MsgCancelContinuousFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{10}
+	return fileDescriptor_09efe14517e7f6dc, []int{12}
 }
 func (m *MsgCancelContinuousFund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +743,7 @@ func (m *MsgCancelContinuousFundResponse) Reset()         { *m = MsgCancelContin
 func (m *MsgCancelContinuousFundResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelContinuousFundResponse) ProtoMessage()    {}
 func (*MsgCancelContinuousFundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{11}
+	return fileDescriptor_09efe14517e7f6dc, []int{13}
 }
 func (m *MsgCancelContinuousFundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -684,7 +809,7 @@ func (m *MsgWithdrawContinuousFund) Reset()         { *m = MsgWithdrawContinuous
 func (m *MsgWithdrawContinuousFund) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawContinuousFund) ProtoMessage()    {}
 func (*MsgWithdrawContinuousFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{12}
+	return fileDescriptor_09efe14517e7f6dc, []int{14}
 }
 func (m *MsgWithdrawContinuousFund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +855,7 @@ func (m *MsgWithdrawContinuousFundResponse) Reset()         { *m = MsgWithdrawCo
 func (m *MsgWithdrawContinuousFundResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawContinuousFundResponse) ProtoMessage()    {}
 func (*MsgWithdrawContinuousFundResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_09efe14517e7f6dc, []int{13}
+	return fileDescriptor_09efe14517e7f6dc, []int{15}
 }
 func (m *MsgWithdrawContinuousFundResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,6 +900,8 @@ func init() {
 	proto.RegisterType((*MsgSubmitBudgetProposalResponse)(nil), "cosmos.protocolpool.v1.MsgSubmitBudgetProposalResponse")
 	proto.RegisterType((*MsgClaimBudget)(nil), "cosmos.protocolpool.v1.MsgClaimBudget")
 	proto.RegisterType((*MsgClaimBudgetResponse)(nil), "cosmos.protocolpool.v1.MsgClaimBudgetResponse")
+	proto.RegisterType((*MsgApproveMilestone)(nil), "cosmos.protocolpool.v1.MsgApproveMilestone")
+	proto.RegisterType((*MsgApproveMilestoneResponse)(nil), "cosmos.protocolpool.v1.MsgApproveMilestoneResponse")
 	proto.RegisterType((*MsgCreateContinuousFund)(nil), "cosmos.protocolpool.v1.MsgCreateContinuousFund")
 	proto.RegisterType((*MsgCreateContinuousFundResponse)(nil), "cosmos.protocolpool.v1.MsgCreateContinuousFundResponse")
 	proto.RegisterType((*MsgCancelContinuousFund)(nil), "cosmos.protocolpool.v1.MsgCancelContinuousFund")
@@ -786,70 +913,77 @@ func init() {
 func init() { proto.RegisterFile("cosmos/protocolpool/v1/tx.proto", fileDescriptor_09efe14517e7f6dc) }
 
 var fileDescriptor_09efe14517e7f6dc = []byte{
-	// 1006 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc4, 0xae, 0x55, 0x4f, 0x21, 0xb4, 0xab, 0xe0, 0x6e, 0x96, 0xe2, 0x4d, 0x7c, 0x80,
-	0xa8, 0x22, 0xbb, 0xb8, 0x40, 0x0b, 0xe1, 0x80, 0xea, 0x84, 0x5f, 0x12, 0x96, 0xc2, 0xa6, 0x12,
-	0x12, 0x17, 0x6b, 0xbc, 0x3b, 0x5d, 0x8f, 0xba, 0xbb, 0xb3, 0xda, 0x99, 0x4d, 0xe2, 0x4a, 0x48,
-	0x15, 0x12, 0xd0, 0x63, 0x8f, 0x1c, 0x7b, 0x42, 0x88, 0x53, 0x0f, 0xfd, 0x23, 0x2a, 0x71, 0xa9,
-	0x7a, 0x42, 0x1c, 0x5a, 0x94, 0x20, 0x95, 0xbf, 0x81, 0x13, 0xda, 0x9d, 0xf1, 0xda, 0x8e, 0xd7,
-	0x9b, 0xba, 0x4a, 0x38, 0xd9, 0x7e, 0xf3, 0xbe, 0xf7, 0xbe, 0xf7, 0xcd, 0x7b, 0x33, 0x63, 0xa8,
-	0xdb, 0x94, 0xf9, 0x94, 0x99, 0x61, 0x44, 0x39, 0xb5, 0xa9, 0x17, 0x52, 0xea, 0x99, 0xbb, 0x2d,
-	0x93, 0xef, 0x1b, 0xa9, 0x49, 0xa9, 0x0b, 0x07, 0x63, 0xdc, 0xc1, 0xd8, 0x6d, 0x69, 0x4b, 0x2e,
-	0x75, 0x69, 0x6a, 0x34, 0x93, 0x6f, 0x62, 0x5d, 0x6b, 0xc8, 0x70, 0x3d, 0xc4, 0xb0, 0xb9, 0xdb,
-	0xea, 0x61, 0x8e, 0x5a, 0xa6, 0x4d, 0x49, 0x20, 0xd7, 0x97, 0xc5, 0x7a, 0x57, 0x00, 0xc7, 0x43,
-	0x6b, 0x17, 0x25, 0xd4, 0x67, 0x6e, 0x42, 0xc0, 0x67, 0xae, 0x5c, 0xd0, 0x5d, 0x4a, 0x5d, 0x0f,
-	0x0b, 0x8a, 0xbd, 0xf8, 0xa6, 0xc9, 0x89, 0x8f, 0x19, 0x47, 0x7e, 0x38, 0x4c, 0x7a, 0xd4, 0xc1,
-	0x89, 0x23, 0xc4, 0x09, 0x95, 0x49, 0x9b, 0xbf, 0x03, 0xb8, 0xd4, 0x61, 0xee, 0x67, 0x71, 0xe0,
-	0x6c, 0x52, 0xdf, 0x8f, 0x03, 0xc2, 0x07, 0xdb, 0x94, 0x7a, 0x8a, 0x0d, 0xab, 0xc8, 0xa7, 0x71,
-	0xc0, 0x55, 0xb0, 0x52, 0x5e, 0x3b, 0x77, 0x65, 0xd9, 0x90, 0x8c, 0x12, 0xfa, 0x86, 0xa4, 0x6f,
-	0x6c, 0x52, 0x12, 0xb4, 0xdf, 0x7d, 0xf4, 0x54, 0x2f, 0xfd, 0xf6, 0x4c, 0x5f, 0x73, 0x09, 0xef,
-	0xc7, 0x3d, 0xc3, 0xa6, 0xbe, 0xa4, 0x2f, 0x3f, 0xd6, 0x99, 0x73, 0xcb, 0xe4, 0x83, 0x10, 0xb3,
-	0x14, 0xc0, 0x2c, 0x19, 0x5a, 0xb9, 0x0a, 0x6b, 0x0e, 0x0e, 0x29, 0x23, 0x9c, 0x46, 0xea, 0xc2,
-	0x0a, 0x58, 0xab, 0xb5, 0xd5, 0x27, 0x0f, 0xd7, 0x97, 0x64, 0xaa, 0xeb, 0x8e, 0x13, 0x61, 0xc6,
-	0x76, 0x78, 0x44, 0x02, 0xd7, 0x1a, 0xb9, 0x6e, 0xd4, 0xef, 0xde, 0xd7, 0x4b, 0xff, 0xdc, 0xd7,
-	0x4b, 0xdf, 0x3f, 0x7f, 0x70, 0x79, 0x64, 0x6f, 0x36, 0xe0, 0xa5, 0xbc, 0x62, 0x2c, 0xcc, 0x42,
-	0x1a, 0x30, 0xdc, 0x3c, 0x00, 0xf0, 0xf5, 0x0e, 0x73, 0x27, 0x16, 0x77, 0x42, 0x1c, 0x38, 0x09,
-	0x13, 0x14, 0xf3, 0x3e, 0x8d, 0x08, 0x1f, 0xa8, 0xe0, 0x38, 0x26, 0x99, 0xab, 0x72, 0x09, 0xd6,
-	0x22, 0x6c, 0x93, 0x90, 0xe0, 0x80, 0x8b, 0x0a, 0xac, 0x91, 0x61, 0x4c, 0xc4, 0xf2, 0xa9, 0x89,
-	0xb8, 0xb1, 0x98, 0x8a, 0x90, 0x51, 0x6a, 0xea, 0xf0, 0xcd, 0xdc, 0x1a, 0x33, 0x15, 0x7e, 0x2a,
-	0xc3, 0x8b, 0x1d, 0xe6, 0xee, 0xc4, 0x3d, 0x9f, 0xf0, 0x76, 0xec, 0xb8, 0x98, 0x6f, 0x47, 0x34,
-	0xa4, 0x0c, 0x79, 0x2f, 0xad, 0xc3, 0xa7, 0xf0, 0x42, 0x56, 0x76, 0x17, 0x09, 0xaf, 0x63, 0x77,
-	0xf4, 0x7c, 0x06, 0x91, 0x76, 0xe5, 0x73, 0xa8, 0xf4, 0x52, 0x42, 0xdd, 0x10, 0x47, 0x5d, 0x1e,
-	0xa1, 0xc0, 0xee, 0x63, 0xb5, 0xbc, 0x02, 0x0a, 0xc5, 0xb3, 0xce, 0x0b, 0xd0, 0x36, 0x8e, 0x6e,
-	0x08, 0x88, 0xf2, 0x09, 0x84, 0x8c, 0xa3, 0x88, 0x77, 0x93, 0x81, 0x50, 0x2b, 0x69, 0x00, 0xcd,
-	0x10, 0xc3, 0x60, 0x0c, 0x87, 0xc1, 0xb8, 0x31, 0x9c, 0x96, 0x76, 0xe5, 0xde, 0x33, 0x1d, 0x58,
-	0xb5, 0x14, 0x93, 0x58, 0x15, 0x0d, 0x9e, 0x95, 0xe9, 0x99, 0x7a, 0x66, 0x05, 0xac, 0x55, 0xac,
-	0xec, 0xb7, 0x72, 0x0d, 0x56, 0x43, 0x1c, 0x11, 0xea, 0xa8, 0x55, 0xc9, 0xec, 0x68, 0xe0, 0x2d,
-	0x39, 0x65, 0xed, 0xca, 0xcf, 0x49, 0x5c, 0xe9, 0x3e, 0xb5, 0x55, 0xab, 0x50, 0x9f, 0xb1, 0x11,
-	0xd9, 0x66, 0x51, 0xb8, 0x98, 0xec, 0xa6, 0x87, 0x88, 0x2f, 0x3c, 0xf2, 0xa5, 0x06, 0xf3, 0x4a,
-	0xbd, 0x51, 0x4f, 0xb8, 0x4c, 0x47, 0x6a, 0x7e, 0x07, 0xeb, 0x93, 0x09, 0x87, 0x54, 0x26, 0x8e,
-	0x04, 0x70, 0x4a, 0xdd, 0xdc, 0xfc, 0x65, 0x21, 0x6d, 0xce, 0xcd, 0x08, 0x23, 0x8e, 0x37, 0x69,
-	0xc0, 0x49, 0x10, 0xd3, 0x98, 0x25, 0x33, 0xfd, 0xd2, 0xcd, 0x79, 0x75, 0x6a, 0x48, 0x8b, 0x70,
-	0xa3, 0xf1, 0xfd, 0x1a, 0xc2, 0x10, 0x47, 0x36, 0x0e, 0x38, 0x72, 0x45, 0x17, 0xd6, 0xda, 0xad,
-	0xa4, 0xb2, 0x3f, 0x9f, 0xea, 0x6f, 0x08, 0x30, 0x73, 0x6e, 0x19, 0x84, 0x9a, 0x3e, 0xe2, 0x7d,
-	0xe3, 0x2b, 0xec, 0x22, 0x7b, 0xb0, 0x85, 0xed, 0x27, 0x0f, 0xd7, 0xa1, 0x8c, 0xbd, 0x85, 0x6d,
-	0x6b, 0x2c, 0x88, 0xf2, 0x21, 0xac, 0xe2, 0xfd, 0x90, 0x44, 0x83, 0x17, 0xee, 0x49, 0xe9, 0x3f,
-	0xa3, 0x77, 0xf2, 0x74, 0xca, 0x7a, 0xe7, 0x57, 0x20, 0xb4, 0x44, 0x81, 0x8d, 0xbd, 0x13, 0xd2,
-	0xf2, 0x64, 0x06, 0x7d, 0xaa, 0x9a, 0xbf, 0x17, 0x44, 0x39, 0x39, 0x54, 0xb3, 0xfe, 0xfb, 0x12,
-	0xbe, 0x6a, 0xa7, 0xeb, 0xd8, 0x11, 0x63, 0x0d, 0x8e, 0x95, 0xf0, 0x6c, 0xb2, 0x5b, 0xa9, 0x8c,
-	0xaf, 0x0c, 0xa1, 0xe9, 0x74, 0xbf, 0x0d, 0x5f, 0xcb, 0x42, 0xf5, 0x31, 0x71, 0xfb, 0xa2, 0x2f,
-	0x2a, 0xd6, 0xe2, 0xd0, 0xfc, 0x45, 0x6a, 0xcd, 0x2f, 0xb7, 0x3c, 0xf7, 0xb9, 0xf6, 0x03, 0x80,
-	0xea, 0x1e, 0xe1, 0x7d, 0x27, 0x42, 0x7b, 0x41, 0x17, 0x79, 0x1e, 0xb5, 0x11, 0xc7, 0x4e, 0xf7,
-	0x66, 0x1c, 0x38, 0xb2, 0x13, 0x4e, 0x74, 0x9a, 0xea, 0x59, 0xb2, 0xeb, 0xc3, 0x5c, 0x89, 0x94,
-	0xcd, 0xdb, 0x70, 0xb9, 0xc3, 0xdc, 0x6f, 0xe4, 0xe2, 0x91, 0x96, 0x38, 0xe5, 0x83, 0xe5, 0x2e,
-	0x80, 0xab, 0x33, 0x93, 0xff, 0xaf, 0x87, 0xcc, 0x95, 0x7f, 0xab, 0xb0, 0xdc, 0x61, 0xae, 0xb2,
-	0x07, 0x2f, 0x4c, 0xbf, 0x7c, 0xde, 0x31, 0xf2, 0x9f, 0x75, 0x46, 0xde, 0xd3, 0x42, 0x7b, 0x7f,
-	0x1e, 0xef, 0xac, 0xca, 0xdb, 0x50, 0xc9, 0x79, 0x84, 0xac, 0x17, 0xc4, 0x9a, 0x76, 0xd7, 0x3e,
-	0x98, 0xcb, 0x3d, 0xcb, 0x7d, 0x07, 0xc0, 0xa5, 0xdc, 0xbb, 0xdf, 0x2c, 0x88, 0x97, 0x07, 0xd0,
-	0xae, 0xcd, 0x09, 0xc8, 0x28, 0x60, 0x78, 0x6e, 0xfc, 0x46, 0x7b, 0xab, 0xa8, 0x90, 0x91, 0x9f,
-	0x66, 0xbc, 0x98, 0xdf, 0x44, 0xa5, 0xb9, 0x17, 0x49, 0x51, 0xa5, 0x79, 0x80, 0xc2, 0x4a, 0x8b,
-	0x8e, 0x60, 0xe5, 0x47, 0x00, 0xeb, 0x33, 0xc6, 0xad, 0x55, 0x10, 0x33, 0x1f, 0xa2, 0x7d, 0x34,
-	0x37, 0x64, 0x52, 0x8b, 0xbc, 0x8b, 0xa0, 0x50, 0x8b, 0x1c, 0x40, 0xb1, 0x16, 0x05, 0xe7, 0xb7,
-	0x76, 0xe6, 0xce, 0xf3, 0x07, 0x97, 0x41, 0xfb, 0xe3, 0x47, 0x07, 0x0d, 0xf0, 0xf8, 0xa0, 0x01,
-	0xfe, 0x3a, 0x68, 0x80, 0x7b, 0x87, 0x8d, 0xd2, 0xe3, 0xc3, 0x46, 0xe9, 0x8f, 0xc3, 0x46, 0xe9,
-	0xdb, 0xd5, 0x89, 0x3b, 0x75, 0x7f, 0xf2, 0x8f, 0x57, 0x3a, 0xc7, 0xbd, 0x6a, 0x6a, 0x7b, 0xef,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3f, 0xbb, 0x27, 0x2e, 0x9c, 0x0d, 0x00, 0x00,
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xd4, 0x6e, 0x1a, 0xbf, 0x7e, 0x9b, 0x6f, 0xb2, 0x04, 0x77, 0xb3, 0x6d, 0xbd, 0x89,
+	0x0f, 0x60, 0x15, 0xb2, 0xc6, 0x69, 0x69, 0x20, 0x1c, 0x50, 0x9c, 0xf0, 0xa3, 0x12, 0x96, 0x82,
+	0x53, 0x09, 0x89, 0x8b, 0xb5, 0xde, 0x9d, 0xae, 0x47, 0xf5, 0xee, 0xac, 0x76, 0x66, 0x93, 0xb8,
+	0x12, 0x52, 0x85, 0x04, 0xea, 0xb1, 0x47, 0x8e, 0x15, 0x07, 0x84, 0x38, 0xf5, 0xd0, 0x3f, 0xa2,
+	0x82, 0x4b, 0xd5, 0x13, 0xe2, 0xd0, 0xa2, 0x04, 0xa9, 0x1c, 0xf9, 0x13, 0xd0, 0xee, 0x8e, 0xc7,
+	0x76, 0xbc, 0x71, 0xea, 0x2a, 0xe1, 0x94, 0xe4, 0xcd, 0xfb, 0xbc, 0xf7, 0x79, 0x9f, 0x7d, 0xef,
+	0xcd, 0x04, 0x74, 0x8b, 0x32, 0x97, 0xb2, 0x8a, 0x1f, 0x50, 0x4e, 0x2d, 0xda, 0xf1, 0x29, 0xed,
+	0x54, 0x76, 0xaa, 0x15, 0xbe, 0x67, 0xc4, 0x26, 0xa5, 0x90, 0x38, 0x18, 0x83, 0x0e, 0xc6, 0x4e,
+	0x55, 0x9b, 0x77, 0xa8, 0x43, 0x63, 0x63, 0x25, 0xfa, 0x2d, 0x39, 0xd7, 0x8a, 0x22, 0x5c, 0xcb,
+	0x64, 0xb8, 0xb2, 0x53, 0x6d, 0x61, 0x6e, 0x56, 0x2b, 0x16, 0x25, 0x9e, 0x38, 0x5f, 0x48, 0xce,
+	0x9b, 0x09, 0x70, 0x30, 0xb4, 0x76, 0x51, 0x40, 0x5d, 0xe6, 0x44, 0x04, 0x5c, 0xe6, 0x88, 0x03,
+	0xdd, 0xa1, 0xd4, 0xe9, 0xe0, 0x84, 0x62, 0x2b, 0xbc, 0x5d, 0xe1, 0xc4, 0xc5, 0x8c, 0x9b, 0xae,
+	0xdf, 0x4b, 0x7a, 0xd8, 0xc1, 0x0e, 0x03, 0x93, 0x13, 0x2a, 0x92, 0x96, 0x7e, 0x43, 0x30, 0x5f,
+	0x67, 0xce, 0xa7, 0xa1, 0x67, 0x6f, 0x50, 0xd7, 0x0d, 0x3d, 0xc2, 0xbb, 0x5b, 0x94, 0x76, 0x14,
+	0x0b, 0xa6, 0x4c, 0x97, 0x86, 0x1e, 0x57, 0xd1, 0x62, 0xb6, 0x7c, 0x7e, 0x65, 0xc1, 0x10, 0x8c,
+	0x22, 0xfa, 0x86, 0xa0, 0x6f, 0x6c, 0x50, 0xe2, 0xd5, 0xde, 0x7b, 0xf2, 0x5c, 0xcf, 0xfc, 0xf2,
+	0x42, 0x2f, 0x3b, 0x84, 0xb7, 0xc3, 0x96, 0x61, 0x51, 0x57, 0xd0, 0x17, 0x3f, 0x96, 0x99, 0x7d,
+	0xa7, 0xc2, 0xbb, 0x3e, 0x66, 0x31, 0x80, 0x35, 0x44, 0x68, 0xe5, 0x06, 0xe4, 0x6d, 0xec, 0x53,
+	0x46, 0x38, 0x0d, 0xd4, 0x33, 0x8b, 0xa8, 0x9c, 0xaf, 0xa9, 0xcf, 0x1e, 0x2f, 0xcf, 0x8b, 0x54,
+	0xeb, 0xb6, 0x1d, 0x60, 0xc6, 0xb6, 0x79, 0x40, 0x3c, 0xa7, 0xd1, 0x77, 0x5d, 0x2b, 0xdc, 0x7f,
+	0xa8, 0x67, 0xfe, 0x7e, 0xa8, 0x67, 0xbe, 0x7d, 0xf9, 0xe8, 0x6a, 0xdf, 0x5e, 0x2a, 0xc2, 0xe5,
+	0xb4, 0x62, 0x1a, 0x98, 0xf9, 0xd4, 0x63, 0xb8, 0xb4, 0x8f, 0xe0, 0xcd, 0x3a, 0x73, 0x86, 0x0e,
+	0xb7, 0x7d, 0xec, 0xd9, 0x11, 0x13, 0x33, 0xe4, 0x6d, 0x1a, 0x10, 0xde, 0x55, 0xd1, 0x71, 0x4c,
+	0xa4, 0xab, 0x72, 0x19, 0xf2, 0x01, 0xb6, 0x88, 0x4f, 0xb0, 0xc7, 0x93, 0x0a, 0x1a, 0x7d, 0xc3,
+	0x80, 0x88, 0xd9, 0x53, 0x13, 0x71, 0x6d, 0x26, 0x16, 0x41, 0x52, 0x2a, 0xe9, 0x70, 0x25, 0xb5,
+	0x46, 0xa9, 0xc2, 0x8f, 0x39, 0xb8, 0x58, 0x67, 0xce, 0x76, 0xd8, 0x72, 0x09, 0xaf, 0x85, 0xb6,
+	0x83, 0xf9, 0x56, 0x40, 0x7d, 0xca, 0xcc, 0xce, 0x6b, 0xeb, 0xf0, 0x09, 0xcc, 0xc9, 0xb2, 0x9b,
+	0x66, 0xe2, 0x75, 0xec, 0x17, 0x9d, 0x95, 0x10, 0x61, 0x57, 0xba, 0xa0, 0xb4, 0x62, 0x42, 0x4d,
+	0x1f, 0x07, 0x4d, 0x1e, 0x98, 0x9e, 0xd5, 0xc6, 0xa7, 0x21, 0xde, 0x6c, 0x92, 0x66, 0x0b, 0x07,
+	0xb7, 0x92, 0x24, 0xca, 0xc7, 0x00, 0x8c, 0x9b, 0x01, 0x6f, 0x46, 0x23, 0xa4, 0xe6, 0x16, 0x51,
+	0xf9, 0xfc, 0x8a, 0x66, 0x24, 0xe3, 0x63, 0xf4, 0xc6, 0xc7, 0xb8, 0xd5, 0x9b, 0xaf, 0x5a, 0xee,
+	0xc1, 0x0b, 0x1d, 0x35, 0xf2, 0x31, 0x26, 0xb2, 0x2a, 0x1a, 0x4c, 0x0b, 0xc2, 0x4c, 0x3d, 0xbb,
+	0x88, 0xca, 0xb9, 0x86, 0xfc, 0x5b, 0x59, 0x85, 0x29, 0x1f, 0x07, 0x84, 0xda, 0xea, 0x54, 0x1c,
+	0x78, 0x61, 0x24, 0xf0, 0xa6, 0x98, 0xcb, 0x5a, 0xee, 0x87, 0x28, 0xae, 0x70, 0x57, 0x3e, 0x03,
+	0xc5, 0x25, 0x1d, 0xcc, 0x38, 0xf5, 0x70, 0xd3, 0xf4, 0xfd, 0x80, 0xee, 0xe0, 0x40, 0x3d, 0x77,
+	0x8c, 0xb0, 0x73, 0x12, 0xb3, 0x2e, 0x20, 0x4a, 0x11, 0x40, 0x1a, 0x99, 0x3a, 0xbd, 0x98, 0x2d,
+	0xe7, 0x1b, 0x03, 0x96, 0x91, 0x2e, 0x5a, 0x02, 0xfd, 0x88, 0x1e, 0x91, 0x7d, 0x44, 0x61, 0x26,
+	0x6a, 0xb4, 0x8e, 0x49, 0xdc, 0xc4, 0x23, 0xbd, 0x0b, 0xd0, 0xa4, 0x5d, 0xb0, 0x56, 0x88, 0xb8,
+	0x8c, 0x46, 0x2a, 0x7d, 0x03, 0x85, 0xe1, 0x84, 0x3d, 0x2a, 0xff, 0xc9, 0xb6, 0x2a, 0xfd, 0x8a,
+	0xe0, 0x8d, 0x3a, 0x73, 0x84, 0xa4, 0xf5, 0x9e, 0x76, 0xca, 0x75, 0x98, 0x96, 0x5f, 0xe6, 0xb8,
+	0x62, 0xa5, 0xe7, 0x49, 0x4d, 0xcc, 0xdb, 0xf0, 0xff, 0x7e, 0x83, 0x10, 0xcf, 0xc6, 0x7b, 0x6a,
+	0x36, 0x6e, 0xbe, 0x19, 0x69, 0xbe, 0x19, 0x59, 0xd7, 0x2e, 0x44, 0xa2, 0xca, 0xf4, 0xa5, 0x2b,
+	0x70, 0x29, 0xa5, 0x16, 0xf9, 0x6d, 0x7f, 0x3a, 0x13, 0xef, 0x88, 0x8d, 0x00, 0x9b, 0x1c, 0x6f,
+	0x50, 0x8f, 0x13, 0x2f, 0xa4, 0x21, 0x8b, 0x56, 0xeb, 0x6b, 0xef, 0x88, 0x1b, 0x23, 0xbb, 0x72,
+	0x1c, 0xae, 0xbf, 0x45, 0xbf, 0x04, 0xf0, 0x71, 0x60, 0x61, 0x8f, 0x9b, 0x0e, 0x8e, 0xab, 0xcb,
+	0xd7, 0xaa, 0xd1, 0x57, 0xfc, 0xe3, 0xb9, 0x7e, 0x29, 0x01, 0x33, 0xfb, 0x8e, 0x41, 0x68, 0xc5,
+	0x35, 0x79, 0xdb, 0xf8, 0x02, 0x3b, 0xa6, 0xd5, 0xdd, 0xc4, 0xd6, 0xb3, 0xc7, 0xcb, 0x20, 0x62,
+	0x6f, 0x62, 0xab, 0x31, 0x10, 0x44, 0xf9, 0x00, 0xa6, 0xf0, 0x9e, 0x4f, 0x82, 0xee, 0x2b, 0x0f,
+	0xba, 0xf0, 0x3f, 0x62, 0x4e, 0xd2, 0x74, 0x92, 0x5a, 0xfe, 0x8c, 0x12, 0x2d, 0x4d, 0xcf, 0xc2,
+	0x9d, 0x13, 0xd2, 0xf2, 0x64, 0xba, 0x67, 0xa4, 0x9a, 0xbf, 0xce, 0x80, 0x7e, 0x04, 0x55, 0x39,
+	0x6b, 0x37, 0xe1, 0x82, 0x15, 0x9f, 0x63, 0x3b, 0xd9, 0x95, 0xe8, 0x58, 0x09, 0xa7, 0xa3, 0xaf,
+	0x15, 0xcb, 0xf8, 0xbf, 0x1e, 0x34, 0x3a, 0x8c, 0x9a, 0x57, 0x86, 0x6a, 0x63, 0xe2, 0xb4, 0x93,
+	0xbe, 0xc8, 0x35, 0x66, 0x7a, 0xe6, 0xcf, 0x63, 0x6b, 0x7a, 0xb9, 0xd9, 0x89, 0x87, 0xe5, 0x3b,
+	0x04, 0xea, 0x2e, 0xe1, 0x6d, 0x3b, 0x30, 0x77, 0xbd, 0xa6, 0xd9, 0xe9, 0x50, 0xcb, 0xe4, 0xd8,
+	0x6e, 0xde, 0x0e, 0x3d, 0x5b, 0x74, 0xc2, 0x89, 0x6e, 0x8e, 0x82, 0x4c, 0xb6, 0xde, 0xcb, 0x15,
+	0x49, 0x59, 0xba, 0x0b, 0x0b, 0x75, 0xe6, 0x7c, 0x25, 0x0e, 0x0f, 0xb5, 0xc4, 0x29, 0x2f, 0xd1,
+	0xfb, 0x08, 0x96, 0x8e, 0x4c, 0x9e, 0xba, 0x50, 0xd1, 0x29, 0x2d, 0xd4, 0x95, 0x7f, 0xce, 0x41,
+	0xb6, 0xce, 0x1c, 0x65, 0x17, 0xe6, 0x46, 0x1f, 0xa0, 0xef, 0x1a, 0xe9, 0xaf, 0x6b, 0x23, 0xed,
+	0x85, 0xa7, 0x5d, 0x9f, 0xc4, 0x5b, 0x56, 0x79, 0x17, 0x94, 0x94, 0xb7, 0xe0, 0xf2, 0x98, 0x58,
+	0xa3, 0xee, 0xda, 0xfb, 0x13, 0xb9, 0xcb, 0xdc, 0xf7, 0x10, 0xcc, 0xa7, 0x3e, 0xc1, 0x2a, 0x63,
+	0xe2, 0xa5, 0x01, 0xb4, 0xd5, 0x09, 0x01, 0x92, 0x02, 0x86, 0xf3, 0x83, 0xb7, 0xf7, 0x5b, 0xe3,
+	0x0a, 0xe9, 0xfb, 0x69, 0xc6, 0xab, 0xf9, 0xc9, 0x34, 0x1c, 0x66, 0x47, 0xee, 0xcc, 0x77, 0xc6,
+	0xc4, 0x38, 0xec, 0xac, 0x5d, 0x9b, 0xc0, 0x79, 0x48, 0xdf, 0xd4, 0xeb, 0x6b, 0x9c, 0xbe, 0x69,
+	0x00, 0x6d, 0x75, 0x42, 0x80, 0xa4, 0xf0, 0x3d, 0x82, 0xc2, 0x11, 0x43, 0x5e, 0x1d, 0x13, 0x33,
+	0x1d, 0xa2, 0x7d, 0x38, 0x31, 0x64, 0x58, 0x8b, 0xb4, 0xeb, 0x67, 0xac, 0x16, 0x29, 0x00, 0x6d,
+	0x75, 0x42, 0x40, 0x8f, 0x82, 0x76, 0xf6, 0xde, 0xcb, 0x47, 0x57, 0x51, 0xed, 0xa3, 0x27, 0xfb,
+	0x45, 0xf4, 0x74, 0xbf, 0x88, 0xfe, 0xdc, 0x2f, 0xa2, 0x07, 0x07, 0xc5, 0xcc, 0xd3, 0x83, 0x62,
+	0xe6, 0xf7, 0x83, 0x62, 0xe6, 0xeb, 0xa5, 0xa1, 0x9b, 0x7c, 0x6f, 0xf8, 0xbf, 0xee, 0x78, 0x7b,
+	0xb4, 0xa6, 0x62, 0xdb, 0xb5, 0x7f, 0x07, 0x00, 0x61, 0x02, 0xcd, 0x6e, 0x99, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -876,6 +1010,9 @@ type MsgClient interface {
 	SubmitBudgetProposal(ctx context.Context, in *MsgSubmitBudgetProposal, opts ...grpc.CallOption) (*MsgSubmitBudgetProposalResponse, error)
 	// ClaimBudget defines a method to claim the distributed budget.
 	ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ...grpc.CallOption) (*MsgClaimBudgetResponse, error)
+	// ApproveMilestone defines a method for the milestone approver of a budget
+	// to approve its next pending milestone.
+	ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error)
 	// CreateContinuousFund defines a method to add funds continuously.
 	CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error)
 	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
@@ -928,6 +1065,15 @@ func (c *msgClient) ClaimBudget(ctx context.Context, in *MsgClaimBudget, opts ..
 	return out, nil
 }
 
+func (c *msgClient) ApproveMilestone(ctx context.Context, in *MsgApproveMilestone, opts ...grpc.CallOption) (*MsgApproveMilestoneResponse, error) {
+	out := new(MsgApproveMilestoneResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/ApproveMilestone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) CreateContinuousFund(ctx context.Context, in *MsgCreateContinuousFund, opts ...grpc.CallOption) (*MsgCreateContinuousFundResponse, error) {
 	out := new(MsgCreateContinuousFundResponse)
 	err := c.cc.Invoke(ctx, "/cosmos.protocolpool.v1.Msg/CreateContinuousFund", in, out, opts...)
@@ -969,6 +1115,9 @@ type MsgServer interface {
 	SubmitBudgetProposal(context.Context, *MsgSubmitBudgetProposal) (*MsgSubmitBudgetProposalResponse, error)
 	// ClaimBudget defines a method to claim the distributed budget.
 	ClaimBudget(context.Context, *MsgClaimBudget) (*MsgClaimBudgetResponse, error)
+	// ApproveMilestone defines a method for the milestone approver of a budget
+	// to approve its next pending milestone.
+	ApproveMilestone(context.Context, *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error)
 	// CreateContinuousFund defines a method to add funds continuously.
 	CreateContinuousFund(context.Context, *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error)
 	// WithdrawContinuousFund defines a method to withdraw continuous fund allocated.
@@ -993,6 +1142,9 @@ func (*UnimplementedMsgServer) SubmitBudgetProposal(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) ClaimBudget(ctx context.Context, req *MsgClaimBudget) (*MsgClaimBudgetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimBudget not implemented")
 }
+func (*UnimplementedMsgServer) ApproveMilestone(ctx context.Context, req *MsgApproveMilestone) (*MsgApproveMilestoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMilestone not implemented")
+}
 func (*UnimplementedMsgServer) CreateContinuousFund(ctx context.Context, req *MsgCreateContinuousFund) (*MsgCreateContinuousFundResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateContinuousFund not implemented")
 }
@@ -1079,6 +1231,24 @@ func _Msg_ClaimBudget_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ApproveMilestone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveMilestone)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveMilestone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.protocolpool.v1.Msg/ApproveMilestone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveMilestone(ctx, req.(*MsgApproveMilestone))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_CreateContinuousFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgCreateContinuousFund)
 	if err := dec(in); err != nil {
@@ -1153,6 +1323,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimBudget",
 			Handler:    _Msg_ClaimBudget_Handler,
 		},
+		{
+			MethodName: "ApproveMilestone",
+			Handler:    _Msg_ApproveMilestone_Handler,
+		},
 		{
 			MethodName: "CreateContinuousFund",
 			Handler:    _Msg_CreateContinuousFund_Handler,
@@ -1331,6 +1505,22 @@ func (m *MsgSubmitBudgetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Milestones[iNdEx])
+			copy(dAtA[i:], m.Milestones[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Milestones[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MilestoneApprover) > 0 {
+		i -= len(m.MilestoneApprover)
+		copy(dAtA[i:], m.MilestoneApprover)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MilestoneApprover)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Period != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
 		if err1 != nil {
@@ -1356,17 +1546,19 @@ func (m *MsgSubmitBudgetProposal) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x22
 	}
-	if m.BudgetPerTranche != nil {
-		{
-			size, err := m.BudgetPerTranche.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.BudgetPerTranche) > 0 {
+		for iNdEx := len(m.BudgetPerTranche) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BudgetPerTranche[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
 	if len(m.RecipientAddress) > 0 {
 		i -= len(m.RecipientAddress)
@@ -1458,16 +1650,85 @@ func (m *MsgClaimBudgetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMilestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMilestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MilestoneIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MilestoneIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Approver) > 0 {
+		i -= len(m.Approver)
+		copy(dAtA[i:], m.Approver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Approver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveMilestoneResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveMilestoneResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveMilestoneResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	return len(dAtA) - i, nil
 }
 
@@ -1492,12 +1753,12 @@ func (m *MsgCreateContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	var l int
 	_ = l
 	if m.Expiry != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
-		if err5 != nil {
-			return 0, err5
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err3 != nil {
+			return 0, err3
 		}
-		i -= n5
-		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -1630,12 +1891,12 @@ func (m *MsgCancelContinuousFundResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x10
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
-	if err7 != nil {
-		return 0, err7
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CanceledTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n7
-	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -1789,9 +2050,11 @@ func (m *MsgSubmitBudgetProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	if m.BudgetPerTranche != nil {
-		l = m.BudgetPerTranche.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.BudgetPerTranche) > 0 {
+		for _, e := range m.BudgetPerTranche {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
 	}
 	if m.StartTime != nil {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
@@ -1804,6 +2067,16 @@ func (m *MsgSubmitBudgetProposal) Size() (n int) {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.MilestoneApprover)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, s := range m.Milestones {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1835,8 +2108,41 @@ func (m *MsgClaimBudgetResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgApproveMilestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Approver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MilestoneIndex != 0 {
+		n += 1 + sovTx(uint64(m.MilestoneIndex))
+	}
+	return n
+}
+
+func (m *MsgApproveMilestoneResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
@@ -2425,10 +2731,8 @@ func (m *MsgSubmitBudgetProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BudgetPerTranche == nil {
-				m.BudgetPerTranche = &types.Coin{}
-			}
-			if err := m.BudgetPerTranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BudgetPerTranche = append(m.BudgetPerTranche, types.Coin{})
+			if err := m.BudgetPerTranche[len(m.BudgetPerTranche)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2523,6 +2827,70 @@ func (m *MsgSubmitBudgetProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneApprover", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MilestoneApprover = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2734,7 +3102,8 @@ func (m *MsgClaimBudgetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2759,6 +3128,189 @@ func (m *MsgClaimBudgetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgApproveMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneIndex", wireType)
+			}
+			m.MilestoneIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MilestoneIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgApproveMilestoneResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgApproveMilestoneResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgApproveMilestoneResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgCreateContinuousFund) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
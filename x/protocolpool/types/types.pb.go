@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -36,18 +37,25 @@ type Budget struct {
 	// recipient_address is the address of the recipient who can claim the budget.
 	RecipientAddress string `protobuf:"bytes,1,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
 	// claimed_amount is the total amount claimed from the total budget amount requested.
-	ClaimedAmount *types.Coin `protobuf:"bytes,2,opt,name=claimed_amount,json=claimedAmount,proto3" json:"claimed_amount,omitempty"`
+	ClaimedAmount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=claimed_amount,json=claimedAmount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_amount"`
 	// last_claimed_at is the time when the budget was last successfully claimed or distributed.
 	// It is used to track the next starting claim time for fund distribution.
 	LastClaimedAt *time.Time `protobuf:"bytes,3,opt,name=last_claimed_at,json=lastClaimedAt,proto3,stdtime" json:"last_claimed_at,omitempty"`
 	// tranches_left is the number of tranches left for the amount to be distributed.
 	TranchesLeft uint64 `protobuf:"varint,4,opt,name=tranches_left,json=tranchesLeft,proto3" json:"tranches_left,omitempty"`
 	// budget_per_tranche is the amount allocated per tranche.
-	BudgetPerTranche *types.Coin `protobuf:"bytes,5,opt,name=budget_per_tranche,json=budgetPerTranche,proto3" json:"budget_per_tranche,omitempty"`
+	BudgetPerTranche github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=budget_per_tranche,json=budgetPerTranche,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget_per_tranche"`
 	// Period is the time interval(number of seconds) at which funds distribution should be performed.
 	// For example, if a period is set to 3600, it represents an action that
 	// should occur every hour (3600 seconds).
+	// It is optional for milestone-gated budgets.
 	Period *time.Duration `protobuf:"bytes,6,opt,name=period,proto3,stdduration" json:"period,omitempty"`
+	// milestone_approver is the address allowed to approve the budget milestones.
+	// It is empty when the budget is not milestone-gated.
+	MilestoneApprover string `protobuf:"bytes,7,opt,name=milestone_approver,json=milestoneApprover,proto3" json:"milestone_approver,omitempty"`
+	// milestones gate the tranches of the budget, the n-th tranche can only be
+	// claimed once the n-th milestone has been approved.
+	Milestones []Milestone `protobuf:"bytes,8,rep,name=milestones,proto3" json:"milestones"`
 }
 
 func (m *Budget) Reset()         { *m = Budget{} }
@@ -90,7 +98,7 @@ func (m *Budget) GetRecipientAddress() string {
 	return ""
 }
 
-func (m *Budget) GetClaimedAmount() *types.Coin {
+func (m *Budget) GetClaimedAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.ClaimedAmount
 	}
@@ -111,7 +119,7 @@ func (m *Budget) GetTranchesLeft() uint64 {
 	return 0
 }
 
-func (m *Budget) GetBudgetPerTranche() *types.Coin {
+func (m *Budget) GetBudgetPerTranche() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.BudgetPerTranche
 	}
@@ -125,6 +133,85 @@ func (m *Budget) GetPeriod() *time.Duration {
 	return nil
 }
 
+func (m *Budget) GetMilestoneApprover() string {
+	if m != nil {
+		return m.MilestoneApprover
+	}
+	return ""
+}
+
+func (m *Budget) GetMilestones() []Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+// Milestone defines a deliverable that must be approved before the matching
+// budget tranche can be claimed.
+type Milestone struct {
+	// description is a human readable description of the milestone.
+	Description string `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// approved is true once the milestone approver has signed off the milestone.
+	Approved bool `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	// approved_at is the block time at which the milestone was approved.
+	ApprovedAt *time.Time `protobuf:"bytes,3,opt,name=approved_at,json=approvedAt,proto3,stdtime" json:"approved_at,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Milestone) GetApproved() bool {
+	if m != nil {
+		return m.Approved
+	}
+	return false
+}
+
+func (m *Milestone) GetApprovedAt() *time.Time {
+	if m != nil {
+		return m.ApprovedAt
+	}
+	return nil
+}
+
 // ContinuousFund defines the fields of continuous fund proposal.
 type ContinuousFund struct {
 	// Recipient address of the account receiving funds.
@@ -139,7 +226,7 @@ func (m *ContinuousFund) Reset()         { *m = ContinuousFund{} }
 func (m *ContinuousFund) String() string { return proto.CompactTextString(m) }
 func (*ContinuousFund) ProtoMessage()    {}
 func (*ContinuousFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b7d0ea246d7f44, []int{1}
+	return fileDescriptor_c1b7d0ea246d7f44, []int{2}
 }
 func (m *ContinuousFund) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,6 +271,7 @@ func (m *ContinuousFund) GetExpiry() *time.Time {
 
 func init() {
 	proto.RegisterType((*Budget)(nil), "cosmos.protocolpool.v1.Budget")
+	proto.RegisterType((*Milestone)(nil), "cosmos.protocolpool.v1.Milestone")
 	proto.RegisterType((*ContinuousFund)(nil), "cosmos.protocolpool.v1.ContinuousFund")
 }
 
@@ -192,39 +280,47 @@ func init() {
 }
 
 var fileDescriptor_c1b7d0ea246d7f44 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0x8e, 0x69, 0x88, 0x94, 0x83, 0x94, 0x72, 0xaa, 0x90, 0x1b, 0x24, 0x27, 0x84, 0x25, 0x4b,
-	0xcf, 0x0a, 0x48, 0x80, 0xc4, 0x42, 0x9d, 0xf0, 0x31, 0x74, 0x80, 0xd0, 0x89, 0xc5, 0x3a, 0xdb,
-	0x6f, 0xdc, 0x13, 0xf6, 0x9d, 0x75, 0x77, 0x8e, 0x9a, 0x95, 0x5f, 0xd0, 0x91, 0x1f, 0xd2, 0x1f,
-	0xd1, 0xb1, 0xaa, 0x84, 0x84, 0x18, 0x0a, 0x4a, 0xfe, 0x08, 0x8a, 0xef, 0x12, 0xd2, 0x2e, 0x65,
-	0xb3, 0xde, 0xe7, 0xc3, 0xcf, 0xf3, 0x9e, 0x5e, 0xd4, 0x8b, 0x85, 0xca, 0x85, 0xf2, 0x0b, 0x29,
-	0xb4, 0x88, 0x45, 0x56, 0x08, 0x91, 0xf9, 0xd3, 0x81, 0xaf, 0x67, 0x05, 0x28, 0x52, 0x4d, 0xf1,
-	0x23, 0xc3, 0x21, 0x9b, 0x1c, 0x32, 0x1d, 0xb4, 0x77, 0x53, 0x91, 0x8a, 0x6a, 0xe8, 0x2f, 0xbf,
-	0x0c, 0xde, 0xde, 0x33, 0xec, 0xd0, 0x00, 0x9b, 0xd2, 0xb6, 0x67, 0x7f, 0x16, 0x51, 0x05, 0xfe,
-	0x74, 0x10, 0x81, 0xa6, 0x03, 0x3f, 0x16, 0x8c, 0x5b, 0xbc, 0x93, 0x0a, 0x91, 0x66, 0x60, 0xc2,
-	0x44, 0xe5, 0xc4, 0xd7, 0x2c, 0x07, 0xa5, 0x69, 0x5e, 0xac, 0x0c, 0x6e, 0x12, 0x92, 0x52, 0x52,
-	0xcd, 0x84, 0x35, 0xe8, 0x7d, 0xdb, 0x42, 0x8d, 0xa0, 0x4c, 0x52, 0xd0, 0xf8, 0x2d, 0x7a, 0x28,
-	0x21, 0x66, 0x05, 0x03, 0xae, 0x43, 0x9a, 0x24, 0x12, 0x94, 0x72, 0x9d, 0xae, 0xd3, 0x6f, 0x06,
-	0xee, 0xe5, 0xd9, 0xfe, 0xae, 0x0d, 0x76, 0x60, 0x90, 0xcf, 0x5a, 0x32, 0x9e, 0x8e, 0x77, 0xd6,
-	0x12, 0x3b, 0xc7, 0x6f, 0xd0, 0x76, 0x9c, 0x51, 0x96, 0x43, 0x12, 0xd2, 0x5c, 0x94, 0x5c, 0xbb,
-	0x77, 0xba, 0x4e, 0xff, 0xde, 0xb3, 0x3d, 0x62, 0x0d, 0x96, 0x5d, 0x88, 0xed, 0x42, 0x86, 0x82,
-	0xf1, 0x71, 0xcb, 0x0a, 0x0e, 0x2a, 0x3e, 0xfe, 0x80, 0x1e, 0x64, 0x54, 0xe9, 0x70, 0x6d, 0xa3,
-	0xdd, 0xad, 0xca, 0xa2, 0x4d, 0x4c, 0x1b, 0xb2, 0x6a, 0x43, 0x8e, 0x56, 0x75, 0x83, 0xfa, 0xe9,
-	0xef, 0x8e, 0x33, 0x6e, 0x2d, 0x85, 0x43, 0xeb, 0xa6, 0xf1, 0x53, 0xd4, 0xd2, 0x92, 0xf2, 0xf8,
-	0x18, 0x54, 0x98, 0xc1, 0x44, 0xbb, 0xf5, 0xae, 0xd3, 0xaf, 0x8f, 0xef, 0xaf, 0x86, 0x87, 0x30,
-	0xd1, 0xf8, 0x3d, 0xc2, 0x51, 0xb5, 0x81, 0xb0, 0x00, 0x19, 0x5a, 0xc8, 0xbd, 0x7b, 0x5b, 0xe8,
-	0x1d, 0x23, 0xfa, 0x08, 0xf2, 0xc8, 0x48, 0xf0, 0x4b, 0xd4, 0x28, 0x40, 0x32, 0x91, 0xb8, 0x0d,
-	0x2b, 0xbe, 0x19, 0x77, 0x64, 0x97, 0x1f, 0xd4, 0xbf, 0x2f, 0xd3, 0x5a, 0x7a, 0xef, 0x87, 0x83,
-	0xb6, 0x87, 0x82, 0x6b, 0xc6, 0x4b, 0x51, 0xaa, 0x77, 0x25, 0x4f, 0xf0, 0x0b, 0xd4, 0x5c, 0x6f,
-	0xf6, 0xd6, 0x47, 0xf8, 0x47, 0xc5, 0x9f, 0x10, 0x2a, 0x40, 0xc6, 0xc0, 0x35, 0x4d, 0xa1, 0xda,
-	0x7c, 0x33, 0x18, 0x9c, 0x5f, 0x75, 0x6a, 0xbf, 0xae, 0x3a, 0x8f, 0x8d, 0x58, 0x25, 0x5f, 0x09,
-	0x13, 0x7e, 0x4e, 0xf5, 0x31, 0x39, 0x84, 0x94, 0xc6, 0xb3, 0x11, 0xc4, 0x97, 0x67, 0xfb, 0xc8,
-	0x7a, 0x8f, 0x20, 0x1e, 0x6f, 0x98, 0xe0, 0x57, 0xa8, 0x01, 0x27, 0x05, 0x93, 0xb3, 0xff, 0x7e,
-	0x05, 0xcb, 0x0f, 0x5e, 0x9f, 0xcf, 0x3d, 0xe7, 0x62, 0xee, 0x39, 0x7f, 0xe6, 0x9e, 0x73, 0xba,
-	0xf0, 0x6a, 0x17, 0x0b, 0xaf, 0xf6, 0x73, 0xe1, 0xd5, 0xbe, 0x3c, 0xb9, 0x16, 0xe5, 0xe4, 0xfa,
-	0x31, 0x55, 0x97, 0x14, 0x35, 0xaa, 0xd9, 0xf3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x86, 0x82,
-	0x01, 0x87, 0x70, 0x03, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0x8e, 0xdb, 0xfc, 0xf9, 0x9b, 0x0d, 0x2d, 0xed, 0xaa, 0x42, 0x6e, 0x90, 0x92, 0x34, 0x5c,
+	0x72, 0xe9, 0x9a, 0x14, 0x09, 0x90, 0x38, 0xc5, 0x2d, 0x94, 0x43, 0x91, 0xc0, 0xf4, 0xc4, 0xc5,
+	0xda, 0xd8, 0x53, 0x77, 0x55, 0xdb, 0x6b, 0xed, 0xae, 0xa3, 0xe6, 0x1d, 0x38, 0xf4, 0xc8, 0x33,
+	0x70, 0xee, 0x43, 0xf4, 0x58, 0x55, 0x42, 0x42, 0x1c, 0x5a, 0xd4, 0xbe, 0x04, 0x47, 0x64, 0x7b,
+	0x6d, 0x52, 0x40, 0x2a, 0x48, 0x9c, 0xbc, 0xfe, 0xe6, 0xfb, 0x66, 0xe6, 0x9b, 0x1d, 0x1b, 0xf5,
+	0x3d, 0x2e, 0x23, 0x2e, 0xad, 0x44, 0x70, 0xc5, 0x3d, 0x1e, 0x26, 0x9c, 0x87, 0xd6, 0x64, 0x68,
+	0xa9, 0x69, 0x02, 0x92, 0xe4, 0x28, 0xbe, 0x57, 0x70, 0xc8, 0x2c, 0x87, 0x4c, 0x86, 0xed, 0xd5,
+	0x80, 0x07, 0x3c, 0x07, 0xad, 0xec, 0x54, 0xc4, 0xdb, 0x6b, 0x05, 0xdb, 0x2d, 0x02, 0xb3, 0xd2,
+	0x76, 0x47, 0x17, 0x1b, 0x53, 0x09, 0xd6, 0x64, 0x38, 0x06, 0x45, 0x87, 0x96, 0xc7, 0x59, 0xac,
+	0xe3, 0xdd, 0x80, 0xf3, 0x20, 0x84, 0xa2, 0x99, 0x71, 0xba, 0x6f, 0x29, 0x16, 0x81, 0x54, 0x34,
+	0x4a, 0xca, 0x04, 0x3f, 0x13, 0xfc, 0x54, 0x50, 0xc5, 0xb8, 0x4e, 0xd0, 0xff, 0x56, 0x47, 0x0d,
+	0x3b, 0xf5, 0x03, 0x50, 0xf8, 0x39, 0x5a, 0x11, 0xe0, 0xb1, 0x84, 0x41, 0xac, 0x5c, 0xea, 0xfb,
+	0x02, 0xa4, 0x34, 0x8d, 0x9e, 0x31, 0x68, 0xda, 0xe6, 0xf9, 0xc9, 0xc6, 0xaa, 0x6e, 0x6c, 0x54,
+	0x44, 0xde, 0x2a, 0xc1, 0xe2, 0xc0, 0x59, 0xae, 0x24, 0x1a, 0xc7, 0x02, 0x2d, 0x79, 0x21, 0x65,
+	0x11, 0xf8, 0x2e, 0x8d, 0x78, 0x1a, 0x2b, 0x73, 0xae, 0x37, 0x3f, 0x68, 0x6d, 0xae, 0x11, 0x9d,
+	0x20, 0xf3, 0x42, 0xb4, 0x17, 0xb2, 0xc5, 0x59, 0x6c, 0x3f, 0x3c, 0xbd, 0xe8, 0xd6, 0x3e, 0x5e,
+	0x76, 0x07, 0x01, 0x53, 0x07, 0xe9, 0x98, 0x78, 0x3c, 0xd2, 0x63, 0xd0, 0x8f, 0x0d, 0xe9, 0x1f,
+	0xea, 0x01, 0x67, 0x02, 0xe9, 0x2c, 0xea, 0x12, 0xa3, 0xbc, 0x02, 0x7e, 0x89, 0xee, 0x86, 0x54,
+	0x2a, 0xb7, 0x2a, 0xac, 0xcc, 0xf9, 0x9e, 0x31, 0x68, 0x6d, 0xb6, 0x49, 0xe1, 0x9f, 0x94, 0xfe,
+	0xc9, 0x5e, 0x39, 0x20, 0xbb, 0x7e, 0x7c, 0xd9, 0x35, 0x9c, 0xc5, 0x4c, 0xb8, 0xa5, 0xb3, 0x29,
+	0xfc, 0x00, 0x2d, 0x2a, 0x41, 0x63, 0xef, 0x00, 0xa4, 0x1b, 0xc2, 0xbe, 0x32, 0xeb, 0x3d, 0x63,
+	0x50, 0x77, 0xee, 0x94, 0xe0, 0x2e, 0xec, 0x2b, 0x3c, 0x45, 0x78, 0x9c, 0xcf, 0xcc, 0x4d, 0x40,
+	0xb8, 0x3a, 0x64, 0xfe, 0xf7, 0xef, 0x6d, 0x2e, 0x17, 0x65, 0x5e, 0x83, 0xd8, 0x2b, 0x8a, 0xe0,
+	0x27, 0xa8, 0x91, 0x80, 0x60, 0xdc, 0x37, 0x1b, 0xb9, 0xc1, 0xb5, 0x5f, 0x0c, 0x6e, 0xeb, 0x0b,
+	0xb6, 0xeb, 0x1f, 0x32, 0x7f, 0x9a, 0x8e, 0x77, 0x10, 0x8e, 0x58, 0x08, 0x52, 0xf1, 0x18, 0x5c,
+	0x9a, 0x24, 0x82, 0x4f, 0x40, 0x98, 0xff, 0xdf, 0x72, 0xbd, 0x2b, 0x95, 0x66, 0xa4, 0x25, 0x78,
+	0x07, 0xa1, 0x0a, 0x94, 0xe6, 0x42, 0x6e, 0x7a, 0x9d, 0xfc, 0x7e, 0xe1, 0xc9, 0xab, 0x92, 0x69,
+	0xd7, 0x33, 0xf3, 0xce, 0x8c, 0xb4, 0xff, 0xde, 0x40, 0xcd, 0x2a, 0x8e, 0x7b, 0xa8, 0xe5, 0x83,
+	0xf4, 0x04, 0x4b, 0xb2, 0xe6, 0x8b, 0xbd, 0x73, 0x66, 0x21, 0xdc, 0x46, 0x0b, 0xba, 0x6f, 0xdf,
+	0x9c, 0xeb, 0x19, 0x83, 0x05, 0xa7, 0x7a, 0xc7, 0x23, 0xd4, 0x2a, 0xcf, 0x7f, 0x73, 0xf9, 0xa8,
+	0x14, 0x8d, 0x54, 0xff, 0x93, 0x81, 0x96, 0xb6, 0x78, 0xac, 0x58, 0x9c, 0xf2, 0x54, 0xbe, 0x48,
+	0x63, 0x1f, 0x3f, 0x46, 0xcd, 0x6a, 0xbd, 0x6f, 0xfd, 0x12, 0x7e, 0x50, 0xf1, 0x1b, 0x84, 0x12,
+	0x10, 0x1e, 0xc4, 0x8a, 0x06, 0x90, 0xf7, 0xda, 0xb4, 0x87, 0x99, 0xff, 0x2f, 0x17, 0xdd, 0xfb,
+	0x85, 0x58, 0xfa, 0x87, 0x84, 0x71, 0x2b, 0xa2, 0xea, 0x80, 0xec, 0x42, 0x40, 0xbd, 0xe9, 0x36,
+	0x78, 0xe7, 0x27, 0x1b, 0x48, 0xe7, 0xde, 0x06, 0xcf, 0x99, 0x49, 0x82, 0x9f, 0xa2, 0x06, 0x1c,
+	0x25, 0x4c, 0x4c, 0xff, 0xd8, 0x9b, 0xe6, 0xdb, 0xcf, 0x4e, 0xaf, 0x3a, 0xc6, 0xd9, 0x55, 0xc7,
+	0xf8, 0x7a, 0xd5, 0x31, 0x8e, 0xaf, 0x3b, 0xb5, 0xb3, 0xeb, 0x4e, 0xed, 0xf3, 0x75, 0xa7, 0xf6,
+	0x6e, 0xfd, 0x46, 0x2b, 0x47, 0x37, 0xff, 0x68, 0xf9, 0x1a, 0x8e, 0x1b, 0x39, 0xf6, 0xe8, 0xfb,
+	0x00, 0xfd, 0xc2, 0x4f, 0x5a, 0xf5, 0x04, 0x00, 0x00,
 }
 
 func (m *Budget) Marshal() (dAtA []byte, err error) {
@@ -247,6 +343,27 @@ func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Milestones) > 0 {
+		for iNdEx := len(m.Milestones) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Milestones[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MilestoneApprover) > 0 {
+		i -= len(m.MilestoneApprover)
+		copy(dAtA[i:], m.MilestoneApprover)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MilestoneApprover)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.Period != nil {
 		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period):])
 		if err1 != nil {
@@ -257,17 +374,19 @@ func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	if m.BudgetPerTranche != nil {
-		{
-			size, err := m.BudgetPerTranche.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.BudgetPerTranche) > 0 {
+		for iNdEx := len(m.BudgetPerTranche) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BudgetPerTranche[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i--
-		dAtA[i] = 0x2a
 	}
 	if m.TranchesLeft != 0 {
 		i = encodeVarintTypes(dAtA, i, uint64(m.TranchesLeft))
@@ -275,26 +394,28 @@ func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x20
 	}
 	if m.LastClaimedAt != nil {
-		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastClaimedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastClaimedAt):])
-		if err3 != nil {
-			return 0, err3
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastClaimedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastClaimedAt):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n3
-		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i -= n2
+		i = encodeVarintTypes(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ClaimedAmount != nil {
-		{
-			size, err := m.ClaimedAmount.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.ClaimedAmount) > 0 {
+		for iNdEx := len(m.ClaimedAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
 	if len(m.RecipientAddress) > 0 {
 		i -= len(m.RecipientAddress)
@@ -306,6 +427,56 @@ func (m *Budget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Milestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedAt != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ApprovedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ApprovedAt):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTypes(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Approved {
+		i--
+		if m.Approved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ContinuousFund) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -327,12 +498,12 @@ func (m *ContinuousFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Expiry != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
-		if err5 != nil {
-			return 0, err5
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiry):])
+		if err4 != nil {
+			return 0, err4
 		}
-		i -= n5
-		i = encodeVarintTypes(dAtA, i, uint64(n5))
+		i -= n4
+		i = encodeVarintTypes(dAtA, i, uint64(n4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -377,9 +548,11 @@ func (m *Budget) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
-	if m.ClaimedAmount != nil {
-		l = m.ClaimedAmount.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.ClaimedAmount) > 0 {
+		for _, e := range m.ClaimedAmount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
 	if m.LastClaimedAt != nil {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastClaimedAt)
@@ -388,14 +561,46 @@ func (m *Budget) Size() (n int) {
 	if m.TranchesLeft != 0 {
 		n += 1 + sovTypes(uint64(m.TranchesLeft))
 	}
-	if m.BudgetPerTranche != nil {
-		l = m.BudgetPerTranche.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if len(m.BudgetPerTranche) > 0 {
+		for _, e := range m.BudgetPerTranche {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
 	}
 	if m.Period != nil {
 		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Period)
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	l = len(m.MilestoneApprover)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Approved {
+		n += 2
+	}
+	if m.ApprovedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ApprovedAt)
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -514,10 +719,8 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClaimedAmount == nil {
-				m.ClaimedAmount = &types.Coin{}
-			}
-			if err := m.ClaimedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClaimedAmount = append(m.ClaimedAmount, types.Coin{})
+			if err := m.ClaimedAmount[len(m.ClaimedAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -605,10 +808,8 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BudgetPerTranche == nil {
-				m.BudgetPerTranche = &types.Coin{}
-			}
-			if err := m.BudgetPerTranche.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BudgetPerTranche = append(m.BudgetPerTranche, types.Coin{})
+			if err := m.BudgetPerTranche[len(m.BudgetPerTranche)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -648,6 +849,210 @@ func (m *Budget) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MilestoneApprover", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MilestoneApprover = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Approved = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ApprovedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -254,6 +254,19 @@ func (k Keeper) IterateDelegatorDelegations(ctx context.Context, delegator sdk.A
 	return nil
 }
 
+// IterateDelegatorDelegationsFrom iterates through one delegator's delegations in
+// validator address order, starting from the delegation to fromValAddr included.
+// An empty fromValAddr iterates through all the delegations of the delegator.
+func (k Keeper) IterateDelegatorDelegationsFrom(ctx context.Context, delegator sdk.AccAddress, fromValAddr sdk.ValAddress, cb func(delegation types.Delegation) (stop bool)) error {
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.ValAddress](delegator)
+	if len(fromValAddr) > 0 {
+		rng = rng.StartInclusive(fromValAddr)
+	}
+	return k.Delegations.Walk(ctx, rng, func(_ collections.Pair[sdk.AccAddress, sdk.ValAddress], del types.Delegation) (stop bool, err error) {
+		return cb(del), nil
+	})
+}
+
 // HasMaxUnbondingDelegationEntries checks if unbonding delegation has maximum number of entries.
 func (k Keeper) HasMaxUnbondingDelegationEntries(ctx context.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress) (bool, error) {
 	ubd, err := k.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
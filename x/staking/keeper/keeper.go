@@ -66,6 +66,31 @@ func NewRotationHistoryIndexes(sb *collections.SchemaBuilder) rotationHistoryInd
 	}
 }
 
+type tokenizeShareRecordIndexes struct {
+	Owner *indexes.Multi[[]byte, uint64, types.TokenizeShareRecord]
+}
+
+func (a tokenizeShareRecordIndexes) IndexesList() []collections.Index[uint64, types.TokenizeShareRecord] {
+	return []collections.Index[uint64, types.TokenizeShareRecord]{
+		a.Owner,
+	}
+}
+
+func NewTokenizeShareRecordIndexes(sb *collections.SchemaBuilder, addressCodec addresscodec.Codec) tokenizeShareRecordIndexes {
+	return tokenizeShareRecordIndexes{
+		Owner: indexes.NewMulti(
+			sb,
+			types.TokenizeShareRecordByOwnerKey,
+			"tokenize_share_records_by_owner",
+			collections.BytesKey,
+			collections.Uint64Key,
+			func(_ uint64, v types.TokenizeShareRecord) ([]byte, error) {
+				return addressCodec.StringToBytes(v.Owner)
+			},
+		),
+	}
+}
+
 // Keeper of the x/staking store
 type Keeper struct {
 	appmodule.Environment
@@ -129,6 +154,13 @@ type Keeper struct {
 	// ValidatorConsPubKeyRotationHistory: consPubkey rotation history by validator
 	// A index is being added with key `BlockConsPubKeyRotationHistory`: consPubkey rotation history by height
 	RotationHistory *collections.IndexedMap[collections.Pair[[]byte, uint64], types.ConsPubKeyRotationHistory, rotationHistoryIndexes]
+	// TokenizeShareRecords key: recordID | value: TokenizeShareRecord
+	// A index is being added with key `TokenizeShareRecordByOwner`: records by owner
+	TokenizeShareRecords *collections.IndexedMap[uint64, types.TokenizeShareRecord, tokenizeShareRecordIndexes]
+	// LastTokenizeShareRecordID value: id of the last created tokenize share record
+	LastTokenizeShareRecordID collections.Sequence
+	// ValidatorLiquidShares key: valAddr | value: delegator shares of the validator held by tokenize share records
+	ValidatorLiquidShares collections.Map[[]byte, math.LegacyDec]
 }
 
 // NewKeeper creates a new staking Keeper instance
@@ -305,6 +337,28 @@ func NewKeeper(
 			codec.CollValue[types.ConsPubKeyRotationHistory](cdc),
 			NewRotationHistoryIndexes(sb),
 		),
+
+		// key format is: 120 | recordID
+		// index is: 121 | owner | recordID
+		TokenizeShareRecords: collections.NewIndexedMap(
+			sb,
+			types.TokenizeShareRecordKey,
+			"tokenize_share_records",
+			collections.Uint64Key,
+			codec.CollValue[types.TokenizeShareRecord](cdc),
+			NewTokenizeShareRecordIndexes(sb, ak.AddressCodec()),
+		),
+
+		// key is: 122
+		LastTokenizeShareRecordID: collections.NewSequence(sb, types.LastTokenizeShareRecordIDKey, "last_tokenize_share_record_id"),
+
+		// key format is: 123 | valAddr
+		ValidatorLiquidShares: collections.NewMap(
+			sb, types.ValidatorLiquidSharesKey,
+			"validator_liquid_shares",
+			collections.BytesKey,
+			sdk.LegacyDecValue,
+		),
 	}
 
 	schema, err := sb.Build()
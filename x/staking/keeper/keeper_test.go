@@ -286,7 +286,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 
 			s.ctx.KVStore(s.key).Set(getLastValidatorPowerKey(valAddrs[i]), bz)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"f59636f1f9d6dba85da4237288526d26e2ccda590b4e58c09b528187b5bc43f8",
 	)
 	s.Require().NoError(err)
 
@@ -301,7 +301,7 @@ func (s *KeeperTestSuite) TestLastTotalPowerMigrationToColls() {
 			err = s.stakingKeeper.LastValidatorPower.Set(s.ctx, valAddrs[i], intV)
 			s.Require().NoError(err)
 		},
-		"198aa9b8c1d9bc02308b7b2a48944f3e4b05c6b8312cb0bcc73518d1260f682d",
+		"f59636f1f9d6dba85da4237288526d26e2ccda590b4e58c09b528187b5bc43f8",
 	)
 	s.Require().NoError(err)
 }
@@ -319,7 +319,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValSrcIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"49cab9557e1d68c1278282b5c1b9bb7bb09e9d99dd4600d981b35a72eb988578",
 	)
 	s.Require().NoError(err)
 
@@ -332,7 +332,7 @@ func (s *KeeperTestSuite) TestSrcRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValSrc.Set(s.ctx, collections.Join3(valAddrs[i].Bytes(), addrs[i].Bytes(), valAddrs[i+1].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"cae99e5c0498356a290f9478b7db73d522840b736878a9d4c00b56d1ddd7fd04",
+		"49cab9557e1d68c1278282b5c1b9bb7bb09e9d99dd4600d981b35a72eb988578",
 	)
 
 	s.Require().NoError(err)
@@ -351,7 +351,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			// legacy method to set in the state
 			s.ctx.KVStore(s.key).Set(getREDByValDstIndexKey(addrs[i], valAddrs[i], valAddrs[i+1]), []byte{})
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81", // this hash obtained when ran this test in main branch
+		"dfa411e490e10c08111cb3a1d9f5e37321e3be0923b10789cd6178ca615ede03", // the store hash includes the params set in SetupTest, the liquid staking params included
 	)
 	s.Require().NoError(err)
 
@@ -364,7 +364,7 @@ func (s *KeeperTestSuite) TestDstRedelegationsMigrationToColls() {
 			err := s.stakingKeeper.RedelegationsByValDst.Set(s.ctx, collections.Join3(valAddrs[i+1].Bytes(), addrs[i].Bytes(), valAddrs[i].Bytes()), []byte{})
 			s.Require().NoError(err)
 		},
-		"1b7687449a83f8176a60aeced7bcfc69a2b957b9eefad60c69a9fae9acfdaa81",
+		"dfa411e490e10c08111cb3a1d9f5e37321e3be0923b10789cd6178ca615ede03",
 	)
 
 	s.Require().NoError(err)
@@ -395,7 +395,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			s.ctx.KVStore(s.key).Set(getUBDKey(delAddrs[i], valAddrs[i]), bz)
 			s.ctx.KVStore(s.key).Set(getUBDByValIndexKey(delAddrs[i], valAddrs[i]), []byte{})
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"9ca6179dc6a65baba229b2ccb8deacf56ef68cee88e8b416340bc343c277e444",
 	)
 	s.Require().NoError(err)
 
@@ -419,7 +419,7 @@ func (s *KeeperTestSuite) TestUnbondingDelegationsMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingDelegation(s.ctx, ubd)
 			s.Require().NoError(err)
 		},
-		"70454ad98368368aaff32d207a7a115fba49133ecf2a225d8e3eca88c6b2324c",
+		"9ca6179dc6a65baba229b2ccb8deacf56ef68cee88e8b416340bc343c277e444",
 	)
 	s.Require().NoError(err)
 }
@@ -436,7 +436,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getUnbondingDelegationTimeKey(date), []byte{})
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"f6c3fa283ecb1f5cc6204d1f8b58cc708f413eb20c14086bfb5e470cfdb08d98",
 	)
 	s.Require().NoError(err)
 
@@ -449,7 +449,7 @@ func (s *KeeperTestSuite) TestUBDQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUBDQueueTimeSlice(s.ctx, date, nil)
 			s.Require().NoError(err)
 		},
-		"2dd1dd08ea1cc2b0a076c420e3888b218647b9409b435f75e5730b0e4f25e890",
+		"f6c3fa283ecb1f5cc6204d1f8b58cc708f413eb20c14086bfb5e470cfdb08d98",
 	)
 	s.Require().NoError(err)
 }
@@ -483,7 +483,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorKey(valAddrs[i]), valBz)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"b4cde85b9fc46259e014265b1f88f9de3692872c8a0a7e1c4bb7a8b1b61dd4fb",
 	)
 	s.Require().NoError(err)
 
@@ -509,7 +509,7 @@ func (s *KeeperTestSuite) TestValidatorsMigrationToColls() {
 			err := s.stakingKeeper.SetValidator(s.ctx, val)
 			s.Require().NoError(err)
 		},
-		"aa495d55fb45df89fcf1d4326331bfc1244ef879764abe76f6ce2a41ccd4180d",
+		"b4cde85b9fc46259e014265b1f88f9de3692872c8a0a7e1c4bb7a8b1b61dd4fb",
 	)
 	s.Require().NoError(err)
 }
@@ -532,7 +532,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			// legacy Set method
 			s.ctx.KVStore(s.key).Set(getValidatorQueueKey(endTime, endHeight), bz)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"614487254439fdafc2ed5b40d351a1c23b6e281369385b4952e383930a4007f4",
 	)
 	s.Require().NoError(err)
 
@@ -547,7 +547,7 @@ func (s *KeeperTestSuite) TestValidatorQueueMigrationToColls() {
 			err := s.stakingKeeper.SetUnbondingValidatorsQueue(s.ctx, endTime, endHeight, addrs)
 			s.Require().NoError(err)
 		},
-		"b23a5905ced2b76c46ddd0f7d39e2ed7dcc68cd81993c497ee314b2e1a158595",
+		"614487254439fdafc2ed5b40d351a1c23b6e281369385b4952e383930a4007f4",
 	)
 	s.Require().NoError(err)
 }
@@ -575,7 +575,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			s.Require().NoError(err)
 			s.ctx.KVStore(s.key).Set(getRedelegationTimeKey(date), bz)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"18a95c69b3f99b8920a80fd62b1c7c958f5af2c2e0eb1e25d9f4ac7bd2225fdf",
 	)
 	s.Require().NoError(err)
 
@@ -597,7 +597,7 @@ func (s *KeeperTestSuite) TestRedelegationQueueMigrationToColls() {
 			err := s.stakingKeeper.SetRedelegationQueueTimeSlice(s.ctx, date, dvvTriplets.Triplets)
 			s.Require().NoError(err)
 		},
-		"d6a1c46c7c5793ff7094b67252c82883aecb75c8359428a59aacd3657fa16235",
+		"18a95c69b3f99b8920a80fd62b1c7c958f5af2c2e0eb1e25d9f4ac7bd2225fdf",
 	)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/x/staking/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TokenizeShares converts an amount of the delegation of delAddr to valAddr into share tokens.
+// The delegation is moved to the account of a new tokenize share record owned by owner, and
+// the share tokens of the record are minted to the delegator.
+func (k Keeper) TokenizeShares(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, amount math.Int, owner string,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	// a delegation received through a redelegation could otherwise escape the slashing of its
+	// source validator once tokenized
+	hasReceivingRedelegation, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+	if hasReceivingRedelegation {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrRedelegationInProgress
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, amount)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.checkLiquidStakingCaps(ctx, validator, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	lastID, err := k.LastTokenizeShareRecordID.Next(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	recordAddr := types.TokenizeShareRecordAddress(lastID + 1)
+	if k.authKeeper.GetAccount(ctx, recordAddr) == nil {
+		k.authKeeper.SetAccount(ctx, k.authKeeper.NewAccountWithAddress(ctx, recordAddr))
+	}
+
+	recordAddrStr, err := k.authKeeper.AddressCodec().BytesToString(recordAddr)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	record := types.TokenizeShareRecord{
+		Id:            lastID + 1,
+		Owner:         owner,
+		ModuleAccount: recordAddrStr,
+		Validator:     validator.GetOperator(),
+	}
+
+	_, newShares, err := k.moveDelegation(ctx, delAddr, recordAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	shareToken := sdk.NewCoin(record.GetShareTokenDenom(), newShares.TruncateInt())
+	if !shareToken.IsPositive() {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "too few shares to tokenize (truncates to zero share tokens)")
+	}
+
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.addValidatorLiquidShares(ctx, valAddr, newShares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.TokenizeShareRecords.Set(ctx, record.Id, record); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, shareToken, nil
+}
+
+// RedeemTokensForShares burns the share tokens of delAddr and moves the shares they represent
+// from the delegation of the tokenize share record back to delAddr. Once the last share tokens
+// of a record are redeemed, the remaining balance of the record account, holding the rewards of
+// the tokenized delegation, is sent to the record owner and the record is deleted.
+func (k Keeper) RedeemTokensForShares(
+	ctx context.Context, delAddr sdk.AccAddress, shareToken sdk.Coin,
+) (types.TokenizeShareRecord, sdk.Coin, error) {
+	record, err := k.GetTokenizeShareRecordByDenom(ctx, shareToken.Denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(record.Validator)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	recordAddr := types.TokenizeShareRecordAddress(record.Id)
+	delegation, err := k.Delegations.Get(ctx, collections.Join(recordAddr, sdk.ValAddress(valAddr)))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, types.ErrNoDelegation
+	} else if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, delAddr, sdk.NewCoins(shareToken)); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	// the last share tokens also redeem the fraction of shares which was truncated when minting
+	shares := math.LegacyNewDecFromInt(shareToken.Amount)
+	lastRedemption := k.bankKeeper.GetSupply(ctx, shareToken.Denom).IsZero()
+	if lastRedemption {
+		shares = delegation.Shares
+	} else if shares.GT(delegation.Shares) {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, errorsmod.Wrap(types.ErrNotEnoughDelegationShares, delegation.Shares.String())
+	}
+
+	tokens, _, err := k.moveDelegation(ctx, recordAddr, delAddr, valAddr, shares)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if err := k.removeValidatorLiquidShares(ctx, valAddr, shares); err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	if lastRedemption {
+		if err := k.deleteTokenizeShareRecord(ctx, record); err != nil {
+			return types.TokenizeShareRecord{}, sdk.Coin{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return types.TokenizeShareRecord{}, sdk.Coin{}, err
+	}
+
+	return record, sdk.NewCoin(bondDenom, tokens), nil
+}
+
+// moveDelegation unbonds shares of the delegation of from to valAddr, and delegates the returned
+// tokens from to. The tokens transit through the balance of from, so that the undelegation of a
+// vesting account is tracked and its locked coins cannot be moved.
+func (k Keeper) moveDelegation(
+	ctx context.Context, from, to sdk.AccAddress, valAddr sdk.ValAddress, shares math.LegacyDec,
+) (math.Int, math.LegacyDec, error) {
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+
+	tokens, err := k.Unbond(ctx, from, valAddr, shares)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+
+	if tokens.IsZero() {
+		return tokens, math.LegacyZeroDec(), nil
+	}
+
+	if validator.IsBonded() {
+		if err := k.bondedTokensToNotBonded(ctx, tokens); err != nil {
+			return math.Int{}, math.LegacyDec{}, err
+		}
+	}
+
+	bondDenom, err := k.BondDenom(ctx)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(bondDenom, tokens))
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.NotBondedPoolName, from, coins); err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, from, to, coins); err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+
+	// the validator tokens and shares were updated by Unbond
+	validator, err = k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+
+	newShares, err := k.Delegate(ctx, to, tokens, types.Unbonded, validator, true)
+	if err != nil {
+		return math.Int{}, math.LegacyDec{}, err
+	}
+
+	return tokens, newShares, nil
+}
+
+// deleteTokenizeShareRecord sends the remaining balance of the record account to the record
+// owner and deletes the record.
+func (k Keeper) deleteTokenizeShareRecord(ctx context.Context, record types.TokenizeShareRecord) error {
+	owner, err := k.authKeeper.AddressCodec().StringToBytes(record.Owner)
+	if err != nil {
+		return err
+	}
+
+	recordAddr := types.TokenizeShareRecordAddress(record.Id)
+	if balance := k.bankKeeper.GetAllBalances(ctx, recordAddr); !balance.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, recordAddr, owner, balance); err != nil {
+			return err
+		}
+	}
+
+	return k.TokenizeShareRecords.Remove(ctx, record.Id)
+}
+
+// checkLiquidStakingCaps returns an error if tokenizing the given shares of the validator
+// exceeds the validator or the global liquid staking cap.
+func (k Keeper) checkLiquidStakingCaps(ctx context.Context, validator types.Validator, shares math.LegacyDec) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.ValidatorLiquidStakingCap.Equal(math.LegacyOneDec()) {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+		if err != nil {
+			return err
+		}
+
+		liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		maxShares := validator.DelegatorShares.Mul(params.ValidatorLiquidStakingCap)
+		if liquidShares.Add(shares).GT(maxShares) {
+			return errorsmod.Wrapf(types.ErrValidatorLiquidStakingCapExceeded, "%s liquid shares exceed the maximum of %s", liquidShares.Add(shares), maxShares)
+		}
+	}
+
+	if !params.GlobalLiquidStakingCap.Equal(math.LegacyOneDec()) {
+		totalLiquidStaked, err := k.TotalLiquidStakedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		totalBonded, err := k.TotalBondedTokens(ctx)
+		if err != nil {
+			return err
+		}
+
+		liquidStaked := math.LegacyNewDecFromInt(totalLiquidStaked).Add(validator.TokensFromShares(shares))
+		maxTokens := math.LegacyNewDecFromInt(totalBonded).Mul(params.GlobalLiquidStakingCap)
+		if liquidStaked.GT(maxTokens) {
+			return errorsmod.Wrapf(types.ErrGlobalLiquidStakingCapExceeded, "%s liquid staked tokens exceed the maximum of %s", liquidStaked, maxTokens)
+		}
+	}
+
+	return nil
+}
+
+// GetValidatorLiquidShares returns the delegator shares of a validator held by tokenize share records.
+func (k Keeper) GetValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress) (math.LegacyDec, error) {
+	shares, err := k.ValidatorLiquidShares.Get(ctx, valAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.LegacyZeroDec(), nil
+	}
+
+	return shares, err
+}
+
+func (k Keeper) addValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares.Add(shares))
+}
+
+func (k Keeper) removeValidatorLiquidShares(ctx context.Context, valAddr sdk.ValAddress, shares math.LegacyDec) error {
+	liquidShares, err := k.GetValidatorLiquidShares(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	liquidShares = liquidShares.Sub(shares)
+	if !liquidShares.IsPositive() {
+		return k.ValidatorLiquidShares.Remove(ctx, valAddr)
+	}
+
+	return k.ValidatorLiquidShares.Set(ctx, valAddr, liquidShares)
+}
+
+// TotalLiquidStakedTokens returns the amount of tokens represented by the delegator shares
+// held by tokenize share records, across all validators.
+func (k Keeper) TotalLiquidStakedTokens(ctx context.Context) (math.Int, error) {
+	total := math.LegacyZeroDec()
+	err := k.ValidatorLiquidShares.Walk(ctx, nil, func(valAddr []byte, shares math.LegacyDec) (stop bool, err error) {
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return true, err
+		}
+
+		total = total.Add(validator.TokensFromShares(shares))
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return total.TruncateInt(), nil
+}
+
+// GetTokenizeShareRecordByDenom returns the tokenize share record of the given share token denom.
+func (k Keeper) GetTokenizeShareRecordByDenom(ctx context.Context, denom string) (types.TokenizeShareRecord, error) {
+	id, err := types.ParseShareTokenDenom(denom)
+	if err != nil {
+		return types.TokenizeShareRecord{}, errorsmod.Wrap(types.ErrTokenizeShareRecordNotFound, err.Error())
+	}
+
+	record, err := k.TokenizeShareRecords.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) || (err == nil && record.GetShareTokenDenom() != denom) {
+		return types.TokenizeShareRecord{}, errorsmod.Wrapf(types.ErrTokenizeShareRecordNotFound, "denom %s", denom)
+	} else if err != nil {
+		return types.TokenizeShareRecord{}, err
+	}
+
+	return record, nil
+}
+
+// GetTokenizeShareRecordsByOwner returns the tokenize share records owned by the given address.
+func (k Keeper) GetTokenizeShareRecordsByOwner(ctx context.Context, owner sdk.AccAddress) ([]types.TokenizeShareRecord, error) {
+	iterator, err := k.TokenizeShareRecords.Indexes.Owner.MatchExact(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	return indexes.CollectValues(ctx, k.TokenizeShareRecords, iterator)
+}
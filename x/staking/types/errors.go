@@ -52,4 +52,10 @@ var (
 	ErrConsensusPubKeyAlreadyUsedForValidator = errors.Register(ModuleName, 46, "consensus pubkey is already used for a validator")
 	ErrExceedingMaxConsPubKeyRotations        = errors.Register(ModuleName, 47, "exceeding maximum consensus pubkey rotations within unbonding period")
 	ErrConsensusPubKeyLenInvalid              = errors.Register(ModuleName, 48, "consensus pubkey len is invalid")
+
+	// liquid staking errors
+	ErrTokenizeShareRecordNotFound       = errors.Register(ModuleName, 49, "tokenize share record not found")
+	ErrGlobalLiquidStakingCapExceeded    = errors.Register(ModuleName, 50, "tokenization exceeds the global liquid staking cap")
+	ErrValidatorLiquidStakingCapExceeded = errors.Register(ModuleName, 51, "tokenization exceeds the validator liquid staking cap")
+	ErrRedelegationInProgress            = errors.Register(ModuleName, 52, "delegator is not allowed to tokenize shares from a validator with a redelegation in progress")
 )
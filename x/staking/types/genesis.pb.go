@@ -46,6 +46,10 @@ type GenesisState struct {
 	Redelegations []Redelegation `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
 	// exported defines a bool to identify whether the chain dealing with exported or initialized genesis.
 	Exported bool `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// tokenize_share_records defines the tokenize share records active at genesis.
+	TokenizeShareRecords []TokenizeShareRecord `protobuf:"bytes,9,rep,name=tokenize_share_records,json=tokenizeShareRecords,proto3" json:"tokenize_share_records"`
+	// last_tokenize_share_record_id is the id of the last created tokenize share record.
+	LastTokenizeShareRecordId uint64 `protobuf:"varint,10,opt,name=last_tokenize_share_record_id,json=lastTokenizeShareRecordId,proto3" json:"last_tokenize_share_record_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -130,6 +134,20 @@ func (m *GenesisState) GetExported() bool {
 	return false
 }
 
+func (m *GenesisState) GetTokenizeShareRecords() []TokenizeShareRecord {
+	if m != nil {
+		return m.TokenizeShareRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastTokenizeShareRecordId() uint64 {
+	if m != nil {
+		return m.LastTokenizeShareRecordId
+	}
+	return 0
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -181,39 +199,44 @@ func init() {
 }
 
 var fileDescriptor_9b3dec8894f2831b = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0x86, 0x6d, 0x42, 0xd2, 0xf4, 0x52, 0x10, 0x1c, 0x09, 0x32, 0x11, 0x72, 0x42, 0xd4, 0x21,
-	0x2a, 0xaa, 0x4d, 0x83, 0xc4, 0xc0, 0xd6, 0x08, 0x09, 0x55, 0xaa, 0x44, 0xe5, 0x52, 0x86, 0x2e,
-	0xd1, 0x05, 0x9f, 0xdc, 0x53, 0x9c, 0x3b, 0xcb, 0xf7, 0xb5, 0x94, 0x7f, 0xc0, 0xc8, 0xc6, 0xda,
-	0x91, 0x91, 0xa1, 0x3f, 0xa2, 0x63, 0xd5, 0x09, 0x31, 0x54, 0x28, 0x19, 0xe0, 0x67, 0x20, 0xdf,
-	0x39, 0xe6, 0xaa, 0xd4, 0x4b, 0x94, 0xcb, 0xf7, 0xbc, 0xcf, 0xfb, 0x49, 0xb9, 0x43, 0xeb, 0x1f,
-	0x85, 0x9c, 0x0a, 0xe9, 0x4b, 0x20, 0x13, 0xc6, 0x23, 0xff, 0x64, 0x6b, 0x4c, 0x81, 0x6c, 0xf9,
-	0x11, 0xe5, 0x54, 0x32, 0xe9, 0x25, 0xa9, 0x00, 0x81, 0x1f, 0x6b, 0xca, 0xcb, 0x29, 0x2f, 0xa7,
-	0xda, 0xcd, 0x48, 0x44, 0x42, 0x21, 0x7e, 0xf6, 0x4d, 0xd3, 0xed, 0x32, 0xe7, 0x22, 0xad, 0xa9,
-	0x27, 0x9a, 0x1a, 0xe9, 0x78, 0x5e, 0xa0, 0x47, 0x0f, 0xc9, 0x94, 0x71, 0xe1, 0xab, 0x4f, 0xfd,
-	0x53, 0xef, 0x5b, 0x15, 0xad, 0xbd, 0xd5, 0x3b, 0xed, 0x03, 0x01, 0x8a, 0xb7, 0x51, 0x2d, 0x21,
-	0x29, 0x99, 0x4a, 0xc7, 0xee, 0xda, 0xfd, 0xc6, 0xc0, 0xf5, 0x6e, 0xdf, 0xd1, 0xdb, 0x53, 0xd4,
-	0x70, 0xf5, 0xe2, 0xba, 0x63, 0x7d, 0xff, 0xf3, 0x63, 0xc3, 0x0e, 0xf2, 0x20, 0x3e, 0x44, 0x0f,
-	0x62, 0x22, 0x61, 0x04, 0x02, 0x48, 0x3c, 0x4a, 0xc4, 0x27, 0x9a, 0x3a, 0x77, 0xba, 0x76, 0x7f,
-	0x6d, 0xf8, 0x22, 0x83, 0x7f, 0x5d, 0x77, 0x5a, 0xda, 0x29, 0xc3, 0x89, 0xc7, 0x84, 0x3f, 0x25,
-	0x70, 0xe4, 0xed, 0x70, 0xb8, 0x3a, 0xdf, 0x44, 0x79, 0xd9, 0x0e, 0x07, 0xed, 0xbc, 0x9f, 0x99,
-	0xde, 0x67, 0xa2, 0xbd, 0xcc, 0x83, 0x19, 0x6a, 0x29, 0xf7, 0x09, 0x89, 0x59, 0x48, 0x40, 0xa4,
-	0xda, 0x2f, 0x9d, 0x4a, 0xb7, 0xd2, 0x6f, 0x0c, 0x36, 0xca, 0xb6, 0xdd, 0x25, 0x12, 0x3e, 0x2c,
-	0x32, 0x4a, 0x65, 0x6e, 0xfe, 0x28, 0x5e, 0x1a, 0x4b, 0xbc, 0x8b, 0x50, 0xd1, 0x22, 0x9d, 0xbb,
-	0xca, 0xff, 0xac, 0xcc, 0x5f, 0x84, 0x4d, 0xad, 0x91, 0xc7, 0xef, 0x50, 0x23, 0xa4, 0x31, 0x8d,
-	0x08, 0x30, 0xc1, 0xa5, 0x53, 0x55, 0xba, 0x5e, 0x99, 0xee, 0x4d, 0x81, 0x9a, 0x3e, 0xd3, 0x80,
-	0x27, 0xa8, 0x75, 0xcc, 0xc7, 0x82, 0x87, 0x8c, 0x47, 0x23, 0x53, 0x5d, 0x53, 0xea, 0xe7, 0x65,
-	0xea, 0x83, 0x45, 0xe8, 0xf6, 0x8e, 0xe6, 0xf1, 0xf2, 0x5c, 0xe2, 0x03, 0x74, 0x2f, 0xa5, 0x66,
-	0xc9, 0x8a, 0x2a, 0x59, 0x2f, 0x2b, 0x09, 0x0c, 0xd8, 0xb4, 0xdf, 0xb4, 0xe0, 0x36, 0xaa, 0xd3,
-	0xd3, 0x44, 0xa4, 0x40, 0x43, 0xa7, 0xde, 0xb5, 0xfb, 0xf5, 0xa0, 0x38, 0xf7, 0x8e, 0x10, 0x5e,
-	0xfe, 0xd3, 0xf0, 0x00, 0xad, 0x90, 0x30, 0x4c, 0xa9, 0xd4, 0xf7, 0x73, 0x75, 0xe8, 0x5c, 0x9d,
-	0x6f, 0x36, 0xf3, 0x2d, 0xb6, 0xf5, 0x64, 0x1f, 0x52, 0xc6, 0xa3, 0x60, 0x01, 0xe2, 0x26, 0xaa,
-	0xfe, 0xbf, 0x84, 0x95, 0x40, 0x1f, 0x5e, 0xd7, 0xbf, 0x9c, 0x75, 0xac, 0xbf, 0x67, 0x1d, 0x6b,
-	0xf8, 0xea, 0x62, 0xe6, 0xda, 0x97, 0x33, 0xd7, 0xfe, 0x3d, 0x73, 0xed, 0xaf, 0x73, 0xd7, 0xba,
-	0x9c, 0xbb, 0xd6, 0xcf, 0xb9, 0x6b, 0x1d, 0x3e, 0xbd, 0x71, 0x4f, 0x4f, 0x8b, 0x97, 0x07, 0x9f,
-	0x13, 0x2a, 0xc7, 0x35, 0xf5, 0x84, 0x5e, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x45, 0x8a, 0xed,
-	0xf3, 0xec, 0x03, 0x00, 0x00,
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
+	0x18, 0xc6, 0x6d, 0xda, 0xa6, 0xc9, 0xa5, 0xfc, 0xbb, 0x26, 0x95, 0x1b, 0x15, 0xc7, 0x44, 0x1d,
+	0xa2, 0xa2, 0xd8, 0x6d, 0x10, 0x0c, 0x6c, 0xb5, 0x90, 0x50, 0xa4, 0x4a, 0x54, 0x4e, 0xcb, 0xd0,
+	0xc5, 0xba, 0xf4, 0x4e, 0x8e, 0x95, 0xc4, 0x17, 0xdd, 0x5d, 0x4b, 0x61, 0x61, 0x65, 0xe4, 0x23,
+	0x74, 0x64, 0x64, 0xc8, 0x87, 0xe8, 0x58, 0x75, 0x42, 0x0c, 0x15, 0x4a, 0x06, 0xd8, 0xf8, 0x0a,
+	0xc8, 0x77, 0x4e, 0x70, 0x95, 0x98, 0x25, 0xca, 0xf9, 0x7d, 0x9e, 0xdf, 0xf3, 0xbe, 0xd2, 0x7b,
+	0x07, 0xb6, 0x4f, 0x29, 0x1f, 0x50, 0xee, 0x70, 0x81, 0x7a, 0x61, 0x14, 0x38, 0xe7, 0x7b, 0x1d,
+	0x22, 0xd0, 0x9e, 0x13, 0x90, 0x88, 0xf0, 0x90, 0xdb, 0x43, 0x46, 0x05, 0x85, 0x1b, 0x4a, 0x65,
+	0x27, 0x2a, 0x3b, 0x51, 0x55, 0x4a, 0x01, 0x0d, 0xa8, 0x94, 0x38, 0xf1, 0x3f, 0xa5, 0xae, 0x64,
+	0x31, 0xa7, 0x6e, 0xa5, 0xda, 0x54, 0x2a, 0x5f, 0xd9, 0x93, 0x00, 0x55, 0x7a, 0x8c, 0x06, 0x61,
+	0x44, 0x1d, 0xf9, 0xab, 0x3e, 0xd5, 0xfe, 0xe4, 0xc0, 0xda, 0x1b, 0xd5, 0x53, 0x5b, 0x20, 0x41,
+	0xe0, 0x3e, 0xc8, 0x0d, 0x11, 0x43, 0x03, 0x6e, 0xe8, 0x96, 0x5e, 0x2f, 0x36, 0x4d, 0x7b, 0x71,
+	0x8f, 0xf6, 0xa1, 0x54, 0xb9, 0x85, 0xab, 0xdb, 0xaa, 0xf6, 0xf5, 0xd7, 0xb7, 0x1d, 0xdd, 0x4b,
+	0x8c, 0xf0, 0x04, 0x3c, 0xea, 0x23, 0x2e, 0x7c, 0x41, 0x05, 0xea, 0xfb, 0x43, 0xfa, 0x9e, 0x30,
+	0xe3, 0x9e, 0xa5, 0xd7, 0xd7, 0xdc, 0xdd, 0x58, 0xfc, 0xe3, 0xb6, 0x5a, 0x56, 0x4c, 0x8e, 0x7b,
+	0x76, 0x48, 0x9d, 0x01, 0x12, 0x5d, 0xbb, 0x15, 0x89, 0x9b, 0x51, 0x03, 0x24, 0x61, 0xad, 0x48,
+	0x28, 0xe6, 0x83, 0x98, 0x74, 0x14, 0x83, 0x0e, 0x63, 0x0e, 0x0c, 0x41, 0x59, 0xb2, 0xcf, 0x51,
+	0x3f, 0xc4, 0x48, 0x50, 0xa6, 0xf8, 0xdc, 0x58, 0xb2, 0x96, 0xea, 0xc5, 0xe6, 0x4e, 0x56, 0xb7,
+	0x07, 0x88, 0x8b, 0x77, 0x53, 0x8f, 0x44, 0xa5, 0x3b, 0x5f, 0xef, 0xcf, 0x95, 0x39, 0x3c, 0x00,
+	0x60, 0x96, 0xc2, 0x8d, 0x65, 0xc9, 0x7f, 0x9a, 0xc5, 0x9f, 0x99, 0xd3, 0xd8, 0x94, 0x1f, 0xbe,
+	0x05, 0x45, 0x4c, 0xfa, 0x24, 0x40, 0x22, 0xa4, 0x11, 0x37, 0x56, 0x24, 0xae, 0x96, 0x85, 0x7b,
+	0x3d, 0x93, 0xa6, 0x79, 0x69, 0x02, 0xec, 0x81, 0xf2, 0x59, 0xd4, 0xa1, 0x11, 0x0e, 0xa3, 0xc0,
+	0x4f, 0xa3, 0x73, 0x12, 0xfd, 0x2c, 0x0b, 0x7d, 0x3c, 0x35, 0x2d, 0xce, 0x28, 0x9d, 0xcd, 0xd7,
+	0x39, 0x3c, 0x06, 0xf7, 0x19, 0x49, 0x87, 0xac, 0xca, 0x90, 0xed, 0xac, 0x10, 0x8f, 0xe0, 0x85,
+	0xf4, 0xbb, 0x14, 0x58, 0x01, 0x79, 0x72, 0x31, 0xa4, 0x4c, 0x10, 0x6c, 0xe4, 0x2d, 0xbd, 0x9e,
+	0xf7, 0x66, 0x67, 0xf8, 0x09, 0x6c, 0x08, 0xda, 0x23, 0x51, 0xf8, 0x91, 0xf8, 0xbc, 0x8b, 0x18,
+	0xf1, 0x19, 0x39, 0xa5, 0x0c, 0x73, 0xa3, 0xf0, 0xff, 0x01, 0x8f, 0x12, 0x57, 0x3b, 0x36, 0x79,
+	0xd2, 0xe3, 0x6e, 0xc9, 0xc5, 0x1b, 0x35, 0x1e, 0x2a, 0x4f, 0x83, 0xe3, 0x9e, 0xb5, 0x6b, 0xbf,
+	0x68, 0x26, 0x33, 0x8b, 0x79, 0x4b, 0x3c, 0xf3, 0x93, 0x64, 0x8d, 0x17, 0x74, 0xe1, 0x87, 0xd8,
+	0x00, 0x96, 0x5e, 0x5f, 0x76, 0xd7, 0x17, 0x60, 0xbd, 0x4d, 0xb5, 0xb6, 0x73, 0xd4, 0x16, 0xae,
+	0x75, 0x01, 0x9c, 0x5f, 0x46, 0xd8, 0x04, 0xab, 0x08, 0x63, 0x46, 0xb8, 0xba, 0x77, 0x05, 0xd7,
+	0xb8, 0x19, 0x35, 0x4a, 0xc9, 0x84, 0xfb, 0xaa, 0xd2, 0x16, 0x2c, 0x8c, 0x02, 0x6f, 0x2a, 0x84,
+	0x25, 0xb0, 0xf2, 0xef, 0x72, 0x2d, 0x79, 0xea, 0xf0, 0x2a, 0xff, 0xf9, 0xb2, 0xaa, 0xfd, 0xbe,
+	0xac, 0x6a, 0xee, 0xcb, 0xab, 0xb1, 0xa9, 0x5f, 0x8f, 0x4d, 0xfd, 0xe7, 0xd8, 0xd4, 0xbf, 0x4c,
+	0x4c, 0xed, 0x7a, 0x62, 0x6a, 0xdf, 0x27, 0xa6, 0x76, 0xb2, 0x75, 0xe7, 0xfe, 0x5d, 0xcc, 0x5e,
+	0x14, 0xf1, 0x61, 0x48, 0x78, 0x27, 0x27, 0x9f, 0x86, 0xe7, 0x7f, 0x07, 0x00, 0x96, 0x22, 0x82,
+	0x50, 0xc4, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -236,6 +259,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastTokenizeShareRecordId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastTokenizeShareRecordId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for iNdEx := len(m.TokenizeShareRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenizeShareRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.Exported {
 		i--
 		if m.Exported {
@@ -428,6 +470,15 @@ func (m *GenesisState) Size() (n int) {
 	if m.Exported {
 		n += 2
 	}
+	if len(m.TokenizeShareRecords) > 0 {
+		for _, e := range m.TokenizeShareRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastTokenizeShareRecordId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastTokenizeShareRecordId))
+	}
 	return n
 }
 
@@ -738,6 +789,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenizeShareRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenizeShareRecords = append(m.TokenizeShareRecords, TokenizeShareRecord{})
+			if err := m.TokenizeShareRecords[len(m.TokenizeShareRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTokenizeShareRecordId", wireType)
+			}
+			m.LastTokenizeShareRecordId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastTokenizeShareRecordId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
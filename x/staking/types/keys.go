@@ -68,6 +68,11 @@ var (
 	ValidatorConsensusKeyRotationRecordIndexKey = collections.NewPrefix(104) // this key is used to restrict the validator next rotation within waiting (unbonding) period
 	NewToOldConsKeyMap                          = collections.NewPrefix(105) // prefix for rotated cons address to new cons address
 	OldToNewConsKeyMap                          = collections.NewPrefix(106) // prefix for rotated cons address to new cons address
+
+	TokenizeShareRecordKey        = collections.NewPrefix(120) // prefix for each key to a tokenize share record
+	TokenizeShareRecordByOwnerKey = collections.NewPrefix(121) // prefix for each key to a tokenize share record, by owner
+	LastTokenizeShareRecordIDKey  = collections.NewPrefix(122) // key for the id of the last created tokenize share record
+	ValidatorLiquidSharesKey      = collections.NewPrefix(123) // prefix for the tokenized shares of each validator
 )
 
 // Reserved kvstore keys
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// TokenizeShareRecordAddress returns the address holding the delegation of the
+// tokenize share record with the given id.
+func TokenizeShareRecordAddress(id uint64) sdk.AccAddress {
+	return address.Module(ModuleName, []byte("tokenize_share_record"), sdk.Uint64ToBigEndian(id))
+}
+
+// GetShareTokenDenom returns the denom of the share tokens minted for the record.
+func (r TokenizeShareRecord) GetShareTokenDenom() string {
+	return fmt.Sprintf("%s/%d", strings.ToLower(r.Validator), r.Id)
+}
+
+// ParseShareTokenDenom returns the id of the tokenize share record the given
+// share token denom belongs to.
+func ParseShareTokenDenom(denom string) (uint64, error) {
+	i := strings.LastIndex(denom, "/")
+	if i < 0 {
+		return 0, fmt.Errorf("invalid share token denom: %s", denom)
+	}
+
+	id, err := strconv.ParseUint(denom[i+1:], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid share token denom %s: %w", denom, err)
+	}
+
+	return id, nil
+}
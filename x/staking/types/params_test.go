@@ -46,4 +46,21 @@ func TestValidateParams(t *testing.T) {
 	// check keyRotationFee
 	params.KeyRotationFee = coinZero
 	require.Error(t, params.Validate())
+
+	// reset params to default
+	params = types.DefaultParams()
+
+	// validate liquid staking caps
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(-1, 1)
+	require.Error(t, params.Validate())
+
+	params.GlobalLiquidStakingCap = math.LegacyNewDecWithPrec(25, 2)
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(11, 1)
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = math.LegacyDec{}
+	require.Error(t, params.Validate())
+
+	params.ValidatorLiquidStakingCap = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, params.Validate())
 }
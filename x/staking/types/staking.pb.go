@@ -999,6 +999,12 @@ type Params struct {
 	// key_rotation_fee is fee to be spent when rotating validator's key
 	// (either consensus pubkey or operator key)
 	KeyRotationFee types.Coin `protobuf:"bytes,7,opt,name=key_rotation_fee,json=keyRotationFee,proto3" json:"key_rotation_fee"`
+	// global_liquid_staking_cap is the maximum fraction of the total bonded tokens that can be
+	// tokenized with MsgTokenizeShares. A cap of one disables the check.
+	GlobalLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=global_liquid_staking_cap,json=globalLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"global_liquid_staking_cap"`
+	// validator_liquid_staking_cap is the maximum fraction of a validator's delegator shares that
+	// can be tokenized with MsgTokenizeShares. A cap of one disables the check.
+	ValidatorLiquidStakingCap cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=validator_liquid_staking_cap,json=validatorLiquidStakingCap,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_liquid_staking_cap"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -1414,6 +1420,81 @@ func (m *ValAddrsOfRotatedConsKeys) GetAddresses() [][]byte {
 	return nil
 }
 
+// TokenizeShareRecord represents a delegation converted into share tokens with MsgTokenizeShares.
+// The delegation is held by an account derived from the record id, and the share tokens of the
+// record are denominated as {validator}/{id}.
+type TokenizeShareRecord struct {
+	// id is the unique identifier of the record.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address entitled to the rewards accrued by the tokenized delegation.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// module_account is the address holding the tokenized delegation.
+	ModuleAccount string `protobuf:"bytes,3,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// validator is the address of the validator the tokenized delegation is bonded to.
+	Validator string `protobuf:"bytes,4,opt,name=validator,proto3" json:"validator,omitempty"`
+}
+
+func (m *TokenizeShareRecord) Reset()         { *m = TokenizeShareRecord{} }
+func (m *TokenizeShareRecord) String() string { return proto.CompactTextString(m) }
+func (*TokenizeShareRecord) ProtoMessage()    {}
+func (*TokenizeShareRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_64c30c6cf92913c9, []int{24}
+}
+func (m *TokenizeShareRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenizeShareRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenizeShareRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenizeShareRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenizeShareRecord.Merge(m, src)
+}
+func (m *TokenizeShareRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenizeShareRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenizeShareRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenizeShareRecord proto.InternalMessageInfo
+
+func (m *TokenizeShareRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TokenizeShareRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
+	}
+	return ""
+}
+
+func (m *TokenizeShareRecord) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("cosmos.staking.v1beta1.BondStatus", BondStatus_name, BondStatus_value)
 	proto.RegisterEnum("cosmos.staking.v1beta1.Infraction", Infraction_name, Infraction_value)
@@ -1441,6 +1522,7 @@ func init() {
 	proto.RegisterType((*ValidatorUpdates)(nil), "cosmos.staking.v1beta1.ValidatorUpdates")
 	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "cosmos.staking.v1beta1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*ValAddrsOfRotatedConsKeys)(nil), "cosmos.staking.v1beta1.ValAddrsOfRotatedConsKeys")
+	proto.RegisterType((*TokenizeShareRecord)(nil), "cosmos.staking.v1beta1.TokenizeShareRecord")
 }
 
 func init() {
@@ -1448,140 +1530,148 @@ func init() {
 }
 
 var fileDescriptor_64c30c6cf92913c9 = []byte{
-	// 2113 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4b, 0x6c, 0x1b, 0xc7,
-	0xf9, 0xd7, 0x92, 0x34, 0x25, 0x7d, 0xa4, 0x44, 0x6a, 0xfc, 0xa2, 0xe8, 0x58, 0x94, 0x19, 0xff,
-	0xff, 0xb1, 0xdd, 0x8a, 0x8a, 0xdc, 0xc0, 0x05, 0x84, 0x20, 0x85, 0x29, 0xd2, 0x31, 0xf3, 0x90,
-	0xd4, 0xa5, 0xa4, 0x3e, 0xd0, 0x66, 0x31, 0xdc, 0x1d, 0x92, 0x5b, 0x91, 0xb3, 0xec, 0xce, 0x50,
-	0x36, 0xef, 0x3d, 0x04, 0x0e, 0x0a, 0xe4, 0x54, 0x04, 0x28, 0x8c, 0x1a, 0xe8, 0x25, 0xbd, 0xe5,
-	0x60, 0xf4, 0xde, 0x5b, 0x5a, 0xa0, 0x80, 0xe1, 0x53, 0x11, 0xa0, 0x6e, 0x61, 0x1f, 0x1c, 0xb4,
-	0x97, 0xa2, 0xa7, 0x1e, 0x8b, 0x99, 0x9d, 0x7d, 0x50, 0x14, 0xad, 0x87, 0x83, 0x22, 0x68, 0x2f,
-	0x02, 0x67, 0xe6, 0xfb, 0x7e, 0xfb, 0xbd, 0x67, 0xbe, 0x4f, 0x70, 0xd9, 0x74, 0x58, 0xd7, 0x61,
-	0xcb, 0x8c, 0xe3, 0x5d, 0x9b, 0xb6, 0x96, 0xf7, 0x56, 0x1a, 0x84, 0xe3, 0x15, 0x7f, 0x5d, 0xea,
-	0xb9, 0x0e, 0x77, 0xd0, 0x39, 0x8f, 0xaa, 0xe4, 0xef, 0x2a, 0xaa, 0xfc, 0x99, 0x96, 0xd3, 0x72,
-	0x24, 0xc9, 0xb2, 0xf8, 0xe5, 0x51, 0xe7, 0xe7, 0x5b, 0x8e, 0xd3, 0xea, 0x90, 0x65, 0xb9, 0x6a,
-	0xf4, 0x9b, 0xcb, 0x98, 0x0e, 0xd4, 0xd1, 0xc2, 0xfe, 0x23, 0xab, 0xef, 0x62, 0x6e, 0x3b, 0x54,
-	0x9d, 0x17, 0xf6, 0x9f, 0x73, 0xbb, 0x4b, 0x18, 0xc7, 0xdd, 0x9e, 0x8f, 0xed, 0x49, 0x62, 0x78,
-	0x1f, 0x55, 0x62, 0x29, 0x6c, 0xa5, 0x4a, 0x03, 0x33, 0x12, 0xe8, 0x61, 0x3a, 0xb6, 0x8f, 0x3d,
-	0x87, 0xbb, 0x36, 0x75, 0x96, 0xe5, 0x5f, 0xb5, 0x75, 0xd1, 0x74, 0xba, 0x84, 0x37, 0x9a, 0x7c,
-	0x99, 0x0f, 0x7a, 0x84, 0x2d, 0xef, 0xad, 0x78, 0x3f, 0xd4, 0xf1, 0x2b, 0xc1, 0x31, 0x6e, 0x98,
-	0xf6, 0xbe, 0xd3, 0xe2, 0x27, 0x1a, 0xcc, 0xde, 0xb6, 0x19, 0x77, 0x5c, 0xdb, 0xc4, 0x9d, 0x1a,
-	0x6d, 0x3a, 0xe8, 0x4d, 0x48, 0xb6, 0x09, 0xb6, 0x88, 0x9b, 0xd3, 0x16, 0xb5, 0x2b, 0xa9, 0xeb,
-	0xf3, 0x25, 0x1f, 0xa1, 0xe4, 0x71, 0xee, 0xad, 0x94, 0x6e, 0x4b, 0x82, 0xf2, 0xf4, 0xe7, 0x4f,
-	0x0a, 0x13, 0x9f, 0x3e, 0xff, 0xec, 0x9a, 0xa6, 0x2b, 0x1e, 0x54, 0x81, 0xe4, 0x1e, 0xee, 0x30,
-	0xc2, 0x73, 0xb1, 0xc5, 0xf8, 0x95, 0xd4, 0xf5, 0x4b, 0xa5, 0x83, 0xcd, 0x5e, 0xda, 0xc1, 0x1d,
-	0xdb, 0xc2, 0xdc, 0x19, 0x46, 0xf1, 0x78, 0x57, 0x63, 0x39, 0xad, 0xf8, 0x91, 0x06, 0xd9, 0x50,
-	0x34, 0x9d, 0x98, 0x8e, 0x6b, 0xa1, 0x1c, 0x4c, 0xe2, 0x5e, 0xaf, 0x8d, 0x59, 0x5b, 0x4a, 0x97,
-	0xd6, 0xfd, 0x25, 0x7a, 0x03, 0x12, 0xc2, 0xce, 0xb9, 0x98, 0x14, 0x3a, 0x5f, 0xf2, 0x9c, 0x50,
-	0xf2, 0x9d, 0x50, 0xda, 0xf2, 0x9d, 0x50, 0x4e, 0x7c, 0xfc, 0x97, 0x82, 0xa6, 0x4b, 0x6a, 0xf4,
-	0x1a, 0x64, 0xf6, 0x7c, 0x41, 0x98, 0x21, 0x71, 0xe3, 0x12, 0x77, 0x36, 0xdc, 0xbe, 0x8d, 0x59,
-	0xbb, 0xf8, 0x8b, 0x18, 0x64, 0xd6, 0x9c, 0x6e, 0xd7, 0x66, 0xcc, 0x76, 0xa8, 0x8e, 0x39, 0x61,
-	0xe8, 0x1d, 0x48, 0xb8, 0x98, 0x13, 0x29, 0xc9, 0x74, 0xf9, 0x86, 0x50, 0xe3, 0x8b, 0x27, 0x85,
-	0x0b, 0x9e, 0xc2, 0xcc, 0xda, 0x2d, 0xd9, 0xce, 0x72, 0x17, 0xf3, 0x76, 0xe9, 0x3d, 0xd2, 0xc2,
-	0xe6, 0xa0, 0x42, 0xcc, 0xc7, 0x0f, 0x97, 0x40, 0xd9, 0xa3, 0x42, 0x4c, 0x4f, 0x67, 0x89, 0x81,
-	0xbe, 0x0b, 0x53, 0x5d, 0x7c, 0xd7, 0x90, 0x78, 0xb1, 0x97, 0xc2, 0x9b, 0xec, 0xe2, 0xbb, 0x42,
-	0x3e, 0xf4, 0x01, 0x64, 0x04, 0xa4, 0xd9, 0xc6, 0xb4, 0x45, 0x3c, 0xe4, 0xf8, 0x4b, 0x21, 0xcf,
-	0x74, 0xf1, 0xdd, 0x35, 0x89, 0x26, 0xf0, 0x57, 0x13, 0x5f, 0x3e, 0x28, 0x68, 0xc5, 0xdf, 0x69,
-	0x00, 0xa1, 0x61, 0x10, 0x86, 0xac, 0x19, 0xac, 0xe4, 0x47, 0x99, 0x8a, 0xa3, 0xd7, 0xc6, 0x45,
-	0xc2, 0x3e, 0xb3, 0x96, 0x67, 0x84, 0x78, 0x8f, 0x9e, 0x14, 0x34, 0xef, 0xab, 0x19, 0x73, 0xc4,
-	0xec, 0xa9, 0x7e, 0xcf, 0xc2, 0x9c, 0x18, 0x47, 0x74, 0xb8, 0x04, 0x14, 0x4e, 0xf7, 0x00, 0xc1,
-	0xe3, 0x16, 0xe7, 0x4a, 0x87, 0x4f, 0x35, 0x48, 0x55, 0x08, 0x33, 0x5d, 0xbb, 0x27, 0xf2, 0x58,
-	0x44, 0x59, 0xd7, 0xa1, 0xf6, 0xae, 0xca, 0x81, 0x69, 0xdd, 0x5f, 0xa2, 0x3c, 0x4c, 0xd9, 0x16,
-	0xa1, 0xdc, 0xe6, 0x03, 0xcf, 0x4d, 0x7a, 0xb0, 0x16, 0x5c, 0x77, 0x48, 0x83, 0xd9, 0xbe, 0x9d,
-	0x75, 0x7f, 0x89, 0xae, 0x42, 0x96, 0x11, 0xb3, 0xef, 0xda, 0x7c, 0x60, 0x98, 0x0e, 0xe5, 0xd8,
-	0xe4, 0xb9, 0x84, 0x24, 0xc9, 0xf8, 0xfb, 0x6b, 0xde, 0xb6, 0x00, 0xb1, 0x08, 0xc7, 0x76, 0x87,
-	0xe5, 0x4e, 0x79, 0x20, 0x6a, 0xa9, 0x44, 0xbd, 0x3f, 0x09, 0xd3, 0x41, 0xea, 0xa0, 0x35, 0xc8,
-	0x3a, 0x3d, 0xe2, 0x8a, 0xdf, 0x06, 0xb6, 0x2c, 0x97, 0x30, 0xa6, 0xa2, 0x31, 0xf7, 0xf8, 0xe1,
-	0xd2, 0x19, 0x65, 0xf0, 0x9b, 0xde, 0x49, 0x9d, 0xbb, 0x36, 0x6d, 0xe9, 0x19, 0x9f, 0x43, 0x6d,
-	0xa3, 0x1f, 0x08, 0x97, 0x51, 0x46, 0x28, 0xeb, 0x33, 0xa3, 0xd7, 0x6f, 0xec, 0x92, 0x81, 0x32,
-	0xea, 0x99, 0x11, 0xa3, 0xde, 0xa4, 0x83, 0x72, 0xee, 0x0f, 0x21, 0xb4, 0xe9, 0x0e, 0x7a, 0xdc,
-	0x29, 0x6d, 0xf6, 0x1b, 0xef, 0x92, 0x81, 0x70, 0x95, 0xc2, 0xd9, 0x94, 0x30, 0xe8, 0x1c, 0x24,
-	0x7f, 0x82, 0xed, 0x0e, 0xb1, 0xa4, 0x45, 0xa6, 0x74, 0xb5, 0x42, 0xab, 0x90, 0x64, 0x1c, 0xf3,
-	0x3e, 0x93, 0x66, 0x98, 0xbd, 0x5e, 0x1c, 0x17, 0x1b, 0x65, 0x87, 0x5a, 0x75, 0x49, 0xa9, 0x2b,
-	0x0e, 0xb4, 0x06, 0x49, 0xee, 0xec, 0x12, 0xaa, 0x0c, 0x54, 0xfe, 0x86, 0x8a, 0xe6, 0xb3, 0xa3,
-	0xd1, 0x5c, 0xa3, 0x3c, 0x12, 0xc7, 0x35, 0xca, 0x75, 0xc5, 0x8a, 0x7e, 0x04, 0x59, 0x8b, 0x74,
-	0x48, 0x4b, 0x5a, 0x8e, 0xb5, 0xb1, 0x4b, 0x58, 0x2e, 0x29, 0xe1, 0x56, 0x8e, 0x9d, 0x1c, 0x7a,
-	0x26, 0x80, 0xaa, 0x4b, 0x24, 0xb4, 0x09, 0x29, 0x2b, 0x0c, 0xa7, 0xdc, 0xa4, 0x34, 0xe6, 0xab,
-	0xe3, 0x74, 0x8c, 0x44, 0x5e, 0xb4, 0x16, 0x46, 0x21, 0x44, 0x04, 0xf5, 0x69, 0xc3, 0xa1, 0x96,
-	0x4d, 0x5b, 0x46, 0x9b, 0xd8, 0xad, 0x36, 0xcf, 0x4d, 0x2d, 0x6a, 0x57, 0xe2, 0x7a, 0x26, 0xd8,
-	0xbf, 0x2d, 0xb7, 0xd1, 0x26, 0xcc, 0x86, 0xa4, 0x32, 0x43, 0xa6, 0x8f, 0x9b, 0x21, 0x33, 0x01,
-	0x80, 0x20, 0x41, 0xef, 0x03, 0x84, 0x39, 0x98, 0x03, 0x89, 0x56, 0x3c, 0x3c, 0x9b, 0xa3, 0xca,
-	0x44, 0x00, 0x10, 0x85, 0xd3, 0x5d, 0x9b, 0x1a, 0x8c, 0x74, 0x9a, 0x86, 0xb2, 0x9c, 0xc0, 0x4d,
-	0x49, 0xf3, 0xbf, 0x75, 0x0c, 0x6f, 0x7e, 0xf1, 0x70, 0x29, 0xe3, 0xad, 0x96, 0x98, 0xb5, 0xbb,
-	0xf8, 0x7a, 0xe9, 0x8d, 0x6f, 0xeb, 0x73, 0x5d, 0x9b, 0xd6, 0x49, 0xa7, 0x59, 0x09, 0x80, 0xd1,
-	0x9b, 0x70, 0x21, 0x34, 0x88, 0x43, 0x8d, 0xb6, 0xd3, 0xb1, 0x0c, 0x97, 0x34, 0x0d, 0xd3, 0xe9,
-	0x53, 0x9e, 0x4b, 0x4b, 0x33, 0x9e, 0x0f, 0x48, 0x36, 0xe8, 0x6d, 0xa7, 0x63, 0xe9, 0xa4, 0xb9,
-	0x26, 0x8e, 0xd1, 0xab, 0x10, 0x5a, 0xc3, 0xb0, 0x2d, 0x96, 0x9b, 0x59, 0x8c, 0x5f, 0x49, 0xe8,
-	0xe9, 0x60, 0xb3, 0x66, 0xb1, 0xd5, 0xa9, 0x0f, 0x1f, 0x14, 0x26, 0xbe, 0x7c, 0x50, 0x98, 0x28,
-	0xde, 0x82, 0xf4, 0x0e, 0xee, 0xa8, 0xd4, 0x22, 0x0c, 0xdd, 0x80, 0x69, 0xec, 0x2f, 0x72, 0xda,
-	0x62, 0xfc, 0x85, 0xa9, 0x19, 0x92, 0x16, 0x7f, 0xa3, 0x41, 0xb2, 0xb2, 0xb3, 0x89, 0x6d, 0x17,
-	0x55, 0x61, 0x2e, 0x8c, 0xd5, 0xa3, 0x66, 0x79, 0x18, 0xde, 0x7e, 0x9a, 0xaf, 0xc3, 0x5c, 0x70,
-	0xa7, 0x05, 0x30, 0xde, 0x55, 0x73, 0xe9, 0xf1, 0xc3, 0xa5, 0x8b, 0x0a, 0x26, 0x28, 0x2e, 0xfb,
-	0xf0, 0xf6, 0xf6, 0xed, 0x47, 0x74, 0x7e, 0x07, 0x26, 0x3d, 0x51, 0x19, 0xfa, 0x0e, 0x9c, 0xea,
-	0x89, 0x1f, 0x52, 0xd5, 0xd4, 0xf5, 0x85, 0xb1, 0x31, 0x2f, 0xe9, 0xa3, 0x11, 0xe2, 0xf1, 0x15,
-	0x3f, 0x8a, 0x01, 0x54, 0x76, 0x76, 0xb6, 0x5c, 0xbb, 0xd7, 0x21, 0xfc, 0xab, 0xd2, 0x7d, 0x1b,
-	0xce, 0x86, 0xba, 0x33, 0xd7, 0x3c, 0xbe, 0xfe, 0xa7, 0x03, 0xfe, 0xba, 0x6b, 0x1e, 0x08, 0x6b,
-	0x31, 0x1e, 0xc0, 0xc6, 0x8f, 0x0f, 0x5b, 0x61, 0x7c, 0xd4, 0xb2, 0xdf, 0x87, 0x54, 0x68, 0x0c,
-	0x86, 0x6a, 0x30, 0xc5, 0xd5, 0x6f, 0x65, 0xe0, 0xe2, 0x78, 0x03, 0xfb, 0x6c, 0x51, 0x23, 0x07,
-	0xec, 0xc5, 0x7f, 0x69, 0x00, 0x91, 0x1c, 0xf9, 0x7a, 0xc6, 0x18, 0xaa, 0x41, 0x52, 0x15, 0xe7,
-	0xf8, 0x49, 0x8b, 0xb3, 0x02, 0x88, 0x18, 0xf5, 0xe7, 0x31, 0x38, 0xbd, 0xed, 0x67, 0xef, 0xd7,
-	0xdf, 0x06, 0xdb, 0x30, 0x49, 0x28, 0x77, 0x6d, 0x69, 0x04, 0xe1, 0xf3, 0xd7, 0xc7, 0xf9, 0xfc,
-	0x00, 0xa5, 0xaa, 0x94, 0xbb, 0x83, 0x68, 0x04, 0xf8, 0x58, 0x11, 0x7b, 0xfc, 0x32, 0x0e, 0xb9,
-	0x71, 0xac, 0xe2, 0x81, 0x6c, 0xba, 0x44, 0x6e, 0xf8, 0xf7, 0x8e, 0x26, 0x0b, 0xe6, 0xac, 0xbf,
-	0xad, 0xae, 0x1d, 0x1d, 0xc4, 0x43, 0x4d, 0x04, 0x97, 0x20, 0x3d, 0xd9, 0xcb, 0x6c, 0x36, 0x44,
-	0x90, 0x17, 0xcf, 0x16, 0x64, 0x6c, 0x6a, 0x73, 0x1b, 0x77, 0x8c, 0x06, 0xee, 0x60, 0x6a, 0xfa,
-	0x2f, 0xd8, 0x63, 0xdd, 0xf9, 0xb3, 0x0a, 0xa3, 0xec, 0x41, 0xa0, 0x2a, 0x4c, 0xfa, 0x68, 0x89,
-	0xe3, 0xa3, 0xf9, 0xbc, 0xe8, 0x12, 0xa4, 0xa3, 0x17, 0x83, 0x7c, 0x8d, 0x24, 0xf4, 0x54, 0xe4,
-	0x5e, 0x38, 0xec, 0xe6, 0x49, 0xbe, 0xf0, 0xe6, 0x51, 0x0f, 0xbe, 0x5f, 0xc5, 0x61, 0x4e, 0x27,
-	0xd6, 0x7f, 0xbf, 0x5b, 0x36, 0x01, 0xbc, 0x54, 0x15, 0x95, 0x54, 0x79, 0xe6, 0x04, 0xf9, 0x3e,
-	0xed, 0x81, 0x54, 0x18, 0xff, 0x4f, 0x79, 0xe8, 0xcf, 0x31, 0x48, 0x47, 0x3d, 0xf4, 0x3f, 0x79,
-	0x69, 0xa1, 0xf5, 0xb0, 0x4c, 0x25, 0x64, 0x99, 0xba, 0x3a, 0xae, 0x4c, 0x8d, 0x44, 0xf3, 0x21,
-	0xf5, 0xe9, 0x79, 0x1c, 0x92, 0x9b, 0xd8, 0xc5, 0x5d, 0x86, 0x36, 0x46, 0xde, 0xb6, 0xfe, 0x8c,
-	0x62, 0x7f, 0x30, 0x57, 0xd4, 0x4c, 0xc6, 0x8b, 0xe5, 0x4f, 0xc6, 0x3d, 0x6d, 0xff, 0x0f, 0x66,
-	0x45, 0x8f, 0x1c, 0x36, 0xfb, 0xd2, 0xb8, 0x33, 0xb2, 0xd5, 0x0d, 0xb4, 0x67, 0xa8, 0x00, 0x29,
-	0x41, 0x16, 0xd6, 0x61, 0x41, 0x03, 0x5d, 0x7c, 0xb7, 0xea, 0xed, 0xa0, 0x25, 0x40, 0xed, 0x60,
-	0x56, 0x61, 0x84, 0x86, 0x10, 0x74, 0x73, 0xe1, 0x89, 0x4f, 0x7e, 0x11, 0x40, 0x48, 0x61, 0x58,
-	0x84, 0x3a, 0x5d, 0xd5, 0xe8, 0x4d, 0x8b, 0x9d, 0x8a, 0xd8, 0x40, 0x3f, 0xd3, 0xbc, 0x27, 0xf2,
-	0xbe, 0x4e, 0x5a, 0x75, 0x28, 0x5b, 0x47, 0x48, 0x8a, 0x7f, 0x3e, 0x29, 0xe4, 0x07, 0xb8, 0xdb,
-	0x59, 0x2d, 0x1e, 0x80, 0x53, 0x3c, 0xa8, 0xb9, 0x17, 0x0f, 0xe7, 0xe1, 0x4e, 0x1c, 0xd5, 0x20,
-	0xbb, 0x4b, 0x06, 0x86, 0xeb, 0x70, 0xaf, 0xd0, 0x34, 0x09, 0x51, 0xbd, 0xcc, 0xbc, 0xef, 0xdb,
-	0x06, 0x66, 0x24, 0xf2, 0xf4, 0xb7, 0x69, 0x39, 0x21, 0xa4, 0xd3, 0x67, 0x77, 0xc9, 0x40, 0x57,
-	0x7c, 0xb7, 0x08, 0x59, 0xbd, 0x2c, 0x32, 0xe5, 0xde, 0xf3, 0xcf, 0xae, 0x5d, 0x08, 0x1f, 0xec,
-	0xcb, 0x77, 0x83, 0x89, 0x9d, 0xe7, 0x5e, 0xf1, 0xe8, 0x45, 0xe1, 0x05, 0xa4, 0x13, 0xd6, 0x13,
-	0xfd, 0xa4, 0xe8, 0x3f, 0x22, 0x7d, 0x82, 0xf6, 0xe2, 0xfe, 0x23, 0xe4, 0x1f, 0xea, 0x3f, 0x22,
-	0xe9, 0xf9, 0x56, 0x58, 0xff, 0x63, 0x87, 0x69, 0x13, 0x8d, 0x4c, 0xc5, 0x24, 0xb3, 0x7e, 0xa2,
-	0xf8, 0x47, 0x0d, 0xe6, 0x47, 0x22, 0x39, 0x10, 0xd9, 0x04, 0xe4, 0x46, 0x0e, 0x65, 0x44, 0x0c,
-	0x94, 0xe8, 0x27, 0x4b, 0x8c, 0x39, 0x77, 0xe4, 0x12, 0xf8, 0x6a, 0x2e, 0x32, 0x55, 0xc5, 0x7e,
-	0xaf, 0xc1, 0x99, 0xa8, 0x00, 0x81, 0x2a, 0x75, 0x48, 0x47, 0x3f, 0xad, 0x94, 0xb8, 0x7c, 0x14,
-	0x25, 0xa2, 0xf2, 0x0f, 0x81, 0xa0, 0x9d, 0xb0, 0x5a, 0x78, 0x73, 0xc2, 0x95, 0x23, 0x1b, 0xc5,
-	0x17, 0xec, 0xc0, 0xaa, 0xe1, 0xf9, 0xe6, 0xef, 0x1a, 0x24, 0x36, 0x1d, 0xa7, 0x83, 0x7e, 0x0a,
-	0x73, 0xd4, 0xe1, 0x86, 0xc8, 0x2c, 0x62, 0x19, 0x6a, 0x6c, 0xe0, 0x55, 0xe2, 0xea, 0x0b, 0x6d,
-	0xf5, 0xb7, 0x27, 0x85, 0x51, 0xce, 0x61, 0x03, 0xaa, 0xe9, 0x14, 0x75, 0x78, 0x59, 0x12, 0x6d,
-	0x79, 0x93, 0x85, 0x26, 0xcc, 0x0c, 0x7f, 0xce, 0xab, 0xd6, 0x37, 0x0f, 0xfb, 0xdc, 0xcc, 0xa1,
-	0x9f, 0x4a, 0x37, 0x22, 0xdf, 0x59, 0x9d, 0x12, 0x5e, 0xfb, 0x87, 0xf0, 0xdc, 0x07, 0x90, 0x0d,
-	0x4a, 0xd5, 0xb6, 0x1c, 0x6d, 0x31, 0x74, 0x0b, 0x26, 0xbd, 0x29, 0x97, 0xdf, 0x28, 0x5c, 0x0a,
-	0xa7, 0xb8, 0xb8, 0x61, 0xda, 0xa5, 0xbd, 0xc8, 0x04, 0xd6, 0x63, 0x1a, 0xb2, 0xa7, 0x62, 0x96,
-	0x83, 0xd8, 0x47, 0x31, 0x98, 0x5f, 0x73, 0x28, 0x53, 0x43, 0x1e, 0x95, 0xd5, 0xde, 0x68, 0x76,
-	0x80, 0xae, 0x8e, 0x19, 0x41, 0xa5, 0x47, 0x07, 0x4d, 0x3b, 0x90, 0x11, 0xd7, 0xab, 0xe9, 0xd0,
-	0x97, 0x9c, 0x33, 0xcd, 0x38, 0x1d, 0x4b, 0x49, 0xb4, 0x4b, 0x06, 0x02, 0x97, 0x92, 0x3b, 0x43,
-	0xb8, 0xf1, 0x93, 0xe1, 0x52, 0x72, 0x27, 0x82, 0x7b, 0x0e, 0x92, 0xea, 0x6d, 0x95, 0x90, 0x2f,
-	0x07, 0xb5, 0x42, 0x37, 0x20, 0x2e, 0x4a, 0xe1, 0xa9, 0x63, 0x14, 0x0f, 0xc1, 0x10, 0xb9, 0xd2,
-	0xea, 0x30, 0xaf, 0xa6, 0x04, 0x6c, 0xa3, 0x29, 0x2d, 0x4a, 0xa4, 0x42, 0xef, 0x92, 0xc1, 0x01,
-	0x23, 0x83, 0xf4, 0x91, 0x46, 0x06, 0xd7, 0x7e, 0xab, 0x01, 0x84, 0xf3, 0x32, 0xf4, 0x4d, 0x38,
-	0x5f, 0xde, 0x58, 0xaf, 0x18, 0xf5, 0xad, 0x9b, 0x5b, 0xdb, 0x75, 0x63, 0x7b, 0xbd, 0xbe, 0x59,
-	0x5d, 0xab, 0xdd, 0xaa, 0x55, 0x2b, 0xd9, 0x89, 0x7c, 0xe6, 0xde, 0xfd, 0xc5, 0xd4, 0x36, 0x65,
-	0x3d, 0x62, 0xda, 0x4d, 0x9b, 0x58, 0xe8, 0xff, 0xe1, 0xcc, 0x30, 0xb5, 0x58, 0x55, 0x2b, 0x59,
-	0x2d, 0x9f, 0xbe, 0x77, 0x7f, 0x71, 0xca, 0xeb, 0x0f, 0x88, 0x85, 0xae, 0xc0, 0xd9, 0x51, 0xba,
-	0xda, 0xfa, 0xdb, 0xd9, 0x58, 0x7e, 0xe6, 0xde, 0xfd, 0xc5, 0xe9, 0xa0, 0x91, 0x40, 0x45, 0x40,
-	0x51, 0x4a, 0x85, 0x17, 0xcf, 0xc3, 0xbd, 0xfb, 0x8b, 0x49, 0x2f, 0x65, 0xf2, 0x89, 0x0f, 0x7f,
-	0xbd, 0x30, 0x71, 0xed, 0xc7, 0x00, 0x35, 0xda, 0x74, 0xb1, 0x29, 0x4b, 0x43, 0x1e, 0xce, 0xd5,
-	0xd6, 0x6f, 0xe9, 0x37, 0xd7, 0xb6, 0x6a, 0x1b, 0xeb, 0xc3, 0x62, 0xef, 0x3b, 0xab, 0x6c, 0x6c,
-	0x97, 0xdf, 0xab, 0x1a, 0xf5, 0xda, 0xdb, 0xeb, 0x59, 0x0d, 0x9d, 0x87, 0xd3, 0x43, 0x67, 0xdf,
-	0x5b, 0xdf, 0xaa, 0xbd, 0x5f, 0xcd, 0xc6, 0xca, 0x37, 0x3e, 0x7f, 0xba, 0xa0, 0x3d, 0x7a, 0xba,
-	0xa0, 0xfd, 0xf5, 0xe9, 0x82, 0xf6, 0xf1, 0xb3, 0x85, 0x89, 0x47, 0xcf, 0x16, 0x26, 0xfe, 0xf4,
-	0x6c, 0x61, 0xe2, 0x87, 0xaf, 0x0c, 0x25, 0x63, 0x78, 0x1d, 0xc9, 0xff, 0x73, 0x34, 0x92, 0x32,
-	0x6a, 0xbe, 0xf5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x32, 0xa6, 0xd0, 0x99, 0x5f, 0x1a, 0x00,
-	0x00,
+	// 2254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x34, 0x25, 0x3e, 0x51, 0x24, 0x35, 0x72, 0x1c, 0x8a, 0xb1, 0x45, 0x99, 0x71,
+	0x1b, 0xc7, 0xad, 0xa8, 0xc8, 0x4d, 0x5d, 0x40, 0x08, 0x62, 0x88, 0xa2, 0x1c, 0x33, 0x71, 0x24,
+	0x75, 0x29, 0xa9, 0x3f, 0x68, 0xb3, 0x18, 0xee, 0x0e, 0xc9, 0xad, 0x96, 0xbb, 0xcc, 0xce, 0x52,
+	0x36, 0x7b, 0xee, 0x21, 0x50, 0x50, 0xc0, 0xa7, 0x22, 0x40, 0x61, 0xd4, 0x40, 0x2f, 0xe9, 0x2d,
+	0x07, 0xa3, 0xf7, 0xde, 0xd2, 0x02, 0x05, 0x0c, 0x9f, 0x8a, 0x00, 0x75, 0x0a, 0xfb, 0x90, 0xa0,
+	0xed, 0xa1, 0xe8, 0xa9, 0x40, 0x2f, 0xc5, 0xfc, 0xec, 0x0f, 0x49, 0xc9, 0x92, 0x6c, 0xa3, 0x08,
+	0xda, 0x0b, 0xb1, 0x33, 0xf3, 0xde, 0x37, 0xef, 0xbd, 0x79, 0x3f, 0x33, 0x8f, 0x70, 0x41, 0x77,
+	0x68, 0xc7, 0xa1, 0x8b, 0xd4, 0xc3, 0xbb, 0xa6, 0xdd, 0x5a, 0xdc, 0x5b, 0x6a, 0x10, 0x0f, 0x2f,
+	0xf9, 0xe3, 0x72, 0xd7, 0x75, 0x3c, 0x07, 0x9d, 0x11, 0x54, 0x65, 0x7f, 0x56, 0x52, 0x15, 0x4e,
+	0xb7, 0x9c, 0x96, 0xc3, 0x49, 0x16, 0xd9, 0x97, 0xa0, 0x2e, 0xcc, 0xb6, 0x1c, 0xa7, 0x65, 0x91,
+	0x45, 0x3e, 0x6a, 0xf4, 0x9a, 0x8b, 0xd8, 0xee, 0xcb, 0xa5, 0xb9, 0xe1, 0x25, 0xa3, 0xe7, 0x62,
+	0xcf, 0x74, 0x6c, 0xb9, 0x5e, 0x1c, 0x5e, 0xf7, 0xcc, 0x0e, 0xa1, 0x1e, 0xee, 0x74, 0x7d, 0x6c,
+	0x21, 0x89, 0x26, 0x36, 0x95, 0x62, 0x49, 0x6c, 0xa9, 0x4a, 0x03, 0x53, 0x12, 0xe8, 0xa1, 0x3b,
+	0xa6, 0x8f, 0x3d, 0x8d, 0x3b, 0xa6, 0xed, 0x2c, 0xf2, 0x5f, 0x39, 0x75, 0x4e, 0x77, 0x3a, 0xc4,
+	0x6b, 0x34, 0xbd, 0x45, 0xaf, 0xdf, 0x25, 0x74, 0x71, 0x6f, 0x49, 0x7c, 0xc8, 0xe5, 0xb3, 0xc1,
+	0x32, 0x6e, 0xe8, 0xe6, 0xd0, 0x6a, 0xe9, 0x23, 0x05, 0x32, 0xd7, 0x4d, 0xea, 0x39, 0xae, 0xa9,
+	0x63, 0xab, 0x66, 0x37, 0x1d, 0xf4, 0x06, 0x24, 0xdb, 0x04, 0x1b, 0xc4, 0xcd, 0x2b, 0xf3, 0xca,
+	0xc5, 0xc9, 0xcb, 0xb3, 0x65, 0x1f, 0xa1, 0x2c, 0x38, 0xf7, 0x96, 0xca, 0xd7, 0x39, 0x41, 0x25,
+	0xf5, 0xe9, 0xc3, 0xe2, 0xd8, 0xc7, 0x5f, 0x7c, 0x72, 0x49, 0x51, 0x25, 0x0f, 0xaa, 0x42, 0x72,
+	0x0f, 0x5b, 0x94, 0x78, 0xf9, 0xd8, 0x7c, 0xfc, 0xe2, 0xe4, 0xe5, 0xf3, 0xe5, 0x83, 0xcd, 0x5e,
+	0xde, 0xc1, 0x96, 0x69, 0x60, 0xcf, 0x19, 0x44, 0x11, 0xbc, 0xcb, 0xb1, 0xbc, 0x52, 0xfa, 0x50,
+	0x81, 0x5c, 0x28, 0x9a, 0x4a, 0x74, 0xc7, 0x35, 0x50, 0x1e, 0xc6, 0x71, 0xb7, 0xdb, 0xc6, 0xb4,
+	0xcd, 0xa5, 0x4b, 0xab, 0xfe, 0x10, 0xbd, 0x0e, 0x09, 0x66, 0xe7, 0x7c, 0x8c, 0x0b, 0x5d, 0x28,
+	0x8b, 0x43, 0x28, 0xfb, 0x87, 0x50, 0xde, 0xf2, 0x0f, 0xa1, 0x92, 0xb8, 0xfd, 0x79, 0x51, 0x51,
+	0x39, 0x35, 0x7a, 0x05, 0xb2, 0x7b, 0xbe, 0x20, 0x54, 0xe3, 0xb8, 0x71, 0x8e, 0x9b, 0x09, 0xa7,
+	0xaf, 0x63, 0xda, 0x2e, 0xfd, 0x22, 0x06, 0xd9, 0x55, 0xa7, 0xd3, 0x31, 0x29, 0x35, 0x1d, 0x5b,
+	0xc5, 0x1e, 0xa1, 0xe8, 0x6d, 0x48, 0xb8, 0xd8, 0x23, 0x5c, 0x92, 0x54, 0xe5, 0x0a, 0x53, 0xe3,
+	0xb3, 0x87, 0xc5, 0x97, 0x84, 0xc2, 0xd4, 0xd8, 0x2d, 0x9b, 0xce, 0x62, 0x07, 0x7b, 0xed, 0xf2,
+	0x0d, 0xd2, 0xc2, 0x7a, 0xbf, 0x4a, 0xf4, 0x07, 0xf7, 0x16, 0x40, 0xda, 0xa3, 0x4a, 0x74, 0xa1,
+	0x33, 0xc7, 0x40, 0xdf, 0x85, 0x89, 0x0e, 0xbe, 0xa5, 0x71, 0xbc, 0xd8, 0x33, 0xe1, 0x8d, 0x77,
+	0xf0, 0x2d, 0x26, 0x1f, 0x7a, 0x0f, 0xb2, 0x0c, 0x52, 0x6f, 0x63, 0xbb, 0x45, 0x04, 0x72, 0xfc,
+	0x99, 0x90, 0xa7, 0x3a, 0xf8, 0xd6, 0x2a, 0x47, 0x63, 0xf8, 0xcb, 0x89, 0x2f, 0xef, 0x16, 0x95,
+	0xd2, 0xef, 0x14, 0x80, 0xd0, 0x30, 0x08, 0x43, 0x4e, 0x0f, 0x46, 0x7c, 0x53, 0x2a, 0xfd, 0xe8,
+	0x95, 0xc3, 0x3c, 0x61, 0xc8, 0xac, 0x95, 0x29, 0x26, 0xde, 0xfd, 0x87, 0x45, 0x45, 0xec, 0x9a,
+	0xd5, 0x47, 0xcc, 0x3e, 0xd9, 0xeb, 0x1a, 0xd8, 0x23, 0xda, 0x31, 0x0f, 0x9c, 0x03, 0xde, 0xfe,
+	0xdc, 0x07, 0x04, 0xc1, 0xcd, 0xd6, 0xa5, 0x0e, 0x1f, 0x2b, 0x30, 0x59, 0x25, 0x54, 0x77, 0xcd,
+	0x2e, 0x8b, 0x63, 0xe6, 0x65, 0x1d, 0xc7, 0x36, 0x77, 0x65, 0x0c, 0xa4, 0x54, 0x7f, 0x88, 0x0a,
+	0x30, 0x61, 0x1a, 0xc4, 0xf6, 0x4c, 0xaf, 0x2f, 0x8e, 0x49, 0x0d, 0xc6, 0x8c, 0xeb, 0x26, 0x69,
+	0x50, 0xd3, 0xb7, 0xb3, 0xea, 0x0f, 0xd1, 0xab, 0x90, 0xa3, 0x44, 0xef, 0xb9, 0xa6, 0xd7, 0xd7,
+	0x74, 0xc7, 0xf6, 0xb0, 0xee, 0xe5, 0x13, 0x9c, 0x24, 0xeb, 0xcf, 0xaf, 0x8a, 0x69, 0x06, 0x62,
+	0x10, 0x0f, 0x9b, 0x16, 0xcd, 0x9f, 0x12, 0x20, 0x72, 0x28, 0x45, 0xbd, 0x33, 0x0e, 0xa9, 0x20,
+	0x74, 0xd0, 0x2a, 0xe4, 0x9c, 0x2e, 0x71, 0xd9, 0xb7, 0x86, 0x0d, 0xc3, 0x25, 0x94, 0x4a, 0x6f,
+	0xcc, 0x3f, 0xb8, 0xb7, 0x70, 0x5a, 0x1a, 0x7c, 0x45, 0xac, 0xd4, 0x3d, 0xd7, 0xb4, 0x5b, 0x6a,
+	0xd6, 0xe7, 0x90, 0xd3, 0xe8, 0x07, 0xec, 0xc8, 0x6c, 0x4a, 0x6c, 0xda, 0xa3, 0x5a, 0xb7, 0xd7,
+	0xd8, 0x25, 0x7d, 0x69, 0xd4, 0xd3, 0x23, 0x46, 0x5d, 0xb1, 0xfb, 0x95, 0xfc, 0x1f, 0x42, 0x68,
+	0xdd, 0xed, 0x77, 0x3d, 0xa7, 0xbc, 0xd9, 0x6b, 0xbc, 0x43, 0xfa, 0x6a, 0x36, 0xc0, 0xd9, 0xe4,
+	0x30, 0xe8, 0x0c, 0x24, 0x7f, 0x82, 0x4d, 0x8b, 0x18, 0xdc, 0x22, 0x13, 0xaa, 0x1c, 0xa1, 0x65,
+	0x48, 0x52, 0x0f, 0x7b, 0x3d, 0xca, 0xcd, 0x90, 0xb9, 0x5c, 0x3a, 0xcc, 0x37, 0x2a, 0x8e, 0x6d,
+	0xd4, 0x39, 0xa5, 0x2a, 0x39, 0xd0, 0x2a, 0x24, 0x3d, 0x67, 0x97, 0xd8, 0xd2, 0x40, 0x95, 0x6f,
+	0x48, 0x6f, 0x7e, 0x61, 0xd4, 0x9b, 0x6b, 0xb6, 0x17, 0xf1, 0xe3, 0x9a, 0xed, 0xa9, 0x92, 0x15,
+	0xfd, 0x08, 0x72, 0x06, 0xb1, 0x48, 0x8b, 0x5b, 0x8e, 0xb6, 0xb1, 0x4b, 0x68, 0x3e, 0xc9, 0xe1,
+	0x96, 0x4e, 0x1c, 0x1c, 0x6a, 0x36, 0x80, 0xaa, 0x73, 0x24, 0xb4, 0x09, 0x93, 0x46, 0xe8, 0x4e,
+	0xf9, 0x71, 0x6e, 0xcc, 0x97, 0x0f, 0xd3, 0x31, 0xe2, 0x79, 0xd1, 0x5c, 0x18, 0x85, 0x60, 0x1e,
+	0xd4, 0xb3, 0x1b, 0x8e, 0x6d, 0x98, 0x76, 0x4b, 0x6b, 0x13, 0xb3, 0xd5, 0xf6, 0xf2, 0x13, 0xf3,
+	0xca, 0xc5, 0xb8, 0x9a, 0x0d, 0xe6, 0xaf, 0xf3, 0x69, 0xb4, 0x09, 0x99, 0x90, 0x94, 0x47, 0x48,
+	0xea, 0xa4, 0x11, 0x32, 0x15, 0x00, 0x30, 0x12, 0xf4, 0x2e, 0x40, 0x18, 0x83, 0x79, 0xe0, 0x68,
+	0xa5, 0xa3, 0xa3, 0x39, 0xaa, 0x4c, 0x04, 0x00, 0xd9, 0x30, 0xd3, 0x31, 0x6d, 0x8d, 0x12, 0xab,
+	0xa9, 0x49, 0xcb, 0x31, 0xdc, 0x49, 0x6e, 0xfe, 0x37, 0x4f, 0x70, 0x9a, 0x9f, 0xdd, 0x5b, 0xc8,
+	0x8a, 0xd1, 0x02, 0x35, 0x76, 0xe7, 0x5f, 0x2b, 0xbf, 0xfe, 0x1d, 0x75, 0xba, 0x63, 0xda, 0x75,
+	0x62, 0x35, 0xab, 0x01, 0x30, 0x7a, 0x03, 0x5e, 0x0a, 0x0d, 0xe2, 0xd8, 0x5a, 0xdb, 0xb1, 0x0c,
+	0xcd, 0x25, 0x4d, 0x4d, 0x77, 0x7a, 0xb6, 0x97, 0x4f, 0x73, 0x33, 0xbe, 0x18, 0x90, 0x6c, 0xd8,
+	0xd7, 0x1d, 0xcb, 0x50, 0x49, 0x73, 0x95, 0x2d, 0xa3, 0x97, 0x21, 0xb4, 0x86, 0x66, 0x1a, 0x34,
+	0x3f, 0x35, 0x1f, 0xbf, 0x98, 0x50, 0xd3, 0xc1, 0x64, 0xcd, 0xa0, 0xcb, 0x13, 0x1f, 0xdc, 0x2d,
+	0x8e, 0x7d, 0x79, 0xb7, 0x38, 0x56, 0xba, 0x06, 0xe9, 0x1d, 0x6c, 0xc9, 0xd0, 0x22, 0x14, 0x5d,
+	0x81, 0x14, 0xf6, 0x07, 0x79, 0x65, 0x3e, 0xfe, 0xc4, 0xd0, 0x0c, 0x49, 0x4b, 0xbf, 0x51, 0x20,
+	0x59, 0xdd, 0xd9, 0xc4, 0xa6, 0x8b, 0xd6, 0x60, 0x3a, 0xf4, 0xd5, 0xe3, 0x46, 0x79, 0xe8, 0xde,
+	0x7e, 0x98, 0xaf, 0xc3, 0x74, 0x50, 0xd3, 0x02, 0x18, 0x51, 0x6a, 0xce, 0x3f, 0xb8, 0xb7, 0x70,
+	0x4e, 0xc2, 0x04, 0xc9, 0x65, 0x08, 0x6f, 0x6f, 0x68, 0x3e, 0xa2, 0xf3, 0xdb, 0x30, 0x2e, 0x44,
+	0xa5, 0xe8, 0x2a, 0x9c, 0xea, 0xb2, 0x0f, 0xae, 0xea, 0xe4, 0xe5, 0xb9, 0x43, 0x7d, 0x9e, 0xd3,
+	0x47, 0x3d, 0x44, 0xf0, 0x95, 0x3e, 0x8c, 0x01, 0x54, 0x77, 0x76, 0xb6, 0x5c, 0xb3, 0x6b, 0x11,
+	0xef, 0x79, 0xe9, 0xbe, 0x0d, 0x2f, 0x84, 0xba, 0x53, 0x57, 0x3f, 0xb9, 0xfe, 0x33, 0x01, 0x7f,
+	0xdd, 0xd5, 0x0f, 0x84, 0x35, 0xa8, 0x17, 0xc0, 0xc6, 0x4f, 0x0e, 0x5b, 0xa5, 0xde, 0xa8, 0x65,
+	0xbf, 0x0f, 0x93, 0xa1, 0x31, 0x28, 0xaa, 0xc1, 0x84, 0x27, 0xbf, 0xa5, 0x81, 0x4b, 0x87, 0x1b,
+	0xd8, 0x67, 0x8b, 0x1a, 0x39, 0x60, 0x2f, 0xfd, 0x4b, 0x01, 0x88, 0xc4, 0xc8, 0x57, 0xd3, 0xc7,
+	0x50, 0x0d, 0x92, 0x32, 0x39, 0xc7, 0x9f, 0x36, 0x39, 0x4b, 0x80, 0x88, 0x51, 0x7f, 0x1e, 0x83,
+	0x99, 0x6d, 0x3f, 0x7a, 0xbf, 0xfa, 0x36, 0xd8, 0x86, 0x71, 0x62, 0x7b, 0xae, 0xc9, 0x8d, 0xc0,
+	0xce, 0xfc, 0xb5, 0xc3, 0xce, 0xfc, 0x00, 0xa5, 0xd6, 0x6c, 0xcf, 0xed, 0x47, 0x3d, 0xc0, 0xc7,
+	0x8a, 0xd8, 0xe3, 0x97, 0x71, 0xc8, 0x1f, 0xc6, 0xca, 0x2e, 0xc8, 0xba, 0x4b, 0xf8, 0x84, 0x5f,
+	0x77, 0x14, 0x9e, 0x30, 0x33, 0xfe, 0xb4, 0x2c, 0x3b, 0x2a, 0xb0, 0x8b, 0x1a, 0x73, 0x2e, 0x46,
+	0xfa, 0x74, 0x37, 0xb3, 0x4c, 0x88, 0xc0, 0x0b, 0xcf, 0x16, 0x64, 0x4d, 0xdb, 0xf4, 0x4c, 0x6c,
+	0x69, 0x0d, 0x6c, 0x61, 0x5b, 0xf7, 0x6f, 0xb0, 0x27, 0xaa, 0xf9, 0x19, 0x89, 0x51, 0x11, 0x10,
+	0x68, 0x0d, 0xc6, 0x7d, 0xb4, 0xc4, 0xc9, 0xd1, 0x7c, 0x5e, 0x74, 0x1e, 0xd2, 0xd1, 0xc2, 0xc0,
+	0x6f, 0x23, 0x09, 0x75, 0x32, 0x52, 0x17, 0x8e, 0xaa, 0x3c, 0xc9, 0x27, 0x56, 0x1e, 0x79, 0xe1,
+	0xfb, 0x55, 0x1c, 0xa6, 0x55, 0x62, 0xfc, 0xef, 0x1f, 0xcb, 0x26, 0x80, 0x08, 0x55, 0x96, 0x49,
+	0xf3, 0x89, 0xa7, 0x8d, 0xf7, 0x94, 0x00, 0xa9, 0x52, 0xef, 0xbf, 0x75, 0x42, 0x7f, 0x8e, 0x41,
+	0x3a, 0x7a, 0x42, 0xff, 0x97, 0x45, 0x0b, 0xad, 0x87, 0x69, 0x2a, 0xc1, 0xd3, 0xd4, 0xab, 0x87,
+	0xa5, 0xa9, 0x11, 0x6f, 0x3e, 0x22, 0x3f, 0xfd, 0xfb, 0x14, 0x24, 0x37, 0xb1, 0x8b, 0x3b, 0x14,
+	0x6d, 0x8c, 0xdc, 0x6d, 0xfd, 0x1e, 0xc5, 0xb0, 0x33, 0x57, 0x65, 0x4f, 0x46, 0xf8, 0xf2, 0x47,
+	0x87, 0x5d, 0x6d, 0xbf, 0x06, 0x19, 0xf6, 0x46, 0x0e, 0x14, 0x12, 0xc6, 0x9d, 0xe2, 0x4f, 0xdd,
+	0x40, 0x7b, 0x8a, 0x8a, 0x30, 0xc9, 0xc8, 0xc2, 0x3c, 0xcc, 0x68, 0xa0, 0x83, 0x6f, 0xad, 0x89,
+	0x19, 0xb4, 0x00, 0xa8, 0x1d, 0xf4, 0x2a, 0xb4, 0xd0, 0x10, 0x8c, 0x6e, 0x3a, 0x5c, 0xf1, 0xc9,
+	0xcf, 0x01, 0x30, 0x29, 0x34, 0x83, 0xd8, 0x4e, 0x47, 0x3e, 0xf4, 0x52, 0x6c, 0xa6, 0xca, 0x26,
+	0xd0, 0xcf, 0x14, 0x71, 0x45, 0x1e, 0x7a, 0x49, 0xcb, 0x17, 0xca, 0xd6, 0x31, 0x82, 0xe2, 0x9f,
+	0x0f, 0x8b, 0x85, 0x3e, 0xee, 0x58, 0xcb, 0xa5, 0x03, 0x70, 0x4a, 0x07, 0x3d, 0xee, 0xd9, 0xc5,
+	0x79, 0xf0, 0x25, 0x8e, 0x6a, 0x90, 0xdb, 0x25, 0x7d, 0xcd, 0x75, 0x3c, 0x91, 0x68, 0x9a, 0x84,
+	0xe4, 0xc7, 0x83, 0x9e, 0x10, 0x67, 0x67, 0x7d, 0xaa, 0xc8, 0xd5, 0xdf, 0xb4, 0x2b, 0x09, 0x26,
+	0x9d, 0x9a, 0xd9, 0x25, 0x7d, 0x55, 0xf2, 0x5d, 0x23, 0x84, 0x69, 0x34, 0xdb, 0xb2, 0x9c, 0x06,
+	0xb6, 0x34, 0xcb, 0x7c, 0xbf, 0x67, 0x1a, 0x9a, 0xf4, 0x0a, 0x4d, 0xc7, 0x5d, 0xfe, 0x92, 0x49,
+	0x55, 0x6a, 0x27, 0x0e, 0xf6, 0x91, 0x07, 0xc0, 0xb7, 0x2f, 0x0b, 0x65, 0xce, 0x88, 0xbd, 0x6e,
+	0xf0, 0xad, 0xea, 0x62, 0xa7, 0x55, 0xdc, 0x45, 0xfb, 0x0a, 0x9c, 0x0d, 0x9d, 0xff, 0x00, 0x49,
+	0x52, 0xcf, 0x5b, 0x92, 0xd9, 0x60, 0xbb, 0x61, 0x61, 0x96, 0x2f, 0xb0, 0xec, 0xb1, 0xff, 0xc5,
+	0x27, 0x97, 0x5e, 0x0a, 0x39, 0x17, 0x6f, 0x05, 0x5d, 0x4c, 0xe1, 0xf2, 0xec, 0x21, 0x80, 0xc2,
+	0xa2, 0xac, 0x12, 0xda, 0x75, 0x6c, 0xca, 0xdf, 0x64, 0x91, 0xb7, 0x93, 0xf2, 0xe4, 0x37, 0x59,
+	0xc8, 0x3f, 0xf0, 0x26, 0x8b, 0xa4, 0xac, 0x37, 0xc3, 0x9a, 0x18, 0x3b, 0xea, 0x84, 0xa3, 0xd1,
+	0x2a, 0x99, 0x78, 0x26, 0x1c, 0x2b, 0xfd, 0x51, 0x81, 0xd9, 0x91, 0xe8, 0x0e, 0x44, 0xd6, 0x01,
+	0xb9, 0x91, 0x45, 0x1e, 0x25, 0x7d, 0x29, 0xfa, 0xd3, 0x25, 0x8b, 0x69, 0x77, 0x78, 0xf5, 0x39,
+	0x15, 0x77, 0x99, 0xd9, 0x7f, 0xaf, 0xc0, 0xe9, 0xa8, 0x00, 0x81, 0x2a, 0x75, 0x48, 0x47, 0xb7,
+	0x96, 0x4a, 0x5c, 0x38, 0x8e, 0x12, 0x51, 0xf9, 0x07, 0x40, 0xd0, 0x4e, 0x98, 0x41, 0x45, 0xef,
+	0x74, 0xe9, 0xd8, 0x46, 0xf1, 0x05, 0x3b, 0x30, 0x93, 0x8a, 0xb3, 0xf9, 0x9b, 0x02, 0x89, 0x4d,
+	0xc7, 0xb1, 0xd0, 0xfb, 0x30, 0x6d, 0x3b, 0x9e, 0xc6, 0xb2, 0x0d, 0x31, 0x34, 0xd9, 0x4a, 0x11,
+	0xd5, 0x69, 0xed, 0x89, 0xb6, 0xfa, 0xeb, 0xc3, 0xe2, 0x28, 0xe7, 0xa0, 0x01, 0x65, 0xc7, 0xce,
+	0x76, 0xbc, 0x0a, 0x27, 0xda, 0xe2, 0x34, 0xa8, 0x09, 0x53, 0x83, 0xdb, 0x89, 0x0a, 0xb6, 0x72,
+	0xd4, 0x76, 0x53, 0x47, 0x6e, 0x95, 0x6e, 0x44, 0xf6, 0x59, 0x9e, 0x60, 0xa7, 0xf6, 0x0f, 0x76,
+	0x72, 0xef, 0x41, 0x2e, 0x48, 0xdf, 0xdb, 0xbc, 0xdd, 0x47, 0xd1, 0x35, 0x18, 0x17, 0x9d, 0x3f,
+	0xff, 0xf1, 0x74, 0x3e, 0xec, 0x6c, 0xb3, 0xde, 0x38, 0x6b, 0x6c, 0x0f, 0x31, 0x0d, 0xd8, 0x53,
+	0x32, 0xf3, 0xe6, 0xf4, 0xfd, 0x18, 0xcc, 0xae, 0x3a, 0x36, 0x95, 0x8d, 0x2f, 0x99, 0xe9, 0x44,
+	0xbb, 0xba, 0xcf, 0xba, 0x35, 0x07, 0xb6, 0xe5, 0xd2, 0xa3, 0xcd, 0xb7, 0x1d, 0xc8, 0xb2, 0x2b,
+	0x87, 0xee, 0xd8, 0xcf, 0xd8, 0x7b, 0x9b, 0x72, 0x2c, 0x43, 0x4a, 0xc4, 0x3a, 0x6f, 0x3b, 0x90,
+	0xb5, 0xc9, 0xcd, 0x01, 0xdc, 0xf8, 0xd3, 0xe1, 0xda, 0xe4, 0x66, 0x04, 0xf7, 0x0c, 0xfb, 0x77,
+	0x80, 0xdf, 0x37, 0x13, 0xfc, 0x36, 0x25, 0x47, 0xe8, 0x0a, 0xc4, 0x59, 0x79, 0x38, 0x75, 0x82,
+	0xe4, 0xc1, 0x18, 0x22, 0x65, 0xbe, 0x0e, 0xb3, 0xb2, 0x73, 0x42, 0x37, 0x9a, 0xdc, 0xa2, 0x84,
+	0x2b, 0xf4, 0x0e, 0xe9, 0x1f, 0xd0, 0x46, 0x49, 0x1f, 0xaf, 0x8d, 0xf2, 0x77, 0x05, 0x66, 0xb8,
+	0x73, 0x98, 0x3f, 0x25, 0xbc, 0x39, 0x27, 0xff, 0x47, 0xc8, 0x40, 0xcc, 0x34, 0xf8, 0x99, 0x24,
+	0xd4, 0x98, 0x69, 0xa0, 0x32, 0x9c, 0x72, 0x6e, 0xda, 0xc4, 0x95, 0x9e, 0x79, 0x38, 0xb6, 0x20,
+	0x43, 0x57, 0x21, 0xd3, 0x71, 0x8c, 0x9e, 0x45, 0x34, 0xac, 0x8b, 0xab, 0x62, 0xfc, 0x08, 0xc6,
+	0x29, 0x41, 0xbf, 0x22, 0xc8, 0xd1, 0x55, 0x48, 0x05, 0x95, 0x21, 0x9f, 0x38, 0xee, 0xcd, 0x2b,
+	0xe4, 0x59, 0x9e, 0x79, 0x30, 0x5a, 0x72, 0x2e, 0xfd, 0x56, 0x01, 0x08, 0x5b, 0xa6, 0xe8, 0x9b,
+	0xf0, 0x62, 0x65, 0x63, 0xbd, 0xaa, 0xd5, 0xb7, 0x56, 0xb6, 0xb6, 0xeb, 0xda, 0xf6, 0x7a, 0x7d,
+	0x73, 0x6d, 0xb5, 0x76, 0xad, 0xb6, 0x56, 0xcd, 0x8d, 0x15, 0xb2, 0xfb, 0x77, 0xe6, 0x27, 0xb7,
+	0x6d, 0xda, 0x25, 0xba, 0xd9, 0x34, 0x89, 0x81, 0xbe, 0x0e, 0xa7, 0x07, 0xa9, 0xd9, 0x68, 0xad,
+	0x9a, 0x53, 0x0a, 0xe9, 0xfd, 0x3b, 0xf3, 0x13, 0xe2, 0x89, 0x48, 0x0c, 0x74, 0x11, 0x5e, 0x18,
+	0xa5, 0xab, 0xad, 0xbf, 0x95, 0x8b, 0x15, 0xa6, 0xf6, 0xef, 0xcc, 0xa7, 0x82, 0xb7, 0x24, 0x2a,
+	0x01, 0x8a, 0x52, 0x4a, 0xbc, 0x78, 0x01, 0xf6, 0xef, 0xcc, 0x27, 0x45, 0x86, 0x28, 0x24, 0x3e,
+	0xf8, 0xf5, 0xdc, 0xd8, 0xa5, 0x1f, 0x03, 0xd4, 0xec, 0xa6, 0x8b, 0x75, 0x9e, 0x09, 0x0b, 0x70,
+	0xa6, 0xb6, 0x7e, 0x4d, 0x5d, 0x59, 0xdd, 0xaa, 0x6d, 0xac, 0x0f, 0x8a, 0x3d, 0xb4, 0x56, 0xdd,
+	0xd8, 0xae, 0xdc, 0x58, 0xd3, 0xea, 0xb5, 0xb7, 0xd6, 0x73, 0x0a, 0x7a, 0x11, 0x66, 0x06, 0xd6,
+	0xbe, 0xb7, 0xbe, 0x55, 0x7b, 0x77, 0x2d, 0x17, 0xab, 0x5c, 0xf9, 0xf4, 0xd1, 0x9c, 0x72, 0xff,
+	0xd1, 0x9c, 0xf2, 0x97, 0x47, 0x73, 0xca, 0xed, 0xc7, 0x73, 0x63, 0xf7, 0x1f, 0xcf, 0x8d, 0xfd,
+	0xe9, 0xf1, 0xdc, 0xd8, 0x0f, 0xcf, 0x0e, 0xe4, 0x9e, 0xb0, 0xfa, 0xf2, 0xbf, 0xba, 0x1a, 0x49,
+	0x1e, 0x24, 0xdf, 0xfa, 0xcf, 0x00, 0x8e, 0x31, 0x9e, 0x6f, 0x62, 0x1c, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1590,735 +1680,932 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 11635 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x90, 0x23, 0x59,
-		0x56, 0x18, 0xdc, 0x29, 0xa9, 0x54, 0xd2, 0x91, 0x54, 0xca, 0xba, 0x55, 0xdd, 0x5d, 0x5d, 0x3d,
-		0xd3, 0x55, 0xad, 0x79, 0x74, 0x4f, 0xcf, 0x4c, 0xf5, 0x74, 0x4f, 0x4f, 0xcf, 0x8c, 0xe6, 0x85,
-		0x54, 0xa5, 0xaa, 0x56, 0x4f, 0xbd, 0x36, 0xa5, 0xaa, 0x9d, 0x19, 0x1e, 0x49, 0x56, 0xea, 0x56,
-		0x55, 0x4e, 0x4b, 0x99, 0xb9, 0x99, 0xa9, 0xee, 0xaa, 0xf9, 0xbe, 0xf8, 0x62, 0xf9, 0x78, 0x7c,
-		0x30, 0x3c, 0xbe, 0xc5, 0x60, 0x58, 0x76, 0xb7, 0x97, 0x05, 0x0c, 0xbb, 0x0b, 0x36, 0x06, 0xef,
-		0xf2, 0x32, 0xe1, 0x07, 0x0e, 0x1b, 0x03, 0x0e, 0xe3, 0x35, 0x3f, 0x6c, 0x82, 0x08, 0x06, 0xd8,
-		0x25, 0x62, 0xd7, 0xb0, 0x60, 0xc0, 0xbb, 0x04, 0xe1, 0x0d, 0x3b, 0x1c, 0xf7, 0x95, 0x0f, 0x29,
-		0x55, 0x52, 0xf5, 0xcc, 0x2c, 0x18, 0xfc, 0xa7, 0xbb, 0xf2, 0xde, 0x73, 0xce, 0x3d, 0xf7, 0xdc,
-		0x73, 0xcf, 0x39, 0xf7, 0xdc, 0x87, 0xe0, 0x0f, 0xaa, 0x30, 0xbf, 0x67, 0x59, 0x7b, 0x6d, 0x7c,
-		0xd9, 0x76, 0x2c, 0xcf, 0xda, 0xe9, 0xee, 0x5e, 0x6e, 0x61, 0x57, 0x77, 0x0c, 0xdb, 0xb3, 0x9c,
-		0x05, 0x5a, 0x86, 0x8a, 0x0c, 0x62, 0x41, 0x40, 0x94, 0xd6, 0x60, 0x72, 0xd9, 0x68, 0xe3, 0x25,
-		0x1f, 0xb0, 0x81, 0x3d, 0xf4, 0x0c, 0xa4, 0x76, 0x8d, 0x36, 0x9e, 0x91, 0xe6, 0x93, 0x17, 0x73,
-		0x57, 0x1f, 0x5c, 0xe8, 0x41, 0x5a, 0x88, 0x62, 0x6c, 0x92, 0x62, 0x85, 0x62, 0x94, 0xfe, 0x67,
-		0x0a, 0xa6, 0x62, 0x6a, 0x11, 0x82, 0x94, 0xa9, 0x75, 0x08, 0x45, 0xe9, 0x62, 0x56, 0xa1, 0x7f,
-		0xa3, 0x19, 0x18, 0xb7, 0x35, 0xfd, 0x96, 0xb6, 0x87, 0x67, 0x12, 0xb4, 0x58, 0x7c, 0xa2, 0x73,
-		0x00, 0x2d, 0x6c, 0x63, 0xb3, 0x85, 0x4d, 0xfd, 0x70, 0x26, 0x39, 0x9f, 0xbc, 0x98, 0x55, 0x42,
-		0x25, 0xe8, 0x51, 0x98, 0xb4, 0xbb, 0x3b, 0x6d, 0x43, 0x57, 0x43, 0x60, 0x30, 0x9f, 0xbc, 0x38,
-		0xa6, 0xc8, 0xac, 0x62, 0x29, 0x00, 0xbe, 0x00, 0xc5, 0x3b, 0x58, 0xbb, 0x15, 0x06, 0xcd, 0x51,
-		0xd0, 0x09, 0x52, 0x1c, 0x02, 0x5c, 0x84, 0x7c, 0x07, 0xbb, 0xae, 0xb6, 0x87, 0x55, 0xef, 0xd0,
-		0xc6, 0x33, 0x29, 0xda, 0xfb, 0xf9, 0xbe, 0xde, 0xf7, 0xf6, 0x3c, 0xc7, 0xb1, 0x9a, 0x87, 0x36,
-		0x46, 0x15, 0xc8, 0x62, 0xb3, 0xdb, 0x61, 0x14, 0xc6, 0x06, 0xc8, 0xaf, 0x66, 0x76, 0x3b, 0xbd,
-		0x54, 0x32, 0x04, 0x8d, 0x93, 0x18, 0x77, 0xb1, 0x73, 0xdb, 0xd0, 0xf1, 0x4c, 0x9a, 0x12, 0xb8,
-		0xd0, 0x47, 0xa0, 0xc1, 0xea, 0x7b, 0x69, 0x08, 0x3c, 0xb4, 0x08, 0x59, 0x7c, 0xe0, 0x61, 0xd3,
-		0x35, 0x2c, 0x73, 0x66, 0x9c, 0x12, 0x79, 0x28, 0x66, 0x14, 0x71, 0xbb, 0xd5, 0x4b, 0x22, 0xc0,
-		0x43, 0xd7, 0x61, 0xdc, 0xb2, 0x3d, 0xc3, 0x32, 0xdd, 0x99, 0xcc, 0xbc, 0x74, 0x31, 0x77, 0xf5,
-		0xbe, 0x58, 0x45, 0xd8, 0x60, 0x30, 0x8a, 0x00, 0x46, 0x75, 0x90, 0x5d, 0xab, 0xeb, 0xe8, 0x58,
-		0xd5, 0xad, 0x16, 0x56, 0x0d, 0x73, 0xd7, 0x9a, 0xc9, 0x52, 0x02, 0x73, 0xfd, 0x1d, 0xa1, 0x80,
-		0x8b, 0x56, 0x0b, 0xd7, 0xcd, 0x5d, 0x4b, 0x99, 0x70, 0x23, 0xdf, 0xe8, 0x14, 0xa4, 0xdd, 0x43,
-		0xd3, 0xd3, 0x0e, 0x66, 0xf2, 0x54, 0x43, 0xf8, 0x17, 0x51, 0x1d, 0xdc, 0x32, 0x48, 0x73, 0x33,
-		0x05, 0xa6, 0x3a, 0xfc, 0xb3, 0xf4, 0x4b, 0x69, 0x28, 0x8e, 0xa2, 0x7c, 0xcf, 0xc1, 0xd8, 0x2e,
-		0xe9, 0xff, 0x4c, 0xe2, 0x38, 0xd2, 0x61, 0x38, 0x51, 0xf1, 0xa6, 0xef, 0x51, 0xbc, 0x15, 0xc8,
-		0x99, 0xd8, 0xf5, 0x70, 0x8b, 0xe9, 0x4a, 0x72, 0x44, 0x6d, 0x03, 0x86, 0xd4, 0xaf, 0x6c, 0xa9,
-		0x7b, 0x52, 0xb6, 0x57, 0xa0, 0xe8, 0xb3, 0xa4, 0x3a, 0x9a, 0xb9, 0x27, 0xb4, 0xf6, 0xf2, 0x30,
-		0x4e, 0x16, 0x6a, 0x02, 0x4f, 0x21, 0x68, 0xca, 0x04, 0x8e, 0x7c, 0xa3, 0x25, 0x00, 0xcb, 0xc4,
-		0xd6, 0xae, 0xda, 0xc2, 0x7a, 0x7b, 0x26, 0x33, 0x40, 0x4a, 0x1b, 0x04, 0xa4, 0x4f, 0x4a, 0x16,
-		0x2b, 0xd5, 0xdb, 0xe8, 0xd9, 0x40, 0x09, 0xc7, 0x07, 0xe8, 0xd0, 0x1a, 0x9b, 0x7e, 0x7d, 0x7a,
-		0xb8, 0x05, 0x13, 0x0e, 0x26, 0x33, 0x02, 0xb7, 0x78, 0xcf, 0xb2, 0x94, 0x89, 0x85, 0xa1, 0x3d,
-		0x53, 0x38, 0x1a, 0xeb, 0x58, 0xc1, 0x09, 0x7f, 0xa2, 0x07, 0xc0, 0x2f, 0x50, 0xa9, 0x5a, 0x01,
-		0xb5, 0x4f, 0x79, 0x51, 0xb8, 0xae, 0x75, 0xf0, 0xec, 0x1b, 0x30, 0x11, 0x15, 0x0f, 0x9a, 0x86,
-		0x31, 0xd7, 0xd3, 0x1c, 0x8f, 0x6a, 0xe1, 0x98, 0xc2, 0x3e, 0x90, 0x0c, 0x49, 0x6c, 0xb6, 0xa8,
-		0xfd, 0x1b, 0x53, 0xc8, 0x9f, 0xe8, 0x6b, 0x82, 0x0e, 0x27, 0x69, 0x87, 0x1f, 0xee, 0x1f, 0xd1,
-		0x08, 0xe5, 0xde, 0x7e, 0xcf, 0x3e, 0x0d, 0x85, 0x48, 0x07, 0x46, 0x6d, 0xba, 0xf4, 0x7f, 0xc3,
-		0xc9, 0x58, 0xd2, 0xe8, 0x15, 0x98, 0xee, 0x9a, 0x86, 0xe9, 0x61, 0xc7, 0x76, 0x30, 0xd1, 0x58,
-		0xd6, 0xd4, 0xcc, 0xe7, 0xc7, 0x07, 0xe8, 0xdc, 0x56, 0x18, 0x9a, 0x51, 0x51, 0xa6, 0xba, 0xfd,
-		0x85, 0x97, 0xb2, 0x99, 0x2f, 0x8c, 0xcb, 0xef, 0x7f, 0xff, 0xfb, 0xdf, 0x9f, 0x28, 0xfd, 0x4a,
-		0x1a, 0xa6, 0xe3, 0xe6, 0x4c, 0xec, 0xf4, 0x3d, 0x05, 0x69, 0xb3, 0xdb, 0xd9, 0xc1, 0x0e, 0x15,
-		0xd2, 0x98, 0xc2, 0xbf, 0x50, 0x05, 0xc6, 0xda, 0xda, 0x0e, 0x6e, 0xcf, 0xa4, 0xe6, 0xa5, 0x8b,
-		0x13, 0x57, 0x1f, 0x1d, 0x69, 0x56, 0x2e, 0xac, 0x12, 0x14, 0x85, 0x61, 0xa2, 0x17, 0x21, 0xc5,
-		0x8d, 0x37, 0xa1, 0x70, 0x69, 0x34, 0x0a, 0x64, 0x2e, 0x29, 0x14, 0x0f, 0x9d, 0x85, 0x2c, 0xf9,
-		0x9f, 0xe9, 0x46, 0x9a, 0xf2, 0x9c, 0x21, 0x05, 0x44, 0x2f, 0xd0, 0x2c, 0x64, 0xe8, 0x34, 0x69,
-		0x61, 0xe1, 0xf4, 0xfc, 0x6f, 0xa2, 0x58, 0x2d, 0xbc, 0xab, 0x75, 0xdb, 0x9e, 0x7a, 0x5b, 0x6b,
-		0x77, 0x31, 0x55, 0xf8, 0xac, 0x92, 0xe7, 0x85, 0xdb, 0xa4, 0x0c, 0xcd, 0x41, 0x8e, 0xcd, 0x2a,
-		0xc3, 0x6c, 0xe1, 0x03, 0x6a, 0x57, 0xc7, 0x14, 0x36, 0xd1, 0xea, 0xa4, 0x84, 0x34, 0xff, 0xba,
-		0x6b, 0x99, 0x42, 0x35, 0x69, 0x13, 0xa4, 0x80, 0x36, 0xff, 0x74, 0xaf, 0x49, 0xbf, 0x3f, 0xbe,
-		0x7b, 0x7d, 0x73, 0xe9, 0x02, 0x14, 0x29, 0xc4, 0x93, 0x7c, 0xe8, 0xb5, 0xf6, 0xcc, 0xe4, 0xbc,
-		0x74, 0x31, 0xa3, 0x4c, 0xb0, 0xe2, 0x0d, 0x5e, 0x5a, 0xfa, 0xf9, 0x04, 0xa4, 0xa8, 0x61, 0x29,
-		0x42, 0xae, 0xf9, 0xea, 0x66, 0x4d, 0x5d, 0xda, 0xd8, 0xaa, 0xae, 0xd6, 0x64, 0x09, 0x4d, 0x00,
-		0xd0, 0x82, 0xe5, 0xd5, 0x8d, 0x4a, 0x53, 0x4e, 0xf8, 0xdf, 0xf5, 0xf5, 0xe6, 0xf5, 0x6b, 0x72,
-		0xd2, 0x47, 0xd8, 0x62, 0x05, 0xa9, 0x30, 0xc0, 0x93, 0x57, 0xe5, 0x31, 0x24, 0x43, 0x9e, 0x11,
-		0xa8, 0xbf, 0x52, 0x5b, 0xba, 0x7e, 0x4d, 0x4e, 0x47, 0x4b, 0x9e, 0xbc, 0x2a, 0x8f, 0xa3, 0x02,
-		0x64, 0x69, 0x49, 0x75, 0x63, 0x63, 0x55, 0xce, 0xf8, 0x34, 0x1b, 0x4d, 0xa5, 0xbe, 0xbe, 0x22,
-		0x67, 0x7d, 0x9a, 0x2b, 0xca, 0xc6, 0xd6, 0xa6, 0x0c, 0x3e, 0x85, 0xb5, 0x5a, 0xa3, 0x51, 0x59,
-		0xa9, 0xc9, 0x39, 0x1f, 0xa2, 0xfa, 0x6a, 0xb3, 0xd6, 0x90, 0xf3, 0x11, 0xb6, 0x9e, 0xbc, 0x2a,
-		0x17, 0xfc, 0x26, 0x6a, 0xeb, 0x5b, 0x6b, 0xf2, 0x04, 0x9a, 0x84, 0x02, 0x6b, 0x42, 0x30, 0x51,
-		0xec, 0x29, 0xba, 0x7e, 0x4d, 0x96, 0x03, 0x46, 0x18, 0x95, 0xc9, 0x48, 0xc1, 0xf5, 0x6b, 0x32,
-		0x2a, 0x2d, 0xc2, 0x18, 0x55, 0x43, 0x84, 0x60, 0x62, 0xb5, 0x52, 0xad, 0xad, 0xaa, 0x1b, 0x9b,
-		0xcd, 0xfa, 0xc6, 0x7a, 0x65, 0x55, 0x96, 0x82, 0x32, 0xa5, 0xf6, 0x9e, 0xad, 0xba, 0x52, 0x5b,
-		0x92, 0x13, 0xe1, 0xb2, 0xcd, 0x5a, 0xa5, 0x59, 0x5b, 0x92, 0x93, 0x25, 0x1d, 0xa6, 0xe3, 0x0c,
-		0x6a, 0xec, 0x14, 0x0a, 0xe9, 0x42, 0x62, 0x80, 0x2e, 0x50, 0x5a, 0xbd, 0xba, 0x50, 0xfa, 0x5c,
-		0x02, 0xa6, 0x62, 0x9c, 0x4a, 0x6c, 0x23, 0x2f, 0xc1, 0x18, 0xd3, 0x65, 0xe6, 0x66, 0x1f, 0x89,
-		0xf5, 0x4e, 0x54, 0xb3, 0xfb, 0x5c, 0x2d, 0xc5, 0x0b, 0x07, 0x21, 0xc9, 0x01, 0x41, 0x08, 0x21,
-		0xd1, 0xa7, 0xb0, 0x5f, 0xdf, 0x67, 0xfc, 0x99, 0x7f, 0xbc, 0x3e, 0x8a, 0x7f, 0xa4, 0x65, 0xc7,
-		0x73, 0x02, 0x63, 0x31, 0x4e, 0xe0, 0x39, 0x98, 0xec, 0x23, 0x34, 0xb2, 0x31, 0xfe, 0x66, 0x09,
-		0x66, 0x06, 0x09, 0x67, 0x88, 0x49, 0x4c, 0x44, 0x4c, 0xe2, 0x73, 0xbd, 0x12, 0x3c, 0x3f, 0x78,
-		0x10, 0xfa, 0xc6, 0xfa, 0xe3, 0x12, 0x9c, 0x8a, 0x0f, 0x36, 0x63, 0x79, 0x78, 0x11, 0xd2, 0x1d,
-		0xec, 0xed, 0x5b, 0x22, 0xac, 0x7a, 0x38, 0xc6, 0x59, 0x93, 0xea, 0xde, 0xc1, 0xe6, 0x58, 0x61,
-		0x6f, 0x9f, 0x1c, 0x14, 0x31, 0x32, 0x6e, 0xfa, 0x38, 0xfd, 0x8e, 0x04, 0x9c, 0x8c, 0x25, 0x1e,
-		0xcb, 0xe8, 0xfd, 0x00, 0x86, 0x69, 0x77, 0x3d, 0x16, 0x3a, 0x31, 0x4b, 0x9c, 0xa5, 0x25, 0xd4,
-		0x78, 0x11, 0x2b, 0xdb, 0xf5, 0xfc, 0xfa, 0x24, 0xad, 0x07, 0x56, 0x44, 0x01, 0x9e, 0x09, 0x18,
-		0x4d, 0x51, 0x46, 0xcf, 0x0d, 0xe8, 0x69, 0x9f, 0x62, 0x3e, 0x01, 0xb2, 0xde, 0x36, 0xb0, 0xe9,
-		0xa9, 0xae, 0xe7, 0x60, 0xad, 0x63, 0x98, 0x7b, 0xd4, 0xd5, 0x64, 0xca, 0x63, 0xbb, 0x5a, 0xdb,
-		0xc5, 0x4a, 0x91, 0x55, 0x37, 0x44, 0x2d, 0xc1, 0xa0, 0x0a, 0xe4, 0x84, 0x30, 0xd2, 0x11, 0x0c,
-		0x56, 0xed, 0x63, 0x94, 0xbe, 0x37, 0x0b, 0xb9, 0x50, 0x68, 0x8e, 0xce, 0x43, 0xfe, 0x75, 0xed,
-		0xb6, 0xa6, 0x8a, 0xe5, 0x16, 0x93, 0x44, 0x8e, 0x94, 0x6d, 0xf2, 0x25, 0xd7, 0x13, 0x30, 0x4d,
-		0x41, 0xac, 0xae, 0x87, 0x1d, 0x55, 0x6f, 0x6b, 0xae, 0x4b, 0x85, 0x96, 0xa1, 0xa0, 0x88, 0xd4,
-		0x6d, 0x90, 0xaa, 0x45, 0x51, 0x83, 0x9e, 0x82, 0x29, 0x8a, 0xd1, 0xe9, 0xb6, 0x3d, 0xc3, 0x6e,
-		0x63, 0x95, 0x2c, 0x00, 0x5d, 0xea, 0x72, 0x7c, 0xce, 0x26, 0x09, 0xc4, 0x1a, 0x07, 0x20, 0x1c,
-		0xb9, 0x68, 0x09, 0xee, 0xa7, 0x68, 0x7b, 0xd8, 0xc4, 0x8e, 0xe6, 0x61, 0x15, 0xbf, 0xaf, 0xab,
-		0xb5, 0x5d, 0x55, 0x33, 0x5b, 0xea, 0xbe, 0xe6, 0xee, 0xcf, 0x4c, 0x13, 0x02, 0xd5, 0xc4, 0x8c,
-		0xa4, 0x9c, 0x21, 0x80, 0x2b, 0x1c, 0xae, 0x46, 0xc1, 0x2a, 0x66, 0xeb, 0x86, 0xe6, 0xee, 0xa3,
-		0x32, 0x9c, 0xa2, 0x54, 0x5c, 0xcf, 0x31, 0xcc, 0x3d, 0x55, 0xdf, 0xc7, 0xfa, 0x2d, 0xb5, 0xeb,
-		0xed, 0x3e, 0x33, 0x73, 0x36, 0xdc, 0x3e, 0xe5, 0xb0, 0x41, 0x61, 0x16, 0x09, 0xc8, 0x96, 0xb7,
-		0xfb, 0x0c, 0x6a, 0x40, 0x9e, 0x0c, 0x46, 0xc7, 0x78, 0x03, 0xab, 0xbb, 0x96, 0x43, 0x7d, 0xe8,
-		0x44, 0x8c, 0x69, 0x0a, 0x49, 0x70, 0x61, 0x83, 0x23, 0xac, 0x59, 0x2d, 0x5c, 0x1e, 0x6b, 0x6c,
-		0xd6, 0x6a, 0x4b, 0x4a, 0x4e, 0x50, 0x59, 0xb6, 0x1c, 0xa2, 0x50, 0x7b, 0x96, 0x2f, 0xe0, 0x1c,
-		0x53, 0xa8, 0x3d, 0x4b, 0x88, 0xf7, 0x29, 0x98, 0xd2, 0x75, 0xd6, 0x67, 0x43, 0x57, 0xf9, 0x32,
-		0xcd, 0x9d, 0x91, 0x23, 0xc2, 0xd2, 0xf5, 0x15, 0x06, 0xc0, 0x75, 0xdc, 0x45, 0xcf, 0xc2, 0xc9,
-		0x40, 0x58, 0x61, 0xc4, 0xc9, 0xbe, 0x5e, 0xf6, 0xa2, 0x3e, 0x05, 0x53, 0xf6, 0x61, 0x3f, 0x22,
-		0x8a, 0xb4, 0x68, 0x1f, 0xf6, 0xa2, 0x3d, 0x0d, 0xd3, 0xf6, 0xbe, 0xdd, 0x8f, 0x77, 0x29, 0x8c,
-		0x87, 0xec, 0x7d, 0xbb, 0x17, 0xf1, 0x21, 0xba, 0x66, 0x77, 0xb0, 0xae, 0x79, 0xb8, 0x35, 0x73,
-		0x3a, 0x0c, 0x1e, 0xaa, 0x40, 0x0b, 0x20, 0xeb, 0xba, 0x8a, 0x4d, 0x6d, 0xa7, 0x8d, 0x55, 0xcd,
-		0xc1, 0xa6, 0xe6, 0xce, 0xcc, 0x51, 0xe0, 0x94, 0xe7, 0x74, 0xb1, 0x32, 0xa1, 0xeb, 0x35, 0x5a,
-		0x59, 0xa1, 0x75, 0xe8, 0x12, 0x4c, 0x5a, 0x3b, 0xaf, 0xeb, 0x4c, 0x23, 0x55, 0xdb, 0xc1, 0xbb,
-		0xc6, 0xc1, 0xcc, 0x83, 0x54, 0xbc, 0x45, 0x52, 0x41, 0xf5, 0x71, 0x93, 0x16, 0xa3, 0x47, 0x40,
-		0xd6, 0xdd, 0x7d, 0xcd, 0xb1, 0xa9, 0x49, 0x76, 0x6d, 0x4d, 0xc7, 0x33, 0x0f, 0x31, 0x50, 0x56,
-		0xbe, 0x2e, 0x8a, 0xc9, 0x8c, 0x70, 0xef, 0x18, 0xbb, 0x9e, 0xa0, 0x78, 0x81, 0xcd, 0x08, 0x5a,
-		0xc6, 0xa9, 0x5d, 0x04, 0x99, 0x48, 0x22, 0xd2, 0xf0, 0x45, 0x0a, 0x36, 0x61, 0xef, 0xdb, 0xe1,
-		0x76, 0x1f, 0x80, 0x02, 0x81, 0x0c, 0x1a, 0x7d, 0x84, 0x05, 0x6e, 0xf6, 0x7e, 0xa8, 0xc5, 0x6b,
-		0x70, 0x8a, 0x00, 0x75, 0xb0, 0xa7, 0xb5, 0x34, 0x4f, 0x0b, 0x41, 0x3f, 0x46, 0xa1, 0x89, 0xd8,
-		0xd7, 0x78, 0x65, 0x84, 0x4f, 0xa7, 0xbb, 0x73, 0xe8, 0x2b, 0xd6, 0xe3, 0x8c, 0x4f, 0x52, 0x26,
-		0x54, 0xeb, 0x5d, 0x0b, 0xce, 0x4b, 0x65, 0xc8, 0x87, 0xf5, 0x1e, 0x65, 0x81, 0x69, 0xbe, 0x2c,
-		0x91, 0x20, 0x68, 0x71, 0x63, 0x89, 0x84, 0x2f, 0xaf, 0xd5, 0xe4, 0x04, 0x09, 0xa3, 0x56, 0xeb,
-		0xcd, 0x9a, 0xaa, 0x6c, 0xad, 0x37, 0xeb, 0x6b, 0x35, 0x39, 0x19, 0x0a, 0xec, 0x6f, 0xa6, 0x32,
-		0x0f, 0xcb, 0x17, 0x4a, 0xbf, 0x9c, 0x84, 0x89, 0xe8, 0x4a, 0x0d, 0x3d, 0x0f, 0xa7, 0x45, 0xc2,
-		0xc5, 0xc5, 0x9e, 0x7a, 0xc7, 0x70, 0xe8, 0x84, 0xec, 0x68, 0xcc, 0x39, 0xfa, 0xfa, 0x33, 0xcd,
-		0xa1, 0x1a, 0xd8, 0x7b, 0xaf, 0xe1, 0x90, 0xe9, 0xd6, 0xd1, 0x3c, 0xb4, 0x0a, 0x73, 0xa6, 0xa5,
-		0xba, 0x9e, 0x66, 0xb6, 0x34, 0xa7, 0xa5, 0x06, 0xa9, 0x2e, 0x55, 0xd3, 0x75, 0xec, 0xba, 0x16,
-		0x73, 0x84, 0x3e, 0x95, 0xfb, 0x4c, 0xab, 0xc1, 0x81, 0x03, 0x0f, 0x51, 0xe1, 0xa0, 0x3d, 0xea,
-		0x9b, 0x1c, 0xa4, 0xbe, 0x67, 0x21, 0xdb, 0xd1, 0x6c, 0x15, 0x9b, 0x9e, 0x73, 0x48, 0xe3, 0xf3,
-		0x8c, 0x92, 0xe9, 0x68, 0x76, 0x8d, 0x7c, 0xa3, 0x6d, 0x78, 0x38, 0x00, 0x55, 0xdb, 0x78, 0x4f,
-		0xd3, 0x0f, 0x55, 0x1a, 0x8c, 0xd3, 0xb4, 0x81, 0xaa, 0x5b, 0xe6, 0x6e, 0xdb, 0xd0, 0x3d, 0x97,
-		0xda, 0x07, 0x66, 0xe3, 0x4a, 0x01, 0xc6, 0x2a, 0x45, 0xb8, 0xe9, 0x5a, 0x26, 0x8d, 0xc1, 0x17,
-		0x05, 0xf4, 0x57, 0x65, 0xf9, 0x75, 0x33, 0x95, 0x49, 0xc9, 0x63, 0x37, 0x53, 0x99, 0x31, 0x39,
-		0x7d, 0x33, 0x95, 0x49, 0xcb, 0xe3, 0x37, 0x53, 0x99, 0x8c, 0x9c, 0xbd, 0x99, 0xca, 0x64, 0x65,
-		0x28, 0xfd, 0x42, 0x06, 0xf2, 0xe1, 0x95, 0x01, 0x59, 0x68, 0xe9, 0xd4, 0x37, 0x4a, 0xd4, 0x7a,
-		0x3e, 0x70, 0xe4, 0x3a, 0x62, 0x61, 0x91, 0x38, 0xcd, 0x72, 0x9a, 0x85, 0xe1, 0x0a, 0xc3, 0x24,
-		0x01, 0x0b, 0x51, 0x6b, 0xcc, 0xc2, 0x9e, 0x8c, 0xc2, 0xbf, 0xd0, 0x0a, 0xa4, 0x5f, 0x77, 0x29,
-		0xed, 0x34, 0xa5, 0xfd, 0xe0, 0xd1, 0xb4, 0x6f, 0x36, 0x28, 0xf1, 0xec, 0xcd, 0x86, 0xba, 0xbe,
-		0xa1, 0xac, 0x55, 0x56, 0x15, 0x8e, 0x8e, 0xce, 0x40, 0xaa, 0xad, 0xbd, 0x71, 0x18, 0x75, 0xaf,
-		0xb4, 0x08, 0x2d, 0x40, 0xb1, 0x6b, 0xde, 0xc6, 0x8e, 0xb1, 0x6b, 0x90, 0xa1, 0x22, 0x50, 0xc5,
-		0x30, 0xd4, 0x44, 0x50, 0xbb, 0x4a, 0xe0, 0x47, 0x54, 0x8f, 0x33, 0x90, 0xba, 0x83, 0xb5, 0x5b,
-		0x51, 0x27, 0x48, 0x8b, 0xd0, 0x45, 0xc8, 0xb7, 0xf0, 0x4e, 0x77, 0x4f, 0x75, 0x70, 0x4b, 0xd3,
-		0xbd, 0xa8, 0xe9, 0xcf, 0xd1, 0x2a, 0x85, 0xd6, 0xa0, 0x97, 0x21, 0x4b, 0xc6, 0xc8, 0xa4, 0x63,
-		0x3c, 0x49, 0x45, 0xf0, 0xf8, 0xd1, 0x22, 0xe0, 0x43, 0x2c, 0x90, 0x94, 0x00, 0x1f, 0x2d, 0x43,
-		0xda, 0xd3, 0x9c, 0x3d, 0xec, 0x51, 0xcb, 0x3f, 0x11, 0x93, 0xfc, 0x88, 0xa1, 0xd4, 0xa4, 0x18,
-		0x74, 0x4d, 0xcb, 0xb1, 0xdf, 0x45, 0x2b, 0x73, 0x19, 0xc6, 0xa8, 0x7a, 0x20, 0x00, 0xae, 0x20,
-		0xf2, 0x09, 0x94, 0x81, 0xd4, 0xe2, 0x86, 0x42, 0x2c, 0x8d, 0x0c, 0x79, 0x56, 0xaa, 0x6e, 0xd6,
-		0x6b, 0x8b, 0x35, 0x39, 0x51, 0x7a, 0x0a, 0xd2, 0x6c, 0xcc, 0x89, 0x15, 0xf2, 0x47, 0x5d, 0x3e,
-		0xc1, 0x3f, 0x39, 0x0d, 0x49, 0xd4, 0x6e, 0xad, 0x55, 0x6b, 0x8a, 0x9c, 0x28, 0x6d, 0x41, 0xb1,
-		0x47, 0x4e, 0xe8, 0x24, 0x4c, 0x2a, 0xb5, 0x66, 0x6d, 0x9d, 0xac, 0xb3, 0xd4, 0xad, 0xf5, 0x97,
-		0xd7, 0x37, 0xde, 0xbb, 0x2e, 0x9f, 0x88, 0x16, 0x0b, 0x93, 0x26, 0xa1, 0x69, 0x90, 0x83, 0xe2,
-		0xc6, 0xc6, 0x96, 0x42, 0xb9, 0xf9, 0xae, 0x04, 0xc8, 0xbd, 0x52, 0x43, 0xa7, 0x61, 0xaa, 0x59,
-		0x51, 0x56, 0x6a, 0x4d, 0x95, 0xad, 0x1d, 0x7d, 0xd2, 0xd3, 0x20, 0x87, 0x2b, 0x96, 0xeb, 0x74,
-		0x69, 0x3c, 0x07, 0x67, 0xc3, 0xa5, 0xb5, 0x57, 0x9a, 0xb5, 0xf5, 0x06, 0x6d, 0xbc, 0xb2, 0xbe,
-		0x42, 0xec, 0x6b, 0x0f, 0x3d, 0xb1, 0x5a, 0x4d, 0x12, 0x56, 0xa3, 0xf4, 0x6a, 0xab, 0x4b, 0x72,
-		0xaa, 0xb7, 0x78, 0x63, 0xbd, 0xb6, 0xb1, 0x2c, 0x8f, 0xf5, 0xb6, 0x4e, 0x57, 0xb0, 0x69, 0x34,
-		0x0b, 0xa7, 0x7a, 0x4b, 0xd5, 0xda, 0x7a, 0x53, 0x79, 0x55, 0x1e, 0xef, 0x6d, 0xb8, 0x51, 0x53,
-		0xb6, 0xeb, 0x8b, 0x35, 0x39, 0x83, 0x4e, 0x01, 0x8a, 0x72, 0xd4, 0xbc, 0xb1, 0xb1, 0x24, 0x67,
-		0xfb, 0x2c, 0x4a, 0xc9, 0x85, 0x7c, 0x78, 0x19, 0xf9, 0xd5, 0xc9, 0x25, 0x7d, 0x30, 0x01, 0xb9,
-		0xd0, 0xb2, 0x90, 0xc4, 0xf3, 0x5a, 0xbb, 0x6d, 0xdd, 0x51, 0xb5, 0xb6, 0xa1, 0xb9, 0xdc, 0xde,
-		0x00, 0x2d, 0xaa, 0x90, 0x92, 0x51, 0xe7, 0xf7, 0xe8, 0x16, 0x3e, 0xfd, 0x37, 0xd1, 0xc2, 0x8f,
-		0xc9, 0xe9, 0xd2, 0x47, 0x25, 0x90, 0x7b, 0xd7, 0x7b, 0x3d, 0xdd, 0x97, 0x06, 0x75, 0xff, 0xab,
-		0x32, 0x76, 0x1f, 0x91, 0x60, 0x22, 0xba, 0xc8, 0xeb, 0x61, 0xef, 0xfc, 0x5f, 0x2b, 0x7b, 0xbf,
-		0x9f, 0x80, 0x42, 0x64, 0x69, 0x37, 0x2a, 0x77, 0xef, 0x83, 0x49, 0xa3, 0x85, 0x3b, 0xb6, 0xe5,
-		0x61, 0x53, 0x3f, 0x54, 0xdb, 0xf8, 0x36, 0x6e, 0xcf, 0x94, 0xa8, 0x51, 0xbe, 0x7c, 0xf4, 0xe2,
-		0x71, 0xa1, 0x1e, 0xe0, 0xad, 0x12, 0xb4, 0xf2, 0x54, 0x7d, 0xa9, 0xb6, 0xb6, 0xb9, 0xd1, 0xac,
-		0xad, 0x2f, 0xbe, 0x2a, 0xac, 0x8b, 0x22, 0x1b, 0x3d, 0x60, 0xef, 0xa2, 0xd1, 0xde, 0x04, 0xb9,
-		0x97, 0x29, 0x62, 0x2b, 0x62, 0xd8, 0x92, 0x4f, 0xa0, 0x29, 0x28, 0xae, 0x6f, 0xa8, 0x8d, 0xfa,
-		0x52, 0x4d, 0xad, 0x2d, 0x2f, 0xd7, 0x16, 0x9b, 0x0d, 0x96, 0x0e, 0xf4, 0xa1, 0x9b, 0x72, 0x22,
-		0x2c, 0xe2, 0x0f, 0x25, 0x61, 0x2a, 0x86, 0x13, 0x54, 0xe1, 0x0b, 0x79, 0x96, 0x5b, 0x78, 0x7c,
-		0x14, 0xee, 0x17, 0x48, 0x28, 0xbd, 0xa9, 0x39, 0x1e, 0x5f, 0xf7, 0x3f, 0x02, 0x44, 0x4a, 0xa6,
-		0x47, 0x3c, 0xbb, 0xc3, 0xd3, 0xac, 0x6c, 0x75, 0x5f, 0x0c, 0xca, 0x59, 0xa6, 0xf5, 0x31, 0x40,
-		0xb6, 0xe5, 0x1a, 0x9e, 0x71, 0x1b, 0xab, 0x86, 0x29, 0x72, 0xb2, 0x64, 0xb5, 0x9f, 0x52, 0x64,
-		0x51, 0x53, 0x37, 0x3d, 0x1f, 0xda, 0xc4, 0x7b, 0x5a, 0x0f, 0x34, 0x89, 0x3c, 0x92, 0x8a, 0x2c,
-		0x6a, 0x7c, 0xe8, 0xf3, 0x90, 0x6f, 0x59, 0x5d, 0xb2, 0x04, 0x62, 0x70, 0xc4, 0x5a, 0x48, 0x4a,
-		0x8e, 0x95, 0xf9, 0x20, 0x7c, 0x71, 0x1b, 0x24, 0x83, 0xf3, 0x4a, 0x8e, 0x95, 0x31, 0x90, 0x0b,
-		0x50, 0xd4, 0xf6, 0xf6, 0x1c, 0x42, 0x5c, 0x10, 0x62, 0xcb, 0xf5, 0x09, 0xbf, 0x98, 0x02, 0xce,
-		0xde, 0x84, 0x8c, 0x90, 0x03, 0x89, 0x60, 0x89, 0x24, 0x54, 0x9b, 0xe5, 0xa0, 0x12, 0x17, 0xb3,
-		0x4a, 0xc6, 0x14, 0x95, 0xe7, 0x21, 0x6f, 0xb8, 0x6a, 0xb0, 0xb7, 0x95, 0x98, 0x4f, 0x5c, 0xcc,
-		0x28, 0x39, 0xc3, 0xf5, 0xf7, 0x05, 0x4a, 0x1f, 0x4f, 0xc0, 0x44, 0x74, 0xd7, 0x0e, 0x2d, 0x41,
-		0xa6, 0x6d, 0xe9, 0x1a, 0x55, 0x2d, 0xb6, 0x65, 0x7c, 0x71, 0xc8, 0x46, 0xdf, 0xc2, 0x2a, 0x87,
-		0x57, 0x7c, 0xcc, 0xd9, 0xdf, 0x94, 0x20, 0x23, 0x8a, 0xd1, 0x29, 0x48, 0xd9, 0x9a, 0xb7, 0x4f,
-		0xc9, 0x8d, 0x55, 0x13, 0xb2, 0xa4, 0xd0, 0x6f, 0x52, 0xee, 0xda, 0x9a, 0x49, 0x55, 0x80, 0x97,
-		0x93, 0x6f, 0x32, 0xae, 0x6d, 0xac, 0xb5, 0x68, 0x2e, 0xc0, 0xea, 0x74, 0xb0, 0xe9, 0xb9, 0x62,
-		0x5c, 0x79, 0xf9, 0x22, 0x2f, 0x46, 0x8f, 0xc2, 0xa4, 0xe7, 0x68, 0x46, 0x3b, 0x02, 0x9b, 0xa2,
-		0xb0, 0xb2, 0xa8, 0xf0, 0x81, 0xcb, 0x70, 0x46, 0xd0, 0x6d, 0x61, 0x4f, 0xd3, 0xf7, 0x71, 0x2b,
-		0x40, 0x4a, 0xd3, 0x9c, 0xdf, 0x69, 0x0e, 0xb0, 0xc4, 0xeb, 0x05, 0x6e, 0xe9, 0x33, 0x09, 0x98,
-		0x14, 0xd9, 0x8b, 0x96, 0x2f, 0xac, 0x35, 0x00, 0xcd, 0x34, 0x2d, 0x2f, 0x2c, 0xae, 0x7e, 0x55,
-		0xee, 0xc3, 0x5b, 0xa8, 0xf8, 0x48, 0x4a, 0x88, 0xc0, 0xec, 0x1f, 0x4b, 0x00, 0x41, 0xd5, 0x40,
-		0xb9, 0xcd, 0x41, 0x8e, 0xef, 0xc9, 0xd2, 0x8d, 0x7d, 0x96, 0xf0, 0x02, 0x56, 0xb4, 0x6c, 0xb4,
-		0x69, 0x5a, 0x72, 0x07, 0xef, 0x19, 0x26, 0xdf, 0x4f, 0x61, 0x1f, 0x22, 0x2d, 0x99, 0x0a, 0xb6,
-		0xa7, 0x14, 0xc8, 0xb8, 0xb8, 0xa3, 0x99, 0x9e, 0xa1, 0xf3, 0x1d, 0x92, 0xeb, 0xc7, 0x62, 0x7e,
-		0xa1, 0xc1, 0xb1, 0x15, 0x9f, 0x4e, 0xe9, 0x22, 0x64, 0x44, 0x29, 0x09, 0xfc, 0xd6, 0x37, 0xd6,
-		0x6b, 0xf2, 0x09, 0x34, 0x0e, 0xc9, 0x46, 0xad, 0x29, 0x4b, 0x64, 0xd9, 0x59, 0x59, 0xad, 0x57,
-		0x1a, 0x72, 0xa2, 0xfa, 0xff, 0xc0, 0x94, 0x6e, 0x75, 0x7a, 0x1b, 0xac, 0xca, 0x3d, 0x29, 0x3f,
-		0xf7, 0x86, 0xf4, 0xda, 0xe3, 0x1c, 0x68, 0xcf, 0x6a, 0x6b, 0xe6, 0xde, 0x82, 0xe5, 0xec, 0x05,
-		0xc7, 0x22, 0xc8, 0xea, 0xc0, 0x0d, 0x1d, 0x8e, 0xb0, 0x77, 0xfe, 0x4a, 0x92, 0x7e, 0x34, 0x91,
-		0x5c, 0xd9, 0xac, 0xfe, 0x64, 0x62, 0x76, 0x85, 0x21, 0x6e, 0x8a, 0xee, 0x28, 0x78, 0xb7, 0x8d,
-		0x75, 0xc2, 0x3c, 0xfc, 0xc9, 0xa3, 0x30, 0xbd, 0x67, 0xed, 0x59, 0x94, 0xd2, 0x65, 0xf2, 0x17,
-		0x3f, 0x57, 0x91, 0xf5, 0x4b, 0x67, 0x87, 0x1e, 0xc2, 0x28, 0xaf, 0xc3, 0x14, 0x07, 0x56, 0xe9,
-		0xf6, 0x2d, 0x4b, 0x2e, 0xa0, 0x23, 0x33, 0xdb, 0x33, 0x3f, 0xfb, 0x87, 0x34, 0x2a, 0x51, 0x26,
-		0x39, 0x2a, 0xa9, 0x63, 0xf9, 0x87, 0xb2, 0x02, 0x27, 0x23, 0xf4, 0x98, 0x8d, 0xc0, 0xce, 0x10,
-		0x8a, 0xff, 0x86, 0x53, 0x9c, 0x0a, 0x51, 0x6c, 0x70, 0xd4, 0xf2, 0x22, 0x14, 0x8e, 0x43, 0xeb,
-		0x57, 0x39, 0xad, 0x3c, 0x0e, 0x13, 0x59, 0x81, 0x22, 0x25, 0xa2, 0x77, 0x5d, 0xcf, 0xea, 0x50,
-		0x03, 0x7c, 0x34, 0x99, 0x7f, 0xfb, 0x87, 0x6c, 0xd2, 0x4e, 0x10, 0xb4, 0x45, 0x1f, 0xab, 0x5c,
-		0x06, 0xba, 0x63, 0xdd, 0xc2, 0x7a, 0x7b, 0x08, 0x85, 0x5f, 0xe3, 0x8c, 0xf8, 0xf0, 0xe5, 0x6d,
-		0x98, 0x26, 0x7f, 0x53, 0xfb, 0x18, 0xe6, 0x64, 0x78, 0x1a, 0x7c, 0xe6, 0x3f, 0x7e, 0x33, 0xb3,
-		0x0b, 0x53, 0x3e, 0x81, 0x10, 0x4f, 0xa1, 0x51, 0xdc, 0xc3, 0x9e, 0x87, 0x1d, 0x57, 0xd5, 0xda,
-		0x71, 0xec, 0x85, 0xf2, 0x88, 0x33, 0x3f, 0xf4, 0xc5, 0xe8, 0x28, 0xae, 0x30, 0xcc, 0x4a, 0xbb,
-		0x5d, 0xde, 0x82, 0xd3, 0x31, 0x5a, 0x31, 0x02, 0xcd, 0x0f, 0x71, 0x9a, 0xd3, 0x7d, 0x9a, 0x41,
-		0xc8, 0x6e, 0x82, 0x28, 0xf7, 0xc7, 0x72, 0x04, 0x9a, 0x1f, 0xe6, 0x34, 0x11, 0xc7, 0x15, 0x43,
-		0x4a, 0x28, 0xde, 0x84, 0xc9, 0xdb, 0xd8, 0xd9, 0xb1, 0x5c, 0x9e, 0xbb, 0x1d, 0x81, 0xdc, 0x47,
-		0x38, 0xb9, 0x22, 0x47, 0xa4, 0xc9, 0x5c, 0x42, 0xeb, 0x59, 0xc8, 0xec, 0x6a, 0x3a, 0x1e, 0x81,
-		0xc4, 0x5d, 0x4e, 0x62, 0x9c, 0xc0, 0x13, 0xd4, 0x0a, 0xe4, 0xf7, 0x2c, 0xee, 0x22, 0x87, 0xa3,
-		0x7f, 0x94, 0xa3, 0xe7, 0x04, 0x0e, 0x27, 0x61, 0x5b, 0x76, 0xb7, 0x4d, 0xfc, 0xe7, 0x70, 0x12,
-		0x3f, 0x2c, 0x48, 0x08, 0x1c, 0x4e, 0xe2, 0x18, 0x62, 0xfd, 0x98, 0x20, 0xe1, 0x86, 0xe4, 0xf9,
-		0x12, 0xe4, 0x2c, 0xb3, 0x7d, 0x68, 0x99, 0xa3, 0x30, 0xf1, 0x23, 0x9c, 0x02, 0x70, 0x14, 0x42,
-		0xe0, 0x39, 0xc8, 0x8e, 0x3a, 0x10, 0x3f, 0xfe, 0x45, 0x31, 0x3d, 0xc4, 0x08, 0xac, 0x40, 0x51,
-		0x18, 0x28, 0xc3, 0x32, 0x47, 0x20, 0xf1, 0x13, 0x9c, 0xc4, 0x44, 0x08, 0x8d, 0x77, 0xc3, 0xc3,
-		0xae, 0xb7, 0x87, 0x47, 0x21, 0xf2, 0x71, 0xd1, 0x0d, 0x8e, 0xc2, 0x45, 0xb9, 0x83, 0x4d, 0x7d,
-		0x7f, 0x34, 0x0a, 0x9f, 0x10, 0xa2, 0x14, 0x38, 0x84, 0xc4, 0x22, 0x14, 0x3a, 0x9a, 0xe3, 0xee,
-		0x6b, 0xed, 0x91, 0x86, 0xe3, 0x93, 0x9c, 0x46, 0xde, 0x47, 0xe2, 0x12, 0xe9, 0x9a, 0xc7, 0x21,
-		0xf3, 0x93, 0x42, 0x22, 0x21, 0x34, 0x3e, 0xf5, 0x5c, 0x8f, 0x26, 0xba, 0x8f, 0x43, 0xed, 0xa7,
-		0xc4, 0xd4, 0x63, 0xb8, 0x6b, 0x61, 0x8a, 0xcf, 0x41, 0xd6, 0x35, 0xde, 0x18, 0x89, 0xcc, 0x3f,
-		0x14, 0x23, 0x4d, 0x11, 0x08, 0xf2, 0xab, 0x70, 0x26, 0xd6, 0x4d, 0x8c, 0x40, 0xec, 0x1f, 0x71,
-		0x62, 0xa7, 0x62, 0x5c, 0x05, 0x37, 0x09, 0xc7, 0x25, 0xf9, 0xd3, 0xc2, 0x24, 0xe0, 0x1e, 0x5a,
-		0x9b, 0x64, 0xd1, 0xe2, 0x6a, 0xbb, 0xc7, 0x93, 0xda, 0x3f, 0x16, 0x52, 0x63, 0xb8, 0x11, 0xa9,
-		0x35, 0xe1, 0x14, 0xa7, 0x78, 0xbc, 0x71, 0xfd, 0x19, 0x61, 0x58, 0x19, 0xf6, 0x56, 0x74, 0x74,
-		0xbf, 0x16, 0x66, 0x7d, 0x71, 0x8a, 0xe8, 0xd8, 0x55, 0x3b, 0x9a, 0x3d, 0x02, 0xe5, 0x9f, 0xe5,
-		0x94, 0x85, 0xc5, 0xf7, 0xc3, 0x6b, 0x77, 0x4d, 0xb3, 0x09, 0xf1, 0x57, 0x60, 0x46, 0x10, 0xef,
-		0x9a, 0x0e, 0xd6, 0xad, 0x3d, 0xd3, 0x78, 0x03, 0xb7, 0x46, 0x20, 0xfd, 0x4f, 0x7a, 0x86, 0x6a,
-		0x2b, 0x84, 0x4e, 0x28, 0xd7, 0x41, 0xf6, 0x63, 0x15, 0xd5, 0xe8, 0xd8, 0x96, 0xe3, 0x0d, 0xa1,
-		0xf8, 0x29, 0x31, 0x52, 0x3e, 0x5e, 0x9d, 0xa2, 0x95, 0x6b, 0xc0, 0x4e, 0x7f, 0x8c, 0xaa, 0x92,
-		0x9f, 0xe6, 0x84, 0x0a, 0x01, 0x16, 0x37, 0x1c, 0xba, 0xd5, 0xb1, 0x35, 0x67, 0x14, 0xfb, 0xf7,
-		0x73, 0xc2, 0x70, 0x70, 0x14, 0x6e, 0x38, 0x48, 0x44, 0x47, 0xbc, 0xfd, 0x08, 0x14, 0x7e, 0x5e,
-		0x18, 0x0e, 0x81, 0xc3, 0x49, 0x88, 0x80, 0x61, 0x04, 0x12, 0xbf, 0x20, 0x48, 0x08, 0x1c, 0x42,
-		0xe2, 0x3d, 0x81, 0xa3, 0x75, 0xf0, 0x9e, 0xe1, 0x7a, 0x0e, 0x0b, 0xc9, 0x8f, 0x26, 0xf5, 0x8b,
-		0x5f, 0x8c, 0x06, 0x61, 0x4a, 0x08, 0x95, 0x58, 0x22, 0xbe, 0xf5, 0x41, 0x97, 0x6c, 0xc3, 0x19,
-		0xfb, 0x25, 0x61, 0x89, 0x42, 0x68, 0x84, 0xb7, 0x50, 0x84, 0x48, 0xc4, 0xae, 0x93, 0x85, 0xca,
-		0x08, 0xe4, 0xfe, 0x69, 0x0f, 0x73, 0x0d, 0x81, 0x4b, 0x68, 0x86, 0xe2, 0x9f, 0xae, 0x79, 0x0b,
-		0x1f, 0x8e, 0xa4, 0x9d, 0xbf, 0xdc, 0x13, 0xff, 0x6c, 0x31, 0x4c, 0x66, 0x43, 0x8a, 0x3d, 0xf1,
-		0x14, 0x1a, 0x76, 0xd6, 0x6f, 0xe6, 0x9b, 0xbe, 0xc4, 0xfb, 0x1b, 0x0d, 0xa7, 0xca, 0xab, 0x44,
-		0xc9, 0xa3, 0x41, 0xcf, 0x70, 0x62, 0xdf, 0xfc, 0x25, 0x5f, 0xcf, 0x23, 0x31, 0x4f, 0x79, 0x19,
-		0x0a, 0x91, 0x80, 0x67, 0x38, 0xa9, 0x6f, 0xe1, 0xa4, 0xf2, 0xe1, 0x78, 0xa7, 0xfc, 0x14, 0xa4,
-		0x48, 0xf0, 0x32, 0x1c, 0xfd, 0x5b, 0x39, 0x3a, 0x05, 0x2f, 0xbf, 0x00, 0x19, 0x11, 0xb4, 0x0c,
-		0x47, 0xfd, 0x36, 0x8e, 0xea, 0xa3, 0x10, 0x74, 0x11, 0xb0, 0x0c, 0x47, 0xff, 0xff, 0x04, 0xba,
-		0x40, 0x21, 0xe8, 0xa3, 0x8b, 0xf0, 0x5f, 0x7e, 0x67, 0x8a, 0x3b, 0x1d, 0x21, 0xbb, 0xe7, 0x60,
-		0x9c, 0x47, 0x2a, 0xc3, 0xb1, 0xbf, 0x83, 0x37, 0x2e, 0x30, 0xca, 0x4f, 0xc3, 0xd8, 0x88, 0x02,
-		0xff, 0x6e, 0x8e, 0xca, 0xe0, 0xcb, 0x8b, 0x90, 0x0b, 0x45, 0x27, 0xc3, 0xd1, 0xbf, 0x87, 0xa3,
-		0x87, 0xb1, 0x08, 0xeb, 0x3c, 0x3a, 0x19, 0x4e, 0xe0, 0xff, 0x17, 0xac, 0x73, 0x0c, 0x22, 0x36,
-		0x11, 0x98, 0x0c, 0xc7, 0xfe, 0x80, 0x90, 0xba, 0x40, 0x29, 0xbf, 0x04, 0x59, 0xdf, 0xd9, 0x0c,
-		0xc7, 0xff, 0x5e, 0x8e, 0x1f, 0xe0, 0x10, 0x09, 0x84, 0x9c, 0xdd, 0x70, 0x12, 0x7f, 0x4f, 0x48,
-		0x20, 0x84, 0x45, 0xa6, 0x51, 0x6f, 0x00, 0x33, 0x9c, 0xd2, 0xf7, 0x89, 0x69, 0xd4, 0x13, 0xbf,
-		0x90, 0xd1, 0xa4, 0x36, 0x7f, 0x38, 0x89, 0xef, 0x17, 0xa3, 0x49, 0xe1, 0x09, 0x1b, 0xbd, 0x11,
-		0xc1, 0x70, 0x1a, 0x3f, 0x28, 0xd8, 0xe8, 0x09, 0x08, 0xca, 0x9b, 0x80, 0xfa, 0xa3, 0x81, 0xe1,
-		0xf4, 0x3e, 0xc8, 0xe9, 0x4d, 0xf6, 0x05, 0x03, 0xe5, 0xf7, 0xc2, 0xa9, 0xf8, 0x48, 0x60, 0x38,
-		0xd5, 0x1f, 0xfa, 0x52, 0xcf, 0xda, 0x2d, 0x1c, 0x08, 0x94, 0x9b, 0x81, 0x4b, 0x09, 0x47, 0x01,
-		0xc3, 0xc9, 0x7e, 0xe8, 0x4b, 0x51, 0xc3, 0x1d, 0x0e, 0x02, 0xca, 0x15, 0x80, 0xc0, 0x01, 0x0f,
-		0xa7, 0xf5, 0x11, 0x4e, 0x2b, 0x84, 0x44, 0xa6, 0x06, 0xf7, 0xbf, 0xc3, 0xf1, 0xef, 0x8a, 0xa9,
-		0xc1, 0x31, 0xc8, 0xd4, 0x10, 0xae, 0x77, 0x38, 0xf6, 0x47, 0xc5, 0xd4, 0x10, 0x28, 0x44, 0xb3,
-		0x43, 0xde, 0x6d, 0x38, 0x85, 0x1f, 0x11, 0x9a, 0x1d, 0xc2, 0x2a, 0xaf, 0xc3, 0x64, 0x9f, 0x43,
-		0x1c, 0x4e, 0xea, 0x47, 0x39, 0x29, 0xb9, 0xd7, 0x1f, 0x86, 0x9d, 0x17, 0x77, 0x86, 0xc3, 0xa9,
-		0xfd, 0x58, 0x8f, 0xf3, 0xe2, 0xbe, 0xb0, 0xfc, 0x1c, 0x64, 0xcc, 0x6e, 0xbb, 0x4d, 0x26, 0x0f,
-		0x3a, 0xfa, 0x7c, 0xee, 0xcc, 0x7f, 0xf9, 0x0a, 0x97, 0x8e, 0x40, 0x28, 0x3f, 0x05, 0x63, 0xb8,
-		0xb3, 0x83, 0x5b, 0xc3, 0x30, 0xff, 0xe8, 0x2b, 0xc2, 0x60, 0x12, 0xe8, 0xf2, 0x4b, 0x00, 0x2c,
-		0x35, 0x42, 0x37, 0xce, 0x87, 0xe0, 0xfe, 0xf1, 0x57, 0xf8, 0x81, 0xb8, 0x00, 0x25, 0x20, 0xc0,
-		0x8e, 0xd7, 0x1d, 0x4d, 0xe0, 0x8b, 0x51, 0x02, 0x74, 0x44, 0x9e, 0x85, 0xf1, 0xd7, 0x5d, 0xcb,
-		0xf4, 0xb4, 0xbd, 0x61, 0xd8, 0x7f, 0xc2, 0xb1, 0x05, 0x3c, 0x11, 0x58, 0xc7, 0x72, 0xb0, 0xa7,
-		0xed, 0xb9, 0xc3, 0x70, 0xff, 0x94, 0xe3, 0xfa, 0x08, 0x04, 0x59, 0xd7, 0x5c, 0x6f, 0x94, 0x7e,
-		0xff, 0x57, 0x81, 0x2c, 0x10, 0x08, 0xd3, 0xe4, 0xef, 0x5b, 0xf8, 0x70, 0x18, 0xee, 0x9f, 0x09,
-		0xa6, 0x39, 0x7c, 0xf9, 0x05, 0xc8, 0x92, 0x3f, 0xd9, 0x29, 0xd7, 0x21, 0xc8, 0x7f, 0xce, 0x91,
-		0x03, 0x0c, 0xd2, 0xb2, 0xeb, 0xb5, 0x3c, 0x63, 0xb8, 0xb0, 0xff, 0x82, 0x8f, 0xb4, 0x80, 0x2f,
-		0x57, 0x20, 0xe7, 0x7a, 0xad, 0x56, 0x97, 0xc7, 0xa7, 0x43, 0xd0, 0xff, 0xdb, 0x57, 0xfc, 0x94,
-		0x85, 0x8f, 0x43, 0x46, 0xfb, 0xce, 0x2d, 0xcf, 0xb6, 0xe8, 0x7e, 0xcb, 0x30, 0x0a, 0x5f, 0xe2,
-		0x14, 0x42, 0x28, 0xe5, 0x45, 0xc8, 0x93, 0xbe, 0x38, 0xd8, 0xc6, 0x74, 0x73, 0x6c, 0x08, 0x89,
-		0x2f, 0x73, 0x01, 0x44, 0x90, 0xaa, 0xdf, 0xf8, 0x6b, 0x9f, 0x3d, 0x27, 0x7d, 0xe6, 0xb3, 0xe7,
-		0xa4, 0xdf, 0xff, 0xec, 0x39, 0xe9, 0x03, 0x9f, 0x3b, 0x77, 0xe2, 0x33, 0x9f, 0x3b, 0x77, 0xe2,
-		0xb7, 0x3f, 0x77, 0xee, 0x44, 0x7c, 0x96, 0x18, 0x56, 0xac, 0x15, 0x8b, 0xe5, 0x87, 0x5f, 0x7b,
-		0x68, 0xcf, 0xf0, 0xf6, 0xbb, 0x3b, 0x0b, 0xba, 0xd5, 0xb9, 0xac, 0x5b, 0x6e, 0xc7, 0x72, 0x2f,
-		0x47, 0xf3, 0xba, 0xf4, 0x2f, 0xf8, 0x1f, 0x12, 0x59, 0x33, 0x47, 0xd3, 0xb9, 0x9a, 0x79, 0x38,
-		0xe8, 0x32, 0xdd, 0x75, 0x48, 0x56, 0xcc, 0x43, 0x74, 0x86, 0x19, 0x38, 0xb5, 0xeb, 0xb4, 0xf9,
-		0x51, 0xcb, 0x71, 0xf2, 0xbd, 0xe5, 0xb4, 0xd1, 0x74, 0x70, 0x1e, 0x5a, 0xba, 0x98, 0xe7, 0x87,
-		0x9c, 0xab, 0xdf, 0x23, 0x1d, 0xaf, 0x27, 0x99, 0x8a, 0x79, 0x48, 0x3b, 0xb2, 0x29, 0xbd, 0xf6,
-		0xd8, 0xd0, 0x3c, 0xf7, 0x2d, 0xd3, 0xba, 0x63, 0x12, 0xb6, 0xed, 0x1d, 0x91, 0xe3, 0x3e, 0xd7,
-		0x9b, 0xe3, 0x7e, 0x2f, 0x6e, 0xb7, 0x5f, 0x26, 0x70, 0x4d, 0x82, 0xb2, 0x93, 0x66, 0xa7, 0xfa,
-		0xe1, 0xfb, 0x12, 0x70, 0xae, 0x2f, 0x9d, 0xcd, 0x95, 0x60, 0x90, 0x10, 0xca, 0x90, 0x59, 0x12,
-		0xba, 0x35, 0x03, 0xe3, 0x2e, 0xd6, 0x2d, 0xb3, 0xe5, 0x52, 0x41, 0x24, 0x15, 0xf1, 0x49, 0x04,
-		0x61, 0x6a, 0xa6, 0xe5, 0xf2, 0xc3, 0xca, 0xec, 0xa3, 0xfa, 0xe1, 0x63, 0x0a, 0xa2, 0x20, 0x5a,
-		0x12, 0xd2, 0xb8, 0x32, 0xa2, 0x34, 0x44, 0x27, 0x22, 0x99, 0xff, 0x51, 0xa5, 0xf2, 0x83, 0x09,
-		0x98, 0xeb, 0x95, 0x0a, 0x99, 0x59, 0xae, 0xa7, 0x75, 0xec, 0x41, 0x62, 0x79, 0x0e, 0xb2, 0x4d,
-		0x01, 0x73, 0x6c, 0xb9, 0xdc, 0x3d, 0xa6, 0x5c, 0x26, 0xfc, 0xa6, 0x84, 0x60, 0xae, 0x8e, 0x28,
-		0x18, 0xbf, 0x1f, 0xf7, 0x24, 0x99, 0x0f, 0x67, 0xe1, 0x0c, 0x9b, 0x4e, 0x2a, 0x9b, 0x4a, 0xec,
-		0x83, 0xcb, 0x24, 0x1f, 0xae, 0x1a, 0xbe, 0x4f, 0x52, 0x7a, 0x19, 0xa6, 0xea, 0xc4, 0x5a, 0x90,
-		0x55, 0x50, 0xb0, 0xc3, 0x13, 0x7b, 0x9e, 0x7b, 0x3e, 0x12, 0xf0, 0xf3, 0xfd, 0xad, 0x70, 0x51,
-		0xe9, 0x9b, 0x24, 0x90, 0x1b, 0xba, 0xd6, 0xd6, 0x9c, 0xb7, 0x4b, 0x0a, 0x3d, 0x0d, 0xc0, 0x8e,
-		0x7b, 0xf8, 0x17, 0xf7, 0x26, 0xae, 0xce, 0x2c, 0x84, 0x3b, 0xb7, 0xc0, 0x5a, 0xa2, 0x27, 0xa8,
-		0xb2, 0x14, 0x96, 0xfc, 0x79, 0xe9, 0x15, 0x80, 0xa0, 0x02, 0x9d, 0x85, 0xd3, 0x8d, 0xc5, 0xca,
-		0x6a, 0x45, 0x11, 0x87, 0x84, 0x1a, 0x9b, 0xb5, 0xc5, 0xfa, 0x72, 0xbd, 0xb6, 0x24, 0x9f, 0x40,
-		0xa7, 0x00, 0x85, 0x2b, 0xfd, 0x43, 0x4d, 0x27, 0x61, 0x32, 0x5c, 0xce, 0x6e, 0xa9, 0x24, 0xca,
-		0x37, 0xa0, 0xc8, 0x8e, 0xd0, 0xab, 0x5a, 0xab, 0x85, 0x5b, 0xaa, 0x61, 0xa2, 0x21, 0x27, 0xd2,
-		0x67, 0x7e, 0xfd, 0x3f, 0x8d, 0xd1, 0xae, 0x15, 0x18, 0x62, 0x85, 0xe0, 0xd5, 0x4d, 0x12, 0x73,
-		0x1a, 0x1d, 0xbb, 0x8d, 0xe9, 0x1e, 0xa6, 0x6a, 0x08, 0xf9, 0x0f, 0x0f, 0x67, 0x08, 0xbd, 0xe4,
-		0xc5, 0xac, 0x32, 0x15, 0xa0, 0xfb, 0xa3, 0x57, 0x7e, 0x19, 0x64, 0x71, 0xd4, 0xd3, 0x67, 0x70,
-		0x28, 0xc5, 0xdf, 0xe0, 0x1c, 0x8a, 0x6c, 0x86, 0x60, 0x71, 0x15, 0x26, 0x35, 0x5d, 0xc7, 0x76,
-		0x84, 0xbf, 0x21, 0x1e, 0x44, 0xf4, 0x56, 0xe6, 0x98, 0x01, 0x6b, 0x4f, 0x43, 0xda, 0xa5, 0x83,
-		0x32, 0x8c, 0x84, 0x60, 0x87, 0x83, 0x97, 0x6b, 0x30, 0xc1, 0xd4, 0xc0, 0xef, 0xd1, 0x10, 0x02,
-		0xff, 0x8e, 0x13, 0xc8, 0x53, 0x34, 0xd1, 0x1b, 0x13, 0x26, 0x49, 0x5c, 0xab, 0x39, 0x38, 0xd4,
-		0x9b, 0xa3, 0xb3, 0x28, 0xff, 0xec, 0x53, 0x4f, 0xd0, 0x7d, 0xe3, 0xf3, 0x51, 0xa5, 0x8b, 0x99,
-		0x2c, 0x8a, 0xcc, 0x69, 0x07, 0xfd, 0xc5, 0x30, 0x21, 0xda, 0xe3, 0xfd, 0x3e, 0xba, 0xb1, 0x7f,
-		0xce, 0x1b, 0x3b, 0x17, 0xa7, 0xe1, 0xa1, 0x96, 0x0a, 0x9c, 0x2a, 0xab, 0x28, 0x57, 0xa1, 0xb0,
-		0x6b, 0xb4, 0x43, 0xc3, 0x7d, 0x74, 0x2b, 0xff, 0xe2, 0x53, 0x4f, 0xb0, 0x89, 0x46, 0x90, 0xb8,
-		0x68, 0xaa, 0xb5, 0x41, 0x56, 0xef, 0xb5, 0x47, 0xfb, 0xfd, 0x37, 0xfb, 0xef, 0x71, 0x4a, 0xfd,
-		0xb9, 0x30, 0xab, 0x81, 0x75, 0x4a, 0xc1, 0xa4, 0xd6, 0x31, 0x4c, 0xeb, 0x32, 0xfd, 0x97, 0x5b,
-		0xa5, 0x31, 0xfa, 0x31, 0xc2, 0xb6, 0xed, 0x75, 0x66, 0x2c, 0x86, 0xeb, 0xed, 0x9f, 0x7f, 0xd7,
-		0x4f, 0x8c, 0x05, 0x06, 0xa5, 0xbc, 0x16, 0xe8, 0x3e, 0x36, 0x75, 0xab, 0x35, 0x52, 0x1e, 0xe7,
-		0x2f, 0x04, 0x0d, 0x91, 0x01, 0xac, 0x71, 0xd4, 0xf2, 0xf3, 0x90, 0xf1, 0xc9, 0x0c, 0x8b, 0xdd,
-		0x04, 0x11, 0x1f, 0x83, 0x44, 0x6e, 0x4c, 0x69, 0x47, 0x89, 0xd3, 0xbf, 0x24, 0xf0, 0x99, 0x0d,
-		0x5b, 0x27, 0xbd, 0x59, 0x81, 0x89, 0x96, 0x65, 0x7a, 0xaa, 0xd5, 0x31, 0x3c, 0xdc, 0xb1, 0xbd,
-		0xa1, 0x91, 0xef, 0x97, 0x19, 0x91, 0x8c, 0x52, 0x20, 0x78, 0x1b, 0x02, 0x8d, 0x70, 0xc2, 0x6e,
-		0x32, 0x8e, 0xc2, 0xc9, 0x5f, 0xfa, 0x9c, 0x50, 0x1c, 0xc2, 0xc9, 0x3d, 0x69, 0x87, 0xdb, 0xba,
-		0xc5, 0xdd, 0x9d, 0x77, 0xc0, 0xb4, 0xc0, 0xd7, 0x8e, 0x8f, 0x27, 0xe1, 0x1c, 0x07, 0xde, 0xd1,
-		0x5c, 0x7c, 0xf9, 0xf6, 0x95, 0x1d, 0xec, 0x69, 0x57, 0x2e, 0xeb, 0x96, 0x21, 0x62, 0x9d, 0x29,
-		0xee, 0xce, 0x48, 0xfd, 0x02, 0xaf, 0x9f, 0x8d, 0x3d, 0x10, 0x30, 0x3b, 0xd8, 0x0d, 0xce, 0xf6,
-		0xeb, 0x60, 0xa9, 0x0d, 0xa9, 0x45, 0xcb, 0x30, 0x89, 0xf7, 0x6f, 0x61, 0xd3, 0xea, 0x70, 0x87,
-		0xc4, 0x3e, 0xd0, 0x0d, 0x48, 0x6b, 0x1d, 0xab, 0x6b, 0x7a, 0xcc, 0x19, 0x55, 0x9f, 0xf8, 0xb5,
-		0xb7, 0xe6, 0x4e, 0xfc, 0xce, 0x5b, 0x73, 0x27, 0x19, 0x59, 0xb7, 0x75, 0x6b, 0xc1, 0xb0, 0x2e,
-		0x77, 0x34, 0x6f, 0x9f, 0x98, 0x80, 0xdf, 0xfa, 0xf4, 0xe3, 0xc0, 0xdb, 0xab, 0x9b, 0xde, 0x27,
-		0x3e, 0xff, 0x33, 0x97, 0x24, 0x85, 0xe3, 0x97, 0x53, 0x5f, 0xf8, 0xd8, 0x9c, 0x54, 0xb2, 0x61,
-		0x7c, 0x09, 0xeb, 0x47, 0x34, 0x58, 0xef, 0x69, 0xf0, 0x0a, 0x6f, 0xf0, 0x6c, 0x7f, 0x83, 0xec,
-		0x48, 0xe3, 0x12, 0xd6, 0x43, 0xcd, 0x2e, 0x61, 0x3d, 0xda, 0x62, 0x75, 0xe9, 0xb7, 0xff, 0xe0,
-		0xdc, 0x89, 0xf7, 0x7f, 0xf6, 0xdc, 0x89, 0x81, 0x43, 0x56, 0x1a, 0x3e, 0x64, 0xfe, 0x48, 0x7d,
-		0x32, 0x45, 0x46, 0xaa, 0x83, 0xbd, 0x9d, 0x5d, 0xef, 0xb2, 0xee, 0x1c, 0xda, 0x9e, 0x75, 0xf9,
-		0xf6, 0x15, 0x32, 0x73, 0xad, 0x5d, 0x3e, 0x52, 0x48, 0xd4, 0x2f, 0xb0, 0xfa, 0x85, 0xdb, 0x03,
-		0x06, 0xaa, 0xb4, 0x0b, 0x63, 0x9b, 0x04, 0x91, 0x88, 0xc2, 0xb3, 0x3c, 0xad, 0xcd, 0x23, 0x32,
-		0xf6, 0x41, 0x4a, 0xd9, 0x4d, 0xdb, 0x04, 0x2b, 0x35, 0xc4, 0x25, 0xdb, 0x36, 0xd6, 0x76, 0xd9,
-		0x85, 0xa5, 0x24, 0x0d, 0xe5, 0x33, 0xa4, 0x80, 0xde, 0x4d, 0x9a, 0x86, 0x31, 0xad, 0xcb, 0x0e,
-		0x15, 0x25, 0x49, 0x8c, 0x4f, 0x3f, 0x4a, 0xab, 0x30, 0xce, 0xcf, 0x16, 0x20, 0x19, 0x92, 0xb7,
-		0xf0, 0x21, 0x6d, 0x27, 0xaf, 0x90, 0x3f, 0xd1, 0x65, 0x18, 0xa3, 0xdc, 0xf3, 0x9b, 0x98, 0x67,
-		0x16, 0xfa, 0xd9, 0x5f, 0xa0, 0x5c, 0x2a, 0x0c, 0xae, 0x74, 0x13, 0x32, 0x4b, 0x16, 0x51, 0xa0,
-		0x28, 0xb9, 0x2c, 0x23, 0x47, 0x99, 0xb6, 0xbb, 0x7c, 0xf8, 0x14, 0xf6, 0x81, 0x4e, 0x41, 0x9a,
-		0xdd, 0x60, 0xe3, 0x27, 0xa3, 0xf8, 0x57, 0x69, 0x11, 0xc6, 0x29, 0xed, 0x0d, 0x9b, 0xc4, 0x43,
-		0xfe, 0xa1, 0xfe, 0x2c, 0xbf, 0xcf, 0xcc, 0xc9, 0x27, 0x02, 0x6e, 0x11, 0xa4, 0x5a, 0x9a, 0xa7,
-		0xf1, 0x8e, 0xd3, 0xbf, 0x4b, 0x2f, 0x41, 0x86, 0x13, 0x71, 0xd1, 0x93, 0x90, 0xb4, 0x6c, 0x97,
-		0x9f, 0x6d, 0x3a, 0x3b, 0xb0, 0x2f, 0x1b, 0x76, 0x35, 0x45, 0x14, 0x4b, 0x21, 0xd0, 0xd5, 0xb5,
-		0x81, 0xaa, 0xf1, 0x64, 0x44, 0x35, 0xc4, 0xb0, 0x8b, 0x3f, 0x34, 0xdb, 0xb8, 0xdc, 0xaf, 0x0c,
-		0xbe, 0xae, 0xfc, 0x77, 0x09, 0xee, 0x8f, 0xd1, 0x95, 0x5b, 0xf8, 0xd0, 0x3d, 0xb6, 0xaa, 0xbc,
-		0x02, 0xd9, 0x4d, 0xfa, 0xba, 0xc8, 0xcb, 0xf8, 0x10, 0xcd, 0xc2, 0x38, 0x6e, 0x5d, 0x7d, 0xea,
-		0xa9, 0x2b, 0xcf, 0xb2, 0x81, 0xbc, 0x71, 0x42, 0x11, 0x05, 0xe8, 0x1c, 0x64, 0x5d, 0xac, 0xdb,
-		0x57, 0x9f, 0xba, 0x7e, 0xeb, 0x0a, 0x13, 0xdc, 0x8d, 0x13, 0x4a, 0x50, 0x54, 0xce, 0x90, 0x49,
-		0xf1, 0x85, 0x1f, 0x99, 0x93, 0xaa, 0x63, 0x90, 0x74, 0xbb, 0x9d, 0x77, 0xab, 0xf3, 0x7f, 0x99,
-		0x86, 0xf3, 0x7e, 0x35, 0x33, 0x7b, 0xb7, 0xaf, 0x5c, 0xbe, 0xad, 0xb5, 0x8d, 0x96, 0x16, 0xbc,
-		0x09, 0x33, 0xe9, 0x0b, 0x80, 0x82, 0x0c, 0xec, 0xff, 0xec, 0xd1, 0x82, 0x2c, 0x7d, 0x5a, 0x82,
-		0xfc, 0xb6, 0xa0, 0xdd, 0xc0, 0x1e, 0x7a, 0x1e, 0xc0, 0x6f, 0x4b, 0xa8, 0xc3, 0x7d, 0x0b, 0x7d,
-		0xad, 0x2d, 0xf8, 0x48, 0x4a, 0x08, 0x1e, 0x3d, 0x03, 0x19, 0xdb, 0xb1, 0x6c, 0xcb, 0xe5, 0x37,
-		0x5a, 0x87, 0xe1, 0xfa, 0xd0, 0xe8, 0x31, 0x40, 0x74, 0xf2, 0xaa, 0xb7, 0x2d, 0xcf, 0x30, 0xf7,
-		0x54, 0xdb, 0xba, 0xc3, 0x1f, 0x0a, 0x48, 0x2a, 0x32, 0xad, 0xd9, 0xa6, 0x15, 0x9b, 0xa4, 0xbc,
-		0xf4, 0x29, 0x09, 0xb2, 0x3e, 0x15, 0xb2, 0x32, 0xd3, 0x5a, 0x2d, 0x07, 0xbb, 0x2e, 0x9f, 0x9f,
-		0xe2, 0x13, 0x3d, 0x0f, 0xe3, 0x76, 0x77, 0x47, 0x15, 0x73, 0x21, 0x77, 0xf5, 0xfe, 0x58, 0xcd,
-		0x16, 0x0a, 0xc2, 0x75, 0x3b, 0x6d, 0x77, 0x77, 0x88, 0xba, 0x9c, 0x87, 0x7c, 0x0c, 0x37, 0xb9,
-		0xdb, 0x01, 0x23, 0xf4, 0x55, 0x1b, 0xde, 0x05, 0xd5, 0x76, 0x0c, 0xcb, 0x31, 0xbc, 0x43, 0x7a,
-		0xf4, 0x2e, 0xa9, 0xc8, 0xa2, 0x62, 0x93, 0x97, 0x97, 0xda, 0x50, 0x6c, 0xd0, 0x40, 0x3b, 0x60,
-		0xfd, 0x7a, 0xc0, 0xa0, 0x34, 0x02, 0x83, 0x03, 0x59, 0x4b, 0xf4, 0xb1, 0x76, 0xe9, 0x3f, 0x4b,
-		0x90, 0xab, 0xb6, 0x2d, 0xfd, 0x56, 0x7d, 0x69, 0xb9, 0xad, 0xed, 0xa1, 0x2b, 0x70, 0xb2, 0xba,
-		0xba, 0xb1, 0xf8, 0xb2, 0x5a, 0x5f, 0x52, 0x97, 0x57, 0x2b, 0x2b, 0xc1, 0x61, 0xdf, 0xd9, 0x53,
-		0x6f, 0xde, 0x9d, 0x47, 0x21, 0xd8, 0x2d, 0x93, 0x2e, 0x2c, 0xd1, 0x65, 0x98, 0x8e, 0xa2, 0x54,
-		0xaa, 0x8d, 0xda, 0x7a, 0x53, 0x96, 0x66, 0x4f, 0xbe, 0x79, 0x77, 0x7e, 0x32, 0x84, 0x51, 0xd9,
-		0x71, 0xb1, 0xe9, 0xf5, 0x23, 0x2c, 0x6e, 0xac, 0xad, 0xd5, 0x9b, 0x72, 0xa2, 0x0f, 0x61, 0xd1,
-		0xea, 0x74, 0x0c, 0x0f, 0x3d, 0x02, 0x93, 0x51, 0x84, 0xf5, 0xfa, 0xaa, 0x9c, 0x9c, 0x45, 0x6f,
-		0xde, 0x9d, 0x9f, 0x08, 0x41, 0xaf, 0x1b, 0xed, 0xd9, 0xcc, 0xb7, 0xff, 0xd8, 0xb9, 0x13, 0x9f,
-		0xf8, 0x07, 0xe7, 0xa4, 0xea, 0xea, 0xc0, 0x99, 0x77, 0x75, 0xf4, 0x99, 0x27, 0xa6, 0x96, 0x3f,
-		0xf1, 0x3e, 0x9a, 0x80, 0x39, 0xbf, 0xf6, 0x36, 0x76, 0x5c, 0xc3, 0x32, 0xc9, 0x6c, 0x61, 0x6a,
-		0xeb, 0x07, 0x13, 0x7c, 0x70, 0x38, 0xc0, 0x60, 0xc3, 0xf3, 0x02, 0x24, 0x2b, 0xb6, 0x8d, 0x66,
-		0xe9, 0x8c, 0xf0, 0x2c, 0xdd, 0x62, 0x4e, 0x2a, 0xa5, 0xf8, 0xdf, 0xa4, 0xce, 0xb5, 0x76, 0xbd,
-		0x3b, 0x9a, 0xe3, 0x3f, 0x2c, 0x21, 0xbe, 0x4b, 0xcf, 0x42, 0x76, 0xd1, 0x32, 0x5d, 0x6c, 0xba,
-		0x5d, 0x9a, 0x60, 0xd8, 0x21, 0xc2, 0xe0, 0x14, 0xd8, 0x07, 0x31, 0xf2, 0x9a, 0x6d, 0x53, 0xcc,
-		0x94, 0x42, 0xfe, 0xe4, 0x8e, 0x7b, 0x7d, 0xa0, 0x78, 0xae, 0x8d, 0x2e, 0x9e, 0x40, 0x00, 0xbe,
-		0x80, 0xbe, 0xff, 0xfe, 0x90, 0x59, 0xf6, 0x2d, 0x53, 0x58, 0x3c, 0x31, 0x56, 0x69, 0x88, 0xd3,
-		0x9f, 0x1d, 0x6e, 0xeb, 0x66, 0x87, 0x8d, 0xca, 0x00, 0xcb, 0x37, 0x2c, 0xdd, 0x53, 0x7a, 0x16,
-		0x0a, 0x9b, 0x9a, 0xe3, 0x35, 0xb0, 0x77, 0x03, 0x6b, 0x2d, 0xec, 0x44, 0xa3, 0x89, 0x82, 0x88,
-		0x26, 0x10, 0xa4, 0x68, 0xc8, 0xc0, 0x9c, 0x29, 0xfd, 0xbb, 0x64, 0x40, 0x8a, 0x9e, 0xbd, 0xf6,
-		0x23, 0x0d, 0x8e, 0xc1, 0x22, 0x0d, 0x32, 0x5c, 0x87, 0x1e, 0x76, 0x45, 0xc2, 0x90, 0x7e, 0xa0,
-		0xa7, 0x44, 0xbc, 0x90, 0x1c, 0x12, 0x2f, 0x70, 0x2b, 0xc4, 0xa3, 0x86, 0x0e, 0x8c, 0xf3, 0x89,
-		0xe0, 0x73, 0x22, 0x05, 0x9c, 0xa0, 0x75, 0x28, 0xda, 0x9a, 0xe3, 0xd1, 0xcb, 0x98, 0xfb, 0xb4,
-		0x1b, 0xdc, 0xd2, 0xcd, 0xc7, 0x18, 0xde, 0x48, 0x77, 0x79, 0x33, 0x05, 0x3b, 0x5c, 0x58, 0xfa,
-		0x42, 0x0a, 0xd2, 0x5c, 0x1c, 0x2f, 0xc2, 0x38, 0x17, 0x38, 0xb7, 0x4d, 0xe7, 0x16, 0x62, 0xd4,
-		0x7f, 0xc1, 0x57, 0x53, 0x4e, 0x50, 0x20, 0xa1, 0x87, 0x21, 0xa3, 0xef, 0x6b, 0x86, 0xa9, 0x1a,
-		0x2d, 0x1e, 0x93, 0xe6, 0x3e, 0xfb, 0xd6, 0xdc, 0xf8, 0x22, 0x29, 0xab, 0x2f, 0x29, 0xe3, 0xb4,
-		0xb2, 0xde, 0x22, 0x31, 0xce, 0x3e, 0x36, 0xf6, 0xf6, 0x3d, 0x6e, 0x60, 0xf9, 0x17, 0x7a, 0x06,
-		0x52, 0x64, 0xc8, 0xf8, 0x65, 0xfd, 0xd9, 0xbe, 0xc5, 0x86, 0x9f, 0x2f, 0xab, 0x66, 0x48, 0xc3,
-		0x1f, 0xf8, 0xbd, 0x39, 0x49, 0xa1, 0x18, 0x68, 0x09, 0x0a, 0x6d, 0xcd, 0xf5, 0x54, 0x3a, 0x4f,
-		0x48, 0xf3, 0x63, 0x9c, 0x44, 0xbf, 0x48, 0xb8, 0x6c, 0x39, 0xef, 0x39, 0x82, 0xc6, 0x8a, 0x5a,
-		0xe8, 0x22, 0xc8, 0x94, 0x8a, 0x4e, 0x4d, 0x15, 0x8b, 0x1b, 0xd3, 0x54, 0xf4, 0x13, 0xa4, 0x9c,
-		0x59, 0x30, 0x1a, 0x3d, 0x9e, 0x85, 0x2c, 0xbd, 0x1f, 0x4c, 0x41, 0xd8, 0xa1, 0xff, 0x0c, 0x29,
-		0xa0, 0x95, 0x17, 0xa0, 0x18, 0x78, 0x48, 0x06, 0x92, 0x61, 0x54, 0x82, 0x62, 0x0a, 0xf8, 0x04,
-		0x4c, 0x9b, 0xf8, 0x80, 0x5e, 0x43, 0x88, 0x40, 0x67, 0x29, 0x34, 0x22, 0x75, 0xdb, 0x51, 0x8c,
-		0x87, 0x60, 0x42, 0x17, 0xd2, 0x67, 0xb0, 0x40, 0x61, 0x0b, 0x7e, 0x29, 0x05, 0x3b, 0x03, 0x19,
-		0xcd, 0xb6, 0x19, 0x40, 0x8e, 0x3b, 0x48, 0xdb, 0xa6, 0x55, 0x97, 0x60, 0x92, 0xf6, 0xd1, 0xc1,
-		0x6e, 0xb7, 0xed, 0x71, 0x22, 0x79, 0x0a, 0x53, 0x24, 0x15, 0x0a, 0x2b, 0xa7, 0xb0, 0x0f, 0x40,
-		0x01, 0xdf, 0x36, 0x5a, 0xd8, 0xd4, 0x31, 0x83, 0x2b, 0x50, 0xb8, 0xbc, 0x28, 0xa4, 0x40, 0x8f,
-		0x80, 0xef, 0xf7, 0x54, 0xe1, 0x94, 0x27, 0x18, 0x3d, 0x51, 0x5e, 0x61, 0xc5, 0xa5, 0x19, 0x48,
-		0x2d, 0x69, 0x9e, 0x46, 0xec, 0x98, 0x77, 0xc0, 0x62, 0x8d, 0xbc, 0x42, 0xfe, 0x2c, 0xfd, 0x52,
-		0x12, 0x52, 0xdb, 0x96, 0x87, 0xd1, 0xb5, 0x50, 0x6c, 0x3b, 0x11, 0xab, 0xd2, 0x0d, 0x63, 0xcf,
-		0xc4, 0xad, 0x35, 0x77, 0x2f, 0xf4, 0x9a, 0x4f, 0xa0, 0x50, 0x89, 0x88, 0x42, 0x4d, 0xc3, 0x98,
-		0x63, 0x75, 0xcd, 0x96, 0x38, 0x2f, 0x4f, 0x3f, 0xd0, 0x32, 0x64, 0x7c, 0x3d, 0x49, 0x0d, 0xd5,
-		0x93, 0x22, 0xd1, 0x13, 0xa2, 0xc6, 0xbc, 0x40, 0x19, 0xdf, 0xe1, 0xea, 0x52, 0x85, 0xac, 0x6f,
-		0x61, 0x7c, 0x85, 0x1b, 0x45, 0x67, 0x03, 0x34, 0x12, 0x4e, 0xf8, 0xa3, 0xef, 0x8b, 0x8f, 0xe9,
-		0x9c, 0xec, 0x57, 0x70, 0xf9, 0x45, 0x14, 0x8b, 0x3f, 0x2d, 0x34, 0x4e, 0x3b, 0x16, 0x28, 0x16,
-		0x7b, 0x5e, 0xe8, 0x3e, 0xc8, 0xba, 0xc6, 0x9e, 0xa9, 0x79, 0x5d, 0x07, 0x73, 0xdd, 0x0b, 0x0a,
-		0x48, 0x6d, 0x70, 0x79, 0x84, 0xe9, 0x5a, 0xe8, 0xc5, 0xb3, 0xcb, 0x30, 0x15, 0xbc, 0x35, 0x16,
-		0x50, 0x61, 0x7a, 0x86, 0xfc, 0xaa, 0x86, 0xa8, 0x29, 0xfd, 0x2b, 0x09, 0xd2, 0xdc, 0xb9, 0x07,
-		0xe3, 0x20, 0xc5, 0x8f, 0x43, 0x62, 0xd0, 0x38, 0x24, 0xdf, 0xd6, 0x38, 0x80, 0xcf, 0xa7, 0xcb,
-		0x5f, 0x90, 0x89, 0x8b, 0x42, 0x19, 0x93, 0x0d, 0x63, 0x8f, 0xcf, 0xfd, 0x10, 0x56, 0xe9, 0x2d,
-		0x89, 0xb8, 0x5f, 0x5e, 0x8f, 0xaa, 0x50, 0x10, 0x9c, 0xa9, 0xbb, 0x6d, 0x6d, 0x8f, 0xab, 0xe3,
-		0xb9, 0xc1, 0xec, 0x91, 0x98, 0x45, 0xc9, 0x71, 0x8e, 0x68, 0xf4, 0x15, 0x3b, 0xb2, 0x89, 0x01,
-		0x23, 0x1b, 0x51, 0xa5, 0xe4, 0xbd, 0xa9, 0x52, 0x64, 0xd0, 0x53, 0x3d, 0x83, 0x5e, 0xfa, 0x9c,
-		0xc4, 0x1f, 0x3b, 0x6b, 0xb1, 0xcb, 0x2f, 0x7f, 0x6d, 0xa3, 0xf5, 0xb5, 0x5c, 0xbf, 0x5a, 0xb8,
-		0xa5, 0xf6, 0x0d, 0xdb, 0x83, 0x31, 0x24, 0xa3, 0x5c, 0x07, 0xc3, 0x87, 0x04, 0x99, 0x46, 0x30,
-		0x8c, 0x3f, 0x97, 0x80, 0xc9, 0x3e, 0xf8, 0xbf, 0x85, 0xc3, 0x19, 0x9d, 0xc3, 0x63, 0x23, 0xce,
-		0xe1, 0xf4, 0xc0, 0x39, 0xfc, 0x73, 0x09, 0x9a, 0x19, 0xb0, 0x2d, 0x57, 0x6b, 0x7f, 0x55, 0x6c,
-		0xf0, 0x59, 0xc8, 0xda, 0x56, 0x5b, 0x65, 0x35, 0xec, 0xe6, 0x52, 0xc6, 0xb6, 0xda, 0x4a, 0x9f,
-		0xaa, 0x8d, 0xbd, 0x53, 0x06, 0x3a, 0xfd, 0x0e, 0x0c, 0xc3, 0x78, 0xef, 0xac, 0xf2, 0x20, 0xcf,
-		0x64, 0xc1, 0x23, 0xa8, 0x2b, 0x44, 0x08, 0x34, 0x26, 0x93, 0x7a, 0x63, 0x3e, 0x9f, 0x6f, 0x06,
-		0xaa, 0x70, 0x40, 0x82, 0xc2, 0xe2, 0x8d, 0xfe, 0xb4, 0x52, 0x8f, 0xe5, 0x52, 0x38, 0x60, 0xe9,
-		0x83, 0x12, 0xc0, 0x2a, 0x11, 0x2e, 0xed, 0x31, 0x09, 0x7e, 0x5c, 0xca, 0x84, 0x1a, 0x69, 0x7b,
-		0x6e, 0xe0, 0xc0, 0x71, 0x0e, 0xf2, 0x6e, 0x98, 0xf5, 0x25, 0x28, 0x04, 0x0a, 0xee, 0x62, 0xc1,
-		0xce, 0xdc, 0x51, 0xcb, 0xf9, 0x06, 0xf6, 0x94, 0xfc, 0xed, 0xd0, 0x57, 0xe9, 0x5f, 0x4b, 0x90,
-		0xa5, 0x5c, 0xad, 0x61, 0x4f, 0x8b, 0x0c, 0xa4, 0xf4, 0x36, 0x06, 0xf2, 0x7e, 0x00, 0x46, 0xc7,
-		0x35, 0xde, 0xc0, 0x5c, 0xbf, 0xb2, 0xb4, 0xa4, 0x61, 0xbc, 0x81, 0xd1, 0xd3, 0xbe, 0xd4, 0x93,
-		0x43, 0xa4, 0x2e, 0xd6, 0xfb, 0x5c, 0xf6, 0xa7, 0x61, 0x9c, 0xbe, 0xcc, 0x79, 0xe0, 0xf2, 0x25,
-		0x7c, 0xda, 0xec, 0x76, 0x9a, 0x07, 0x6e, 0xe9, 0x16, 0x8c, 0x37, 0x0f, 0x58, 0xc6, 0xf1, 0x2c,
-		0x64, 0x1d, 0xcb, 0xe2, 0xd1, 0x20, 0x0b, 0xc4, 0x33, 0xa4, 0x80, 0x06, 0x3f, 0x22, 0xc9, 0x96,
-		0x08, 0x92, 0x6c, 0x41, 0x9a, 0x30, 0x39, 0x5a, 0x9a, 0x90, 0xac, 0xdb, 0x0b, 0x91, 0x19, 0x85,
-		0x1e, 0x83, 0xd3, 0x8d, 0xfa, 0xca, 0x7a, 0x6d, 0x49, 0x5d, 0x6b, 0xac, 0xf4, 0xbc, 0x4e, 0x30,
-		0x5b, 0x7c, 0xf3, 0xee, 0x7c, 0x8e, 0x2f, 0xd8, 0x07, 0x41, 0x6f, 0x2a, 0xb5, 0xed, 0x8d, 0x66,
-		0x4d, 0x96, 0x18, 0xf4, 0xa6, 0x83, 0x6f, 0x5b, 0x1e, 0x7b, 0xfb, 0xf6, 0x09, 0x38, 0x13, 0x03,
-		0xed, 0x2f, 0xdb, 0x27, 0xdf, 0xbc, 0x3b, 0x5f, 0xd8, 0x74, 0x30, 0x53, 0x35, 0x8a, 0xb1, 0x00,
-		0x33, 0xfd, 0x18, 0x1b, 0x9b, 0x1b, 0x8d, 0xca, 0xaa, 0x3c, 0x3f, 0x2b, 0xbf, 0x79, 0x77, 0x3e,
-		0x2f, 0x6c, 0x07, 0x81, 0x7f, 0xf7, 0xd7, 0xed, 0xa9, 0xfe, 0xf3, 0x0e, 0x77, 0x1c, 0xcd, 0xb6,
-		0xb1, 0xe3, 0x0e, 0xda, 0xd8, 0x7f, 0x00, 0x72, 0x4b, 0xa1, 0x7b, 0xbb, 0xfe, 0x09, 0x0f, 0x89,
-		0xde, 0xe9, 0x65, 0x1f, 0xa5, 0x12, 0xc0, 0x72, 0xdb, 0xd2, 0xbc, 0x18, 0x98, 0x44, 0x08, 0xa6,
-		0x6e, 0x7a, 0xd7, 0xaf, 0xc5, 0xc0, 0x24, 0x05, 0xcc, 0x03, 0x90, 0xdb, 0x1a, 0x04, 0x94, 0x8a,
-		0x12, 0x7a, 0xf2, 0x6a, 0x0c, 0xcc, 0x58, 0x0f, 0xa1, 0x58, 0xa0, 0x82, 0x00, 0x3a, 0x0f, 0xd9,
-		0xaa, 0x65, 0xb5, 0x63, 0x40, 0x32, 0x21, 0x3a, 0x8d, 0xd0, 0x95, 0xe4, 0x08, 0x50, 0x36, 0xc4,
-		0x50, 0x95, 0xac, 0x5b, 0x63, 0x60, 0xfc, 0x33, 0x30, 0xc7, 0x3e, 0xfa, 0xf1, 0x5e, 0x3e, 0x2e,
-		0xc7, 0x3d, 0xfa, 0x21, 0xc6, 0xf3, 0xde, 0x8e, 0x7e, 0xe4, 0x43, 0x5b, 0x0f, 0x7e, 0x96, 0xc1,
-		0xd6, 0x1c, 0xad, 0xe3, 0x1e, 0x37, 0x9d, 0x3a, 0xe4, 0x64, 0xcd, 0xec, 0x10, 0x4d, 0x24, 0x2b,
-		0x9b, 0xa2, 0xbf, 0x60, 0xde, 0xa4, 0x2c, 0xa0, 0x6b, 0xe1, 0xec, 0x4e, 0x6e, 0x70, 0x1c, 0xc2,
-		0xc0, 0x45, 0xf6, 0xe7, 0x05, 0xc8, 0x88, 0x85, 0x17, 0xb7, 0xcd, 0xe7, 0xe3, 0xa2, 0x25, 0x0e,
-		0xc2, 0x71, 0x7d, 0x14, 0xf4, 0x35, 0x90, 0xf5, 0x2d, 0x35, 0x37, 0x4d, 0xa5, 0xa3, 0x6c, 0x3b,
-		0x27, 0x10, 0x20, 0xa1, 0x72, 0x90, 0x1e, 0x48, 0x0d, 0xcc, 0x38, 0x6c, 0x33, 0x08, 0x8e, 0xed,
-		0xa7, 0x06, 0x9e, 0x82, 0x94, 0xb6, 0xa3, 0x1b, 0xdc, 0x9d, 0xdf, 0x1f, 0x83, 0x58, 0xa9, 0x2e,
-		0xd6, 0x19, 0x16, 0x7d, 0x90, 0x83, 0x82, 0x13, 0xa6, 0xdd, 0x43, 0x53, 0xdf, 0x77, 0x2c, 0xf3,
-		0x90, 0x7b, 0xf0, 0x38, 0xa6, 0x1b, 0x02, 0x46, 0x30, 0xed, 0x23, 0x11, 0xa6, 0x77, 0x71, 0xe0,
-		0xbd, 0xe3, 0x99, 0x5e, 0x66, 0x10, 0x82, 0x69, 0x8e, 0x50, 0xaa, 0xf3, 0x7c, 0x2a, 0x1f, 0x36,
-		0xfa, 0xac, 0xd4, 0x81, 0xca, 0x32, 0x3d, 0x6c, 0xc2, 0x67, 0x3a, 0xda, 0x01, 0x9d, 0x34, 0xc4,
-		0x95, 0x90, 0xca, 0x3d, 0xfe, 0x70, 0x49, 0x52, 0x49, 0x77, 0xb4, 0x83, 0x15, 0xcd, 0xbd, 0x99,
-		0xca, 0x24, 0xe5, 0x54, 0xe9, 0x93, 0x24, 0xfc, 0x8e, 0x0c, 0x0d, 0x7a, 0x14, 0x10, 0xc1, 0xd0,
-		0xf6, 0xb0, 0x4a, 0x9c, 0x10, 0x1d, 0x64, 0x41, 0xb7, 0xd8, 0xd1, 0x0e, 0x2a, 0x7b, 0x78, 0xbd,
-		0xdb, 0xa1, 0x0c, 0xb8, 0x68, 0x0d, 0x64, 0x01, 0x2c, 0x14, 0xd0, 0x8f, 0x17, 0xfa, 0x1e, 0x4a,
-		0xe6, 0x00, 0x2c, 0xa0, 0xf9, 0x20, 0x09, 0x68, 0x26, 0x18, 0x3d, 0xff, 0xc8, 0x57, 0xa4, 0x2b,
-		0xc9, 0x68, 0x57, 0x4a, 0x2f, 0x41, 0xb1, 0x47, 0x0b, 0x50, 0x09, 0x0a, 0x3c, 0x6b, 0x4d, 0x8f,
-		0xd3, 0xb0, 0xb5, 0x7b, 0x56, 0xc9, 0xb1, 0xe4, 0x34, 0x9d, 0x7d, 0xe5, 0xcc, 0x2f, 0x7e, 0x6c,
-		0x4e, 0xa2, 0x5b, 0x97, 0x8f, 0x42, 0x21, 0xa2, 0x06, 0x22, 0x71, 0x29, 0x05, 0x89, 0xcb, 0x00,
-		0xf8, 0x35, 0xc8, 0x13, 0x57, 0x8a, 0x5b, 0x1c, 0xf6, 0x61, 0x28, 0x32, 0x5f, 0xdf, 0x2b, 0x6b,
-		0x16, 0xc3, 0xaf, 0x09, 0x81, 0x97, 0x44, 0x50, 0x1f, 0x15, 0x7b, 0x4e, 0x40, 0xad, 0x68, 0x6e,
-		0xe9, 0x07, 0x24, 0x28, 0xf6, 0xe8, 0x06, 0x7a, 0x01, 0xb2, 0xb6, 0x83, 0x75, 0x23, 0x94, 0xe6,
-		0x3a, 0x42, 0x84, 0x29, 0x2a, 0xbe, 0x00, 0x83, 0x84, 0x49, 0xe2, 0x9c, 0x40, 0x0b, 0xb7, 0xb5,
-		0xc3, 0xe1, 0xa3, 0xc0, 0x48, 0x88, 0x57, 0xeb, 0x97, 0x08, 0x52, 0xe9, 0x57, 0x25, 0x28, 0x44,
-		0x94, 0x0e, 0xb5, 0xe0, 0x7e, 0xe2, 0xa2, 0xc3, 0x67, 0xd3, 0xf9, 0xfb, 0x7b, 0xa1, 0x35, 0x5a,
-		0xee, 0xea, 0xd9, 0xbe, 0x76, 0x02, 0x47, 0x43, 0x83, 0x1b, 0x49, 0x99, 0x25, 0x74, 0x82, 0x23,
-		0xea, 0xec, 0xa1, 0xbe, 0x1b, 0x2c, 0x18, 0xdf, 0x00, 0x64, 0xef, 0x78, 0xbd, 0xa4, 0x13, 0xa3,
-		0x92, 0x96, 0x09, 0x72, 0x98, 0x60, 0xa9, 0x01, 0x10, 0x4c, 0x5c, 0x54, 0x19, 0xa5, 0x13, 0xc9,
-		0xa3, 0x38, 0x2c, 0x27, 0x66, 0xa4, 0xea, 0xe6, 0x27, 0x3e, 0x7b, 0x4e, 0x7a, 0x57, 0x42, 0x87,
-		0xdf, 0x6d, 0xc0, 0x7d, 0x01, 0xe8, 0x8e, 0x6e, 0xf4, 0x26, 0xb4, 0x65, 0xdf, 0x38, 0x90, 0x5a,
-		0xe2, 0x16, 0x8e, 0xde, 0x4f, 0x1b, 0x9a, 0xee, 0x1e, 0xe2, 0x88, 0x46, 0x49, 0x87, 0xdf, 0x63,
-		0xb6, 0xfb, 0x3f, 0x64, 0x61, 0x5c, 0xc1, 0xef, 0xeb, 0x62, 0xd7, 0x43, 0x4f, 0x42, 0x0a, 0xeb,
-		0xfb, 0x56, 0xff, 0x96, 0x13, 0xef, 0xe5, 0x42, 0x4d, 0xdf, 0xb7, 0x38, 0xf0, 0x8d, 0x13, 0x0a,
-		0x05, 0x46, 0xd7, 0x61, 0x6c, 0xb7, 0xdd, 0xe5, 0x89, 0xf0, 0x88, 0x9b, 0x12, 0x58, 0xcb, 0xa4,
-		0x3a, 0x40, 0x63, 0xe0, 0xa4, 0x31, 0xfa, 0x73, 0x02, 0xc9, 0x41, 0x8d, 0xd1, 0x5f, 0x11, 0x08,
-		0x1a, 0x23, 0xc0, 0x68, 0x11, 0xc0, 0x30, 0x0d, 0x4f, 0xa5, 0x39, 0x62, 0xee, 0x26, 0x4a, 0x71,
-		0xa8, 0x86, 0x47, 0xf3, 0xc9, 0x01, 0x7e, 0xd6, 0x10, 0x65, 0x84, 0xe3, 0xf7, 0x75, 0xb1, 0x23,
-		0x5c, 0x45, 0x0c, 0xc7, 0xef, 0x21, 0xd5, 0x21, 0x8e, 0x29, 0x38, 0x71, 0xad, 0xec, 0x71, 0x52,
-		0xef, 0x80, 0x3f, 0xb9, 0x3d, 0xdf, 0x8f, 0x4a, 0xdf, 0x26, 0x6d, 0x1e, 0x04, 0xc8, 0xe3, 0x3a,
-		0x2b, 0x41, 0xcf, 0xfa, 0x4b, 0xb8, 0x5c, 0xef, 0x9a, 0xc9, 0x47, 0x66, 0x2b, 0x38, 0x1f, 0x97,
-		0x23, 0xa0, 0x0d, 0x98, 0x68, 0x1b, 0xae, 0xa7, 0xba, 0xa6, 0x66, 0xbb, 0xfb, 0x96, 0xe7, 0xd2,
-		0x5c, 0x6c, 0xee, 0xea, 0xc3, 0xfd, 0x24, 0x56, 0x0d, 0xd7, 0x6b, 0x08, 0xb0, 0x80, 0x52, 0xa1,
-		0x1d, 0x2e, 0x27, 0x04, 0xad, 0xdd, 0x5d, 0xec, 0xf8, 0x14, 0x69, 0xd2, 0x36, 0x96, 0xe0, 0x06,
-		0x81, 0x13, 0x98, 0x21, 0x82, 0x56, 0xb8, 0x1c, 0x7d, 0x1d, 0x4c, 0xb5, 0x2d, 0xad, 0xe5, 0xd3,
-		0x53, 0xf5, 0xfd, 0xae, 0x79, 0x8b, 0xa6, 0x78, 0x73, 0x57, 0x2f, 0xc5, 0xb0, 0x69, 0x69, 0x2d,
-		0x81, 0xbc, 0x48, 0x40, 0x03, 0xca, 0x93, 0xed, 0xde, 0x3a, 0xa4, 0xc2, 0xb4, 0x66, 0xdb, 0xed,
-		0xc3, 0x5e, 0xf2, 0x45, 0x4a, 0xfe, 0xd1, 0x7e, 0xf2, 0x15, 0x02, 0x3d, 0x80, 0x3e, 0xd2, 0xfa,
-		0x2a, 0xd1, 0x16, 0xc8, 0xb6, 0x83, 0xe9, 0xbd, 0x55, 0x9b, 0x2f, 0x52, 0xe8, 0xab, 0x7e, 0xb9,
-		0xab, 0x17, 0xfb, 0x89, 0x6f, 0x32, 0x48, 0xb1, 0x9a, 0x09, 0x28, 0x17, 0xed, 0x68, 0x0d, 0x23,
-		0x6b, 0xe9, 0x98, 0xbe, 0x3a, 0xca, 0xc9, 0x4e, 0x0e, 0x26, 0x4b, 0x21, 0x63, 0xc9, 0x46, 0x6a,
-		0xd0, 0x32, 0xe4, 0x58, 0x56, 0x4b, 0x25, 0x26, 0x92, 0xbe, 0x06, 0x98, 0xbb, 0xfa, 0x40, 0xcc,
-		0x74, 0xa5, 0x40, 0xdb, 0x96, 0x87, 0x03, 0x62, 0x80, 0xfd, 0x42, 0xb4, 0x03, 0x27, 0xe9, 0xcb,
-		0x88, 0x87, 0x6a, 0xd4, 0x1e, 0xcf, 0x4c, 0x51, 0x8a, 0x8f, 0xf5, 0x53, 0xdc, 0xa6, 0xe0, 0xdb,
-		0x61, 0xc3, 0x1c, 0x90, 0x9e, 0xba, 0xdd, 0x5f, 0x4b, 0x34, 0x6d, 0xd7, 0x30, 0xb5, 0xb6, 0xf1,
-		0x06, 0x66, 0xc1, 0x0b, 0x7d, 0x14, 0x38, 0x56, 0xd3, 0x96, 0x39, 0x1c, 0x0d, 0x66, 0x42, 0x9a,
-		0xb6, 0x1b, 0x2e, 0xaf, 0x8e, 0xf3, 0x25, 0x87, 0xff, 0xca, 0xe5, 0xb8, 0x9c, 0x61, 0x2f, 0x5b,
-		0xde, 0x4c, 0x65, 0x40, 0xce, 0x95, 0x2e, 0x40, 0x2e, 0x64, 0xa7, 0xd0, 0x0c, 0x8c, 0x73, 0xa7,
-		0x2a, 0x0e, 0xf0, 0xf3, 0xcf, 0xd2, 0x04, 0xe4, 0xc3, 0xa6, 0xa9, 0xf4, 0x01, 0x09, 0x72, 0x21,
-		0xa3, 0x43, 0x30, 0xc3, 0x1b, 0x5d, 0xd9, 0x20, 0x4e, 0x7d, 0x40, 0x44, 0x15, 0xa2, 0x9e, 0x6d,
-		0xb6, 0xe6, 0x69, 0x21, 0x0f, 0x6a, 0xd0, 0x1c, 0xe4, 0xec, 0xab, 0xb6, 0x0f, 0x92, 0xa4, 0x20,
-		0x60, 0x5f, 0xb5, 0x05, 0xc0, 0x79, 0xc8, 0x93, 0xae, 0xab, 0xe1, 0x70, 0x39, 0xab, 0xe4, 0x48,
-		0x19, 0x07, 0x29, 0xfd, 0x66, 0x02, 0xe4, 0x5e, 0x63, 0xe6, 0x6f, 0x80, 0x49, 0xc7, 0xde, 0x00,
-		0x3b, 0xd3, 0xbb, 0xf5, 0x16, 0xec, 0xb6, 0xad, 0x81, 0x1c, 0xec, 0x19, 0x31, 0xdf, 0x73, 0x44,
-		0xfc, 0xdf, 0xb3, 0x56, 0x51, 0x8a, 0x7a, 0xcf, 0xe2, 0x65, 0x25, 0x72, 0x5e, 0x24, 0xe5, 0x1f,
-		0x71, 0xed, 0xd5, 0x27, 0x01, 0xb3, 0x65, 0xb7, 0x34, 0x0f, 0x8b, 0x94, 0x7b, 0xe8, 0xe8, 0xc8,
-		0xc3, 0x50, 0xd4, 0x6c, 0x5b, 0x75, 0x3d, 0xcd, 0xc3, 0x3c, 0xd0, 0x63, 0x89, 0xcc, 0x82, 0x66,
-		0xdb, 0x0d, 0x52, 0xca, 0x02, 0xbd, 0x87, 0x60, 0x82, 0x58, 0x78, 0x43, 0x6b, 0x8b, 0x28, 0x22,
-		0xcd, 0xe2, 0x41, 0x5e, 0xca, 0x23, 0x91, 0x16, 0xe4, 0xc3, 0xc6, 0xdd, 0x4f, 0xcd, 0x48, 0xa1,
-		0xd4, 0x0c, 0xe2, 0x0f, 0x2f, 0x31, 0x09, 0x89, 0xc7, 0xaa, 0xe2, 0x37, 0x23, 0xa7, 0x69, 0x1a,
-		0xe7, 0x36, 0xcb, 0xbd, 0x66, 0x14, 0xf6, 0x51, 0x7a, 0x15, 0x26, 0xa2, 0x7e, 0x00, 0x4d, 0x40,
-		0xc2, 0x3b, 0xe0, 0xad, 0x24, 0xbc, 0x03, 0x74, 0x85, 0x67, 0x4f, 0x93, 0x34, 0x7b, 0x7a, 0xff,
-		0x40, 0x3f, 0x12, 0xa4, 0x4e, 0x6f, 0xa6, 0x32, 0x09, 0x39, 0x59, 0x2a, 0x42, 0x21, 0xe2, 0x25,
-		0x4a, 0xa7, 0x60, 0x3a, 0xce, 0xe6, 0x97, 0x0c, 0x98, 0x8e, 0x33, 0xdd, 0xe8, 0x3a, 0x64, 0x7c,
-		0xa3, 0xdf, 0x97, 0x6d, 0x13, 0xad, 0xfb, 0x48, 0x3e, 0x6c, 0x64, 0xb7, 0x30, 0x11, 0xd9, 0x2d,
-		0x2c, 0x7d, 0x23, 0xcc, 0x0c, 0xb2, 0xe7, 0x3d, 0xdb, 0x07, 0x29, 0x5f, 0x70, 0xa7, 0x20, 0xcd,
-		0xdf, 0x07, 0x4e, 0xd0, 0x34, 0x05, 0xff, 0x22, 0x02, 0x65, 0xb6, 0x3d, 0xc9, 0xb2, 0x17, 0xf4,
-		0xa3, 0xa4, 0xc2, 0x99, 0x81, 0x26, 0x7d, 0xf0, 0x6e, 0x3b, 0x23, 0xc4, 0x77, 0xdb, 0xe9, 0x07,
-		0xfd, 0x15, 0x22, 0x6c, 0x8a, 0x24, 0x60, 0x56, 0xe1, 0x5f, 0xa5, 0x0f, 0x25, 0xe1, 0x54, 0xbc,
-		0x5d, 0x47, 0xf3, 0x90, 0x27, 0x8b, 0x07, 0x2f, 0xba, 0xce, 0x80, 0x8e, 0x76, 0xd0, 0xe4, 0x8b,
-		0x0c, 0xbe, 0x53, 0x99, 0xf0, 0x77, 0x2a, 0xd1, 0x36, 0x4c, 0xb6, 0x2d, 0x5d, 0x6b, 0xab, 0xa1,
-		0x9d, 0x62, 0x3e, 0x9d, 0x1e, 0x1c, 0x64, 0xa7, 0xc5, 0x5e, 0x04, 0x31, 0x41, 0x7c, 0x22, 0x14,
-		0x29, 0x91, 0x55, 0x7f, 0x57, 0x19, 0xd5, 0x20, 0xd7, 0x31, 0xdc, 0x1d, 0xbc, 0xaf, 0xdd, 0x36,
-		0x2c, 0x87, 0xcf, 0xab, 0x18, 0xed, 0x59, 0x0b, 0x80, 0xc4, 0x16, 0x76, 0x08, 0x2f, 0x34, 0x28,
-		0x63, 0xb1, 0x5b, 0xeb, 0xe9, 0x63, 0x5b, 0x96, 0x41, 0x9b, 0xd4, 0xe3, 0x03, 0x37, 0xa9, 0xe3,
-		0x76, 0x84, 0x33, 0xf1, 0x3b, 0xc2, 0x6f, 0xd2, 0xc1, 0x89, 0xf3, 0x8e, 0xfd, 0x9b, 0xc4, 0xa8,
-		0x09, 0xd3, 0x1c, 0xbf, 0x15, 0x91, 0x7e, 0xdf, 0xb9, 0xb3, 0x68, 0xd0, 0x15, 0x92, 0x3a, 0x12,
-		0xf8, 0x83, 0x05, 0x9f, 0xbc, 0x47, 0xc1, 0x8b, 0xa3, 0x1a, 0xa9, 0xd0, 0x51, 0x8d, 0xff, 0xcd,
-		0x06, 0xe3, 0x5b, 0x93, 0x62, 0xf3, 0x2c, 0x14, 0x58, 0xc4, 0x9e, 0x41, 0x19, 0xb4, 0xd7, 0x23,
-		0x3a, 0x96, 0x3c, 0x76, 0xc7, 0xf8, 0x68, 0xa7, 0x86, 0x8f, 0xf6, 0xd8, 0x3b, 0x39, 0xda, 0xe9,
-		0x7b, 0x1c, 0xed, 0x77, 0x75, 0x1c, 0x3e, 0x22, 0xc1, 0xec, 0xe0, 0x70, 0x2c, 0x76, 0x40, 0x8e,
-		0xb5, 0x3b, 0x39, 0xc8, 0xe3, 0x3d, 0x04, 0x13, 0x3d, 0xd1, 0x22, 0x53, 0xe6, 0x42, 0x64, 0xb9,
-		0x5e, 0xfa, 0xb6, 0x24, 0x4c, 0xc7, 0x05, 0x74, 0x31, 0x33, 0x56, 0x81, 0xa9, 0x16, 0xd6, 0x8d,
-		0xd6, 0x3d, 0x4f, 0xd8, 0x49, 0x8e, 0xfe, 0x7f, 0xe6, 0x6b, 0x8c, 0x9e, 0xfc, 0x38, 0x40, 0x46,
-		0xc1, 0xae, 0x4d, 0x02, 0x34, 0xf6, 0x6b, 0x77, 0x3a, 0xb6, 0xbd, 0x20, 0xad, 0x15, 0xbb, 0x6e,
-		0xe0, 0x20, 0x02, 0x8f, 0xac, 0x9f, 0x7d, 0x3c, 0x74, 0x8d, 0xa7, 0x09, 0x06, 0x2e, 0xf8, 0x59,
-		0xf8, 0xed, 0xa3, 0xb2, 0x3c, 0xc1, 0xd3, 0x22, 0x4f, 0x90, 0x1c, 0xb4, 0xfa, 0xe5, 0xc1, 0xb8,
-		0x8f, 0xc7, 0x13, 0x05, 0xd7, 0x78, 0xa2, 0x20, 0x35, 0xa8, 0x39, 0x16, 0xb3, 0x07, 0xcd, 0x19,
-		0xec, 0x21, 0xd3, 0x70, 0xa6, 0x20, 0x3d, 0xa8, 0xab, 0xa1, 0xe0, 0x3a, 0xe8, 0x6a, 0x90, 0x2a,
-		0x78, 0x5a, 0xa4, 0x0a, 0xc6, 0x07, 0x31, 0xcd, 0xa3, 0xc9, 0x80, 0x69, 0x96, 0x2b, 0x78, 0x31,
-		0x94, 0x2b, 0xc8, 0xf6, 0xa6, 0xe1, 0xfb, 0x72, 0x05, 0x3e, 0xb6, 0x9f, 0x2c, 0x28, 0xfb, 0xc9,
-		0x82, 0xfc, 0xc0, 0x4c, 0x03, 0x0f, 0x03, 0x7d, 0x64, 0x91, 0x2d, 0xd8, 0xec, 0xcb, 0x16, 0xb0,
-		0xc5, 0xfd, 0x85, 0xa1, 0xd9, 0x02, 0x9f, 0x54, 0x4f, 0xba, 0x60, 0xb3, 0x2f, 0x5d, 0x30, 0x31,
-		0x88, 0x62, 0x4f, 0xcc, 0x19, 0x50, 0x8c, 0xe6, 0x0b, 0xbe, 0x3e, 0x3e, 0x5f, 0x30, 0x70, 0x41,
-		0x1f, 0x13, 0x5f, 0xfa, 0xa4, 0x63, 0x12, 0x06, 0xdf, 0x38, 0x20, 0x61, 0x20, 0x0f, 0x5a, 0xd8,
-		0xc6, 0x45, 0x97, 0x7e, 0x03, 0x71, 0x19, 0x83, 0xed, 0x98, 0x8c, 0x01, 0x5b, 0xda, 0x3f, 0x32,
-		0x42, 0xc6, 0xc0, 0x27, 0xdd, 0x97, 0x32, 0xd8, 0x8e, 0x49, 0x19, 0xa0, 0xc1, 0x74, 0x7b, 0x82,
-		0xa2, 0x30, 0xdd, 0x68, 0xce, 0x60, 0x25, 0x9a, 0x33, 0x98, 0x3a, 0x3a, 0x16, 0x65, 0xae, 0xdd,
-		0xa7, 0x16, 0x4e, 0x1a, 0xe8, 0x83, 0x92, 0x06, 0x6c, 0x5d, 0xff, 0xf8, 0x88, 0x49, 0x03, 0x9f,
-		0x76, 0x6c, 0xd6, 0x60, 0xb3, 0x2f, 0x6b, 0x70, 0x72, 0x90, 0xc2, 0xf5, 0x38, 0x99, 0x40, 0xe1,
-		0x06, 0xa6, 0x0d, 0xd8, 0xcf, 0x62, 0xb0, 0x1f, 0xc4, 0x00, 0x39, 0x77, 0x33, 0x95, 0xc9, 0xc9,
-		0xf9, 0xd2, 0x23, 0x24, 0xac, 0xe9, 0xb1, 0x7b, 0x64, 0x11, 0x81, 0x1d, 0xc7, 0x72, 0xc4, 0x1e,
-		0x28, 0xfd, 0x28, 0x5d, 0x84, 0x7c, 0xd8, 0xc4, 0x1d, 0x91, 0x62, 0x28, 0x42, 0x21, 0x62, 0xd5,
-		0x4a, 0xbf, 0x28, 0x41, 0x3e, 0x6c, 0xaf, 0x22, 0x0b, 0xd0, 0x2c, 0x5f, 0x80, 0x86, 0x12, 0x0f,
-		0x89, 0x68, 0xe2, 0x61, 0x0e, 0x72, 0x64, 0x11, 0xd6, 0x93, 0x53, 0xd0, 0x6c, 0x3f, 0xa7, 0x20,
-		0x0e, 0x6e, 0xb2, 0xf4, 0x04, 0xf7, 0x53, 0xec, 0xd4, 0x42, 0xd1, 0x3f, 0xc4, 0xca, 0xd3, 0xfc,
-		0x8f, 0xc3, 0x54, 0x08, 0xd6, 0x5f, 0xdc, 0xb1, 0xe5, 0xb5, 0xec, 0x43, 0x57, 0xf8, 0x2a, 0xef,
-		0x57, 0x25, 0x98, 0xec, 0x33, 0x97, 0xb1, 0x79, 0x03, 0xe9, 0x9d, 0xca, 0x1b, 0x24, 0xee, 0x3d,
-		0x6f, 0x10, 0x5e, 0xae, 0x26, 0xa3, 0xcb, 0xd5, 0xbf, 0x92, 0xa0, 0x10, 0x31, 0xdb, 0x64, 0x10,
-		0x74, 0xab, 0x25, 0x76, 0xcc, 0xe9, 0xdf, 0x24, 0x4e, 0x69, 0x5b, 0x7b, 0x7c, 0x99, 0x48, 0xfe,
-		0x24, 0x50, 0xbe, 0x23, 0xca, 0x72, 0x37, 0xe3, 0xaf, 0x3d, 0xc7, 0xc2, 0x77, 0xca, 0xf8, 0x3d,
-		0xab, 0x74, 0x70, 0xcf, 0xca, 0xdf, 0x28, 0x1f, 0x0f, 0x6d, 0x94, 0xa3, 0x67, 0x21, 0x4b, 0x77,
-		0x01, 0x54, 0xcb, 0x0e, 0x7e, 0x98, 0x77, 0xf0, 0x1d, 0x2b, 0x97, 0x5e, 0x12, 0x60, 0x17, 0xb3,
-		0x82, 0x28, 0x24, 0x1b, 0x89, 0x42, 0xee, 0x83, 0x2c, 0x61, 0x9f, 0xfd, 0x1c, 0x11, 0xf0, 0xa7,
-		0x46, 0x44, 0x41, 0xe9, 0xa7, 0x12, 0x50, 0xec, 0xf1, 0x3a, 0xb1, 0x9d, 0x8f, 0x3b, 0xb1, 0x32,
-		0x9a, 0x40, 0xce, 0x01, 0xec, 0x69, 0xae, 0x7a, 0x47, 0x33, 0x3d, 0xdc, 0xe2, 0x52, 0x09, 0x95,
-		0xa0, 0x59, 0xc8, 0x90, 0xaf, 0xae, 0x8b, 0x5b, 0x3c, 0x43, 0xe3, 0x7f, 0xa3, 0x3a, 0xa4, 0xf1,
-		0x6d, 0xfa, 0x1c, 0x37, 0x7b, 0xd4, 0xfe, 0x74, 0x8c, 0x79, 0x22, 0xf5, 0xd5, 0x19, 0x32, 0xdc,
-		0x7f, 0xf4, 0xd6, 0x9c, 0xcc, 0xc0, 0x1f, 0xf3, 0x2f, 0xb0, 0x2a, 0x9c, 0x40, 0x54, 0x0c, 0x99,
-		0x1e, 0x31, 0xd0, 0x74, 0x61, 0x5e, 0xac, 0xfd, 0x89, 0x50, 0xd9, 0x4d, 0x1c, 0xa5, 0xd0, 0xc1,
-		0x1d, 0xdb, 0xb2, 0xda, 0x2a, 0x9b, 0xe7, 0x15, 0x98, 0x88, 0x3a, 0x59, 0xf6, 0x5b, 0x81, 0x9e,
-		0x66, 0x98, 0x6a, 0x24, 0x36, 0xce, 0xb3, 0x42, 0x36, 0xaf, 0x6e, 0xa6, 0x32, 0x92, 0x9c, 0xe0,
-		0xe9, 0x9a, 0xf7, 0xc0, 0xc9, 0x58, 0x1f, 0x8b, 0x9e, 0x81, 0x6c, 0xe0, 0x9f, 0xd9, 0x7d, 0xaa,
-		0xa3, 0xf2, 0x30, 0x01, 0x70, 0x69, 0x1b, 0x4e, 0xc6, 0x3a, 0x59, 0xf4, 0x02, 0xa4, 0xd9, 0x79,
-		0x6d, 0x7e, 0x26, 0xef, 0xa1, 0xe1, 0xde, 0xb9, 0xdb, 0xf6, 0x14, 0x8e, 0x54, 0xba, 0x02, 0x67,
-		0x06, 0x7a, 0xd9, 0x20, 0x9b, 0x22, 0x85, 0xb2, 0x29, 0xa5, 0x9f, 0x96, 0x60, 0x76, 0xb0, 0xe7,
-		0x44, 0xd5, 0x1e, 0x86, 0x2e, 0x8d, 0xe8, 0x77, 0x43, 0x5c, 0x91, 0xe5, 0x86, 0x83, 0x77, 0xb1,
-		0xa7, 0xef, 0x33, 0x17, 0xce, 0x8c, 0x42, 0x41, 0x29, 0xf0, 0x52, 0x8a, 0xe3, 0x32, 0xb0, 0xd7,
-		0xb1, 0xee, 0xa9, 0x6c, 0x50, 0x5d, 0xfe, 0x53, 0xe3, 0x05, 0x56, 0xda, 0x60, 0x85, 0xa5, 0x47,
-		0xe1, 0xf4, 0x00, 0x5f, 0x1c, 0x73, 0xdc, 0xfc, 0x35, 0x02, 0x1c, 0xeb, 0x60, 0xd1, 0x4b, 0x90,
-		0x76, 0x3d, 0xcd, 0xeb, 0xba, 0xbc, 0x67, 0x17, 0x86, 0xfa, 0xe6, 0x06, 0x05, 0x57, 0x38, 0x5a,
-		0xe9, 0x39, 0x40, 0xfd, 0x9e, 0x36, 0x66, 0x6d, 0x25, 0xc5, 0xad, 0xad, 0x76, 0xe0, 0xec, 0x11,
-		0x3e, 0x15, 0x2d, 0xf6, 0x30, 0xf7, 0xe8, 0x48, 0x2e, 0xb9, 0x87, 0xc1, 0x3f, 0x4d, 0xc0, 0xc9,
-		0x58, 0xd7, 0x1a, 0x9a, 0xa5, 0xd2, 0xdb, 0x9d, 0xa5, 0x2f, 0x00, 0x78, 0x07, 0xe2, 0x92, 0x01,
-		0xb7, 0xf6, 0x71, 0xeb, 0x89, 0x03, 0xac, 0x53, 0x83, 0x45, 0x14, 0x23, 0xeb, 0xf1, 0xbf, 0xc8,
-		0xe2, 0x3f, 0xb4, 0x9e, 0xed, 0x52, 0x4f, 0xe0, 0xf2, 0xa5, 0xde, 0xc8, 0x3e, 0x23, 0x58, 0xf8,
-		0xb2, 0x62, 0x17, 0xbd, 0x06, 0xa7, 0x7b, 0x3c, 0x9a, 0x4f, 0x3b, 0x35, 0xb2, 0x63, 0x3b, 0x19,
-		0x75, 0x6c, 0x82, 0x76, 0xd8, 0x2b, 0x8d, 0x45, 0xbd, 0xd2, 0x6b, 0x00, 0xc1, 0xc2, 0x36, 0x38,
-		0x0f, 0x2b, 0x85, 0xcf, 0xc3, 0x5e, 0x87, 0x31, 0xa2, 0x09, 0x42, 0x54, 0x31, 0x06, 0x83, 0x0c,
-		0x69, 0x68, 0x65, 0xcc, 0xc0, 0x4b, 0xaf, 0x0b, 0x6d, 0x0b, 0xe7, 0x18, 0x07, 0xb4, 0xf1, 0x62,
-		0xb4, 0x8d, 0xd2, 0xe0, 0x74, 0x65, 0x7c, 0x5b, 0xff, 0x17, 0x8c, 0xd1, 0xe1, 0x8f, 0xbd, 0x80,
-		0xfc, 0x0d, 0x00, 0x9a, 0xe7, 0x39, 0xc6, 0x4e, 0x37, 0x68, 0x61, 0x7e, 0x80, 0xfe, 0x54, 0x04,
-		0x60, 0xf5, 0x3e, 0xae, 0x48, 0xd3, 0x01, 0x6e, 0x48, 0x99, 0x42, 0x14, 0x4b, 0xeb, 0x30, 0x11,
-		0xc5, 0x8d, 0xbf, 0x51, 0x1d, 0xbc, 0xdb, 0x24, 0xce, 0xb5, 0x05, 0x8e, 0x9c, 0xbf, 0xa5, 0x46,
-		0x3f, 0x4a, 0xdf, 0x94, 0x80, 0x7c, 0x58, 0xfb, 0xfe, 0x0e, 0x3a, 0xcb, 0xd2, 0xb7, 0x49, 0x90,
-		0xf1, 0xfb, 0x7f, 0xc4, 0x6d, 0x80, 0xe0, 0x6e, 0xbd, 0x9f, 0x83, 0x67, 0xbb, 0x1e, 0x49, 0x7f,
-		0xd7, 0xe3, 0x79, 0xdf, 0x21, 0x0c, 0x5c, 0xcc, 0x87, 0xa5, 0x2d, 0xce, 0xe1, 0x72, 0x07, 0xf5,
-		0xdc, 0x68, 0x97, 0x7b, 0xa7, 0x61, 0x2c, 0x7c, 0x2f, 0x97, 0x7d, 0x94, 0x70, 0xe8, 0xb8, 0x12,
-		0x9b, 0x8d, 0xe1, 0x5b, 0xc0, 0xd2, 0xf1, 0x6f, 0x01, 0xfb, 0xcd, 0x24, 0xc2, 0xcd, 0xfc, 0x7d,
-		0x09, 0x32, 0x62, 0x5e, 0xa0, 0x97, 0xc2, 0x87, 0xe9, 0xc4, 0xc9, 0x9c, 0xc1, 0x76, 0x89, 0x37,
-		0x10, 0x3a, 0x4b, 0xd7, 0x77, 0x25, 0x21, 0x79, 0xec, 0x2b, 0x09, 0x3c, 0x0e, 0xf9, 0xb2, 0x04,
-		0x72, 0xef, 0xbc, 0x7d, 0xfb, 0xfc, 0xf5, 0xfb, 0xab, 0x64, 0x8c, 0xbf, 0x1a, 0x74, 0xd1, 0x20,
-		0x35, 0xe8, 0xa2, 0x41, 0x7f, 0xbf, 0xc7, 0xee, 0xb5, 0xdf, 0xdf, 0x9a, 0x80, 0x5c, 0x28, 0xc7,
-		0x87, 0x9e, 0x8a, 0xdc, 0x5a, 0x38, 0x7f, 0x64, 0x42, 0x30, 0x74, 0x6d, 0x21, 0x22, 0xa9, 0xc4,
-		0x3d, 0x48, 0xea, 0x9d, 0xbf, 0xcc, 0x18, 0x7f, 0x33, 0x7e, 0x6c, 0xc0, 0xcd, 0xf8, 0xff, 0x57,
-		0x82, 0x8c, 0x9f, 0x7c, 0x39, 0xee, 0x9e, 0xdc, 0x29, 0x48, 0xf3, 0xd8, 0x8b, 0x6d, 0xca, 0xf1,
-		0xaf, 0xd8, 0xec, 0xe8, 0x2c, 0x64, 0xc4, 0xef, 0xa2, 0x72, 0x0f, 0xe7, 0x7f, 0x5f, 0xda, 0x81,
-		0x5c, 0x68, 0x5b, 0x13, 0x9d, 0x81, 0x93, 0x8b, 0x37, 0x6a, 0x8b, 0x2f, 0xab, 0xcd, 0x57, 0x7a,
-		0x7f, 0x5b, 0xaf, 0xaf, 0x4a, 0xa9, 0xd1, 0x6f, 0x59, 0x42, 0xa7, 0x61, 0x2a, 0x5a, 0xc5, 0x2a,
-		0x12, 0xb3, 0xa9, 0x6f, 0xff, 0xb1, 0x73, 0x27, 0x2e, 0x7d, 0x59, 0x82, 0xa9, 0x98, 0x28, 0x17,
-		0x9d, 0x87, 0xfb, 0x37, 0x96, 0x97, 0x6b, 0x8a, 0xda, 0x58, 0xaf, 0x6c, 0x36, 0x6e, 0x6c, 0x34,
-		0x55, 0xa5, 0xd6, 0xd8, 0x5a, 0x6d, 0x86, 0x1a, 0x9d, 0x87, 0xfb, 0xe2, 0x41, 0x2a, 0x8b, 0x8b,
-		0xb5, 0xcd, 0x26, 0xfb, 0x71, 0xbf, 0x01, 0x10, 0xd5, 0x0d, 0xa5, 0x29, 0x27, 0x06, 0x93, 0x50,
-		0x6a, 0x37, 0x6b, 0x8b, 0x4d, 0x39, 0x89, 0x2e, 0xc0, 0x03, 0x47, 0x41, 0xa8, 0xcb, 0x1b, 0xca,
-		0x5a, 0xa5, 0x29, 0xa7, 0x86, 0x02, 0x36, 0x6a, 0xeb, 0x4b, 0x35, 0x45, 0x1e, 0xe3, 0xfd, 0xfe,
-		0x58, 0x02, 0x66, 0x06, 0x05, 0xd3, 0x84, 0x56, 0x65, 0x73, 0x73, 0xf5, 0xd5, 0x80, 0xd6, 0xe2,
-		0x8d, 0xad, 0xf5, 0x97, 0xfb, 0x45, 0xf0, 0x30, 0x94, 0x8e, 0x02, 0xf4, 0x05, 0xf1, 0x10, 0x9c,
-		0x3f, 0x12, 0x8e, 0x8b, 0x63, 0x08, 0x98, 0x52, 0x6b, 0x2a, 0xaf, 0xca, 0x49, 0xb4, 0x00, 0x97,
-		0x86, 0x82, 0xf9, 0x75, 0x72, 0x0a, 0x5d, 0x86, 0x47, 0x8f, 0x86, 0x67, 0x02, 0x12, 0x08, 0x42,
-		0x44, 0x6f, 0x4a, 0x70, 0x32, 0x36, 0x2a, 0x47, 0x0f, 0xc0, 0xdc, 0xa6, 0xb2, 0xb1, 0x58, 0x6b,
-		0x34, 0xfc, 0x3b, 0x0b, 0x6a, 0xa3, 0x59, 0x69, 0x6e, 0x35, 0x42, 0xb2, 0x29, 0xc1, 0xb9, 0x41,
-		0x40, 0xbe, 0x5c, 0x8e, 0x80, 0xe1, 0x1a, 0x20, 0xf4, 0xf4, 0xae, 0x04, 0x67, 0x06, 0x46, 0xe1,
-		0xe8, 0x22, 0x3c, 0xb8, 0x5d, 0x53, 0xea, 0xcb, 0xaf, 0xaa, 0xdb, 0x1b, 0xcd, 0xf0, 0xaf, 0x48,
-		0xf6, 0x71, 0x75, 0x01, 0x1e, 0x38, 0x12, 0xd2, 0x67, 0x6d, 0x18, 0x60, 0x0f, 0x7f, 0xdf, 0x22,
-		0x41, 0xb1, 0xc7, 0x16, 0xa2, 0xfb, 0x60, 0x66, 0xad, 0xde, 0xa8, 0xd6, 0x6e, 0x54, 0xb6, 0xeb,
-		0x1b, 0x4a, 0xef, 0x9c, 0x7d, 0x00, 0xe6, 0xfa, 0x6a, 0x97, 0xb6, 0x36, 0x57, 0xeb, 0x8b, 0x95,
-		0x66, 0x4d, 0x65, 0x17, 0x4d, 0x48, 0xc7, 0xfa, 0x80, 0x56, 0xeb, 0x2b, 0x37, 0x9a, 0xea, 0xe2,
-		0x6a, 0xbd, 0xb6, 0xde, 0x54, 0x2b, 0xcd, 0x66, 0x25, 0x98, 0xce, 0xd5, 0x97, 0x07, 0x1e, 0xf0,
-		0xbc, 0x32, 0xfa, 0x01, 0x4f, 0x7e, 0x84, 0xd3, 0x3f, 0xdf, 0xf9, 0x7b, 0xd7, 0xe0, 0x41, 0xfe,
-		0x30, 0x91, 0xeb, 0x69, 0xb7, 0x0c, 0x73, 0xcf, 0x7f, 0x21, 0x8a, 0x7f, 0xf3, 0x73, 0x9e, 0xa7,
-		0xf8, 0x2b, 0x48, 0xa2, 0x74, 0xc8, 0x3b, 0x51, 0x03, 0x9f, 0x17, 0x1d, 0x7a, 0x3f, 0x60, 0xd8,
-		0x31, 0xcd, 0xa3, 0xde, 0xa0, 0x1a, 0xf2, 0xd2, 0x55, 0xcc, 0x1b, 0x55, 0xb3, 0x47, 0xbf, 0xd7,
-		0x30, 0x7b, 0xe4, 0xe1, 0xd7, 0xd2, 0x07, 0x25, 0x98, 0xb8, 0x61, 0xb8, 0x9e, 0xe5, 0x18, 0xba,
-		0xd6, 0xa6, 0x81, 0xc4, 0xf3, 0x23, 0x5f, 0x68, 0xab, 0x66, 0x89, 0x1b, 0xe3, 0x2f, 0x59, 0xed,
-		0x8b, 0x3b, 0x65, 0xe9, 0xdb, 0x5a, 0x9b, 0x5d, 0x26, 0x0b, 0x3f, 0x85, 0xd7, 0x2b, 0xf6, 0x90,
-		0x7f, 0x0d, 0x53, 0x61, 0xb8, 0xe5, 0xc4, 0x8c, 0x54, 0xfa, 0x4e, 0x09, 0xe4, 0x80, 0x35, 0x05,
-		0xeb, 0x96, 0xd3, 0xa2, 0x91, 0xa2, 0x6d, 0x87, 0x36, 0x43, 0xc5, 0x27, 0xbd, 0xc2, 0x68, 0x74,
-		0xc4, 0x3d, 0x89, 0xa3, 0x9c, 0x6f, 0x2a, 0xe4, 0x78, 0x63, 0x2e, 0xee, 0x27, 0xe3, 0x2e, 0xee,
-		0x97, 0x7e, 0x20, 0x01, 0x45, 0xba, 0xdc, 0x72, 0xe9, 0xf2, 0x9c, 0x2e, 0x00, 0x6f, 0x42, 0xca,
-		0xd1, 0x3c, 0xbe, 0x28, 0xaa, 0x5e, 0x3f, 0xf6, 0x63, 0x5c, 0xac, 0xcf, 0x94, 0x06, 0x7a, 0x0f,
-		0x64, 0x3a, 0xda, 0x81, 0x4a, 0xe9, 0x25, 0xde, 0x16, 0xbd, 0xf1, 0x8e, 0x76, 0x40, 0xf8, 0x43,
-		0xdf, 0x00, 0x45, 0x42, 0x52, 0xdf, 0xd7, 0xcc, 0x3d, 0xcc, 0x28, 0x27, 0xdf, 0x16, 0xe5, 0x42,
-		0x47, 0x3b, 0x58, 0xa4, 0xd4, 0x08, 0x7d, 0xfe, 0x68, 0xd9, 0xaf, 0x48, 0x7c, 0xad, 0x4b, 0x05,
-		0x83, 0x34, 0x90, 0x75, 0xff, 0x8b, 0x36, 0x2a, 0x52, 0xc8, 0x17, 0x06, 0x69, 0x42, 0x8f, 0x58,
-		0xab, 0x05, 0xc2, 0xde, 0x67, 0xde, 0x9a, 0x93, 0x58, 0xab, 0x45, 0xbd, 0x4f, 0xec, 0x39, 0xb6,
-		0x86, 0x57, 0x47, 0x1c, 0xf0, 0x82, 0x88, 0xb6, 0x18, 0x41, 0x60, 0xd8, 0xa4, 0x9e, 0xf7, 0xe1,
-		0x13, 0x12, 0xe4, 0x96, 0x42, 0x0f, 0x89, 0xce, 0xc0, 0x78, 0xc7, 0x32, 0x8d, 0x5b, 0xd8, 0xf1,
-		0xf7, 0x00, 0xd8, 0x27, 0x89, 0x88, 0xd8, 0xef, 0x51, 0x7a, 0x87, 0xe2, 0x39, 0x17, 0xf1, 0x4d,
-		0xb0, 0xee, 0xe0, 0x1d, 0xd7, 0x10, 0x72, 0x56, 0xc4, 0x27, 0x7a, 0x04, 0x64, 0x17, 0xeb, 0x5d,
-		0xc7, 0xf0, 0x0e, 0x55, 0xdd, 0x32, 0x3d, 0x4d, 0xf7, 0xf8, 0xd2, 0xb1, 0x28, 0xca, 0x17, 0x59,
-		0x31, 0x21, 0xd2, 0xc2, 0x9e, 0x66, 0xb4, 0xd9, 0xd1, 0xb8, 0xac, 0x22, 0x3e, 0x39, 0xab, 0x77,
-		0xc7, 0xc3, 0x0b, 0xa7, 0x45, 0x90, 0x2d, 0x1b, 0x3b, 0x91, 0x33, 0x00, 0x4c, 0x1b, 0x67, 0x7e,
-		0xeb, 0xd3, 0x8f, 0x4f, 0x73, 0x81, 0xf3, 0xdd, 0x63, 0x76, 0x1f, 0x4c, 0x29, 0x0a, 0x0c, 0x71,
-		0x38, 0xe0, 0xd5, 0x48, 0xd6, 0xbf, 0xbb, 0x13, 0xbc, 0xa4, 0x34, 0xdd, 0x27, 0xd4, 0x8a, 0x79,
-		0x58, 0x9d, 0xf9, 0x8d, 0x80, 0x34, 0x5f, 0x5a, 0x6d, 0xd2, 0x65, 0x54, 0x78, 0x07, 0x80, 0x92,
-		0x21, 0xc1, 0xe6, 0xeb, 0x9a, 0xd1, 0x16, 0x3f, 0xdd, 0xab, 0xf0, 0x2f, 0x54, 0xf6, 0xb3, 0x5a,
-		0x29, 0x1a, 0xbb, 0x97, 0x06, 0xe9, 0x46, 0xd5, 0x32, 0x5b, 0xd1, 0x64, 0x16, 0x5a, 0x84, 0xb4,
-		0x67, 0xdd, 0xc2, 0x26, 0x17, 0x50, 0xf5, 0xd1, 0x63, 0xbc, 0xba, 0xa7, 0x70, 0x54, 0xf4, 0x75,
-		0x20, 0xb7, 0x70, 0x1b, 0xef, 0xb1, 0xab, 0xaf, 0xfb, 0x9a, 0x83, 0xd9, 0x1b, 0x0c, 0xf7, 0xf4,
-		0xa6, 0x5e, 0xd1, 0x27, 0xd5, 0xa0, 0x94, 0xd0, 0x66, 0xf4, 0xa9, 0xda, 0x71, 0x7f, 0xc3, 0x3a,
-		0xb6, 0x8f, 0x21, 0xcd, 0x0b, 0xdb, 0xc2, 0xc8, 0xd3, 0xb6, 0x8f, 0x80, 0xdc, 0x35, 0x77, 0x2c,
-		0x93, 0xfe, 0xe2, 0x25, 0x8f, 0xf7, 0x33, 0x6c, 0x27, 0xc8, 0x2f, 0xe7, 0x3b, 0x41, 0x9b, 0x30,
-		0x11, 0x80, 0xd2, 0x19, 0x92, 0x3d, 0xee, 0x0c, 0x29, 0xf8, 0x04, 0x08, 0x08, 0x5a, 0x03, 0x08,
-		0xe6, 0x20, 0xdd, 0x87, 0xc8, 0x0d, 0x1e, 0xb1, 0x60, 0x36, 0x87, 0x3b, 0x13, 0x22, 0x80, 0x4c,
-		0x98, 0xea, 0x18, 0xa6, 0xea, 0xe2, 0xf6, 0xae, 0xca, 0x25, 0x47, 0xe8, 0xe6, 0xa8, 0xf8, 0x5f,
-		0x3c, 0xc6, 0x68, 0xfe, 0xce, 0xa7, 0x1f, 0x2f, 0x06, 0x8f, 0x11, 0xce, 0x3f, 0xb1, 0x70, 0xed,
-		0x69, 0x65, 0xb2, 0x63, 0x98, 0x0d, 0xdc, 0xde, 0x5d, 0xf2, 0x09, 0xa3, 0xe7, 0xe1, 0x6c, 0x20,
-		0x10, 0xcb, 0x54, 0xf7, 0xad, 0x76, 0x4b, 0x75, 0xf0, 0xae, 0xaa, 0xd3, 0xa7, 0x14, 0xf3, 0x54,
-		0x8c, 0xa7, 0x7d, 0x90, 0x0d, 0xf3, 0x86, 0xd5, 0x6e, 0x29, 0x78, 0x77, 0x91, 0x54, 0xa3, 0x07,
-		0x20, 0x90, 0x86, 0x6a, 0xb4, 0xdc, 0x99, 0xc2, 0x7c, 0xf2, 0x62, 0x4a, 0xc9, 0xfb, 0x85, 0xf5,
-		0x96, 0x5b, 0xce, 0x7c, 0xfb, 0xc7, 0xe6, 0x4e, 0x7c, 0xe1, 0x63, 0x73, 0x27, 0x4a, 0xcb, 0xf4,
-		0xad, 0x35, 0x3e, 0xb5, 0xb0, 0x8b, 0xae, 0x43, 0x56, 0x13, 0x1f, 0xec, 0x0a, 0xd5, 0x11, 0x53,
-		0x33, 0x00, 0x2d, 0x7d, 0x52, 0x82, 0xf4, 0xd2, 0xf6, 0xa6, 0x66, 0x38, 0xa8, 0x06, 0x93, 0x81,
-		0xae, 0x8e, 0x3a, 0xcb, 0x03, 0xf5, 0x16, 0xd3, 0x7c, 0x7d, 0xd0, 0x81, 0xa1, 0x6c, 0xf5, 0xfc,
-		0x6f, 0x7d, 0xfa, 0xf1, 0xfb, 0x39, 0x99, 0xed, 0x9e, 0xb3, 0x43, 0x82, 0x5e, 0xef, 0x99, 0xa2,
-		0x50, 0x9f, 0x6f, 0xc2, 0x38, 0x63, 0xd5, 0x45, 0x2f, 0xc1, 0x98, 0x4d, 0xfe, 0xe0, 0xe9, 0xe4,
-		0x73, 0x03, 0x75, 0x9e, 0xc2, 0x87, 0x35, 0x84, 0xe1, 0x95, 0xbe, 0x33, 0x01, 0xb0, 0xb4, 0xbd,
-		0xdd, 0x74, 0x0c, 0xbb, 0x8d, 0xbd, 0x77, 0xaa, 0xef, 0x5b, 0x70, 0x32, 0x74, 0xd3, 0xdd, 0xd1,
-		0x8f, 0xdf, 0xff, 0xa9, 0xe0, 0xce, 0xbb, 0xa3, 0xc7, 0x92, 0x6d, 0xb9, 0x9e, 0x4f, 0x36, 0x79,
-		0x7c, 0xb2, 0x4b, 0xae, 0xd7, 0x2f, 0xd9, 0x57, 0x20, 0x17, 0x08, 0xc3, 0x45, 0x75, 0xc8, 0x78,
-		0xfc, 0x6f, 0x2e, 0xe0, 0xd2, 0x60, 0x01, 0x0b, 0xb4, 0xb0, 0x90, 0x7d, 0xf4, 0xd2, 0x5f, 0x49,
-		0x00, 0xa1, 0x39, 0xf2, 0x37, 0x53, 0xc7, 0x50, 0x1d, 0xd2, 0xdc, 0x38, 0x27, 0xef, 0xf9, 0xc1,
-		0x53, 0x46, 0x20, 0x24, 0xd4, 0xef, 0x4e, 0xc0, 0xd4, 0x96, 0x98, 0xbd, 0x7f, 0xf3, 0x65, 0xb0,
-		0x05, 0xe3, 0xd8, 0xf4, 0x1c, 0xc3, 0xdf, 0x0e, 0x79, 0x62, 0xd0, 0x98, 0xc7, 0x74, 0xaa, 0x66,
-		0x7a, 0xce, 0x61, 0x58, 0x03, 0x04, 0xad, 0x90, 0x3c, 0x3e, 0x9c, 0x84, 0x99, 0x41, 0xa8, 0x24,
-		0x40, 0xd6, 0x1d, 0x4c, 0x0b, 0xa2, 0x37, 0xfa, 0x26, 0x44, 0x31, 0x77, 0x3b, 0x0a, 0x90, 0x40,
-		0x8d, 0x28, 0x17, 0x01, 0xbd, 0xb7, 0xc8, 0x6c, 0x22, 0xa0, 0x40, 0x1d, 0x4f, 0x13, 0x8a, 0xe2,
-		0x1e, 0xc0, 0x8e, 0xd6, 0xd6, 0x4c, 0x5d, 0x44, 0xb0, 0xc7, 0xf2, 0xf9, 0xe2, 0x2e, 0x41, 0x95,
-		0x91, 0x40, 0x35, 0x18, 0x17, 0xd4, 0x52, 0xc7, 0xa7, 0x26, 0x70, 0xd1, 0x79, 0xc8, 0x87, 0x1d,
-		0x03, 0x8d, 0x46, 0x52, 0x4a, 0x2e, 0xe4, 0x17, 0x86, 0x79, 0x9e, 0xf4, 0x91, 0x9e, 0x87, 0x07,
-		0x7c, 0x3f, 0x9c, 0x84, 0x49, 0x05, 0xb7, 0xfe, 0xf6, 0x0f, 0xcb, 0x26, 0x00, 0x9b, 0xaa, 0xc4,
-		0x92, 0xf2, 0x91, 0xb9, 0x87, 0xf9, 0x9e, 0x65, 0x44, 0x96, 0x5c, 0xef, 0xab, 0x35, 0x42, 0xbf,
-		0x9b, 0x80, 0x7c, 0x78, 0x84, 0xfe, 0x4e, 0x3a, 0x2d, 0xb4, 0x1e, 0x98, 0x29, 0x76, 0x93, 0xe1,
-		0x91, 0x41, 0x66, 0xaa, 0x4f, 0x9b, 0x87, 0xd8, 0xa7, 0xcf, 0x27, 0x21, 0xcd, 0x4f, 0x14, 0x6d,
-		0xf4, 0xc5, 0xb6, 0x43, 0xaf, 0x73, 0x17, 0xc4, 0x8d, 0xf8, 0xd8, 0xd0, 0xf6, 0x21, 0x98, 0x20,
-		0x6b, 0xe4, 0xc8, 0x31, 0x25, 0xe9, 0x62, 0x81, 0x2e, 0x75, 0x83, 0x63, 0xba, 0x68, 0x0e, 0x72,
-		0x04, 0x2c, 0xb0, 0xc3, 0x04, 0x06, 0x3a, 0xda, 0x41, 0x8d, 0x95, 0xa0, 0xc7, 0x01, 0xed, 0xfb,
-		0xb9, 0x0a, 0x35, 0x10, 0x04, 0x81, 0x9b, 0x0c, 0x6a, 0x04, 0xf8, 0xfd, 0x00, 0x84, 0x0b, 0x95,
-		0xbd, 0xf1, 0xcd, 0x5f, 0x50, 0x27, 0x25, 0x4b, 0xf4, 0x9d, 0xef, 0x6f, 0x91, 0x58, 0x88, 0xdc,
-		0xb3, 0x92, 0xe6, 0x2b, 0x94, 0xe6, 0x08, 0x93, 0xe2, 0x2f, 0xde, 0x9a, 0x9b, 0x3d, 0xd4, 0x3a,
-		0xed, 0x72, 0x29, 0x86, 0x4e, 0x29, 0x6e, 0x71, 0x4f, 0x02, 0xe7, 0xe8, 0x4a, 0x1c, 0xd5, 0x41,
-		0xbe, 0x85, 0x0f, 0x55, 0x87, 0xff, 0x44, 0xbc, 0xba, 0x8b, 0xc5, 0x8b, 0x0a, 0x67, 0x16, 0x62,
-		0x5e, 0x5c, 0x5f, 0x58, 0xb4, 0x0c, 0x93, 0xef, 0x96, 0x4c, 0xdc, 0xc2, 0x87, 0x0a, 0xc7, 0x5b,
-		0xc6, 0xb8, 0xfc, 0x20, 0x99, 0x29, 0x6f, 0x7e, 0xfe, 0x67, 0x2e, 0x9d, 0x0d, 0xbd, 0x1e, 0x7e,
-		0xe0, 0x67, 0xec, 0xd8, 0xf0, 0x92, 0xa0, 0x17, 0x05, 0x0e, 0x28, 0x74, 0x2c, 0x0d, 0x42, 0xeb,
-		0x04, 0xe9, 0xe8, 0xf5, 0x47, 0x80, 0x1f, 0x59, 0x7f, 0x84, 0xa6, 0xe7, 0x8b, 0x81, 0xfd, 0x4f,
-		0x0c, 0xeb, 0x4d, 0x58, 0x33, 0x39, 0x12, 0x9d, 0xf5, 0x27, 0x4a, 0xff, 0x5e, 0x82, 0x33, 0x7d,
-		0x9a, 0xec, 0xb3, 0xac, 0x03, 0x72, 0x42, 0x95, 0x54, 0x23, 0xc4, 0xce, 0xe4, 0xbd, 0x4d, 0x8c,
-		0x49, 0xa7, 0xcf, 0x09, 0xbc, 0x33, 0x8e, 0x8c, 0x5b, 0xb1, 0x5f, 0x97, 0x60, 0x3a, 0xcc, 0x80,
-		0xdf, 0x95, 0x06, 0xe4, 0xc3, 0x4d, 0xf3, 0x4e, 0x3c, 0x38, 0x4a, 0x27, 0xc2, 0xfc, 0x47, 0x88,
-		0xa0, 0xed, 0xc0, 0x5a, 0xb0, 0x3c, 0xe1, 0x95, 0x91, 0x85, 0x22, 0x18, 0x8b, 0xb5, 0x1a, 0x6c,
-		0x6c, 0xfe, 0x58, 0x82, 0xd4, 0xa6, 0x65, 0xb5, 0xd1, 0xfb, 0x60, 0xd2, 0xb4, 0x3c, 0x95, 0xcc,
-		0x2c, 0xdc, 0x52, 0x79, 0xda, 0x80, 0x59, 0xe2, 0xda, 0x91, 0xb2, 0xfa, 0xa3, 0xb7, 0xe6, 0xfa,
-		0x31, 0xe3, 0x5e, 0xf0, 0x2f, 0x9a, 0x96, 0x57, 0xa5, 0x40, 0x4d, 0x96, 0x59, 0xd8, 0x85, 0x42,
-		0xb4, 0x39, 0x66, 0xad, 0x2b, 0xc3, 0x9a, 0x2b, 0x0c, 0x6d, 0x2a, 0xbf, 0x13, 0x6a, 0x87, 0xbd,
-		0x55, 0xfe, 0x67, 0x64, 0xe4, 0xbe, 0x01, 0xe4, 0xed, 0xde, 0x73, 0x2f, 0xcb, 0x30, 0x2e, 0xce,
-		0xb9, 0x48, 0xa3, 0x9e, 0xa1, 0x09, 0xcb, 0x93, 0x23, 0xd3, 0x44, 0xec, 0x67, 0x12, 0x70, 0x66,
-		0xd1, 0x32, 0x5d, 0x9e, 0xe4, 0xe1, 0xb3, 0x9a, 0xa5, 0x66, 0x0f, 0xd1, 0x23, 0x03, 0x52, 0x50,
-		0xf9, 0xfe, 0x44, 0xd3, 0x36, 0x14, 0x89, 0x7b, 0xd5, 0x2d, 0xf3, 0x6d, 0xe6, 0x99, 0x0a, 0x56,
-		0xbb, 0xc5, 0x39, 0xba, 0x85, 0x0f, 0x09, 0x5d, 0x13, 0xdf, 0x89, 0xd0, 0x4d, 0xde, 0x1b, 0x5d,
-		0x13, 0xdf, 0x09, 0xd1, 0x0d, 0xb6, 0x56, 0x53, 0x91, 0xad, 0xd5, 0xeb, 0x90, 0x24, 0xa6, 0x70,
-		0xec, 0x18, 0xc6, 0x83, 0x20, 0x84, 0x5c, 0x5a, 0x03, 0xce, 0xf0, 0x2c, 0x81, 0xbb, 0xb1, 0x4b,
-		0x25, 0x8a, 0x69, 0x87, 0x5e, 0xc6, 0x87, 0x31, 0x29, 0x83, 0xfc, 0x48, 0x29, 0x83, 0x4b, 0x3f,
-		0x2f, 0x01, 0x04, 0xf9, 0x32, 0xf4, 0x18, 0x9c, 0xae, 0x6e, 0xac, 0x2f, 0x05, 0xbb, 0x4c, 0xa1,
-		0x9f, 0x31, 0x12, 0x2f, 0x8a, 0xb9, 0x36, 0xd6, 0x8d, 0x5d, 0x03, 0xb7, 0xd0, 0xc3, 0x30, 0x1d,
-		0x85, 0x26, 0x5f, 0xb5, 0x25, 0x59, 0x9a, 0xcd, 0xbf, 0x79, 0x77, 0x3e, 0xc3, 0xd6, 0x07, 0xb8,
-		0x85, 0x2e, 0xc2, 0xc9, 0x7e, 0xb8, 0xfa, 0xfa, 0x8a, 0x9c, 0x98, 0x2d, 0xbc, 0x79, 0x77, 0x3e,
-		0xeb, 0x2f, 0x24, 0x50, 0x09, 0x50, 0x18, 0x92, 0xd3, 0x4b, 0xce, 0xc2, 0x9b, 0x77, 0xe7, 0xd3,
-		0x6c, 0xca, 0xf0, 0xed, 0xa9, 0xaf, 0x07, 0xa8, 0x9b, 0xbb, 0x8e, 0xa6, 0x53, 0xd3, 0x30, 0x0b,
-		0xa7, 0xea, 0xeb, 0xcb, 0x4a, 0x65, 0xb1, 0x59, 0xdf, 0x58, 0xef, 0xf9, 0xf5, 0xa5, 0x68, 0xdd,
-		0xd2, 0xc6, 0x56, 0x75, 0xb5, 0xa6, 0x36, 0xea, 0x2b, 0xeb, 0x6c, 0x2f, 0x39, 0x52, 0xf7, 0xde,
-		0xf5, 0x66, 0x7d, 0xad, 0x26, 0x27, 0xaa, 0xd7, 0x07, 0x6e, 0x3b, 0xdd, 0x17, 0x99, 0x8c, 0x81,
-		0x3b, 0x8a, 0xfc, 0xac, 0xc5, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xfa, 0x3d, 0x4f, 0xc1,
-		0xa5, 0x00, 0x00,
+		// 14798 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x6b, 0x78, 0x1c, 0x59,
+		0x75, 0x28, 0xea, 0xea, 0x6e, 0x49, 0xdd, 0x4b, 0x2d, 0xa9, 0xb5, 0x25, 0xdb, 0xed, 0xb6, 0xc7,
+		0x92, 0x6b, 0x1e, 0xf6, 0xd8, 0x58, 0x1a, 0x7b, 0x6c, 0xcf, 0x8c, 0xe6, 0x45, 0xb7, 0xd4, 0xb6,
+		0xe5, 0xd1, 0x8b, 0x6a, 0xc9, 0xcc, 0x4c, 0x80, 0xa6, 0xd4, 0x5d, 0x92, 0x1a, 0xb7, 0xaa, 0x7a,
+		0xba, 0xaa, 0x6d, 0x0b, 0x2e, 0x37, 0x04, 0x08, 0x09, 0x93, 0x17, 0xb9, 0x79, 0x91, 0x80, 0x09,
+		0xe4, 0x05, 0x79, 0x5e, 0x92, 0x0c, 0x04, 0xf2, 0xba, 0x37, 0xf7, 0x4b, 0x72, 0x13, 0xee, 0xbd,
+		0x09, 0x97, 0xf3, 0x25, 0x27, 0x27, 0xdf, 0x97, 0x49, 0x02, 0xf9, 0x3e, 0x38, 0x81, 0x3c, 0x0f,
+		0x9c, 0xc3, 0x77, 0x38, 0x27, 0xdf, 0xf9, 0xd6, 0x7e, 0xd4, 0xbb, 0xba, 0x5a, 0xb2, 0x4d, 0x06,
+		0x72, 0xfe, 0x48, 0xbd, 0xf7, 0x5e, 0x6b, 0xed, 0xb5, 0xd7, 0x5e, 0x7b, 0xed, 0xb5, 0xd7, 0x7e,
+		0x14, 0xfc, 0x75, 0x09, 0x26, 0x37, 0x0d, 0x63, 0xb3, 0xa9, 0x4d, 0xb7, 0xda, 0x86, 0x65, 0xac,
+		0x77, 0x36, 0xa6, 0xeb, 0x9a, 0x59, 0x6b, 0x37, 0x5a, 0x96, 0xd1, 0x9e, 0xa2, 0x79, 0x64, 0x84,
+		0x41, 0x4c, 0x09, 0x08, 0x79, 0x11, 0x46, 0x2f, 0x36, 0x9a, 0xda, 0x9c, 0x0d, 0x58, 0xd1, 0x2c,
+		0xf2, 0x28, 0xa4, 0x36, 0x1a, 0x4d, 0x2d, 0x2f, 0x4d, 0x26, 0x4f, 0x0c, 0x9e, 0xbd, 0x6f, 0xca,
+		0x87, 0x34, 0xe5, 0xc5, 0x58, 0xc1, 0x6c, 0x85, 0x62, 0xc8, 0xff, 0x92, 0x82, 0xb1, 0x90, 0x52,
+		0x42, 0x20, 0xa5, 0xab, 0xdb, 0x48, 0x51, 0x3a, 0x91, 0x51, 0xe8, 0x6f, 0x92, 0x87, 0x81, 0x96,
+		0x5a, 0xbb, 0xa6, 0x6e, 0x6a, 0xf9, 0x04, 0xcd, 0x16, 0x49, 0x72, 0x14, 0xa0, 0xae, 0xb5, 0x34,
+		0xbd, 0xae, 0xe9, 0xb5, 0x9d, 0x7c, 0x72, 0x32, 0x79, 0x22, 0xa3, 0xb8, 0x72, 0xc8, 0x29, 0x18,
+		0x6d, 0x75, 0xd6, 0x9b, 0x8d, 0x5a, 0xd5, 0x05, 0x06, 0x93, 0xc9, 0x13, 0x7d, 0x4a, 0x8e, 0x15,
+		0xcc, 0x39, 0xc0, 0xc7, 0x61, 0xe4, 0x86, 0xa6, 0x5e, 0x73, 0x83, 0x0e, 0x52, 0xd0, 0x61, 0xcc,
+		0x76, 0x01, 0xce, 0x42, 0x76, 0x5b, 0x33, 0x4d, 0x75, 0x53, 0xab, 0x5a, 0x3b, 0x2d, 0x2d, 0x9f,
+		0xa2, 0xad, 0x9f, 0x0c, 0xb4, 0xde, 0xdf, 0xf2, 0x41, 0x8e, 0xb5, 0xba, 0xd3, 0xd2, 0x48, 0x11,
+		0x32, 0x9a, 0xde, 0xd9, 0x66, 0x14, 0xfa, 0x22, 0xe4, 0x57, 0xd6, 0x3b, 0xdb, 0x7e, 0x2a, 0x69,
+		0x44, 0xe3, 0x24, 0x06, 0x4c, 0xad, 0x7d, 0xbd, 0x51, 0xd3, 0xf2, 0xfd, 0x94, 0xc0, 0xf1, 0x00,
+		0x81, 0x0a, 0x2b, 0xf7, 0xd3, 0x10, 0x78, 0x64, 0x16, 0x32, 0xda, 0x4d, 0x4b, 0xd3, 0xcd, 0x86,
+		0xa1, 0xe7, 0x07, 0x28, 0x91, 0xfb, 0x43, 0x7a, 0x51, 0x6b, 0xd6, 0xfd, 0x24, 0x1c, 0x3c, 0x72,
+		0x01, 0x06, 0x8c, 0x96, 0xd5, 0x30, 0x74, 0x33, 0x9f, 0x9e, 0x94, 0x4e, 0x0c, 0x9e, 0x3d, 0x12,
+		0xaa, 0x08, 0xcb, 0x0c, 0x46, 0x11, 0xc0, 0x64, 0x1e, 0x72, 0xa6, 0xd1, 0x69, 0xd7, 0xb4, 0x6a,
+		0xcd, 0xa8, 0x6b, 0xd5, 0x86, 0xbe, 0x61, 0xe4, 0x33, 0x94, 0xc0, 0x44, 0xb0, 0x21, 0x14, 0x70,
+		0xd6, 0xa8, 0x6b, 0xf3, 0xfa, 0x86, 0xa1, 0x0c, 0x9b, 0x9e, 0x34, 0x39, 0x00, 0xfd, 0xe6, 0x8e,
+		0x6e, 0xa9, 0x37, 0xf3, 0x59, 0xaa, 0x21, 0x3c, 0x85, 0xaa, 0xa3, 0xd5, 0x1b, 0x58, 0x5d, 0x7e,
+		0x88, 0xa9, 0x0e, 0x4f, 0xca, 0x9f, 0xec, 0x87, 0x91, 0x5e, 0x94, 0xef, 0x71, 0xe8, 0xdb, 0xc0,
+		0xf6, 0xe7, 0x13, 0xbb, 0x91, 0x0e, 0xc3, 0xf1, 0x8a, 0xb7, 0x7f, 0x8f, 0xe2, 0x2d, 0xc2, 0xa0,
+		0xae, 0x99, 0x96, 0x56, 0x67, 0xba, 0x92, 0xec, 0x51, 0xdb, 0x80, 0x21, 0x05, 0x95, 0x2d, 0xb5,
+		0x27, 0x65, 0x7b, 0x16, 0x46, 0x6c, 0x96, 0xaa, 0x6d, 0x55, 0xdf, 0x14, 0x5a, 0x3b, 0x1d, 0xc7,
+		0xc9, 0x54, 0x59, 0xe0, 0x29, 0x88, 0xa6, 0x0c, 0x6b, 0x9e, 0x34, 0x99, 0x03, 0x30, 0x74, 0xcd,
+		0xd8, 0xa8, 0xd6, 0xb5, 0x5a, 0x33, 0x9f, 0x8e, 0x90, 0xd2, 0x32, 0x82, 0x04, 0xa4, 0x64, 0xb0,
+		0xdc, 0x5a, 0x93, 0x3c, 0xe6, 0x28, 0xe1, 0x40, 0x84, 0x0e, 0x2d, 0xb2, 0xe1, 0x17, 0xd0, 0xc3,
+		0x35, 0x18, 0x6e, 0x6b, 0x38, 0x22, 0xb4, 0x3a, 0x6f, 0x59, 0x86, 0x32, 0x31, 0x15, 0xdb, 0x32,
+		0x85, 0xa3, 0xb1, 0x86, 0x0d, 0xb5, 0xdd, 0x49, 0x72, 0x2f, 0xd8, 0x19, 0x55, 0xaa, 0x56, 0x40,
+		0xed, 0x53, 0x56, 0x64, 0x2e, 0xa9, 0xdb, 0x5a, 0xe1, 0xcd, 0x30, 0xec, 0x15, 0x0f, 0x19, 0x87,
+		0x3e, 0xd3, 0x52, 0xdb, 0x16, 0xd5, 0xc2, 0x3e, 0x85, 0x25, 0x48, 0x0e, 0x92, 0x9a, 0x5e, 0xa7,
+		0xf6, 0xaf, 0x4f, 0xc1, 0x9f, 0xe4, 0xd5, 0x4e, 0x83, 0x93, 0xb4, 0xc1, 0x0f, 0x04, 0x7b, 0xd4,
+		0x43, 0xd9, 0xdf, 0xee, 0xc2, 0x23, 0x30, 0xe4, 0x69, 0x40, 0xaf, 0x55, 0xcb, 0xff, 0x0b, 0xec,
+		0x0f, 0x25, 0x4d, 0x9e, 0x85, 0xf1, 0x8e, 0xde, 0xd0, 0x2d, 0xad, 0xdd, 0x6a, 0x6b, 0xa8, 0xb1,
+		0xac, 0xaa, 0xfc, 0xe7, 0x07, 0x22, 0x74, 0x6e, 0xcd, 0x0d, 0xcd, 0xa8, 0x28, 0x63, 0x9d, 0x60,
+		0xe6, 0xc9, 0x4c, 0xfa, 0x0b, 0x03, 0xb9, 0xb7, 0xbd, 0xed, 0x6d, 0x6f, 0x4b, 0xc8, 0xbf, 0xd3,
+		0x0f, 0xe3, 0x61, 0x63, 0x26, 0x74, 0xf8, 0x1e, 0x80, 0x7e, 0xbd, 0xb3, 0xbd, 0xae, 0xb5, 0xa9,
+		0x90, 0xfa, 0x14, 0x9e, 0x22, 0x45, 0xe8, 0x6b, 0xaa, 0xeb, 0x5a, 0x33, 0x9f, 0x9a, 0x94, 0x4e,
+		0x0c, 0x9f, 0x3d, 0xd5, 0xd3, 0xa8, 0x9c, 0x5a, 0x40, 0x14, 0x85, 0x61, 0x92, 0xa7, 0x20, 0xc5,
+		0x8d, 0x37, 0x52, 0x38, 0xd9, 0x1b, 0x05, 0x1c, 0x4b, 0x0a, 0xc5, 0x23, 0x87, 0x21, 0x83, 0xff,
+		0x99, 0x6e, 0xf4, 0x53, 0x9e, 0xd3, 0x98, 0x81, 0x7a, 0x41, 0x0a, 0x90, 0xa6, 0xc3, 0xa4, 0xae,
+		0x89, 0x49, 0xcf, 0x4e, 0xa3, 0x62, 0xd5, 0xb5, 0x0d, 0xb5, 0xd3, 0xb4, 0xaa, 0xd7, 0xd5, 0x66,
+		0x47, 0xa3, 0x0a, 0x9f, 0x51, 0xb2, 0x3c, 0xf3, 0x2a, 0xe6, 0x91, 0x09, 0x18, 0x64, 0xa3, 0xaa,
+		0xa1, 0xd7, 0xb5, 0x9b, 0xd4, 0xae, 0xf6, 0x29, 0x6c, 0xa0, 0xcd, 0x63, 0x0e, 0x56, 0xff, 0x26,
+		0xd3, 0xd0, 0x85, 0x6a, 0xd2, 0x2a, 0x30, 0x83, 0x56, 0xff, 0x88, 0xdf, 0xa4, 0xdf, 0x13, 0xde,
+		0xbc, 0xc0, 0x58, 0x3a, 0x0e, 0x23, 0x14, 0xe2, 0x61, 0xde, 0xf5, 0x6a, 0x33, 0x3f, 0x3a, 0x29,
+		0x9d, 0x48, 0x2b, 0xc3, 0x2c, 0x7b, 0x99, 0xe7, 0xca, 0x1f, 0x4f, 0x40, 0x8a, 0x1a, 0x96, 0x11,
+		0x18, 0x5c, 0x7d, 0x6e, 0xa5, 0x5c, 0x9d, 0x5b, 0x5e, 0x2b, 0x2d, 0x94, 0x73, 0x12, 0x19, 0x06,
+		0xa0, 0x19, 0x17, 0x17, 0x96, 0x8b, 0xab, 0xb9, 0x84, 0x9d, 0x9e, 0x5f, 0x5a, 0xbd, 0x70, 0x2e,
+		0x97, 0xb4, 0x11, 0xd6, 0x58, 0x46, 0xca, 0x0d, 0xf0, 0xf0, 0xd9, 0x5c, 0x1f, 0xc9, 0x41, 0x96,
+		0x11, 0x98, 0x7f, 0xb6, 0x3c, 0x77, 0xe1, 0x5c, 0xae, 0xdf, 0x9b, 0xf3, 0xf0, 0xd9, 0xdc, 0x00,
+		0x19, 0x82, 0x0c, 0xcd, 0x29, 0x2d, 0x2f, 0x2f, 0xe4, 0xd2, 0x36, 0xcd, 0xca, 0xaa, 0x32, 0xbf,
+		0x74, 0x29, 0x97, 0xb1, 0x69, 0x5e, 0x52, 0x96, 0xd7, 0x56, 0x72, 0x60, 0x53, 0x58, 0x2c, 0x57,
+		0x2a, 0xc5, 0x4b, 0xe5, 0xdc, 0xa0, 0x0d, 0x51, 0x7a, 0x6e, 0xb5, 0x5c, 0xc9, 0x65, 0x3d, 0x6c,
+		0x3d, 0x7c, 0x36, 0x37, 0x64, 0x57, 0x51, 0x5e, 0x5a, 0x5b, 0xcc, 0x0d, 0x93, 0x51, 0x18, 0x62,
+		0x55, 0x08, 0x26, 0x46, 0x7c, 0x59, 0x17, 0xce, 0xe5, 0x72, 0x0e, 0x23, 0x8c, 0xca, 0xa8, 0x27,
+		0xe3, 0xc2, 0xb9, 0x1c, 0x91, 0x67, 0xa1, 0x8f, 0xaa, 0x21, 0x21, 0x30, 0xbc, 0x50, 0x2c, 0x95,
+		0x17, 0xaa, 0xcb, 0x2b, 0xab, 0xf3, 0xcb, 0x4b, 0xc5, 0x85, 0x9c, 0xe4, 0xe4, 0x29, 0xe5, 0xd7,
+		0xac, 0xcd, 0x2b, 0xe5, 0xb9, 0x5c, 0xc2, 0x9d, 0xb7, 0x52, 0x2e, 0xae, 0x96, 0xe7, 0x72, 0x49,
+		0xb9, 0x06, 0xe3, 0x61, 0x06, 0x35, 0x74, 0x08, 0xb9, 0x74, 0x21, 0x11, 0xa1, 0x0b, 0x94, 0x96,
+		0x5f, 0x17, 0xe4, 0xcf, 0x25, 0x60, 0x2c, 0x64, 0x52, 0x09, 0xad, 0xe4, 0x69, 0xe8, 0x63, 0xba,
+		0xcc, 0xa6, 0xd9, 0x07, 0x43, 0x67, 0x27, 0xaa, 0xd9, 0x81, 0xa9, 0x96, 0xe2, 0xb9, 0x9d, 0x90,
+		0x64, 0x84, 0x13, 0x82, 0x24, 0x02, 0x0a, 0xfb, 0xfa, 0x80, 0xf1, 0x67, 0xf3, 0xe3, 0x85, 0x5e,
+		0xe6, 0x47, 0x9a, 0xb7, 0xbb, 0x49, 0xa0, 0x2f, 0x64, 0x12, 0x78, 0x1c, 0x46, 0x03, 0x84, 0x7a,
+		0x36, 0xc6, 0xef, 0x90, 0x20, 0x1f, 0x25, 0x9c, 0x18, 0x93, 0x98, 0xf0, 0x98, 0xc4, 0xc7, 0xfd,
+		0x12, 0x3c, 0x16, 0xdd, 0x09, 0x81, 0xbe, 0xfe, 0xb0, 0x04, 0x07, 0xc2, 0x9d, 0xcd, 0x50, 0x1e,
+		0x9e, 0x82, 0xfe, 0x6d, 0xcd, 0xda, 0x32, 0x84, 0x5b, 0xf5, 0x40, 0xc8, 0x64, 0x8d, 0xc5, 0xfe,
+		0xce, 0xe6, 0x58, 0xe4, 0x31, 0x3f, 0xaf, 0x13, 0x51, 0xae, 0x6f, 0x80, 0xd3, 0x77, 0x27, 0x60,
+		0x7f, 0x28, 0xf1, 0x50, 0x46, 0xef, 0x01, 0x68, 0xe8, 0xad, 0x8e, 0xc5, 0x5c, 0x27, 0x66, 0x89,
+		0x33, 0x34, 0x87, 0x1a, 0x2f, 0xb4, 0xb2, 0x1d, 0xcb, 0x2e, 0x4f, 0xd2, 0x72, 0x60, 0x59, 0x14,
+		0xe0, 0x51, 0x87, 0xd1, 0x14, 0x65, 0xf4, 0x68, 0x44, 0x4b, 0x03, 0x8a, 0xf9, 0x10, 0xe4, 0x6a,
+		0xcd, 0x86, 0xa6, 0x5b, 0x55, 0xd3, 0x6a, 0x6b, 0xea, 0x76, 0x43, 0xdf, 0xa4, 0x53, 0x4d, 0x7a,
+		0xa6, 0x6f, 0x43, 0x6d, 0x9a, 0x9a, 0x32, 0xc2, 0x8a, 0x2b, 0xa2, 0x14, 0x31, 0xa8, 0x02, 0xb5,
+		0x5d, 0x18, 0xfd, 0x1e, 0x0c, 0x56, 0x6c, 0x63, 0xc8, 0xdf, 0x9f, 0x81, 0x41, 0x97, 0x6b, 0x4e,
+		0x8e, 0x41, 0xf6, 0x4d, 0xea, 0x75, 0xb5, 0x2a, 0x96, 0x5b, 0x4c, 0x12, 0x83, 0x98, 0xb7, 0xc2,
+		0xb2, 0xc8, 0x43, 0x30, 0x4e, 0x41, 0x8c, 0x8e, 0xa5, 0xb5, 0xab, 0xb5, 0xa6, 0x6a, 0x9a, 0x54,
+		0x68, 0x69, 0x0a, 0x4a, 0xb0, 0x6c, 0x19, 0x8b, 0x66, 0x45, 0x09, 0x39, 0x0f, 0x63, 0x14, 0x63,
+		0xbb, 0xd3, 0xb4, 0x1a, 0xad, 0xa6, 0x56, 0xc5, 0x05, 0xa0, 0x99, 0x07, 0x37, 0x67, 0xa3, 0x08,
+		0xb1, 0xc8, 0x01, 0x90, 0x23, 0x93, 0xcc, 0xc1, 0x3d, 0x14, 0x6d, 0x53, 0xd3, 0xb5, 0xb6, 0x6a,
+		0x69, 0x55, 0xed, 0x85, 0x8e, 0xda, 0x34, 0xab, 0xaa, 0x5e, 0xaf, 0x6e, 0xa9, 0xe6, 0x56, 0x7e,
+		0x1c, 0x09, 0x94, 0x12, 0x79, 0x49, 0x39, 0x84, 0x80, 0x97, 0x38, 0x5c, 0x99, 0x82, 0x15, 0xf5,
+		0xfa, 0x65, 0xd5, 0xdc, 0x22, 0x33, 0x70, 0x80, 0x52, 0x31, 0xad, 0x76, 0x43, 0xdf, 0xac, 0xd6,
+		0xb6, 0xb4, 0xda, 0xb5, 0x6a, 0xc7, 0xda, 0x78, 0x34, 0x7f, 0xd8, 0x5d, 0x3f, 0xe5, 0xb0, 0x42,
+		0x61, 0x66, 0x11, 0x64, 0xcd, 0xda, 0x78, 0x94, 0x54, 0x20, 0x8b, 0x9d, 0xb1, 0xdd, 0x78, 0xb3,
+		0x56, 0xdd, 0x30, 0xda, 0x74, 0x0e, 0x1d, 0x0e, 0x31, 0x4d, 0x2e, 0x09, 0x4e, 0x2d, 0x73, 0x84,
+		0x45, 0xa3, 0xae, 0xcd, 0xf4, 0x55, 0x56, 0xca, 0xe5, 0x39, 0x65, 0x50, 0x50, 0xb9, 0x68, 0xb4,
+		0x51, 0xa1, 0x36, 0x0d, 0x5b, 0xc0, 0x83, 0x4c, 0xa1, 0x36, 0x0d, 0x21, 0xde, 0xf3, 0x30, 0x56,
+		0xab, 0xb1, 0x36, 0x37, 0x6a, 0x55, 0xbe, 0x4c, 0x33, 0xf3, 0x39, 0x8f, 0xb0, 0x6a, 0xb5, 0x4b,
+		0x0c, 0x80, 0xeb, 0xb8, 0x49, 0x1e, 0x83, 0xfd, 0x8e, 0xb0, 0xdc, 0x88, 0xa3, 0x81, 0x56, 0xfa,
+		0x51, 0xcf, 0xc3, 0x58, 0x6b, 0x27, 0x88, 0x48, 0x3c, 0x35, 0xb6, 0x76, 0xfc, 0x68, 0x8f, 0xc0,
+		0x78, 0x6b, 0xab, 0x15, 0xc4, 0x3b, 0xe9, 0xc6, 0x23, 0xad, 0xad, 0x96, 0x1f, 0xf1, 0x7e, 0xba,
+		0x66, 0x6f, 0x6b, 0x35, 0xd5, 0xd2, 0xea, 0xf9, 0x83, 0x6e, 0x70, 0x57, 0x01, 0x99, 0x82, 0x5c,
+		0xad, 0x56, 0xd5, 0x74, 0x75, 0xbd, 0xa9, 0x55, 0xd5, 0xb6, 0xa6, 0xab, 0x66, 0x7e, 0x82, 0x02,
+		0xa7, 0xac, 0x76, 0x47, 0x53, 0x86, 0x6b, 0xb5, 0x32, 0x2d, 0x2c, 0xd2, 0x32, 0x72, 0x12, 0x46,
+		0x8d, 0xf5, 0x37, 0xd5, 0x98, 0x46, 0x56, 0x5b, 0x6d, 0x6d, 0xa3, 0x71, 0x33, 0x7f, 0x1f, 0x15,
+		0xef, 0x08, 0x16, 0x50, 0x7d, 0x5c, 0xa1, 0xd9, 0xe4, 0x41, 0xc8, 0xd5, 0xcc, 0x2d, 0xb5, 0xdd,
+		0xa2, 0x26, 0xd9, 0x6c, 0xa9, 0x35, 0x2d, 0x7f, 0x3f, 0x03, 0x65, 0xf9, 0x4b, 0x22, 0x1b, 0x47,
+		0x84, 0x79, 0xa3, 0xb1, 0x61, 0x09, 0x8a, 0xc7, 0xd9, 0x88, 0xa0, 0x79, 0x9c, 0xda, 0x09, 0xc8,
+		0xa1, 0x24, 0x3c, 0x15, 0x9f, 0xa0, 0x60, 0xc3, 0xad, 0xad, 0x96, 0xbb, 0xde, 0x7b, 0x61, 0xa8,
+		0xb5, 0xe5, 0xae, 0xf4, 0x41, 0xe6, 0xb8, 0xb5, 0xb6, 0x5c, 0x35, 0x9e, 0x83, 0x03, 0x08, 0xb4,
+		0xad, 0x59, 0x6a, 0x5d, 0xb5, 0x54, 0x17, 0xf4, 0xab, 0x28, 0x34, 0x8a, 0x7d, 0x91, 0x17, 0x7a,
+		0xf8, 0x6c, 0x77, 0xd6, 0x77, 0x6c, 0xc5, 0x3a, 0xcd, 0xf8, 0xc4, 0x3c, 0xa1, 0x5a, 0x77, 0xcd,
+		0x39, 0x97, 0x67, 0x20, 0xeb, 0xd6, 0x7b, 0x92, 0x01, 0xa6, 0xf9, 0x39, 0x09, 0x9d, 0xa0, 0xd9,
+		0xe5, 0x39, 0x74, 0x5f, 0x9e, 0x2f, 0xe7, 0x12, 0xe8, 0x46, 0x2d, 0xcc, 0xaf, 0x96, 0xab, 0xca,
+		0xda, 0xd2, 0xea, 0xfc, 0x62, 0x39, 0x97, 0x74, 0x39, 0xf6, 0x57, 0x52, 0xe9, 0x07, 0x72, 0xc7,
+		0xe5, 0xdf, 0x48, 0xc2, 0xb0, 0x77, 0xa5, 0x46, 0x9e, 0x80, 0x83, 0x22, 0xe0, 0x62, 0x6a, 0x56,
+		0xf5, 0x46, 0xa3, 0x4d, 0x07, 0xe4, 0xb6, 0xca, 0x26, 0x47, 0x5b, 0x7f, 0xc6, 0x39, 0x54, 0x45,
+		0xb3, 0x5e, 0xdb, 0x68, 0xe3, 0x70, 0xdb, 0x56, 0x2d, 0xb2, 0x00, 0x13, 0xba, 0x51, 0x35, 0x2d,
+		0x55, 0xaf, 0xab, 0xed, 0x7a, 0xd5, 0x09, 0x75, 0x55, 0xd5, 0x5a, 0x4d, 0x33, 0x4d, 0x83, 0x4d,
+		0x84, 0x36, 0x95, 0x23, 0xba, 0x51, 0xe1, 0xc0, 0xce, 0x0c, 0x51, 0xe4, 0xa0, 0x3e, 0xf5, 0x4d,
+		0x46, 0xa9, 0xef, 0x61, 0xc8, 0x6c, 0xab, 0xad, 0xaa, 0xa6, 0x5b, 0xed, 0x1d, 0xea, 0x9f, 0xa7,
+		0x95, 0xf4, 0xb6, 0xda, 0x2a, 0x63, 0x9a, 0x5c, 0x85, 0x07, 0x1c, 0xd0, 0x6a, 0x53, 0xdb, 0x54,
+		0x6b, 0x3b, 0x55, 0xea, 0x8c, 0xd3, 0xb0, 0x41, 0xb5, 0x66, 0xe8, 0x1b, 0xcd, 0x46, 0xcd, 0x32,
+		0xf3, 0x83, 0xb6, 0x8d, 0x93, 0x1d, 0x8c, 0x05, 0x8a, 0x70, 0xc5, 0x34, 0x74, 0xea, 0x83, 0xcf,
+		0x0a, 0xe8, 0xaf, 0xcb, 0xf2, 0xeb, 0x4a, 0x2a, 0x9d, 0xca, 0xf5, 0x5d, 0x49, 0xa5, 0xfb, 0x72,
+		0xfd, 0x57, 0x52, 0xe9, 0xfe, 0xdc, 0xc0, 0x95, 0x54, 0x3a, 0x9d, 0xcb, 0x5c, 0x49, 0xa5, 0x33,
+		0x39, 0x90, 0x7f, 0x2d, 0x0d, 0x59, 0xf7, 0xca, 0x00, 0x17, 0x5a, 0x35, 0x3a, 0x37, 0x4a, 0xd4,
+		0x7a, 0xde, 0xdb, 0x75, 0x1d, 0x31, 0x35, 0x8b, 0x93, 0xe6, 0x4c, 0x3f, 0x73, 0xc3, 0x15, 0x86,
+		0x89, 0x0e, 0x0b, 0xaa, 0xb5, 0xc6, 0xdc, 0x9e, 0xb4, 0xc2, 0x53, 0xe4, 0x12, 0xf4, 0xbf, 0xc9,
+		0xa4, 0xb4, 0xfb, 0x29, 0xed, 0xfb, 0xba, 0xd3, 0xbe, 0x52, 0xa1, 0xc4, 0x33, 0x57, 0x2a, 0xd5,
+		0xa5, 0x65, 0x65, 0xb1, 0xb8, 0xa0, 0x70, 0x74, 0x72, 0x08, 0x52, 0x4d, 0xf5, 0xcd, 0x3b, 0xde,
+		0xe9, 0x95, 0x66, 0x91, 0x29, 0x18, 0xe9, 0xe8, 0xd7, 0xb5, 0x76, 0x63, 0xa3, 0x81, 0x5d, 0x85,
+		0x50, 0x23, 0x6e, 0xa8, 0x61, 0xa7, 0x74, 0x01, 0xe1, 0x7b, 0x54, 0x8f, 0x43, 0x90, 0xc2, 0xa0,
+		0xa2, 0x77, 0x12, 0xa4, 0x59, 0xe4, 0x04, 0x64, 0xeb, 0xda, 0x7a, 0x67, 0xb3, 0xda, 0xd6, 0xea,
+		0x6a, 0xcd, 0xf2, 0x9a, 0xfe, 0x41, 0x5a, 0xa4, 0xd0, 0x12, 0xf2, 0x0c, 0x64, 0xb0, 0x8f, 0x74,
+		0xda, 0xc7, 0xa3, 0x54, 0x04, 0xa7, 0xbb, 0x8b, 0x80, 0x77, 0xb1, 0x40, 0x52, 0x1c, 0x7c, 0x72,
+		0x11, 0xfa, 0x2d, 0xb5, 0xbd, 0xa9, 0x59, 0xd4, 0xf2, 0x0f, 0x9f, 0x9d, 0xea, 0x85, 0xd2, 0x2a,
+		0xc5, 0xa0, 0x6b, 0x5a, 0x8e, 0x7d, 0x17, 0xad, 0xcc, 0x34, 0xf4, 0x51, 0xf5, 0x20, 0x00, 0x5c,
+		0x41, 0x72, 0xfb, 0x48, 0x1a, 0x52, 0xb3, 0xcb, 0x0a, 0x5a, 0x9a, 0x1c, 0x64, 0x59, 0x6e, 0x75,
+		0x65, 0xbe, 0x3c, 0x5b, 0xce, 0x25, 0xe4, 0xf3, 0xd0, 0xcf, 0xfa, 0x1c, 0xad, 0x90, 0xdd, 0xeb,
+		0xb9, 0x7d, 0x3c, 0xc9, 0x69, 0x48, 0xa2, 0x74, 0x6d, 0xb1, 0x54, 0x56, 0x72, 0x09, 0x79, 0x0d,
+		0x46, 0x7c, 0x72, 0x22, 0xfb, 0x61, 0x54, 0x29, 0xaf, 0x96, 0x97, 0x70, 0x9d, 0x55, 0x5d, 0x5b,
+		0x7a, 0x66, 0x69, 0xf9, 0xb5, 0x4b, 0xb9, 0x7d, 0xde, 0x6c, 0x61, 0xd2, 0x24, 0x32, 0x0e, 0x39,
+		0x27, 0xbb, 0xb2, 0xbc, 0xa6, 0x50, 0x6e, 0xbe, 0x3b, 0x01, 0x39, 0xbf, 0xd4, 0xc8, 0x41, 0x18,
+		0x5b, 0x2d, 0x2a, 0x97, 0xca, 0xab, 0x55, 0xb6, 0x76, 0xb4, 0x49, 0x8f, 0x43, 0xce, 0x5d, 0x70,
+		0x71, 0x9e, 0x2e, 0x8d, 0x27, 0xe0, 0xb0, 0x3b, 0xb7, 0xfc, 0xec, 0x6a, 0x79, 0xa9, 0x42, 0x2b,
+		0x2f, 0x2e, 0x5d, 0x42, 0xfb, 0xea, 0xa3, 0x27, 0x56, 0xab, 0x49, 0x64, 0xd5, 0x4b, 0xaf, 0xbc,
+		0x30, 0x97, 0x4b, 0xf9, 0xb3, 0x97, 0x97, 0xca, 0xcb, 0x17, 0x73, 0x7d, 0xfe, 0xda, 0xe9, 0x0a,
+		0xb6, 0x9f, 0x14, 0xe0, 0x80, 0x3f, 0xb7, 0x5a, 0x5e, 0x5a, 0x55, 0x9e, 0xcb, 0x0d, 0xf8, 0x2b,
+		0xae, 0x94, 0x95, 0xab, 0xf3, 0xb3, 0xe5, 0x5c, 0x9a, 0x1c, 0x00, 0xe2, 0xe5, 0x68, 0xf5, 0xf2,
+		0xf2, 0x5c, 0x2e, 0x13, 0xb0, 0x28, 0xb2, 0x09, 0x59, 0xf7, 0x32, 0xf2, 0xeb, 0x13, 0x4b, 0x7a,
+		0x6f, 0x02, 0x06, 0x5d, 0xcb, 0x42, 0xf4, 0xe7, 0xd5, 0x66, 0xd3, 0xb8, 0x51, 0x55, 0x9b, 0x0d,
+		0xd5, 0xe4, 0xf6, 0x06, 0x68, 0x56, 0x11, 0x73, 0x7a, 0x1d, 0xdf, 0xbd, 0x5b, 0xf8, 0xfe, 0x57,
+		0xa2, 0x85, 0xef, 0xcb, 0xf5, 0xcb, 0x1f, 0x90, 0x20, 0xe7, 0x5f, 0xef, 0xf9, 0x9a, 0x2f, 0x45,
+		0x35, 0xff, 0xeb, 0xd2, 0x77, 0xef, 0x97, 0x60, 0xd8, 0xbb, 0xc8, 0xf3, 0xb1, 0x77, 0xec, 0x5f,
+		0x95, 0xbd, 0xbf, 0x4a, 0xc0, 0x90, 0x67, 0x69, 0xd7, 0x2b, 0x77, 0x2f, 0xc0, 0x68, 0xa3, 0xae,
+		0x6d, 0xb7, 0x0c, 0x0b, 0x77, 0x9b, 0xaa, 0x4d, 0xed, 0xba, 0xd6, 0xcc, 0xcb, 0xd4, 0x28, 0x4f,
+		0x77, 0x5f, 0x3c, 0x4e, 0xcd, 0x3b, 0x78, 0x0b, 0x88, 0x36, 0x33, 0x36, 0x3f, 0x57, 0x5e, 0x5c,
+		0x59, 0x5e, 0x2d, 0x2f, 0xcd, 0x3e, 0x27, 0xac, 0x8b, 0x92, 0x6b, 0xf8, 0xc0, 0xee, 0xa2, 0xd1,
+		0x5e, 0x81, 0x9c, 0x9f, 0x29, 0xb4, 0x15, 0x21, 0x6c, 0xe5, 0xf6, 0x91, 0x31, 0x18, 0x59, 0x5a,
+		0xae, 0x56, 0xe6, 0xe7, 0xca, 0xd5, 0xf2, 0xc5, 0x8b, 0xe5, 0xd9, 0xd5, 0x0a, 0x0b, 0x07, 0xda,
+		0xd0, 0xab, 0xb9, 0x84, 0x5b, 0xc4, 0x3f, 0x96, 0x84, 0xb1, 0x10, 0x4e, 0x48, 0x91, 0x2f, 0xe4,
+		0x59, 0x6c, 0xe1, 0x74, 0x2f, 0xdc, 0x4f, 0xa1, 0x2b, 0xbd, 0xa2, 0xb6, 0x2d, 0xbe, 0xee, 0x7f,
+		0x10, 0x50, 0x4a, 0xba, 0x85, 0x33, 0x7b, 0x9b, 0x87, 0x59, 0xd9, 0xea, 0x7e, 0xc4, 0xc9, 0x67,
+		0x91, 0xd6, 0x57, 0x01, 0x69, 0x19, 0x66, 0xc3, 0x6a, 0x5c, 0xc7, 0x3d, 0x2c, 0x11, 0x93, 0xc5,
+		0xd5, 0x7e, 0x4a, 0xc9, 0x89, 0x92, 0x79, 0xdd, 0xb2, 0xa1, 0x75, 0x6d, 0x53, 0xf5, 0x41, 0xa3,
+		0xe7, 0x91, 0x54, 0x72, 0xa2, 0xc4, 0x86, 0x3e, 0x06, 0xd9, 0xba, 0xd1, 0xc1, 0x25, 0x10, 0x83,
+		0x43, 0x6b, 0x21, 0x29, 0x83, 0x2c, 0xcf, 0x06, 0xe1, 0x8b, 0x5b, 0x27, 0x18, 0x9c, 0x55, 0x06,
+		0x59, 0x1e, 0x03, 0x39, 0x0e, 0x23, 0xea, 0xe6, 0x66, 0x1b, 0x89, 0x0b, 0x42, 0x6c, 0xb9, 0x3e,
+		0x6c, 0x67, 0x53, 0xc0, 0xc2, 0x15, 0x48, 0x0b, 0x39, 0xa0, 0x07, 0x8b, 0x92, 0xa8, 0xb6, 0x58,
+		0x0c, 0x2a, 0x81, 0xf1, 0x61, 0x5d, 0x14, 0x1e, 0x83, 0x6c, 0xc3, 0xac, 0x3a, 0x7b, 0x5b, 0x89,
+		0xc9, 0xc4, 0x89, 0xb4, 0x32, 0xd8, 0x30, 0xed, 0x7d, 0x01, 0xf9, 0xc3, 0x09, 0x18, 0xf6, 0xee,
+		0xda, 0x91, 0x39, 0x48, 0x37, 0x8d, 0x9a, 0x4a, 0x55, 0x8b, 0x6d, 0x19, 0x9f, 0x88, 0xd9, 0xe8,
+		0x9b, 0x5a, 0xe0, 0xf0, 0x8a, 0x8d, 0x59, 0xf8, 0x23, 0x09, 0xd2, 0x22, 0x9b, 0x1c, 0x80, 0x54,
+		0x4b, 0xb5, 0xb6, 0x28, 0xb9, 0xbe, 0x52, 0x22, 0x27, 0x29, 0x34, 0x8d, 0xf9, 0x66, 0x4b, 0xd5,
+		0xf3, 0x09, 0x27, 0x1f, 0xd3, 0xd8, 0xaf, 0x4d, 0x4d, 0xad, 0xd3, 0x58, 0x80, 0xb1, 0xbd, 0xad,
+		0xe9, 0x96, 0x29, 0xfa, 0x95, 0xe7, 0xcf, 0xf2, 0x6c, 0xdc, 0x3c, 0xb6, 0xda, 0x6a, 0xa3, 0xe9,
+		0x81, 0x4d, 0x51, 0xd8, 0x9c, 0x28, 0xb0, 0x81, 0x67, 0xe0, 0x90, 0xa0, 0x5b, 0xd7, 0x2c, 0xb5,
+		0xb6, 0xa5, 0xd5, 0x1d, 0xa4, 0x7e, 0x1a, 0xf3, 0x3b, 0xc8, 0x01, 0xe6, 0x78, 0xb9, 0xc0, 0x95,
+		0x3f, 0x9d, 0x80, 0x51, 0x11, 0xbd, 0xa8, 0xdb, 0xc2, 0x5a, 0x04, 0x50, 0x75, 0xdd, 0xb0, 0xdc,
+		0xe2, 0x0a, 0xaa, 0x72, 0x00, 0x6f, 0xaa, 0x68, 0x23, 0x29, 0x2e, 0x02, 0x85, 0x2f, 0x4a, 0x00,
+		0x4e, 0x51, 0xa4, 0xdc, 0x26, 0x60, 0x90, 0xef, 0xc9, 0xd2, 0x8d, 0x7d, 0x16, 0xf0, 0x02, 0x96,
+		0x85, 0x71, 0x0e, 0x0c, 0x4b, 0xae, 0x6b, 0x9b, 0x0d, 0x9d, 0xef, 0xa7, 0xb0, 0x84, 0x08, 0x4b,
+		0xa6, 0x9c, 0xed, 0x29, 0x05, 0xd2, 0xa6, 0xb6, 0xad, 0xea, 0x56, 0xa3, 0xc6, 0x77, 0x48, 0x2e,
+		0xec, 0x8a, 0xf9, 0xa9, 0x0a, 0xc7, 0x56, 0x6c, 0x3a, 0xf2, 0x09, 0x48, 0x8b, 0x5c, 0x74, 0xfc,
+		0x96, 0x96, 0x97, 0xca, 0xb9, 0x7d, 0x64, 0x00, 0x92, 0x95, 0xf2, 0x6a, 0x4e, 0xc2, 0x65, 0x67,
+		0x71, 0x61, 0xbe, 0x58, 0xc9, 0x25, 0x4a, 0xff, 0x2b, 0x8c, 0xd5, 0x8c, 0x6d, 0x7f, 0x85, 0xa5,
+		0x9c, 0x2f, 0xe4, 0x67, 0x5e, 0x96, 0x9e, 0x3f, 0xcd, 0x81, 0x36, 0x8d, 0xa6, 0xaa, 0x6f, 0x4e,
+		0x19, 0xed, 0x4d, 0xe7, 0x58, 0x04, 0xae, 0x0e, 0x4c, 0xd7, 0xe1, 0x88, 0xd6, 0xfa, 0x57, 0x25,
+		0xe9, 0x27, 0x12, 0xc9, 0x4b, 0x2b, 0xa5, 0x9f, 0x4b, 0x14, 0x2e, 0x31, 0xc4, 0x15, 0xd1, 0x1c,
+		0x45, 0xdb, 0x68, 0x6a, 0x35, 0x64, 0x1e, 0xfe, 0xee, 0x14, 0x8c, 0x6f, 0x1a, 0x9b, 0x06, 0xa5,
+		0x34, 0x8d, 0xbf, 0x18, 0x13, 0x24, 0x63, 0xe7, 0x16, 0x62, 0x0f, 0x61, 0xcc, 0x2c, 0xc1, 0x18,
+		0x07, 0xae, 0xd2, 0xed, 0x5b, 0x16, 0x5c, 0x20, 0x5d, 0x23, 0xdb, 0xf9, 0x5f, 0xfe, 0x1b, 0xea,
+		0x95, 0x28, 0xa3, 0x1c, 0x15, 0xcb, 0x58, 0xfc, 0x61, 0x46, 0x81, 0xfd, 0x1e, 0x7a, 0xcc, 0x46,
+		0x68, 0xed, 0x18, 0x8a, 0xbf, 0xc7, 0x29, 0x8e, 0xb9, 0x28, 0x56, 0x38, 0xea, 0xcc, 0x2c, 0x0c,
+		0xed, 0x86, 0xd6, 0xef, 0x73, 0x5a, 0x59, 0xcd, 0x4d, 0xe4, 0x12, 0x8c, 0x50, 0x22, 0xb5, 0x8e,
+		0x69, 0x19, 0xdb, 0xd4, 0x00, 0x77, 0x27, 0xf3, 0x7f, 0xff, 0x0d, 0x1b, 0xb4, 0xc3, 0x88, 0x36,
+		0x6b, 0x63, 0xcd, 0xcc, 0x00, 0xdd, 0xb1, 0xc6, 0x9d, 0xe4, 0x18, 0x0a, 0x7f, 0xc0, 0x19, 0xb1,
+		0xe1, 0x67, 0xae, 0xc2, 0x38, 0xfe, 0xa6, 0xf6, 0xd1, 0xcd, 0x49, 0x7c, 0x18, 0x3c, 0xff, 0xff,
+		0xbf, 0x83, 0xd9, 0x85, 0x31, 0x9b, 0x80, 0x8b, 0x27, 0x57, 0x2f, 0x6e, 0x6a, 0x96, 0xa5, 0xb5,
+		0xcd, 0xaa, 0xda, 0x0c, 0x63, 0xcf, 0x15, 0x47, 0xcc, 0xff, 0xe8, 0x97, 0xbc, 0xbd, 0x78, 0x89,
+		0x61, 0x16, 0x9b, 0xcd, 0x99, 0x35, 0x38, 0x18, 0xa2, 0x15, 0x3d, 0xd0, 0xfc, 0x31, 0x4e, 0x73,
+		0x3c, 0xa0, 0x19, 0x48, 0x76, 0x05, 0x44, 0xbe, 0xdd, 0x97, 0x3d, 0xd0, 0x7c, 0x1f, 0xa7, 0x49,
+		0x38, 0xae, 0xe8, 0x52, 0xa4, 0x78, 0x05, 0x46, 0xaf, 0x6b, 0xed, 0x75, 0xc3, 0xe4, 0xb1, 0xdb,
+		0x1e, 0xc8, 0xbd, 0x9f, 0x93, 0x1b, 0xe1, 0x88, 0x34, 0x98, 0x8b, 0xb4, 0x1e, 0x83, 0xf4, 0x86,
+		0x5a, 0xd3, 0x7a, 0x20, 0x71, 0x8b, 0x93, 0x18, 0x40, 0x78, 0x44, 0x2d, 0x42, 0x76, 0xd3, 0xe0,
+		0x53, 0x64, 0x3c, 0xfa, 0x07, 0x38, 0xfa, 0xa0, 0xc0, 0xe1, 0x24, 0x5a, 0x46, 0xab, 0xd3, 0xc4,
+		0xf9, 0x33, 0x9e, 0xc4, 0x8f, 0x0b, 0x12, 0x02, 0x87, 0x93, 0xd8, 0x85, 0x58, 0x3f, 0x28, 0x48,
+		0x98, 0x2e, 0x79, 0x3e, 0x8d, 0x5b, 0xba, 0xcd, 0x1d, 0x43, 0xef, 0x85, 0x89, 0x0f, 0x71, 0x0a,
+		0xc0, 0x51, 0x90, 0xc0, 0xe3, 0x90, 0xe9, 0xb5, 0x23, 0x7e, 0xfa, 0x4b, 0x62, 0x78, 0x88, 0x1e,
+		0xb8, 0x04, 0x23, 0xc2, 0x40, 0xe1, 0x11, 0x90, 0x78, 0x12, 0x3f, 0xc3, 0x49, 0x0c, 0xbb, 0xd0,
+		0x78, 0x33, 0x2c, 0xcd, 0xb4, 0x36, 0xb5, 0x5e, 0x88, 0x7c, 0x58, 0x34, 0x83, 0xa3, 0x70, 0x51,
+		0xae, 0x6b, 0x7a, 0x6d, 0xab, 0x37, 0x0a, 0x1f, 0x11, 0xa2, 0x14, 0x38, 0x48, 0x62, 0x16, 0x86,
+		0xb6, 0xd5, 0xb6, 0xb9, 0xa5, 0x36, 0x7b, 0xea, 0x8e, 0x9f, 0xe5, 0x34, 0xb2, 0x36, 0x12, 0x97,
+		0x48, 0x47, 0xdf, 0x0d, 0x99, 0x9f, 0x13, 0x12, 0xe9, 0xe8, 0x1e, 0x42, 0x2b, 0x30, 0x6e, 0x5a,
+		0x34, 0xd0, 0xbd, 0x1b, 0x6a, 0x3f, 0x2f, 0x86, 0x1e, 0xc3, 0x5d, 0x74, 0x53, 0x7c, 0x1c, 0x32,
+		0x66, 0xe3, 0xcd, 0x3d, 0x91, 0xf9, 0x05, 0xd1, 0xd3, 0x14, 0x01, 0x91, 0x9f, 0x83, 0x43, 0xa1,
+		0xd3, 0x44, 0x0f, 0xc4, 0x7e, 0x91, 0x13, 0x3b, 0x10, 0x32, 0x55, 0x70, 0x93, 0xb0, 0x5b, 0x92,
+		0xbf, 0x24, 0x4c, 0x82, 0xe6, 0xa3, 0xb5, 0x82, 0x8b, 0x16, 0x53, 0xdd, 0xd8, 0x9d, 0xd4, 0xfe,
+		0x77, 0x21, 0x35, 0x86, 0xeb, 0x91, 0xda, 0x2a, 0x1c, 0xe0, 0x14, 0x77, 0xd7, 0xaf, 0x1f, 0x15,
+		0x86, 0x95, 0x61, 0xaf, 0x79, 0x7b, 0xf7, 0x5b, 0xa0, 0x60, 0x8b, 0x53, 0x78, 0xc7, 0x66, 0x15,
+		0xa3, 0xc3, 0xf1, 0x94, 0x7f, 0x99, 0x53, 0x16, 0x16, 0xdf, 0x76, 0xaf, 0xcd, 0x45, 0xb5, 0x85,
+		0xc4, 0x9f, 0x85, 0xbc, 0x20, 0xde, 0xd1, 0xdb, 0x5a, 0xcd, 0xd8, 0xd4, 0x1b, 0x6f, 0xd6, 0xea,
+		0x3d, 0x90, 0xfe, 0x15, 0x5f, 0x57, 0xad, 0xb9, 0xd0, 0x91, 0xf2, 0x3c, 0xe4, 0x6c, 0x5f, 0xa5,
+		0xda, 0xd8, 0x6e, 0x19, 0x6d, 0x2b, 0x86, 0xe2, 0xaf, 0x8a, 0x9e, 0xb2, 0xf1, 0xe6, 0x29, 0xda,
+		0x4c, 0x19, 0xd8, 0xe9, 0x8f, 0x5e, 0x55, 0xf2, 0x25, 0x4e, 0x68, 0xc8, 0xc1, 0xe2, 0x86, 0xa3,
+		0x66, 0x6c, 0xb7, 0xd4, 0x76, 0x2f, 0xf6, 0xef, 0x63, 0xc2, 0x70, 0x70, 0x14, 0x6e, 0x38, 0xd0,
+		0xa3, 0xc3, 0xd9, 0xbe, 0x07, 0x0a, 0x1f, 0x17, 0x86, 0x43, 0xe0, 0x70, 0x12, 0xc2, 0x61, 0xe8,
+		0x81, 0xc4, 0xaf, 0x09, 0x12, 0x02, 0x07, 0x49, 0xbc, 0xc6, 0x99, 0x68, 0xdb, 0xda, 0x66, 0xc3,
+		0xb4, 0xda, 0xcc, 0x25, 0xef, 0x4e, 0xea, 0x13, 0x5f, 0xf2, 0x3a, 0x61, 0x8a, 0x0b, 0x15, 0x2d,
+		0x11, 0xdf, 0xfa, 0xa0, 0x4b, 0xb6, 0x78, 0xc6, 0x3e, 0x29, 0x2c, 0x91, 0x0b, 0x0d, 0x79, 0x73,
+		0x79, 0x88, 0x28, 0xf6, 0x1a, 0x2e, 0x54, 0x7a, 0x20, 0xf7, 0xeb, 0x3e, 0xe6, 0x2a, 0x02, 0x17,
+		0x69, 0xba, 0xfc, 0x9f, 0x8e, 0x7e, 0x4d, 0xdb, 0xe9, 0x49, 0x3b, 0x7f, 0xc3, 0xe7, 0xff, 0xac,
+		0x31, 0x4c, 0x66, 0x43, 0x46, 0x7c, 0xfe, 0x14, 0x89, 0x3b, 0xeb, 0x97, 0xff, 0xb6, 0x2f, 0xf3,
+		0xf6, 0x7a, 0xdd, 0xa9, 0x99, 0x05, 0xc8, 0xf1, 0x1c, 0xc7, 0x81, 0x8d, 0x25, 0xf6, 0x8e, 0x2f,
+		0xdb, 0x7a, 0xee, 0xf1, 0x79, 0x66, 0x2e, 0xc2, 0x90, 0xc7, 0xe1, 0x89, 0x27, 0xf5, 0x4e, 0x4e,
+		0x2a, 0xeb, 0xf6, 0x77, 0x66, 0xce, 0x43, 0x0a, 0x9d, 0x97, 0x78, 0xf4, 0x6f, 0xe7, 0xe8, 0x14,
+		0x7c, 0xe6, 0x49, 0x48, 0x0b, 0xa7, 0x25, 0x1e, 0xf5, 0x5d, 0x1c, 0xd5, 0x46, 0x41, 0x74, 0xe1,
+		0xb0, 0xc4, 0xa3, 0x7f, 0x87, 0x40, 0x17, 0x28, 0x88, 0xde, 0xbb, 0x08, 0xff, 0xcf, 0xef, 0x4a,
+		0x31, 0x74, 0x81, 0x32, 0x83, 0xa7, 0x4f, 0x98, 0xa7, 0x12, 0x8f, 0xfd, 0x6e, 0x5e, 0xb9, 0xc0,
+		0x98, 0x79, 0x04, 0xfa, 0x7a, 0x14, 0xf8, 0xf7, 0x70, 0x54, 0x06, 0x3f, 0x33, 0x0b, 0x83, 0x2e,
+		0xef, 0x24, 0x1e, 0xfd, 0x7b, 0x39, 0xba, 0x1b, 0x0b, 0x59, 0xe7, 0xde, 0x49, 0x3c, 0x81, 0xef,
+		0x13, 0xac, 0x73, 0x0c, 0x14, 0x9b, 0x70, 0x4c, 0xe2, 0xb1, 0xdf, 0x23, 0xa4, 0x2e, 0x50, 0x66,
+		0x9e, 0x86, 0x8c, 0x3d, 0xd9, 0xc4, 0xe3, 0x7f, 0x3f, 0xc7, 0x77, 0x70, 0x50, 0x02, 0x1d, 0x7d,
+		0x17, 0x24, 0xfe, 0x37, 0x21, 0x01, 0x17, 0x16, 0x0e, 0x23, 0xbf, 0x03, 0x13, 0x4f, 0xe9, 0x07,
+		0xc4, 0x30, 0xf2, 0xf9, 0x2f, 0xd8, 0x9b, 0xd4, 0xe6, 0xc7, 0x93, 0xf8, 0x41, 0xd1, 0x9b, 0x14,
+		0x1e, 0xd9, 0xf0, 0x7b, 0x04, 0xf1, 0x34, 0x7e, 0x44, 0xb0, 0xe1, 0x73, 0x08, 0x66, 0x56, 0x80,
+		0x04, 0xbd, 0x81, 0x78, 0x7a, 0xef, 0xe5, 0xf4, 0x46, 0x03, 0xce, 0xc0, 0xcc, 0x6b, 0xe1, 0x40,
+		0xb8, 0x27, 0x10, 0x4f, 0xf5, 0x47, 0xbf, 0xec, 0x5b, 0xbb, 0xb9, 0x1d, 0x81, 0x99, 0x55, 0x18,
+		0x0f, 0xf3, 0x02, 0xe2, 0xc9, 0xfe, 0xd8, 0x97, 0xbd, 0x86, 0xdb, 0xed, 0x04, 0xcc, 0x14, 0x01,
+		0x9c, 0x09, 0x38, 0x9e, 0xd6, 0xfb, 0x39, 0x2d, 0x17, 0x12, 0x0e, 0x0d, 0x3e, 0xff, 0xc6, 0xe3,
+		0xdf, 0x12, 0x43, 0x83, 0x63, 0xe0, 0xd0, 0x10, 0x53, 0x6f, 0x3c, 0xf6, 0x07, 0xc4, 0xd0, 0x10,
+		0x28, 0xa8, 0xd9, 0xae, 0xd9, 0x2d, 0x9e, 0xc2, 0x87, 0x84, 0x66, 0xbb, 0xb0, 0x66, 0x96, 0x60,
+		0x34, 0x30, 0x21, 0xc6, 0x93, 0xfa, 0x09, 0x4e, 0x2a, 0xe7, 0x9f, 0x0f, 0xdd, 0x93, 0x17, 0x9f,
+		0x0c, 0xe3, 0xa9, 0xfd, 0xa4, 0x6f, 0xf2, 0xe2, 0x73, 0xe1, 0xcc, 0xe3, 0x90, 0xd6, 0x3b, 0xcd,
+		0x26, 0x0e, 0x1e, 0xd2, 0xfd, 0x7c, 0x6e, 0xfe, 0x3f, 0x7e, 0x8d, 0x4b, 0x47, 0x20, 0xcc, 0x9c,
+		0x87, 0x3e, 0x6d, 0x7b, 0x5d, 0xab, 0xc7, 0x61, 0xfe, 0xed, 0xd7, 0x84, 0xc1, 0x44, 0xe8, 0x99,
+		0xa7, 0x01, 0x58, 0x68, 0x84, 0x6e, 0x9c, 0xc7, 0xe0, 0x7e, 0xf1, 0x6b, 0xfc, 0x40, 0x9c, 0x83,
+		0xe2, 0x10, 0x60, 0xc7, 0xeb, 0xba, 0x13, 0xf8, 0x92, 0x97, 0x00, 0xed, 0x91, 0xc7, 0x60, 0x00,
+		0x37, 0xd2, 0x2c, 0x75, 0x33, 0x0e, 0xfb, 0xef, 0x38, 0xb6, 0x80, 0x47, 0x81, 0x6d, 0x1b, 0x6d,
+		0xcd, 0x52, 0x37, 0xcd, 0x38, 0xdc, 0xbf, 0xe7, 0xb8, 0x36, 0x02, 0x22, 0xd7, 0x54, 0xd3, 0xea,
+		0xa5, 0xdd, 0xff, 0x20, 0x90, 0x05, 0x02, 0x32, 0x8d, 0xbf, 0xaf, 0x69, 0x3b, 0x71, 0xb8, 0xff,
+		0x28, 0x98, 0xe6, 0xf0, 0x33, 0x4f, 0x42, 0x06, 0x7f, 0xb2, 0x53, 0xae, 0x31, 0xc8, 0xff, 0xc4,
+		0x91, 0x1d, 0x0c, 0xac, 0xd9, 0xb4, 0xea, 0x56, 0x23, 0x5e, 0xd8, 0xff, 0xcc, 0x7b, 0x5a, 0xc0,
+		0xcf, 0x14, 0x61, 0xd0, 0xb4, 0xea, 0xf5, 0x0e, 0xf7, 0x4f, 0x63, 0xd0, 0xff, 0xd3, 0xd7, 0xec,
+		0x90, 0x85, 0x8d, 0x83, 0xbd, 0x7d, 0xe3, 0x9a, 0xd5, 0x32, 0xe8, 0x7e, 0x4b, 0x1c, 0x85, 0x2f,
+		0x73, 0x0a, 0x2e, 0x94, 0x99, 0x59, 0xc8, 0x62, 0x5b, 0xda, 0x5a, 0x4b, 0xa3, 0x9b, 0x63, 0x31,
+		0x24, 0xbe, 0xc2, 0x05, 0xe0, 0x41, 0x2a, 0xbd, 0xf1, 0x0f, 0x3e, 0x7b, 0x54, 0xfa, 0xf4, 0x67,
+		0x8f, 0x4a, 0x7f, 0xf5, 0xd9, 0xa3, 0xd2, 0x7b, 0x3e, 0x77, 0x74, 0xdf, 0xa7, 0x3f, 0x77, 0x74,
+		0xdf, 0x9f, 0x7d, 0xee, 0xe8, 0xbe, 0xf0, 0x28, 0x31, 0x5c, 0x32, 0x2e, 0x19, 0x2c, 0x3e, 0xfc,
+		0xfc, 0xfd, 0x9b, 0x0d, 0x6b, 0xab, 0xb3, 0x3e, 0x55, 0x33, 0xb6, 0xa7, 0x6b, 0x86, 0xb9, 0x6d,
+		0x98, 0xd3, 0xde, 0xb8, 0x2e, 0xfd, 0x05, 0xff, 0x5d, 0x82, 0x43, 0x8c, 0x8c, 0x13, 0xce, 0x55,
+		0xf5, 0x9d, 0xa8, 0xcb, 0x74, 0x17, 0x20, 0x59, 0xd4, 0x77, 0xc8, 0x21, 0x66, 0xe0, 0xaa, 0x9d,
+		0x76, 0x93, 0x1f, 0xb5, 0x1c, 0xc0, 0xf4, 0x5a, 0xbb, 0x89, 0x61, 0x76, 0x71, 0x1e, 0x1a, 0xb7,
+		0x73, 0x58, 0xa2, 0xf4, 0xbd, 0xd2, 0xee, 0x5a, 0x92, 0x2e, 0xea, 0x3b, 0xb4, 0x21, 0x2b, 0xd2,
+		0xf3, 0xaf, 0x8a, 0x8d, 0x73, 0x5f, 0xd3, 0x8d, 0x1b, 0x3a, 0xb2, 0xdd, 0x5a, 0x17, 0x31, 0xee,
+		0xa3, 0xfe, 0x18, 0xf7, 0x6b, 0xb5, 0x66, 0xf3, 0x19, 0x84, 0xc3, 0xe3, 0x0b, 0xe6, 0x7a, 0x3f,
+		0x3b, 0xd5, 0x0f, 0x3f, 0x90, 0x80, 0xa3, 0x81, 0x70, 0x36, 0x57, 0x82, 0x28, 0x21, 0xcc, 0x40,
+		0x7a, 0x4e, 0xe8, 0x56, 0x1e, 0xaf, 0xb2, 0xd5, 0x0c, 0xbd, 0x6e, 0x52, 0x41, 0x24, 0x15, 0x91,
+		0x44, 0x41, 0xe8, 0xaa, 0x6e, 0x98, 0xfc, 0xb0, 0x32, 0x4b, 0x94, 0xde, 0xb7, 0x4b, 0x41, 0x0c,
+		0x89, 0x9a, 0x84, 0x34, 0xce, 0xf4, 0x28, 0x0d, 0xd1, 0x08, 0x4f, 0xe4, 0xbf, 0x57, 0xa9, 0xfc,
+		0x48, 0x02, 0x26, 0xfc, 0x52, 0xc1, 0x91, 0x65, 0x5a, 0xea, 0x76, 0x2b, 0x4a, 0x2c, 0x8f, 0x43,
+		0x66, 0x55, 0xc0, 0xec, 0x5a, 0x2e, 0xb7, 0x76, 0x29, 0x97, 0x61, 0xbb, 0x2a, 0x21, 0x98, 0xb3,
+		0x3d, 0x0a, 0xc6, 0x6e, 0xc7, 0x9e, 0x24, 0xf3, 0xbe, 0x0c, 0x1c, 0x62, 0xc3, 0xa9, 0xca, 0x86,
+		0x12, 0x4b, 0x70, 0x99, 0x64, 0xdd, 0x45, 0xf1, 0xfb, 0x24, 0xf2, 0x33, 0x30, 0x36, 0x8f, 0xd6,
+		0x02, 0x57, 0x41, 0xce, 0x0e, 0x4f, 0xe8, 0x79, 0xee, 0x49, 0x8f, 0xc3, 0xcf, 0xf7, 0xb7, 0xdc,
+		0x59, 0xf2, 0xb7, 0x49, 0x90, 0xab, 0xd4, 0xd4, 0xa6, 0xda, 0xbe, 0x5d, 0x52, 0xe4, 0x11, 0x00,
+		0x76, 0xdc, 0xc3, 0xbe, 0xb8, 0x37, 0x7c, 0x36, 0x3f, 0xe5, 0x6e, 0xdc, 0x14, 0xab, 0x89, 0x9e,
+		0xa0, 0xca, 0x50, 0x58, 0xfc, 0x79, 0xf2, 0x59, 0x00, 0xa7, 0x80, 0x1c, 0x86, 0x83, 0x95, 0xd9,
+		0xe2, 0x42, 0x51, 0x11, 0x87, 0x84, 0x2a, 0x2b, 0xe5, 0xd9, 0xf9, 0x8b, 0xf3, 0xe5, 0xb9, 0xdc,
+		0x3e, 0x3c, 0x5f, 0xe3, 0x2e, 0xb4, 0x0f, 0x35, 0xed, 0x87, 0x51, 0x77, 0x3e, 0xbb, 0xa5, 0x92,
+		0x98, 0xb9, 0x8c, 0x91, 0x02, 0x3c, 0x32, 0x50, 0x55, 0xeb, 0x75, 0xad, 0x5e, 0x6d, 0xe8, 0x24,
+		0xe6, 0x44, 0x7a, 0xfe, 0x0f, 0xff, 0xb4, 0x8f, 0x36, 0x6d, 0x88, 0x21, 0x16, 0x11, 0x6f, 0x5e,
+		0x47, 0x9f, 0xb3, 0xb1, 0xdd, 0x6a, 0x6a, 0x74, 0x0f, 0xb3, 0xda, 0x10, 0xf2, 0x8f, 0x77, 0x67,
+		0x90, 0x1e, 0xee, 0x87, 0x8e, 0x39, 0xe8, 0x76, 0xef, 0xcd, 0x3c, 0x03, 0x39, 0x71, 0xd4, 0xd3,
+		0x66, 0x30, 0x96, 0xe2, 0xa7, 0x38, 0x87, 0x22, 0x9a, 0x21, 0x58, 0x5c, 0x80, 0x51, 0xb5, 0x56,
+		0xd3, 0x5a, 0x1e, 0xfe, 0x62, 0x66, 0x10, 0xd1, 0xda, 0x1c, 0xc7, 0x74, 0x58, 0x7b, 0x04, 0xfa,
+		0x4d, 0xda, 0x29, 0x71, 0x24, 0x04, 0x3b, 0x1c, 0x1c, 0xc3, 0x5f, 0x4c, 0x0d, 0xec, 0x16, 0xc5,
+		0x10, 0xf8, 0x7f, 0x38, 0x81, 0x2c, 0x45, 0x13, 0xad, 0xd1, 0x61, 0x14, 0xfd, 0x5a, 0x0c, 0x7f,
+		0x39, 0xad, 0xe9, 0x1e, 0x45, 0xf9, 0xad, 0x5f, 0x7d, 0x88, 0xee, 0x1b, 0x1f, 0xf3, 0x2a, 0x5d,
+		0xc8, 0x60, 0x51, 0x72, 0x9c, 0xb6, 0xd3, 0x5e, 0x0d, 0x86, 0x45, 0x7d, 0xbc, 0xdd, 0xdd, 0x2b,
+		0xfb, 0x6d, 0x5e, 0xd9, 0xd1, 0x30, 0x0d, 0x77, 0xd5, 0x34, 0xc4, 0xa9, 0xb2, 0x82, 0x99, 0x12,
+		0x0c, 0xe1, 0x56, 0xb3, 0x23, 0x9c, 0xee, 0xb5, 0xfc, 0x1f, 0xbf, 0xfa, 0x10, 0x1b, 0x68, 0x88,
+		0xc4, 0x45, 0x53, 0x2a, 0x47, 0x59, 0xbd, 0xe7, 0x4f, 0x05, 0xe7, 0x6f, 0xf6, 0xef, 0x34, 0xa5,
+		0xfe, 0xb8, 0x9b, 0x55, 0xc7, 0x3a, 0xa5, 0x60, 0x14, 0x2f, 0x48, 0x18, 0xd3, 0xf4, 0x2f, 0xb7,
+		0x4a, 0x7d, 0x34, 0xd1, 0xc3, 0xb6, 0xed, 0x05, 0x66, 0x2c, 0xe2, 0xf5, 0xf6, 0x9f, 0xbe, 0xfb,
+		0x67, 0xfa, 0x1c, 0x83, 0x32, 0xb3, 0xe8, 0xe8, 0xbe, 0xa6, 0xd7, 0x8c, 0x7a, 0x4f, 0x71, 0x9c,
+		0x7f, 0x16, 0x34, 0x44, 0x04, 0xb0, 0xcc, 0x51, 0x67, 0x9e, 0xc0, 0xbd, 0x50, 0x4e, 0x26, 0xce,
+		0x77, 0x13, 0x44, 0x6c, 0x0c, 0xf4, 0xdc, 0x98, 0xd2, 0xf6, 0xe2, 0xa7, 0x7f, 0x59, 0xe0, 0x33,
+		0x1b, 0x86, 0x67, 0x48, 0x66, 0x2e, 0xc1, 0x70, 0xdd, 0xd0, 0xad, 0xaa, 0xb1, 0xdd, 0xb0, 0xb4,
+		0xed, 0x96, 0x15, 0xeb, 0xf9, 0x7e, 0x85, 0x11, 0x49, 0x2b, 0x43, 0x88, 0xb7, 0x2c, 0xd0, 0x90,
+		0x13, 0x76, 0x93, 0xb1, 0x17, 0x4e, 0xfe, 0xb3, 0xcd, 0x09, 0xc5, 0x41, 0x4e, 0xf6, 0xa4, 0x1d,
+		0x66, 0xfd, 0x1a, 0x9f, 0xee, 0xac, 0x9b, 0x4c, 0x0b, 0x6c, 0xed, 0xf8, 0x70, 0x12, 0x8e, 0x72,
+		0xe0, 0x75, 0xd5, 0xd4, 0xa6, 0xaf, 0x9f, 0x59, 0xd7, 0x2c, 0xf5, 0xcc, 0x74, 0xcd, 0x68, 0x08,
+		0x5f, 0x67, 0x8c, 0x95, 0x4f, 0x61, 0xf9, 0x14, 0x2f, 0x2f, 0x84, 0x1e, 0x08, 0x28, 0x44, 0x4f,
+		0x83, 0x85, 0xa0, 0x0e, 0xca, 0x4d, 0x48, 0xcd, 0x1a, 0x0d, 0x1d, 0x67, 0xff, 0xba, 0xa6, 0x1b,
+		0xdb, 0x7c, 0x42, 0x62, 0x09, 0x72, 0x19, 0xfa, 0xd5, 0x6d, 0xa3, 0xa3, 0x5b, 0x6c, 0x32, 0x2a,
+		0x3d, 0xf4, 0x07, 0x2f, 0x4f, 0xec, 0xfb, 0xf3, 0x97, 0x27, 0xf6, 0x33, 0xb2, 0x66, 0xfd, 0xda,
+		0x54, 0xc3, 0x98, 0xde, 0x56, 0xad, 0x2d, 0x34, 0x01, 0x9f, 0x79, 0xe9, 0x34, 0xf0, 0xfa, 0xe6,
+		0x75, 0xeb, 0x23, 0x9f, 0xff, 0xe8, 0x49, 0x49, 0xe1, 0xf8, 0x33, 0xa9, 0x2f, 0x7c, 0x70, 0x42,
+		0x92, 0x5b, 0x30, 0x30, 0xa7, 0xd5, 0xba, 0x54, 0x38, 0xef, 0xab, 0xf0, 0x0c, 0xaf, 0xf0, 0x70,
+		0xb0, 0x42, 0x76, 0xa4, 0x71, 0x4e, 0xab, 0xb9, 0xaa, 0x9d, 0xd3, 0x6a, 0xde, 0x1a, 0x4b, 0x73,
+		0x7f, 0xf6, 0xd7, 0x47, 0xf7, 0xbd, 0xed, 0xb3, 0x47, 0xf7, 0x45, 0x76, 0x99, 0x1c, 0xdf, 0x65,
+		0x76, 0x4f, 0xfd, 0x6c, 0x0a, 0x7b, 0x6a, 0x5b, 0xb3, 0xd6, 0x37, 0xac, 0xe9, 0x5a, 0x7b, 0xa7,
+		0x65, 0x19, 0xd3, 0xd7, 0xcf, 0xe0, 0xc8, 0x35, 0x36, 0x78, 0x4f, 0x11, 0x51, 0x3e, 0xc5, 0xca,
+		0xa7, 0xae, 0x47, 0x74, 0x94, 0xbc, 0x01, 0x7d, 0x2b, 0x88, 0x88, 0xa2, 0xb0, 0x0c, 0x4b, 0x6d,
+		0x72, 0x8f, 0x8c, 0x25, 0x30, 0x97, 0xdd, 0xb4, 0x4d, 0xb0, 0xdc, 0x86, 0xb8, 0x64, 0xdb, 0xd4,
+		0xd4, 0x0d, 0x76, 0x61, 0x29, 0x49, 0x5d, 0xf9, 0x34, 0x66, 0xd0, 0xbb, 0x49, 0xe3, 0xd0, 0xa7,
+		0x76, 0xd8, 0xa1, 0xa2, 0x24, 0xfa, 0xf8, 0x34, 0x21, 0x2f, 0xc0, 0x00, 0x3f, 0x5b, 0x80, 0xa7,
+		0x6a, 0xae, 0x69, 0x3b, 0xb4, 0x9e, 0xac, 0x82, 0x3f, 0xc9, 0x34, 0xf4, 0x51, 0xee, 0xf9, 0x4d,
+		0xcc, 0x43, 0x53, 0x41, 0xf6, 0xa7, 0x28, 0x97, 0x0a, 0x83, 0x93, 0xaf, 0x40, 0x7a, 0xce, 0x40,
+		0x05, 0xf2, 0x92, 0xcb, 0x30, 0x72, 0x94, 0xe9, 0x56, 0x87, 0x77, 0x9f, 0xc2, 0x12, 0x78, 0xde,
+		0x9e, 0xdd, 0x60, 0xe3, 0x27, 0xa3, 0x78, 0x4a, 0x9e, 0x85, 0x01, 0x4a, 0x7b, 0xb9, 0x85, 0xfe,
+		0x90, 0x7d, 0xa8, 0x3f, 0xc3, 0xef, 0x33, 0x73, 0xf2, 0x09, 0x87, 0x5b, 0x02, 0x29, 0xbc, 0xa5,
+		0xc2, 0x1b, 0x4e, 0x7f, 0xcb, 0x4f, 0x43, 0x9a, 0x13, 0x31, 0xc9, 0xc3, 0x90, 0x34, 0x5a, 0x26,
+		0x3f, 0xdb, 0x74, 0x38, 0xb2, 0x2d, 0xcb, 0xad, 0x52, 0x0a, 0x15, 0x4b, 0x41, 0xe8, 0xd2, 0x62,
+		0xa4, 0x6a, 0x3c, 0xec, 0x51, 0x0d, 0xd1, 0xed, 0xe2, 0x87, 0xda, 0x6a, 0x4c, 0x07, 0x95, 0xc1,
+		0xd6, 0x95, 0xf7, 0x26, 0xe0, 0x9e, 0x10, 0x5d, 0xb9, 0xa6, 0xed, 0x98, 0xbb, 0x56, 0x95, 0xeb,
+		0x90, 0x59, 0xa1, 0xaf, 0x8b, 0x3c, 0xa3, 0xed, 0x90, 0x02, 0x3e, 0x41, 0x71, 0xf6, 0xfc, 0xf9,
+		0x33, 0x8f, 0xb1, 0x8e, 0xbc, 0xbc, 0x4f, 0x11, 0x19, 0xe4, 0x28, 0x64, 0x4c, 0xad, 0xd6, 0x3a,
+		0x7b, 0xfe, 0xc2, 0xb5, 0x33, 0x4c, 0x70, 0x97, 0xf7, 0x29, 0x4e, 0x16, 0x39, 0x02, 0xe9, 0xf5,
+		0xa6, 0x79, 0xe6, 0xec, 0xc3, 0x8f, 0x9e, 0x61, 0x42, 0xbc, 0xbc, 0x4f, 0xb1, 0x73, 0x66, 0xd2,
+		0x38, 0x64, 0xbe, 0xf0, 0xa1, 0x09, 0xa9, 0xd4, 0x07, 0x49, 0xb3, 0xb3, 0x7d, 0xb7, 0x44, 0xf3,
+		0xeb, 0x03, 0x70, 0xcc, 0x2e, 0x66, 0x46, 0xf1, 0xfa, 0x99, 0xe9, 0xeb, 0x6a, 0xb3, 0x51, 0x57,
+		0x9d, 0x17, 0x63, 0x46, 0x6d, 0xf1, 0x50, 0x10, 0x94, 0x4e, 0x77, 0x81, 0x46, 0x08, 0xef, 0x25,
+		0x09, 0xb2, 0x57, 0x05, 0x6d, 0x7c, 0x64, 0xe6, 0x09, 0x00, 0xbb, 0x2e, 0xa1, 0x2c, 0x47, 0xa6,
+		0x02, 0xb5, 0x4d, 0xd9, 0x48, 0x8a, 0x0b, 0x9e, 0x3c, 0x0a, 0xe9, 0x56, 0xdb, 0x68, 0x19, 0x26,
+		0xbf, 0xef, 0x1a, 0x87, 0x6b, 0x43, 0xe3, 0x49, 0x4d, 0x3a, 0xb4, 0xab, 0xd7, 0x0d, 0x0b, 0xcf,
+		0x8e, 0xb4, 0x8c, 0x1b, 0xfc, 0x19, 0x81, 0xa4, 0x92, 0xa3, 0x25, 0x57, 0x69, 0xc1, 0x0a, 0xe6,
+		0xcb, 0xff, 0x45, 0x82, 0x8c, 0x4d, 0x05, 0xd7, 0x6d, 0x6a, 0xbd, 0xde, 0xd6, 0x4c, 0x93, 0x8f,
+		0x5e, 0x91, 0x24, 0x33, 0x30, 0xd0, 0xea, 0xac, 0x57, 0xc5, 0x48, 0xc1, 0xdb, 0xd4, 0x61, 0x7a,
+		0x2f, 0xd4, 0x87, 0x9e, 0x0c, 0xef, 0x6f, 0x75, 0xd6, 0x51, 0x95, 0x8e, 0x41, 0x36, 0x84, 0x97,
+		0xc1, 0xeb, 0x0e, 0x1b, 0xf4, 0xc5, 0x1b, 0xde, 0x80, 0x6a, 0xab, 0xdd, 0x30, 0xda, 0x0d, 0x6b,
+		0x87, 0x1e, 0xcb, 0x4b, 0x2a, 0x39, 0x51, 0xb0, 0xc2, 0xf3, 0x89, 0x0c, 0x43, 0x9c, 0x97, 0xea,
+		0xfa, 0x8e, 0xa5, 0x99, 0xf4, 0xa0, 0x5e, 0x56, 0x19, 0x64, 0xd5, 0x95, 0x30, 0x8b, 0x4c, 0x42,
+		0x56, 0xc0, 0xd8, 0x57, 0x6d, 0x32, 0x0a, 0x30, 0x10, 0x5c, 0x9e, 0xc8, 0x4d, 0x18, 0xa9, 0x50,
+		0x57, 0xde, 0x69, 0xfe, 0x05, 0xa7, 0x91, 0x52, 0x0f, 0x8d, 0x8c, 0x6c, 0x60, 0x22, 0xd0, 0xc0,
+		0x93, 0xff, 0x5e, 0x82, 0xc1, 0x52, 0xd3, 0xa8, 0x5d, 0x9b, 0x9f, 0xbb, 0xd8, 0x54, 0x37, 0xc9,
+		0x19, 0xd8, 0x5f, 0x5a, 0x58, 0x9e, 0x7d, 0xa6, 0x3a, 0x3f, 0x57, 0xbd, 0xb8, 0x50, 0xbc, 0xe4,
+		0x1c, 0x27, 0x2e, 0x1c, 0x78, 0xf1, 0xd6, 0x24, 0x71, 0xc1, 0xae, 0xe9, 0x74, 0xe9, 0x4a, 0xa6,
+		0x61, 0xdc, 0x8b, 0x52, 0x2c, 0x55, 0xf0, 0x6c, 0xb1, 0x54, 0xd8, 0xff, 0xe2, 0xad, 0xc9, 0x51,
+		0x17, 0x46, 0x71, 0xdd, 0xd4, 0x74, 0x2b, 0x88, 0x30, 0xbb, 0xbc, 0xb8, 0x38, 0xbf, 0x9a, 0x4b,
+		0x04, 0x10, 0xf0, 0x4c, 0x67, 0xc3, 0x22, 0x0f, 0xc2, 0xa8, 0x17, 0x61, 0x69, 0x7e, 0x21, 0x97,
+		0x2c, 0x90, 0x17, 0x6f, 0x4d, 0x0e, 0xbb, 0xa0, 0x97, 0x1a, 0xcd, 0x42, 0xfa, 0x3b, 0x7f, 0xf2,
+		0xe8, 0xbe, 0x8f, 0xfc, 0xd4, 0x51, 0xa9, 0xb4, 0x10, 0x39, 0x7a, 0xcf, 0xf6, 0x3e, 0x7a, 0xc5,
+		0xf0, 0xb4, 0x07, 0xef, 0x07, 0x12, 0x30, 0x61, 0x97, 0x5e, 0xd7, 0xda, 0xb8, 0x67, 0x80, 0xe3,
+		0x90, 0xa9, 0xbe, 0xed, 0xae, 0xf0, 0xce, 0xe1, 0x00, 0xd1, 0xa6, 0xed, 0x49, 0x48, 0x16, 0x5b,
+		0x2d, 0x7c, 0x9e, 0x82, 0xa6, 0x6b, 0x06, 0x9b, 0x06, 0x53, 0x8a, 0x9d, 0xc6, 0x32, 0xd3, 0xd8,
+		0xb0, 0x6e, 0xa8, 0x6d, 0xfb, 0xe9, 0x0a, 0x91, 0x96, 0x1f, 0x83, 0xcc, 0xac, 0xa1, 0x9b, 0x9a,
+		0x6e, 0x76, 0x68, 0x08, 0x63, 0x1d, 0x85, 0xc1, 0x29, 0xb0, 0x04, 0x4e, 0x23, 0x6a, 0xab, 0x45,
+		0x31, 0x53, 0x0a, 0xfe, 0xe4, 0xae, 0xc1, 0x52, 0xa4, 0x78, 0xce, 0xf5, 0x2e, 0x1e, 0x47, 0x00,
+		0xb6, 0x80, 0x7e, 0xf0, 0x1e, 0x97, 0xe1, 0xb7, 0xad, 0x9b, 0x5b, 0x3c, 0x21, 0x96, 0x2d, 0xc6,
+		0xad, 0x28, 0xc4, 0xdb, 0xcb, 0x42, 0x5c, 0xaf, 0x44, 0xf8, 0x8b, 0x71, 0x01, 0x25, 0xf9, 0x31,
+		0x18, 0xc2, 0x23, 0xda, 0x15, 0xcd, 0xba, 0xac, 0xa9, 0x75, 0xad, 0xed, 0xf5, 0x57, 0x86, 0x84,
+		0xbf, 0x42, 0x20, 0x45, 0x9d, 0x12, 0x36, 0x5d, 0xd3, 0xdf, 0x72, 0x03, 0x52, 0x88, 0xea, 0xf8,
+		0x32, 0x1c, 0x83, 0x26, 0x68, 0x77, 0x51, 0x2b, 0xc1, 0x50, 0x58, 0x82, 0x9c, 0x17, 0x1e, 0x49,
+		0x32, 0xc6, 0x23, 0xe1, 0x73, 0x38, 0xf7, 0x4b, 0xb6, 0x61, 0x80, 0x0f, 0x04, 0x9b, 0x13, 0xc9,
+		0xe1, 0x84, 0x2c, 0xc1, 0x48, 0x4b, 0x6d, 0x5b, 0xf4, 0xba, 0xe7, 0x16, 0x6d, 0x06, 0xb7, 0x96,
+		0x93, 0x21, 0xc6, 0xdb, 0xd3, 0x5c, 0x5e, 0xcd, 0x50, 0xcb, 0x9d, 0x29, 0x7f, 0x21, 0x05, 0xfd,
+		0x5c, 0x1c, 0x4f, 0xc1, 0x00, 0x17, 0x38, 0xb7, 0x4d, 0x47, 0xa7, 0x42, 0xd4, 0x7f, 0xca, 0x56,
+		0x53, 0x4e, 0x50, 0x20, 0x91, 0x07, 0x20, 0x5d, 0xdb, 0x52, 0x1b, 0x7a, 0xb5, 0x51, 0xe7, 0x5e,
+		0xef, 0xe0, 0x67, 0x5f, 0x9e, 0x18, 0x98, 0xc5, 0xbc, 0xf9, 0x39, 0x65, 0x80, 0x16, 0xce, 0xd7,
+		0xd1, 0x8b, 0xda, 0xd2, 0x1a, 0x9b, 0x5b, 0x16, 0x37, 0xd3, 0x3c, 0x85, 0x6f, 0xa6, 0x61, 0x97,
+		0xf1, 0xe7, 0x00, 0x0a, 0x81, 0xe5, 0x8c, 0x1d, 0x91, 0x2b, 0xa5, 0xb1, 0xe2, 0xf7, 0xfc, 0xe5,
+		0x84, 0xa4, 0x50, 0x0c, 0x32, 0x07, 0x43, 0x4d, 0xd5, 0xb4, 0xaa, 0x74, 0x9c, 0x60, 0xf5, 0x7d,
+		0x9c, 0x44, 0x50, 0x24, 0x5c, 0xb6, 0x9c, 0xf7, 0x41, 0x44, 0x63, 0x59, 0x75, 0xbc, 0xae, 0x4c,
+		0xa9, 0xd4, 0xa8, 0xa9, 0x62, 0x9e, 0x69, 0x3f, 0x15, 0xfd, 0x30, 0xe6, 0x33, 0x0b, 0x46, 0xfd,
+		0xd3, 0xc3, 0x90, 0xa1, 0x37, 0x90, 0x29, 0x08, 0xbb, 0x56, 0x90, 0xc6, 0x0c, 0x5a, 0x78, 0x1c,
+		0x46, 0x9c, 0x59, 0x96, 0x81, 0xa4, 0x19, 0x15, 0x27, 0x9b, 0x02, 0x3e, 0x04, 0xe3, 0xba, 0x76,
+		0xd3, 0xaa, 0x3a, 0xd9, 0x0c, 0x3a, 0x43, 0xa1, 0x09, 0x96, 0x5d, 0xf5, 0x62, 0xdc, 0x0f, 0xc3,
+		0x35, 0x21, 0x7d, 0x06, 0x0b, 0x14, 0x76, 0xc8, 0xce, 0xa5, 0x60, 0x87, 0x20, 0xad, 0xb6, 0x5a,
+		0x0c, 0x60, 0x90, 0x4f, 0xb2, 0xad, 0x16, 0x2d, 0x3a, 0x09, 0xa3, 0xb4, 0x8d, 0x6d, 0xcd, 0xec,
+		0x34, 0x2d, 0x4e, 0x24, 0x4b, 0x61, 0x46, 0xb0, 0x40, 0x61, 0xf9, 0x14, 0xf6, 0x5e, 0x18, 0xd2,
+		0xae, 0xe3, 0x9d, 0x8e, 0x9a, 0xc6, 0xe0, 0x86, 0x28, 0x5c, 0x56, 0x64, 0x52, 0xa0, 0x07, 0xc1,
+		0x9e, 0x3d, 0xab, 0x62, 0x62, 0x1f, 0x66, 0xf4, 0x44, 0x7e, 0x91, 0x65, 0xcb, 0x79, 0x48, 0xcd,
+		0xa9, 0x96, 0x8a, 0x76, 0xcc, 0xba, 0xc9, 0xfc, 0x95, 0xac, 0x82, 0x3f, 0xe5, 0x4f, 0x26, 0x21,
+		0x75, 0xd5, 0xb0, 0xf0, 0x8a, 0x77, 0xca, 0x75, 0x25, 0x36, 0x4c, 0xa5, 0x2b, 0x8d, 0x4d, 0x5d,
+		0xab, 0x2f, 0x9a, 0x9b, 0xae, 0xf7, 0x82, 0x1c, 0x85, 0x4a, 0x78, 0x14, 0x6a, 0x1c, 0xfa, 0xda,
+		0x46, 0x47, 0xaf, 0x8b, 0x13, 0xf9, 0x34, 0x41, 0x2e, 0x42, 0xda, 0xd6, 0x93, 0x54, 0xac, 0x9e,
+		0x8c, 0xa0, 0x9e, 0xa0, 0x1a, 0xf3, 0x0c, 0x65, 0x60, 0x9d, 0xab, 0x4b, 0x09, 0x32, 0xb6, 0x85,
+		0xc9, 0xf7, 0xed, 0x42, 0x67, 0x1d, 0x34, 0x74, 0x4a, 0xec, 0xde, 0xb7, 0xc5, 0xc7, 0x74, 0x2e,
+		0x67, 0x17, 0x70, 0xf9, 0x79, 0x14, 0x8b, 0x3f, 0x5e, 0x34, 0x40, 0x1b, 0xe6, 0x28, 0x16, 0x7b,
+		0xc0, 0xe8, 0x08, 0x9e, 0x71, 0xdc, 0xd4, 0x55, 0xab, 0xd3, 0xd6, 0xb8, 0xee, 0x39, 0x19, 0x58,
+		0xea, 0x5c, 0x4f, 0x61, 0xba, 0xe6, 0x64, 0x90, 0x69, 0x18, 0xb3, 0x13, 0x55, 0x87, 0x0a, 0xd3,
+		0x33, 0x62, 0x17, 0x55, 0x44, 0x89, 0xfc, 0x7f, 0x49, 0xd0, 0xcf, 0x27, 0x77, 0xa7, 0x1f, 0xa4,
+		0xf0, 0x7e, 0x48, 0x44, 0xf5, 0x43, 0xf2, 0xb6, 0xfa, 0x01, 0x6c, 0x3e, 0x4d, 0xfe, 0x46, 0x4d,
+		0x98, 0x27, 0xcb, 0x98, 0xac, 0x34, 0x36, 0xf9, 0xd8, 0x77, 0x61, 0xc9, 0x2f, 0x4b, 0x90, 0xb1,
+		0xcb, 0x49, 0x09, 0x86, 0x04, 0x67, 0xd5, 0x8d, 0xa6, 0xba, 0xc9, 0xd5, 0xf1, 0x68, 0x34, 0x7b,
+		0xe8, 0xb3, 0x28, 0x83, 0x9c, 0x23, 0x4c, 0x84, 0xf7, 0x6c, 0x22, 0xa2, 0x67, 0x3d, 0xaa, 0x94,
+		0xdc, 0x9b, 0x2a, 0x79, 0x3a, 0x3d, 0xe5, 0xeb, 0x74, 0xf9, 0x73, 0x12, 0x7f, 0x4e, 0xad, 0xae,
+		0xd5, 0xff, 0x55, 0x7b, 0xeb, 0x5b, 0xb8, 0x7e, 0x61, 0x48, 0x33, 0xd0, 0x6d, 0xf7, 0x85, 0x90,
+		0xf4, 0x72, 0xed, 0x74, 0x1f, 0x11, 0x64, 0x2a, 0x4e, 0x37, 0x7e, 0x2c, 0x01, 0xa3, 0x01, 0xf8,
+		0x6f, 0xc2, 0xee, 0xf4, 0x8e, 0xe1, 0xbe, 0x1e, 0xc7, 0x70, 0x7f, 0xe4, 0x18, 0xfe, 0x58, 0x82,
+		0xc6, 0x1e, 0x5a, 0x86, 0xa9, 0x36, 0xbf, 0x2e, 0x36, 0xf8, 0x30, 0x64, 0x5a, 0x46, 0xb3, 0xca,
+		0x4a, 0xd8, 0xdd, 0xa8, 0x74, 0xcb, 0x68, 0x2a, 0x01, 0x55, 0xeb, 0xbb, 0x53, 0x06, 0xba, 0xff,
+		0x0e, 0x74, 0xc3, 0x80, 0x7f, 0x54, 0x59, 0x90, 0x65, 0xb2, 0xe0, 0x1e, 0xd4, 0x19, 0x14, 0x02,
+		0xfe, 0xca, 0x4b, 0x7e, 0x9f, 0xcf, 0xe6, 0x9b, 0x81, 0x2a, 0xfd, 0x5b, 0x36, 0x0a, 0xf3, 0x37,
+		0xf2, 0x89, 0x48, 0x14, 0xa6, 0xca, 0x0a, 0x07, 0x94, 0xdf, 0x2b, 0x01, 0x2c, 0xa0, 0x70, 0x69,
+		0x8b, 0xd1, 0xf9, 0x31, 0x29, 0x13, 0x55, 0x4f, 0xdd, 0x13, 0x91, 0x1d, 0xc7, 0x39, 0xc8, 0x9a,
+		0x6e, 0xd6, 0xe7, 0x60, 0xc8, 0x51, 0x70, 0x53, 0x13, 0xec, 0x4c, 0x74, 0x0b, 0x09, 0x54, 0x34,
+		0x4b, 0xc9, 0x5e, 0x77, 0xa5, 0xe4, 0xdf, 0x95, 0x20, 0x43, 0xb9, 0xc2, 0x77, 0x58, 0x3c, 0x1d,
+		0x29, 0xdd, 0x46, 0x47, 0xde, 0x03, 0xc0, 0xe8, 0xe0, 0x41, 0x23, 0xae, 0x5f, 0x19, 0x9a, 0x83,
+		0xc7, 0x87, 0xc8, 0x23, 0xb6, 0xd4, 0x93, 0x31, 0x52, 0xe7, 0xa6, 0x43, 0xc8, 0xfe, 0x20, 0x0c,
+		0xd0, 0xb7, 0x3f, 0x6f, 0x9a, 0x3c, 0x10, 0x80, 0x0f, 0x7e, 0xad, 0xde, 0x34, 0xe5, 0x6b, 0x30,
+		0xb0, 0x7a, 0x93, 0xc5, 0x34, 0x0f, 0x43, 0xa6, 0x6d, 0x18, 0xdc, 0x1b, 0x64, 0x8e, 0x78, 0x1a,
+		0x33, 0xa8, 0xf3, 0x23, 0xc2, 0x78, 0x09, 0x27, 0x8c, 0xe7, 0x04, 0x22, 0x93, 0xbd, 0x05, 0x22,
+		0x71, 0xdd, 0x3e, 0xe4, 0x19, 0x51, 0xe4, 0x55, 0x70, 0xb0, 0x32, 0x7f, 0x69, 0xa9, 0x3c, 0x57,
+		0x5d, 0xac, 0x5c, 0xf2, 0xbd, 0x7f, 0x50, 0x18, 0x79, 0xf1, 0xd6, 0xe4, 0x20, 0x5f, 0xb0, 0x47,
+		0x41, 0xaf, 0x28, 0xe5, 0xab, 0xcb, 0xab, 0xe5, 0x9c, 0xc4, 0xa0, 0x57, 0xda, 0xda, 0x75, 0xc3,
+		0x62, 0xaf, 0xeb, 0x3e, 0x04, 0x87, 0x42, 0xa0, 0xed, 0x65, 0xfb, 0xe8, 0x8b, 0xb7, 0x26, 0x87,
+		0x56, 0xda, 0x1a, 0x53, 0x35, 0x8a, 0x31, 0x05, 0xf9, 0x20, 0xc6, 0xf2, 0xca, 0x72, 0xa5, 0xb8,
+		0x90, 0x9b, 0x2c, 0xe4, 0x5e, 0xbc, 0x35, 0x99, 0x15, 0xb6, 0x03, 0xe1, 0xef, 0xfe, 0xba, 0x3d,
+		0x15, 0x3c, 0x51, 0x71, 0xa3, 0xad, 0xb6, 0x5a, 0x5a, 0xdb, 0x8c, 0x3a, 0x3a, 0x70, 0x2f, 0x0c,
+		0xce, 0xb9, 0x6e, 0x06, 0xdb, 0x67, 0x48, 0x24, 0x7a, 0x6b, 0x98, 0x25, 0x64, 0x19, 0xe0, 0x62,
+		0xd3, 0x50, 0xad, 0x10, 0x98, 0x84, 0x0b, 0x66, 0x5e, 0xb7, 0x2e, 0x9c, 0x0b, 0x81, 0x49, 0x0a,
+		0x98, 0x7b, 0x61, 0x70, 0x2d, 0x0a, 0x28, 0xe5, 0x25, 0xf4, 0xf0, 0xd9, 0x10, 0x98, 0x3e, 0x1f,
+		0xa1, 0x50, 0xa0, 0x21, 0x01, 0x74, 0x0c, 0x32, 0x25, 0xc3, 0x68, 0x86, 0x80, 0xa4, 0x5d, 0x74,
+		0x2a, 0xae, 0x4b, 0xcf, 0x1e, 0xa0, 0x8c, 0x8b, 0x21, 0x1a, 0xd7, 0x0a, 0x81, 0xb1, 0x4f, 0xd9,
+		0xec, 0xfa, 0x70, 0xc9, 0x6b, 0x79, 0xbf, 0xec, 0xf6, 0x70, 0x89, 0xe8, 0xcf, 0xbd, 0x1d, 0x2e,
+		0xc9, 0xba, 0x36, 0x37, 0xec, 0x28, 0x43, 0x4b, 0x6d, 0xab, 0xdb, 0x5d, 0x02, 0x17, 0xe1, 0x41,
+		0x85, 0x98, 0xb3, 0x3b, 0x85, 0x18, 0x4d, 0xc4, 0x95, 0xcd, 0x88, 0xbd, 0x60, 0x5e, 0xa1, 0x2c,
+		0x90, 0x73, 0xee, 0xe8, 0xce, 0x60, 0xb4, 0x1f, 0xc2, 0xc0, 0x45, 0xf4, 0xe7, 0x49, 0x48, 0x8b,
+		0x85, 0x17, 0xb7, 0xcd, 0xc7, 0xc2, 0xbc, 0x25, 0x0e, 0xc2, 0x71, 0x6d, 0x14, 0xf2, 0x6a, 0xc8,
+		0xd8, 0x96, 0x9a, 0x9b, 0x26, 0xb9, 0x9b, 0x6d, 0xe7, 0x04, 0x1c, 0x24, 0x8c, 0xcf, 0x8a, 0xf0,
+		0x40, 0x2a, 0x32, 0xe2, 0x70, 0x95, 0x41, 0x70, 0x6c, 0x81, 0x40, 0xce, 0x43, 0x4a, 0x5d, 0xaf,
+		0x35, 0xf2, 0x7d, 0xfe, 0x98, 0xa7, 0x8d, 0x58, 0x2c, 0xcd, 0xce, 0x33, 0x2c, 0x1a, 0xd8, 0xa5,
+		0xe0, 0xc8, 0xb4, 0xb9, 0xa3, 0xd7, 0xb6, 0xda, 0x86, 0xbe, 0x93, 0xef, 0x8f, 0x64, 0xba, 0x22,
+		0x60, 0x04, 0xd3, 0x36, 0x12, 0x32, 0xbd, 0xa1, 0x39, 0xb3, 0x77, 0x38, 0xd3, 0x17, 0x19, 0x84,
+		0x60, 0x9a, 0x23, 0xc8, 0xf3, 0x3c, 0x9e, 0xca, 0xbb, 0x8d, 0x3e, 0x5c, 0x75, 0x93, 0xc7, 0x83,
+		0xd9, 0x80, 0x4f, 0x6f, 0xab, 0x37, 0x59, 0x30, 0xf8, 0x20, 0x0c, 0x60, 0xe1, 0x26, 0x7f, 0x1a,
+		0x25, 0xa9, 0xf4, 0x6f, 0xab, 0x37, 0x2f, 0xa9, 0xe6, 0x95, 0x54, 0x3a, 0x99, 0x4b, 0xc9, 0x3f,
+		0x8b, 0xee, 0xb7, 0xa7, 0x6b, 0xc8, 0x29, 0x20, 0x88, 0x81, 0x7b, 0xda, 0x38, 0x09, 0xd1, 0x4e,
+		0x16, 0x74, 0x47, 0xb6, 0xd5, 0x9b, 0xc5, 0x4d, 0x6d, 0xa9, 0xb3, 0x4d, 0x19, 0x30, 0x09, 0x6e,
+		0x80, 0x73, 0x60, 0xa1, 0x80, 0xb6, 0xbf, 0x10, 0x78, 0x8a, 0x99, 0x03, 0x30, 0x87, 0xe6, 0xbd,
+		0xe8, 0xd0, 0x0c, 0x33, 0x7a, 0xa2, 0xc4, 0xdb, 0x94, 0xa4, 0xb7, 0x29, 0xf2, 0xd3, 0x30, 0xe2,
+		0xd3, 0x02, 0x77, 0x38, 0x9c, 0x0a, 0x8d, 0xae, 0xdd, 0x33, 0x22, 0x1c, 0x4e, 0x47, 0xdf, 0x4c,
+		0xfa, 0x13, 0xb8, 0xe7, 0x82, 0x9b, 0xa3, 0xa7, 0x60, 0xc8, 0xa3, 0x06, 0x22, 0x70, 0x29, 0x39,
+		0x81, 0x4b, 0x07, 0xf8, 0x79, 0xc8, 0xe2, 0x54, 0xaa, 0xd5, 0x39, 0xec, 0x03, 0x30, 0xc2, 0xe6,
+		0x7a, 0xbf, 0xac, 0x99, 0x0f, 0xbf, 0x28, 0x04, 0x2e, 0xc3, 0x90, 0x03, 0xe7, 0x88, 0x7d, 0x50,
+		0x40, 0x5d, 0x52, 0x4d, 0xf9, 0x87, 0x25, 0x18, 0xf1, 0xe9, 0x06, 0x79, 0x12, 0x32, 0xad, 0xb6,
+		0x56, 0x6b, 0xb8, 0xc2, 0x5c, 0x5d, 0x44, 0x98, 0xa2, 0xe2, 0x73, 0x30, 0xd0, 0x4d, 0x12, 0x27,
+		0x11, 0xea, 0x5a, 0x53, 0xdd, 0xc9, 0x27, 0x7a, 0x23, 0x21, 0xde, 0xc5, 0x9f, 0x43, 0x24, 0xf9,
+		0xf7, 0x25, 0x18, 0xf2, 0x28, 0x1d, 0xa9, 0xc3, 0x3d, 0x38, 0x45, 0xbb, 0x4f, 0xbf, 0xf3, 0x17,
+		0xfe, 0x5c, 0x6b, 0x34, 0xdc, 0x0a, 0xf4, 0xd7, 0xe3, 0x4c, 0x34, 0xd4, 0xb9, 0x91, 0x94, 0x02,
+		0xd2, 0x71, 0x0e, 0xc1, 0xb3, 0xa7, 0x00, 0x2f, 0x33, 0x67, 0x7c, 0x19, 0x48, 0x6b, 0xdd, 0xf2,
+		0x93, 0x4e, 0xf4, 0x4a, 0x3a, 0x87, 0xc8, 0x6e, 0x82, 0x72, 0x05, 0xc0, 0x19, 0xb8, 0xa4, 0xd8,
+		0x4b, 0x23, 0x92, 0xdd, 0x38, 0x9c, 0x49, 0xe4, 0xa5, 0xd2, 0xca, 0x47, 0x3e, 0x7b, 0x54, 0xba,
+		0x2b, 0xae, 0xc3, 0x57, 0x2b, 0x70, 0xc4, 0x01, 0x5d, 0xaf, 0x35, 0xfc, 0x01, 0xed, 0x9c, 0x28,
+		0x9d, 0xc2, 0xd2, 0x5e, 0xe2, 0xd9, 0x31, 0x33, 0x4d, 0x2f, 0xf1, 0xee, 0x3d, 0x86, 0xb3, 0xff,
+		0x38, 0x03, 0x03, 0x8a, 0xf6, 0x42, 0x47, 0x33, 0x2d, 0xf2, 0x30, 0xa4, 0xb4, 0xda, 0x96, 0x11,
+		0xdc, 0x53, 0xe2, 0xcd, 0x98, 0x2a, 0xd7, 0xb6, 0x0c, 0x0e, 0x7c, 0x79, 0x9f, 0x42, 0x81, 0xc9,
+		0x05, 0xe8, 0xdb, 0x68, 0x76, 0x78, 0xa4, 0xdb, 0x33, 0x0f, 0x09, 0xac, 0x8b, 0x58, 0xec, 0xa0,
+		0x31, 0x70, 0xac, 0x8c, 0x7e, 0x91, 0x20, 0x19, 0x55, 0x19, 0xfd, 0x10, 0x81, 0x53, 0x19, 0x02,
+		0x93, 0x59, 0x80, 0x86, 0xde, 0xb0, 0xaa, 0x34, 0x08, 0x9c, 0xef, 0xf3, 0xdb, 0x72, 0x07, 0xb5,
+		0x61, 0xd1, 0x80, 0xb1, 0x83, 0x9f, 0x69, 0x88, 0x3c, 0xe4, 0xf8, 0x85, 0x8e, 0xd6, 0x16, 0x73,
+		0x41, 0x08, 0xc7, 0xaf, 0xc1, 0x62, 0x17, 0xc7, 0x14, 0x1c, 0xe7, 0x4e, 0xf6, 0xbe, 0xa9, 0x75,
+		0x33, 0x9f, 0xf6, 0x4f, 0x03, 0x02, 0x95, 0x3e, 0x6f, 0xba, 0x7a, 0xd3, 0x41, 0x1e, 0xa8, 0xb1,
+		0x1c, 0xf2, 0x98, 0xbd, 0x46, 0x1b, 0xf4, 0x2f, 0x8a, 0x6c, 0x64, 0x5a, 0xee, 0xe0, 0x72, 0x04,
+		0xb2, 0x0c, 0xc3, 0xcd, 0x86, 0x69, 0x55, 0x4d, 0x5d, 0x6d, 0x99, 0x5b, 0x86, 0x65, 0xd2, 0x60,
+		0x2b, 0x3e, 0xeb, 0x1b, 0x20, 0xb1, 0xd0, 0x30, 0xad, 0x8a, 0x00, 0x73, 0x28, 0x0d, 0x35, 0xdd,
+		0xf9, 0x48, 0xd0, 0xd8, 0xd8, 0xd0, 0xda, 0x36, 0xc5, 0xfc, 0x50, 0x14, 0xc1, 0x65, 0x84, 0x13,
+		0x98, 0x2e, 0x82, 0x86, 0x3b, 0x9f, 0xbc, 0x0e, 0xc6, 0x9a, 0x86, 0x5a, 0xb7, 0xe9, 0x55, 0x6b,
+		0x5b, 0x1d, 0xfd, 0x1a, 0x8d, 0xe1, 0x0e, 0x9e, 0x3d, 0x19, 0xc2, 0xa6, 0xa1, 0xd6, 0x05, 0xf2,
+		0x2c, 0x82, 0x3a, 0x94, 0x47, 0x9b, 0xfe, 0x32, 0x52, 0x85, 0x71, 0xb5, 0xd5, 0x6a, 0xee, 0xf8,
+		0xc9, 0x8f, 0x50, 0xf2, 0xa7, 0x82, 0xe4, 0x8b, 0x08, 0x1d, 0x41, 0x9f, 0xa8, 0x81, 0x42, 0xb2,
+		0x86, 0xf1, 0x67, 0x8d, 0x5e, 0x7d, 0x6d, 0xf1, 0x55, 0x08, 0x7d, 0x18, 0x10, 0x5f, 0xd0, 0x09,
+		0x10, 0x5f, 0x61, 0x90, 0x62, 0xb9, 0xe2, 0x50, 0x1e, 0x69, 0x79, 0x4b, 0x18, 0x59, 0xa3, 0xa6,
+		0xd1, 0x87, 0x4b, 0x39, 0xd9, 0xd1, 0x68, 0xb2, 0x14, 0x32, 0x94, 0xac, 0xa7, 0x84, 0x5c, 0x84,
+		0x41, 0x16, 0xb6, 0xc2, 0xad, 0x73, 0x8d, 0x3e, 0x28, 0x38, 0x78, 0xf6, 0xde, 0x90, 0xe1, 0x4a,
+		0x81, 0x30, 0x24, 0xee, 0x10, 0x03, 0xcd, 0xce, 0x24, 0xeb, 0xb0, 0x9f, 0x3e, 0xae, 0xb8, 0x53,
+		0xf5, 0x1a, 0xdc, 0xfc, 0x18, 0xa5, 0xf8, 0xaa, 0x20, 0xc5, 0xab, 0x14, 0xfc, 0xaa, 0xdb, 0xf2,
+		0x3a, 0xa4, 0xc7, 0xae, 0x07, 0x4b, 0x51, 0xd3, 0x36, 0x1a, 0xba, 0xda, 0xc4, 0x47, 0x7e, 0x99,
+		0xbf, 0x3a, 0x1e, 0xa5, 0x69, 0x17, 0x39, 0x1c, 0xf5, 0x56, 0x5c, 0x9a, 0xb6, 0xe1, 0xce, 0x2f,
+		0x0d, 0xf0, 0x35, 0x85, 0xfd, 0x50, 0xe6, 0x40, 0x2e, 0xcd, 0x1e, 0xc7, 0xbc, 0x92, 0x4a, 0x43,
+		0x6e, 0x50, 0x3e, 0x0e, 0x83, 0x2e, 0x3b, 0x85, 0x47, 0x05, 0xf8, 0xac, 0x29, 0xee, 0x00, 0xf0,
+		0xa4, 0x3c, 0x0c, 0x59, 0xb7, 0x69, 0x92, 0xdf, 0x23, 0xc1, 0xa0, 0xcb, 0xe8, 0x20, 0xa6, 0x7b,
+		0x27, 0x2b, 0xe3, 0x38, 0xa2, 0xf7, 0x0a, 0xb7, 0x41, 0x94, 0xb3, 0xdd, 0xd4, 0x2c, 0xcd, 0xe4,
+		0x5e, 0x0b, 0x3e, 0xf5, 0xd3, 0x3a, 0xdb, 0xb2, 0x41, 0x92, 0x14, 0x04, 0x5a, 0x67, 0x5b, 0x02,
+		0xe0, 0x18, 0x64, 0xb1, 0xe9, 0x55, 0xb7, 0x3f, 0x9c, 0x51, 0x06, 0x31, 0x8f, 0x83, 0xc8, 0x7f,
+		0x94, 0x80, 0x9c, 0xdf, 0x98, 0xd9, 0x3b, 0x5c, 0xd2, 0xae, 0x77, 0xb8, 0x0e, 0xf9, 0xf7, 0xd6,
+		0x9c, 0xed, 0xb4, 0x45, 0xc8, 0x39, 0x9b, 0x42, 0x6c, 0xee, 0xe9, 0xe2, 0xe0, 0xfb, 0x16, 0x23,
+		0xca, 0x48, 0xcd, 0x9b, 0x41, 0x2e, 0x79, 0x0e, 0x95, 0xa4, 0xec, 0x53, 0xb2, 0x7e, 0x7d, 0x12,
+		0x30, 0x6b, 0xad, 0xba, 0x6a, 0x69, 0x22, 0xa6, 0xee, 0xa0, 0xa2, 0x27, 0x87, 0xbb, 0x50, 0xa6,
+		0xa5, 0x5a, 0x9a, 0xe7, 0x14, 0xc5, 0x90, 0xda, 0x6a, 0x55, 0x30, 0x97, 0x79, 0x72, 0xf7, 0xc3,
+		0x30, 0x5a, 0xf8, 0x86, 0xda, 0x14, 0x6e, 0x42, 0x3f, 0x73, 0xf8, 0x78, 0x2e, 0x77, 0x35, 0xea,
+		0x90, 0x75, 0x1b, 0x77, 0x3b, 0xf6, 0x22, 0xb9, 0x62, 0x2f, 0x84, 0xbf, 0xdd, 0xc4, 0x24, 0x24,
+		0xde, 0xbb, 0x0a, 0xdf, 0x6d, 0x1c, 0xa7, 0x71, 0x9a, 0xeb, 0x2c, 0xb8, 0x9a, 0x56, 0x58, 0x42,
+		0x7e, 0x0e, 0x86, 0xbd, 0xf3, 0x00, 0x19, 0x86, 0x84, 0x75, 0x93, 0xd7, 0x92, 0xb0, 0x6e, 0x92,
+		0x33, 0x3c, 0x3c, 0x9a, 0xa4, 0xe1, 0xd1, 0x7b, 0x22, 0xe7, 0x11, 0x27, 0x36, 0x7a, 0x25, 0x95,
+		0x4e, 0xe4, 0x92, 0xf2, 0x08, 0x0c, 0x79, 0x66, 0x09, 0xf9, 0x00, 0x8c, 0x87, 0xd9, 0x7c, 0xb9,
+		0x01, 0xe3, 0x61, 0xa6, 0x9b, 0x5c, 0x80, 0xb4, 0x6d, 0xf4, 0x03, 0xe1, 0x34, 0x51, 0xbb, 0x8d,
+		0x64, 0xc3, 0x7a, 0xb6, 0x03, 0x13, 0x9e, 0xed, 0x40, 0xf9, 0x8d, 0x90, 0x8f, 0xb2, 0xe7, 0xbe,
+		0xfd, 0x81, 0x94, 0x2d, 0xb8, 0x03, 0xd0, 0xcf, 0x9f, 0x18, 0x4e, 0xd0, 0x38, 0x04, 0x4f, 0xa1,
+		0x40, 0x99, 0x6d, 0x4f, 0xd2, 0x6c, 0x96, 0x90, 0xab, 0x70, 0x28, 0xd2, 0xa4, 0x47, 0x6f, 0xa7,
+		0x33, 0x42, 0x7c, 0x3b, 0x9d, 0x26, 0xb0, 0x5a, 0x13, 0x03, 0xfb, 0x6d, 0x71, 0xf6, 0x8e, 0xa5,
+		0xf0, 0xa9, 0xbb, 0x03, 0xe1, 0x76, 0x1d, 0x4f, 0xe8, 0xe0, 0xea, 0xc0, 0xf2, 0x2e, 0x24, 0x60,
+		0x5b, 0xbd, 0xb9, 0xca, 0x57, 0x11, 0x7c, 0x2b, 0x32, 0x61, 0x6f, 0x45, 0x92, 0xab, 0x30, 0xda,
+		0x34, 0x6a, 0x6a, 0xb3, 0xea, 0xda, 0x0a, 0xe6, 0xc3, 0xe9, 0xbe, 0x28, 0x3b, 0x2d, 0x36, 0x1b,
+		0xd0, 0x04, 0xf1, 0x81, 0x30, 0x42, 0x89, 0x2c, 0xd8, 0xdb, 0xc6, 0xa4, 0x0c, 0x83, 0xdb, 0x0d,
+		0x73, 0x5d, 0xdb, 0x52, 0xaf, 0x37, 0x8c, 0x36, 0x1f, 0x57, 0x21, 0xda, 0xb3, 0xe8, 0x00, 0x89,
+		0x3d, 0x6a, 0x17, 0x9e, 0xab, 0x53, 0xfa, 0x42, 0xf7, 0xce, 0xfb, 0x77, 0x6d, 0x59, 0xa2, 0x76,
+		0xa1, 0x07, 0x22, 0x77, 0xa1, 0xc3, 0xb6, 0x7c, 0xd3, 0xe1, 0x5b, 0xbe, 0x2f, 0xd2, 0xce, 0x09,
+		0x9b, 0x1d, 0x83, 0xbb, 0xc0, 0x64, 0x15, 0xc6, 0x39, 0x7e, 0xdd, 0x23, 0xfd, 0xc0, 0xe1, 0x34,
+		0xaf, 0xd3, 0xe5, 0x92, 0x3a, 0x11, 0xf8, 0xd1, 0x82, 0x4f, 0xee, 0x51, 0xf0, 0xe2, 0x2c, 0x46,
+		0xca, 0x75, 0x16, 0xe3, 0x1b, 0xac, 0x33, 0xbe, 0x3d, 0x29, 0x76, 0xc7, 0x5c, 0x8e, 0x45, 0xe8,
+		0x21, 0x93, 0xa8, 0xcd, 0x1c, 0xd1, 0xb0, 0xe4, 0xae, 0x1b, 0xc6, 0x7b, 0x3b, 0x15, 0xdf, 0xdb,
+		0x7d, 0x77, 0xb2, 0xb7, 0xfb, 0xf7, 0xd8, 0xdb, 0x77, 0xb5, 0x1f, 0xde, 0x2f, 0x41, 0x21, 0xda,
+		0x1d, 0x0b, 0xed, 0x90, 0x5d, 0x6d, 0x3f, 0x46, 0xcd, 0x78, 0xf7, 0xc3, 0xb0, 0xcf, 0x5b, 0x64,
+		0xca, 0x3c, 0xe4, 0x59, 0x8f, 0xcb, 0xbf, 0x92, 0xc4, 0xaf, 0x48, 0x05, 0x1d, 0xba, 0x90, 0x11,
+		0xab, 0xc0, 0x58, 0x5d, 0xab, 0x35, 0xea, 0x7b, 0x1e, 0xb0, 0xa3, 0x1c, 0xfd, 0x7f, 0x8e, 0xd7,
+		0xa0, 0x9e, 0xe0, 0x59, 0x1d, 0x0c, 0x64, 0xe2, 0xa1, 0x4f, 0xcb, 0x10, 0xbe, 0x51, 0x86, 0x05,
+		0x08, 0x79, 0xc1, 0xaa, 0xc1, 0xbd, 0xa3, 0x9f, 0x06, 0x48, 0x2b, 0x9a, 0xd9, 0x42, 0x67, 0x8e,
+		0x7d, 0x5c, 0xaf, 0xa6, 0xb5, 0x2c, 0x27, 0xc6, 0x15, 0xba, 0xc6, 0xe0, 0x20, 0x02, 0x0f, 0xd7,
+		0xda, 0x36, 0x1e, 0x6e, 0x03, 0xd3, 0x90, 0x42, 0x64, 0x70, 0x80, 0xb9, 0xea, 0x36, 0x2a, 0x85,
+		0x26, 0x8f, 0x88, 0x98, 0x42, 0x32, 0x6a, 0xa5, 0xcc, 0x1d, 0x77, 0x1b, 0x8f, 0xc1, 0x63, 0x75,
+		0x34, 0xa8, 0x90, 0x8a, 0xaa, 0x8e, 0xf9, 0xf7, 0x4e, 0x75, 0x0d, 0xf6, 0x6e, 0xaa, 0x3b, 0xaa,
+		0xd0, 0x1f, 0xd5, 0x54, 0x97, 0x23, 0xee, 0x34, 0xd5, 0x09, 0x2b, 0x3c, 0x22, 0xc2, 0x0a, 0x03,
+		0x51, 0x4c, 0x73, 0xcf, 0xd3, 0x61, 0x9a, 0xc2, 0x93, 0xa7, 0x5c, 0x71, 0x85, 0x8c, 0x3f, 0x26,
+		0x1f, 0x88, 0x2b, 0xd8, 0xd8, 0x76, 0x60, 0x61, 0xc6, 0x0e, 0x2c, 0x64, 0x23, 0xa3, 0x12, 0xdc,
+		0x65, 0xb4, 0x91, 0x39, 0x06, 0x59, 0x09, 0x44, 0x16, 0x58, 0x20, 0xe0, 0x78, 0x6c, 0x64, 0xc1,
+		0x26, 0xe5, 0x0b, 0x2d, 0xac, 0x04, 0x42, 0x0b, 0xc3, 0x51, 0x14, 0x7d, 0xfe, 0xa9, 0x43, 0xd1,
+		0x1b, 0x5b, 0x78, 0x7d, 0x78, 0x6c, 0x21, 0x72, 0xf1, 0x1f, 0xe2, 0x8b, 0xda, 0xa4, 0x43, 0x82,
+		0x0b, 0x6f, 0x8c, 0x08, 0x2e, 0xe4, 0xa2, 0x16, 0xc1, 0x61, 0x9e, 0xa8, 0x5d, 0x41, 0x58, 0x74,
+		0xe1, 0x6a, 0x48, 0x74, 0x81, 0x85, 0x01, 0x1e, 0xec, 0x21, 0xba, 0x60, 0x93, 0x0e, 0x84, 0x17,
+		0xae, 0x86, 0x84, 0x17, 0x48, 0x34, 0x5d, 0x9f, 0x03, 0xe5, 0xa6, 0xeb, 0x29, 0x22, 0x97, 0xbc,
+		0xf1, 0x85, 0xb1, 0xee, 0x7e, 0x2b, 0x73, 0x03, 0x6c, 0x6a, 0xee, 0x00, 0x43, 0x2d, 0x2a, 0xc0,
+		0xc0, 0x62, 0x00, 0xa7, 0x7b, 0x0c, 0x30, 0xd8, 0xb4, 0x43, 0x23, 0x0c, 0x2b, 0x81, 0x08, 0xc3,
+		0xfe, 0x28, 0x85, 0xf3, 0x4d, 0x48, 0x8e, 0xc2, 0x45, 0x86, 0x18, 0xd8, 0x57, 0x38, 0xd8, 0xf7,
+		0x37, 0x20, 0x37, 0x78, 0x25, 0x95, 0x1e, 0xcc, 0x65, 0xe5, 0x07, 0xd1, 0x05, 0xf2, 0xd9, 0x3d,
+		0x5c, 0x70, 0x68, 0xed, 0xb6, 0xd1, 0x16, 0x1b, 0xa2, 0x34, 0x21, 0x9f, 0x80, 0xac, 0xdb, 0xc4,
+		0x75, 0x09, 0x47, 0x8c, 0xc0, 0x90, 0xc7, 0xaa, 0xc9, 0x9f, 0x90, 0x20, 0xeb, 0xb6, 0x57, 0x9e,
+		0xc5, 0x6a, 0x86, 0x2f, 0x56, 0x5d, 0x41, 0x8a, 0x84, 0x37, 0x48, 0x81, 0x6f, 0xed, 0xb7, 0x02,
+		0xf1, 0x07, 0xb5, 0x65, 0xc7, 0x1f, 0xc4, 0x29, 0x4e, 0x16, 0xca, 0xe0, 0x33, 0x03, 0x3b, 0xc2,
+		0x30, 0x62, 0x9f, 0x68, 0xe5, 0x31, 0xff, 0xd3, 0x30, 0xe6, 0x82, 0xb5, 0x17, 0x82, 0x6c, 0x29,
+		0x9e, 0xb3, 0xa1, 0x8b, 0x7c, 0x45, 0xf8, 0xfb, 0x12, 0x8c, 0x06, 0xcc, 0x65, 0x68, 0x8c, 0x41,
+		0xba, 0x53, 0x31, 0x86, 0xc4, 0xde, 0x63, 0x0c, 0xee, 0xa5, 0x6d, 0xd2, 0xbb, 0xb4, 0xfd, 0xaa,
+		0x04, 0x43, 0x1e, 0xb3, 0x8d, 0x9d, 0x50, 0x33, 0xea, 0x62, 0xfb, 0x9c, 0xfe, 0x46, 0x9f, 0xa6,
+		0x69, 0x6c, 0xf2, 0x25, 0x25, 0xfe, 0x44, 0x28, 0x7b, 0x22, 0xca, 0xf0, 0x69, 0xc6, 0x5e, 0xa7,
+		0xf6, 0xb9, 0xaf, 0xb0, 0xf1, 0x6b, 0x5d, 0xfd, 0xce, 0xb5, 0x2e, 0x7b, 0xd7, 0x7c, 0xc0, 0xb5,
+		0x6b, 0x4e, 0x1e, 0xc3, 0x2d, 0x27, 0xc3, 0xd8, 0xa8, 0x1a, 0x2d, 0x36, 0xd7, 0x7b, 0x7c, 0xa3,
+		0xc0, 0x95, 0x2e, 0x93, 0xde, 0x18, 0xa0, 0xbf, 0x5c, 0x1e, 0x4b, 0xc6, 0xe3, 0xb1, 0x1c, 0x81,
+		0x0c, 0xb2, 0xcf, 0xbe, 0x7e, 0x04, 0xfc, 0x65, 0x13, 0x91, 0x21, 0xff, 0x7c, 0x02, 0x46, 0x7c,
+		0xb3, 0x4e, 0x68, 0xe3, 0xc3, 0x8e, 0xaf, 0xf4, 0x26, 0x90, 0xa3, 0x00, 0x9b, 0xaa, 0x59, 0xbd,
+		0xa1, 0xea, 0x96, 0x56, 0xe7, 0x52, 0x71, 0xe5, 0xe0, 0x4d, 0x07, 0x4c, 0x75, 0x4c, 0xad, 0xce,
+		0xa3, 0x39, 0x76, 0x1a, 0xaf, 0x46, 0x6a, 0xd7, 0xe9, 0xeb, 0xdf, 0xec, 0x0d, 0xfd, 0x83, 0x21,
+		0xe6, 0x09, 0xcb, 0x4b, 0x79, 0xec, 0xee, 0xbf, 0x7d, 0x79, 0x22, 0xc7, 0xc0, 0x5f, 0x65, 0xdf,
+		0x97, 0x55, 0x38, 0x01, 0xaf, 0x18, 0xd2, 0x3e, 0x31, 0xd0, 0xd0, 0x62, 0x56, 0xc4, 0x09, 0x50,
+		0xa8, 0xec, 0x72, 0x0f, 0x5e, 0xd7, 0xdf, 0x6e, 0x19, 0x46, 0xb3, 0xca, 0xc6, 0x79, 0x11, 0x86,
+		0xbd, 0x93, 0x2c, 0xfb, 0x34, 0xa1, 0x85, 0xd1, 0x36, 0x8f, 0x1f, 0x9d, 0x65, 0x99, 0x6c, 0x5c,
+		0x5d, 0x49, 0xa5, 0xa5, 0x5c, 0x82, 0x87, 0x76, 0x5e, 0x03, 0xfb, 0x43, 0xe7, 0x58, 0xf2, 0x28,
+		0x64, 0x9c, 0xf9, 0x99, 0x5d, 0xd0, 0xea, 0x16, 0xb3, 0x71, 0x80, 0xe5, 0xab, 0xb0, 0x3f, 0x74,
+		0x92, 0x25, 0x4f, 0x42, 0x3f, 0x3b, 0xbc, 0xcd, 0x0f, 0xe8, 0xdd, 0x1f, 0x3f, 0x3b, 0x77, 0x9a,
+		0x96, 0xc2, 0x91, 0xe4, 0x33, 0x70, 0x28, 0x72, 0x96, 0x75, 0x22, 0x2f, 0x92, 0x2b, 0xf2, 0x22,
+		0xff, 0x92, 0x04, 0x85, 0xe8, 0x99, 0x93, 0x94, 0x7c, 0x0c, 0x9d, 0xec, 0x71, 0xde, 0x75, 0x71,
+		0x85, 0x4b, 0x93, 0xb6, 0xb6, 0xa1, 0x59, 0xb5, 0x2d, 0x36, 0x85, 0x33, 0xa3, 0x30, 0xa4, 0x0c,
+		0xf1, 0x5c, 0x8a, 0x63, 0x32, 0xb0, 0x37, 0x69, 0x35, 0xab, 0xca, 0x3a, 0xd5, 0xe4, 0x5f, 0x36,
+		0x1f, 0x62, 0xb9, 0x15, 0x96, 0x29, 0x9f, 0x82, 0x83, 0x11, 0x73, 0x71, 0xc8, 0xd9, 0xf3, 0xe7,
+		0x11, 0x38, 0x74, 0x82, 0x25, 0x4f, 0x43, 0xbf, 0x69, 0xa9, 0x56, 0xc7, 0xe4, 0x2d, 0x3b, 0x1e,
+		0x3b, 0x37, 0x57, 0x28, 0xb8, 0xc2, 0xd1, 0xe4, 0xc7, 0x81, 0x04, 0x67, 0xda, 0x90, 0x75, 0x98,
+		0x14, 0xb6, 0x0e, 0x5b, 0x87, 0xc3, 0x5d, 0xe6, 0x54, 0x32, 0xeb, 0x63, 0xee, 0x54, 0x4f, 0x53,
+		0xb2, 0x8f, 0xc1, 0xbf, 0x4f, 0xc0, 0xfe, 0xd0, 0xa9, 0xd5, 0x35, 0x4a, 0xa5, 0xdb, 0x1d, 0xa5,
+		0x4f, 0x02, 0x58, 0x37, 0xc5, 0x8d, 0x03, 0x6e, 0xed, 0xc3, 0xd6, 0x13, 0x37, 0xb5, 0xda, 0xea,
+		0x4d, 0xae, 0x18, 0x19, 0x8b, 0xff, 0xc2, 0x40, 0x81, 0x6b, 0xed, 0xdb, 0xa1, 0x33, 0x81, 0x99,
+		0x4f, 0xee, 0x6e, 0xce, 0xc8, 0x5d, 0xf7, 0x66, 0x9b, 0xe4, 0x79, 0x38, 0xe8, 0x9b, 0xd1, 0x6c,
+		0xda, 0xa9, 0x9e, 0x27, 0xb6, 0xfd, 0xde, 0x89, 0x4d, 0xd0, 0x76, 0xcf, 0x4a, 0x7d, 0xde, 0x59,
+		0xe9, 0x79, 0x00, 0x67, 0x11, 0xec, 0x1c, 0x8e, 0x95, 0xdc, 0x87, 0x63, 0x2f, 0x40, 0x1f, 0x6a,
+		0x82, 0x10, 0x55, 0x88, 0xc1, 0xc0, 0x2e, 0x75, 0xad, 0xa2, 0x19, 0xb8, 0xfc, 0x26, 0x20, 0xc1,
+		0x78, 0x64, 0x44, 0x1d, 0x4f, 0x79, 0xeb, 0x90, 0xa3, 0x43, 0x9b, 0xe1, 0x75, 0xbd, 0x05, 0xfa,
+		0x68, 0xf7, 0x87, 0xde, 0x77, 0x7e, 0x03, 0x80, 0x6a, 0x59, 0xed, 0xc6, 0x7a, 0xc7, 0xa9, 0x61,
+		0x32, 0x42, 0x7f, 0x8a, 0x02, 0xb0, 0x74, 0x84, 0x2b, 0xd2, 0xb8, 0x83, 0xeb, 0x52, 0x26, 0x17,
+		0x45, 0x79, 0x09, 0x86, 0xbd, 0xb8, 0xe1, 0x17, 0xb8, 0x9d, 0x67, 0xa2, 0xc4, 0x21, 0x37, 0x67,
+		0x22, 0xe7, 0x4f, 0xb7, 0xd1, 0x84, 0xfc, 0x6d, 0x09, 0xc8, 0xba, 0xb5, 0xef, 0xdf, 0xe0, 0x64,
+		0x29, 0xbf, 0x4b, 0x82, 0xb4, 0xdd, 0xfe, 0x2e, 0x57, 0x03, 0x9c, 0xab, 0xfc, 0x76, 0xbc, 0x9e,
+		0xed, 0x90, 0x24, 0xed, 0x1d, 0x92, 0x27, 0xec, 0x09, 0x21, 0x72, 0x31, 0xef, 0x96, 0xb6, 0x38,
+		0x94, 0xcb, 0x27, 0xa8, 0xc7, 0x7b, 0xbb, 0x2d, 0x8c, 0xdb, 0x37, 0xae, 0xab, 0xbe, 0x2c, 0x21,
+		0x9b, 0xae, 0xb3, 0x4b, 0x6c, 0x34, 0x3a, 0x80, 0x09, 0x17, 0x60, 0xf0, 0x82, 0x6f, 0x32, 0xfe,
+		0x82, 0x6f, 0xca, 0x7f, 0xc1, 0x97, 0xf9, 0x00, 0xf2, 0x0f, 0x49, 0x90, 0x16, 0xa3, 0x84, 0x3c,
+		0xed, 0x3e, 0x67, 0x27, 0x0e, 0xed, 0x44, 0x5b, 0x29, 0xde, 0x78, 0x07, 0x27, 0x78, 0x5b, 0x21,
+		0xb9, 0xeb, 0xdb, 0x0a, 0xdc, 0x2b, 0xf9, 0x0a, 0x7e, 0xa7, 0xca, 0x37, 0x8a, 0x6f, 0x9f, 0xbf,
+		0xe0, 0xec, 0x95, 0x0c, 0x99, 0xbd, 0xa2, 0xee, 0x20, 0xa4, 0xa2, 0xee, 0x20, 0x04, 0xdb, 0xdd,
+		0xb7, 0xd7, 0x76, 0x7f, 0x7b, 0x02, 0x06, 0x5d, 0xd1, 0x41, 0x3c, 0x7c, 0xe8, 0xba, 0xd0, 0x70,
+		0xac, 0x6b, 0x28, 0xd1, 0x75, 0xa3, 0xc1, 0x23, 0xa9, 0xc4, 0x1e, 0x24, 0x75, 0xe7, 0xef, 0x39,
+		0x86, 0x5f, 0xbc, 0xef, 0x8b, 0xb8, 0x78, 0xff, 0x76, 0x09, 0xd2, 0x76, 0x28, 0x66, 0xb7, 0xbb,
+		0x79, 0x07, 0xa0, 0x9f, 0x7b, 0x62, 0x6c, 0x3b, 0x8f, 0xa7, 0x42, 0xe3, 0xaa, 0x05, 0x48, 0x8b,
+		0x8f, 0xb2, 0xf2, 0xf9, 0xce, 0x4e, 0x9f, 0x5c, 0x87, 0x41, 0xd7, 0x86, 0x28, 0x39, 0x04, 0xfb,
+		0x67, 0x2f, 0x97, 0x67, 0x9f, 0xa9, 0xae, 0x3e, 0xeb, 0xff, 0xb0, 0x5f, 0xa0, 0x48, 0x29, 0xd3,
+		0x74, 0x4e, 0xc2, 0xef, 0x62, 0x79, 0x8b, 0x58, 0x41, 0xa2, 0x90, 0xc2, 0xb3, 0xe6, 0x27, 0xbf,
+		0x22, 0xc1, 0x58, 0x88, 0xcf, 0x4b, 0x8e, 0xc1, 0x3d, 0xcb, 0x17, 0x2f, 0x96, 0x95, 0x6a, 0x65,
+		0xa9, 0xb8, 0x52, 0xb9, 0xbc, 0xbc, 0x5a, 0x55, 0xca, 0x95, 0xb5, 0x85, 0x55, 0x57, 0xa5, 0x93,
+		0x70, 0x24, 0x1c, 0xa4, 0x38, 0x3b, 0x5b, 0x5e, 0x59, 0x65, 0x5f, 0x16, 0x8c, 0x80, 0x28, 0x2d,
+		0x2b, 0xf8, 0x15, 0xfe, 0x48, 0x12, 0x4a, 0xf9, 0x4a, 0x79, 0x76, 0x35, 0x97, 0x24, 0xc7, 0xe1,
+		0xde, 0x6e, 0x10, 0xd5, 0x8b, 0xf8, 0xf9, 0xc5, 0xd5, 0x5c, 0x2a, 0x16, 0xb0, 0x52, 0x5e, 0x9a,
+		0x2b, 0x2b, 0xb9, 0x3e, 0xde, 0xee, 0x0f, 0x26, 0x20, 0x1f, 0xe5, 0x5a, 0x23, 0xad, 0xe2, 0xca,
+		0xca, 0xc2, 0x73, 0x0e, 0xad, 0xd9, 0xcb, 0x6b, 0x4b, 0xcf, 0x04, 0x45, 0xf0, 0x00, 0xc8, 0xdd,
+		0x00, 0x6d, 0x41, 0xdc, 0x0f, 0xc7, 0xba, 0xc2, 0x71, 0x71, 0xc4, 0x80, 0x29, 0x65, 0xfc, 0x2c,
+		0x62, 0x92, 0x4c, 0xc1, 0xc9, 0x58, 0x30, 0xbb, 0x2c, 0x97, 0x22, 0xd3, 0x70, 0xaa, 0x3b, 0x3c,
+		0x13, 0x90, 0x40, 0x10, 0x22, 0x7a, 0x51, 0x82, 0xfd, 0xa1, 0x3e, 0x3a, 0xb9, 0x17, 0x26, 0x56,
+		0x94, 0xe5, 0xd9, 0x72, 0xa5, 0x62, 0x5f, 0x67, 0xa8, 0x56, 0x56, 0x8b, 0xab, 0x6b, 0x15, 0x97,
+		0x6c, 0x64, 0x38, 0x1a, 0x05, 0x64, 0xcb, 0xa5, 0x0b, 0x0c, 0xd7, 0x00, 0xa1, 0xa7, 0xb7, 0x24,
+		0x38, 0x14, 0xe9, 0x93, 0x93, 0x13, 0x70, 0xdf, 0xd5, 0xb2, 0x32, 0x7f, 0xf1, 0xb9, 0x2a, 0xde,
+		0xdd, 0x70, 0x7d, 0xc2, 0x32, 0xc0, 0xd5, 0x71, 0xb8, 0xb7, 0x2b, 0xa4, 0xcd, 0x5a, 0x1c, 0xa0,
+		0x8f, 0xbf, 0x77, 0x4a, 0x30, 0xe2, 0xb3, 0x85, 0xe4, 0x08, 0xe4, 0x17, 0xe7, 0x2b, 0xa5, 0xf2,
+		0xe5, 0xe2, 0xd5, 0xf9, 0x65, 0xc5, 0x3f, 0x66, 0xef, 0x85, 0x89, 0x40, 0xe9, 0xdc, 0xda, 0xca,
+		0xc2, 0xfc, 0x6c, 0x71, 0xb5, 0x5c, 0x65, 0x77, 0x50, 0xb0, 0x61, 0x01, 0xa0, 0x85, 0xf9, 0x4b,
+		0x97, 0x57, 0xab, 0xb3, 0x0b, 0xf3, 0xe5, 0xa5, 0xd5, 0x6a, 0x71, 0x75, 0xb5, 0xe8, 0x0c, 0xe7,
+		0xd2, 0x33, 0x91, 0x67, 0x3f, 0xcf, 0xf4, 0x7e, 0xf6, 0x93, 0x9f, 0xee, 0xb4, 0x8f, 0x7e, 0x7e,
+		0xf6, 0x51, 0xb8, 0x8f, 0xbf, 0x8a, 0x64, 0x5a, 0xea, 0xb5, 0x86, 0xbe, 0x69, 0x3f, 0x4f, 0xc5,
+		0xd3, 0xfc, 0x08, 0xe8, 0x01, 0x06, 0x35, 0x25, 0x72, 0x63, 0x1e, 0xa9, 0x8a, 0x7c, 0xdb, 0x34,
+		0xf6, 0xea, 0x40, 0xdc, 0x01, 0xcf, 0x6e, 0x0f, 0x60, 0xc5, 0x3c, 0xb3, 0x15, 0xf2, 0x40, 0x56,
+		0xa1, 0xfb, 0x53, 0x0e, 0x85, 0xae, 0xe7, 0x62, 0xf1, 0xca, 0xd9, 0xf0, 0xe5, 0x86, 0x69, 0x19,
+		0xed, 0x46, 0x4d, 0x6d, 0x52, 0x47, 0xe2, 0x89, 0x9e, 0xef, 0xba, 0x95, 0x32, 0x38, 0x8d, 0xf1,
+		0x67, 0xb4, 0xb6, 0xc4, 0x75, 0xb3, 0xfe, 0xeb, 0x6a, 0x93, 0xdd, 0x33, 0x73, 0xbf, 0xc3, 0xe7,
+		0x17, 0xbb, 0x6b, 0x7e, 0x75, 0x53, 0x61, 0xb8, 0x78, 0x5a, 0x58, 0xfe, 0x2e, 0x09, 0x72, 0x0e,
+		0x6b, 0x8a, 0x56, 0x33, 0xda, 0x75, 0xea, 0x37, 0xb6, 0x5a, 0xae, 0x6d, 0x54, 0x91, 0x24, 0xe7,
+		0xf8, 0xe4, 0x9b, 0x88, 0x9d, 0x7c, 0x53, 0xae, 0x89, 0x37, 0xe4, 0x4e, 0x7f, 0x32, 0xec, 0x4e,
+		0xbf, 0xfc, 0xc3, 0x18, 0x7a, 0xc3, 0xc5, 0x97, 0x49, 0x17, 0xeb, 0x74, 0x39, 0x78, 0x05, 0x52,
+		0x6d, 0xd5, 0xe2, 0x4b, 0xa4, 0xd2, 0x85, 0x5d, 0xbf, 0x04, 0xc6, 0xda, 0x4c, 0x69, 0x90, 0xd7,
+		0x00, 0x1e, 0xd4, 0xaf, 0x52, 0x7a, 0x89, 0xdb, 0xa2, 0x87, 0xf7, 0x15, 0x90, 0x3f, 0xf2, 0x06,
+		0xc0, 0xeb, 0x06, 0xb8, 0x85, 0xa6, 0x6f, 0x6a, 0x8c, 0x72, 0xf2, 0xb6, 0x28, 0x0f, 0x6d, 0xab,
+		0x37, 0x67, 0x29, 0x35, 0xa4, 0xcf, 0x5f, 0x4c, 0xfb, 0x1d, 0x09, 0xc0, 0x11, 0x0c, 0x51, 0x31,
+		0xa0, 0x2c, 0x52, 0xb4, 0x52, 0x11, 0x50, 0x3e, 0x1e, 0xa5, 0x09, 0x3e, 0xb1, 0x96, 0x86, 0x90,
+		0xbd, 0x4f, 0xbf, 0x3c, 0x21, 0xb1, 0x5a, 0x47, 0x6a, 0x01, 0xb1, 0x0f, 0xb2, 0x15, 0x7d, 0xb5,
+		0xc7, 0x0e, 0x1f, 0x12, 0xde, 0x16, 0x23, 0x08, 0x0c, 0x1b, 0xcb, 0x79, 0x1b, 0x3e, 0x22, 0xc1,
+		0xe0, 0x9c, 0xeb, 0x15, 0x53, 0xdc, 0x11, 0x30, 0xf4, 0xc6, 0x35, 0xad, 0x6d, 0xef, 0x08, 0xb0,
+		0x24, 0x7a, 0x44, 0xec, 0x63, 0x98, 0xd6, 0x8e, 0x78, 0xe9, 0x45, 0xa4, 0x11, 0xeb, 0x86, 0xb6,
+		0x6e, 0x36, 0x84, 0x9c, 0x15, 0x91, 0xc4, 0xbd, 0x61, 0x53, 0xab, 0x75, 0x30, 0x48, 0x59, 0xad,
+		0x19, 0xba, 0xa5, 0xd6, 0x2c, 0xbe, 0xe8, 0x18, 0x11, 0xf9, 0xb3, 0x2c, 0x1b, 0x89, 0xd4, 0x35,
+		0x4b, 0x6d, 0x34, 0xd9, 0xa1, 0xba, 0x8c, 0x22, 0x92, 0x9c, 0xd5, 0x5b, 0x03, 0xee, 0x65, 0xd4,
+		0x2c, 0xe4, 0x8c, 0x96, 0xd6, 0xf6, 0x9c, 0x1e, 0x60, 0xda, 0x98, 0xff, 0xcc, 0x4b, 0xa7, 0xc7,
+		0xb9, 0xc0, 0xf9, 0xbe, 0x33, 0xbb, 0x2a, 0xa6, 0x8c, 0x08, 0x0c, 0x9e, 0x4d, 0x9e, 0xf3, 0xec,
+		0x01, 0x74, 0xd6, 0x9d, 0x87, 0x9a, 0xc6, 0x03, 0x42, 0x2d, 0xea, 0x3b, 0xa5, 0xfc, 0xa7, 0x1c,
+		0xd2, 0x3c, 0xc8, 0xbd, 0x42, 0x97, 0x4e, 0xee, 0xfd, 0x00, 0x4a, 0x06, 0x9d, 0xcd, 0x37, 0xa9,
+		0x8d, 0xa6, 0xf8, 0x6e, 0xb0, 0xc2, 0x53, 0xb8, 0x3f, 0xca, 0x63, 0x5c, 0x29, 0xea, 0xbb, 0xcb,
+		0x51, 0xba, 0x51, 0x32, 0xf4, 0xba, 0x37, 0xb4, 0x85, 0xf1, 0x31, 0xcb, 0xb8, 0xa6, 0xe9, 0x5c,
+		0x40, 0xa5, 0x53, 0xbb, 0x78, 0xf2, 0x4f, 0xe1, 0xa8, 0xe4, 0x75, 0x90, 0xab, 0x6b, 0x4d, 0x6d,
+		0x93, 0x4a, 0xce, 0xdc, 0x52, 0xf1, 0xaa, 0x7a, 0xff, 0x5e, 0x1f, 0xf4, 0x1b, 0xb1, 0x49, 0x55,
+		0x28, 0x25, 0xb2, 0xe2, 0x7d, 0x27, 0x77, 0xc0, 0xde, 0xbe, 0x0e, 0x6d, 0xa3, 0x4b, 0xf3, 0xdc,
+		0xb6, 0xd0, 0x4d, 0x02, 0x35, 0xa8, 0xa3, 0xaf, 0x1b, 0x3a, 0xfd, 0xdc, 0x26, 0xf7, 0xf7, 0xd3,
+		0x6c, 0x5f, 0xc8, 0xce, 0xe7, 0xfb, 0x42, 0x2b, 0x30, 0xec, 0x80, 0xd2, 0x11, 0x92, 0xd9, 0xed,
+		0x08, 0x19, 0xb2, 0x09, 0x20, 0x08, 0x7e, 0x97, 0xd3, 0x19, 0x83, 0x74, 0x57, 0x62, 0xf0, 0xac,
+		0x1c, 0x3f, 0x9a, 0xdd, 0x8d, 0x71, 0x11, 0x20, 0x3a, 0x8c, 0x6d, 0x37, 0xf4, 0xaa, 0xa9, 0x35,
+		0x37, 0xaa, 0x5c, 0x72, 0x48, 0x77, 0x90, 0x8a, 0xff, 0xa9, 0x5d, 0xf4, 0xe6, 0x9f, 0xbf, 0x74,
+		0x7a, 0xc4, 0x79, 0x09, 0x71, 0xf2, 0xa1, 0xa9, 0x73, 0x8f, 0x28, 0xa3, 0xdb, 0x0d, 0xbd, 0xa2,
+		0x35, 0x37, 0xe6, 0x6c, 0xc2, 0xe4, 0x09, 0x38, 0xec, 0x08, 0xc4, 0xd0, 0xab, 0x5b, 0x46, 0xb3,
+		0x5e, 0x6d, 0x6b, 0x1b, 0xd5, 0x1a, 0x7d, 0xc7, 0x31, 0x4b, 0xc5, 0x78, 0xd0, 0x06, 0x59, 0xd6,
+		0x2f, 0x1b, 0xcd, 0xba, 0xa2, 0x6d, 0xcc, 0x62, 0x31, 0xee, 0x19, 0x38, 0xd8, 0x8d, 0x3a, 0xee,
+		0xc6, 0x27, 0xf1, 0x60, 0xb1, 0x9d, 0x39, 0x5f, 0x37, 0x67, 0xd2, 0xdf, 0xf9, 0xc1, 0x89, 0x7d,
+		0x5f, 0xf8, 0xe0, 0xc4, 0x3e, 0xf9, 0x22, 0x7d, 0xca, 0x8d, 0x0f, 0x2d, 0xcd, 0x24, 0x17, 0x20,
+		0xa3, 0x8a, 0x04, 0xbb, 0x5d, 0xd5, 0x65, 0x68, 0x3a, 0xa0, 0x78, 0xb1, 0xac, 0x7f, 0xee, 0xea,
+		0x8a, 0xda, 0x68, 0x93, 0x32, 0xbe, 0x82, 0x2b, 0x74, 0xb5, 0xd7, 0x51, 0xee, 0xa8, 0xb7, 0x18,
+		0xe6, 0x4b, 0x51, 0x47, 0x8d, 0x32, 0xa5, 0x63, 0x9f, 0x79, 0xe9, 0xf4, 0x3d, 0x9c, 0xcc, 0x55,
+		0xdf, 0xa9, 0x23, 0x41, 0xcf, 0x7f, 0x1a, 0xc9, 0xd5, 0xe6, 0x2b, 0x30, 0xc0, 0x58, 0x35, 0xc9,
+		0xd3, 0xd0, 0xd7, 0xc2, 0x1f, 0x3c, 0xb8, 0x7c, 0x34, 0x52, 0xe7, 0x29, 0xbc, 0x5b, 0x43, 0x18,
+		0x9e, 0xfc, 0x5d, 0x09, 0x80, 0xb9, 0xab, 0x57, 0x57, 0xdb, 0x8d, 0x56, 0x53, 0xb3, 0xee, 0x54,
+		0xdb, 0xd7, 0x60, 0xbf, 0xd3, 0x76, 0xb3, 0x5d, 0xdb, 0x7d, 0xfb, 0xc7, 0x9c, 0xeb, 0xf0, 0xed,
+		0x5a, 0x28, 0xd9, 0xba, 0x69, 0xd9, 0x64, 0x93, 0xbb, 0x27, 0x3b, 0x67, 0x5a, 0x41, 0xc9, 0x3e,
+		0x0b, 0x83, 0x8e, 0x30, 0x4c, 0x32, 0x0f, 0x69, 0x8b, 0xff, 0xe6, 0x02, 0x96, 0xa3, 0x05, 0x2c,
+		0xd0, 0xdc, 0x42, 0xb6, 0xd1, 0x71, 0x17, 0x15, 0x5c, 0x63, 0xe4, 0x95, 0xa9, 0x63, 0x18, 0x25,
+		0xe5, 0xc6, 0x39, 0xb9, 0xe7, 0xd7, 0x56, 0x19, 0x01, 0x97, 0x50, 0xbf, 0x27, 0x81, 0xdf, 0xd0,
+		0xe6, 0xa3, 0xf7, 0x95, 0x2f, 0x83, 0x35, 0x18, 0xd0, 0x74, 0xab, 0xdd, 0xb0, 0x37, 0x47, 0x1e,
+		0x8a, 0xea, 0xf3, 0x90, 0x46, 0x95, 0x75, 0xab, 0xbd, 0xe3, 0xd6, 0x00, 0x41, 0xcb, 0x25, 0x8f,
+		0xf7, 0x25, 0x21, 0x1f, 0x85, 0x8a, 0x0e, 0x72, 0xad, 0xad, 0xd1, 0x0c, 0xef, 0x65, 0xbf, 0x61,
+		0x91, 0xcd, 0xa7, 0x1d, 0x05, 0xd0, 0x51, 0x43, 0xe5, 0x42, 0xd0, 0xbd, 0x79, 0x66, 0xc3, 0x0e,
+		0x05, 0x3a, 0xf1, 0xac, 0xc2, 0x88, 0xb8, 0x41, 0xb0, 0xae, 0x36, 0x55, 0xbc, 0x21, 0x9d, 0xdc,
+		0xfd, 0x9c, 0x2f, 0x6e, 0x21, 0x94, 0x18, 0x09, 0x52, 0x86, 0x01, 0x41, 0x2d, 0xb5, 0x7b, 0x6a,
+		0x02, 0x17, 0xef, 0x8a, 0xb8, 0x27, 0x06, 0xea, 0x8d, 0xa4, 0x94, 0x41, 0x3b, 0x6f, 0xbe, 0x1e,
+		0x37, 0xf3, 0xf4, 0x77, 0x9d, 0x79, 0xb8, 0xc3, 0xf7, 0xe3, 0x49, 0x18, 0x55, 0xb4, 0xfa, 0x37,
+		0x7f, 0xb7, 0xac, 0x00, 0xb0, 0xa1, 0x8a, 0x96, 0x34, 0x9f, 0xda, 0xeb, 0x78, 0xcf, 0x30, 0x22,
+		0x73, 0xa6, 0xf5, 0xf5, 0xea, 0xa1, 0xbf, 0x48, 0x40, 0xd6, 0xdd, 0x43, 0xff, 0x26, 0x27, 0x2d,
+		0xb2, 0xe4, 0x98, 0x29, 0x76, 0x07, 0xe2, 0xc1, 0x28, 0x33, 0x15, 0xd0, 0xe6, 0x18, 0xfb, 0xf4,
+		0xb5, 0x3e, 0xe8, 0xe7, 0xe7, 0x8b, 0x96, 0x03, 0xbe, 0x6d, 0xec, 0x4d, 0xef, 0x21, 0x71, 0x59,
+		0x3e, 0xd4, 0xb5, 0xbd, 0x1f, 0xf0, 0x0a, 0x7d, 0xd5, 0x73, 0x68, 0x09, 0xa3, 0xe2, 0xb8, 0xd4,
+		0xb5, 0x5b, 0x6f, 0xe2, 0xc1, 0x2d, 0x04, 0x73, 0xec, 0x30, 0xc2, 0xe0, 0x7d, 0x93, 0x32, 0xcb,
+		0x21, 0xa7, 0x81, 0x6c, 0xd9, 0xb1, 0x8a, 0xaa, 0x23, 0x08, 0x84, 0x1b, 0x75, 0x4a, 0x04, 0x38,
+		0x3e, 0x7c, 0x63, 0xe8, 0xf5, 0x2a, 0x7b, 0x60, 0x9c, 0x3f, 0xdf, 0x8e, 0x39, 0x73, 0x98, 0x41,
+		0xde, 0x29, 0x31, 0x17, 0xd9, 0xb7, 0x92, 0xe6, 0x2b, 0x94, 0xd5, 0x1e, 0x06, 0xc5, 0x3f, 0xbf,
+		0x3c, 0x51, 0xd8, 0x51, 0xb7, 0x9b, 0x33, 0x72, 0x08, 0x1d, 0x39, 0x6c, 0x71, 0x8f, 0x8e, 0xb3,
+		0x77, 0x25, 0x4e, 0xe6, 0x21, 0x87, 0x7b, 0x64, 0x6d, 0xfe, 0x7d, 0xfa, 0xea, 0x86, 0x26, 0x1e,
+		0x5b, 0x38, 0x34, 0x15, 0xf2, 0xdc, 0xfb, 0x14, 0x3e, 0x9b, 0xce, 0x77, 0x4b, 0x86, 0xaf, 0x69,
+		0x3b, 0x0a, 0xc7, 0xbb, 0xa8, 0x69, 0xd8, 0xa2, 0x43, 0x9b, 0x4d, 0x63, 0x1d, 0xef, 0xdf, 0x34,
+		0x5e, 0xe8, 0x34, 0xea, 0x55, 0xae, 0x15, 0xd5, 0x9a, 0xda, 0x62, 0x9b, 0x96, 0xa5, 0xf9, 0x5d,
+		0x0f, 0xf6, 0xc0, 0x02, 0xe0, 0xfc, 0x59, 0xd6, 0x98, 0x03, 0xac, 0xae, 0x05, 0x5a, 0x55, 0x85,
+		0xd5, 0x34, 0xab, 0xb6, 0xc8, 0x8b, 0x12, 0x1c, 0x71, 0x94, 0x3f, 0x84, 0x93, 0xcc, 0x9d, 0xe6,
+		0xe4, 0x90, 0x5d, 0x9d, 0x9f, 0x99, 0x99, 0xfb, 0xd0, 0x7a, 0xbc, 0xf8, 0xf9, 0x8f, 0x9e, 0x3c,
+		0xec, 0x60, 0x4e, 0xdf, 0xb4, 0xa3, 0x98, 0x4c, 0xe5, 0x71, 0x21, 0x40, 0x9c, 0x49, 0xd9, 0x75,
+		0x70, 0x0f, 0x5c, 0x6b, 0x27, 0xa9, 0xfb, 0x9a, 0xcc, 0xc1, 0xf7, 0xac, 0xc9, 0x5c, 0x26, 0xeb,
+		0x29, 0x67, 0x4e, 0x4c, 0xc4, 0xf5, 0xb0, 0x7b, 0xb4, 0x72, 0x24, 0x6a, 0x09, 0xf7, 0xc9, 0xff,
+		0x9f, 0x04, 0x87, 0x02, 0xa3, 0xdb, 0x66, 0xb9, 0x06, 0xa4, 0xed, 0x2a, 0xa4, 0xa3, 0x44, 0xbc,
+		0x96, 0xbc, 0x37, 0x63, 0x31, 0xda, 0xf6, 0x97, 0xde, 0xa1, 0xc9, 0x9d, 0x5b, 0xf6, 0x3f, 0x94,
+		0x60, 0xdc, 0xcd, 0x80, 0xdd, 0x94, 0x0a, 0x64, 0xdd, 0x55, 0xe7, 0x25, 0xfb, 0x3c, 0x6e, 0x6c,
+		0x23, 0xdc, 0xfc, 0x7b, 0x88, 0x90, 0xab, 0x8e, 0x05, 0x65, 0xb1, 0xd3, 0x33, 0x3d, 0x0b, 0x45,
+		0x30, 0x16, 0x6a, 0x49, 0x59, 0xdf, 0x7c, 0x51, 0x82, 0xd4, 0x8a, 0x61, 0x34, 0xc9, 0x0b, 0x30,
+		0xaa, 0x1b, 0x56, 0x15, 0xad, 0x8d, 0x56, 0xaf, 0xf2, 0x50, 0x0a, 0x9b, 0x9d, 0xca, 0x5d, 0x65,
+		0xf5, 0xb7, 0x2f, 0x4f, 0x04, 0x31, 0xc3, 0x3e, 0xa9, 0x30, 0xa2, 0x1b, 0x56, 0x89, 0x02, 0xad,
+		0x52, 0x18, 0xb2, 0x01, 0x43, 0xde, 0xea, 0xd8, 0x0c, 0x56, 0x8c, 0xab, 0x6e, 0x28, 0xb6, 0xaa,
+		0xec, 0xba, 0xab, 0x1e, 0xf6, 0x3c, 0xfc, 0x3f, 0x62, 0xcf, 0xbd, 0x01, 0x72, 0x57, 0xfd, 0x27,
+		0x83, 0x2e, 0xc2, 0x80, 0x38, 0x09, 0x24, 0xf5, 0x7a, 0xca, 0xc8, 0x2d, 0x4f, 0x8e, 0x4c, 0x83,
+		0xd3, 0x9f, 0x4e, 0xc0, 0x21, 0x3c, 0x34, 0xc4, 0x03, 0x5f, 0xdc, 0xd2, 0xb1, 0x70, 0xf5, 0x0e,
+		0x46, 0x6b, 0x42, 0xc3, 0x72, 0xd9, 0x60, 0xf0, 0xed, 0x2a, 0x8c, 0xa0, 0xcb, 0x81, 0x81, 0xb3,
+		0xdb, 0x8b, 0xbd, 0x0d, 0x19, 0xcd, 0x3a, 0xe7, 0x08, 0x23, 0x6f, 0x57, 0x61, 0x44, 0xd7, 0x6e,
+		0x78, 0xe8, 0x26, 0xf7, 0x46, 0x57, 0xd7, 0x6e, 0xb8, 0xe8, 0x3a, 0xdb, 0xcd, 0x29, 0xcf, 0x76,
+		0xf3, 0x05, 0x48, 0xe2, 0xf4, 0xd0, 0xb7, 0x0b, 0xe3, 0x81, 0x08, 0xae, 0x69, 0xbe, 0x02, 0x87,
+		0x78, 0xe4, 0xc4, 0x5c, 0xde, 0xa0, 0x12, 0xd5, 0x68, 0x83, 0x9e, 0xd1, 0x76, 0x42, 0xc2, 0x28,
+		0xd9, 0xde, 0xc2, 0x28, 0x5f, 0x92, 0x60, 0x8c, 0x2a, 0x47, 0xe3, 0xcd, 0x1a, 0x0d, 0xce, 0xf1,
+		0x7d, 0x84, 0x61, 0x48, 0xf0, 0xb7, 0xeb, 0x52, 0x4a, 0xa2, 0x51, 0x27, 0x53, 0xd0, 0x67, 0xdc,
+		0xd0, 0xf9, 0x61, 0x92, 0x6e, 0x6e, 0x1a, 0x03, 0x23, 0x4f, 0xc3, 0xf0, 0xb6, 0x51, 0xef, 0x34,
+		0xb5, 0xaa, 0x5a, 0x63, 0xae, 0x62, 0x32, 0x06, 0x71, 0x88, 0xc1, 0x17, 0x19, 0xb8, 0xf7, 0x10,
+		0x42, 0xaa, 0x57, 0xcf, 0xcb, 0xc1, 0x99, 0x19, 0xfb, 0x4c, 0x70, 0xca, 0x39, 0xf9, 0x71, 0x09,
+		0xc0, 0x09, 0x99, 0xe2, 0x0b, 0x72, 0xa5, 0xe5, 0xa5, 0x39, 0x67, 0xa3, 0xd1, 0xf5, 0x19, 0x2d,
+		0xf1, 0xde, 0x9c, 0xd9, 0xd2, 0x6a, 0x8d, 0x8d, 0x86, 0x56, 0x27, 0x0f, 0xc0, 0xb8, 0x17, 0x1a,
+		0x53, 0xe5, 0xb9, 0x9c, 0x54, 0xc8, 0xbe, 0x78, 0x6b, 0x32, 0xcd, 0x96, 0x88, 0x1a, 0x3e, 0xa7,
+		0xbc, 0x3f, 0x08, 0x87, 0x9f, 0xe0, 0x4a, 0x14, 0x86, 0x5e, 0xbc, 0x35, 0x99, 0xb1, 0xd7, 0x92,
+		0x44, 0x06, 0xe2, 0x86, 0xe4, 0xf4, 0x92, 0x05, 0x78, 0xf1, 0xd6, 0x64, 0x3f, 0xb3, 0x10, 0x7c,
+		0x87, 0xf2, 0xf5, 0xf8, 0x6a, 0xda, 0x46, 0x5b, 0xad, 0x51, 0x4b, 0x58, 0x80, 0x03, 0xf3, 0x4b,
+		0x17, 0x95, 0xe2, 0xec, 0x2a, 0xee, 0x69, 0x7a, 0xd8, 0xf6, 0x95, 0xcd, 0x2d, 0xaf, 0x95, 0x16,
+		0xca, 0x55, 0x7c, 0xc7, 0x8e, 0x1d, 0x27, 0xf0, 0x94, 0xbd, 0x76, 0x69, 0x75, 0x7e, 0xb1, 0x9c,
+		0x4b, 0x94, 0x2e, 0x44, 0xee, 0x3c, 0x1e, 0xf1, 0xd8, 0x1e, 0x67, 0xf6, 0xf5, 0x7e, 0x56, 0xe5,
+		0x77, 0x33, 0x91, 0x9b, 0x8c, 0x9b, 0x9a, 0xae, 0x99, 0x0d, 0x73, 0x4f, 0x9b, 0x8c, 0x3d, 0x6d,
+		0x5c, 0xee, 0xf2, 0x7b, 0x39, 0xff, 0xd0, 0x0f, 0xd9, 0x4b, 0x8c, 0x27, 0xd4, 0x02, 0x8d, 0x14,
+		0xa1, 0xdf, 0x73, 0xb0, 0x3f, 0x32, 0x26, 0xc7, 0x5f, 0xe9, 0x72, 0x6f, 0xc7, 0x31, 0x44, 0xf2,
+		0x3c, 0x7f, 0x40, 0x9b, 0x9d, 0x51, 0x71, 0x4e, 0x5d, 0x65, 0xf7, 0xf0, 0xbd, 0x1d, 0xfa, 0xe4,
+		0xf6, 0x2a, 0x12, 0x62, 0x9f, 0x6f, 0x68, 0xc0, 0x7e, 0x4a, 0xdb, 0x71, 0xcb, 0x28, 0x7d, 0x11,
+		0xec, 0x38, 0x19, 0xc5, 0x2d, 0xde, 0x2c, 0x74, 0x9e, 0xb3, 0x42, 0x14, 0x37, 0xe7, 0x63, 0xcd,
+		0x40, 0xb1, 0x49, 0x16, 0x22, 0x5e, 0x40, 0xe8, 0x7d, 0x7f, 0xd2, 0x85, 0x4f, 0x96, 0x61, 0xd0,
+		0x99, 0x85, 0x71, 0x33, 0x22, 0xb9, 0x7b, 0x17, 0xcc, 0x4d, 0x81, 0x5c, 0x83, 0xfd, 0xce, 0xe2,
+		0xc6, 0x4d, 0x9a, 0x5d, 0x76, 0x3d, 0xb5, 0x8b, 0xb0, 0x8f, 0xbb, 0x8e, 0xf1, 0x4e, 0xb0, 0x1c,
+		0x57, 0x81, 0x43, 0x6e, 0xe7, 0x43, 0x1c, 0x43, 0xdc, 0xb5, 0x0b, 0xe3, 0xa5, 0x82, 0xfb, 0x63,
+		0xda, 0xcd, 0x96, 0xd1, 0xc6, 0x03, 0x91, 0x69, 0xf6, 0xfd, 0x5d, 0x91, 0x26, 0xdf, 0x0a, 0x07,
+		0x2c, 0x6e, 0x8a, 0xd9, 0x96, 0x4b, 0xb5, 0x4d, 0x8d, 0xb1, 0x99, 0xcf, 0x74, 0x6f, 0x60, 0x88,
+		0x01, 0x67, 0x87, 0x4a, 0x23, 0xbd, 0xee, 0x71, 0x2b, 0x88, 0x82, 0x6d, 0xbe, 0x87, 0xab, 0x71,
+		0x08, 0x17, 0x18, 0x20, 0xc0, 0xad, 0x8d, 0x54, 0x69, 0x2c, 0x84, 0xac, 0x72, 0x88, 0xa9, 0x6d,
+		0x80, 0xea, 0x7c, 0x5d, 0xde, 0x02, 0x12, 0x54, 0x46, 0x72, 0xd6, 0x7b, 0xc2, 0xb1, 0xdb, 0xd4,
+		0x10, 0x3c, 0xfb, 0xe8, 0x3e, 0xd2, 0xe8, 0x4c, 0x91, 0xb7, 0x6d, 0xc6, 0xbe, 0x2f, 0x09, 0x27,
+		0xdd, 0x07, 0x0c, 0xe8, 0x65, 0x47, 0xdb, 0xea, 0xb4, 0xd4, 0xcd, 0x86, 0xee, 0xfe, 0x7e, 0xe9,
+		0x21, 0xf7, 0x2c, 0x4e, 0x61, 0x6d, 0x7b, 0x16, 0x6d, 0x93, 0xe4, 0x5b, 0x12, 0x0c, 0xae, 0xa8,
+		0x9b, 0x9a, 0xeb, 0x76, 0xb1, 0xef, 0x93, 0x4e, 0xf8, 0xb5, 0xa5, 0x8d, 0x0d, 0xf1, 0x16, 0x6d,
+		0x4a, 0xe1, 0x29, 0x6c, 0x7e, 0xb3, 0x21, 0x9e, 0x65, 0x48, 0x29, 0x2c, 0x81, 0x6b, 0x6f, 0x3a,
+		0x65, 0x32, 0xeb, 0xc3, 0x5f, 0xf5, 0x00, 0x9a, 0x45, 0xcd, 0x08, 0x39, 0x0d, 0x03, 0x6d, 0x0d,
+		0xef, 0x54, 0x31, 0xf7, 0x23, 0x1d, 0xd2, 0x81, 0xe7, 0x1e, 0x56, 0x04, 0x8c, 0xfc, 0x34, 0x64,
+		0x19, 0x7b, 0xdc, 0x97, 0x3f, 0x04, 0x69, 0x7a, 0xd5, 0xd7, 0x61, 0x72, 0x00, 0xd3, 0xcf, 0xb0,
+		0xb3, 0xc6, 0xac, 0x52, 0xc6, 0x27, 0x4b, 0x94, 0x4a, 0x91, 0xbd, 0x70, 0xa2, 0x87, 0x8f, 0xab,
+		0x51, 0x39, 0xda, 0x3d, 0xf2, 0x7b, 0x7d, 0xb0, 0x9f, 0x1f, 0x17, 0xc1, 0x53, 0x2e, 0x5b, 0x96,
+		0x25, 0xbe, 0x92, 0x0a, 0x2c, 0x7b, 0x4a, 0x6d, 0x35, 0xe4, 0x1d, 0x48, 0x5d, 0xb6, 0xac, 0x16,
+		0x39, 0x09, 0x7d, 0xed, 0x4e, 0xd3, 0xf6, 0x5f, 0x6d, 0x57, 0x4e, 0x6d, 0x35, 0xa6, 0x10, 0x40,
+		0xe9, 0x34, 0x35, 0x85, 0x81, 0x90, 0x32, 0x4c, 0x6c, 0x74, 0x9a, 0xcd, 0x9d, 0x6a, 0x5d, 0xab,
+		0x19, 0x75, 0xd4, 0x72, 0x53, 0x6b, 0x5f, 0xd7, 0xea, 0x55, 0xed, 0x66, 0x4b, 0xd5, 0xed, 0xdb,
+		0x69, 0x69, 0xe5, 0x08, 0x05, 0x9b, 0xa3, 0x50, 0x0a, 0x07, 0x2a, 0x0b, 0x18, 0xf9, 0xcf, 0x13,
+		0x90, 0x16, 0xa4, 0x71, 0x74, 0x9b, 0x5a, 0x53, 0xab, 0x59, 0xf6, 0x95, 0x3a, 0x3b, 0x4d, 0x08,
+		0x24, 0x37, 0x79, 0x8f, 0x66, 0x2e, 0xef, 0x53, 0x30, 0x81, 0x79, 0xf6, 0x37, 0xb5, 0x30, 0x0f,
+		0x3f, 0xb5, 0x35, 0x0e, 0xa9, 0x96, 0x21, 0x02, 0x7c, 0x78, 0xdf, 0x17, 0x53, 0x24, 0x0f, 0xfd,
+		0x68, 0x46, 0x2c, 0xd6, 0x85, 0x98, 0xcf, 0xd3, 0xe4, 0x00, 0xee, 0x28, 0x59, 0x35, 0xf6, 0xc5,
+		0x06, 0x2c, 0x60, 0x49, 0x7c, 0x1c, 0x98, 0x7d, 0x22, 0x9b, 0xdf, 0xbc, 0xba, 0xc7, 0x2d, 0x8c,
+		0x59, 0x5a, 0x82, 0x7c, 0xaf, 0xa8, 0x96, 0xa5, 0xb5, 0x75, 0x24, 0xc8, 0xc0, 0xf1, 0xa0, 0xe3,
+		0xba, 0x51, 0x67, 0x77, 0x82, 0x33, 0x0a, 0xfd, 0xcd, 0x2e, 0x06, 0x31, 0x7d, 0xa8, 0xd2, 0xc2,
+		0x2c, 0x2d, 0xcc, 0x8a, 0xcc, 0x12, 0x02, 0x95, 0x61, 0x4c, 0xad, 0xd7, 0x1b, 0x38, 0x40, 0x30,
+		0xf2, 0xd9, 0xa0, 0x36, 0xd5, 0xcc, 0x0f, 0x76, 0xe9, 0x0b, 0xe2, 0x20, 0x94, 0x38, 0x7c, 0x29,
+		0x03, 0x03, 0x2d, 0xc6, 0x94, 0xfc, 0x38, 0x8c, 0x06, 0x38, 0x45, 0xfe, 0xae, 0x35, 0xf8, 0xb1,
+		0xfe, 0x8c, 0x42, 0x7f, 0x87, 0xbd, 0x7f, 0x53, 0x7a, 0x7b, 0xf4, 0x9b, 0xad, 0xc3, 0xae, 0x37,
+		0x5b, 0xd5, 0x56, 0xa3, 0x94, 0xa1, 0xf4, 0xf9, 0x53, 0xad, 0xc5, 0xe0, 0x53, 0xad, 0x9b, 0x9a,
+		0x2e, 0x7c, 0x13, 0x2c, 0x52, 0x5b, 0x0d, 0x93, 0xaa, 0xa3, 0xaa, 0xeb, 0x7c, 0x65, 0x63, 0x3e,
+		0xee, 0xfa, 0x4d, 0x9f, 0x6e, 0x4d, 0x5d, 0x2a, 0xae, 0xcc, 0xdb, 0x7a, 0xfc, 0x3b, 0x09, 0x38,
+		0xe2, 0xd2, 0x63, 0x17, 0x70, 0x50, 0x9d, 0x0b, 0xe1, 0x1a, 0xdf, 0xc3, 0x67, 0x26, 0x9f, 0x81,
+		0x14, 0xc2, 0xc7, 0x7e, 0xbf, 0xf5, 0xa3, 0x9f, 0xfa, 0x4d, 0x79, 0x52, 0x8a, 0xec, 0x15, 0x4a,
+		0xa4, 0xf4, 0xae, 0xde, 0xe5, 0x97, 0x2b, 0x3a, 0xed, 0xb9, 0x73, 0x62, 0xf4, 0xcb, 0xf0, 0x8b,
+		0x12, 0xf0, 0x48, 0x90, 0x6d, 0x98, 0xd9, 0x0f, 0xf1, 0xf8, 0x31, 0x2b, 0xb4, 0x2d, 0x71, 0x0f,
+		0x02, 0x5b, 0x84, 0x51, 0xbe, 0x4c, 0xa1, 0x48, 0x55, 0x53, 0xdd, 0xd0, 0x62, 0xa5, 0xf7, 0x4f,
+		0xe2, 0xab, 0x94, 0x23, 0x0c, 0x97, 0x5e, 0xb6, 0xac, 0xa8, 0x1b, 0xda, 0x1d, 0xb5, 0x7c, 0xef,
+		0xbc, 0x04, 0x72, 0x84, 0xfb, 0xeb, 0x6e, 0x74, 0x94, 0x43, 0xbd, 0x8b, 0x79, 0x2c, 0xc2, 0xf9,
+		0xee, 0xaa, 0xaf, 0xb7, 0xef, 0x9a, 0x77, 0xeb, 0xca, 0x30, 0xbf, 0xfd, 0x26, 0x1c, 0xa0, 0x82,
+		0x76, 0xa2, 0xce, 0xae, 0xf7, 0x9a, 0x5c, 0x17, 0xc2, 0x32, 0xf6, 0x41, 0x98, 0x8b, 0x00, 0x4e,
+		0x53, 0xf3, 0x09, 0xfb, 0x0d, 0xb7, 0x88, 0x39, 0x7b, 0xca, 0x35, 0x29, 0x2b, 0x2e, 0x4c, 0xf9,
+		0xa3, 0x12, 0x1c, 0x0c, 0x54, 0xcd, 0x27, 0xc7, 0x85, 0x90, 0x2f, 0xd1, 0xed, 0xdd, 0x65, 0xbe,
+		0x14, 0xc2, 0xf1, 0xf1, 0x58, 0x8e, 0x19, 0x2b, 0x1e, 0x96, 0x55, 0xd8, 0xef, 0xe5, 0x58, 0xc8,
+		0xea, 0x32, 0x0c, 0x7b, 0x37, 0x5f, 0xf3, 0x52, 0xaf, 0x6b, 0xeb, 0x21, 0xcf, 0xce, 0xab, 0x5c,
+		0xf7, 0xf7, 0x87, 0x2d, 0x93, 0x2b, 0xc1, 0x9b, 0x16, 0xbb, 0x13, 0x89, 0x83, 0x8e, 0x9f, 0xfe,
+		0x9b, 0xf4, 0x56, 0xe3, 0x72, 0xd2, 0xef, 0x78, 0xa3, 0xee, 0x98, 0xca, 0xfc, 0x9d, 0x04, 0xc7,
+		0xba, 0xb0, 0xcd, 0x05, 0xf5, 0xad, 0x30, 0xee, 0x0a, 0xf7, 0x8a, 0xb9, 0x54, 0xa8, 0xd1, 0xc9,
+		0xf8, 0xa5, 0x92, 0x1d, 0xd6, 0x9c, 0x44, 0xe1, 0xfd, 0xdc, 0x5f, 0x4e, 0x8c, 0x05, 0xcb, 0x4c,
+		0xbe, 0xe0, 0x0b, 0x46, 0x69, 0xef, 0xa0, 0xbe, 0xfd, 0x96, 0x04, 0x0f, 0x7a, 0xdb, 0x1b, 0xb2,
+		0xe8, 0x7a, 0x05, 0xf7, 0xd7, 0xcb, 0x12, 0x9c, 0xec, 0x85, 0x7f, 0xde, 0x71, 0x9b, 0x30, 0xe6,
+		0xac, 0x44, 0xfd, 0xfd, 0xb6, 0xd7, 0x75, 0x28, 0xb1, 0x49, 0xde, 0x85, 0x0e, 0xfa, 0x45, 0x89,
+		0x0f, 0x57, 0xb7, 0x82, 0xb0, 0xde, 0x78, 0x1a, 0x3f, 0xea, 0xed, 0xde, 0x8d, 0x8d, 0x5d, 0x8f,
+		0x0d, 0x79, 0xb6, 0x62, 0x43, 0xba, 0x33, 0xb1, 0xb7, 0xee, 0x74, 0x05, 0x3b, 0xaf, 0xc3, 0xc1,
+		0x00, 0xbb, 0x5c, 0xf8, 0xdf, 0x02, 0x63, 0x21, 0xa3, 0x86, 0x1b, 0x9a, 0x5d, 0x0c, 0x1a, 0x85,
+		0x04, 0x87, 0x84, 0xfc, 0x71, 0x09, 0x26, 0x68, 0xc5, 0x21, 0x9d, 0xf5, 0x8a, 0x16, 0x58, 0x1b,
+		0x26, 0xa3, 0xf9, 0xe6, 0x92, 0x5b, 0x82, 0x7e, 0xa6, 0x63, 0x5c, 0x58, 0x7b, 0xd5, 0x54, 0x4e,
+		0x45, 0xfe, 0x98, 0x30, 0xce, 0x73, 0xa2, 0x79, 0x21, 0x83, 0xfd, 0xb6, 0xa5, 0x75, 0x87, 0xc6,
+		0xb8, 0x4b, 0x56, 0x7f, 0x2a, 0xac, 0x73, 0x38, 0xdf, 0x5c, 0x5a, 0x5b, 0x77, 0xcc, 0x3a, 0xbb,
+		0xe3, 0x6e, 0x77, 0xd5, 0x0c, 0xff, 0xb6, 0x30, 0xc3, 0x76, 0xc3, 0xba, 0x99, 0xe1, 0x57, 0x60,
+		0xcf, 0xd8, 0x76, 0x38, 0xa6, 0x01, 0xdf, 0xb0, 0x76, 0xf8, 0xb7, 0x13, 0x70, 0x88, 0x3f, 0xce,
+		0x52, 0xbf, 0x2b, 0x3d, 0x42, 0xf0, 0x20, 0x4c, 0xa8, 0x75, 0x89, 0x26, 0x92, 0x33, 0xdb, 0xb5,
+		0xab, 0xbe, 0x79, 0x95, 0xd4, 0x4d, 0xcb, 0x4f, 0x27, 0x6e, 0x0b, 0x27, 0x57, 0x77, 0x45, 0xf9,
+		0x42, 0x34, 0x24, 0x75, 0x07, 0x34, 0xe4, 0x4f, 0x24, 0x28, 0x84, 0x09, 0x90, 0x6b, 0x84, 0x0e,
+		0x07, 0x3c, 0x7b, 0xe8, 0x7e, 0xa5, 0x78, 0x55, 0x2f, 0xf1, 0xdb, 0xb0, 0x81, 0xbb, 0xbf, 0xad,
+		0xdd, 0xd5, 0xa1, 0xfb, 0x92, 0x98, 0x78, 0x6c, 0xcd, 0x0f, 0x2e, 0x74, 0x5e, 0x81, 0x03, 0xf6,
+		0x93, 0x81, 0x29, 0xe0, 0x1b, 0x67, 0x91, 0xf4, 0x31, 0x09, 0x8e, 0x46, 0xf0, 0xfe, 0x8a, 0x9e,
+		0xea, 0xb7, 0x23, 0x35, 0xe5, 0xae, 0x2c, 0xc1, 0xce, 0xf1, 0x01, 0xe7, 0xbd, 0x06, 0x17, 0xfe,
+		0x58, 0xb2, 0x7d, 0xd7, 0x1b, 0xf5, 0xf9, 0x70, 0x28, 0x1a, 0xe7, 0xf0, 0x29, 0x48, 0xe1, 0xb1,
+		0xaf, 0xbc, 0xe4, 0x55, 0x42, 0x3f, 0x73, 0x5e, 0x6c, 0xf6, 0x85, 0x1c, 0xc4, 0x23, 0x6b, 0xe0,
+		0x3a, 0x36, 0xc6, 0xb7, 0x28, 0xf2, 0x09, 0xfb, 0xa1, 0xfa, 0x18, 0x62, 0x6c, 0x6f, 0x42, 0xc9,
+		0x6d, 0xf9, 0x72, 0x64, 0x02, 0x39, 0xca, 0x35, 0x9e, 0x04, 0x11, 0xcf, 0x52, 0xaf, 0xc0, 0xa8,
+		0x2b, 0x8f, 0xf3, 0xff, 0x38, 0x86, 0x69, 0xf9, 0xa7, 0xae, 0xd9, 0xb3, 0x56, 0xe1, 0x7b, 0x86,
+		0x86, 0xd1, 0x74, 0xcb, 0x95, 0x22, 0xc9, 0xe3, 0x40, 0x18, 0x45, 0xf6, 0x9a, 0x0a, 0xaf, 0xe7,
+		0x59, 0x18, 0xf3, 0xe4, 0xf2, 0x9a, 0x6e, 0x7f, 0x7f, 0x52, 0xbe, 0xc8, 0x35, 0x26, 0x64, 0x7f,
+		0xc6, 0xf5, 0xda, 0xb7, 0x7b, 0xc3, 0x3f, 0x74, 0xfb, 0x5c, 0xfe, 0x0e, 0x31, 0xda, 0x43, 0x09,
+		0x39, 0x5e, 0x26, 0xef, 0x8e, 0x18, 0x2f, 0x33, 0x6c, 0xdb, 0xca, 0xcd, 0x3c, 0xa3, 0x12, 0xce,
+		0xc9, 0x4b, 0x12, 0xdc, 0x1f, 0xc5, 0x89, 0xb9, 0x7c, 0x43, 0xd7, 0xec, 0x86, 0xd9, 0x27, 0x17,
+		0xa4, 0xde, 0x4e, 0x2e, 0xdc, 0x29, 0x1b, 0x19, 0xca, 0xf6, 0x9f, 0x49, 0xf0, 0x40, 0x1c, 0xdb,
+		0x5c, 0x8c, 0x2b, 0xb8, 0x6b, 0x43, 0xf3, 0xe3, 0xfc, 0x99, 0x18, 0x39, 0x0a, 0x32, 0x77, 0xcc,
+		0x70, 0x86, 0x37, 0xed, 0x1c, 0xdc, 0xc3, 0x5b, 0x66, 0xb9, 0x0f, 0x16, 0xda, 0x1d, 0x11, 0x8e,
+		0xf5, 0xad, 0x70, 0x34, 0x0a, 0x8b, 0xcb, 0xe1, 0xb2, 0x7d, 0x9d, 0x8d, 0x75, 0xe0, 0xee, 0x77,
+		0xd4, 0x39, 0x7e, 0x28, 0x03, 0x67, 0xff, 0xe2, 0x30, 0xf4, 0x51, 0x0e, 0xc8, 0x07, 0x24, 0x00,
+		0xd7, 0x91, 0xd6, 0xa9, 0x28, 0x71, 0x87, 0x47, 0x21, 0x0b, 0xd3, 0x3d, 0xc3, 0xf3, 0xa5, 0xe6,
+		0xf4, 0x77, 0x22, 0x77, 0x6f, 0xff, 0x77, 0x7f, 0xf3, 0x03, 0x89, 0xfb, 0x88, 0x3c, 0x1d, 0x11,
+		0x4f, 0x75, 0x4d, 0x7c, 0xbf, 0x20, 0xb9, 0xaf, 0x36, 0x9e, 0xee, 0xad, 0x3e, 0xc1, 0xde, 0x54,
+		0xaf, 0xe0, 0x9c, 0xbb, 0x57, 0x3b, 0xdc, 0x9d, 0x27, 0x0f, 0xc7, 0x73, 0x37, 0xfd, 0x16, 0xef,
+		0x3c, 0xf7, 0x56, 0xf2, 0x1f, 0x24, 0x18, 0x0f, 0x0b, 0x7f, 0x91, 0x47, 0x7b, 0x63, 0x25, 0xb8,
+		0x62, 0x29, 0x3c, 0xb6, 0x07, 0x4c, 0xde, 0x9e, 0x05, 0xa7, 0x3d, 0x45, 0xf2, 0xf4, 0x1e, 0xda,
+		0x33, 0xed, 0xde, 0xc6, 0xff, 0x17, 0x09, 0xee, 0xe9, 0x1a, 0x2a, 0x22, 0xc5, 0xde, 0x58, 0xed,
+		0xb2, 0x3e, 0x2b, 0x94, 0x6e, 0x87, 0x04, 0x6f, 0xf6, 0x55, 0xa7, 0xd9, 0xcf, 0x90, 0xf9, 0xbd,
+		0x34, 0x3b, 0xf4, 0xc8, 0x05, 0xf9, 0x7f, 0xbd, 0xd7, 0xa3, 0xba, 0x6b, 0x57, 0x20, 0x84, 0x52,
+		0x98, 0xee, 0x19, 0x9e, 0xb7, 0xe3, 0xf5, 0x4e, 0x3b, 0x14, 0xb2, 0x72, 0x9b, 0xdd, 0x37, 0xfd,
+		0x16, 0xaf, 0x53, 0xf7, 0x56, 0xf2, 0xdf, 0xa4, 0xf0, 0x2b, 0x4f, 0x8f, 0x74, 0xe5, 0x33, 0x3a,
+		0x46, 0x54, 0x78, 0x74, 0xf7, 0x88, 0xbc, 0xa5, 0x6d, 0xa7, 0xa5, 0x9b, 0x44, 0xbb, 0xd3, 0x2d,
+		0x0d, 0xed, 0x4e, 0xf2, 0x47, 0x12, 0x8c, 0x87, 0xc5, 0x42, 0x62, 0x86, 0x6a, 0x97, 0xb0, 0x4f,
+		0xcc, 0x50, 0xed, 0x16, 0x78, 0x91, 0x8b, 0x8e, 0x04, 0x2e, 0x90, 0x73, 0x51, 0x12, 0xe8, 0xda,
+		0x9f, 0x38, 0x3e, 0xbb, 0x86, 0x10, 0x62, 0xc6, 0x67, 0x2f, 0xf1, 0x93, 0x98, 0xf1, 0xd9, 0x53,
+		0x04, 0xa3, 0xc7, 0xf1, 0x69, 0x37, 0xaf, 0xc7, 0x0e, 0x35, 0x09, 0x7e, 0x68, 0xcf, 0xb3, 0x42,
+		0x26, 0x67, 0xba, 0x72, 0x1b, 0x16, 0x8e, 0x28, 0x9c, 0xdd, 0x0d, 0x0a, 0x6f, 0xd0, 0x92, 0xd3,
+		0xa0, 0x59, 0x52, 0xdc, 0x4b, 0x83, 0xbc, 0x07, 0xa6, 0xfe, 0x44, 0x82, 0xb1, 0x90, 0xb5, 0x65,
+		0xcc, 0xc8, 0x8c, 0x5e, 0x44, 0x17, 0x1e, 0xdd, 0x3d, 0x22, 0x6f, 0xda, 0x33, 0x4e, 0xd3, 0x5e,
+		0x4d, 0x9e, 0xda, 0x4b, 0xd3, 0x5c, 0x93, 0xf9, 0xe7, 0x9c, 0x6b, 0x0b, 0xae, 0xca, 0xc8, 0x85,
+		0x5d, 0x72, 0x27, 0x5a, 0xf5, 0xc8, 0xae, 0xf1, 0x78, 0xa3, 0x5e, 0xe7, 0x34, 0xea, 0x35, 0x64,
+		0xf9, 0xf6, 0x1a, 0x15, 0xf4, 0x01, 0x3e, 0x11, 0x7c, 0x3e, 0xa5, 0xbb, 0x52, 0x85, 0x2e, 0x32,
+		0x0b, 0x0f, 0xef, 0x0a, 0x87, 0xb7, 0xec, 0x49, 0xa7, 0x65, 0x67, 0xc9, 0x43, 0x51, 0x2d, 0x73,
+		0x2d, 0x22, 0x1b, 0xfa, 0x86, 0x31, 0xfd, 0x16, 0xb6, 0x7e, 0x7d, 0x2b, 0x79, 0x97, 0xb8, 0x0f,
+		0x70, 0xa2, 0x6b, 0xe5, 0xae, 0x85, 0x62, 0xe1, 0xc1, 0x1e, 0x20, 0x39, 0x73, 0x0f, 0x3a, 0xcc,
+		0x1d, 0x25, 0x47, 0xa2, 0x98, 0xc3, 0xc5, 0x22, 0xf9, 0x5e, 0xc9, 0xbe, 0xde, 0x75, 0xb2, 0x7b,
+		0x05, 0xee, 0xd5, 0x64, 0xe1, 0x54, 0x4f, 0xb0, 0x9c, 0x9d, 0x53, 0x0e, 0x3b, 0x93, 0xe4, 0x68,
+		0x24, 0x3b, 0x8c, 0x8b, 0xbf, 0x88, 0x38, 0x33, 0xde, 0x5d, 0x07, 0xa3, 0xd7, 0x9e, 0x85, 0x47,
+		0x77, 0x8f, 0xc8, 0xf9, 0xae, 0x7c, 0x2a, 0xe8, 0xd2, 0xf7, 0xe4, 0xb8, 0x86, 0x1f, 0xb4, 0x9c,
+		0x7e, 0x4b, 0xa3, 0xfe, 0x56, 0xf2, 0x55, 0x09, 0x0e, 0x45, 0xae, 0xcf, 0xc8, 0x93, 0xbb, 0x65,
+		0xd6, 0xb3, 0x1c, 0x2d, 0x3c, 0xb5, 0x57, 0x74, 0x31, 0x5e, 0xbb, 0xb6, 0xf8, 0x29, 0xf2, 0xc4,
+		0x2e, 0x5b, 0x4c, 0x17, 0xbd, 0xd3, 0x6f, 0xa1, 0xff, 0xde, 0x4a, 0xfe, 0x58, 0x82, 0xd1, 0xc0,
+		0x52, 0x8c, 0x9c, 0x8f, 0xe1, 0x39, 0x7c, 0xc1, 0x57, 0xb8, 0xb0, 0x5b, 0x34, 0x61, 0x67, 0xbb,
+		0x36, 0xf1, 0x34, 0x39, 0x15, 0xdd, 0x44, 0xcb, 0x7b, 0x7f, 0x4e, 0xab, 0xdf, 0xf6, 0x91, 0xd0,
+		0x1f, 0x4a, 0xc0, 0x41, 0x06, 0x38, 0xbd, 0x6d, 0x62, 0x55, 0xf8, 0x8f, 0x9f, 0xbd, 0x19, 0xe2,
+		0xed, 0xc3, 0x9c, 0x9e, 0x8e, 0x1b, 0x3d, 0x01, 0x03, 0x78, 0x7e, 0xb0, 0x51, 0xd3, 0xc8, 0x44,
+		0xe0, 0x90, 0x51, 0x85, 0x95, 0x88, 0x53, 0x46, 0xff, 0x28, 0x4e, 0x19, 0x09, 0x94, 0x19, 0x7c,
+		0x14, 0xa6, 0xb1, 0x89, 0x31, 0x8a, 0x89, 0x90, 0x13, 0x4a, 0xf4, 0x45, 0x7e, 0x0f, 0x72, 0x92,
+		0x9e, 0x85, 0xa1, 0x18, 0xa5, 0x4b, 0x91, 0x62, 0x38, 0xdd, 0xc3, 0xc9, 0xa4, 0x6d, 0x73, 0x93,
+		0x33, 0x61, 0xcb, 0xe5, 0xd3, 0x53, 0x30, 0x11, 0xd5, 0x0d, 0x37, 0x63, 0xce, 0x26, 0x75, 0x79,
+		0x3b, 0x2c, 0xf6, 0x6d, 0xb0, 0x88, 0xd7, 0xc8, 0xf6, 0xfe, 0x62, 0x58, 0x6f, 0x07, 0x99, 0xa2,
+		0x74, 0x20, 0xec, 0xa4, 0xd2, 0xd7, 0x52, 0x40, 0x16, 0xcd, 0xcd, 0xd9, 0xb6, 0xa6, 0x5a, 0x9a,
+		0x33, 0xb5, 0xfb, 0x1e, 0xbd, 0x91, 0x6e, 0xff, 0xd1, 0x1b, 0xc5, 0xf3, 0xee, 0x4c, 0x62, 0x77,
+		0xaf, 0x48, 0x45, 0x3c, 0x3e, 0xf3, 0xc6, 0xf0, 0xc7, 0x67, 0x92, 0x7b, 0x8c, 0xbd, 0x84, 0x3c,
+		0x37, 0x73, 0x29, 0xec, 0xf6, 0x37, 0xbb, 0xe1, 0x53, 0x88, 0x0a, 0xce, 0xe5, 0xa5, 0x5e, 0x1f,
+		0x92, 0xe8, 0xdb, 0xfb, 0x43, 0x12, 0x17, 0xa1, 0x9f, 0xdf, 0x04, 0xeb, 0xdf, 0xd3, 0x4d, 0x30,
+		0x8e, 0x4d, 0x66, 0xdc, 0x1f, 0x43, 0xe8, 0xf5, 0xb2, 0x17, 0x43, 0x99, 0x79, 0xb5, 0x88, 0xf2,
+		0xbf, 0xfd, 0xf3, 0x1f, 0x3d, 0x19, 0x6c, 0x1e, 0xde, 0x86, 0xbd, 0xc7, 0x35, 0x3c, 0x83, 0x6a,
+		0x26, 0x1f, 0x81, 0x42, 0x30, 0xd7, 0xb6, 0xad, 0x3f, 0x9d, 0x84, 0xdc, 0xa2, 0xb9, 0x59, 0xae,
+		0x37, 0xac, 0xbb, 0xa9, 0x99, 0x77, 0xfa, 0x8d, 0x8f, 0xab, 0x30, 0xe2, 0xbf, 0xeb, 0xcd, 0x34,
+		0xf2, 0xf4, 0xee, 0x1e, 0x3f, 0x18, 0xf6, 0xbe, 0x95, 0x46, 0x5e, 0x1b, 0xae, 0xed, 0x4c, 0x1b,
+		0x8f, 0xf7, 0x7a, 0x2b, 0x36, 0xa8, 0xe4, 0x33, 0x4f, 0xc5, 0xf7, 0xe3, 0x61, 0x6f, 0x3f, 0x7a,
+		0xba, 0x44, 0x2e, 0x40, 0xde, 0x9f, 0x67, 0xf7, 0xe1, 0x2d, 0x7c, 0xe6, 0xd7, 0x14, 0xcb, 0x49,
+		0xed, 0x95, 0xfa, 0x2e, 0xcb, 0x13, 0xd0, 0xaf, 0x6e, 0xdb, 0x57, 0xff, 0x7a, 0x1d, 0x07, 0x1c,
+		0x67, 0xe6, 0x31, 0x8f, 0x00, 0x03, 0xed, 0x43, 0x01, 0x1e, 0xf0, 0x0a, 0x50, 0xc8, 0x43, 0xde,
+		0x0f, 0x63, 0xae, 0xa4, 0x2d, 0xb6, 0x77, 0x27, 0xa9, 0x59, 0x2e, 0x69, 0x9b, 0x0d, 0xdd, 0x5e,
+		0xbb, 0x6a, 0xff, 0x36, 0x1f, 0xa3, 0x70, 0xfa, 0x26, 0xb5, 0x87, 0xbe, 0x79, 0x75, 0x7c, 0xdf,
+		0xf8, 0x8c, 0x94, 0x4f, 0xe8, 0x72, 0x0b, 0x0a, 0xc1, 0x5c, 0x3b, 0xe4, 0x1f, 0xf2, 0x26, 0x8b,
+		0x74, 0x9b, 0x6f, 0xb2, 0xc8, 0x1f, 0x4a, 0xc0, 0xd0, 0xa2, 0xb9, 0xb9, 0xa6, 0xd7, 0xbf, 0xa9,
+		0x87, 0xcd, 0xe3, 0xf1, 0x5d, 0x93, 0xf7, 0x76, 0x8d, 0x23, 0x11, 0xf9, 0x37, 0x25, 0xd8, 0xef,
+		0xc9, 0xb9, 0x9b, 0x3d, 0x42, 0x96, 0xed, 0x86, 0xc6, 0xbe, 0xa8, 0x10, 0x71, 0x99, 0xed, 0x21,
+		0x4f, 0xdb, 0xe5, 0xaf, 0x25, 0xe0, 0x08, 0x4e, 0x7d, 0xaa, 0x5e, 0xd3, 0x9a, 0xdf, 0x40, 0x0f,
+		0x58, 0xdd, 0x56, 0x8f, 0x87, 0xbd, 0x74, 0x94, 0x0a, 0x7b, 0xe9, 0x68, 0xe6, 0xf5, 0x42, 0x35,
+		0x02, 0xdb, 0x60, 0xe7, 0x2e, 0x44, 0x6b, 0xcb, 0x71, 0x9f, 0xb7, 0x11, 0x25, 0x5c, 0xf9, 0x71,
+		0xb8, 0xaf, 0x5b, 0xb9, 0x50, 0xa5, 0x90, 0x5d, 0xb8, 0x73, 0x17, 0x70, 0x5f, 0x74, 0x04, 0x35,
+		0x8f, 0xbe, 0x20, 0xc0, 0x83, 0x1d, 0x78, 0xa5, 0xbd, 0x63, 0x6d, 0xd1, 0x4f, 0x17, 0xc5, 0xf6,
+		0x92, 0x03, 0xea, 0xda, 0x2f, 0x4f, 0xec, 0x71, 0xbf, 0x7c, 0xe6, 0x4a, 0x90, 0xc7, 0x47, 0x50,
+		0x44, 0x4e, 0x2d, 0x28, 0x9a, 0xfb, 0x42, 0x9f, 0x25, 0xf1, 0x35, 0x43, 0x9e, 0x82, 0x83, 0xbe,
+		0xac, 0x6e, 0xa2, 0x78, 0x44, 0x7e, 0x5f, 0x82, 0x4e, 0x5f, 0xec, 0x82, 0xbf, 0xf3, 0x84, 0x42,
+		0xb8, 0xd6, 0x49, 0x7b, 0xd7, 0xba, 0x37, 0x00, 0xe0, 0x03, 0x08, 0x3d, 0xbc, 0xa9, 0xf0, 0x60,
+		0x94, 0xc7, 0xfb, 0x99, 0x97, 0x4e, 0x8b, 0x25, 0x30, 0xcb, 0x50, 0x32, 0xba, 0x76, 0x83, 0x3d,
+		0x84, 0x30, 0xb3, 0x1a, 0xa9, 0x6e, 0xe7, 0xcf, 0x44, 0x3b, 0x45, 0x47, 0xbd, 0xea, 0xe6, 0x97,
+		0x82, 0x7c, 0x16, 0x0e, 0x87, 0x64, 0x77, 0x91, 0xe8, 0xf9, 0x33, 0xf8, 0x2d, 0xe6, 0xd1, 0x45,
+		0x73, 0xd3, 0x13, 0x5b, 0x31, 0xbf, 0x39, 0x8d, 0xc1, 0x02, 0xec, 0x17, 0x71, 0x9e, 0x3a, 0x0f,
+		0xf4, 0xb0, 0xc3, 0x0f, 0xa9, 0x98, 0x86, 0x8d, 0xd9, 0x68, 0x54, 0x3c, 0x18, 0x73, 0x6a, 0xcf,
+		0x28, 0xd1, 0x5d, 0x78, 0x36, 0xda, 0x62, 0x1c, 0xf1, 0x76, 0xa1, 0x57, 0xec, 0xb2, 0x0e, 0x87,
+		0x02, 0x99, 0xf6, 0x34, 0xe3, 0x34, 0x5e, 0xda, 0xc3, 0xdc, 0x17, 0x7a, 0xc0, 0xe0, 0xbf, 0x4a,
+		0xd4, 0x93, 0x46, 0x2f, 0x43, 0xdb, 0xa6, 0xd5, 0x9a, 0x17, 0x8d, 0xf6, 0x9d, 0xd5, 0x81, 0x27,
+		0x7a, 0x9f, 0xc9, 0x42, 0xd8, 0x7e, 0x6e, 0x4f, 0x52, 0xbe, 0xd7, 0x37, 0x50, 0xc2, 0xda, 0x27,
+		0x77, 0x60, 0x32, 0xaa, 0xec, 0x2e, 0xca, 0xfc, 0xec, 0x97, 0x33, 0x90, 0x5c, 0x34, 0x37, 0xc9,
+		0x0b, 0x30, 0xe2, 0x0f, 0x82, 0x44, 0x46, 0xb0, 0x83, 0x6b, 0xd6, 0xc2, 0xd9, 0xde, 0x61, 0xed,
+		0xd6, 0x5c, 0x83, 0x21, 0xef, 0xda, 0xf6, 0x44, 0x17, 0x22, 0x1e, 0xc8, 0xc2, 0x43, 0xbd, 0x42,
+		0xda, 0x95, 0xbd, 0x0e, 0xd2, 0xf6, 0x22, 0xec, 0xde, 0x2e, 0xd8, 0x02, 0xa8, 0x70, 0xaa, 0x07,
+		0x20, 0x9b, 0xfa, 0x0b, 0x30, 0xe2, 0x5f, 0xab, 0x74, 0x93, 0x9e, 0x0f, 0xb6, 0x70, 0xb6, 0x77,
+		0x58, 0xbb, 0xca, 0x75, 0x00, 0x97, 0x83, 0x7c, 0x7f, 0x17, 0x0a, 0x0e, 0x58, 0xe1, 0x74, 0x4f,
+		0x60, 0x76, 0x1d, 0x3f, 0x25, 0xc1, 0xa1, 0x68, 0x17, 0xed, 0x5c, 0xb7, 0x3e, 0x8f, 0xc2, 0x2a,
+		0x3c, 0xb1, 0x17, 0x2c, 0x7b, 0x61, 0x38, 0xf6, 0xa9, 0xa0, 0x47, 0x42, 0xde, 0x0a, 0x59, 0x8f,
+		0x37, 0x72, 0xbc, 0x5b, 0x2b, 0x5d, 0x80, 0x85, 0xe9, 0x1e, 0x01, 0xbb, 0x55, 0xff, 0x08, 0x79,
+		0xb7, 0x04, 0xb9, 0x80, 0x0b, 0xd0, 0x4d, 0x7d, 0xfc, 0xc0, 0x85, 0x87, 0x77, 0x01, 0xdc, 0x85,
+		0x97, 0xf3, 0x67, 0xc8, 0x3b, 0x24, 0x18, 0xf6, 0x4d, 0x9e, 0x0f, 0x76, 0x21, 0xee, 0x05, 0x2d,
+		0x9c, 0xe9, 0x19, 0xb4, 0x1b, 0x17, 0x67, 0xc9, 0x7b, 0x25, 0xd8, 0x1f, 0x6e, 0xc5, 0xbb, 0x0d,
+		0xdc, 0x50, 0x8c, 0xc2, 0xa3, 0xbb, 0xc5, 0xe8, 0xca, 0x5a, 0xa1, 0xef, 0x6d, 0x68, 0x13, 0x6f,
+		0x77, 0xab, 0xe1, 0x7f, 0x0c, 0x00, 0x81, 0xe1, 0xa8, 0x7f, 0x86, 0xef, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2545,6 +2832,12 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.KeyRotationFee.Equal(&that1.KeyRotationFee) {
 		return false
 	}
+	if !this.GlobalLiquidStakingCap.Equal(that1.GlobalLiquidStakingCap) {
+		return false
+	}
+	if !this.ValidatorLiquidStakingCap.Equal(that1.ValidatorLiquidStakingCap) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -3485,6 +3778,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ValidatorLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.ValidatorLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.GlobalLiquidStakingCap.Size()
+		i -= size
+		if _, err := m.GlobalLiquidStakingCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size, err := m.KeyRotationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3852,6 +4165,55 @@ func (m *ValAddrsOfRotatedConsKeys) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenizeShareRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenizeShareRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenizeShareRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStaking(v)
 	base := offset
@@ -4231,6 +4593,10 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovStaking(uint64(l))
 	l = m.KeyRotationFee.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	l = m.GlobalLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = m.ValidatorLiquidStakingCap.Size()
+	n += 1 + l + sovStaking(uint64(l))
 	return n
 }
 
@@ -4346,6 +4712,30 @@ func (m *ValAddrsOfRotatedConsKeys) Size() (n int) {
 	return n
 }
 
+func (m *TokenizeShareRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovStaking(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	return n
+}
+
 func sovStaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -7193,6 +7583,74 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GlobalLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorLiquidStakingCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorLiquidStakingCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -8056,6 +8514,171 @@ func (m *ValAddrsOfRotatedConsKeys) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TokenizeShareRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenizeShareRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenizeShareRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipStaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -474,9 +474,10 @@ func (k Keeper) DumpUpgradeInfoToDisk(height int64, p types.Plan) error {
 	}
 
 	upgradeInfo := types.Plan{
-		Name:   p.Name,
-		Height: height,
-		Info:   p.Info,
+		Name:     p.Name,
+		Height:   height,
+		Info:     p.Info,
+		Binaries: p.Binaries,
 	}
 	info, err := json.Marshal(upgradeInfo)
 	if err != nil {
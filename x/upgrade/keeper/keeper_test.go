@@ -90,6 +90,12 @@ func (s *KeeperTestSuite) TestReadUpgradeInfoFromDisk() {
 	expected := types.Plan{
 		Name:   "test_upgrade",
 		Height: 100,
+		Binaries: []types.BinaryArtifact{{
+			OsArch:    "linux/amd64",
+			Url:       "https://example.com/linux",
+			Sha256:    "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9",
+			Signature: make([]byte, 64),
+		}},
 	}
 
 	// create an upgrade info file
@@ -47,6 +47,21 @@ func (s *KeeperTestSuite) TestSoftwareUpgrade() {
 			true,
 			"name cannot be empty: invalid request",
 		},
+		{
+			"invalid plan binary",
+			&types.MsgSoftwareUpgrade{
+				Authority: s.encodedAuthority,
+				Plan: types.Plan{
+					Name:   "all-good",
+					Height: 123450000,
+					Binaries: []types.BinaryArtifact{
+						{OsArch: "linux/amd64", Url: "https://example.com/simd", Sha256: "not hex"},
+					},
+				},
+			},
+			true,
+			"invalid binary for \"linux/amd64\"",
+		},
 		{
 			"successful upgrade scheduled",
 			&types.MsgSoftwareUpgrade{
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	neturl "net/url"
+	"regexp"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -11,6 +16,11 @@ import (
 // UpgradeInfoFileName file to store upgrade information
 const UpgradeInfoFilename = "upgrade-info.json"
 
+// AnyOSArch is the os/arch of a binary artifact running on any os/arch.
+const AnyOSArch = "any"
+
+var osArchRx = regexp.MustCompile(`^[a-zA-Z0-9]+/[a-zA-Z0-9]+$`)
+
 // ValidateBasic does basic validation of a Plan
 func (p Plan) ValidateBasic() error {
 	if !p.Time.IsZero() {
@@ -26,6 +36,115 @@ func (p Plan) ValidateBasic() error {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "height must be greater than 0")
 	}
 
+	osArchs := make(map[string]struct{}, len(p.Binaries))
+	for _, binary := range p.Binaries {
+		if err := binary.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid binary for %q: %s", binary.OsArch, err)
+		}
+
+		if _, ok := osArchs[binary.OsArch]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate binary for %q", binary.OsArch)
+		}
+		osArchs[binary.OsArch] = struct{}{}
+	}
+
+	return nil
+}
+
+// BinaryFor returns the binary artifact of the plan for the given os/arch,
+// falling back to the binary artifact for any os/arch.
+func (p Plan) BinaryFor(osArch string) (BinaryArtifact, bool) {
+	var anyBinary *BinaryArtifact
+	for i, binary := range p.Binaries {
+		switch binary.OsArch {
+		case osArch:
+			return binary, true
+		case AnyOSArch:
+			anyBinary = &p.Binaries[i]
+		}
+	}
+
+	if anyBinary == nil {
+		return BinaryArtifact{}, false
+	}
+
+	return *anyBinary, true
+}
+
+// ValidateBasic does basic validation of a BinaryArtifact
+func (a BinaryArtifact) ValidateBasic() error {
+	if a.OsArch != AnyOSArch && !osArchRx.MatchString(a.OsArch) {
+		return fmt.Errorf("os/arch must be %q or have the os/arch format", AnyOSArch)
+	}
+
+	url, err := neturl.Parse(a.Url)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+	if !url.IsAbs() {
+		return fmt.Errorf("url must be absolute, got %q", a.Url)
+	}
+
+	if _, err := a.Checksum(); err != nil {
+		return err
+	}
+
+	if len(a.Signature) != 0 && len(a.Signature) != ed25519.SignatureSize {
+		return fmt.Errorf("signature must be %d bytes long, got %d", ed25519.SignatureSize, len(a.Signature))
+	}
+
+	return nil
+}
+
+// Checksum returns the decoded SHA-256 checksum of the binary.
+func (a BinaryArtifact) Checksum() ([]byte, error) {
+	checksum, err := hex.DecodeString(a.Sha256)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sha256 checksum: %w", err)
+	}
+	if len(checksum) != sha256.Size {
+		return nil, fmt.Errorf("sha256 checksum must be %d bytes long, got %d", sha256.Size, len(checksum))
+	}
+
+	return checksum, nil
+}
+
+// SignBytes returns the bytes signed by the signature of the binary: the SHA-256 of the
+// chain-id, followed by the SHA-256 of the plan name and the raw SHA-256 checksum of the
+// binary. Binding the signature to the chain and the plan prevents it from being replayed
+// in another upgrade plan. Cosmovisor mirrors it to verify the signature, both are tested
+// against the golden vectors of testdata/binary_signatures.json.
+func (a BinaryArtifact) SignBytes(chainID, planName string) ([]byte, error) {
+	checksum, err := a.Checksum()
+	if err != nil {
+		return nil, err
+	}
+
+	chainIDHash := sha256.Sum256([]byte(chainID))
+	planNameHash := sha256.Sum256([]byte(planName))
+
+	signBytes := make([]byte, 0, 3*sha256.Size)
+	signBytes = append(signBytes, chainIDHash[:]...)
+	signBytes = append(signBytes, planNameHash[:]...)
+	return append(signBytes, checksum...), nil
+}
+
+// VerifySignature checks the signature of the binary of the given chain and plan against
+// the given public key.
+func (a BinaryArtifact) VerifySignature(pubKey ed25519.PublicKey, chainID, planName string) error {
+	if len(a.Signature) == 0 {
+		return fmt.Errorf("binary for %q is not signed", a.OsArch)
+	}
+
+	signBytes, err := a.SignBytes(chainID, planName)
+	if err != nil {
+		return err
+	}
+
+	if !ed25519.Verify(pubKey, signBytes, a.Signature) {
+		return fmt.Errorf("invalid signature of binary for %q", a.OsArch)
+	}
+
 	return nil
 }
 
@@ -1,6 +1,11 @@
 package types_test
 
 import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -12,6 +17,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+const testChecksum = "abababababababababababababababababababababababababababababababab"
+
 func mustParseTime(s string) time.Time {
 	t, err := time.Parse(time.RFC3339, s)
 	if err != nil {
@@ -90,6 +97,55 @@ func TestPlanValid(t *testing.T) {
 				Height: -12345,
 			},
 		},
+		"with binaries": {
+			p: types.Plan{
+				Name:   "binaries",
+				Height: 123450000,
+				Binaries: []types.BinaryArtifact{
+					{OsArch: "linux/amd64", Url: "https://example.com/linux", Sha256: testChecksum},
+					{OsArch: "any", Url: "https://example.com/any", Sha256: testChecksum, Signature: make([]byte, 64)},
+				},
+			},
+			valid: true,
+		},
+		"binary with invalid os/arch": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.BinaryArtifact{{OsArch: "linux", Url: "https://example.com/linux", Sha256: testChecksum}},
+			},
+		},
+		"binary with relative url": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.BinaryArtifact{{OsArch: "linux/amd64", Url: "example.com/linux", Sha256: testChecksum}},
+			},
+		},
+		"binary without checksum": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.BinaryArtifact{{OsArch: "linux/amd64", Url: "https://example.com/linux"}},
+			},
+		},
+		"binary with invalid signature": {
+			p: types.Plan{
+				Name:     "binaries",
+				Height:   123450000,
+				Binaries: []types.BinaryArtifact{{OsArch: "linux/amd64", Url: "https://example.com/linux", Sha256: testChecksum, Signature: []byte("sig")}},
+			},
+		},
+		"duplicate binaries": {
+			p: types.Plan{
+				Name:   "binaries",
+				Height: 123450000,
+				Binaries: []types.BinaryArtifact{
+					{OsArch: "linux/amd64", Url: "https://example.com/linux", Sha256: testChecksum},
+					{OsArch: "linux/amd64", Url: "https://example.com/other", Sha256: testChecksum},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -149,3 +205,82 @@ func TestShouldExecute(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanBinaryFor(t *testing.T) {
+	linux := types.BinaryArtifact{OsArch: "linux/amd64", Url: "https://example.com/linux", Sha256: testChecksum}
+	anyBinary := types.BinaryArtifact{OsArch: "any", Url: "https://example.com/any", Sha256: testChecksum}
+
+	p := types.Plan{Binaries: []types.BinaryArtifact{anyBinary, linux}}
+	binary, ok := p.BinaryFor("linux/amd64")
+	require.True(t, ok)
+	require.Equal(t, linux, binary)
+
+	binary, ok = p.BinaryFor("darwin/arm64")
+	require.True(t, ok)
+	require.Equal(t, anyBinary, binary)
+
+	p = types.Plan{Binaries: []types.BinaryArtifact{linux}}
+	_, ok = p.BinaryFor("darwin/arm64")
+	require.False(t, ok)
+}
+
+func TestBinaryArtifactVerifySignature(t *testing.T) {
+	pubKey, privKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	otherPubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	binary := types.BinaryArtifact{OsArch: "any", Url: "https://example.com/any", Sha256: testChecksum}
+	require.ErrorContains(t, binary.VerifySignature(pubKey, "test-chain", "v2"), "not signed")
+
+	signBytes, err := binary.SignBytes("test-chain", "v2")
+	require.NoError(t, err)
+	binary.Signature = ed25519.Sign(privKey, signBytes)
+	require.NoError(t, binary.VerifySignature(pubKey, "test-chain", "v2"))
+	require.ErrorContains(t, binary.VerifySignature(otherPubKey, "test-chain", "v2"), "invalid signature")
+
+	// the signature cannot be replayed on another chain or plan
+	require.ErrorContains(t, binary.VerifySignature(pubKey, "other-chain", "v2"), "invalid signature")
+	require.ErrorContains(t, binary.VerifySignature(pubKey, "test-chain", "v3"), "invalid signature")
+
+	// nor be used as a signature of the raw checksum
+	checksum, err := hex.DecodeString(testChecksum)
+	require.NoError(t, err)
+	binary.Signature = ed25519.Sign(privKey, checksum)
+	require.ErrorContains(t, binary.VerifySignature(pubKey, "test-chain", "v2"), "invalid signature")
+}
+
+// binarySignatureVector is a golden vector of the signature of a binary, shared with
+// the cosmovisor BinaryArtifact which must produce the same sign bytes.
+type binarySignatureVector struct {
+	Description string `json:"description"`
+	ChainID     string `json:"chain_id"`
+	PlanName    string `json:"plan_name"`
+	Sha256      string `json:"sha256"`
+	SignBytes   string `json:"sign_bytes"`
+	PubKey      string `json:"pub_key"`
+	Signature   string `json:"signature"`
+}
+
+func TestBinaryArtifactSignatureGoldenVectors(t *testing.T) {
+	bz, err := os.ReadFile(filepath.Join("testdata", "binary_signatures.json"))
+	require.NoError(t, err)
+	var vectors []binarySignatureVector
+	require.NoError(t, json.Unmarshal(bz, &vectors))
+	require.NotEmpty(t, vectors)
+
+	for _, v := range vectors {
+		t.Run(v.Description, func(t *testing.T) {
+			signature, err := hex.DecodeString(v.Signature)
+			require.NoError(t, err)
+			pubKey, err := hex.DecodeString(v.PubKey)
+			require.NoError(t, err)
+
+			binary := types.BinaryArtifact{Sha256: v.Sha256, Signature: signature}
+			signBytes, err := binary.SignBytes(v.ChainID, v.PlanName)
+			require.NoError(t, err)
+			require.Equal(t, v.SignBytes, hex.EncodeToString(signBytes))
+			require.NoError(t, binary.VerifySignature(pubKey, v.ChainID, v.PlanName))
+		})
+	}
+}
@@ -4,6 +4,7 @@
 package types
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -53,6 +54,9 @@ type Plan struct {
 	// moved to the IBC module in the sub module 02-client.
 	// If this field is not empty, an error will be thrown.
 	UpgradedClientState *any.Any `protobuf:"bytes,5,opt,name=upgraded_client_state,json=upgradedClientState,proto3" json:"upgraded_client_state,omitempty"` // Deprecated: Do not use.
+	// binaries lists the binaries to upgrade to, at most one per os/arch. When
+	// set, they are used by cosmovisor instead of the binaries found in info.
+	Binaries []BinaryArtifact `protobuf:"bytes,6,rep,name=binaries,proto3" json:"binaries"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -88,6 +92,55 @@ func (m *Plan) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Plan proto.InternalMessageInfo
 
+// BinaryArtifact specifies a binary of an upgrade plan for a given os/arch.
+type BinaryArtifact struct {
+	// os_arch is the os/arch the binary is built for, e.g. linux/amd64, or
+	// "any" for a binary running on any os/arch.
+	OsArch string `protobuf:"bytes,1,opt,name=os_arch,json=osArch,proto3" json:"os_arch,omitempty"`
+	// url is the location where the binary, or an archive containing it, can be
+	// downloaded from.
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// sha256 is the hex encoded SHA-256 checksum of the binary.
+	Sha256 string `protobuf:"bytes,3,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// signature is the optional ed25519 signature of the binary by the key
+	// releasing it. The signed bytes are the SHA-256 of the chain-id, followed by
+	// the SHA-256 of the plan name and the raw SHA-256 checksum of the binary.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *BinaryArtifact) Reset()         { *m = BinaryArtifact{} }
+func (m *BinaryArtifact) String() string { return proto.CompactTextString(m) }
+func (*BinaryArtifact) ProtoMessage()    {}
+func (*BinaryArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+}
+func (m *BinaryArtifact) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BinaryArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BinaryArtifact.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BinaryArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BinaryArtifact.Merge(m, src)
+}
+func (m *BinaryArtifact) XXX_Size() int {
+	return m.Size()
+}
+func (m *BinaryArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_BinaryArtifact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BinaryArtifact proto.InternalMessageInfo
+
 // SoftwareUpgradeProposal is a gov Content type for initiating a software
 // upgrade.
 // Deprecated: This legacy proposal is deprecated in favor of Msg-based gov
@@ -107,7 +160,7 @@ func (m *SoftwareUpgradeProposal) Reset()         { *m = SoftwareUpgradeProposal
 func (m *SoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*SoftwareUpgradeProposal) ProtoMessage()    {}
 func (*SoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{1}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
 }
 func (m *SoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +206,7 @@ func (m *CancelSoftwareUpgradeProposal) Reset()         { *m = CancelSoftwareUpg
 func (m *CancelSoftwareUpgradeProposal) String() string { return proto.CompactTextString(m) }
 func (*CancelSoftwareUpgradeProposal) ProtoMessage()    {}
 func (*CancelSoftwareUpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{2}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
 }
 func (m *CancelSoftwareUpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +247,7 @@ func (m *ModuleVersion) Reset()         { *m = ModuleVersion{} }
 func (m *ModuleVersion) String() string { return proto.CompactTextString(m) }
 func (*ModuleVersion) ProtoMessage()    {}
 func (*ModuleVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccf2a7d4d7b48dca, []int{3}
+	return fileDescriptor_ccf2a7d4d7b48dca, []int{4}
 }
 func (m *ModuleVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -225,6 +278,7 @@ var xxx_messageInfo_ModuleVersion proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*Plan)(nil), "cosmos.upgrade.v1beta1.Plan")
+	proto.RegisterType((*BinaryArtifact)(nil), "cosmos.upgrade.v1beta1.BinaryArtifact")
 	proto.RegisterType((*SoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.SoftwareUpgradeProposal")
 	proto.RegisterType((*CancelSoftwareUpgradeProposal)(nil), "cosmos.upgrade.v1beta1.CancelSoftwareUpgradeProposal")
 	proto.RegisterType((*ModuleVersion)(nil), "cosmos.upgrade.v1beta1.ModuleVersion")
@@ -235,41 +289,47 @@ func init() {
 }
 
 var fileDescriptor_ccf2a7d4d7b48dca = []byte{
-	// 532 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xbf, 0x6e, 0xd3, 0x40,
-	0x18, 0xcf, 0xb5, 0x6e, 0x51, 0x2e, 0x42, 0x15, 0x26, 0xb4, 0xd7, 0xa8, 0x38, 0x96, 0xc5, 0x10,
-	0x55, 0x8a, 0x4d, 0x5b, 0x26, 0x33, 0x20, 0x92, 0x11, 0x90, 0x8a, 0x0b, 0x1d, 0x58, 0xa2, 0x4b,
-	0x7c, 0x71, 0xad, 0xda, 0x77, 0x96, 0xef, 0x12, 0xc8, 0x2b, 0x30, 0xf5, 0x11, 0x18, 0x11, 0x53,
-	0x87, 0x3e, 0x44, 0xc4, 0x54, 0x31, 0x21, 0x21, 0xf1, 0x27, 0x19, 0xca, 0xc6, 0x2b, 0xa0, 0xbb,
-	0xb3, 0xab, 0x08, 0x0a, 0x62, 0x60, 0x89, 0xbe, 0xef, 0xbb, 0xef, 0x97, 0xdf, 0x9f, 0x3b, 0xc3,
-	0x3b, 0x03, 0xc6, 0x53, 0xc6, 0xbd, 0x51, 0x16, 0xe5, 0x38, 0x24, 0xde, 0x78, 0xa7, 0x4f, 0x04,
-	0xde, 0x29, 0x7b, 0x37, 0xcb, 0x99, 0x60, 0xe6, 0xba, 0xde, 0x72, 0xcb, 0x69, 0xb1, 0xd5, 0xd8,
-	0x8c, 0x18, 0x8b, 0x12, 0xe2, 0xa9, 0xad, 0xfe, 0x68, 0xe8, 0x61, 0x3a, 0xd1, 0x90, 0x46, 0x3d,
-	0x62, 0x11, 0x53, 0xa5, 0x27, 0xab, 0x62, 0xda, 0xfc, 0x15, 0x20, 0xe2, 0x94, 0x70, 0x81, 0xd3,
-	0xac, 0x58, 0xd8, 0xd4, 0x4c, 0x3d, 0x8d, 0x2c, 0x68, 0xf5, 0xd1, 0x0d, 0x9c, 0xc6, 0x94, 0x79,
-	0xea, 0x57, 0x8f, 0x9c, 0x1f, 0x00, 0x1a, 0xfb, 0x09, 0xa6, 0xa6, 0x09, 0x0d, 0x8a, 0x53, 0x82,
-	0x80, 0x0d, 0x5a, 0xd5, 0x40, 0xd5, 0xe6, 0x03, 0x68, 0xc8, 0x7f, 0x47, 0x4b, 0x36, 0x68, 0xd5,
-	0x76, 0x1b, 0xae, 0xa6, 0x76, 0x4b, 0x6a, 0xf7, 0x59, 0x49, 0xdd, 0x59, 0x9b, 0x7e, 0x6e, 0x56,
-	0x4e, 0xbe, 0x34, 0xc1, 0xdb, 0x8b, 0xd3, 0x6d, 0x80, 0x40, 0xa0, 0x80, 0xe6, 0x3a, 0x5c, 0x3d,
-	0x22, 0x71, 0x74, 0x24, 0xd0, 0xb2, 0x0d, 0x5a, 0xcb, 0x41, 0xd1, 0x49, 0xb2, 0x98, 0x0e, 0x19,
-	0x32, 0x34, 0x99, 0xac, 0xcd, 0xc7, 0xf0, 0x56, 0x11, 0x4e, 0xd8, 0x1b, 0x24, 0x31, 0xa1, 0xa2,
-	0xc7, 0x05, 0x16, 0x04, 0xad, 0x28, 0xf6, 0xfa, 0x6f, 0xec, 0x0f, 0xe9, 0xa4, 0xb3, 0x84, 0x40,
-	0x70, 0xb3, 0x84, 0x75, 0x15, 0xea, 0x40, 0x82, 0x7c, 0xf4, 0xfd, 0x4d, 0x13, 0xbc, 0xbe, 0x38,
-	0xdd, 0x5e, 0xd3, 0x09, 0xb4, 0x79, 0x78, 0xec, 0x49, 0xa3, 0xce, 0x27, 0x00, 0x37, 0x0e, 0xd8,
-	0x50, 0xbc, 0xc4, 0x39, 0x79, 0xae, 0x91, 0xfb, 0x39, 0xcb, 0x18, 0xc7, 0x89, 0x59, 0x87, 0x2b,
-	0x22, 0x16, 0x49, 0x99, 0x82, 0x6e, 0x4c, 0x1b, 0xd6, 0x42, 0xc2, 0x07, 0x79, 0x9c, 0x89, 0x98,
-	0x51, 0x95, 0x46, 0x35, 0x58, 0x1c, 0x99, 0xf7, 0xa1, 0x91, 0x25, 0x98, 0x2a, 0x97, 0xb5, 0xdd,
-	0x2d, 0xf7, 0xea, 0xcb, 0x76, 0x25, 0x7f, 0xa7, 0x2a, 0xa3, 0x52, 0x31, 0x05, 0x0a, 0xe4, 0x3f,
-	0x92, 0x52, 0xdf, 0x9f, 0xb5, 0x1b, 0x05, 0x2a, 0x62, 0xe3, 0x4b, 0x44, 0x97, 0x51, 0x41, 0xa8,
-	0x90, 0x46, 0x9c, 0x05, 0x23, 0x7f, 0xd0, 0x8f, 0x80, 0xf3, 0x0e, 0xc0, 0xdb, 0x5d, 0x4c, 0x07,
-	0x24, 0xf9, 0xcf, 0x1e, 0xfd, 0xa7, 0xff, 0x26, 0xb3, 0xb5, 0x20, 0xf3, 0xaf, 0x42, 0x10, 0x70,
-	0x0e, 0xe1, 0xf5, 0x27, 0x2c, 0x1c, 0x25, 0xe4, 0x90, 0xe4, 0x5c, 0xe6, 0x78, 0xd5, 0x23, 0x44,
-	0xf0, 0xda, 0x58, 0x1f, 0x2b, 0x55, 0x46, 0x50, 0xb6, 0xfe, 0x86, 0x54, 0xf4, 0xe1, 0xac, 0xbd,
-	0x70, 0xc5, 0xf6, 0x5d, 0xf7, 0xde, 0x5e, 0xc7, 0x9f, 0x7e, 0xb3, 0x2a, 0xd3, 0x99, 0x05, 0xce,
-	0x67, 0x16, 0xf8, 0x3a, 0xb3, 0xc0, 0xc9, 0xdc, 0xaa, 0x9c, 0xcf, 0xad, 0xca, 0xc7, 0xb9, 0x55,
-	0x79, 0xb1, 0xa5, 0xd7, 0x79, 0x78, 0xec, 0xc6, 0xcc, 0x7b, 0x75, 0xf9, 0xe1, 0x8a, 0x49, 0x46,
-	0x78, 0x7f, 0x55, 0xbd, 0xaf, 0xbd, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x6a, 0x37, 0x42,
-	0xd7, 0x03, 0x00, 0x00,
+	// 636 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4f,
+	0x18, 0xee, 0xd0, 0x52, 0x7e, 0x9d, 0xfe, 0x14, 0x1d, 0x91, 0x2e, 0x0d, 0x6e, 0x9b, 0xc6, 0x98,
+	0x86, 0x84, 0x5d, 0x29, 0xe2, 0xa1, 0x1e, 0x0c, 0xe5, 0xa8, 0x26, 0xb8, 0x28, 0x07, 0x3d, 0x34,
+	0xd3, 0xed, 0x74, 0x3b, 0x61, 0x3b, 0xb3, 0xd9, 0x99, 0xa2, 0xfd, 0x0a, 0x9c, 0xf8, 0x08, 0x1e,
+	0x8d, 0x27, 0x0e, 0x7c, 0x08, 0x62, 0x3c, 0x10, 0x4f, 0x46, 0x13, 0xff, 0xc0, 0x01, 0x3f, 0x86,
+	0x99, 0x99, 0x5d, 0xac, 0x02, 0xc6, 0x83, 0x97, 0xe6, 0x7d, 0xdf, 0x79, 0x9e, 0x79, 0x9e, 0x79,
+	0xa6, 0xb3, 0xf0, 0xa6, 0xcf, 0xc5, 0x80, 0x0b, 0x77, 0x18, 0x05, 0x31, 0xee, 0x12, 0x77, 0x7b,
+	0xa9, 0x43, 0x24, 0x5e, 0x4a, 0x7b, 0x27, 0x8a, 0xb9, 0xe4, 0x68, 0xd6, 0xa0, 0x9c, 0x74, 0x9a,
+	0xa0, 0xca, 0x73, 0x01, 0xe7, 0x41, 0x48, 0x5c, 0x8d, 0xea, 0x0c, 0x7b, 0x2e, 0x66, 0x23, 0x43,
+	0x29, 0xcf, 0x04, 0x3c, 0xe0, 0xba, 0x74, 0x55, 0x95, 0x4c, 0x2b, 0xbf, 0x13, 0x24, 0x1d, 0x10,
+	0x21, 0xf1, 0x20, 0x4a, 0x00, 0x73, 0x46, 0xa9, 0x6d, 0x98, 0x89, 0xac, 0x59, 0xba, 0x8a, 0x07,
+	0x94, 0x71, 0x57, 0xff, 0x9a, 0x51, 0xed, 0xdd, 0x04, 0xcc, 0xad, 0x87, 0x98, 0x21, 0x04, 0x73,
+	0x0c, 0x0f, 0x88, 0x05, 0xaa, 0xa0, 0x5e, 0xf0, 0x74, 0x8d, 0xee, 0xc3, 0x9c, 0xda, 0xdd, 0x9a,
+	0xa8, 0x82, 0x7a, 0xb1, 0x51, 0x76, 0x8c, 0xb4, 0x93, 0x4a, 0x3b, 0x4f, 0x52, 0xe9, 0xd6, 0xf4,
+	0xc1, 0xe7, 0x4a, 0x66, 0xf7, 0x4b, 0x05, 0xbc, 0x3e, 0xd9, 0x5b, 0x00, 0x16, 0xf0, 0x34, 0x11,
+	0xcd, 0xc2, 0x7c, 0x9f, 0xd0, 0xa0, 0x2f, 0xad, 0x6c, 0x15, 0xd4, 0xb3, 0x5e, 0xd2, 0x29, 0x31,
+	0xca, 0x7a, 0xdc, 0xca, 0x19, 0x31, 0x55, 0xa3, 0x87, 0xf0, 0x7a, 0x12, 0x4e, 0xb7, 0xed, 0x87,
+	0x94, 0x30, 0xd9, 0x16, 0x12, 0x4b, 0x62, 0x4d, 0x6a, 0xf5, 0x99, 0x33, 0xea, 0xab, 0x6c, 0xd4,
+	0x9a, 0xb0, 0x80, 0x77, 0x2d, 0xa5, 0xad, 0x69, 0xd6, 0x86, 0x22, 0xa1, 0xe7, 0xf0, 0xbf, 0x0e,
+	0x65, 0x38, 0xa6, 0x44, 0x58, 0xf9, 0x6a, 0xb6, 0x5e, 0x6c, 0xdc, 0x72, 0xce, 0xbf, 0x02, 0xa7,
+	0xa5, 0x70, 0xa3, 0xd5, 0x58, 0xd2, 0x1e, 0xf6, 0x65, 0xab, 0xa4, 0x8e, 0xf2, 0x71, 0x7f, 0x71,
+	0xda, 0xc0, 0x17, 0x45, 0x77, 0xab, 0x7a, 0xdb, 0x59, 0x69, 0x78, 0xa7, 0x1b, 0x36, 0xad, 0xef,
+	0xaf, 0x2a, 0x60, 0xe7, 0x64, 0x6f, 0x61, 0x0c, 0xe3, 0xaa, 0x14, 0x6b, 0x3b, 0x00, 0x5e, 0xfe,
+	0x75, 0x3f, 0x54, 0x82, 0x53, 0x5c, 0xb4, 0x71, 0xec, 0xf7, 0x93, 0x6c, 0xf3, 0x5c, 0xac, 0xc6,
+	0x7e, 0x1f, 0x5d, 0x81, 0xd9, 0x61, 0x1c, 0xea, 0x70, 0x0b, 0x9e, 0x2a, 0x55, 0x5c, 0xa2, 0x8f,
+	0x1b, 0x2b, 0x77, 0x75, 0x5c, 0x05, 0x2f, 0xe9, 0xd0, 0x3c, 0x2c, 0x08, 0x1a, 0x30, 0x2c, 0x87,
+	0x31, 0xd1, 0x99, 0xfd, 0xef, 0xfd, 0x1c, 0x34, 0x4b, 0xca, 0xcd, 0xfb, 0xb3, 0x86, 0x6b, 0x9f,
+	0x00, 0x2c, 0x6d, 0xf0, 0x9e, 0x7c, 0x81, 0x63, 0xf2, 0xd4, 0x1c, 0x7a, 0x3d, 0xe6, 0x11, 0x17,
+	0x38, 0x44, 0x33, 0x70, 0x52, 0x52, 0x19, 0xa6, 0xf7, 0x6d, 0x1a, 0x54, 0x85, 0xc5, 0x2e, 0x11,
+	0x7e, 0x4c, 0x23, 0x49, 0x39, 0x4b, 0xac, 0x8d, 0x8f, 0xd0, 0x3d, 0x98, 0x8b, 0x42, 0xcc, 0xb4,
+	0xc1, 0x62, 0x63, 0xfe, 0xa2, 0x4c, 0x55, 0x18, 0xad, 0x82, 0x4a, 0x52, 0xff, 0x21, 0x3c, 0x4d,
+	0x6a, 0x3e, 0x50, 0x4e, 0xdf, 0xee, 0x2f, 0x96, 0x13, 0x56, 0xc0, 0xb7, 0x4f, 0x19, 0x6b, 0x9c,
+	0x49, 0xc2, 0xa4, 0x4a, 0xb5, 0x36, 0x96, 0xea, 0x05, 0xfe, 0x2d, 0x50, 0x7b, 0x03, 0xe0, 0x8d,
+	0x35, 0xcc, 0x7c, 0x12, 0xfe, 0xe3, 0x33, 0x36, 0x1f, 0xff, 0x9d, 0xcd, 0xfa, 0x98, 0xcd, 0x3f,
+	0x1a, 0xb1, 0x40, 0x6d, 0x13, 0x5e, 0x7a, 0xc4, 0xbb, 0xc3, 0x90, 0x6c, 0x92, 0x58, 0x50, 0x7e,
+	0xfe, 0x73, 0xb3, 0xe0, 0xd4, 0xb6, 0x59, 0xd6, 0xae, 0x72, 0x5e, 0xda, 0x5e, 0x70, 0xc5, 0x77,
+	0x96, 0x5b, 0xcd, 0x83, 0x6f, 0x76, 0xe6, 0xe0, 0xc8, 0x06, 0x87, 0x47, 0x36, 0xf8, 0x7a, 0x64,
+	0x83, 0xdd, 0x63, 0x3b, 0x73, 0x78, 0x6c, 0x67, 0x3e, 0x1c, 0xdb, 0x99, 0x67, 0xf3, 0x06, 0x2e,
+	0xba, 0x5b, 0x0e, 0xe5, 0xee, 0xcb, 0xd3, 0x4f, 0x94, 0x1c, 0x45, 0x44, 0x74, 0xf2, 0xfa, 0x25,
+	0x2d, 0xff, 0x18, 0x00, 0xad, 0xba, 0xbd, 0xaf, 0xc1, 0x04, 0x00, 0x00,
 }
 
 func (this *Plan) Equal(that interface{}) bool {
@@ -306,6 +366,47 @@ func (this *Plan) Equal(that interface{}) bool {
 	if !this.UpgradedClientState.Equal(that1.UpgradedClientState) {
 		return false
 	}
+	if len(this.Binaries) != len(that1.Binaries) {
+		return false
+	}
+	for i := range this.Binaries {
+		if !this.Binaries[i].Equal(&that1.Binaries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BinaryArtifact) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BinaryArtifact)
+	if !ok {
+		that2, ok := that.(BinaryArtifact)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.OsArch != that1.OsArch {
+		return false
+	}
+	if this.Url != that1.Url {
+		return false
+	}
+	if this.Sha256 != that1.Sha256 {
+		return false
+	}
+	if !bytes.Equal(this.Signature, that1.Signature) {
+		return false
+	}
 	return true
 }
 func (this *SoftwareUpgradeProposal) Equal(that interface{}) bool {
@@ -412,6 +513,20 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Binaries) > 0 {
+		for iNdEx := len(m.Binaries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Binaries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintUpgrade(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.UpgradedClientState != nil {
 		{
 			size, err := m.UpgradedClientState.MarshalToSizedBuffer(dAtA[:i])
@@ -454,6 +569,57 @@ func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BinaryArtifact) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BinaryArtifact) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BinaryArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Sha256) > 0 {
+		i -= len(m.Sha256)
+		copy(dAtA[i:], m.Sha256)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Sha256)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OsArch) > 0 {
+		i -= len(m.OsArch)
+		copy(dAtA[i:], m.OsArch)
+		i = encodeVarintUpgrade(dAtA, i, uint64(len(m.OsArch)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SoftwareUpgradeProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -607,6 +773,37 @@ func (m *Plan) Size() (n int) {
 		l = m.UpgradedClientState.Size()
 		n += 1 + l + sovUpgrade(uint64(l))
 	}
+	if len(m.Binaries) > 0 {
+		for _, e := range m.Binaries {
+			l = e.Size()
+			n += 1 + l + sovUpgrade(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BinaryArtifact) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OsArch)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Sha256)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovUpgrade(uint64(l))
+	}
 	return n
 }
 
@@ -849,6 +1046,220 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Binaries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Binaries = append(m.Binaries, BinaryArtifact{})
+			if err := m.Binaries[len(m.Binaries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUpgrade(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BinaryArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUpgrade
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BinaryArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BinaryArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OsArch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OsArch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sha256", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sha256 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUpgrade
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUpgrade
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipUpgrade(dAtA[iNdEx:])